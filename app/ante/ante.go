@@ -9,6 +9,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	bbn "github.com/babylonlabs-io/babylon/types"
 	btcckeeper "github.com/babylonlabs-io/babylon/x/btccheckpoint/keeper"
+	bskeeper "github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
 	epochingkeeper "github.com/babylonlabs-io/babylon/x/epoching/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -29,6 +30,7 @@ func NewAnteHandler(
 	epochingKeeper *epochingkeeper.Keeper,
 	btcConfig *bbn.BtcConfig,
 	btccKeeper *btcckeeper.Keeper,
+	btcStakingKeeper *bskeeper.Keeper,
 	txCounterStoreService store.KVStoreService,
 ) sdk.AnteHandler {
 	// initialize AnteHandler, which includes
@@ -66,6 +68,7 @@ func NewAnteHandler(
 		NewWrappedAnteHandler(authAnteHandler),
 		epochingkeeper.NewDropValidatorMsgDecorator(epochingKeeper),
 		NewBtcValidationDecorator(btcConfig, btccKeeper),
+		bskeeper.NewCovenantSigsLimitDecorator(btcStakingKeeper),
 	)
 
 	return anteHandler
@@ -483,6 +483,7 @@ func NewBabylonApp(
 		&app.EpochingKeeper,
 		&btcConfig,
 		&app.BtcCheckpointKeeper,
+		&app.BTCStakingKeeper,
 		runtime.NewKVStoreService(app.AppKeepers.GetKey(wasmtypes.StoreKey)),
 	)
 
@@ -58,6 +58,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types" // ibc module puts types under `ibchost` rather than `ibctypes`
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/spf13/cast"
 
 	appparams "github.com/babylonlabs-io/babylon/app/params"
 	bbn "github.com/babylonlabs-io/babylon/types"
@@ -203,7 +204,7 @@ func (ak *AppKeepers) InitKeepers(
 	ak.keys = keys
 
 	// set transient store keys
-	ak.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, btccheckpointtypes.TStoreKey)
+	ak.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, btccheckpointtypes.TStoreKey, btcstakingtypes.TStoreKey)
 
 	// set memory store keys
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
@@ -472,6 +473,7 @@ func (ak *AppKeepers) InitKeepers(
 		appCodec,
 		runtime.NewKVStoreService(keys[monitortypes.StoreKey]),
 		&btclightclientKeeper,
+		&checkpointingKeeper,
 	)
 
 	// add msgServiceRouter so that the epoching module can forward unwrapped messages to the staking module
@@ -494,11 +496,14 @@ func (ak *AppKeepers) InitKeepers(
 	ak.BTCStakingKeeper = btcstakingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[btcstakingtypes.StoreKey]),
+		ak.tkeys[btcstakingtypes.TStoreKey],
 		&btclightclientKeeper,
 		&btcCheckpointKeeper,
 		&ak.IncentiveKeeper,
+		ak.BankKeeper,
 		btcNetParams,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		btcstakingkeeper.WithJSONEvents(cast.ToBool(appOpts.Get(btcstakingkeeper.FlagEmitJSONEvents))),
 	)
 
 	// set up finality keeper
@@ -510,6 +515,10 @@ func (ak *AppKeepers) InitKeepers(
 		ak.CheckpointingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// make Finality to subscribe to the BTC staking's hooks
+	ak.BTCStakingKeeper = *ak.BTCStakingKeeper.SetHooks(
+		btcstakingtypes.NewMultiBtcStakingHooks(ak.FinalityKeeper.Hooks()),
+	)
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/stretchr/testify/require"
@@ -575,3 +576,123 @@ func TestSpendingRelativeTimeLockScript(t *testing.T) {
 	}
 	btctest.AssertEngineExecution(t, 0, true, newEngine)
 }
+
+func TestEstimateSlashingPathTxVSize(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	for _, tc := range []struct {
+		desc           string
+		numFps         uint32
+		numCovenants   uint32
+		covenantQuorum uint32
+	}{
+		{desc: "single key covenant", numFps: 1, numCovenants: 1, covenantQuorum: 1},
+		{desc: "3/5 covenant", numFps: 1, numCovenants: 5, covenantQuorum: 3},
+		{desc: "3/5 covenant with restaking", numFps: 2, numCovenants: 5, covenantQuorum: 3},
+		{desc: "6/9 covenant with restaking", numFps: 3, numCovenants: 9, covenantQuorum: 6},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			scenario := GenerateTestScenario(
+				r,
+				t,
+				tc.numFps,
+				tc.numCovenants,
+				tc.covenantQuorum,
+				btcutil.Amount(2*10e8),
+				5,
+			)
+
+			stakingInfo, err := btcstaking.BuildStakingInfo(
+				scenario.StakerKey.PubKey(),
+				scenario.FinalityProviderPublicKeys(),
+				scenario.CovenantPublicKeys(),
+				scenario.RequiredCovenantSigs,
+				scenario.StakingTime,
+				scenario.StakingAmount,
+				&chaincfg.MainNetParams,
+			)
+			require.NoError(t, err)
+
+			spendStakeTx := createSpendStakeTx(scenario.StakingAmount.MulF64(0.5))
+
+			si, err := stakingInfo.SlashingPathSpendInfo()
+			require.NoError(t, err)
+
+			// estimate the vsize before the slashing tx is witnessed
+			estimatedVSize, err := btcstaking.EstimateSlashingPathTxVSize(
+				spendStakeTx,
+				si,
+				int(tc.numCovenants),
+				tc.covenantQuorum,
+				int(tc.numFps),
+			)
+			require.NoError(t, err)
+			require.Empty(t, spendStakeTx.TxIn[0].Witness)
+
+			// witness the slashing tx with a quorum of covenant signatures and
+			// the signature of the last finality provider
+			stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+				spendStakeTx,
+				stakingInfo.StakingOutput,
+				scenario.StakerKey,
+				si.RevealedLeaf,
+			)
+			require.NoError(t, err)
+			covenantSigantures := GenerateSignatures(
+				t,
+				scenario.CovenantKeys,
+				spendStakeTx,
+				stakingInfo.StakingOutput,
+				si.RevealedLeaf,
+			)
+			for i := tc.covenantQuorum; i < tc.numCovenants; i++ {
+				covenantSigantures[i] = nil
+			}
+			fpSignatures := GenerateSignatures(
+				t,
+				scenario.FinalityProviderKeys,
+				spendStakeTx,
+				stakingInfo.StakingOutput,
+				si.RevealedLeaf,
+			)
+			for i := 0; i < len(fpSignatures)-1; i++ {
+				fpSignatures[i] = nil
+			}
+
+			witness, err := si.CreateSlashingPathWitness(covenantSigantures, fpSignatures, stakerSig)
+			require.NoError(t, err)
+			spendStakeTx.TxIn[0].Witness = witness
+
+			prevOutputFetcher := stakingInfo.GetOutputFetcher()
+			newEngine := func() (*txscript.Engine, error) {
+				return txscript.NewEngine(
+					stakingInfo.GetPkScript(),
+					spendStakeTx, 0, txscript.StandardVerifyFlags, nil,
+					txscript.NewTxSigHashes(spendStakeTx, prevOutputFetcher), stakingInfo.StakingOutput.Value,
+					prevOutputFetcher,
+				)
+			}
+			btctest.AssertEngineExecution(t, 0, true, newEngine)
+
+			require.Equal(t, mempool.GetTxVirtualSize(btcutil.NewTx(spendStakeTx)), estimatedVSize)
+			require.Equal(t, estimatedVSize, btcstaking.MinRelayFeeForVSize(estimatedVSize))
+		})
+	}
+
+	// invalid covenant quorum
+	scenario := GenerateTestScenario(r, t, 1, 3, 2, btcutil.Amount(2*10e8), 5)
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	si, err := stakingInfo.SlashingPathSpendInfo()
+	require.NoError(t, err)
+	_, err = btcstaking.EstimateSlashingPathTxVSize(createSpendStakeTx(scenario.StakingAmount), si, 3, 4, 1)
+	require.Error(t, err)
+}
@@ -27,14 +27,14 @@ const (
 )
 
 // buildSlashingTxFromOutpoint builds a valid slashing transaction by creating a new Bitcoin transaction that slashes a portion
-// of staked funds and directs them to a specified slashing address. The transaction also includes a change output sent back to
+// of staked funds and splits them among the specified slashing destinations. The transaction also includes a change output sent back to
 // the specified change address. The slashing rate determines the proportion of staked funds to be slashed.
 //
 // Parameters:
 //   - stakingOutput: The staking output to be spent in the transaction.
 //   - stakingAmount: The amount of staked funds in the staking output.
 //   - fee: The transaction fee to be paid.
-//   - slashingAddress: The Bitcoin address to which the slashed funds will be sent.
+//   - slashingDestinations: The destinations among which the slashed funds will be split, one output per destination.
 //   - changeAddress: The Bitcoin address to receive the change from the transaction.
 //   - slashingRate: The rate at which the staked funds will be slashed, expressed as a decimal.
 //
@@ -44,7 +44,7 @@ const (
 func buildSlashingTxFromOutpoint(
 	stakingOutput wire.OutPoint,
 	stakingAmount, fee int64,
-	slashingPkScript []byte,
+	slashingDestinations []SlashingDestination,
 	changeAddress btcutil.Address,
 	slashingRate sdkmath.LegacyDec,
 ) (*wire.MsgTx, error) {
@@ -58,8 +58,8 @@ func buildSlashingTxFromOutpoint(
 		return nil, ErrInvalidSlashingRate
 	}
 
-	if len(slashingPkScript) == 0 {
-		return nil, fmt.Errorf("slashing pk script must not be empty")
+	if err := ValidateSlashingDestinations(slashingDestinations); err != nil {
+		return nil, err
 	}
 
 	// Calculate the amount to be slashed
@@ -89,7 +89,12 @@ func buildSlashingTxFromOutpoint(
 	// means this tx is not replaceable.
 	input := wire.NewTxIn(&stakingOutput, nil, nil)
 	tx.AddTxIn(input)
-	tx.AddTxOut(wire.NewTxOut(int64(slashingAmount), slashingPkScript))
+	for i, amount := range splitSlashingAmount(slashingAmount, slashingDestinations) {
+		if amount <= 0 {
+			return nil, ErrInsufficientSlashingAmount
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(amount), slashingDestinations[i].PkScript))
+	}
 	tx.AddTxOut(wire.NewTxOut(int64(changeAmount), changeAddrScript))
 
 	// Verify that the none of the outputs is a dust output.
@@ -154,6 +159,32 @@ func BuildSlashingTxFromStakingTxStrict(
 	fee int64,
 	slashingRate sdkmath.LegacyDec,
 	net *chaincfg.Params,
+) (*wire.MsgTx, error) {
+	return BuildSlashingTxFromStakingTxStrictWithDestinations(
+		stakingTx,
+		stakingOutputIdx,
+		NewSingleSlashingDestination(slashingPkScript),
+		stakerPk,
+		slashChangeLockTime,
+		fee,
+		slashingRate,
+		net,
+	)
+}
+
+// BuildSlashingTxFromStakingTxStrictWithDestinations is the same as BuildSlashingTxFromStakingTxStrict,
+// except that the slashed funds are split among the given slashing destinations according to their
+// weights. The slashing transaction has one output per destination, in the given order, followed by
+// the change output.
+func BuildSlashingTxFromStakingTxStrictWithDestinations(
+	stakingTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	slashingDestinations []SlashingDestination,
+	stakerPk *btcec.PublicKey,
+	slashChangeLockTime uint16,
+	fee int64,
+	slashingRate sdkmath.LegacyDec,
+	net *chaincfg.Params,
 ) (*wire.MsgTx, error) {
 	// Get the staking output at the specified index from the staking transaction
 	stakingOutput, err := getPossibleStakingOutput(stakingTx, stakingOutputIdx)
@@ -180,7 +211,7 @@ func BuildSlashingTxFromStakingTxStrict(
 	return buildSlashingTxFromOutpoint(
 		*stakingOutpoint,
 		stakingOutput.Value, fee,
-		slashingPkScript, si.TapAddress,
+		slashingDestinations, si.TapAddress,
 		slashingRate)
 }
 
@@ -311,23 +342,33 @@ func CheckPreSignedSlashingTxSanity(tx *wire.MsgTx) error {
 // - the slashing transaction has exactly one input.
 // - the slashing transaction is non-replaceable.
 // - the lock time of the slashing transaction is 0.
-// - the slashing transaction has exactly two outputs, and:
-//   - the first output must pay to the provided slashing address.
-//   - the first output must pay at least (staking output value * slashing rate) to the slashing address.
-//   - neither of the outputs are considered dust.
+// - the slashing transaction has exactly one output per slashing destination plus the change output, and:
+//   - the i-th output must pay to the pk script of the i-th slashing destination.
+//   - the i-th output must pay at least its weighted share of (staking output value * slashing rate).
+//   - the last output must pay to the change timelock script.
+//   - none of the outputs are considered dust.
 //
 // - the min fee for slashing tx is preserved
 func validateSlashingTx(
 	slashingTx *wire.MsgTx,
-	slashingPkScript []byte,
+	slashingDestinations []SlashingDestination,
 	slashingRate sdkmath.LegacyDec,
 	slashingTxMinFee, stakingOutputValue int64,
 	stakerPk *btcec.PublicKey,
 	slashingChangeLockTime uint16,
 	net *chaincfg.Params,
 ) error {
+	if err := ValidateSlashingDestinations(slashingDestinations); err != nil {
+		return err
+	}
 
-	if err := CheckPreSignedSlashingTxSanity(slashingTx); err != nil {
+	numSlashingOutputs := len(slashingDestinations)
+	if err := CheckPreSignedTxSanity(
+		slashingTx,
+		1,
+		uint32(numSlashingOutputs+1),
+		MaxTxVersion,
+	); err != nil {
 		return fmt.Errorf("invalid slashing tx: %w", err)
 	}
 
@@ -337,12 +378,15 @@ func validateSlashingTx(
 		return fmt.Errorf("error converting slashing rate to float64: %w", err)
 	}
 	minSlashingAmount := btcutil.Amount(stakingOutputValue).MulF64(slashingRateFloat64)
-	if btcutil.Amount(slashingTx.TxOut[0].Value) < minSlashingAmount {
-		return fmt.Errorf("slashing transaction must slash at least staking output value * slashing rate")
-	}
+	for i, minAmount := range splitSlashingAmount(minSlashingAmount, slashingDestinations) {
+		out := slashingTx.TxOut[i]
+		if btcutil.Amount(out.Value) < minAmount {
+			return fmt.Errorf("slashing transaction must slash at least staking output value * slashing rate")
+		}
 
-	if !bytes.Equal(slashingTx.TxOut[0].PkScript, slashingPkScript) {
-		return fmt.Errorf("slashing transaction must pay to the provided slashing address")
+		if !bytes.Equal(out.PkScript, slashingDestinations[i].PkScript) {
+			return fmt.Errorf("slashing transaction must pay to the provided slashing address")
+		}
 	}
 
 	// Verify that the second output pays to the taproot address which locks funds for
@@ -357,8 +401,9 @@ func validateSlashingTx(
 		return fmt.Errorf("error creating change timelock script: %w", err)
 	}
 
-	if !bytes.Equal(slashingTx.TxOut[1].PkScript, si.PkScript) {
-		return fmt.Errorf("invalid slashing tx change output pkscript, expected: %s, got: %s", hex.EncodeToString(si.PkScript), hex.EncodeToString(slashingTx.TxOut[1].PkScript))
+	changeOutput := slashingTx.TxOut[numSlashingOutputs]
+	if !bytes.Equal(changeOutput.PkScript, si.PkScript) {
+		return fmt.Errorf("invalid slashing tx change output pkscript, expected: %s, got: %s", hex.EncodeToString(si.PkScript), hex.EncodeToString(changeOutput.PkScript))
 	}
 
 	// Verify that the none of the outputs is a dust output.
@@ -372,9 +417,14 @@ func validateSlashingTx(
 		Check Fees
 	*/
 	// Check that values of slashing and staking transaction are larger than 0
-	if slashingTx.TxOut[0].Value <= 0 || stakingOutputValue <= 0 {
+	if stakingOutputValue <= 0 {
 		return fmt.Errorf("values of slashing and staking transaction must be larger than 0")
 	}
+	for _, out := range slashingTx.TxOut[:numSlashingOutputs] {
+		if out.Value <= 0 {
+			return fmt.Errorf("values of slashing and staking transaction must be larger than 0")
+		}
+	}
 
 	// Calculate the sum of output values in the slashing transaction.
 	slashingTxOutSum := int64(0)
@@ -411,6 +461,33 @@ func CheckSlashingTxMatchFundingTx(
 	stakerPk *btcec.PublicKey,
 	slashingChangeLockTime uint16,
 	net *chaincfg.Params,
+) error {
+	return CheckSlashingTxMatchFundingTxWithDestinations(
+		slashingTx,
+		fundingTransaction,
+		fundingOutputIdx,
+		slashingTxMinFee,
+		slashingRate,
+		NewSingleSlashingDestination(slashingPkScript),
+		stakerPk,
+		slashingChangeLockTime,
+		net,
+	)
+}
+
+// CheckSlashingTxMatchFundingTxWithDestinations is the same as CheckSlashingTxMatchFundingTx,
+// except that the slashing transaction must split the slashed funds among the given slashing
+// destinations according to their weights.
+func CheckSlashingTxMatchFundingTxWithDestinations(
+	slashingTx *wire.MsgTx,
+	fundingTransaction *wire.MsgTx,
+	fundingOutputIdx uint32,
+	slashingTxMinFee int64,
+	slashingRate sdkmath.LegacyDec,
+	slashingDestinations []SlashingDestination,
+	stakerPk *btcec.PublicKey,
+	slashingChangeLockTime uint16,
+	net *chaincfg.Params,
 ) error {
 	if slashingTx == nil || fundingTransaction == nil {
 		return fmt.Errorf("slashing and funding transactions must not be nil")
@@ -438,7 +515,7 @@ func CheckSlashingTxMatchFundingTx(
 	// 3. Check if slashing transaction is valid
 	if err := validateSlashingTx(
 		slashingTx,
-		slashingPkScript,
+		slashingDestinations,
 		slashingRate,
 		slashingTxMinFee,
 		stakingOutput.Value,
@@ -636,6 +713,28 @@ func checkTxBeforeSigning(txToSign *wire.MsgTx, fundingTx *wire.MsgTx, fundingOu
 	return nil
 }
 
+// CalcTapscriptSigHash calculates the sighash (SigHashDefault) that a signer
+// spending the given funding output through the given script path signs over
+// the given transaction. It expects the transaction to have exactly one input.
+func CalcTapscriptSigHash(
+	transaction *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	script []byte,
+) ([]byte, error) {
+	tapLeaf := txscript.NewBaseTapLeaf(script)
+
+	inputFetcher := txscript.NewCannedPrevOutputFetcher(
+		fundingOutput.PkScript,
+		fundingOutput.Value,
+	)
+
+	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
+
+	return txscript.CalcTapscriptSignaturehash(
+		sigHashes, txscript.SigHashDefault, transaction, 0, inputFetcher, tapLeaf,
+	)
+}
+
 // VerifyTransactionSigWithOutput verifies that:
 // - provided transaction has exactly one input
 // - provided signature is valid schnorr BIP340 signature
@@ -663,19 +762,7 @@ func VerifyTransactionSigWithOutput(
 		return fmt.Errorf("public key must not be nil")
 	}
 
-	tapLeaf := txscript.NewBaseTapLeaf(script)
-
-	inputFetcher := txscript.NewCannedPrevOutputFetcher(
-		fundingOutput.PkScript,
-		fundingOutput.Value,
-	)
-
-	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
-
-	sigHash, err := txscript.CalcTapscriptSignaturehash(
-		sigHashes, txscript.SigHashDefault, transaction, 0, inputFetcher, tapLeaf,
-	)
-
+	sigHash, err := CalcTapscriptSigHash(transaction, fundingOutput, script)
 	if err != nil {
 		return err
 	}
@@ -719,19 +806,7 @@ func EncVerifyTransactionSigWithOutput(
 		return fmt.Errorf("public key must not be nil")
 	}
 
-	tapLeaf := txscript.NewBaseTapLeaf(script)
-
-	inputFetcher := txscript.NewCannedPrevOutputFetcher(
-		fundingOut.PkScript,
-		fundingOut.Value,
-	)
-
-	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
-
-	sigHash, err := txscript.CalcTapscriptSignaturehash(
-		sigHashes, txscript.SigHashDefault, transaction, 0, inputFetcher, tapLeaf,
-	)
-
+	sigHash, err := CalcTapscriptSigHash(transaction, fundingOut, script)
 	if err != nil {
 		return err
 	}
@@ -318,6 +318,136 @@ func TestSlashingTxWithOverflowMustNotAccepted(t *testing.T) {
 	require.EqualError(t, err, "invalid slashing tx: btc transaction do not obey BTC rules: transaction output value is higher than max allowed value: 1152921504606846975 > 2.1e+15 ")
 }
 
+func TestSlashingTxWithMultipleDestinations(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	stakingTx := wire.NewMsgTx(2)
+	slashingLockTime := uint16(100)
+	stakingValue := int64(r.Intn(100000) + 100000)
+	minFee := int64(2000)
+	slashingRate := sdkmath.LegacyNewDecWithPrec(1000, 4)
+	sd := genValidStakingScriptData(t, r)
+
+	info, err := btcstaking.BuildStakingInfo(
+		sd.StakerKey,
+		[]*btcec.PublicKey{sd.FinalityProviderKey},
+		[]*btcec.PublicKey{sd.CovenantKey},
+		1,
+		sd.StakingTime,
+		btcutil.Amount(stakingValue),
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	stakingTx.AddTxOut(info.StakingOutput)
+	bogusInputHashBytes := [32]byte{}
+	bogusInputHash, _ := chainhash.NewHash(bogusInputHashBytes[:])
+	stakingTx.AddTxIn(
+		wire.NewTxIn(wire.NewOutPoint(bogusInputHash, 0), nil, nil),
+	)
+
+	genPkScript := func() []byte {
+		addr, err := genRandomBTCAddress(r)
+		require.NoError(t, err)
+		pkScript, err := txscript.PayToAddrScript(addr)
+		require.NoError(t, err)
+		return pkScript
+	}
+	destinations := []btcstaking.SlashingDestination{
+		{PkScript: genPkScript(), Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{PkScript: genPkScript(), Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+	}
+
+	slashingTx, err := btcstaking.BuildSlashingTxFromStakingTxStrictWithDestinations(
+		stakingTx,
+		uint32(0),
+		destinations,
+		sd.StakerKey,
+		slashingLockTime,
+		minFee,
+		slashingRate,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	// one output per destination followed by the change output
+	require.Len(t, slashingTx.TxOut, len(destinations)+1)
+
+	slashingRateFloat64, err := slashingRate.Float64()
+	require.NoError(t, err)
+	slashingAmount := btcutil.Amount(stakingValue).MulF64(slashingRateFloat64)
+	var slashedSum int64
+	for i, d := range destinations {
+		require.Equal(t, d.PkScript, slashingTx.TxOut[i].PkScript)
+		slashedSum += slashingTx.TxOut[i].Value
+	}
+	require.Equal(t, int64(slashingAmount), slashedSum)
+	require.Greater(t, slashingTx.TxOut[0].Value, slashingTx.TxOut[1].Value)
+
+	err = btcstaking.CheckSlashingTxMatchFundingTxWithDestinations(
+		slashingTx,
+		stakingTx,
+		uint32(0),
+		minFee,
+		slashingRate,
+		destinations,
+		sd.StakerKey,
+		slashingLockTime,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	// the slashing tx must not be accepted if it only pays to a single destination
+	err = btcstaking.CheckSlashingTxMatchFundingTx(
+		slashingTx,
+		stakingTx,
+		uint32(0),
+		minFee,
+		slashingRate,
+		destinations[0].PkScript,
+		sd.StakerKey,
+		slashingLockTime,
+		&chaincfg.MainNetParams,
+	)
+	require.Error(t, err)
+
+	// the slashing tx must not be accepted if destinations are swapped
+	swapped := []btcstaking.SlashingDestination{destinations[1], destinations[0]}
+	err = btcstaking.CheckSlashingTxMatchFundingTxWithDestinations(
+		slashingTx,
+		stakingTx,
+		uint32(0),
+		minFee,
+		slashingRate,
+		swapped,
+		sd.StakerKey,
+		slashingLockTime,
+		&chaincfg.MainNetParams,
+	)
+	require.Error(t, err)
+
+	// weights not summing up to 1 are rejected
+	invalid := []btcstaking.SlashingDestination{
+		{PkScript: destinations[0].PkScript, Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{PkScript: destinations[1].PkScript, Weight: sdkmath.LegacyNewDecWithPrec(2, 1)},
+	}
+	err = btcstaking.ValidateSlashingDestinations(invalid)
+	require.ErrorIs(t, err, btcstaking.ErrInvalidSlashingDestination)
+
+	// pk scripts not paying to a standard address are rejected
+	invalid = []btcstaking.SlashingDestination{
+		{PkScript: destinations[0].PkScript, Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{PkScript: datagen.GenRandomByteArray(r, 32), Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+	}
+	err = btcstaking.ValidateSlashingDestinations(invalid)
+	require.ErrorIs(t, err, btcstaking.ErrInvalidSlashingDestination)
+
+	// duplicated pk scripts are rejected
+	invalid = []btcstaking.SlashingDestination{
+		{PkScript: destinations[0].PkScript, Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{PkScript: destinations[0].PkScript, Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+	}
+	err = btcstaking.ValidateSlashingDestinations(invalid)
+	require.ErrorIs(t, err, btcstaking.ErrInvalidSlashingDestination)
+}
+
 func TestNotAllowStakerKeyToBeFinalityProviderKey(t *testing.T) {
 	r := rand.New(rand.NewSource(0))
 	sd := genValidStakingScriptData(t, r)
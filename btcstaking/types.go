@@ -548,6 +548,73 @@ func IsRateValid(rate sdkmath.LegacyDec) bool {
 	return multipliedRate.Equal(truncatedRate)
 }
 
+// SlashingDestination is a slashing output of a slashing transaction, which
+// receives the given weight of the slashed amount
+type SlashingDestination struct {
+	PkScript []byte
+	Weight   sdkmath.LegacyDec
+}
+
+// NewSingleSlashingDestination returns the slashing destinations that send the
+// whole slashed amount to the given pk script
+func NewSingleSlashingDestination(slashingPkScript []byte) []SlashingDestination {
+	return []SlashingDestination{
+		{PkScript: slashingPkScript, Weight: sdkmath.LegacyOneDec()},
+	}
+}
+
+// ValidateSlashingDestinations checks that there is at least one slashing
+// destination, every destination has a distinct pk script paying to a
+// standard address and a positive weight, and the weights sum up to 1
+func ValidateSlashingDestinations(destinations []SlashingDestination) error {
+	if len(destinations) == 0 {
+		return fmt.Errorf("%w: there must be at least one slashing destination", ErrInvalidSlashingDestination)
+	}
+
+	totalWeight := sdkmath.LegacyZeroDec()
+	seen := make(map[string]struct{}, len(destinations))
+	for i, d := range destinations {
+		if len(d.PkScript) == 0 {
+			return fmt.Errorf("%w: pk script of destination %d must not be empty", ErrInvalidSlashingDestination, i)
+		}
+		if _, err := txscript.ParsePkScript(d.PkScript); err != nil {
+			return fmt.Errorf("%w: pk script of destination %d is invalid: %v", ErrInvalidSlashingDestination, i, err)
+		}
+		if _, ok := seen[string(d.PkScript)]; ok {
+			return fmt.Errorf("%w: pk script of destination %d is duplicated", ErrInvalidSlashingDestination, i)
+		}
+		seen[string(d.PkScript)] = struct{}{}
+		if d.Weight.IsNil() || !d.Weight.IsPositive() {
+			return fmt.Errorf("%w: weight of destination %d must be positive", ErrInvalidSlashingDestination, i)
+		}
+		totalWeight = totalWeight.Add(d.Weight)
+	}
+
+	if !totalWeight.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("%w: weights must sum up to 1, got %s", ErrInvalidSlashingDestination, totalWeight)
+	}
+
+	return nil
+}
+
+// splitSlashingAmount splits the slashed amount among the slashing destinations
+// in proportion to their weights. Amounts are truncated and the last
+// destination receives the remainder, so that the amounts sum up to the
+// slashed amount
+func splitSlashingAmount(slashingAmount btcutil.Amount, destinations []SlashingDestination) []btcutil.Amount {
+	amounts := make([]btcutil.Amount, len(destinations))
+	remaining := slashingAmount
+	for i, d := range destinations {
+		if i == len(destinations)-1 {
+			amounts[i] = remaining
+			break
+		}
+		amounts[i] = btcutil.Amount(sdkmath.LegacyNewDec(int64(slashingAmount)).Mul(d.Weight).TruncateInt64())
+		remaining -= amounts[i]
+	}
+	return amounts
+}
+
 type RelativeTimeLockTapScriptInfo struct {
 	// data necessary to build witness for given script
 	SpendInfo *SpendInfo
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/wire"
 )
 
@@ -134,3 +136,65 @@ func CreateWitness(si *SpendInfo, signatures [][]byte) (wire.TxWitness, error) {
 
 	return witnessStack, nil
 }
+
+// EstimateSlashingPathTxVSize estimates the virtual size of the given slashing
+// tx once its input is fully witnessed through the slashing path of the given
+// spend info, i.e., with a quorum number of covenant signatures out of
+// numCovenants, a signature of one of the numFps finality providers, and the
+// staker signature. BIP340 signatures have a fixed size, so the estimation
+// is exact for a slashing tx with the default sighash type.
+func EstimateSlashingPathTxVSize(
+	slashingTx *wire.MsgTx,
+	si *SpendInfo,
+	numCovenants int,
+	covenantQuorum uint32,
+	numFps int,
+) (int64, error) {
+	if slashingTx == nil || len(slashingTx.TxIn) != 1 {
+		return 0, fmt.Errorf("slashing tx must have exactly one input")
+	}
+	if si == nil {
+		return 0, fmt.Errorf("cannot estimate vsize without spend info")
+	}
+	if covenantQuorum == 0 || int(covenantQuorum) > numCovenants {
+		return 0, fmt.Errorf("invalid covenant quorum %d out of %d covenants", covenantQuorum, numCovenants)
+	}
+	if numFps == 0 {
+		return 0, fmt.Errorf("finality provider signatures should not be empty")
+	}
+
+	dummySig := make([]byte, schnorr.SignatureSize)
+
+	var witnessStack [][]byte
+	for i := 0; i < numCovenants; i++ {
+		if i < int(covenantQuorum) {
+			witnessStack = append(witnessStack, dummySig)
+		} else {
+			witnessStack = append(witnessStack, []byte{})
+		}
+	}
+	for i := 0; i < numFps; i++ {
+		if i == 0 {
+			witnessStack = append(witnessStack, dummySig)
+		} else {
+			witnessStack = append(witnessStack, []byte{})
+		}
+	}
+	witnessStack = append(witnessStack, dummySig)
+
+	witness, err := CreateWitness(si, witnessStack)
+	if err != nil {
+		return 0, err
+	}
+
+	witnessedTx := slashingTx.Copy()
+	witnessedTx.TxIn[0].Witness = witness
+
+	return mempool.GetTxVirtualSize(btcutil.NewTx(witnessedTx)), nil
+}
+
+// MinRelayFeeForVSize returns the minimum fee for a tx of the given virtual
+// size to be relayed by BTC nodes under the default min relay fee rate
+func MinRelayFeeForVSize(vsize int64) int64 {
+	return vsize * int64(mempool.DefaultMinRelayTxFee) / 1000
+}
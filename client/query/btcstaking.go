@@ -2,8 +2,11 @@ package query
 
 import (
 	"context"
+	"fmt"
 
 	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdkquerytypes "github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -45,6 +48,37 @@ func (c *QueryClient) BTCStakingParamsByVersion(version uint32) (*btcstakingtype
 	return resp, err
 }
 
+// BTCStakingCurrentParams queries the latest BTC staking module parameters
+// together with their version, activation height and authority
+func (c *QueryClient) BTCStakingCurrentParams() (*btcstakingtypes.QueryCurrentParamsResponse, error) {
+	var resp *btcstakingtypes.QueryCurrentParamsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCurrentParamsRequest{}
+		resp, err = queryClient.CurrentParams(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCStakingUnbondingParams queries the exact unbonding fee and the minimum
+// unbonding time expected from unbonding txs. If stakingValueSat is positive,
+// the expected value of the unbonding output is returned as well
+func (c *QueryClient) BTCStakingUnbondingParams(stakingValueSat int64) (*btcstakingtypes.QueryUnbondingParamsResponse, error) {
+	var resp *btcstakingtypes.QueryUnbondingParamsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryUnbondingParamsRequest{
+			StakingValueSat: stakingValueSat,
+		}
+		resp, err = queryClient.UnbondingParams(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
 // FinalityProvider queries the BTCStaking module for a given finlaity provider
 func (c *QueryClient) FinalityProvider(fpBtcPkHex string) (*btcstakingtypes.QueryFinalityProviderResponse, error) {
 	var resp *btcstakingtypes.QueryFinalityProviderResponse
@@ -121,3 +155,772 @@ func (c *QueryClient) BTCDelegation(stakingTxHashHex string) (*btcstakingtypes.Q
 
 	return resp, err
 }
+
+// VerifyCovenantSlashingSig queries the BTCStaking module to verify the adaptor signatures
+// of a covenant member on the slashing tx of a BTC delegation
+func (c *QueryClient) VerifyCovenantSlashingSig(stakingTxHashHex string, covenantPkHex string, slashingTxSigs [][]byte) (*btcstakingtypes.QueryVerifyCovenantSlashingSigResponse, error) {
+	var resp *btcstakingtypes.QueryVerifyCovenantSlashingSigResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryVerifyCovenantSlashingSigRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			CovenantPkHex:    covenantPkHex,
+			SlashingTxSigs:   slashingTxSigs,
+		}
+		resp, err = queryClient.VerifyCovenantSlashingSig(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationSignatureReadiness queries the BTCStaking module for whether a BTC
+// delegation has a covenant quorum of adaptor signatures for each finality provider
+func (c *QueryClient) BTCDelegationSignatureReadiness(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationSignatureReadinessResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationSignatureReadinessResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationSignatureReadinessRequest{StakingTxHashHex: stakingTxHashHex}
+		resp, err = queryClient.BTCDelegationSignatureReadiness(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// NextPowerDistUpdateHeight queries the BTCStaking module for the next BTC height
+// at which a power distribution update is scheduled
+func (c *QueryClient) NextPowerDistUpdateHeight() (*btcstakingtypes.QueryNextPowerDistUpdateHeightResponse, error) {
+	var resp *btcstakingtypes.QueryNextPowerDistUpdateHeightResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryNextPowerDistUpdateHeightRequest{}
+		resp, err = queryClient.NextPowerDistUpdateHeight(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CommissionRateBounds queries the BTCStaking module for the minimum and maximum
+// commission rates of finality providers
+func (c *QueryClient) CommissionRateBounds() (*btcstakingtypes.QueryCommissionRateBoundsResponse, error) {
+	var resp *btcstakingtypes.QueryCommissionRateBoundsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCommissionRateBoundsRequest{}
+		resp, err = queryClient.CommissionRateBounds(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderCommissionAtDelegation queries the BTCStaking module for the
+// commission rate of each finality provider of a BTC delegation at the time the
+// BTC delegation was created
+func (c *QueryClient) FinalityProviderCommissionAtDelegation(stakingTxHashHex string) (*btcstakingtypes.QueryFinalityProviderCommissionAtDelegationResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProviderCommissionAtDelegationResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProviderCommissionAtDelegationRequest{StakingTxHashHex: stakingTxHashHex}
+		resp, err = queryClient.FinalityProviderCommissionAtDelegation(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationCovenantCoverage queries the BTCStaking module for how much of the
+// required covenant quorum has signed a BTC delegation
+func (c *QueryClient) BTCDelegationCovenantCoverage(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationCovenantCoverageResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationCovenantCoverageResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationCovenantCoverageRequest{StakingTxHashHex: stakingTxHashHex}
+		resp, err = queryClient.BTCDelegationCovenantCoverage(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationsByValueRange queries the BTCStaking module for all BTC delegations
+// whose staking value is within the given range, in ascending order of staking value
+func (c *QueryClient) BTCDelegationsByValueRange(minSat, maxSat uint64, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryBTCDelegationsByValueRangeResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationsByValueRangeResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationsByValueRangeRequest{
+			MinSat:     minSat,
+			MaxSat:     maxSat,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.BTCDelegationsByValueRange(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CovenantInfo queries the BTCStaking module for the covenant committee and its
+// quorum under the latest params
+func (c *QueryClient) CovenantInfo() (*btcstakingtypes.QueryCovenantInfoResponse, error) {
+	var resp *btcstakingtypes.QueryCovenantInfoResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCovenantInfoRequest{}
+		resp, err = queryClient.CovenantInfo(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// VerifyInclusionProofAt queries the BTCStaking module to verify the inclusion
+// proof of a staking tx as of the BTC tip at the given height
+func (c *QueryClient) VerifyInclusionProofAt(
+	stakingTxHex string,
+	stakingTime uint32,
+	inclusionProof *btcstakingtypes.InclusionProof,
+	btcHeight uint32,
+) (*btcstakingtypes.QueryVerifyInclusionProofAtResponse, error) {
+	var resp *btcstakingtypes.QueryVerifyInclusionProofAtResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryVerifyInclusionProofAtRequest{
+			StakingTxHex:            stakingTxHex,
+			StakingTime:             stakingTime,
+			StakingTxInclusionProof: inclusionProof,
+			BtcHeight:               btcHeight,
+		}
+		resp, err = queryClient.VerifyInclusionProofAt(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationFinalityProviderStatuses queries the BTCStaking module for the
+// status of each finality provider of a BTC delegation
+func (c *QueryClient) BTCDelegationFinalityProviderStatuses(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationFinalityProviderStatusesResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationFinalityProviderStatusesResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationFinalityProviderStatusesRequest{StakingTxHashHex: stakingTxHashHex}
+		resp, err = queryClient.BTCDelegationFinalityProviderStatuses(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCNetwork queries the BTCStaking module for the BTC network the chain is
+// configured for
+func (c *QueryClient) BTCNetwork() (*btcstakingtypes.QueryBTCNetworkResponse, error) {
+	var resp *btcstakingtypes.QueryBTCNetworkResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCNetworkRequest{}
+		resp, err = queryClient.BTCNetwork(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderDelegationCount queries the BTCStaking module for the number
+// of BTC delegations of a finality provider under the given status
+func (c *QueryClient) FinalityProviderDelegationCount(fpBtcPkHex string, status btcstakingtypes.BTCDelegationStatus) (*btcstakingtypes.QueryFinalityProviderDelegationCountResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProviderDelegationCountResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProviderDelegationCountRequest{
+			FpBtcPkHex: fpBtcPkHex,
+			Status:     status,
+		}
+		resp, err = queryClient.FinalityProviderDelegationCount(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// DelegationCountByParamsVersion queries the BTCStaking module for the number
+// of BTC delegations that are not unbonded yet under each params version
+func (c *QueryClient) DelegationCountByParamsVersion() (*btcstakingtypes.QueryDelegationCountByParamsVersionResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationCountByParamsVersionResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationCountByParamsVersionRequest{}
+		resp, err = queryClient.DelegationCountByParamsVersion(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationCovenantSigs queries the BTCStaking module for the covenant adaptor
+// signatures on the slashing tx of the given BTC delegation
+func (c *QueryClient) BTCDelegationCovenantSigs(stakingTxHashHex string, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryBTCDelegationCovenantSigsResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationCovenantSigsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationCovenantSigsRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Pagination:       pagination,
+		}
+		resp, err = queryClient.BTCDelegationCovenantSigs(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// DelegationsExpiringWithin queries the BTCStaking module for all active BTC
+// delegations whose end height is within nBlocks of the BTC tip
+func (c *QueryClient) DelegationsExpiringWithin(nBlocks uint32, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryDelegationsExpiringWithinResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationsExpiringWithinResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationsExpiringWithinRequest{
+			NBlocks:    nBlocks,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.DelegationsExpiringWithin(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// IsStakingTxRegistered queries the BTCStaking module for whether a staking tx
+// is already registered as a BTC delegation
+func (c *QueryClient) IsStakingTxRegistered(stakingTxHashHex string) (*btcstakingtypes.QueryIsStakingTxRegisteredResponse, error) {
+	var resp *btcstakingtypes.QueryIsStakingTxRegisteredResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryIsStakingTxRegisteredRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.IsStakingTxRegistered(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationUnbondingStatus queries the BTCStaking module for the unbonding
+// status of a BTC delegation
+func (c *QueryClient) BTCDelegationUnbondingStatus(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationUnbondingStatusResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationUnbondingStatusResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationUnbondingStatusRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.BTCDelegationUnbondingStatus(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// SelectiveSlashingEvidenceList queries the BTCStaking module for the
+// submitted selective slashing evidences, latest first
+func (c *QueryClient) SelectiveSlashingEvidenceList(pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QuerySelectiveSlashingEvidenceListResponse, error) {
+	var resp *btcstakingtypes.QuerySelectiveSlashingEvidenceListResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QuerySelectiveSlashingEvidenceListRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.SelectiveSlashingEvidenceList(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// DelegationsByFpSet queries the BTCStaking module for all BTC delegations
+// restaking to exactly the given set of finality providers
+func (c *QueryClient) DelegationsByFpSet(fpBtcPkHexList []string, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryDelegationsByFpSetResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationsByFpSetResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationsByFpSetRequest{
+			FpBtcPkHexList: fpBtcPkHexList,
+			Pagination:     pagination,
+		}
+		resp, err = queryClient.DelegationsByFpSet(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationTransactions queries the BTCStaking module for the raw
+// transactions of the BTC delegation with the given staking tx hash
+func (c *QueryClient) BTCDelegationTransactions(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationTransactionsResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationTransactionsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationTransactionsRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.BTCDelegationTransactions(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationScriptPaths queries the BTCStaking module for the taproot
+// script paths of the staking output of the BTC delegation with the given
+// staking tx hash
+func (c *QueryClient) BTCDelegationScriptPaths(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationScriptPathsResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationScriptPathsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationScriptPathsRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.BTCDelegationScriptPaths(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationsByFlags queries the BTCStaking module for BTC delegations
+// matching the given filters on whether they have an inclusion proof, a
+// covenant quorum and the delegator's unbonding signature
+func (c *QueryClient) BTCDelegationsByFlags(
+	hasProof, hasQuorum, hasUnbondingSig btcstakingtypes.FlagFilter,
+	pagination *sdkquerytypes.PageRequest,
+) (*btcstakingtypes.QueryBTCDelegationsByFlagsResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationsByFlagsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationsByFlagsRequest{
+			HasProof:        hasProof,
+			HasQuorum:       hasQuorum,
+			HasUnbondingSig: hasUnbondingSig,
+			Pagination:      pagination,
+		}
+		resp, err = queryClient.BTCDelegationsByFlags(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FindStakingOutputIndex queries the BTCStaking module for the index of the
+// staking output Babylon would select in the given staking tx under the given
+// params version
+func (c *QueryClient) FindStakingOutputIndex(
+	stakingTxHex string,
+	paramsVersion uint32,
+	stakerBtcPkHex string,
+	fpBtcPkHexList []string,
+	stakingTime uint32,
+	stakingValue int64,
+) (*btcstakingtypes.QueryFindStakingOutputIndexResponse, error) {
+	var resp *btcstakingtypes.QueryFindStakingOutputIndexResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFindStakingOutputIndexRequest{
+			StakingTxHex:   stakingTxHex,
+			ParamsVersion:  paramsVersion,
+			StakerBtcPkHex: stakerBtcPkHex,
+			FpBtcPkHexList: fpBtcPkHexList,
+			StakingTime:    stakingTime,
+			StakingValue:   stakingValue,
+		}
+		resp, err = queryClient.FindStakingOutputIndex(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CovenantSigningHashes queries the BTCStaking module for the sighashes a
+// covenant member signs for the BTC delegation with the given staking tx hash
+func (c *QueryClient) CovenantSigningHashes(stakingTxHashHex string) (*btcstakingtypes.QueryCovenantSigningHashesResponse, error) {
+	var resp *btcstakingtypes.QueryCovenantSigningHashesResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCovenantSigningHashesRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.CovenantSigningHashes(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// AllBTCDelegations queries the BTCStaking module for all BTC delegations in
+// the order of their staking tx hashes, optionally under the given statuses
+func (c *QueryClient) AllBTCDelegations(
+	statusFilter []btcstakingtypes.BTCDelegationStatus,
+	pagination *sdkquerytypes.PageRequest,
+) (*btcstakingtypes.QueryAllBTCDelegationsResponse, error) {
+	var resp *btcstakingtypes.QueryAllBTCDelegationsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryAllBTCDelegationsRequest{
+			StatusFilter: statusFilter,
+			Pagination:   pagination,
+		}
+		resp, err = queryClient.AllBTCDelegations(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CovenantCommitteeChanges queries the BTCStaking module for the covenant
+// committee at genesis against the one under the latest params
+func (c *QueryClient) CovenantCommitteeChanges() (*btcstakingtypes.QueryCovenantCommitteeChangesResponse, error) {
+	var resp *btcstakingtypes.QueryCovenantCommitteeChangesResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCovenantCommitteeChangesRequest{}
+		resp, err = queryClient.CovenantCommitteeChanges(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderRewardGauge queries the BTCStaking module for the reward
+// gauge of the finality provider with the given BTC PK
+func (c *QueryClient) FinalityProviderRewardGauge(fpBtcPkHex string) (*btcstakingtypes.QueryFinalityProviderRewardGaugeResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProviderRewardGaugeResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProviderRewardGaugeRequest{
+			FpBtcPkHex: fpBtcPkHex,
+		}
+		resp, err = queryClient.FinalityProviderRewardGauge(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// DelegationActivationRate queries the BTCStaking module for the number of
+// BTC delegations activated in each bucket of BTC heights within the given range
+func (c *QueryClient) DelegationActivationRate(fromBtcHeight, toBtcHeight, bucketSize uint32) (*btcstakingtypes.QueryDelegationActivationRateResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationActivationRateResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationActivationRateRequest{
+			FromBtcHeight: fromBtcHeight,
+			ToBtcHeight:   toBtcHeight,
+			BucketSize:    bucketSize,
+		}
+		resp, err = queryClient.DelegationActivationRate(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// StakerDelegationAttestation queries the BTCStaking module for all BTC
+// delegations of the given staker together with a reproducible digest of the set
+func (c *QueryClient) StakerDelegationAttestation(stakerAddr string) (*btcstakingtypes.QueryStakerDelegationAttestationResponse, error) {
+	var resp *btcstakingtypes.QueryStakerDelegationAttestationResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryStakerDelegationAttestationRequest{
+			StakerAddr: stakerAddr,
+		}
+		resp, err = queryClient.StakerDelegationAttestation(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderRewardBreakdown queries the BTCStaking module for the
+// rewards of the finality provider with the given BTC PK split into its
+// commission and the rewards of its self-delegations
+func (c *QueryClient) FinalityProviderRewardBreakdown(fpBtcPkHex string) (*btcstakingtypes.QueryFinalityProviderRewardBreakdownResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProviderRewardBreakdownResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProviderRewardBreakdownRequest{
+			FpBtcPkHex: fpBtcPkHex,
+		}
+		resp, err = queryClient.FinalityProviderRewardBreakdown(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CanReachCovenantQuorum queries the BTCStaking module for whether the BTC
+// delegation with the given staking tx hash can still reach its covenant quorum
+func (c *QueryClient) CanReachCovenantQuorum(stakingTxHashHex string) (*btcstakingtypes.QueryCanReachCovenantQuorumResponse, error) {
+	var resp *btcstakingtypes.QueryCanReachCovenantQuorumResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCanReachCovenantQuorumRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.CanReachCovenantQuorum(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCTip queries the BTCStaking module for the tip of the BTC light client
+// that it uses for computing the status of BTC delegations
+func (c *QueryClient) BTCTip() (*btcstakingtypes.QueryBTCTipResponse, error) {
+	var resp *btcstakingtypes.QueryBTCTipResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCTipRequest{}
+		resp, err = queryClient.BTCTip(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// DelegationsWithUnbondingScheduleIssues queries the BTCStaking module for the
+// BTC delegations whose BTC height of unbonding underflows
+func (c *QueryClient) DelegationsWithUnbondingScheduleIssues(pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryDelegationsWithUnbondingScheduleIssuesResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationsWithUnbondingScheduleIssuesResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationsWithUnbondingScheduleIssuesRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.DelegationsWithUnbondingScheduleIssues(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderStats queries the BTCStaking module for the number of
+// finality providers in total and under each status
+func (c *QueryClient) FinalityProviderStats() (*btcstakingtypes.QueryFinalityProviderStatsResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProviderStatsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProviderStatsRequest{}
+		resp, err = queryClient.FinalityProviderStats(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationCovenantUnbondingSigs queries the BTCStaking module for the
+// covenant signatures on the unbonding tx of the given BTC delegation
+func (c *QueryClient) BTCDelegationCovenantUnbondingSigs(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationCovenantUnbondingSigsResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationCovenantUnbondingSigsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationCovenantUnbondingSigsRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.BTCDelegationCovenantUnbondingSigs(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationSlashingRate queries the BTCStaking module for the slashing
+// rate and slashing destinations under the params version of the given BTC
+// delegation
+func (c *QueryClient) BTCDelegationSlashingRate(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationSlashingRateResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationSlashingRateResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationSlashingRateRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		}
+		resp, err = queryClient.BTCDelegationSlashingRate(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// DelegationsAffectedBySlashing queries the BTCStaking module for the BTC
+// delegations that became active under the given slashed finality provider
+func (c *QueryClient) DelegationsAffectedBySlashing(fpBtcPkHex string, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryDelegationsAffectedBySlashingResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationsAffectedBySlashingResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationsAffectedBySlashingRequest{
+			FpBtcPkHex: fpBtcPkHex,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.DelegationsAffectedBySlashing(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// RefundableBTCDelegations queries the BTCStaking module for the staking tx
+// hashes of the BTC delegations of the given staker whose refund can be
+// claimed in a later BTC delegation creation
+func (c *QueryClient) RefundableBTCDelegations(stakerAddr string, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryRefundableBTCDelegationsResponse, error) {
+	var resp *btcstakingtypes.QueryRefundableBTCDelegationsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryRefundableBTCDelegationsRequest{
+			StakerAddr: stakerAddr,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.RefundableBTCDelegations(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationStateUpdates is the list of state updates of BTC delegations
+// that happened in a Babylon block
+type BTCDelegationStateUpdates struct {
+	// Height is the height of the Babylon block
+	Height int64
+	// Updates are the state updates in the order of the transactions in the
+	// block, followed by those that happened outside transactions
+	Updates []*btcstakingtypes.EventBTCDelegationStateUpdate
+	// Err is set if the state updates of the block cannot be retrieved. The
+	// watch terminates after delivering it
+	Err error
+}
+
+// WatchBTCDelegationStateUpdates subscribes to new blocks of the Babylon node
+// and streams the state updates of BTC delegations in each block that has any.
+// If fpBtcPkHex is not empty, only the state updates of BTC delegations
+// restaked to the given finality provider are streamed. The returned channel
+// is closed once ctx is done or the subscription is terminated by the node.
+//
+// NOTE: the Query service of Babylon only supports unary RPCs, so the state
+// updates are derived from the typed events of each block delivered by the
+// CometBFT event subscription.
+func (c *QueryClient) WatchBTCDelegationStateUpdates(
+	ctx context.Context,
+	subscriber string,
+	fpBtcPkHex string,
+) (<-chan *BTCDelegationStateUpdates, error) {
+	query := cmttypes.EventQueryNewBlock.String()
+	eventCh, err := c.RPCClient.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *BTCDelegationStateUpdates)
+	go func() {
+		defer close(out)
+		defer func() {
+			_ = c.RPCClient.Unsubscribe(context.Background(), subscriber, query)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+				newBlock, ok := event.Data.(cmttypes.EventDataNewBlock)
+				if !ok {
+					continue
+				}
+
+				updates := c.btcDelegationStateUpdates(newBlock, fpBtcPkHex)
+				if updates.Err == nil && len(updates.Updates) == 0 {
+					continue
+				}
+				select {
+				case out <- updates:
+				case <-ctx.Done():
+					return
+				}
+				if updates.Err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// btcDelegationStateUpdates extracts the state updates of BTC delegations from
+// the events of the given block, keeping only those of BTC delegations restaked
+// to the given finality provider if fpBtcPkHex is not empty
+func (c *QueryClient) btcDelegationStateUpdates(
+	newBlock cmttypes.EventDataNewBlock,
+	fpBtcPkHex string,
+) *BTCDelegationStateUpdates {
+	result := &BTCDelegationStateUpdates{
+		Height:  newBlock.Block.Height,
+		Updates: []*btcstakingtypes.EventBTCDelegationStateUpdate{},
+	}
+
+	events := []abci.Event{}
+	for _, txResult := range newBlock.ResultFinalizeBlock.TxResults {
+		if txResult.IsOK() {
+			events = append(events, txResult.Events...)
+		}
+	}
+	events = append(events, newBlock.ResultFinalizeBlock.Events...)
+
+	for _, event := range events {
+		update, ok, err := btcstakingtypes.ParseBTCDelegationStateUpdate(event)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		if !ok {
+			continue
+		}
+
+		if fpBtcPkHex != "" {
+			restaked, err := c.isRestakedTo(update.StakingTxHash, fpBtcPkHex)
+			if err != nil {
+				result.Err = fmt.Errorf("failed to get BTC delegation %s: %w", update.StakingTxHash, err)
+				return result
+			}
+			if !restaked {
+				continue
+			}
+		}
+
+		result.Updates = append(result.Updates, update)
+	}
+
+	return result
+}
+
+// isRestakedTo returns whether the BTC delegation with the given staking tx
+// hash is restaked to the given finality provider
+func (c *QueryClient) isRestakedTo(stakingTxHashHex string, fpBtcPkHex string) (bool, error) {
+	resp, err := c.BTCDelegation(stakingTxHashHex)
+	if err != nil {
+		return false, err
+	}
+
+	for _, fpBTCPK := range resp.BtcDelegation.FpBtcPkList {
+		if fpBTCPK.MarshalHex() == fpBtcPkHex {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -152,3 +152,36 @@ func (c *QueryClient) ListEvidences(startHeight uint64, pagination *sdkquerytype
 
 	return resp, err
 }
+
+// VotingPowerDistributionAt queries the Finality module to get the voting power
+// distribution at a given BTC height.
+func (c *QueryClient) VotingPowerDistributionAt(btcHeight uint32) (*finalitytypes.QueryVotingPowerDistributionAtResponse, error) {
+	var resp *finalitytypes.QueryVotingPowerDistributionAtResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryVotingPowerDistributionAtRequest{
+			BtcHeight: btcHeight,
+		}
+		resp, err = queryClient.VotingPowerDistributionAt(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderSlashableAmount queries the Finality module to get the
+// amount of BTC stake that would be slashed if the given finality provider
+// misbehaves.
+func (c *QueryClient) FinalityProviderSlashableAmount(fpBtcPkHex string) (*finalitytypes.QueryFinalityProviderSlashableAmountResponse, error) {
+	var resp *finalitytypes.QueryFinalityProviderSlashableAmountResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryFinalityProviderSlashableAmountRequest{
+			FpBtcPkHex: fpBtcPkHex,
+		}
+		resp, err = queryClient.FinalityProviderSlashableAmount(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
@@ -5,6 +5,7 @@ import (
 
 	incentivetypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdkquerytypes "github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // QueryIncentive queries the Incentive module of the Babylon node
@@ -32,3 +33,84 @@ func (c *QueryClient) RewardGauges(address string) (*incentivetypes.QueryRewardG
 
 	return resp, err
 }
+
+// AllRewardGauges queries the Incentive module to get the reward gauges with
+// withdrawable coins of an address under all stakeholder types
+func (c *QueryClient) AllRewardGauges(address string) (*incentivetypes.QueryAllRewardGaugesResponse, error) {
+	var resp *incentivetypes.QueryAllRewardGaugesResponse
+	err := c.QueryIncentive(func(ctx context.Context, queryClient incentivetypes.QueryClient) error {
+		var err error
+		req := &incentivetypes.QueryAllRewardGaugesRequest{
+			Address: address,
+		}
+		resp, err = queryClient.AllRewardGauges(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// IncentiveModuleAccounting queries the Incentive module to get the balance of
+// the incentive module account against the withdrawable rewards of all reward
+// gauges
+func (c *QueryClient) IncentiveModuleAccounting() (*incentivetypes.QueryIncentiveModuleAccountingResponse, error) {
+	var resp *incentivetypes.QueryIncentiveModuleAccountingResponse
+	err := c.QueryIncentive(func(ctx context.Context, queryClient incentivetypes.QueryClient) error {
+		var err error
+		req := &incentivetypes.QueryIncentiveModuleAccountingRequest{}
+		resp, err = queryClient.IncentiveModuleAccounting(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// RewardsDistributed queries the Incentive module to get the total rewards
+// distributed to all reward gauges in the epochs within [fromEpoch, toEpoch]
+func (c *QueryClient) RewardsDistributed(fromEpoch, toEpoch uint64) (*incentivetypes.QueryRewardsDistributedResponse, error) {
+	var resp *incentivetypes.QueryRewardsDistributedResponse
+	err := c.QueryIncentive(func(ctx context.Context, queryClient incentivetypes.QueryClient) error {
+		var err error
+		req := &incentivetypes.QueryRewardsDistributedRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   toEpoch,
+		}
+		resp, err = queryClient.RewardsDistributed(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// RewardDenoms queries the Incentive module to get the denoms present across
+// the reward gauges of an address
+func (c *QueryClient) RewardDenoms(address string) (*incentivetypes.QueryRewardDenomsResponse, error) {
+	var resp *incentivetypes.QueryRewardDenomsResponse
+	err := c.QueryIncentive(func(ctx context.Context, queryClient incentivetypes.QueryClient) error {
+		var err error
+		req := &incentivetypes.QueryRewardDenomsRequest{
+			Address: address,
+		}
+		resp, err = queryClient.RewardDenoms(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// GaugesByType queries the Incentive module to get the reward gauges of all
+// stakeholders of a given type
+func (c *QueryClient) GaugesByType(stakeholderType string, pagination *sdkquerytypes.PageRequest) (*incentivetypes.QueryGaugesByTypeResponse, error) {
+	var resp *incentivetypes.QueryGaugesByTypeResponse
+	err := c.QueryIncentive(func(ctx context.Context, queryClient incentivetypes.QueryClient) error {
+		var err error
+		req := &incentivetypes.QueryGaugesByTypeRequest{
+			StakeholderType: stakeholderType,
+			Pagination:      pagination,
+		}
+		resp, err = queryClient.GaugesByType(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
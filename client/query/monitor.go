@@ -5,6 +5,7 @@ import (
 
 	monitortypes "github.com/babylonlabs-io/babylon/x/monitor/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdkquerytypes "github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // QueryMonitor queries the Monitor module of the Babylon node
@@ -48,3 +49,63 @@ func (c *QueryClient) ReportedCheckpointBTCHeight(hashStr string) (*monitortypes
 
 	return resp, err
 }
+
+// AllEndedEpochs queries all ended epochs together with the tip height of BTC light client when each of them ended
+func (c *QueryClient) AllEndedEpochs(pagination *sdkquerytypes.PageRequest) (*monitortypes.QueryAllEndedEpochsResponse, error) {
+	var resp *monitortypes.QueryAllEndedEpochsResponse
+	err := c.QueryMonitor(func(ctx context.Context, queryClient monitortypes.QueryClient) error {
+		var err error
+		req := &monitortypes.QueryAllEndedEpochsRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.AllEndedEpochs(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CheckpointReportingLagSeries queries the reporting lag of the checkpoint of each epoch in the given range
+func (c *QueryClient) CheckpointReportingLagSeries(fromEpoch uint64, toEpoch uint64) (*monitortypes.QueryCheckpointReportingLagSeriesResponse, error) {
+	var resp *monitortypes.QueryCheckpointReportingLagSeriesResponse
+	err := c.QueryMonitor(func(ctx context.Context, queryClient monitortypes.QueryClient) error {
+		var err error
+		req := &monitortypes.QueryCheckpointReportingLagSeriesRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   toEpoch,
+		}
+		resp, err = queryClient.CheckpointReportingLagSeries(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// EpochForBtcHeight queries the latest epoch that had ended by the given tip height of BTC light client
+func (c *QueryClient) EpochForBtcHeight(btcHeight uint32) (*monitortypes.QueryEpochForBtcHeightResponse, error) {
+	var resp *monitortypes.QueryEpochForBtcHeightResponse
+	err := c.QueryMonitor(func(ctx context.Context, queryClient monitortypes.QueryClient) error {
+		var err error
+		req := &monitortypes.QueryEpochForBtcHeightRequest{
+			BtcHeight: btcHeight,
+		}
+		resp, err = queryClient.EpochForBtcHeight(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// MonitorSummary queries the number of ended epochs, the number of those whose checkpoint is reported,
+// and the latest epoch whose checkpoint is reported
+func (c *QueryClient) MonitorSummary() (*monitortypes.QueryMonitorSummaryResponse, error) {
+	var resp *monitortypes.QueryMonitorSummaryResponse
+	err := c.QueryMonitor(func(ctx context.Context, queryClient monitortypes.QueryClient) error {
+		var err error
+		req := &monitortypes.QueryMonitorSummaryRequest{}
+		resp, err = queryClient.MonitorSummary(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
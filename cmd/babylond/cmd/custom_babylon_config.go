@@ -21,12 +21,24 @@ func defaultBabylonBtcConfig() BtcConfig {
 	}
 }
 
+type BtcStakingConfig struct {
+	EmitJSONEvents bool `mapstructure:"emit-json-events"`
+}
+
+func defaultBabylonBtcStakingConfig() BtcStakingConfig {
+	return BtcStakingConfig{
+		EmitJSONEvents: false,
+	}
+}
+
 type BabylonAppConfig struct {
 	serverconfig.Config `mapstructure:",squash"`
 
 	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
 
 	BtcConfig BtcConfig `mapstructure:"btc-config"`
+
+	BtcStakingConfig BtcStakingConfig `mapstructure:"btcstaking"`
 }
 
 func DefaultBabylonAppConfig() *BabylonAppConfig {
@@ -35,9 +47,10 @@ func DefaultBabylonAppConfig() *BabylonAppConfig {
 	// app.toml, in order to avoid spamming attacks due to transactions with 0 gas price.
 	baseConfig.MinGasPrices = fmt.Sprintf("%f%s", appparams.GlobalMinGasPrice, appparams.BaseCoinUnit)
 	return &BabylonAppConfig{
-		Config:    baseConfig,
-		Wasm:      wasmtypes.DefaultWasmConfig(),
-		BtcConfig: defaultBabylonBtcConfig(),
+		Config:           baseConfig,
+		Wasm:             wasmtypes.DefaultWasmConfig(),
+		BtcConfig:        defaultBabylonBtcConfig(),
+		BtcStakingConfig: defaultBabylonBtcStakingConfig(),
 	}
 }
 
@@ -52,5 +65,16 @@ func DefaultBabylonTemplate() string {
 # Configures which bitcoin network should be used for checkpointing
 # valid values are: [mainnet, testnet, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
+
+###############################################################################
+###                      Babylon BTC staking configuration                  ###
+###############################################################################
+
+[btcstaking]
+
+# Configures whether BTC delegation state updates and finality provider
+# creations are additionally emitted as events carrying their JSON-serialized
+# payload, for off-chain consumers that cannot decode protobuf
+emit-json-events = {{ .BtcStakingConfig.EmitJSONEvents }}
 `
 }
@@ -43,6 +43,7 @@ import (
 	"github.com/babylonlabs-io/babylon/app"
 	"github.com/babylonlabs-io/babylon/app/params"
 	"github.com/babylonlabs-io/babylon/cmd/babylond/cmd/genhelpers"
+	btcstakingkeeper "github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
 )
 
 // NewRootCmd creates a new root command for babylond. It is called once in the
@@ -195,6 +196,7 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxEncodingConfig, basic
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	wasm.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Bool(btcstakingkeeper.FlagEmitJSONEvents, false, "Emit BTC delegation state updates and finality provider creations also as JSON events")
 
 	startCmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
 	startCmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
@@ -3,6 +3,7 @@ package testutil
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
@@ -32,6 +33,7 @@ import (
 
 var (
 	btcTipHeight     = uint32(30)
+	btcTipTime       = time.Unix(1700000000, 0)
 	timestampedEpoch = uint64(10)
 )
 
@@ -47,6 +49,7 @@ type Helper struct {
 
 	BTCLightClientKeeper *types.MockBTCLightClientKeeper
 	BTCCheckpointKeeper  *types.MockBtcCheckpointKeeper
+	BankKeeper           *types.MockBankKeeper
 	CheckpointingKeeper  *ftypes.MockCheckpointingKeeper
 	Net                  *chaincfg.Params
 }
@@ -66,6 +69,9 @@ func NewHelper(
 	// mock refundable messages
 	iKeeper := ftypes.NewMockIncentiveKeeper(ctrl)
 	iKeeper.EXPECT().IndexRefundableMsg(gomock.Any(), gomock.Any()).AnyTimes()
+	bsIKeeper := types.NewMockIncentiveKeeper(ctrl)
+	bsIKeeper.EXPECT().IndexRefundableMsg(gomock.Any(), gomock.Any()).AnyTimes()
+	bankKeeper := types.NewMockBankKeeper(ctrl)
 
 	ckptKeeper := ftypes.NewMockCheckpointingKeeper(ctrl)
 	ckptKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(timestampedEpoch).AnyTimes()
@@ -73,12 +79,15 @@ func NewHelper(
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
 
-	k, _ := keepertest.BTCStakingKeeperWithStore(t, db, stateStore, btclcKeeper, btccKeeper, iKeeper)
-	msgSrvr := keeper.NewMsgServerImpl(*k)
+	k, _ := keepertest.BTCStakingKeeperWithStore(t, db, stateStore, btclcKeeper, btccKeeper, bsIKeeper, bankKeeper)
 
 	fk, ctx := keepertest.FinalityKeeperWithStore(t, db, stateStore, k, iKeeper, ckptKeeper)
 	fMsgSrvr := fkeeper.NewMsgServerImpl(*fk)
 
+	// make Finality to subscribe to the BTC staking's hooks
+	k.SetHooks(types.NewMultiBtcStakingHooks(fk.Hooks()))
+	msgSrvr := keeper.NewMsgServerImpl(*k)
+
 	// set all parameters
 	err := k.SetParams(ctx, types.DefaultParams())
 	require.NoError(t, err)
@@ -99,6 +108,7 @@ func NewHelper(
 
 		BTCLightClientKeeper: btclcKeeper,
 		BTCCheckpointKeeper:  btccKeeper,
+		BankKeeper:           bankKeeper,
 		CheckpointingKeeper:  ckptKeeper,
 		Net:                  &chaincfg.SimNetParams,
 	}
@@ -152,12 +162,14 @@ func (h *Helper) GenAndApplyCustomParams(
 	h.NoError(err)
 	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
 	h.NoError(err)
+	// the minimum staking time has to be larger than the minimum unbonding time
+	minStakingTime := max(10, minUnbondingTime+1)
 	err = h.BTCStakingKeeper.SetParams(h.Ctx, types.Params{
 		CovenantPks:            bbn.NewBIP340PKsFromBTCPKs(covenantPKs),
 		CovenantQuorum:         3,
 		MinStakingValueSat:     1000,
 		MaxStakingValueSat:     int64(4 * 10e8),
-		MinStakingTimeBlocks:   10,
+		MinStakingTimeBlocks:   minStakingTime,
 		MaxStakingTimeBlocks:   10000,
 		SlashingPkScript:       slashingPkScript,
 		MinSlashingTxFeeSat:    10,
@@ -214,6 +226,64 @@ func (h *Helper) CreateDelegation(
 	unbondingTime uint16,
 	usePreApproval bool,
 ) (string, *types.MsgCreateBTCDelegation, *types.BTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *UnbondingTxInfo, error) {
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+
+	// random signer
+	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
+
+	stakingTxHash, msgCreateBTCDel, btcHeaderInfo, txInclusionProof, unbondingInfo := h.CreateDelegationMsg(
+		r,
+		staker,
+		delSK,
+		fpPK,
+		changeAddress,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+		usePreApproval,
+	)
+
+	_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+	if err != nil {
+		return "", nil, nil, nil, nil, nil, err
+	}
+
+	stakingMsgTx, err := bbn.NewBTCTxFromBytes(msgCreateBTCDel.StakingTx)
+	h.NoError(err)
+	btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingMsgTx.TxHash().String())
+	h.NoError(err)
+
+	// ensure the delegation is still pending
+	require.Equal(h.t, btcDel.GetStatus(btcTipHeight, bcParams.CheckpointFinalizationTimeout, bsParams.CovenantQuorum), types.BTCDelegationStatus_PENDING)
+
+	if usePreApproval {
+		// the BTC delegation does not have inclusion proof
+		require.False(h.t, btcDel.HasInclusionProof())
+	} else {
+		// the BTC delegation has inclusion proof
+		require.True(h.t, btcDel.HasInclusionProof())
+	}
+
+	return stakingTxHash, msgCreateBTCDel, btcDel, btcHeaderInfo, txInclusionProof, unbondingInfo, nil
+}
+
+// CreateDelegationMsg generates a MsgCreateBTCDelegation from the given staker
+// address to the given finality provider without submitting it, and mocks the
+// BTC headers including its staking and unbonding txs
+func (h *Helper) CreateDelegationMsg(
+	r *rand.Rand,
+	staker sdk.AccAddress,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	changeAddress string,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+	usePreApproval bool,
+) (string, *types.MsgCreateBTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *UnbondingTxInfo) {
 	stakingTimeBlocks := stakingTime
 	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
 	bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
@@ -247,14 +317,14 @@ func (h *Helper) CreateDelegation(
 	h.NoError(err)
 	stakingTxHash := testStakingInfo.StakingTx.TxHash().String()
 
-	// random signer
-	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
-
 	// PoP
 	pop, err := types.NewPoPBTC(staker, delSK)
 	h.NoError(err)
 	// generate staking tx info
 	prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+	// the parent of the header that includes the staking tx is at height 9,
+	// mined 10 minutes per block before the BTC tip
+	prevBlock.Header.Timestamp = btcTipTime.Add(-time.Duration(btcTipHeight-9) * 10 * time.Minute)
 	btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, testStakingInfo.StakingTx)
 	btcHeader := btcHeaderWithProof.HeaderBytes
 	btcHeaderInfo := &btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: 10}
@@ -265,7 +335,10 @@ func (h *Helper) CreateDelegation(
 
 	// mock for testing k-deep stuff
 	h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(btcHeaderInfo).AnyTimes()
-	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+	btcTipHeader := datagen.GenRandomBtcdHeader(r)
+	btcTipHeader.Timestamp = btcTipTime
+	btcTipHeaderBytes := bbn.NewBTCHeaderBytesFromBlockHeader(btcTipHeader)
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Header: &btcTipHeaderBytes, Height: btcTipHeight}).AnyTimes()
 
 	slashingSpendInfo, err := testStakingInfo.StakingInfo.SlashingPathSpendInfo()
 	h.NoError(err)
@@ -344,31 +417,10 @@ func (h *Helper) CreateDelegation(
 		msgCreateBTCDel.StakingTxInclusionProof = txInclusionProof
 	}
 
-	_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
-	if err != nil {
-		return "", nil, nil, nil, nil, nil, err
-	}
-
-	stakingMsgTx, err := bbn.NewBTCTxFromBytes(msgCreateBTCDel.StakingTx)
-	h.NoError(err)
-	btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingMsgTx.TxHash().String())
-	h.NoError(err)
-
-	// ensure the delegation is still pending
-	require.Equal(h.t, btcDel.GetStatus(btcTipHeight, bcParams.CheckpointFinalizationTimeout, bsParams.CovenantQuorum), types.BTCDelegationStatus_PENDING)
-
-	if usePreApproval {
-		// the BTC delegation does not have inclusion proof
-		require.False(h.t, btcDel.HasInclusionProof())
-	} else {
-		// the BTC delegation has inclusion proof
-		require.True(h.t, btcDel.HasInclusionProof())
-	}
-
-	return stakingTxHash, msgCreateBTCDel, btcDel, btcHeaderInfo, txInclusionProof, &UnbondingTxInfo{
+	return stakingTxHash, msgCreateBTCDel, btcHeaderInfo, txInclusionProof, &UnbondingTxInfo{
 		UnbondingTxInclusionProof: unbondingTxInclusionProof,
 		UnbondingHeaderInfo:       btcUnbondingHeaderInfo,
-	}, nil
+	}
 }
 
 func (h *Helper) GenerateCovenantSignaturesMessages(
@@ -82,11 +82,16 @@ func GenRandomBTCDelDistInfo(r *rand.Rand) (*ftypes.BTCDelDistInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &ftypes.BTCDelDistInfo{
+	btcDel := &ftypes.BTCDelDistInfo{
 		BtcPk:      btcPK,
 		StakerAddr: GenRandomAccount().Address,
 		TotalSat:   RandomInt(r, 1000) + 1,
-	}, nil
+	}
+	// route rewards to an address distinct from the staker for some delegations
+	if OneInN(r, 2) {
+		btcDel.RewardAddress = GenRandomAccount().Address
+	}
+	return btcDel, nil
 }
 
 func GenRandomFinalityProviderDistInfo(r *rand.Rand) (*ftypes.FinalityProviderDistInfo, error) {
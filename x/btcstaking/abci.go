@@ -19,5 +19,8 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.ProcessCovenantQuorumDeadlines(ctx)
+	k.PruneParamsVersions(ctx)
+
 	return []abci.ValidatorUpdate{}, nil
 }
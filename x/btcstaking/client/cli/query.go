@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
@@ -10,6 +12,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagHasProof        = "has-proof"
+	FlagHasQuorum       = "has-quorum"
+	FlagHasUnbondingSig = "has-unbonding-sig"
+	FlagStatus          = "status"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group btcstaking queries under a subcommand
@@ -22,11 +31,53 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryParamsVersions())
+	cmd.AddCommand(CmdQueryCurrentParams())
+	cmd.AddCommand(CmdQueryUnbondingParams())
 	cmd.AddCommand(CmdFinalityProvider())
 	cmd.AddCommand(CmdFinalityProviders())
 	cmd.AddCommand(CmdBTCDelegations())
+	cmd.AddCommand(CmdDelegationsByParamsVersion())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdVerifyCovenantSlashingSig())
+	cmd.AddCommand(CmdBTCDelegationSignatureReadiness())
+	cmd.AddCommand(CmdNextPowerDistUpdateHeight())
+	cmd.AddCommand(CmdCommissionRateBounds())
+	cmd.AddCommand(CmdFinalityProviderCommissionAtDelegation())
+	cmd.AddCommand(CmdBTCDelegationCovenantCoverage())
+	cmd.AddCommand(CmdBTCDelegationsByValueRange())
+	cmd.AddCommand(CmdCovenantInfo())
+	cmd.AddCommand(CmdVerifyInclusionProofAt())
+	cmd.AddCommand(CmdBTCDelegationFinalityProviderStatuses())
+	cmd.AddCommand(CmdBTCNetwork())
+	cmd.AddCommand(CmdFinalityProviderDelegationCount())
+	cmd.AddCommand(CmdDelegationCountByParamsVersion())
+	cmd.AddCommand(CmdBTCDelegationCovenantSigs())
+	cmd.AddCommand(CmdDelegationsExpiringWithin())
+	cmd.AddCommand(CmdIsStakingTxRegistered())
+	cmd.AddCommand(CmdBTCDelegationUnbondingStatus())
+	cmd.AddCommand(CmdSelectiveSlashingEvidenceList())
+	cmd.AddCommand(CmdDelegationsByFpSet())
+	cmd.AddCommand(CmdBTCDelegationTransactions())
+	cmd.AddCommand(CmdBTCDelegationScriptPaths())
+	cmd.AddCommand(CmdBTCDelegationsByFlags())
+	cmd.AddCommand(CmdFindStakingOutputIndex())
+	cmd.AddCommand(CmdCovenantSigningHashes())
+	cmd.AddCommand(CmdAllBTCDelegations())
+	cmd.AddCommand(CmdCovenantCommitteeChanges())
+	cmd.AddCommand(CmdFinalityProviderRewardGauge())
+	cmd.AddCommand(CmdDelegationActivationRate())
+	cmd.AddCommand(CmdStakerDelegationAttestation())
+	cmd.AddCommand(CmdFinalityProviderRewardBreakdown())
+	cmd.AddCommand(CmdCanReachCovenantQuorum())
+	cmd.AddCommand(CmdBTCTip())
+	cmd.AddCommand(CmdDelegationsWithUnbondingScheduleIssues())
+	cmd.AddCommand(CmdFinalityProviderStats())
+	cmd.AddCommand(CmdBTCDelegationCovenantUnbondingSigs())
+	cmd.AddCommand(CmdBTCDelegationSlashingRate())
+	cmd.AddCommand(CmdDelegationsAffectedBySlashing())
+	cmd.AddCommand(CmdRefundableBTCDelegations())
 
 	return cmd
 }
@@ -122,7 +173,7 @@ func CmdFinalityProviders() *cobra.Command {
 func CmdBTCDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-delegations [status]",
-		Short: "retrieve all BTC delegations under the given status (pending, active, unbonding, unbonded, any)",
+		Short: "retrieve all BTC delegations under the given status (pending, verified, active, unbonded, any)",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -133,7 +184,7 @@ func CmdBTCDelegations() *cobra.Command {
 				return err
 			}
 
-			status, err := types.NewBTCDelegationStatusFromString(args[0])
+			status, err := types.ParseBTCDelegationStatus(args[0])
 			if err != nil {
 				return err
 			}
@@ -156,6 +207,43 @@ func CmdBTCDelegations() *cobra.Command {
 	return cmd
 }
 
+func CmdDelegationsByParamsVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-by-params-version [version]",
+		Short: "retrieve all BTC delegations validated against the given params version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationsByParamsVersion(cmd.Context(), &types.QueryDelegationsByParamsVersionRequest{
+				Version:    uint32(version),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations-by-params-version")
+
+	return cmd
+}
+
 func CmdFinalityProviderDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-provider-delegations [fp_pk_hex]",
@@ -188,3 +276,1136 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	return cmd
 }
+
+func CmdVerifyCovenantSlashingSig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-covenant-slashing-sig [staking_tx_hash_hex] [covenant_pk_hex] [slashing_tx_sig_hex]...",
+		Short: "verify the adaptor signatures of a covenant member on the slashing tx of a BTC delegation, one per finality provider",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sigs := make([][]byte, 0, len(args)-2)
+			for _, sigHex := range args[2:] {
+				sig, err := hex.DecodeString(sigHex)
+				if err != nil {
+					return err
+				}
+				sigs = append(sigs, sig)
+			}
+
+			res, err := queryClient.VerifyCovenantSlashingSig(
+				cmd.Context(),
+				&types.QueryVerifyCovenantSlashingSigRequest{
+					StakingTxHashHex: args[0],
+					CovenantPkHex:    args[1],
+					SlashingTxSigs:   sigs,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationSignatureReadiness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-signature-readiness [staking_tx_hash_hex]",
+		Short: "retrieve whether a BTC delegation has a covenant quorum of adaptor signatures for each finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationSignatureReadiness(
+				cmd.Context(),
+				&types.QueryBTCDelegationSignatureReadinessRequest{StakingTxHashHex: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdNextPowerDistUpdateHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-power-dist-update-height",
+		Short: "retrieve the next BTC height at which a power distribution update is scheduled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextPowerDistUpdateHeight(
+				cmd.Context(),
+				&types.QueryNextPowerDistUpdateHeightRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCommissionRateBounds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commission-rate-bounds",
+		Short: "retrieve the minimum and maximum commission rates of finality providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CommissionRateBounds(
+				cmd.Context(),
+				&types.QueryCommissionRateBoundsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderCommissionAtDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-commission-at-delegation [staking_tx_hash_hex]",
+		Short: "retrieve the commission rates of the finality providers of a BTC delegation at the time it was created",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderCommissionAtDelegation(
+				cmd.Context(),
+				&types.QueryFinalityProviderCommissionAtDelegationRequest{StakingTxHashHex: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationCovenantCoverage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-covenant-coverage [staking_tx_hash_hex]",
+		Short: "retrieve how much of the required covenant quorum has signed a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationCovenantCoverage(
+				cmd.Context(),
+				&types.QueryBTCDelegationCovenantCoverageRequest{StakingTxHashHex: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationsByValueRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-value-range [min_sat] [max_sat]",
+		Short: "retrieve all BTC delegations whose staking value in satoshis is within the given range, in ascending order of staking value",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			minSat, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxSat, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByValueRange(cmd.Context(), &types.QueryBTCDelegationsByValueRangeRequest{
+				MinSat:     minSat,
+				MaxSat:     maxSat,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-value-range")
+
+	return cmd
+}
+
+func CmdCovenantInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-info",
+		Short: "retrieve the covenant committee and its quorum under the latest params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantInfo(cmd.Context(), &types.QueryCovenantInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVerifyInclusionProofAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-inclusion-proof-at [staking_tx_hex] [staking_time] [inclusion_proof] [btc_height]",
+		Short: "verify the inclusion proof of a staking tx as of the BTC tip at the given height",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingTime, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			inclusionProof, err := types.NewInclusionProofFromHex(args[2])
+			if err != nil {
+				return err
+			}
+			btcHeight, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VerifyInclusionProofAt(cmd.Context(), &types.QueryVerifyInclusionProofAtRequest{
+				StakingTxHex:            args[0],
+				StakingTime:             uint32(stakingTime),
+				StakingTxInclusionProof: inclusionProof,
+				BtcHeight:               uint32(btcHeight),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationFinalityProviderStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-fp-statuses [staking_tx_hash_hex]",
+		Short: "retrieve the status of each finality provider of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationFinalityProviderStatuses(
+				cmd.Context(),
+				&types.QueryBTCDelegationFinalityProviderStatusesRequest{StakingTxHashHex: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCNetwork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-network",
+		Short: "retrieve the BTC network the chain is configured for",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCNetwork(cmd.Context(), &types.QueryBTCNetworkRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderDelegationCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-delegation-count [fp_btc_pk_hex] [status]",
+		Short: "retrieve the number of BTC delegations of a finality provider under the given status (pending, verified, active, unbonded, any)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status, err := types.ParseBTCDelegationStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviderDelegationCount(cmd.Context(), &types.QueryFinalityProviderDelegationCountRequest{
+				FpBtcPkHex: args[0],
+				Status:     status,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationCountByParamsVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-count-by-params-version",
+		Short: "retrieve the number of BTC delegations that are not unbonded yet under each params version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationCountByParamsVersion(cmd.Context(), &types.QueryDelegationCountByParamsVersionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationCovenantSigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-covenant-sigs [staking_tx_hash_hex]",
+		Short: "retrieve the covenant adaptor signatures on the slashing tx of a BTC delegation, in ascending order of covenant PK and then of finality provider index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationCovenantSigs(cmd.Context(), &types.QueryBTCDelegationCovenantSigsRequest{
+				StakingTxHashHex: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegation-covenant-sigs")
+
+	return cmd
+}
+
+func CmdDelegationsExpiringWithin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-expiring-within [n_blocks]",
+		Short: "retrieve all active BTC delegations whose end height is within the given number of BTC blocks of the BTC tip",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			nBlocks, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationsExpiringWithin(cmd.Context(), &types.QueryDelegationsExpiringWithinRequest{
+				NBlocks:    uint32(nBlocks),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations-expiring-within")
+
+	return cmd
+}
+
+func CmdIsStakingTxRegistered() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-staking-tx-registered [staking_tx_hash_hex]",
+		Short: "check whether a staking tx is already registered as a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsStakingTxRegistered(cmd.Context(), &types.QueryIsStakingTxRegisteredRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationUnbondingStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-unbonding-status [staking_tx_hash_hex]",
+		Short: "retrieve the unbonding status of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationUnbondingStatus(cmd.Context(), &types.QueryBTCDelegationUnbondingStatusRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSelectiveSlashingEvidenceList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "selective-slashing-evidences",
+		Short: "retrieve the submitted selective slashing evidences, latest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SelectiveSlashingEvidenceList(cmd.Context(), &types.QuerySelectiveSlashingEvidenceListRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "selective-slashing-evidences")
+
+	return cmd
+}
+
+func CmdDelegationsByFpSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-fp-set [fp_btc_pk_hex] [fp_btc_pk_hex]...",
+		Short: "retrieve all BTC delegations restaking to exactly the given set of finality providers, in ascending order of staking tx hash",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationsByFpSet(cmd.Context(), &types.QueryDelegationsByFpSetRequest{
+				FpBtcPkHexList: args,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-fp-set")
+
+	return cmd
+}
+
+func CmdBTCDelegationTransactions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-transactions [staking_tx_hash_hex]",
+		Short: "retrieve the raw staking, slashing, unbonding and unbonding slashing txs of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationTransactions(cmd.Context(), &types.QueryBTCDelegationTransactionsRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationScriptPaths() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-script-paths [staking_tx_hash_hex]",
+		Short: "retrieve the taproot script paths of the staking output of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationScriptPaths(cmd.Context(), &types.QueryBTCDelegationScriptPathsRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationsByFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-flags",
+		Short: "retrieve all BTC delegations matching the given flag filters, each being one of {yes, no, any}",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filters := make([]types.FlagFilter, 0, 3)
+			for _, flagName := range []string{FlagHasProof, FlagHasQuorum, FlagHasUnbondingSig} {
+				filterStr, err := cmd.Flags().GetString(flagName)
+				if err != nil {
+					return err
+				}
+				filter, err := types.ParseFlagFilter(filterStr)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %w", flagName, err)
+				}
+				filters = append(filters, filter)
+			}
+
+			res, err := queryClient.BTCDelegationsByFlags(cmd.Context(), &types.QueryBTCDelegationsByFlagsRequest{
+				HasProof:        filters[0],
+				HasQuorum:       filters[1],
+				HasUnbondingSig: filters[2],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagHasProof, "any", "whether the BTC delegations have an inclusion proof")
+	cmd.Flags().String(FlagHasQuorum, "any", "whether the BTC delegations have a quorum of covenant signatures")
+	cmd.Flags().String(FlagHasUnbondingSig, "any", "whether the BTC delegations have the delegator's unbonding signature")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-flags")
+
+	return cmd
+}
+
+func CmdFindStakingOutputIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find-staking-output-index [staking_tx_hex] [params_version] [staker_btc_pk_hex] [staking_time] [staking_value] [fp_btc_pk_hex] [fp_btc_pk_hex]...",
+		Short: "find the index of the staking output Babylon would select in a staking tx under the given params version",
+		Args:  cobra.MinimumNArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			version, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			stakingTime, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+			stakingValue, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FindStakingOutputIndex(cmd.Context(), &types.QueryFindStakingOutputIndexRequest{
+				StakingTxHex:   args[0],
+				ParamsVersion:  uint32(version),
+				StakerBtcPkHex: args[2],
+				FpBtcPkHexList: args[5:],
+				StakingTime:    uint32(stakingTime),
+				StakingValue:   stakingValue,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCovenantSigningHashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-signing-hashes [staking_tx_hash_hex]",
+		Short: "retrieve the sighashes a covenant member signs for a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantSigningHashes(cmd.Context(), &types.QueryCovenantSigningHashesRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllBTCDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-btc-delegations",
+		Short: "retrieve all BTC delegations ordered by staking tx hash, optionally under the given statuses (pending, verified, active, unbonded, any)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statusStrs, err := cmd.Flags().GetStringSlice(FlagStatus)
+			if err != nil {
+				return err
+			}
+			statusFilter := make([]types.BTCDelegationStatus, 0, len(statusStrs))
+			for _, statusStr := range statusStrs {
+				status, err := types.ParseBTCDelegationStatus(statusStr)
+				if err != nil {
+					return err
+				}
+				statusFilter = append(statusFilter, status)
+			}
+
+			res, err := queryClient.AllBTCDelegations(cmd.Context(), &types.QueryAllBTCDelegationsRequest{
+				StatusFilter: statusFilter,
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagStatus, nil, "statuses of the BTC delegations to retrieve, retrieving BTC delegations under any status if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-btc-delegations")
+
+	return cmd
+}
+
+func CmdCovenantCommitteeChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-committee-changes",
+		Short: "retrieve the covenant committee at genesis against the one under the latest params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantCommitteeChanges(cmd.Context(), &types.QueryCovenantCommitteeChangesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderRewardGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-reward-gauge [fp_btc_pk_hex]",
+		Short: "retrieve the reward gauge of a finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FinalityProviderRewardGauge(
+				cmd.Context(),
+				&types.QueryFinalityProviderRewardGaugeRequest{
+					FpBtcPkHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationActivationRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-activation-rate [from_btc_height] [to_btc_height] [bucket_size]",
+		Short: "retrieve the number of BTC delegations activated in each bucket of BTC heights within the given range",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			bucketSize, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationActivationRate(cmd.Context(), &types.QueryDelegationActivationRateRequest{
+				FromBtcHeight: uint32(fromHeight),
+				ToBtcHeight:   uint32(toHeight),
+				BucketSize:    uint32(bucketSize),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStakerDelegationAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-delegation-attestation [staker_addr]",
+		Short: "retrieve all BTC delegations of a staker together with a reproducible digest of the set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StakerDelegationAttestation(
+				cmd.Context(),
+				&types.QueryStakerDelegationAttestationRequest{
+					StakerAddr: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderRewardBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-reward-breakdown [fp_btc_pk_hex]",
+		Short: "retrieve the rewards of a finality provider split into its commission and the rewards of its self-delegations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FinalityProviderRewardBreakdown(
+				cmd.Context(),
+				&types.QueryFinalityProviderRewardBreakdownRequest{
+					FpBtcPkHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCanReachCovenantQuorum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "can-reach-covenant-quorum [staking_tx_hash_hex]",
+		Short: "retrieve whether a BTC delegation can still reach the covenant quorum of its params, and its missing signers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CanReachCovenantQuorum(cmd.Context(), &types.QueryCanReachCovenantQuorumRequest{StakingTxHashHex: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCTip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-tip",
+		Short: "retrieve the tip of the BTC light client used by the btcstaking module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCTip(cmd.Context(), &types.QueryBTCTipRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationsWithUnbondingScheduleIssues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-with-unbonding-schedule-issues",
+		Short: "retrieve the BTC delegations whose BTC height of unbonding underflows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationsWithUnbondingScheduleIssues(cmd.Context(), &types.QueryDelegationsWithUnbondingScheduleIssuesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-with-unbonding-schedule-issues")
+
+	return cmd
+}
+
+func CmdFinalityProviderStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-stats",
+		Short: "retrieve the number of finality providers in total and under each status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderStats(cmd.Context(), &types.QueryFinalityProviderStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationCovenantUnbondingSigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-covenant-unbonding-sigs [staking_tx_hash_hex]",
+		Short: "retrieve the covenant signatures on the unbonding tx of a BTC delegation, in ascending order of covenant PK",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationCovenantUnbondingSigs(cmd.Context(), &types.QueryBTCDelegationCovenantUnbondingSigsRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationSlashingRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-slashing-rate [staking_tx_hash_hex]",
+		Short: "retrieve the slashing rate and slashing destinations under the params version of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationSlashingRate(cmd.Context(), &types.QueryBTCDelegationSlashingRateRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationsAffectedBySlashing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-affected-by-slashing [fp_pk_hex]",
+		Short: "retrieve the BTC delegations that became active under a slashed finality provider, with their amounts",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationsAffectedBySlashing(cmd.Context(), &types.QueryDelegationsAffectedBySlashingRequest{
+				FpBtcPkHex: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations-affected-by-slashing")
+
+	return cmd
+}
+
+func CmdRefundableBTCDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refundable-btc-delegations [staker_addr]",
+		Short: "retrieve the staking tx hashes of the BTC delegations of a staker whose refund can be claimed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RefundableBTCDelegations(cmd.Context(), &types.QueryRefundableBTCDelegationsRequest{
+				StakerAddr: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "refundable-btc-delegations")
+
+	return cmd
+}
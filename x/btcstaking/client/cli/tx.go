@@ -24,6 +24,7 @@ const (
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
 	FlagCommissionRate  = "commission-rate"
+	FlagRewardAddress   = "reward-address"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,6 +40,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateFinalityProviderCmd(),
 		NewEditFinalityProviderCmd(),
+		NewCancelFinalityProviderCmd(),
 		NewCreateBTCDelegationCmd(),
 		NewAddCovenantSigsCmd(),
 		NewBTCUndelegateCmd(),
@@ -188,6 +190,41 @@ func NewEditFinalityProviderCmd() *cobra.Command {
 	return cmd
 }
 
+func NewCancelFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-finality-provider [btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the registration of a finality provider without BTC delegations",
+		Long: strings.TrimSpace(
+			`Cancel the registration of a finality provider. This is only allowed if
+the finality provider has not received any BTC delegation.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get BTC PK
+			btcPK, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelFinalityProvider{
+				Addr:  clientCtx.FromAddress.String(),
+				BtcPk: btcPK,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCreateBTCDelegationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-btc-delegation [btc_pk] [pop_hex] [staking_tx] [inclusion_proof] [fp_pk] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
@@ -289,6 +326,8 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				return err
 			}
 
+			rewardAddress, _ := cmd.Flags().GetString(FlagRewardAddress)
+
 			msg := types.MsgCreateBTCDelegation{
 				StakerAddr:                    clientCtx.FromAddress.String(),
 				BtcPk:                         btcPK,
@@ -305,12 +344,14 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				UnbondingValue:                int64(unbondingValue),
 				UnbondingSlashingTx:           unbondingSlashingTx,
 				DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+				RewardAddress:                 rewardAddress,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagRewardAddress, "", "The (optional) address to receive rewards from the BTC delegation, defaults to the staker address")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
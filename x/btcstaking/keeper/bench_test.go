@@ -108,3 +108,69 @@ func BenchmarkBeginBlock_10_100(b *testing.B)  { benchBeginBlock(b, 10, 100) }
 func BenchmarkBeginBlock_100_1(b *testing.B)   { benchBeginBlock(b, 100, 1) }
 func BenchmarkBeginBlock_100_10(b *testing.B)  { benchBeginBlock(b, 100, 10) }
 func BenchmarkBeginBlock_100_100(b *testing.B) { benchBeginBlock(b, 100, 100) }
+
+func benchAddCovenantSigs(b *testing.B, numDels int, sameBlock bool) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	// helper
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(b, btclcKeeper, btccKeeper)
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	h.NoError(err)
+
+	// generate a new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// create new BTC delegations and the covenant signatures of all covenant
+	// members over them
+	covMsgs := []*types.MsgAddCovenantSigs{}
+	for i := 0; i < numDels; i++ {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+		covMsgs = append(covMsgs, h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)...)
+	}
+
+	// mock stuff
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+
+	// Reset timer before the benchmark loop starts
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		// discard state changes so that covenant signatures are not
+		// duplicated across iterations
+		ctx, _ := h.Ctx.CacheContext()
+		for _, msg := range covMsgs {
+			if !sameBlock {
+				// process each covenant signature in a new block, in which
+				// case the staking info has to be reconstructed every time
+				err = h.BTCStakingKeeper.BeginBlocker(ctx)
+				h.NoError(err)
+			}
+			_, err = h.MsgServer.AddCovenantSigs(ctx, msg)
+			h.NoError(err)
+		}
+	}
+}
+
+func BenchmarkAddCovenantSigs_SameBlock_10(b *testing.B) { benchAddCovenantSigs(b, 10, true) }
+func BenchmarkAddCovenantSigs_SameBlock_50(b *testing.B) { benchAddCovenantSigs(b, 50, true) }
+func BenchmarkAddCovenantSigs_NewBlocks_10(b *testing.B) { benchAddCovenantSigs(b, 10, false) }
+func BenchmarkAddCovenantSigs_NewBlocks_50(b *testing.B) { benchAddCovenantSigs(b, 50, false) }
@@ -8,6 +8,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	bbn "github.com/babylonlabs-io/babylon/types"
@@ -16,7 +17,10 @@ import (
 
 // AddBTCDelegation adds a BTC delegation post verification to the system, including
 // - indexing the given BTC delegation in the BTC delegator store,
-// - saving it under BTC delegation store, and
+// - saving it under BTC delegation store and indexing it by staking value,
+// finality provider set and staker address,
+// - counting it towards the staker's BTC delegations that are not unbonded yet,
+// - indexing it by end height if it already has an inclusion proof, and
 // - emit events about this BTC delegation.
 func (k Keeper) AddBTCDelegation(
 	ctx sdk.Context,
@@ -51,26 +55,34 @@ func (k Keeper) AddBTCDelegation(
 
 	// save this BTC delegation
 	k.setBTCDelegation(ctx, btcDel)
+	k.setBTCDelegationValueIndex(ctx, btcDel.TotalSat, stakingTxHash)
+	k.setBTCDelegationFpSetIndex(ctx, btcDel.FpBtcPkList, stakingTxHash)
+	k.setBTCDelegationStakerIndex(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr), stakingTxHash)
+	k.incrementStakerActiveBTCDelegations(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr))
+	k.incrementParamsVersionLiveBTCDelegations(ctx, btcDel.ParamsVersion)
 
-	if err := ctx.EventManager().EmitTypedEvents(types.NewBtcDelCreationEvent(
-		stakingTxHash.String(),
-		btcDel,
-	)); err != nil {
+	creationEvent := types.NewBtcDelCreationEvent(stakingTxHash.String(), btcDel)
+	if err := ctx.EventManager().EmitTypedEvents(creationEvent); err != nil {
 		panic(fmt.Errorf("failed to emit events for the new pending BTC delegation: %w", err))
 	}
+	k.EmitJSONEvent(ctx, creationEvent)
 
 	// NOTE: we don't need to record events for pending BTC delegations since these
 	// do not affect voting power distribution
 	// NOTE: we only insert unbonded event if the delegation already has inclusion proof
 	if btcDel.HasInclusionProof() {
-		if err := ctx.EventManager().EmitTypedEvent(types.NewInclusionProofEvent(
+		inclusionProofEvent := types.NewInclusionProofEvent(
 			stakingTxHash.String(),
 			btcDel.StartHeight,
 			btcDel.EndHeight,
 			types.BTCDelegationStatus_PENDING,
-		)); err != nil {
+		)
+		if err := ctx.EventManager().EmitTypedEvent(inclusionProofEvent); err != nil {
 			panic(fmt.Errorf("failed to emit EventBTCDelegationInclusionProofReceived for the new pending BTC delegation: %w", err))
 		}
+		k.EmitJSONEvent(ctx, inclusionProofEvent)
+
+		k.setBTCDelegationEndHeightIndex(ctx, btcDel.EndHeight, stakingTxHash)
 
 		// record event that the BTC delegation will become unbonded at endHeight-w
 		// This event will be generated to subscribers as block event, when the
@@ -131,6 +143,7 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 			if err := ctx.EventManager().EmitTypedEvent(quorumReachedEvent); err != nil {
 				panic(fmt.Errorf("failed to emit emit for the new verified BTC delegation: %w", err))
 			}
+			k.EmitJSONEvent(ctx, quorumReachedEvent)
 
 			// record event that the BTC delegation becomes active at this height
 			activeEvent := types.NewEventPowerDistUpdateWithBTCDel(
@@ -150,6 +163,7 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 			if err := ctx.EventManager().EmitTypedEvent(quorumReachedEvent); err != nil {
 				panic(fmt.Errorf("failed to emit emit for the new verified BTC delegation: %w", err))
 			}
+			k.EmitJSONEvent(ctx, quorumReachedEvent)
 		}
 
 	}
@@ -164,14 +178,19 @@ func (k Keeper) btcUndelegate(
 ) {
 	btcDel.BtcUndelegation.DelegatorUnbondingInfo = u
 	k.setBTCDelegation(ctx, btcDel)
+	k.decrementStakerActiveBTCDelegations(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr))
 
 	if !btcDel.HasInclusionProof() {
 		return
 	}
 
+	// the BTC delegation will no longer expire naturally
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	k.removeBTCDelegationEndHeightIndex(ctx, btcDel.EndHeight, stakingTxHash)
+
 	// notify subscriber about this unbonded BTC delegation
 	event := &types.EventBTCDelegationStateUpdate{
-		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		StakingTxHash: stakingTxHash.String(),
 		NewState:      types.BTCDelegationStatus_UNBONDED,
 	}
 
@@ -188,19 +207,28 @@ func (k Keeper) setBTCDelegation(ctx context.Context, btcDel *types.BTCDelegatio
 	store.Set(stakingTxHash[:], btcDelBytes)
 }
 
-// GetBTCDelegation gets the BTC delegation with a given staking tx hash
+// GetBTCDelegation gets the BTC delegation with a given staking tx hash.
+// It returns ErrInvalidStakingTxHash if the staking tx hash cannot be decoded,
+// ErrBTCDelegationNotFound if there is no BTC delegation under the staking tx
+// hash, and a different error if the stored BTC delegation cannot be decoded
 func (k Keeper) GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (*types.BTCDelegation, error) {
 	// decode staking tx hash string
 	stakingTxHash, err := chainhash.NewHashFromStr(stakingTxHashStr)
 	if err != nil {
-		return nil, err
+		return nil, types.ErrInvalidStakingTxHash.Wrap(err.Error())
 	}
-	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
-	if btcDel == nil {
+
+	store := k.btcDelegationStore(ctx)
+	btcDelBytes := store.Get(stakingTxHash[:])
+	if len(btcDelBytes) == 0 {
 		return nil, types.ErrBTCDelegationNotFound
 	}
+	var btcDel types.BTCDelegation
+	if err := k.cdc.Unmarshal(btcDelBytes, &btcDel); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal BTC delegation %s: %w", stakingTxHashStr, err)
+	}
 
-	return btcDel, nil
+	return &btcDel, nil
 }
 
 func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Hash) *types.BTCDelegation {
@@ -214,6 +242,223 @@ func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Ha
 	return &btcDel
 }
 
+// getBTCDelegationParams returns the params under the version referenced by
+// the given BTC delegation. Params versions are only pruned once all BTC
+// delegations referencing them are expired after receiving a covenant quorum,
+// so ErrParamsNotFound is returned for any other BTC delegation whose params
+// version is not found
+func (k Keeper) getBTCDelegationParams(ctx context.Context, btcDel *types.BTCDelegation) (*types.Params, error) {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		return nil, types.ErrParamsNotFound.Wrapf("params version %d of the BTC delegation is not found", btcDel.ParamsVersion)
+	}
+	return params, nil
+}
+
+// getBTCDelegationStatus returns the status of the given BTC delegation at
+// the given BTC height. BTC delegations that are unbonded early or expired are
+// unbonded regardless of their params, so their params version is not
+// required to be retained
+func (k Keeper) getBTCDelegationStatus(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	btcHeight uint32,
+	w uint32,
+) (types.BTCDelegationStatus, error) {
+	if btcDel.IsUnbondedEarly() || btcDel.IsExpiredAt(btcHeight) {
+		return types.BTCDelegationStatus_UNBONDED, nil
+	}
+	params, err := k.getBTCDelegationParams(ctx, btcDel)
+	if err != nil {
+		return types.BTCDelegationStatus_ANY, err
+	}
+	return btcDel.GetStatus(btcHeight, w, params.CovenantQuorum), nil
+}
+
+// MarkBTCDelegationExpired records that the unbonded event of the BTC
+// delegation with the given staking tx hash, scheduled at
+// `EndHeight - minUnbondingTime`, is processed at the given BTC height, such
+// that the BTC delegation reads as unbonded from then on, and removes it from
+// the index by end height. BTC delegations that are unbonded early or already
+// expired are left untouched
+func (k Keeper) MarkBTCDelegationExpired(ctx context.Context, stakingTxHashStr string, btcHeight uint32) error {
+	btcDel, err := k.GetBTCDelegation(ctx, stakingTxHashStr)
+	if err != nil {
+		return err
+	}
+	if btcDel.IsUnbondedEarly() || btcDel.ExpiredBtcHeight > 0 {
+		return nil
+	}
+
+	// the params version of a live BTC delegation is always retained
+	params, err := k.getBTCDelegationParams(ctx, btcDel)
+	if err != nil {
+		return err
+	}
+
+	btcDel.ExpiredBtcHeight = btcHeight
+	k.setBTCDelegation(ctx, btcDel)
+	k.decrementStakerActiveBTCDelegations(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr))
+	k.removeBTCDelegationEndHeightIndex(ctx, btcDel.EndHeight, btcDel.MustGetStakingTxHash())
+	if !isLiveBTCDelegation(btcDel, params, uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)) {
+		k.decrementParamsVersionLiveBTCDelegations(ctx, btcDel.ParamsVersion)
+	}
+	return nil
+}
+
+// IterateActiveBTCDelegations iterates over all BTC delegations that hold
+// voting power at the given BTC height, i.e., delegations that are not
+// unbonded early, have a covenant quorum and an inclusion proof, and whose
+// unbonded event at `EndHeight - minUnbondingTime` is not yet reached. The
+// handler is called in the order of the delegations' staking tx hashes and
+// the iteration stops once it returns true. An error is returned if a BTC
+// delegation that is not expired references a params version that is not
+// found.
+func (k Keeper) IterateActiveBTCDelegations(
+	ctx context.Context,
+	btcHeight uint32,
+	handler func(btcDel *types.BTCDelegation) (stop bool),
+) error {
+	btccParams := k.btccKeeper.GetParams(ctx)
+
+	store := k.btcDelegationStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		if btcDel.IsUnbondedEarly() || btcDel.IsExpiredAt(btcHeight) || !btcDel.HasInclusionProof() {
+			continue
+		}
+		params, err := k.getBTCDelegationParams(ctx, &btcDel)
+		if err != nil {
+			return err
+		}
+		if !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+			continue
+		}
+		minUnbondingTime := types.MinimumUnbondingTime(params, &btccParams)
+		if uint64(btcHeight)+uint64(minUnbondingTime) >= uint64(btcDel.EndHeight) {
+			continue
+		}
+
+		if handler(&btcDel) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// setBTCDelegationValueIndex indexes the BTC delegation with the given staking
+// tx hash under its staking value
+func (k Keeper) setBTCDelegationValueIndex(ctx context.Context, totalSat uint64, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationValueStore(ctx)
+	key := append(sdk.Uint64ToBigEndian(totalSat), stakingTxHash[:]...)
+	store.Set(key, []byte{})
+}
+
+// setBTCDelegationFpSetIndex indexes the BTC delegation with the given
+// staking tx hash under the set of finality providers it restakes to
+func (k Keeper) setBTCDelegationFpSetIndex(ctx context.Context, fpBTCPKs []bbn.BIP340PubKey, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationFpSetStore(ctx, types.FpSetHash(fpBTCPKs))
+	store.Set(stakingTxHash[:], []byte{})
+}
+
+// setBTCDelegationStakerIndex indexes the BTC delegation with the given
+// staking tx hash under the address of its staker
+func (k Keeper) setBTCDelegationStakerIndex(ctx context.Context, stakerAddr sdk.AccAddress, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationStakerStore(ctx, stakerAddr)
+	store.Set(stakingTxHash[:], []byte{})
+}
+
+// getStakerActiveBTCDelegations returns the number of BTC delegations of the
+// given staker that are not unbonded yet, i.e., that are pending, verified or
+// active. The number is maintained upon the creation, early unbonding,
+// expiration and deletion of BTC delegations, rather than being counted upon
+// each BTC delegation creation
+func (k Keeper) getStakerActiveBTCDelegations(ctx context.Context, stakerAddr sdk.AccAddress) uint32 {
+	bz := k.stakerActiveBTCDelegationStore(ctx).Get(stakerAddr)
+	if len(bz) == 0 {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// incrementStakerActiveBTCDelegations increments the number of BTC
+// delegations of the given staker that are not unbonded yet
+func (k Keeper) incrementStakerActiveBTCDelegations(ctx context.Context, stakerAddr sdk.AccAddress) {
+	count := k.getStakerActiveBTCDelegations(ctx, stakerAddr)
+	k.stakerActiveBTCDelegationStore(ctx).Set(stakerAddr, sdk.Uint64ToBigEndian(uint64(count)+1))
+}
+
+// decrementStakerActiveBTCDelegations decrements the number of BTC
+// delegations of the given staker that are not unbonded yet, and removes the
+// entry once no such BTC delegation is left
+func (k Keeper) decrementStakerActiveBTCDelegations(ctx context.Context, stakerAddr sdk.AccAddress) {
+	store := k.stakerActiveBTCDelegationStore(ctx)
+	count := k.getStakerActiveBTCDelegations(ctx, stakerAddr)
+	if count <= 1 {
+		store.Delete(stakerAddr)
+		return
+	}
+	store.Set(stakerAddr, sdk.Uint64ToBigEndian(uint64(count)-1))
+}
+
+// setBTCDelegationEndHeightIndex indexes the BTC delegation with the given
+// staking tx hash under its end height
+func (k Keeper) setBTCDelegationEndHeightIndex(ctx context.Context, endHeight uint32, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationEndHeightStore(ctx)
+	key := append(sdk.Uint64ToBigEndian(uint64(endHeight)), stakingTxHash[:]...)
+	store.Set(key, []byte{})
+}
+
+// removeBTCDelegationEndHeightIndex removes the BTC delegation with the given
+// staking tx hash from the index by end height
+func (k Keeper) removeBTCDelegationEndHeightIndex(ctx context.Context, endHeight uint32, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationEndHeightStore(ctx)
+	key := append(sdk.Uint64ToBigEndian(uint64(endHeight)), stakingTxHash[:]...)
+	store.Delete(key)
+}
+
+// IterateDelegationsByEndHeightRange iterates over the BTC delegations whose
+// end height is within [from, to], in ascending order of end height and then
+// of staking tx hash, until the handler returns true. BTC delegations without
+// inclusion proof, unbonded early or expired are not indexed by end height,
+// thus not visited.
+func (k Keeper) IterateDelegationsByEndHeightRange(
+	ctx context.Context,
+	from, to uint32,
+	handler func(btcDel *types.BTCDelegation) (stop bool),
+) {
+	if from > to {
+		return
+	}
+	// the end of the range is exclusive
+	iter := k.btcDelegationEndHeightStore(ctx).Iterator(
+		sdk.Uint64ToBigEndian(uint64(from)),
+		sdk.Uint64ToBigEndian(uint64(to)+1),
+	)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Key()[8:])
+		if err != nil {
+			// failing to unmarshal the key of the index is a programming error
+			panic(err)
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			panic(fmt.Errorf("indexed BTC delegation %s is not found", stakingTxHash))
+		}
+		if handler(btcDel) {
+			break
+		}
+	}
+}
+
 // btcDelegationStore returns the KVStore of the BTC delegations
 // prefix: BTCDelegationKey
 // key: BTC delegation's staking tx hash
@@ -222,3 +467,55 @@ func (k Keeper) btcDelegationStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.BTCDelegationKey)
 }
+
+// btcDelegationValueStore returns the KVStore of the index of BTC delegations
+// by staking value
+// prefix: BTCDelegationValueKey
+// key: (BTC delegation's staking value in satoshis || staking tx hash)
+// value: empty
+func (k Keeper) btcDelegationValueStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationValueKey)
+}
+
+// btcDelegationFpSetStore returns the KVStore of the index of BTC
+// delegations restaking to the finality provider set with the given hash
+// prefix: BTCDelegationFpSetKey || hash of the finality provider set
+// key: staking tx hash
+// value: empty
+func (k Keeper) btcDelegationFpSetStore(ctx context.Context, fpSetHash []byte) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	fpSetStore := prefix.NewStore(storeAdapter, types.BTCDelegationFpSetKey)
+	return prefix.NewStore(fpSetStore, fpSetHash)
+}
+
+// btcDelegationStakerStore returns the KVStore of the index of BTC
+// delegations of the given staker
+// prefix: BTCDelegationStakerKey || length-prefixed staker address
+// key: staking tx hash
+// value: empty
+func (k Keeper) btcDelegationStakerStore(ctx context.Context, stakerAddr sdk.AccAddress) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	stakerStore := prefix.NewStore(storeAdapter, types.BTCDelegationStakerKey)
+	return prefix.NewStore(stakerStore, address.MustLengthPrefix(stakerAddr))
+}
+
+// stakerActiveBTCDelegationStore returns the KVStore of the number of BTC
+// delegations of each staker that are not unbonded yet
+// prefix: StakerActiveBTCDelegationKey
+// key: staker address
+// value: number of BTC delegations in big endian
+func (k Keeper) stakerActiveBTCDelegationStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.StakerActiveBTCDelegationKey)
+}
+
+// btcDelegationEndHeightStore returns the KVStore of the index of BTC
+// delegations by end height
+// prefix: BTCDelegationEndHeightKey
+// key: (BTC delegation's end height || staking tx hash)
+// value: empty
+func (k Keeper) btcDelegationEndHeightStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationEndHeightKey)
+}
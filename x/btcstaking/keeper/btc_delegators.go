@@ -60,6 +60,15 @@ func (k Keeper) getBTCDelegatorDelegations(ctx context.Context, fpBTCPK *bbn.BIP
 	return &types.BTCDelegatorDelegations{Dels: btcDels}
 }
 
+// hasBTCDelegators checks if any BTC delegator has delegated to the given
+// finality provider
+func (k Keeper) hasBTCDelegators(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) bool {
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
 // btcDelegatorFpStore returns the KVStore of the BTC delegators
 // prefix: BTCDelegatorKey || finality provider's Bitcoin secp256k1 PK
 // key: delegator's Bitcoin secp256k1 PK
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// ProcessCovenantQuorumDeadlines processes the BTC delegations whose covenant
+// quorum deadline is reached at the current Babylon height. Each of them that
+// has not received a covenant quorum is recorded as refundable for its staker,
+// such that the staker can claim the refund of a later BTC delegation
+// creation. If enabled in the params, the ones without an inclusion proof are
+// also deleted. BTC delegations with an inclusion proof are never deleted, as
+// their expiration is already scheduled. A BTC delegation whose params
+// version is not found is logged and skipped, so that its state cannot halt
+// the chain.
+func (k Keeper) ProcessCovenantQuorumDeadlines(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.HeaderInfo().Height)
+	params := k.GetParams(ctx)
+
+	// collect the keys first, as the index is pruned while processing it
+	store := k.covenantQuorumDeadlineStore(ctx)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		deadlineHeight := sdk.BigEndianToUint64(key[:8])
+		stakingTxHash, err := chainhash.NewHash(key[8:])
+		if err != nil {
+			// failing to unmarshal the key of the index is a programming error
+			panic(err)
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			continue
+		}
+		delParams, err := k.getBTCDelegationParams(ctx, btcDel)
+		if err != nil {
+			k.Logger(sdkCtx).Error("skipping BTC delegation past its covenant quorum deadline",
+				"staking_tx_hash", stakingTxHash.String(), "err", err)
+			continue
+		}
+		if btcDel.HasCovenantQuorums(delParams.CovenantQuorum) {
+			continue
+		}
+
+		stakerAddr := sdk.MustAccAddressFromBech32(btcDel.StakerAddr)
+		k.setRefundableBTCDelegation(ctx, stakerAddr, *stakingTxHash)
+
+		// an expired BTC delegation without a covenant quorum is no longer
+		// live once its deadline is processed
+		if btcDel.ExpiredBtcHeight > 0 && !btcDel.IsUnbondedEarly() {
+			k.decrementParamsVersionLiveBTCDelegations(ctx, btcDel.ParamsVersion)
+		}
+
+		deleted := params.DeleteDelegationsPastCovenantQuorumDeadline && !btcDel.HasInclusionProof()
+		if deleted {
+			k.deleteBTCDelegation(ctx, btcDel, *stakingTxHash)
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventBTCDelegationCovenantQuorumDeadlinePassed{
+			StakingTxHash:  stakingTxHash.String(),
+			StakerAddr:     btcDel.StakerAddr,
+			DeadlineHeight: deadlineHeight,
+			Deleted:        deleted,
+		}); err != nil {
+			panic(fmt.Errorf("failed to emit EventBTCDelegationCovenantQuorumDeadlinePassed: %w", err))
+		}
+	}
+}
+
+// deleteBTCDelegation deletes the given BTC delegation without an inclusion
+// proof together with all its indices
+func (k Keeper) deleteBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation, stakingTxHash chainhash.Hash) {
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fpBTCPK := fpBTCPK // remove when update to go1.22
+		btcDelIndex := k.getBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk)
+		if btcDelIndex == nil {
+			continue
+		}
+		btcDelIndex.Remove(stakingTxHash)
+		if len(btcDelIndex.StakingTxHashList) == 0 {
+			k.btcDelegatorFpStore(ctx, &fpBTCPK).Delete(*btcDel.BtcPk)
+		} else {
+			k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
+		}
+	}
+
+	k.btcDelegationStore(ctx).Delete(stakingTxHash[:])
+	k.btcDelegationValueStore(ctx).Delete(append(sdk.Uint64ToBigEndian(btcDel.TotalSat), stakingTxHash[:]...))
+	k.btcDelegationFpSetStore(ctx, types.FpSetHash(btcDel.FpBtcPkList)).Delete(stakingTxHash[:])
+	k.btcDelegationStakerStore(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr)).Delete(stakingTxHash[:])
+	if !btcDel.IsUnbondedEarly() && btcDel.ExpiredBtcHeight == 0 {
+		k.decrementStakerActiveBTCDelegations(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr))
+	}
+	// a BTC delegation without an inclusion proof never expires, so it is
+	// live until deleted
+	k.decrementParamsVersionLiveBTCDelegations(ctx, btcDel.ParamsVersion)
+}
+
+// setCovenantQuorumDeadlineIndex indexes the BTC delegation with the given
+// staking tx hash under the Babylon height at which its covenant quorum
+// deadline is reached
+func (k Keeper) setCovenantQuorumDeadlineIndex(ctx context.Context, deadlineHeight uint64, stakingTxHash chainhash.Hash) {
+	store := k.covenantQuorumDeadlineStore(ctx)
+	key := append(sdk.Uint64ToBigEndian(deadlineHeight), stakingTxHash[:]...)
+	store.Set(key, []byte{})
+}
+
+// consumeRefundableBTCDelegation removes the BTC delegation with the given
+// staking tx hash from the refundable BTC delegations of the given staker,
+// and returns whether it was refundable
+func (k Keeper) consumeRefundableBTCDelegation(ctx context.Context, stakerAddr sdk.AccAddress, stakingTxHash chainhash.Hash) bool {
+	store := k.refundableBTCDelegationStore(ctx, stakerAddr)
+	if !store.Has(stakingTxHash[:]) {
+		return false
+	}
+
+	store.Delete(stakingTxHash[:])
+	return true
+}
+
+// setRefundableBTCDelegation records the BTC delegation with the given
+// staking tx hash as refundable to the given staker
+func (k Keeper) setRefundableBTCDelegation(ctx context.Context, stakerAddr sdk.AccAddress, stakingTxHash chainhash.Hash) {
+	k.refundableBTCDelegationStore(ctx, stakerAddr).Set(stakingTxHash[:], []byte{})
+}
+
+// covenantQuorumDeadlineStore returns the KVStore of the index of BTC
+// delegations by covenant quorum deadline
+// prefix: CovenantQuorumDeadlineKey
+// key: (Babylon height of the deadline || staking tx hash)
+// value: empty
+func (k Keeper) covenantQuorumDeadlineStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.CovenantQuorumDeadlineKey)
+}
+
+// refundableBTCDelegationStore returns the KVStore of the BTC delegations of
+// the given staker that did not receive a covenant quorum before the deadline
+// and are thus refundable
+// prefix: RefundableBTCDelegationKey || length-prefixed staker address
+// key: staking tx hash
+// value: empty
+func (k Keeper) refundableBTCDelegationStore(ctx context.Context, stakerAddr sdk.AccAddress) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	refundableStore := prefix.NewStore(storeAdapter, types.RefundableBTCDelegationKey)
+	return prefix.NewStore(refundableStore, address.MustLengthPrefix(stakerAddr))
+}
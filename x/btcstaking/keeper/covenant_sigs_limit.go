@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authz "github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// CovenantSigsLimitDecorator defines an AnteHandler decorator that caps the
+// number of covenant signature messages processed per block, as per the
+// MaxCovenantSigsPerBlock param.
+//
+// The quota is charged in the AnteHandler rather than in the message handler,
+// as the state changes of the AnteHandler are written before the messages are
+// executed. In this way, a message that fails the verification of its
+// signatures still consumes quota, and the cap bounds the verification work
+// of invalid submissions as well. Only messages signed on behalf of a member
+// of the current covenant committee are counted, so that junk messages with
+// arbitrary PKs cannot use up the quota of the covenant committee. The message
+// handler rejects the other messages before verifying any signature, unless
+// their PK belongs to the covenant committee of an earlier params version.
+type CovenantSigsLimitDecorator struct {
+	k *Keeper
+}
+
+// NewCovenantSigsLimitDecorator creates a new CovenantSigsLimitDecorator
+func NewCovenantSigsLimitDecorator(k *Keeper) *CovenantSigsLimitDecorator {
+	return &CovenantSigsLimitDecorator{
+		k: k,
+	}
+}
+
+// AnteHandle counts the covenant signature messages of the tx, including the
+// ones wrapped in MsgExec, towards the cap of the current block. It rejects
+// the tx with ErrCovenantSigsPerBlockLimit if the messages do not fit in the
+// remaining quota, in which case the tx can be resubmitted in a later block.
+// The quota is charged in CheckTx and ReCheckTx as well, where the counter
+// starts from 0 after each commit. In this way, the mempool does not admit
+// more covenant signature messages than a block can process. The quota is
+// not charged upon simulation
+func (d CovenantSigsLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.k.GetParams(ctx)
+	numMsgs := uint32(0)
+	for _, msg := range tx.GetMsgs() {
+		n, err := countCovenantSigsMsgs(&params, msg)
+		if err != nil {
+			return ctx, err
+		}
+		numMsgs += n
+	}
+
+	if numMsgs > 0 {
+		if err := d.k.consumeCovenantSigsBlockQuota(ctx, params.MaxCovenantSigsPerBlock, numMsgs); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// countCovenantSigsMsgs returns the number of covenant signature messages
+// signed on behalf of a member of the current covenant committee in the given
+// message, unpacking MsgExec so that they cannot bypass the cap
+func countCovenantSigsMsgs(params *types.Params, msg sdk.Msg) (uint32, error) {
+	switch msg := msg.(type) {
+	case *types.MsgAddCovenantSigs:
+		if msg.Pk == nil || !params.HasCovenantPK(msg.Pk) {
+			return 0, nil
+		}
+		return 1, nil
+	case *authz.MsgExec:
+		internalMsgs, err := msg.GetMessages()
+		if err != nil {
+			// the internal message is not valid
+			return 0, err
+		}
+		numMsgs := uint32(0)
+		for _, internalMsg := range internalMsgs {
+			n, err := countCovenantSigsMsgs(params, internalMsg)
+			if err != nil {
+				return 0, err
+			}
+			numMsgs += n
+		}
+		return numMsgs, nil
+	default:
+		return 0, nil
+	}
+}
+
+// consumeCovenantSigsBlockQuota counts the given number of covenant signature
+// messages towards the cap of the current block. It returns
+// ErrCovenantSigsPerBlockLimit if they exceed the remaining quota of the
+// block. A cap of 0 disables the limit
+func (k Keeper) consumeCovenantSigsBlockQuota(ctx context.Context, maxCovenantSigsPerBlock uint32, numMsgs uint32) error {
+	if maxCovenantSigsPerBlock == 0 {
+		return nil
+	}
+
+	numSigs := k.getCovenantSigsInBlock(ctx)
+	if uint64(numSigs)+uint64(numMsgs) > uint64(maxCovenantSigsPerBlock) {
+		types.RecordCovenantSigsPerBlockLimitHit()
+		return types.ErrCovenantSigsPerBlockLimit.Wrapf("cap: %d, already processed: %d, submitted: %d",
+			maxCovenantSigsPerBlock, numSigs, numMsgs)
+	}
+
+	k.setCovenantSigsInBlock(ctx, numSigs+numMsgs)
+	return nil
+}
+
+// getCovenantSigsInBlock returns the number of covenant signature messages
+// processed in the current block
+func (k Keeper) getCovenantSigsInBlock(ctx context.Context) uint32 {
+	// transient store is cleared after each block execution, so the counter
+	// starts from 0 in every block
+	store := sdk.UnwrapSDKContext(ctx).TransientStore(k.tsKey)
+	bz := store.Get(types.CovenantSigsInBlockKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+func (k Keeper) setCovenantSigsInBlock(ctx context.Context, numSigs uint32) {
+	store := sdk.UnwrapSDKContext(ctx).TransientStore(k.tsKey)
+	store.Set(types.CovenantSigsInBlockKey, sdk.Uint64ToBigEndian(uint64(numSigs)))
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authz "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testutil "github.com/babylonlabs-io/babylon/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+var _ sdk.Tx = &testTx{}
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx *testTx) GetMsgsV2() ([]protoreflect.ProtoMessage, error) {
+	return nil, nil
+}
+
+func TestAddCovenantSigsMaxPerBlock(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters, where a block fits the covenant signatures of a
+	// single BTC delegation
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	bsParams.MaxCovenantSigsPerBlock = uint32(len(covenantSKs))
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+	require.NoError(t, err)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	createDelegation := func() []*types.MsgAddCovenantSigs {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+		return h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+	}
+
+	decorator := keeper.NewCovenantSigsLimitDecorator(h.BTCStakingKeeper)
+	noopNext := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// deliverTx runs the tx through the decorator, and then executes its
+	// messages in a cached context that is discarded if any of them fails,
+	// as upon block execution
+	deliverTx := func(ctx sdk.Context, msgs ...*types.MsgAddCovenantSigs) error {
+		tx := &testTx{}
+		for _, msg := range msgs {
+			tx.msgs = append(tx.msgs, msg)
+		}
+		if _, err := decorator.AnteHandle(ctx, tx, false, noopNext); err != nil {
+			return err
+		}
+		cacheCtx, write := ctx.CacheContext()
+		for _, msg := range msgs {
+			if _, err := h.MsgServer.AddCovenantSigs(cacheCtx, msg); err != nil {
+				return err
+			}
+		}
+		write()
+		return nil
+	}
+
+	covenantMsgs1 := createDelegation()
+	covenantMsgs2 := createDelegation()
+	// the messages are executed in a cached context
+	btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(btcTip).AnyTimes()
+
+	// covenant signatures that fail verification still use up the cap. The
+	// unbonding tx signatures of the second BTC delegation are not valid
+	// for the first one
+	for i, msg := range covenantMsgs1 {
+		invalidMsg := *msg
+		invalidMsg.UnbondingTxSig = covenantMsgs2[i].UnbondingTxSig
+		err := deliverTx(h.Ctx, &invalidMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+	}
+	require.Equal(t, uint32(len(covenantSKs)), h.BTCStakingKeeper.GetCovenantSigsInBlock(h.Ctx))
+
+	// further covenant signatures are rejected in this block
+	err = deliverTx(h.Ctx, covenantMsgs1[0])
+	require.ErrorIs(t, err, types.ErrCovenantSigsPerBlockLimit)
+
+	// the cap is enforced in CheckTx as well, but not in simulation
+	_, err = decorator.AnteHandle(h.Ctx.WithIsCheckTx(true), &testTx{msgs: []sdk.Msg{covenantMsgs1[0]}}, false, noopNext)
+	require.ErrorIs(t, err, types.ErrCovenantSigsPerBlockLimit)
+	_, err = decorator.AnteHandle(h.Ctx.WithIsReCheckTx(true), &testTx{msgs: []sdk.Msg{covenantMsgs1[0]}}, false, noopNext)
+	require.ErrorIs(t, err, types.ErrCovenantSigsPerBlockLimit)
+	_, err = decorator.AnteHandle(h.Ctx, &testTx{msgs: []sdk.Msg{covenantMsgs1[0]}}, true, noopNext)
+	require.NoError(t, err)
+	require.Equal(t, uint32(len(covenantSKs)), h.BTCStakingKeeper.GetCovenantSigsInBlock(h.Ctx))
+
+	// messages signed on behalf of a PK outside the covenant committee do
+	// not use up the cap, and are rejected by the message handler
+	junkMsgs := make([]*types.MsgAddCovenantSigs, 0, len(covenantMsgs2))
+	for _, msg := range covenantMsgs2 {
+		junkMsg := *msg
+		junkMsg.Pk, err = datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		junkMsgs = append(junkMsgs, &junkMsg)
+	}
+	err = deliverTx(h.Ctx, junkMsgs...)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantPK)
+	require.Equal(t, uint32(len(covenantSKs)), h.BTCStakingKeeper.GetCovenantSigsInBlock(h.Ctx))
+
+	// the counter is reset in the next block, so the covenant signatures can
+	// be resubmitted
+	h.BTCStakingKeeper.ResetCovenantSigsInBlock(h.Ctx)
+	err = deliverTx(h.Ctx, covenantMsgs1...)
+	require.NoError(t, err)
+	require.Equal(t, uint32(len(covenantSKs)), h.BTCStakingKeeper.GetCovenantSigsInBlock(h.Ctx))
+
+	// covenant signatures wrapped in MsgExec are counted as well
+	h.BTCStakingKeeper.ResetCovenantSigsInBlock(h.Ctx)
+	execMsgs := make([]sdk.Msg, 0, len(covenantMsgs2)+1)
+	for _, msg := range covenantMsgs2 {
+		execMsgs = append(execMsgs, msg)
+	}
+	execMsgs = append(execMsgs, covenantMsgs2[0])
+	msgExec := authz.NewMsgExec(sdk.AccAddress("test"), execMsgs)
+	_, err = decorator.AnteHandle(h.Ctx, &testTx{msgs: []sdk.Msg{&msgExec}}, false, noopNext)
+	require.ErrorIs(t, err, types.ErrCovenantSigsPerBlockLimit)
+	require.Zero(t, h.BTCStakingKeeper.GetCovenantSigsInBlock(h.Ctx))
+
+	// a cap of 0 disables the limit
+	bsParams.MaxCovenantSigsPerBlock = 0
+	err = h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+	require.NoError(t, err)
+	err = deliverTx(h.Ctx, covenantMsgs2...)
+	require.NoError(t, err)
+	err = deliverTx(h.Ctx, createDelegation()...)
+	require.NoError(t, err)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func (k Keeper) BTCDelegationStore(ctx context.Context) prefix.Store {
+	return k.btcDelegationStore(ctx)
+}
+
+func (k Keeper) SetBTCDelegationEndHeightIndex(ctx context.Context, endHeight uint32, stakingTxHash chainhash.Hash) {
+	k.setBTCDelegationEndHeightIndex(ctx, endHeight, stakingTxHash)
+}
+
+func (k Keeper) SetBTCDelegationValueIndex(ctx context.Context, totalSat uint64, stakingTxHash chainhash.Hash) {
+	k.setBTCDelegationValueIndex(ctx, totalSat, stakingTxHash)
+}
+
+func (k Keeper) SetBTCDelegationFpSetIndex(ctx context.Context, fpBTCPKs []bbn.BIP340PubKey, stakingTxHash chainhash.Hash) {
+	k.setBTCDelegationFpSetIndex(ctx, fpBTCPKs, stakingTxHash)
+}
+
+func (k Keeper) SetFinalityProvider(ctx context.Context, fp *types.FinalityProvider) {
+	k.setFinalityProvider(ctx, fp)
+}
+
+func (k Keeper) SetFinalityProviderCommissionAt(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, height uint64, commission sdkmath.LegacyDec) {
+	k.setFinalityProviderCommissionAt(ctx, fpBTCPK, height, commission)
+}
+
+func (k Keeper) GetStakingInfo(ctx context.Context, btcDel *types.BTCDelegation, params *types.Params) (*btcstaking.StakingInfo, error) {
+	return k.getStakingInfo(ctx, btcDel, params)
+}
+
+func (k Keeper) AddPowerDistUpdateEvent(ctx context.Context, btcHeight uint32, event *types.EventPowerDistUpdate) {
+	k.addPowerDistUpdateEvent(ctx, btcHeight, event)
+}
+
+func (k Keeper) SetSelectiveSlashingEvidence(ctx context.Context, evidence *types.SelectiveSlashingEvidence) {
+	k.setSelectiveSlashingEvidence(ctx, evidence)
+}
+
+func (k Keeper) ResetCovenantSigsInBlock(ctx context.Context) {
+	k.setCovenantSigsInBlock(ctx, 0)
+}
+
+func (k Keeper) GetCovenantSigsInBlock(ctx context.Context) uint32 {
+	return k.getCovenantSigsInBlock(ctx)
+}
+
+func (k Keeper) IncrementParamsVersionLiveBTCDelegations(ctx context.Context, version uint32) {
+	k.incrementParamsVersionLiveBTCDelegations(ctx, version)
+}
+
+func (k Keeper) GetParamsVersionLiveBTCDelegations(ctx context.Context, version uint32) uint64 {
+	return k.getParamsVersionLiveBTCDelegations(ctx, version)
+}
+
+func (k Keeper) DeleteParamsVersion(ctx context.Context, version uint32) {
+	k.paramsStore(ctx).Delete(uint32ToBytes(version))
+}
+
+func (k Keeper) IncrementStakerActiveBTCDelegations(ctx context.Context, stakerAddr sdk.AccAddress) {
+	k.incrementStakerActiveBTCDelegations(ctx, stakerAddr)
+}
+
+func (k Keeper) GetStakerActiveBTCDelegations(ctx context.Context, stakerAddr sdk.AccAddress) uint32 {
+	return k.getStakerActiveBTCDelegations(ctx, stakerAddr)
+}
+
+func (k Keeper) ConsumeRefundableBTCDelegation(ctx context.Context, stakerAddr sdk.AccAddress, stakingTxHash chainhash.Hash) bool {
+	return k.consumeRefundableBTCDelegation(ctx, stakerAddr, stakingTxHash)
+}
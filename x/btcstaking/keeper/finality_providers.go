@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
@@ -26,6 +27,10 @@ func (k Keeper) AddFinalityProvider(goCtx context.Context, msg *types.MsgCreateF
 	if msg.Commission.GT(sdkmath.LegacyOneDec()) {
 		return types.ErrCommissionGTMaxRate
 	}
+	// ensure the description is within the length limits in parameters
+	if err := params.ValidateDescriptionLength(msg.Description); err != nil {
+		return err
+	}
 
 	// ensure finality provider does not already exist
 	if k.HasFinalityProvider(ctx, *msg.BtcPk) {
@@ -41,9 +46,16 @@ func (k Keeper) AddFinalityProvider(goCtx context.Context, msg *types.MsgCreateF
 		Pop:         msg.Pop,
 	}
 	k.setFinalityProvider(ctx, &fp)
+	k.setFinalityProviderCommission(ctx, fp.BtcPk, *fp.Commission)
 
 	// notify subscriber
-	return ctx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderCreated(&fp))
+	createdEvent := types.NewEventFinalityProviderCreated(&fp)
+	if err := ctx.EventManager().EmitTypedEvent(createdEvent); err != nil {
+		return err
+	}
+	k.EmitJSONEvent(ctx, createdEvent)
+
+	return nil
 }
 
 // setFinalityProvider adds the given finality provider to KVStore
@@ -53,6 +65,13 @@ func (k Keeper) setFinalityProvider(ctx context.Context, fp *types.FinalityProvi
 	store.Set(fp.BtcPk.MustMarshal(), fpBytes)
 }
 
+// deleteFinalityProvider removes the finality provider with the given Bitcoin
+// PK from KVStore
+func (k Keeper) deleteFinalityProvider(ctx context.Context, fpBTCPK []byte) {
+	store := k.finalityProviderStore(ctx)
+	store.Delete(fpBTCPK)
+}
+
 // HasFinalityProvider checks if the finality provider exists
 func (k Keeper) HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool {
 	store := k.finalityProviderStore(ctx)
@@ -71,9 +90,49 @@ func (k Keeper) GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*types
 	return &fp, nil
 }
 
-// SlashFinalityProvider slashes a finality provider with the given PK
+// cancelFinalityProvider deletes the finality provider with the given PK. It
+// fails if the finality provider has any BTC delegation, regardless of the
+// delegation status. The hooks are notified so that the state of the finality
+// provider in other modules is deleted as well, and a finality provider
+// re-registered with the same PK starts afresh
+func (k Keeper) cancelFinalityProvider(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, *fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is not slashed, so that the evidence
+	// of slashing is retained
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+
+	// ensure finality provider has no BTC delegation. The finality provider
+	// has no entry in the BTC delegator index if and only if it has never
+	// received any BTC delegation
+	if k.hasBTCDelegators(ctx, fpBTCPK) {
+		return types.ErrFpHasDelegations.Wrapf(
+			"cannot cancel finality provider %s with BTC delegations", fpBTCPK.MarshalHex())
+	}
+
+	k.deleteFinalityProvider(ctx, *fpBTCPK)
+	k.deleteFinalityProviderCommissionHistory(ctx, fpBTCPK)
+	k.finalityProviderLastEditStore(ctx).Delete(*fpBTCPK)
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterFinalityProviderCanceled(ctx, fpBTCPK); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SlashFinalityProvider slashes a finality provider with the given PK for the
+// given reason
 // A slashed finality provider will not have voting power
-func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte, reason types.SlashingReason) error {
 	// ensure finality provider exists
 	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
 	if err != nil {
@@ -92,6 +151,7 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 		return fmt.Errorf("failed to get current BTC tip")
 	}
 	fp.SlashedBtcHeight = btcTip.Height
+	fp.SlashingReason = reason
 	k.setFinalityProvider(ctx, fp)
 
 	// record slashed event. The next `BeginBlock` will consume this
@@ -168,6 +228,29 @@ func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) erro
 	return nil
 }
 
+// setFinalityProviderLastEditHeight records the current Babylon height as the
+// height at which the given finality provider was last edited
+func (k Keeper) setFinalityProviderLastEditHeight(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	k.setFinalityProviderLastEditHeightAt(ctx, fpBTCPK, height)
+}
+
+// setFinalityProviderLastEditHeightAt records the given Babylon height as the
+// height at which the given finality provider was last edited
+func (k Keeper) setFinalityProviderLastEditHeightAt(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, height uint64) {
+	k.finalityProviderLastEditStore(ctx).Set(*fpBTCPK, sdk.Uint64ToBigEndian(height))
+}
+
+// getFinalityProviderLastEditHeight returns the Babylon height at which the
+// given finality provider was last edited, and whether it was ever edited
+func (k Keeper) getFinalityProviderLastEditHeight(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) (uint64, bool) {
+	bz := k.finalityProviderLastEditStore(ctx).Get(*fpBTCPK)
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
 // finalityProviderStore returns the KVStore of the finality provider set
 // prefix: FinalityProviderKey
 // key: Bitcoin secp256k1 PK
@@ -176,3 +259,13 @@ func (k Keeper) finalityProviderStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.FinalityProviderKey)
 }
+
+// finalityProviderLastEditStore returns the KVStore of the heights at which
+// finality providers were last edited
+// prefix: FinalityProviderLastEditKey
+// key: Bitcoin secp256k1 PK
+// value: Babylon height of the last edit
+func (k Keeper) finalityProviderLastEditStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FinalityProviderLastEditKey)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// setFinalityProviderCommission records the commission rate of the given
+// finality provider from the current Babylon height onwards
+func (k Keeper) setFinalityProviderCommission(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, commission sdkmath.LegacyDec) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	k.setFinalityProviderCommissionAt(ctx, fpBTCPK, height, commission)
+}
+
+// setFinalityProviderCommissionAt records the commission rate of the given
+// finality provider from the given Babylon height onwards
+func (k Keeper) setFinalityProviderCommissionAt(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, height uint64, commission sdkmath.LegacyDec) {
+	bz, err := commission.Marshal()
+	if err != nil {
+		panic(err) // only happens upon a programming error
+	}
+	store := k.fpCommissionStore(ctx, fpBTCPK)
+	store.Set(sdk.Uint64ToBigEndian(height), bz)
+}
+
+// GetFinalityProviderCommissionAt returns the commission rate of the given
+// finality provider that was in effect at the given Babylon height, i.e., the
+// last commission rate recorded at or before the height. It returns
+// ErrFpCommissionNotFound if no commission rate was recorded at or before the
+// height, which is the case for finality providers whose commission was last
+// set before the commission history was introduced
+func (k Keeper) GetFinalityProviderCommissionAt(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, height uint64) (sdkmath.LegacyDec, error) {
+	store := k.fpCommissionStore(ctx, fpBTCPK)
+	// the end of the range is exclusive, so iterate up to height+1
+	iter := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(height+1))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return sdkmath.LegacyDec{}, types.ErrFpCommissionNotFound.Wrapf(
+			"finality provider %s at height %d", fpBTCPK.MarshalHex(), height)
+	}
+
+	var commission sdkmath.LegacyDec
+	if err := commission.Unmarshal(iter.Value()); err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return commission, nil
+}
+
+// hasFinalityProviderCommissionHistory returns whether any commission rate of
+// the given finality provider is recorded
+func (k Keeper) hasFinalityProviderCommissionHistory(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) bool {
+	iter := k.fpCommissionStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// deleteFinalityProviderCommissionHistory removes all recorded commission
+// rates of the given finality provider
+func (k Keeper) deleteFinalityProviderCommissionHistory(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) {
+	store := k.fpCommissionStore(ctx, fpBTCPK)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// fpCommissionStore returns the KVStore of the commission history of the
+// given finality provider
+// prefix: FinalityProviderCommissionKey
+// key: (finality provider's Bitcoin secp256k1 PK || Babylon height)
+// value: commission rate set at the Babylon height
+func (k Keeper) fpCommissionStore(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	commissionStore := prefix.NewStore(storeAdapter, types.FinalityProviderCommissionKey)
+	return prefix.NewStore(commissionStore, fpBTCPK.MustMarshal())
+}
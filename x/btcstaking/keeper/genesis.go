@@ -5,17 +5,22 @@ import (
 	"fmt"
 	"math"
 
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
-	// save all past params versions
-	for _, p := range gs.Params {
+	// save all past params versions that are not pruned
+	for i, p := range gs.Params {
 		params := p
-		if err := k.SetParams(ctx, *params); err != nil {
+		if err := k.setParamsAtVersion(ctx, gs.FirstParamsVersion+uint32(i), *params); err != nil {
 			return err
 		}
 	}
@@ -24,8 +29,49 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setFinalityProvider(ctx, fp)
 	}
 
+	for _, record := range gs.FpCommissionHistory {
+		k.setFinalityProviderCommissionAt(ctx, record.FpBtcPk, record.Height, record.Commission)
+	}
+	// finality providers without commission history, e.g., in a genesis
+	// exported before the commission history was introduced, have their
+	// current commission rate recorded from the genesis height onwards
+	for _, fp := range gs.FinalityProviders {
+		if !k.hasFinalityProviderCommissionHistory(ctx, fp.BtcPk) {
+			k.setFinalityProviderCommission(ctx, fp.BtcPk, *fp.Commission)
+		}
+	}
+
+	for _, lastEdit := range gs.FpLastEdits {
+		k.setFinalityProviderLastEditHeightAt(ctx, lastEdit.FpBtcPk, lastEdit.Height)
+	}
+
+	// the genesis is exported after the EndBlocker of the height before the
+	// genesis height, which processed all covenant quorum deadlines up to it
+	genesisHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
 	for _, btcDel := range gs.BtcDelegations {
 		k.setBTCDelegation(ctx, btcDel)
+		k.setBTCDelegationValueIndex(ctx, btcDel.TotalSat, btcDel.MustGetStakingTxHash())
+		k.setBTCDelegationFpSetIndex(ctx, btcDel.FpBtcPkList, btcDel.MustGetStakingTxHash())
+		k.setBTCDelegationStakerIndex(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr), btcDel.MustGetStakingTxHash())
+		if !btcDel.IsUnbondedEarly() && btcDel.ExpiredBtcHeight == 0 {
+			k.incrementStakerActiveBTCDelegations(ctx, sdk.MustAccAddressFromBech32(btcDel.StakerAddr))
+		}
+		if err := k.indexParamsVersionLiveBTCDelegation(ctx, btcDel, genesisHeight); err != nil {
+			return err
+		}
+		if btcDel.HasInclusionProof() && !btcDel.IsUnbondedEarly() && btcDel.ExpiredBtcHeight == 0 {
+			k.setBTCDelegationEndHeightIndex(ctx, btcDel.EndHeight, btcDel.MustGetStakingTxHash())
+		}
+		// the covenant quorum deadline is set by the params version of the
+		// BTC delegation. A deadline before the genesis height is already
+		// processed, and is not indexed again so that the refund of the BTC
+		// delegation cannot be recorded twice
+		if p := k.GetParamsByVersion(ctx, btcDel.ParamsVersion); p != nil && p.CovenantQuorumDeadlineBlocks > 0 && !btcDel.HasCovenantQuorums(p.CovenantQuorum) {
+			deadlineHeight := btcDel.CreationHeight + p.CovenantQuorumDeadlineBlocks
+			if deadlineHeight >= genesisHeight {
+				k.setCovenantQuorumDeadlineIndex(ctx, deadlineHeight, btcDel.MustGetStakingTxHash())
+			}
+		}
 	}
 
 	for _, blocks := range gs.BlockHeightChains {
@@ -36,6 +82,18 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setBTCDelegatorDelegationIndex(ctx, del.FpBtcPk, del.DelBtcPk, del.Idx)
 	}
 
+	for _, evidence := range gs.SelectiveSlashingEvidences {
+		k.setSelectiveSlashingEvidence(ctx, evidence)
+	}
+
+	for _, refundable := range gs.RefundableBtcDelegations {
+		stakingTxHash, err := chainhash.NewHashFromStr(refundable.StakingTxHash)
+		if err != nil {
+			return err
+		}
+		k.setRefundableBTCDelegation(ctx, sdk.MustAccAddressFromBech32(refundable.StakerAddr), *stakingTxHash)
+	}
+
 	// Events are generated on block `N` to be processed at block `N+1`
 	// When ExportGenesis is called the node already stopped at block N.
 	// In this case the events on the state would refer to the block `N+1`
@@ -72,13 +130,38 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	evidences, err := k.selectiveSlashingEvidences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refundables, err := k.refundableBTCDelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionHistory, err := k.fpCommissionHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastEdits, err := k.fpLastEdits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetAllParams(ctx),
-		FinalityProviders: fps,
-		BtcDelegations:    dels,
-		BlockHeightChains: k.blockHeightChains(ctx),
-		BtcDelegators:     btcDels,
-		Events:            evts,
+		Params:                     k.GetAllParams(ctx),
+		FirstParamsVersion:         k.firstParamsVersion(ctx),
+		FinalityProviders:          fps,
+		BtcDelegations:             dels,
+		BlockHeightChains:          k.blockHeightChains(ctx),
+		BtcDelegators:              btcDels,
+		Events:                     evts,
+		SelectiveSlashingEvidences: evidences,
+		RefundableBtcDelegations:   refundables,
+		FpCommissionHistory:        commissionHistory,
+		FpLastEdits:                lastEdits,
 	}, nil
 }
 
@@ -187,6 +270,104 @@ func (k Keeper) eventIdxs(
 	return evts, nil
 }
 
+func (k Keeper) selectiveSlashingEvidences(ctx context.Context) ([]*types.SelectiveSlashingEvidence, error) {
+	iter := k.selectiveSlashingEvidenceStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	evidences := make([]*types.SelectiveSlashingEvidence, 0)
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.SelectiveSlashingEvidence
+		if err := evidence.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		evidences = append(evidences, &evidence)
+	}
+
+	return evidences, nil
+}
+
+// refundableBTCDelegations returns all refundable BTC delegations, in
+// ascending order of staker address and then of staking tx hash bytes
+func (k Keeper) refundableBTCDelegations(ctx context.Context) ([]*types.RefundableBTCDelegation, error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := prefix.NewStore(storeAdapter, types.RefundableBTCDelegationKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	refundables := make([]*types.RefundableBTCDelegation, 0)
+	for ; iter.Valid(); iter.Next() {
+		// the key is the length-prefixed staker address followed by the
+		// staking tx hash
+		key := iter.Key()
+		if len(key) == 0 || len(key) != 1+int(key[0])+chainhash.HashSize {
+			return nil, fmt.Errorf("invalid key of refundable BTC delegation: %x", key)
+		}
+		stakerAddr := sdk.AccAddress(key[1 : 1+key[0]])
+		stakingTxHash, err := chainhash.NewHash(key[1+key[0]:])
+		if err != nil {
+			return nil, err
+		}
+		refundables = append(refundables, &types.RefundableBTCDelegation{
+			StakerAddr:    stakerAddr.String(),
+			StakingTxHash: stakingTxHash.String(),
+		})
+	}
+
+	return refundables, nil
+}
+
+// fpCommissionHistory returns the commission history of all finality
+// providers, in ascending order of finality provider BTC PK and then of height
+func (k Keeper) fpCommissionHistory(ctx context.Context) ([]*types.FinalityProviderCommissionRecord, error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := prefix.NewStore(storeAdapter, types.FinalityProviderCommissionKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	records := make([]*types.FinalityProviderCommissionRecord, 0)
+	for ; iter.Valid(); iter.Next() {
+		// the key is the finality provider's BTC PK followed by the height
+		key := iter.Key()
+		if len(key) != bbn.BIP340PubKeyLen+8 {
+			return nil, fmt.Errorf("invalid key of finality provider commission: %x", key)
+		}
+		fpBTCPK, err := bbn.NewBIP340PubKey(key[:bbn.BIP340PubKeyLen])
+		if err != nil {
+			return nil, err
+		}
+		var commission sdkmath.LegacyDec
+		if err := commission.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		records = append(records, &types.FinalityProviderCommissionRecord{
+			FpBtcPk:    fpBTCPK,
+			Height:     sdk.BigEndianToUint64(key[bbn.BIP340PubKeyLen:]),
+			Commission: commission,
+		})
+	}
+
+	return records, nil
+}
+
+// fpLastEdits returns the heights at which finality providers were last
+// edited, in ascending order of finality provider BTC PK
+func (k Keeper) fpLastEdits(ctx context.Context) ([]*types.FinalityProviderLastEdit, error) {
+	iter := k.finalityProviderLastEditStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	lastEdits := make([]*types.FinalityProviderLastEdit, 0)
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		lastEdits = append(lastEdits, &types.FinalityProviderLastEdit{
+			FpBtcPk: fpBTCPK,
+			Height:  sdk.BigEndianToUint64(iter.Value()),
+		})
+	}
+
+	return lastEdits, nil
+}
+
 func (k Keeper) setBlockHeightChains(ctx context.Context, blocks *types.BlockHeightBbnToBtc) {
 	store := k.btcHeightStore(ctx)
 	store.Set(sdk.Uint64ToBigEndian(blocks.BlockHeightBbn), sdk.Uint64ToBigEndian(uint64(blocks.BlockHeightBtc)))
@@ -6,9 +6,20 @@ import (
 	"strings"
 	"testing"
 
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	"github.com/babylonlabs-io/babylon/testutil/helper"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
 	btclightclientt "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -160,3 +171,285 @@ func TestExportGenesis(t *testing.T) {
 
 	// TODO: vp dst cache
 }
+
+func TestGenesisWithPrunedParamsVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	k, ctx := testkeeper.BTCStakingKeeperWithStore(t, db, stateStore, nil, nil, nil, nil)
+
+	// params versions before 3 were pruned
+	params3 := types.DefaultParams()
+	params4 := types.DefaultParams()
+	params4.MinSlashingTxFeeSat = 23400
+	gs := types.DefaultGenesis()
+	gs.Params = []*types.Params{&params3, &params4}
+	gs.FirstParamsVersion = 3
+
+	err := k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{3, 4}, k.GetParamsVersions(ctx))
+	require.EqualValues(t, params4, *k.GetParamsByVersion(ctx, 4))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gs.FirstParamsVersion, exported.FirstParamsVersion)
+	require.Equal(t, gs.Params, exported.Params)
+}
+
+func TestInitGenesisRebuildsEndHeightIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(12))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	dels := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 4, 3)
+	// the second BTC delegation is unbonded early, the third one does not
+	// have an inclusion proof yet and the fourth one is expired, so none of
+	// them is indexed
+	dels[1].BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+	dels[2].StartHeight, dels[2].EndHeight = 0, 0
+	dels[3].ExpiredBtcHeight = dels[3].EndHeight
+
+	gs := types.DefaultGenesis()
+	gs.BtcDelegations = dels
+	err = k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+
+	indexed := []string{}
+	k.IterateDelegationsByEndHeightRange(ctx, 0, math.MaxUint32, func(btcDel *types.BTCDelegation) bool {
+		indexed = append(indexed, btcDel.MustGetStakingTxHash().String())
+		return false
+	})
+	require.Equal(t, []string{dels[0].MustGetStakingTxHash().String()}, indexed)
+
+	// the number of live BTC delegations per params version is rebuilt as
+	// well, where the expired one is not live whether or not it has a covenant
+	// quorum, as the params disable the covenant quorum deadline
+	require.EqualValues(t, 3, k.GetParamsVersionLiveBTCDelegations(ctx, dels[0].ParamsVersion))
+}
+
+func TestGenesisCovenantQuorumDeadlines(t *testing.T) {
+	r := rand.New(rand.NewSource(16))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// the BTC delegations use a params version with a covenant quorum
+	// deadline of 10 blocks, while the latest params version disables it
+	deadlineParams := types.DefaultParams()
+	deadlineParams.CovenantQuorumDeadlineBlocks = 10
+	gs := types.DefaultGenesis()
+	gs.Params = []*types.Params{&deadlineParams, gs.Params[0]}
+
+	// none of the BTC delegations receives a covenant quorum. The deadline
+	// of the first one is processed and its refund is claimed, the deadline
+	// of the second one is processed and its refund is not claimed yet, and
+	// the deadline of the third one is not reached before the export
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	dels := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 3, 3)
+	for i, btcDel := range dels {
+		btcDel.StakerAddr = dels[0].StakerAddr
+		btcDel.ParamsVersion = 0
+		btcDel.CovenantSigs = nil
+		btcDel.CreationHeight = uint64(1 + 7*i)
+	}
+	gs.BtcDelegations = dels
+	err = k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+
+	refundableBTCDelegations := func(k *keeper.Keeper, ctx sdk.Context) []string {
+		resp, err := k.RefundableBTCDelegations(ctx, &types.QueryRefundableBTCDelegationsRequest{
+			StakerAddr: dels[0].StakerAddr,
+		})
+		require.NoError(t, err)
+		return resp.StakingTxHashHexList
+	}
+
+	for height := uint64(1); height <= 20; height++ {
+		ctx = datagen.WithCtxHeight(ctx, height)
+		k.ProcessCovenantQuorumDeadlines(ctx)
+	}
+	require.True(t, k.ConsumeRefundableBTCDelegation(ctx, sdk.MustAccAddressFromBech32(dels[0].StakerAddr), dels[0].MustGetStakingTxHash()))
+	require.Equal(t, []string{dels[1].MustGetStakingTxHash().String()}, refundableBTCDelegations(k, ctx))
+
+	// export at height 20 and import at height 21
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	k2, ctx2 := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	ctx2 = datagen.WithCtxHeight(ctx2, 21)
+	err = k2.InitGenesis(ctx2, *exported)
+	require.NoError(t, err)
+
+	// the processed deadlines are not processed again, so the claimed refund
+	// cannot be claimed twice, while the pending deadline is still processed
+	for height := uint64(21); height <= 30; height++ {
+		ctx2 = datagen.WithCtxHeight(ctx2, height)
+		k2.ProcessCovenantQuorumDeadlines(ctx2)
+	}
+	require.ElementsMatch(t, []string{
+		dels[1].MustGetStakingTxHash().String(),
+		dels[2].MustGetStakingTxHash().String(),
+	}, refundableBTCDelegations(k2, ctx2))
+}
+
+func TestGenesisSelectiveSlashingEvidences(t *testing.T) {
+	r := rand.New(rand.NewSource(13))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	evidences := make([]*types.SelectiveSlashingEvidence, 0)
+	for i := 0; i < 3; i++ {
+		fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		evidences = append(evidences, &types.SelectiveSlashingEvidence{
+			StakingTxHash:    datagen.GenRandomBtcdHash(r).String(),
+			FpBtcPk:          bbn.NewBIP340PubKeyFromBTCPK(fpPK),
+			RecoveredFpBtcSk: fpSK.Serialize(),
+			BlockHeight:      uint64(i + 1),
+		})
+	}
+
+	gs := types.DefaultGenesis()
+	gs.SelectiveSlashingEvidences = evidences
+	require.NoError(t, gs.Validate())
+	err := k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+
+	// the imported evidences are queryable
+	resp, err := k.SelectiveSlashingEvidenceList(ctx, &types.QuerySelectiveSlashingEvidenceListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.SelectiveSlashingEvidence{evidences[2], evidences[1], evidences[0]}, resp.Evidences)
+
+	// the evidences are exported in the order of block heights
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, evidences, exported.SelectiveSlashingEvidences)
+}
+
+func TestGenesisRefundableBTCDelegations(t *testing.T) {
+	r := rand.New(rand.NewSource(14))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	staker := datagen.GenRandomAccount().GetAddress()
+	refundables := make([]*types.RefundableBTCDelegation, 0)
+	for i := 0; i < 3; i++ {
+		refundables = append(refundables, &types.RefundableBTCDelegation{
+			StakerAddr:    staker.String(),
+			StakingTxHash: datagen.GenRandomBtcdHash(r).String(),
+		})
+	}
+
+	gs := types.DefaultGenesis()
+	gs.RefundableBtcDelegations = refundables
+	require.NoError(t, gs.Validate())
+	err := k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+
+	// the imported refundable BTC delegations are queryable
+	resp, err := k.RefundableBTCDelegations(ctx, &types.QueryRefundableBTCDelegationsRequest{
+		StakerAddr: staker.String(),
+	})
+	require.NoError(t, err)
+	expected := make([]string, 0, len(refundables))
+	for _, refundable := range refundables {
+		expected = append(expected, refundable.StakingTxHash)
+	}
+	require.ElementsMatch(t, expected, resp.StakingTxHashHexList)
+
+	// exporting and importing the genesis again yields the same state
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, refundables, exported.RefundableBtcDelegations)
+
+	k2, ctx2 := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	err = k2.InitGenesis(ctx2, *exported)
+	require.NoError(t, err)
+	reexported, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, exported.RefundableBtcDelegations, reexported.RefundableBtcDelegations)
+}
+
+func TestGenesisFinalityProviderCommissionHistory(t *testing.T) {
+	r := rand.New(rand.NewSource(15))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 100})
+
+	fp1, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	fp2, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+
+	// only the first finality provider has commission history
+	history := []*types.FinalityProviderCommissionRecord{
+		{FpBtcPk: fp1.BtcPk, Height: 10, Commission: sdkmath.LegacyMustNewDecFromStr("0.1")},
+		{FpBtcPk: fp1.BtcPk, Height: 20, Commission: sdkmath.LegacyMustNewDecFromStr("0.2")},
+	}
+	gs := types.DefaultGenesis()
+	gs.FinalityProviders = []*types.FinalityProvider{fp1, fp2}
+	gs.FpCommissionHistory = history
+	require.NoError(t, gs.Validate())
+	err = k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+
+	// the imported commission history is used
+	commission, err := k.GetFinalityProviderCommissionAt(ctx, fp1.BtcPk, 15)
+	require.NoError(t, err)
+	require.Equal(t, history[0].Commission, commission)
+	commission, err = k.GetFinalityProviderCommissionAt(ctx, fp1.BtcPk, 100)
+	require.NoError(t, err)
+	require.Equal(t, history[1].Commission, commission)
+
+	// the commission of the finality provider without commission history is
+	// recorded at the genesis height
+	_, err = k.GetFinalityProviderCommissionAt(ctx, fp2.BtcPk, 99)
+	require.ErrorIs(t, err, types.ErrFpCommissionNotFound)
+	commission, err = k.GetFinalityProviderCommissionAt(ctx, fp2.BtcPk, 100)
+	require.NoError(t, err)
+	require.Equal(t, *fp2.Commission, commission)
+
+	// exporting and importing the genesis again yields the same history
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	expected := append(history, &types.FinalityProviderCommissionRecord{
+		FpBtcPk: fp2.BtcPk, Height: 100, Commission: *fp2.Commission,
+	})
+	require.ElementsMatch(t, expected, exported.FpCommissionHistory)
+
+	k2, ctx2 := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	err = k2.InitGenesis(ctx2.WithHeaderInfo(header.Info{Height: 200}), *exported)
+	require.NoError(t, err)
+	reexported, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, exported.FpCommissionHistory, reexported.FpCommissionHistory)
+}
+
+func TestGenesisFinalityProviderLastEdits(t *testing.T) {
+	r := rand.New(rand.NewSource(16))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	lastEdits := make([]*types.FinalityProviderLastEdit, 0)
+	for i := 0; i < 3; i++ {
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		lastEdits = append(lastEdits, &types.FinalityProviderLastEdit{
+			FpBtcPk: bbn.NewBIP340PubKeyFromBTCPK(fpPK),
+			Height:  uint64(i + 1),
+		})
+	}
+
+	gs := types.DefaultGenesis()
+	gs.FpLastEdits = lastEdits
+	require.NoError(t, gs.Validate())
+	err := k.InitGenesis(ctx, *gs)
+	require.NoError(t, err)
+
+	// exporting and importing the genesis again yields the same last edits
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, lastEdits, exported.FpLastEdits)
+
+	k2, ctx2 := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	err = k2.InitGenesis(ctx2, *exported)
+	require.NoError(t, err)
+	reexported, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, exported.FpLastEdits, reexported.FpLastEdits)
+}
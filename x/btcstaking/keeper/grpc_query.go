@@ -1,18 +1,30 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
-	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
 	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	itypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -118,6 +130,116 @@ func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegatio
 	}, nil
 }
 
+// DelegationsByParamsVersion returns a paginated list of all BTC delegations validated against the given params version
+func (k Keeper) DelegationsByParamsVersion(ctx context.Context, req *types.QueryDelegationsByParamsVersionRequest) (*types.QueryDelegationsByParamsVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	// get current BTC height
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	// get value of w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		if btcDel.ParamsVersion != req.Version {
+			return false, nil
+		}
+
+		if accumulate {
+			status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsByParamsVersionResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// BTCDelegationsByValueRange returns a paginated list of BTC delegations whose
+// staking value is within [min_sat, max_sat], in ascending order of staking
+// value. It iterates over the index of BTC delegations by staking value, so
+// that only the BTC delegations in the range are visited. Only key-based
+// pagination is supported.
+func (k Keeper) BTCDelegationsByValueRange(ctx context.Context, req *types.QueryBTCDelegationsByValueRangeRequest) (*types.QueryBTCDelegationsByValueRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MinSat > req.MaxSat {
+		return nil, status.Errorf(codes.InvalidArgument, "min_sat %d is larger than max_sat %d", req.MinSat, req.MaxSat)
+	}
+
+	limit := uint64(query.DefaultLimit)
+	start := sdk.Uint64ToBigEndian(req.MinSat)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "only key-based pagination in ascending order is supported")
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		if len(req.Pagination.Key) > 0 {
+			if bytes.Compare(req.Pagination.Key, start) < 0 {
+				return nil, status.Error(codes.InvalidArgument, "pagination key is below min_sat")
+			}
+			start = req.Pagination.Key
+		}
+	}
+	// the end of the range is exclusive
+	var end []byte
+	if req.MaxSat < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(req.MaxSat + 1)
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	// get current BTC height
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	// get value of w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	iter := k.btcDelegationValueStore(ctx).Iterator(start, end)
+	defer iter.Close()
+
+	btcDels := []*types.BTCDelegationResponse{}
+	var nextKey []byte
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(btcDels)) == limit {
+			nextKey = iter.Key()
+			break
+		}
+
+		stakingTxHash, err := chainhash.NewHash(iter.Key()[8:])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return nil, status.Errorf(codes.Internal, "indexed BTC delegation %s is not found", stakingTxHash)
+		}
+		delStatus := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, delStatus))
+	}
+
+	return &types.QueryBTCDelegationsByValueRangeResponse{
+		BtcDelegations: btcDels,
+		Pagination:     &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
 // FinalityProviderDelegations returns all the delegations of the provided finality provider filtered by the provided status.
 func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.QueryFinalityProviderDelegationsRequest) (*types.QueryFinalityProviderDelegationsResponse, error) {
 	if req == nil {
@@ -178,16 +300,10 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	// decode staking tx hash
-	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
-	if err != nil {
-		return nil, err
-	}
-
 	// find BTC delegation
-	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
-	if btcDel == nil {
-		return nil, types.ErrBTCDelegationNotFound
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
 	}
 
 	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
@@ -201,3 +317,1610 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// VerifyCovenantSlashingSig verifies the adaptor signatures of a covenant member
+// on the slashing tx of a BTC delegation, under the params the BTC delegation was
+// validated against, and returns the verification result for each finality provider
+func (k Keeper) VerifyCovenantSlashingSig(ctx context.Context, req *types.QueryVerifyCovenantSlashingSigRequest) (*types.QueryVerifyCovenantSlashingSigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	covPK, err := bbn.NewBIP340PubKeyFromHex(req.CovenantPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid covenant public key: %v", err)
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure that the given covenant PK is in the committee of the params version
+	if !params.HasCovenantPK(covPK) {
+		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", covPK.MarshalHex())
+	}
+
+	if len(req.SlashingTxSigs) != len(btcDel.FpBtcPkList) {
+		return nil, types.ErrInvalidCovenantSig.Wrapf(
+			"number of covenant signatures: %d, number of finality providers being staked to: %d",
+			len(req.SlashingTxSigs), len(btcDel.FpBtcPkList))
+	}
+
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get staking info: %v", err)
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slashing spend info: %v", err)
+	}
+
+	// verify the adaptor signature encrypted by each finality provider's PK
+	// separately, so that the caller knows which of them is invalid
+	results := make([]*types.CovenantSlashingSigVerification, 0, len(btcDel.FpBtcPkList))
+	for i := range btcDel.FpBtcPkList {
+		result := &types.CovenantSlashingSigVerification{
+			FpBtcPkHex: btcDel.FpBtcPkList[i].MarshalHex(),
+			Valid:      true,
+		}
+		_, err := btcDel.SlashingTx.ParseEncVerifyAdaptorSignatures(
+			stakingInfo.StakingOutput,
+			slashingSpendInfo,
+			covPK,
+			btcDel.FpBtcPkList[i:i+1],
+			req.SlashingTxSigs[i:i+1],
+		)
+		if err != nil {
+			result.Valid = false
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return &types.QueryVerifyCovenantSlashingSigResponse{
+		ParamsVersion: btcDel.ParamsVersion,
+		Results:       results,
+	}, nil
+}
+
+// BTCDelegationSignatureReadiness returns whether the BTC delegation has a
+// covenant quorum of adaptor signatures on the staking slashing path and the
+// unbonding slashing path for each finality provider, under the params the BTC
+// delegation was validated against
+func (k Keeper) BTCDelegationSignatureReadiness(ctx context.Context, req *types.QueryBTCDelegationSignatureReadinessRequest) (*types.QueryBTCDelegationSignatureReadinessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingQuorum := btcDel.BtcUndelegation.HasCovenantQuorumOnUnbonding(params.CovenantQuorum)
+	activationReady := unbondingQuorum
+	fpReadiness := make([]*types.FpSignatureReadiness, 0, len(btcDel.FpBtcPkList))
+	for i := range btcDel.FpBtcPkList {
+		stakingSlashingQuorum, unbondingSlashingQuorum := btcDel.FpSignatureReadiness(i, params.CovenantQuorum)
+		fpReadiness = append(fpReadiness, &types.FpSignatureReadiness{
+			FpBtcPkHex:              btcDel.FpBtcPkList[i].MarshalHex(),
+			StakingSlashingQuorum:   stakingSlashingQuorum,
+			UnbondingSlashingQuorum: unbondingSlashingQuorum,
+		})
+		activationReady = activationReady && stakingSlashingQuorum && unbondingSlashingQuorum
+	}
+
+	return &types.QueryBTCDelegationSignatureReadinessResponse{
+		ParamsVersion:     btcDel.ParamsVersion,
+		CovenantQuorum:    params.CovenantQuorum,
+		FpReadiness:       fpReadiness,
+		UnbondingQuorum:   unbondingQuorum,
+		ActivationReady:   activationReady,
+		HasInclusionProof: btcDel.HasInclusionProof(),
+	}, nil
+}
+
+// NextPowerDistUpdateHeight returns the smallest BTC height, starting from the
+// current BTC tip, at which a power distribution update is scheduled
+func (k Keeper) NextPowerDistUpdateHeight(ctx context.Context, req *types.QueryNextPowerDistUpdateHeightRequest) (*types.QueryNextPowerDistUpdateHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcHeight, found := k.GetNextPowerDistUpdateHeight(ctx)
+
+	return &types.QueryNextPowerDistUpdateHeightResponse{
+		Found:     found,
+		BtcHeight: btcHeight,
+	}, nil
+}
+
+// CommissionRateBounds returns the minimum commission rate set by governance
+// and the maximum commission rate that a finality provider can charge
+func (k Keeper) CommissionRateBounds(ctx context.Context, req *types.QueryCommissionRateBoundsRequest) (*types.QueryCommissionRateBoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryCommissionRateBoundsResponse{
+		MinCommissionRate: k.MinCommissionRate(ctx),
+		MaxCommissionRate: sdkmath.LegacyOneDec(),
+	}, nil
+}
+
+// FinalityProviderCommissionAtDelegation returns the commission rate of each
+// finality provider of the given BTC delegation at the Babylon height where
+// the BTC delegation was created. It returns a FailedPrecondition error if the
+// creation height or the commission history is not available, which is the
+// case for data predating their introduction
+func (k Keeper) FinalityProviderCommissionAtDelegation(ctx context.Context, req *types.QueryFinalityProviderCommissionAtDelegationRequest) (*types.QueryFinalityProviderCommissionAtDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
+	}
+
+	if btcDel.CreationHeight == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the creation height of BTC delegation %s is not recorded", req.StakingTxHashHex)
+	}
+
+	fpCommissions := make([]*types.FpCommission, 0, len(btcDel.FpBtcPkList))
+	for i := range btcDel.FpBtcPkList {
+		fpBTCPK := &btcDel.FpBtcPkList[i]
+		commission, err := k.GetFinalityProviderCommissionAt(ctx, fpBTCPK, btcDel.CreationHeight)
+		if err != nil {
+			if errors.Is(err, types.ErrFpCommissionNotFound) {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		fpCommissions = append(fpCommissions, &types.FpCommission{
+			FpBtcPkHex: fpBTCPK.MarshalHex(),
+			Commission: commission,
+		})
+	}
+
+	return &types.QueryFinalityProviderCommissionAtDelegationResponse{
+		CreationHeight: btcDel.CreationHeight,
+		FpCommissions:  fpCommissions,
+	}, nil
+}
+
+// BTCDelegationCovenantCoverage returns the number of covenant members that
+// have signed the given BTC delegation, out of the covenant committee and
+// quorum of the params the BTC delegation was validated against
+func (k Keeper) BTCDelegationCovenantCoverage(ctx context.Context, req *types.QueryBTCDelegationCovenantCoverageRequest) (*types.QueryBTCDelegationCovenantCoverageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	signedCount := btcDel.NumCovenantSigners(params.CovenantPks)
+	// NOTE: the covenant quorum in params is guaranteed to be positive
+	ratio := sdkmath.LegacyNewDec(int64(signedCount)).QuoInt64(int64(params.CovenantQuorum))
+	if ratio.GT(sdkmath.LegacyOneDec()) {
+		ratio = sdkmath.LegacyOneDec()
+	}
+
+	return &types.QueryBTCDelegationCovenantCoverageResponse{
+		ParamsVersion:  btcDel.ParamsVersion,
+		SignedCount:    signedCount,
+		RequiredQuorum: params.CovenantQuorum,
+		CoverageRatio:  ratio,
+	}, nil
+}
+
+// CovenantInfo returns the covenant committee and its quorum under the latest
+// params
+func (k Keeper) CovenantInfo(ctx context.Context, req *types.QueryCovenantInfoRequest) (*types.QueryCovenantInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	p := k.GetParamsWithVersion(ctx)
+	covenantPksHex := make([]string, 0, len(p.Params.CovenantPks))
+	for _, pk := range p.Params.CovenantPks {
+		covenantPksHex = append(covenantPksHex, pk.MarshalHex())
+	}
+	committeeSize := uint32(len(covenantPksHex))
+
+	// NOTE: the covenant committee in params is guaranteed to be non-empty
+	threshold := sdkmath.LegacyNewDec(int64(p.Params.CovenantQuorum)).
+		MulInt64(100).
+		QuoInt64(int64(committeeSize))
+
+	return &types.QueryCovenantInfoResponse{
+		ParamsVersion:       p.Version,
+		CovenantPksHex:      covenantPksHex,
+		CovenantQuorum:      p.Params.CovenantQuorum,
+		CommitteeSize:       committeeSize,
+		ThresholdPercentage: threshold,
+	}, nil
+}
+
+// CovenantCommitteeChanges returns the covenant committee under params
+// version 0, i.e., the one configured at genesis, and the one under the latest
+// params, together with the public keys added and removed in between. It
+// returns NotFound if params version 0 has been pruned
+func (k Keeper) CovenantCommitteeChanges(ctx context.Context, req *types.QueryCovenantCommitteeChangesRequest) (*types.QueryCovenantCommitteeChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	genesisParams := k.GetParamsByVersion(ctx, 0)
+	if genesisParams == nil {
+		return nil, status.Error(codes.NotFound, "params version 0 is pruned")
+	}
+	p := k.GetParamsWithVersion(ctx)
+
+	genesisPksHex := make([]string, 0, len(genesisParams.CovenantPks))
+	removedPksHex := []string{}
+	for _, pk := range genesisParams.CovenantPks {
+		genesisPksHex = append(genesisPksHex, pk.MarshalHex())
+		if !p.Params.HasCovenantPK(&pk) {
+			removedPksHex = append(removedPksHex, pk.MarshalHex())
+		}
+	}
+	currentPksHex := make([]string, 0, len(p.Params.CovenantPks))
+	addedPksHex := []string{}
+	for _, pk := range p.Params.CovenantPks {
+		currentPksHex = append(currentPksHex, pk.MarshalHex())
+		if !genesisParams.HasCovenantPK(&pk) {
+			addedPksHex = append(addedPksHex, pk.MarshalHex())
+		}
+	}
+
+	return &types.QueryCovenantCommitteeChangesResponse{
+		GenesisCovenantPksHex: genesisPksHex,
+		GenesisCovenantQuorum: genesisParams.CovenantQuorum,
+		CurrentParamsVersion:  p.Version,
+		CurrentCovenantPksHex: currentPksHex,
+		CurrentCovenantQuorum: p.Params.CovenantQuorum,
+		AddedPksHex:           addedPksHex,
+		RemovedPksHex:         removedPksHex,
+	}, nil
+}
+
+// VerifyInclusionProofAt verifies the inclusion proof of the given staking tx
+// as of the BTC tip at the given height, under the current params. It returns
+// ErrBTCHeaderNotRetained if the BTC light client does not retain the header
+// at the given height
+func (k Keeper) VerifyInclusionProofAt(goCtx context.Context, req *types.QueryVerifyInclusionProofAtRequest) (*types.QueryVerifyInclusionProofAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StakingTxInclusionProof == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty inclusion proof")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakingTx, _, err := bbn.NewBTCTxFromHex(req.StakingTxHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staking tx: %v", err)
+	}
+	inclusionProof, err := types.NewParsedProofOfInclusion(req.StakingTxInclusionProof)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid inclusion proof: %v", err)
+	}
+
+	// the BTC light client retains all headers between its base header and
+	// its tip
+	baseHeader := k.btclcKeeper.GetBaseBTCHeader(ctx)
+	if req.BtcHeight < baseHeader.Height {
+		return nil, types.ErrBTCHeaderNotRetained.Wrapf("height %d is below the base header height %d", req.BtcHeight, baseHeader.Height)
+	}
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if req.BtcHeight > btcTip.Height {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is above the BTC tip height %d", req.BtcHeight, btcTip.Height)
+	}
+
+	params := k.GetParams(ctx)
+	btccParams := k.btccKeeper.GetParams(ctx)
+	timeInfo, err := k.verifyInclusionProofAtTip(
+		ctx,
+		btcutil.NewTx(stakingTx),
+		btccParams.BtcConfirmationDepth,
+		req.StakingTime,
+		types.MinimumUnbondingTime(&params, &btccParams),
+		inclusionProof,
+		req.BtcHeight,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid inclusion proof: %v", err)
+	}
+
+	return &types.QueryVerifyInclusionProofAtResponse{
+		InclusionHeight: timeInfo.startHeight,
+		EndHeight:       timeInfo.endHeight,
+	}, nil
+}
+
+// BTCDelegationFinalityProviderStatuses returns the status of each finality
+// provider of the given BTC delegation
+func (k Keeper) BTCDelegationFinalityProviderStatuses(ctx context.Context, req *types.QueryBTCDelegationFinalityProviderStatusesRequest) (*types.QueryBTCDelegationFinalityProviderStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
+	}
+
+	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	fpStatuses := make([]*types.DelegationFinalityProviderStatus, 0, len(btcDel.FpBtcPkList))
+	for i := range btcDel.FpBtcPkList {
+		fpBTCPK := &btcDel.FpBtcPkList[i]
+		fp, err := k.GetFinalityProvider(ctx, *fpBTCPK)
+		if err != nil {
+			// a BTC delegation can only be created under existing finality
+			// providers, which are never removed while having BTC delegations
+			return nil, status.Errorf(codes.Internal, "finality provider %s of the BTC delegation is not found: %v", fpBTCPK.MarshalHex(), err)
+		}
+		fpStatuses = append(fpStatuses, &types.DelegationFinalityProviderStatus{
+			FpBtcPkHex:           fpBTCPK.MarshalHex(),
+			Slashed:              fp.IsSlashed(),
+			Jailed:               fp.IsJailed(),
+			HasActiveDelegations: k.hasActiveBTCDelegation(ctx, fpBTCPK, btcHeight, currentWValue, covenantQuorum),
+		})
+	}
+
+	return &types.QueryBTCDelegationFinalityProviderStatusesResponse{FinalityProviders: fpStatuses}, nil
+}
+
+// BTCNetwork returns the name of the BTC network the chain is configured for,
+// which is the same network the msg server verifies BTC addresses and proofs
+// of possession under
+func (k Keeper) BTCNetwork(ctx context.Context, req *types.QueryBTCNetworkRequest) (*types.QueryBTCNetworkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryBTCNetworkResponse{Network: k.btcNet.Name}, nil
+}
+
+// FinalityProviderDelegationCount returns the number of BTC delegations of
+// the given finality provider under the given status. It only counts the BTC
+// delegations in the index of the finality provider without aggregating
+// their staked amounts
+func (k Keeper) FinalityProviderDelegationCount(ctx context.Context, req *types.QueryFinalityProviderDelegationCountRequest) (*types.QueryFinalityProviderDelegationCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.FpBtcPkHex) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "finality provider BTC public key cannot be empty")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider BTC public key: %v", err)
+	}
+
+	if !k.HasFinalityProvider(ctx, *fpPK) {
+		return nil, status.Error(codes.NotFound, types.ErrFpNotFound.Error())
+	}
+
+	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	iter := k.btcDelegatorFpStore(ctx, fpPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		delBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		btcDels := k.getBTCDelegatorDelegations(ctx, fpPK, delBTCPK)
+		for _, btcDel := range btcDels.Dels {
+			if req.Status == types.BTCDelegationStatus_ANY ||
+				btcDel.GetStatus(btcHeight, currentWValue, covenantQuorum) == req.Status {
+				count++
+			}
+		}
+	}
+
+	return &types.QueryFinalityProviderDelegationCountResponse{Count: count}, nil
+}
+
+// DelegationCountByParamsVersion returns the number of BTC delegations that
+// are not unbonded yet under each params version
+func (k Keeper) DelegationCountByParamsVersion(ctx context.Context, req *types.QueryDelegationCountByParamsVersionRequest) (*types.QueryDelegationCountByParamsVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	countByVersion := map[uint32]uint64{}
+
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		btcDelStatus, err := k.getBTCDelegationStatus(ctx, &btcDel, btcTipHeight, wValue)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if btcDelStatus != types.BTCDelegationStatus_UNBONDED {
+			countByVersion[btcDel.ParamsVersion]++
+		}
+	}
+
+	// retained params versions are contiguous, so iterating over them yields
+	// the counts in ascending order of params version
+	counts := []*types.ParamsVersionDelegationCount{}
+	lastVersion := k.GetParamsWithVersion(ctx).Version
+	for v := k.firstParamsVersion(ctx); v <= lastVersion; v++ {
+		if count := countByVersion[v]; count > 0 {
+			counts = append(counts, &types.ParamsVersionDelegationCount{Version: v, Count: count})
+		}
+	}
+
+	return &types.QueryDelegationCountByParamsVersionResponse{Counts: counts}, nil
+}
+
+// BTCDelegationCovenantSigs returns a paginated list of the covenant adaptor
+// signatures on the slashing tx of the given BTC delegation, one per
+// (covenant PK, finality provider PK) pair, in ascending order of covenant PK
+// and then of finality provider index. The pagination key of an entry is the
+// covenant PK followed by the big-endian finality provider index. Only
+// key-based pagination is supported.
+func (k Keeper) BTCDelegationCovenantSigs(ctx context.Context, req *types.QueryBTCDelegationCovenantSigsRequest) (*types.QueryBTCDelegationCovenantSigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	limit := uint64(query.DefaultLimit)
+	var start []byte
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "only key-based pagination in ascending order is supported")
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		start = req.Pagination.Key
+	}
+
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
+	}
+
+	// sort the covenant signatures by covenant PK without mutating the
+	// BTC delegation
+	covSigs := make([]*types.CovenantAdaptorSignatures, len(btcDel.CovenantSigs))
+	copy(covSigs, btcDel.CovenantSigs)
+	sort.SliceStable(covSigs, func(i, j int) bool {
+		return bytes.Compare(*covSigs[i].CovPk, *covSigs[j].CovPk) < 0
+	})
+
+	entries := []*types.CovenantSlashingSigEntry{}
+	var nextKey []byte
+	for _, covSig := range covSigs {
+		for fpIdx, adaptorSig := range covSig.AdaptorSigs {
+			key := make([]byte, 0, bbn.BIP340PubKeyLen+4)
+			key = append(key, *covSig.CovPk...)
+			key = binary.BigEndian.AppendUint32(key, uint32(fpIdx))
+			if bytes.Compare(key, start) < 0 {
+				continue
+			}
+			if uint64(len(entries)) == limit {
+				nextKey = key
+				break
+			}
+
+			entry := &types.CovenantSlashingSigEntry{
+				CovPkHex:      covSig.CovPk.MarshalHex(),
+				FpIndex:       uint32(fpIdx),
+				AdaptorSigHex: hex.EncodeToString(adaptorSig),
+			}
+			if fpIdx < len(btcDel.FpBtcPkList) {
+				entry.FpBtcPkHex = btcDel.FpBtcPkList[fpIdx].MarshalHex()
+			}
+			entries = append(entries, entry)
+		}
+		if nextKey != nil {
+			break
+		}
+	}
+
+	return &types.QueryBTCDelegationCovenantSigsResponse{
+		CovenantSigs: entries,
+		Pagination:   &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// DelegationsExpiringWithin returns a paginated list of active BTC delegations
+// whose end height is within the given number of BTC blocks of the current BTC
+// tip, i.e., those that will unbond naturally soon unless renewed, in
+// ascending order of end height. It iterates over the index of BTC delegations
+// by end height, so that only the BTC delegations in the range are visited.
+// Only key-based pagination is supported.
+func (k Keeper) DelegationsExpiringWithin(ctx context.Context, req *types.QueryDelegationsExpiringWithinRequest) (*types.QueryDelegationsExpiringWithinResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	// get current BTC height
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	// get value of w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// a BTC delegation is active only if its end height is at least w blocks
+	// after the BTC tip, so the range starts there. Use uint64 so that the
+	// bounds do not overflow
+	start := sdk.Uint64ToBigEndian(uint64(btcTipHeight) + uint64(wValue))
+	// the end of the range is exclusive
+	end := sdk.Uint64ToBigEndian(uint64(btcTipHeight) + uint64(req.NBlocks) + 1)
+
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "only key-based pagination in ascending order is supported")
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		if len(req.Pagination.Key) > 0 {
+			if bytes.Compare(req.Pagination.Key, start) < 0 {
+				return nil, status.Error(codes.InvalidArgument, "pagination key is below the range of active BTC delegations")
+			}
+			start = req.Pagination.Key
+		}
+	}
+
+	btcDels := []*types.BTCDelegationResponse{}
+	var nextKey []byte
+	if bytes.Compare(start, end) < 0 {
+		iter := k.btcDelegationEndHeightStore(ctx).Iterator(start, end)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			if uint64(len(btcDels)) == limit {
+				nextKey = iter.Key()
+				break
+			}
+
+			stakingTxHash, err := chainhash.NewHash(iter.Key()[8:])
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				return nil, status.Errorf(codes.Internal, "indexed BTC delegation %s is not found", stakingTxHash)
+			}
+			delStatus := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+			if delStatus != types.BTCDelegationStatus_ACTIVE {
+				continue
+			}
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, delStatus))
+		}
+	}
+
+	return &types.QueryDelegationsExpiringWithinResponse{
+		BtcTipHeight:   btcTipHeight,
+		BtcDelegations: btcDels,
+		Pagination:     &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// IsStakingTxRegistered returns whether the given staking tx is already
+// registered as a BTC delegation, in which case a new BTC delegation with it
+// is rejected with ErrReusedStakingTx
+func (k Keeper) IsStakingTxRegistered(ctx context.Context, req *types.QueryIsStakingTxRegisteredRequest) (*types.QueryIsStakingTxRegisteredResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staking tx hash %s: %v", req.StakingTxHashHex, err)
+	}
+
+	return &types.QueryIsStakingTxRegisteredResponse{
+		Registered: k.getBTCDelegation(ctx, *stakingTxHash) != nil,
+	}, nil
+}
+
+// BTCDelegationUnbondingStatus returns whether the given BTC delegation is
+// unbonded early by the delegator and whether its covenant unbonding
+// signatures have reached quorum, together with the resulting status
+func (k Keeper) BTCDelegationUnbondingStatus(ctx context.Context, req *types.QueryBTCDelegationUnbondingStatusRequest) (*types.QueryBTCDelegationUnbondingStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	resp := &types.QueryBTCDelegationUnbondingStatusResponse{
+		DelegatorUnbonded:        btcDel.IsUnbondedEarly(),
+		NumCovenantUnbondingSigs: uint32(len(btcDel.BtcUndelegation.CovenantUnbondingSigList)),
+		CovenantUnbondingQuorum:  btcDel.BtcUndelegation.HasCovenantQuorumOnUnbonding(covenantQuorum),
+		Status: btcDel.GetStatus(
+			k.btclcKeeper.GetTipInfo(ctx).Height,
+			k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout,
+			covenantQuorum,
+		),
+	}
+	if resp.DelegatorUnbonded {
+		resp.UnbondingBtcHeight = btcDel.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTxBtcHeight
+	}
+
+	return resp, nil
+}
+
+// SelectiveSlashingEvidenceList returns the submitted selective slashing
+// evidences, ordered by block height descending
+func (k Keeper) SelectiveSlashingEvidenceList(c context.Context, req *types.QuerySelectiveSlashingEvidenceListRequest) (*types.QuerySelectiveSlashingEvidenceListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.selectiveSlashingEvidenceStore(ctx)
+
+	// the evidences are keyed by block height, thus iterating in reverse
+	// yields the latest evidences first
+	pagination := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pagination = *req.Pagination
+	}
+	pagination.Reverse = true
+
+	var evidences []*types.SelectiveSlashingEvidence
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var evidence types.SelectiveSlashingEvidence
+		if err := k.cdc.Unmarshal(value, &evidence); err != nil {
+			return err
+		}
+		evidences = append(evidences, &evidence)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySelectiveSlashingEvidenceListResponse{Evidences: evidences, Pagination: pageRes}, nil
+}
+
+// DelegationsByFpSet returns a paginated list of BTC delegations restaking to
+// exactly the given set of finality providers, in ascending order of staking
+// tx hash
+func (k Keeper) DelegationsByFpSet(ctx context.Context, req *types.QueryDelegationsByFpSetRequest) (*types.QueryDelegationsByFpSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.FpBtcPkHexList) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "finality provider BTC public keys cannot be empty")
+	}
+
+	fpBTCPKs := make([]bbn.BIP340PubKey, 0, len(req.FpBtcPkHexList))
+	for _, fpBTCPKHex := range req.FpBtcPkHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider BTC public key %s: %v", fpBTCPKHex, err)
+		}
+		fpBTCPKs = append(fpBTCPKs, *fpBTCPK)
+	}
+	if dupPK, found := types.FindDup(fpBTCPKs); found {
+		return nil, status.Errorf(codes.InvalidArgument, "duplicated finality provider BTC public key %s", dupPK.MarshalHex())
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationFpSetStore(ctx, types.FpSetHash(fpBTCPKs))
+	btcDels := []*types.BTCDelegationResponse{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		stakingTxHash, err := chainhash.NewHash(key)
+		if err != nil {
+			return err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return types.ErrBTCDelegationNotFound.Wrapf("indexed BTC delegation %s is not found", stakingTxHash)
+		}
+		delStatus := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, delStatus))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsByFpSetResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// BTCDelegationTransactions returns the raw transactions of the BTC delegation
+// with the given staking tx hash, along with the delegator signatures on the
+// slashing txs
+func (k Keeper) BTCDelegationTransactions(ctx context.Context, req *types.QueryBTCDelegationTransactionsRequest) (*types.QueryBTCDelegationTransactionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
+	}
+
+	return types.NewBTCDelegationTransactionsResponse(btcDel), nil
+}
+
+// BTCDelegationScriptPaths returns the taproot script paths of the staking
+// output of the BTC delegation with the given staking tx hash, along with the
+// internal key and the merkle root of the script tree, reconstructed under the
+// params the BTC delegation was validated against
+func (k Keeper) BTCDelegationScriptPaths(ctx context.Context, req *types.QueryBTCDelegationScriptPathsRequest) (*types.QueryBTCDelegationScriptPathsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get staking info: %v", err)
+	}
+	timeLockSpendInfo, err := stakingInfo.TimeLockPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get timelock spend info: %v", err)
+	}
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get unbonding spend info: %v", err)
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slashing spend info: %v", err)
+	}
+
+	timeLockPath, err := types.NewScriptPath(timeLockSpendInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode timelock path: %v", err)
+	}
+	unbondingPath, err := types.NewScriptPath(unbondingSpendInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode unbonding path: %v", err)
+	}
+	slashingPath, err := types.NewScriptPath(slashingSpendInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode slashing path: %v", err)
+	}
+
+	// all script paths share the same internal key and merkle root
+	controlBlock := timeLockSpendInfo.ControlBlock
+	merkleRoot := controlBlock.RootHash(timeLockSpendInfo.RevealedLeaf.Script)
+
+	return &types.QueryBTCDelegationScriptPathsResponse{
+		ParamsVersion:            btcDel.ParamsVersion,
+		InternalKeyHex:           hex.EncodeToString(schnorr.SerializePubKey(controlBlock.InternalKey)),
+		MerkleRootHex:            hex.EncodeToString(merkleRoot),
+		StakingOutputPkScriptHex: hex.EncodeToString(stakingInfo.GetPkScript()),
+		TimelockPath:             timeLockPath,
+		UnbondingPath:            unbondingPath,
+		SlashingPath:             slashingPath,
+	}, nil
+}
+
+// BTCDelegationsByFlags returns a paginated list of BTC delegations matching
+// all the given flag filters, i.e., whether they have an inclusion proof, a
+// quorum of covenant signatures and the delegator's unbonding signature
+func (k Keeper) BTCDelegationsByFlags(ctx context.Context, req *types.QueryBTCDelegationsByFlagsRequest) (*types.QueryBTCDelegationsByFlagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	for _, filter := range []types.FlagFilter{req.HasProof, req.HasQuorum, req.HasUnbondingSig} {
+		if _, ok := types.FlagFilter_name[int32(filter)]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid flag filter %d", filter)
+		}
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	btcDels := []*types.BTCDelegationResponse{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		// skip the BTC delegation as soon as a flag does not match
+		if !req.HasProof.Matches(btcDel.HasInclusionProof()) ||
+			!req.HasQuorum.Matches(btcDel.HasCovenantQuorums(covenantQuorum)) ||
+			!req.HasUnbondingSig.Matches(btcDel.IsUnbondedEarly()) {
+			return false, nil
+		}
+
+		if accumulate {
+			delStatus := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, delStatus))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBTCDelegationsByFlagsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// FindStakingOutputIndex returns the index of the staking output Babylon would
+// select in the given staking tx under the given params version, using the
+// same matching logic as the validation of MsgCreateBTCDelegation
+func (k Keeper) FindStakingOutputIndex(ctx context.Context, req *types.QueryFindStakingOutputIndexRequest) (*types.QueryFindStakingOutputIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTx, _, err := bbn.NewBTCTxFromHex(req.StakingTxHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staking tx: %v", err)
+	}
+	stakerBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.StakerBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker BTC public key: %v", err)
+	}
+	stakerPK, err := types.NewParsedPublicKey(stakerBTCPK)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker BTC public key: %v", err)
+	}
+	fpBTCPKs := make([]bbn.BIP340PubKey, 0, len(req.FpBtcPkHexList))
+	for _, fpBTCPKHex := range req.FpBtcPkHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider BTC public key %s: %v", fpBTCPKHex, err)
+		}
+		fpBTCPKs = append(fpBTCPKs, *fpBTCPK)
+	}
+	fpPKs, err := types.NewParsedPublicKeyList(fpBTCPKs)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider BTC public keys: %v", err)
+	}
+	if req.StakingTime > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "staking time %d must be lower than %d", req.StakingTime, math.MaxUint16)
+	}
+	if req.StakingValue < 0 {
+		return nil, status.Error(codes.InvalidArgument, "staking value must be positive")
+	}
+
+	params := k.GetParamsByVersion(ctx, req.ParamsVersion)
+	if params == nil {
+		return nil, status.Errorf(codes.NotFound, "params version %d is not found", req.ParamsVersion)
+	}
+
+	stakingInfo, stakingOutputIdx, err := types.FindStakingOutputIdx(
+		stakingTx,
+		stakerPK.PublicKey,
+		fpPKs.PublicKeys,
+		uint16(req.StakingTime),
+		btcutil.Amount(req.StakingValue),
+		params,
+		k.btcNet,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFindStakingOutputIndexResponse{
+		StakingOutputIdx:         stakingOutputIdx,
+		StakingOutputPkScriptHex: hex.EncodeToString(stakingInfo.GetPkScript()),
+	}, nil
+}
+
+// CovenantSigningHashes returns the sighashes a covenant member signs for a
+// BTC delegation, derived from the spend info of the params version the BTC
+// delegation was validated against, in the same way as AddCovenantSigs
+// verifies the covenant signatures
+func (k Keeper) CovenantSigningHashes(ctx context.Context, req *types.QueryCovenantSigningHashesRequest) (*types.QueryCovenantSigningHashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get staking info: %v", err)
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slashing spend info: %v", err)
+	}
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get unbonding spend info: %v", err)
+	}
+	unbondingInfo, err := btcDel.GetUnbondingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get unbonding info: %v", err)
+	}
+	unbondingSlashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get unbonding slashing spend info: %v", err)
+	}
+
+	slashingMsgTx, err := btcDel.SlashingTx.ToMsgTx()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse slashing tx: %v", err)
+	}
+	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse unbonding tx: %v", err)
+	}
+	unbondingSlashingMsgTx, err := btcDel.BtcUndelegation.SlashingTx.ToMsgTx()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse unbonding slashing tx: %v", err)
+	}
+
+	slashingSigHash, err := btcstaking.CalcTapscriptSigHash(
+		slashingMsgTx,
+		stakingInfo.StakingOutput,
+		slashingSpendInfo.GetPkScriptPath(),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to calculate slashing tx sighash: %v", err)
+	}
+	unbondingSigHash, err := btcstaking.CalcTapscriptSigHash(
+		unbondingMsgTx,
+		stakingInfo.StakingOutput,
+		unbondingSpendInfo.GetPkScriptPath(),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to calculate unbonding tx sighash: %v", err)
+	}
+	// unbonding tx always has only one output
+	unbondingSlashingSigHash, err := btcstaking.CalcTapscriptSigHash(
+		unbondingSlashingMsgTx,
+		unbondingMsgTx.TxOut[0],
+		unbondingSlashingSpendInfo.GetPkScriptPath(),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to calculate unbonding slashing tx sighash: %v", err)
+	}
+
+	// NOTE: the sighashes are the same for all finality providers, while the
+	// covenant member encrypts an adaptor signature on them by each finality
+	// provider's BTC PK
+	slashingSigHashes := make([]*types.FpCovenantSigningHashes, 0, len(btcDel.FpBtcPkList))
+	for i := range btcDel.FpBtcPkList {
+		slashingSigHashes = append(slashingSigHashes, &types.FpCovenantSigningHashes{
+			FpBtcPkHex:                    btcDel.FpBtcPkList[i].MarshalHex(),
+			SlashingTxSigHashHex:          hex.EncodeToString(slashingSigHash),
+			SlashingUnbondingTxSigHashHex: hex.EncodeToString(unbondingSlashingSigHash),
+		})
+	}
+
+	return &types.QueryCovenantSigningHashesResponse{
+		ParamsVersion:         btcDel.ParamsVersion,
+		SlashingSigHashes:     slashingSigHashes,
+		UnbondingTxSigHashHex: hex.EncodeToString(unbondingSigHash),
+	}, nil
+}
+
+// AllBTCDelegations returns a paginated list of all BTC delegations in the
+// order of their staking tx hashes, optionally filtered by their statuses at
+// the current BTC tip. The pagination key is the raw store key of the BTC
+// delegation
+func (k Keeper) AllBTCDelegations(ctx context.Context, req *types.QueryAllBTCDelegationsRequest) (*types.QueryAllBTCDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	// get current BTC height
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	// get value of w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		if !types.MatchesStatusFilter(status, req.StatusFilter) {
+			return false, nil
+		}
+
+		if accumulate {
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBTCDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// FinalityProviderRewardGauge returns the reward gauge of the finality
+// provider with the given BTC PK
+func (k Keeper) FinalityProviderRewardGauge(c context.Context, req *types.QueryFinalityProviderRewardGaugeRequest) (*types.QueryFinalityProviderRewardGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fp, err := k.GetFinalityProvider(ctx, *fpPK)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "finality provider %s is not found", req.FpBtcPkHex)
+	}
+
+	fpAddr, err := sdk.AccAddressFromBech32(fp.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid address of finality provider %s: %v", req.FpBtcPkHex, err)
+	}
+
+	// a finality provider that has not received any rewards yet does not
+	// have a reward gauge
+	resp := &types.QueryFinalityProviderRewardGaugeResponse{
+		FpAddr:            fp.Addr,
+		Coins:             sdk.NewCoins(),
+		WithdrawnCoins:    sdk.NewCoins(),
+		WithdrawableCoins: sdk.NewCoins(),
+	}
+	if rg := k.iKeeper.GetRewardGauge(ctx, itypes.FinalityProviderType, fpAddr); rg != nil {
+		resp.Coins = rg.Coins
+		resp.WithdrawnCoins = rg.WithdrawnCoins
+		resp.WithdrawableCoins = rg.GetWithdrawableCoins()
+	}
+
+	return resp, nil
+}
+
+// DelegationActivationRate returns the number of BTC delegations activated in
+// each bucket of BTC heights within the given range, where a BTC delegation
+// is activated at its start height
+func (k Keeper) DelegationActivationRate(ctx context.Context, req *types.QueryDelegationActivationRateRequest) (*types.QueryDelegationActivationRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	buckets, err := types.NewActivationRateBuckets(req.FromBtcHeight, req.ToBtcHeight, req.BucketSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		// the start height of a BTC delegation is not known until its
+		// inclusion proof is submitted
+		if !btcDel.HasInclusionProof() {
+			continue
+		}
+		if btcDel.StartHeight < req.FromBtcHeight || btcDel.StartHeight > req.ToBtcHeight {
+			continue
+		}
+		buckets[(btcDel.StartHeight-req.FromBtcHeight)/req.BucketSize].Count++
+	}
+
+	return &types.QueryDelegationActivationRateResponse{Buckets: buckets}, nil
+}
+
+// StakerDelegationAttestation returns all BTC delegations of the given staker
+// in ascending order of staking tx hash bytes, together with their digest
+func (k Keeper) StakerDelegationAttestation(ctx context.Context, req *types.QueryStakerDelegationAttestationRequest) (*types.QueryStakerDelegationAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakerAddr, err := sdk.AccAddressFromBech32(req.StakerAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker address: %v", err)
+	}
+
+	// the staker index is keyed by staking tx hash bytes, so iterating it
+	// yields a deterministic order
+	iter := k.btcDelegationStakerStore(ctx, stakerAddr).Iterator(nil, nil)
+	defer iter.Close()
+
+	dels := []*types.StakerDelegationEntry{}
+	totalSat := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Key())
+		if err != nil {
+			// failing to unmarshal the key of the index is a programming error
+			panic(err)
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		dels = append(dels, &types.StakerDelegationEntry{
+			StakingTxHashHex: stakingTxHash.String(),
+			TotalSat:         btcDel.TotalSat,
+		})
+		totalSat += btcDel.TotalSat
+	}
+
+	digest, err := types.StakerDelegationsDigest(dels)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStakerDelegationAttestationResponse{
+		StakerAddr:  stakerAddr.String(),
+		Delegations: dels,
+		TotalSat:    totalSat,
+		DigestHex:   hex.EncodeToString(digest),
+	}, nil
+}
+
+// FinalityProviderRewardBreakdown returns the rewards of the finality provider
+// with the given BTC PK split into its commission and the rewards of its
+// self-delegations
+func (k Keeper) FinalityProviderRewardBreakdown(c context.Context, req *types.QueryFinalityProviderRewardBreakdownRequest) (*types.QueryFinalityProviderRewardBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fp, err := k.GetFinalityProvider(ctx, *fpPK)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "finality provider %s is not found", req.FpBtcPkHex)
+	}
+
+	fpAddr, err := sdk.AccAddressFromBech32(fp.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid address of finality provider %s: %v", req.FpBtcPkHex, err)
+	}
+
+	selfDelCoins, err := k.iKeeper.GetFpSelfDelRewards(ctx, fpPK.MustMarshal())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// a finality provider that has not received any rewards yet does not
+	// have a reward gauge
+	resp := &types.QueryFinalityProviderRewardBreakdownResponse{
+		FpAddr:                      fp.Addr,
+		CommissionCoins:             sdk.NewCoins(),
+		WithdrawableCommissionCoins: sdk.NewCoins(),
+		SelfDelegationCoins:         selfDelCoins,
+	}
+	if rg := k.iKeeper.GetRewardGauge(ctx, itypes.FinalityProviderType, fpAddr); rg != nil {
+		resp.CommissionCoins = rg.Coins
+		resp.WithdrawableCommissionCoins = rg.GetWithdrawableCoins()
+	}
+
+	return resp, nil
+}
+
+// CanReachCovenantQuorum returns whether the BTC delegation with the given
+// staking tx hash can still reach the covenant quorum of the params it was
+// validated against. A committee member that has not signed yet is considered
+// available only if it remains in the covenant committee under the latest
+// params
+func (k Keeper) CanReachCovenantQuorum(ctx context.Context, req *types.QueryCanReachCovenantQuorumRequest) (*types.QueryCanReachCovenantQuorumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	latestParams := k.GetParams(ctx)
+	signedCount := uint32(0)
+	missingPksHex := []string{}
+	unavailablePksHex := []string{}
+	for i := range params.CovenantPks {
+		covPk := &params.CovenantPks[i]
+		if btcDel.IsSignedByCovMember(covPk) {
+			signedCount++
+			continue
+		}
+		missingPksHex = append(missingPksHex, covPk.MarshalHex())
+		if !latestParams.HasCovenantPK(covPk) {
+			unavailablePksHex = append(unavailablePksHex, covPk.MarshalHex())
+		}
+	}
+	numAvailable := uint32(len(missingPksHex) - len(unavailablePksHex))
+
+	return &types.QueryCanReachCovenantQuorumResponse{
+		ParamsVersion:            btcDel.ParamsVersion,
+		CovenantQuorum:           params.CovenantQuorum,
+		SignedCount:              signedCount,
+		CanReachQuorum:           signedCount+numAvailable >= params.CovenantQuorum,
+		MissingSignersPksHex:     missingPksHex,
+		UnavailableSignersPksHex: unavailablePksHex,
+	}, nil
+}
+
+// BTCTip returns the tip of the BTC light client that the module uses for
+// computing the status of BTC delegations
+func (k Keeper) BTCTip(ctx context.Context, req *types.QueryBTCTipRequest) (*types.QueryBTCTipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return nil, status.Error(codes.NotFound, "BTC tip is not available")
+	}
+
+	return &types.QueryBTCTipResponse{
+		Height:  btcTip.Height,
+		HashHex: btcTip.Hash.MarshalHex(),
+	}, nil
+}
+
+// DelegationsWithUnbondingScheduleIssues returns a paginated list of BTC
+// delegations with inclusion proof whose end height does not exceed their
+// minimum unbonding time, such that the BTC height `EndHeight -
+// minUnbondingTime` at which they are unbonded underflows. The minimum
+// unbonding time is taken from the params the BTC delegation was validated
+// against, or is the checkpoint finalization timeout if they are pruned
+func (k Keeper) DelegationsWithUnbondingScheduleIssues(ctx context.Context, req *types.QueryDelegationsWithUnbondingScheduleIssuesRequest) (*types.QueryDelegationsWithUnbondingScheduleIssuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btccParams := k.btccKeeper.GetParams(ctx)
+
+	store := k.btcDelegationStore(ctx)
+	issues := []*types.UnbondingScheduleIssue{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		if !btcDel.HasInclusionProof() {
+			return false, nil
+		}
+		minUnbondingTime := btccParams.CheckpointFinalizationTimeout
+		if params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion); params != nil {
+			minUnbondingTime = types.MinimumUnbondingTime(params, &btccParams)
+		}
+		if btcDel.EndHeight > minUnbondingTime {
+			return false, nil
+		}
+
+		if accumulate {
+			issues = append(issues, &types.UnbondingScheduleIssue{
+				StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+				StartHeight:      btcDel.StartHeight,
+				EndHeight:        btcDel.EndHeight,
+				MinUnbondingTime: minUnbondingTime,
+				UnbondingHeight:  btcDel.EndHeight - minUnbondingTime,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsWithUnbondingScheduleIssuesResponse{
+		Issues:     issues,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FinalityProviderStats returns the number of finality providers in total and
+// under each status. A finality provider is slashed, jailed, active, or
+// dormant, checked in this order
+func (k Keeper) FinalityProviderStats(ctx context.Context, req *types.QueryFinalityProviderStatsRequest) (*types.QueryFinalityProviderStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	resp := &types.QueryFinalityProviderStatsResponse{}
+
+	iter := k.finalityProviderStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fp types.FinalityProvider
+		k.cdc.MustUnmarshal(iter.Value(), &fp)
+
+		resp.Total++
+		switch {
+		case fp.IsSlashed():
+			resp.Slashed++
+		case fp.IsJailed():
+			resp.Jailed++
+		case k.hasActiveBTCDelegation(ctx, fp.BtcPk, btcHeight, currentWValue, covenantQuorum):
+			resp.Active++
+		default:
+			resp.Dormant++
+		}
+	}
+
+	return resp, nil
+}
+
+// BTCDelegationCovenantUnbondingSigs returns the covenant Schnorr signatures
+// on the unbonding tx of the given BTC delegation, ordered by covenant PK
+func (k Keeper) BTCDelegationCovenantUnbondingSigs(ctx context.Context, req *types.QueryBTCDelegationCovenantUnbondingSigsRequest) (*types.QueryBTCDelegationCovenantUnbondingSigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcDel, err := k.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, btcDelegationStatusError(err)
+	}
+
+	entries := []*types.CovenantUnbondingSigEntry{}
+	if btcDel.BtcUndelegation == nil {
+		return &types.QueryBTCDelegationCovenantUnbondingSigsResponse{CovenantUnbondingSigs: entries}, nil
+	}
+
+	// sort the covenant signatures by covenant PK without mutating the
+	// BTC delegation
+	sigs := make([]*types.SignatureInfo, len(btcDel.BtcUndelegation.CovenantUnbondingSigList))
+	copy(sigs, btcDel.BtcUndelegation.CovenantUnbondingSigList)
+	sort.SliceStable(sigs, func(i, j int) bool {
+		return bytes.Compare(*sigs[i].Pk, *sigs[j].Pk) < 0
+	})
+
+	for _, sig := range sigs {
+		entries = append(entries, &types.CovenantUnbondingSigEntry{
+			CovPkHex: sig.Pk.MarshalHex(),
+			SigHex:   sig.Sig.ToHexStr(),
+		})
+	}
+
+	return &types.QueryBTCDelegationCovenantUnbondingSigsResponse{CovenantUnbondingSigs: entries}, nil
+}
+
+// BTCDelegationSlashingRate returns the slashing rate and the slashing
+// destinations under the params version of the given BTC delegation, which
+// may differ from the ones in the current params
+func (k Keeper) BTCDelegationSlashingRate(ctx context.Context, req *types.QueryBTCDelegationSlashingRateRequest) (*types.QueryBTCDelegationSlashingRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	btcDel, params, err := k.queryBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	// the slashed funds go to the single slashing pk_script if the params
+	// have no slashing destinations
+	btcSlashingDestinations := params.BTCSlashingDestinations()
+	slashingDestinations := make([]types.SlashingDestination, 0, len(btcSlashingDestinations))
+	for _, d := range btcSlashingDestinations {
+		slashingDestinations = append(slashingDestinations, types.SlashingDestination{
+			PkScript: d.PkScript,
+			Weight:   d.Weight,
+		})
+	}
+
+	return &types.QueryBTCDelegationSlashingRateResponse{
+		ParamsVersion:        btcDel.ParamsVersion,
+		SlashingRate:         params.SlashingRate,
+		SlashingPkScriptHex:  hex.EncodeToString(params.SlashingPkScript),
+		SlashingDestinations: slashingDestinations,
+	}, nil
+}
+
+// DelegationsAffectedBySlashing returns the BTC delegations to the given
+// slashed finality provider that became active, i.e., got included on BTC and
+// received a quorum of covenant signatures, together with their amounts.
+// Pagination is over the BTC delegators of the finality provider
+func (k Keeper) DelegationsAffectedBySlashing(c context.Context, req *types.QueryDelegationsAffectedBySlashingRequest) (*types.QueryDelegationsAffectedBySlashingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fp, err := k.GetFinalityProvider(ctx, *fpPK)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "finality provider %s is not found", req.FpBtcPkHex)
+	}
+	if !fp.IsSlashed() {
+		return nil, status.Errorf(codes.FailedPrecondition, "finality provider %s is not slashed", req.FpBtcPkHex)
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	resp := &types.QueryDelegationsAffectedBySlashingResponse{
+		SlashedBabylonHeight: fp.SlashedBabylonHeight,
+		SlashedBtcHeight:     fp.SlashedBtcHeight,
+		Delegations:          []*types.AffectedBTCDelegation{},
+	}
+
+	btcDelStore := k.btcDelegatorFpStore(ctx, fpPK)
+	pageRes, err := query.Paginate(btcDelStore, req.Pagination, func(key, _ []byte) error {
+		delBTCPK, err := bbn.NewBIP340PubKey(key)
+		if err != nil {
+			return err
+		}
+
+		btcDels := k.getBTCDelegatorDelegations(ctx, fpPK, delBTCPK)
+		for _, btcDel := range btcDels.Dels {
+			if !btcDel.HasInclusionProof() || !btcDel.HasCovenantQuorums(covenantQuorum) {
+				continue
+			}
+			resp.Delegations = append(resp.Delegations, &types.AffectedBTCDelegation{
+				StakerAddr:       btcDel.StakerAddr,
+				BtcPkHex:         btcDel.BtcPk.MarshalHex(),
+				StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+				TotalSat:         btcDel.TotalSat,
+			})
+			resp.TotalSat += btcDel.TotalSat
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+// RefundableBTCDelegations returns a paginated list of the staking tx hashes
+// of the BTC delegations of the given staker that did not receive a covenant
+// quorum before their deadline, and whose refunds are not claimed yet, in
+// ascending order of staking tx hash bytes
+func (k Keeper) RefundableBTCDelegations(c context.Context, req *types.QueryRefundableBTCDelegationsRequest) (*types.QueryRefundableBTCDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakerAddr, err := sdk.AccAddressFromBech32(req.StakerAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.refundableBTCDelegationStore(ctx, stakerAddr)
+	stakingTxHashes := []string{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		stakingTxHash, err := chainhash.NewHash(key)
+		if err != nil {
+			return err
+		}
+		stakingTxHashes = append(stakingTxHashes, stakingTxHash.String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRefundableBTCDelegationsResponse{
+		StakingTxHashHexList: stakingTxHashes,
+		Pagination:           pageRes,
+	}, nil
+}
+
+// hasActiveBTCDelegation returns whether the given finality provider has at
+// least one BTC delegation that is active at the given BTC height
+func (k Keeper) hasActiveBTCDelegation(
+	ctx context.Context,
+	fpBTCPK *bbn.BIP340PubKey,
+	btcHeight uint32,
+	w uint32,
+	covenantQuorum uint32,
+) bool {
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		delBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			// failing to unmarshal the key of the BTC delegator index is a
+			// programming error
+			panic(err)
+		}
+		btcDels := k.getBTCDelegatorDelegations(ctx, fpBTCPK, delBTCPK)
+		for _, btcDel := range btcDels.Dels {
+			if btcDel.GetStatus(btcHeight, w, covenantQuorum) == types.BTCDelegationStatus_ACTIVE {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// queryBTCDelWithParams is the variant of getBTCDelWithParams for query
+// handlers. It returns a gRPC status error if the BTC delegation is not found
+// or references a params version that is not found
+func (k Keeper) queryBTCDelWithParams(
+	ctx context.Context,
+	stakingTxHashHex string,
+) (*types.BTCDelegation, *types.Params, error) {
+	btcDel, err := k.GetBTCDelegation(ctx, stakingTxHashHex)
+	if err != nil {
+		return nil, nil, btcDelegationStatusError(err)
+	}
+
+	params, err := k.getBTCDelegationParams(ctx, btcDel)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return btcDel, params, nil
+}
+
+// btcDelegationStatusError maps an error returned by GetBTCDelegation to the
+// gRPC status error with the corresponding code
+func btcDelegationStatusError(err error) error {
+	switch {
+	case errors.Is(err, types.ErrInvalidStakingTxHash):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, types.ErrBTCDelegationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -1,20 +1,32 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"math"
 	"math/rand"
+	"sort"
 	"testing"
 
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
+	testutil "github.com/babylonlabs-io/babylon/testutil/btcstaking-helper"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
 	bbn "github.com/babylonlabs-io/babylon/types"
@@ -22,6 +34,7 @@ import (
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	btcstakingkeeper "github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	itypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 )
 
 var net = &chaincfg.SimNetParams
@@ -373,6 +386,556 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 	})
 }
 
+func FuzzDelegationsByParamsVersion(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations, each referencing one
+		// of the 3 params versions
+		numVersions := uint32(3)
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		btcDelsByVersion := make(map[uint32]map[string]bool)
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			btcDel.ParamsVersion = uint32(r.Intn(int(numVersions)))
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+
+			if btcDelsByVersion[btcDel.ParamsVersion] == nil {
+				btcDelsByVersion[btcDel.ParamsVersion] = make(map[string]bool)
+			}
+			btcDelsByVersion[btcDel.ParamsVersion][btcDel.MustGetStakingTxHash().String()] = true
+		}
+
+		// querying paginated BTC delegations of each params version and assert
+		for version := uint32(0); version < numVersions; version++ {
+			limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+			pagination := constructRequestWithLimit(r, limit)
+			req := &types.QueryDelegationsByParamsVersionRequest{
+				Version:    version,
+				Pagination: pagination,
+			}
+			found := make(map[string]bool)
+			for {
+				resp, err := keeper.DelegationsByParamsVersion(ctx, req)
+				require.NoError(t, err)
+				for _, btcDel := range resp.BtcDelegations {
+					require.Equal(t, version, btcDel.ParamsVersion)
+					stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+					require.NoError(t, err)
+					found[stakingTx.TxHash().String()] = true
+				}
+				if len(resp.Pagination.NextKey) == 0 {
+					break
+				}
+				// Construct the next page request
+				pagination.Key = resp.Pagination.NextKey
+			}
+			require.Len(t, found, len(btcDelsByVersion[version]))
+			for stakingTxHash := range btcDelsByVersion[version] {
+				require.True(t, found[stakingTxHash])
+			}
+		}
+	})
+}
+
+func FuzzBTCDelegationsByValueRange(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations with random staking
+		// values, where some of them share the same staking value
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		valueOf := make(map[string]uint64)
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			totalSat := 10000 + datagen.RandomInt(r, 10)*1000
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, totalSat,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+			valueOf[btcDel.MustGetStakingTxHash().String()] = totalSat
+		}
+
+		// querying paginated BTC delegations in a random value range and assert
+		minSat := 10000 + datagen.RandomInt(r, 10)*1000
+		maxSat := minSat + datagen.RandomInt(r, 10)*1000
+		expected := make(map[string]bool)
+		for stakingTxHash, totalSat := range valueOf {
+			if minSat <= totalSat && totalSat <= maxSat {
+				expected[stakingTxHash] = true
+			}
+		}
+
+		limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+		pagination := constructRequestWithLimit(r, limit)
+		req := &types.QueryBTCDelegationsByValueRangeRequest{
+			MinSat:     minSat,
+			MaxSat:     maxSat,
+			Pagination: pagination,
+		}
+		found := make(map[string]bool)
+		lastValue := uint64(0)
+		for {
+			resp, err := keeper.BTCDelegationsByValueRange(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.BtcDelegations)), limit)
+			for _, btcDel := range resp.BtcDelegations {
+				// BTC delegations are within the range, in ascending order of value
+				require.GreaterOrEqual(t, btcDel.TotalSat, minSat)
+				require.LessOrEqual(t, btcDel.TotalSat, maxSat)
+				require.GreaterOrEqual(t, btcDel.TotalSat, lastValue)
+				lastValue = btcDel.TotalSat
+
+				stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+				require.NoError(t, err)
+				found[stakingTx.TxHash().String()] = true
+			}
+			if len(resp.Pagination.NextKey) == 0 {
+				break
+			}
+			// Construct the next page request
+			pagination.Key = resp.Pagination.NextKey
+		}
+		require.Equal(t, expected, found)
+
+		// invalid range
+		_, err = keeper.BTCDelegationsByValueRange(ctx, &types.QueryBTCDelegationsByValueRangeRequest{
+			MinSat: maxSat + 1,
+			MaxSat: maxSat,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func FuzzVerifyCovenantSlashingSig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// signatures of each covenant member are valid
+		for _, msg := range msgs {
+			resp, err := h.BTCStakingKeeper.VerifyCovenantSlashingSig(h.Ctx, &types.QueryVerifyCovenantSlashingSigRequest{
+				StakingTxHashHex: stakingTxHash,
+				CovenantPkHex:    msg.Pk.MarshalHex(),
+				SlashingTxSigs:   msg.SlashingTxSigs,
+			})
+			h.NoError(err)
+			require.Equal(t, actualDel.ParamsVersion, resp.ParamsVersion)
+			require.Len(t, resp.Results, 1)
+			require.Equal(t, bbn.NewBIP340PubKeyFromBTCPK(fpPK).MarshalHex(), resp.Results[0].FpBtcPkHex)
+			require.True(t, resp.Results[0].Valid)
+			require.Empty(t, resp.Results[0].Error)
+		}
+
+		// signatures of a covenant member are invalid under another member's PK
+		resp, err := h.BTCStakingKeeper.VerifyCovenantSlashingSig(h.Ctx, &types.QueryVerifyCovenantSlashingSigRequest{
+			StakingTxHashHex: stakingTxHash,
+			CovenantPkHex:    msgs[1].Pk.MarshalHex(),
+			SlashingTxSigs:   msgs[0].SlashingTxSigs,
+		})
+		h.NoError(err)
+		require.Len(t, resp.Results, 1)
+		require.False(t, resp.Results[0].Valid)
+		require.NotEmpty(t, resp.Results[0].Error)
+
+		// covenant PK not in the committee
+		_, randPK, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.VerifyCovenantSlashingSig(h.Ctx, &types.QueryVerifyCovenantSlashingSigRequest{
+			StakingTxHashHex: stakingTxHash,
+			CovenantPkHex:    bbn.NewBIP340PubKeyFromBTCPK(randPK).MarshalHex(),
+			SlashingTxSigs:   msgs[0].SlashingTxSigs,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidCovenantPK)
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.VerifyCovenantSlashingSig(h.Ctx, &types.QueryVerifyCovenantSlashingSigRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+			CovenantPkHex:    msgs[0].Pk.MarshalHex(),
+			SlashingTxSigs:   msgs[0].SlashingTxSigs,
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		// BTC delegation referencing a params version that is not found
+		corruptedDel := *actualDel
+		corruptedDel.ParamsVersion = 999
+		corruptedDelBytes, err := corruptedDel.Marshal()
+		h.NoError(err)
+		corruptedStakingTxHash := corruptedDel.MustGetStakingTxHash()
+		h.BTCStakingKeeper.BTCDelegationStore(h.Ctx).Set(corruptedStakingTxHash[:], corruptedDelBytes)
+		require.NotPanics(t, func() {
+			_, err = h.BTCStakingKeeper.VerifyCovenantSlashingSig(h.Ctx, &types.QueryVerifyCovenantSlashingSigRequest{
+				StakingTxHashHex: stakingTxHash,
+				CovenantPkHex:    msgs[0].Pk.MarshalHex(),
+				SlashingTxSigs:   msgs[0].SlashingTxSigs,
+			})
+		})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Contains(t, err.Error(), "params version 999")
+	})
+}
+
+func FuzzBTCDelegationSignatureReadiness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		quorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		usePreApproval := datagen.OneInN(r, 2)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			usePreApproval,
+		)
+		h.NoError(err)
+
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// submit covenant signatures one by one, and the BTC delegation
+		// becomes ready once a quorum of covenant members has signed
+		for i := 0; i <= len(msgs); i++ {
+			resp, err := h.BTCStakingKeeper.BTCDelegationSignatureReadiness(h.Ctx, &types.QueryBTCDelegationSignatureReadinessRequest{
+				StakingTxHashHex: stakingTxHash,
+			})
+			h.NoError(err)
+			require.Equal(t, actualDel.ParamsVersion, resp.ParamsVersion)
+			require.Equal(t, quorum, resp.CovenantQuorum)
+			require.Equal(t, !usePreApproval, resp.HasInclusionProof)
+
+			hasQuorum := i >= int(quorum)
+			require.Len(t, resp.FpReadiness, 1)
+			require.Equal(t, bbn.NewBIP340PubKeyFromBTCPK(fpPK).MarshalHex(), resp.FpReadiness[0].FpBtcPkHex)
+			require.Equal(t, hasQuorum, resp.FpReadiness[0].StakingSlashingQuorum)
+			require.Equal(t, hasQuorum, resp.FpReadiness[0].UnbondingSlashingQuorum)
+			require.Equal(t, hasQuorum, resp.UnbondingQuorum)
+			require.Equal(t, hasQuorum, resp.ActivationReady)
+
+			if i < len(msgs) {
+				_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+				h.NoError(err)
+			}
+		}
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.BTCDelegationSignatureReadiness(h.Ctx, &types.QueryBTCDelegationSignatureReadinessRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func FuzzBTCDelegationCovenantCoverage(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		quorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			datagen.OneInN(r, 2),
+		)
+		h.NoError(err)
+
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// submit covenant signatures one by one, and the coverage grows
+		// until the quorum is reached
+		for i := 0; i <= len(msgs); i++ {
+			resp, err := h.BTCStakingKeeper.BTCDelegationCovenantCoverage(h.Ctx, &types.QueryBTCDelegationCovenantCoverageRequest{
+				StakingTxHashHex: stakingTxHash,
+			})
+			h.NoError(err)
+			require.Equal(t, actualDel.ParamsVersion, resp.ParamsVersion)
+			require.Equal(t, uint32(i), resp.SignedCount)
+			require.Equal(t, quorum, resp.RequiredQuorum)
+
+			expectedRatio := sdkmath.LegacyNewDec(int64(i)).QuoInt64(int64(quorum))
+			if i >= int(quorum) {
+				expectedRatio = sdkmath.LegacyOneDec()
+			}
+			require.True(t, expectedRatio.Equal(resp.CoverageRatio))
+
+			if i < len(msgs) {
+				_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+				h.NoError(err)
+			}
+		}
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.BTCDelegationCovenantCoverage(h.Ctx, &types.QueryBTCDelegationCovenantCoverageRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func FuzzBTCDelegationFinalityProviderStatuses(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation with inclusion proof
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+
+		assertStatus := func(slashed, hasActiveDelegations bool) {
+			resp, err := h.BTCStakingKeeper.BTCDelegationFinalityProviderStatuses(h.Ctx, &types.QueryBTCDelegationFinalityProviderStatusesRequest{
+				StakingTxHashHex: stakingTxHash,
+			})
+			h.NoError(err)
+			require.Len(t, resp.FinalityProviders, 1)
+			require.Equal(t, fp.BtcPk.MarshalHex(), resp.FinalityProviders[0].FpBtcPkHex)
+			require.Equal(t, slashed, resp.FinalityProviders[0].Slashed)
+			require.False(t, resp.FinalityProviders[0].Jailed)
+			require.Equal(t, hasActiveDelegations, resp.FinalityProviders[0].HasActiveDelegations)
+		}
+
+		// the BTC delegation is pending, so the finality provider is dormant
+		assertStatus(false, false)
+
+		// the BTC delegation becomes active upon covenant quorum
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		assertStatus(false, true)
+
+		// the slashed finality provider retains its BTC delegations
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), types.SlashingReason_SLASHING_REASON_EQUIVOCATION)
+		h.NoError(err)
+		assertStatus(true, true)
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.BTCDelegationFinalityProviderStatuses(h.Ctx, &types.QueryBTCDelegationFinalityProviderStatusesRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestBTCDelegationNotFoundAndInternalErrors(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	// invalid staking tx hash
+	_, err := keeper.GetBTCDelegation(ctx, "invalid")
+	require.ErrorIs(t, err, types.ErrInvalidStakingTxHash)
+	_, err = keeper.BTCDelegation(ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// BTC delegation that does not exist
+	stakingTxHash := datagen.GenRandomBtcdHash(r)
+	_, err = keeper.GetBTCDelegation(ctx, stakingTxHash.String())
+	require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	_, err = keeper.BTCDelegation(ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// BTC delegation that cannot be decoded
+	keeper.BTCDelegationStore(ctx).Set(stakingTxHash[:], []byte{0xff, 0xff, 0xff})
+	_, err = keeper.GetBTCDelegation(ctx, stakingTxHash.String())
+	require.Error(t, err)
+	require.NotErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	_, err = keeper.BTCDelegation(ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash.String()})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
 // Constructors for PageRequest objects
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
@@ -402,3 +965,2355 @@ func AddFinalityProvider(t *testing.T, goCtx context.Context, k btcstakingkeeper
 	})
 	require.NoError(t, err)
 }
+
+func FuzzNextPowerDistUpdateHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context with a BTC tip that can be moved
+		btcTipHeight := uint32(datagen.RandomInt(r, 1000)) + 1
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).DoAndReturn(func(_ context.Context) *btclctypes.BTCHeaderInfo {
+			return &btclctypes.BTCHeaderInfo{Height: btcTipHeight}
+		}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// no event is scheduled
+		resp, err := keeper.NextPowerDistUpdateHeight(ctx, &types.QueryNextPowerDistUpdateHeightRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.Found)
+
+		// schedule events at random BTC heights before and after the BTC tip
+		heights := []uint32{}
+		numEvents := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numEvents; i++ {
+			height := uint32(datagen.RandomInt(r, 2000)) + 1
+			event := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+				StakingTxHash: datagen.GenRandomBtcdHash(r).String(),
+				NewState:      types.BTCDelegationStatus_UNBONDED,
+			})
+			keeper.AddPowerDistUpdateEvent(ctx, height, event)
+			heights = append(heights, height)
+		}
+
+		// the result is the smallest scheduled BTC height no smaller than the BTC tip
+		expectedHeight := func() (uint32, bool) {
+			found := false
+			next := uint32(0)
+			for _, height := range heights {
+				if height >= btcTipHeight && (!found || height < next) {
+					next = height
+					found = true
+				}
+			}
+			return next, found
+		}
+
+		for _, tip := range []uint32{btcTipHeight, 0, 2001} {
+			btcTipHeight = tip
+			resp, err = keeper.NextPowerDistUpdateHeight(ctx, &types.QueryNextPowerDistUpdateHeightRequest{})
+			require.NoError(t, err)
+			expected, found := expectedHeight()
+			require.Equal(t, found, resp.Found)
+			require.Equal(t, expected, resp.BtcHeight)
+		}
+	})
+}
+
+func FuzzCommissionRateBounds(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// set a random minimum commission rate
+		params := types.DefaultParams()
+		params.MinCommissionRate = sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 101)), 2)
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		resp, err := keeper.CommissionRateBounds(ctx, &types.QueryCommissionRateBoundsRequest{})
+		require.NoError(t, err)
+		require.True(t, params.MinCommissionRate.Equal(resp.MinCommissionRate))
+		require.True(t, sdkmath.LegacyOneDec().Equal(resp.MaxCommissionRate))
+	})
+}
+
+func FuzzCovenantInfo(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// set a new params version with a random covenant committee
+		_, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		resp, err := keeper.CovenantInfo(ctx, &types.QueryCovenantInfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, keeper.GetParamsWithVersion(ctx).Version, resp.ParamsVersion)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, uint32(len(covenantPKs)), resp.CommitteeSize)
+		require.Len(t, resp.CovenantPksHex, len(covenantPKs))
+		for i, pk := range params.CovenantPks {
+			require.Equal(t, pk.MarshalHex(), resp.CovenantPksHex[i])
+		}
+		expectedThreshold := sdkmath.LegacyNewDec(int64(covenantQuorum * 100)).QuoInt64(int64(len(covenantPKs)))
+		require.True(t, expectedThreshold.Equal(resp.ThresholdPercentage))
+	})
+}
+
+func FuzzCovenantCommitteeChanges(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		genesisParams := keeper.GetParamsByVersion(ctx, 0)
+		require.NotNil(t, genesisParams)
+		pksToHex := func(pks []bbn.BIP340PubKey) []string {
+			pksHex := make([]string, 0, len(pks))
+			for _, pk := range pks {
+				pksHex = append(pksHex, pk.MarshalHex())
+			}
+			return pksHex
+		}
+
+		// without any change, nothing is added or removed
+		resp, err := keeper.CovenantCommitteeChanges(ctx, &types.QueryCovenantCommitteeChangesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, resp.GenesisCovenantPksHex, resp.CurrentCovenantPksHex)
+		require.Empty(t, resp.AddedPksHex)
+		require.Empty(t, resp.RemovedPksHex)
+
+		// set a new params version retaining a random subset of the genesis
+		// covenant committee and adding random new members
+		var (
+			newPks          []bbn.BIP340PubKey
+			expectedAdded   []string
+			expectedRemoved []string
+		)
+		for _, pk := range genesisParams.CovenantPks {
+			if r.Intn(2) == 0 {
+				newPks = append(newPks, pk)
+			} else {
+				expectedRemoved = append(expectedRemoved, pk.MarshalHex())
+			}
+		}
+		numAdded := int(datagen.RandomInt(r, 5)) + 1
+		for i := 0; i < numAdded; i++ {
+			pk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			newPks = append(newPks, *pk)
+			expectedAdded = append(expectedAdded, pk.MarshalHex())
+		}
+		params := types.DefaultParams()
+		params.CovenantPks = newPks
+		params.CovenantQuorum = uint32(len(newPks)/2 + 1)
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		resp, err = keeper.CovenantCommitteeChanges(ctx, &types.QueryCovenantCommitteeChangesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, pksToHex(genesisParams.CovenantPks), resp.GenesisCovenantPksHex)
+		require.Equal(t, genesisParams.CovenantQuorum, resp.GenesisCovenantQuorum)
+		require.Equal(t, keeper.GetParamsWithVersion(ctx).Version, resp.CurrentParamsVersion)
+		require.Equal(t, pksToHex(newPks), resp.CurrentCovenantPksHex)
+		require.Equal(t, params.CovenantQuorum, resp.CurrentCovenantQuorum)
+		require.ElementsMatch(t, expectedAdded, resp.AddedPksHex)
+		require.ElementsMatch(t, expectedRemoved, resp.RemovedPksHex)
+	})
+}
+
+func FuzzVerifyInclusionProofAt(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTime := uint16(1000)
+		_, msgCreateBTCDel, _, headerInfo, inclusionProof, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			stakingTime,
+			0,
+			0,
+			true,
+		)
+		h.NoError(err)
+
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		confirmationDepth := btccKeeper.GetParams(h.Ctx).BtcConfirmationDepth
+
+		req := &types.QueryVerifyInclusionProofAtRequest{
+			StakingTxHex:            hex.EncodeToString(msgCreateBTCDel.StakingTx),
+			StakingTime:             uint32(stakingTime),
+			StakingTxInclusionProof: inclusionProof,
+		}
+
+		// the staking tx is k-deep as of any height between the
+		// k-deep height and the BTC tip
+		req.BtcHeight = headerInfo.Height + confirmationDepth + uint32(datagen.RandomInt(r, int(btcTip.Height-headerInfo.Height-confirmationDepth+1)))
+		resp, err := h.BTCStakingKeeper.VerifyInclusionProofAt(h.Ctx, req)
+		h.NoError(err)
+		require.Equal(t, headerInfo.Height, resp.InclusionHeight)
+		require.Equal(t, headerInfo.Height+uint32(stakingTime), resp.EndHeight)
+
+		// the staking tx is not k-deep yet as of a height before the k-deep
+		// height
+		req.BtcHeight = headerInfo.Height + uint32(datagen.RandomInt(r, int(confirmationDepth)))
+		_, err = h.BTCStakingKeeper.VerifyInclusionProofAt(h.Ctx, req)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// the staking tx is not included yet as of a height before the
+		// inclusion height
+		req.BtcHeight = headerInfo.Height - 1
+		_, err = h.BTCStakingKeeper.VerifyInclusionProofAt(h.Ctx, req)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// the header above the BTC tip does not exist yet
+		req.BtcHeight = btcTip.Height + 1
+		_, err = h.BTCStakingKeeper.VerifyInclusionProofAt(h.Ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// the header below the base header of the BTC light client is not
+		// retained
+		prunedBtclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		prunedBtclcKeeper.EXPECT().GetBaseBTCHeader(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: headerInfo.Height + 1}).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, prunedBtclcKeeper, btccKeeper, nil)
+		req.BtcHeight = headerInfo.Height
+		_, err = keeper.VerifyInclusionProofAt(ctx, req)
+		require.ErrorIs(t, err, types.ErrBTCHeaderNotRetained)
+	})
+}
+
+func FuzzFinalityProviderCommissionAtDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		minRate := h.BTCStakingKeeper.GetParams(h.Ctx).MinCommissionRate
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// edit the commission of the finality provider at a later height
+		creationHeight := int64(datagen.RandomInt(r, 100)) + 2
+		commissionAtCreation := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 100)), 2).Add(minRate)
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Height: creationHeight})
+		_, err = h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+			Addr:        fp.Addr,
+			BtcPk:       *fp.BtcPk,
+			Description: fp.Description,
+			Commission:  &commissionAtCreation,
+		})
+		h.NoError(err)
+
+		// generate and insert new BTC delegation at the same height
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, _, _, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			true,
+		)
+		h.NoError(err)
+
+		// edit the commission again after the BTC delegation is created
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Height: creationHeight + int64(datagen.RandomInt(r, 100)) + 1})
+		_, err = h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+			Addr:        fp.Addr,
+			BtcPk:       *fp.BtcPk,
+			Description: fp.Description,
+			Commission:  fp.Commission,
+		})
+		h.NoError(err)
+
+		// the commission at the creation height is returned
+		resp, err := h.BTCStakingKeeper.FinalityProviderCommissionAtDelegation(h.Ctx, &types.QueryFinalityProviderCommissionAtDelegationRequest{
+			StakingTxHashHex: stakingTxHash,
+		})
+		h.NoError(err)
+		require.Equal(t, uint64(creationHeight), resp.CreationHeight)
+		require.Len(t, resp.FpCommissions, 1)
+		require.Equal(t, fp.BtcPk.MarshalHex(), resp.FpCommissions[0].FpBtcPkHex)
+		require.True(t, commissionAtCreation.Equal(resp.FpCommissions[0].Commission))
+
+		// a BTC delegation without creation height is rejected
+		legacyDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		legacyDel.CreationHeight = 0
+		legacyDelBytes, err := legacyDel.Marshal()
+		h.NoError(err)
+		legacyStakingTxHash := legacyDel.MustGetStakingTxHash()
+		h.BTCStakingKeeper.BTCDelegationStore(h.Ctx).Set(legacyStakingTxHash[:], legacyDelBytes)
+		_, err = h.BTCStakingKeeper.FinalityProviderCommissionAtDelegation(h.Ctx, &types.QueryFinalityProviderCommissionAtDelegationRequest{
+			StakingTxHashHex: stakingTxHash,
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.FinalityProviderCommissionAtDelegation(h.Ctx, &types.QueryFinalityProviderCommissionAtDelegationRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestBTCNetwork(t *testing.T) {
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	resp, err := keeper.BTCNetwork(ctx, &types.QueryBTCNetworkRequest{})
+	require.NoError(t, err)
+	require.Equal(t, net.Name, resp.Network)
+
+	_, err = keeper.BTCNetwork(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func FuzzFinalityProviderDelegationCount(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a finality provider with BTC delegations and another one
+		// without BTC delegations
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+		fpWithoutDels, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fpWithoutDels)
+
+		startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+		endHeight := uint32(datagen.RandomInt(r, 1000)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		stakingTime := endHeight - startHeight
+		btcTipHeight := startHeight + uint32(datagen.RandomInt(r, int(stakingTime)))
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		btcDels := make([]*types.BTCDelegation, 0, numBTCDels)
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				stakingTime, startHeight, endHeight, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+			btcDels = append(btcDels, btcDel)
+		}
+
+		// count BTC delegations under each status
+		wValue := btcctypes.DefaultParams().CheckpointFinalizationTimeout
+		quorum := keeper.GetParams(ctx).CovenantQuorum
+		expectedCounts := map[types.BTCDelegationStatus]uint64{}
+		for _, btcDel := range btcDels {
+			expectedCounts[btcDel.GetStatus(btcTipHeight, wValue, quorum)]++
+		}
+
+		for _, s := range []types.BTCDelegationStatus{
+			types.BTCDelegationStatus_PENDING,
+			types.BTCDelegationStatus_VERIFIED,
+			types.BTCDelegationStatus_ACTIVE,
+			types.BTCDelegationStatus_UNBONDED,
+		} {
+			resp, err := keeper.FinalityProviderDelegationCount(ctx, &types.QueryFinalityProviderDelegationCountRequest{
+				FpBtcPkHex: fp.BtcPk.MarshalHex(),
+				Status:     s,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expectedCounts[s], resp.Count)
+
+			// the finality provider without BTC delegations has zero count
+			resp, err = keeper.FinalityProviderDelegationCount(ctx, &types.QueryFinalityProviderDelegationCountRequest{
+				FpBtcPkHex: fpWithoutDels.BtcPk.MarshalHex(),
+				Status:     s,
+			})
+			require.NoError(t, err)
+			require.Zero(t, resp.Count)
+		}
+
+		resp, err := keeper.FinalityProviderDelegationCount(ctx, &types.QueryFinalityProviderDelegationCountRequest{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+			Status:     types.BTCDelegationStatus_ANY,
+		})
+		require.NoError(t, err)
+		require.Equal(t, numBTCDels, resp.Count)
+
+		// unknown finality provider
+		unknownPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		_, err = keeper.FinalityProviderDelegationCount(ctx, &types.QueryFinalityProviderDelegationCountRequest{
+			FpBtcPkHex: unknownPK.MarshalHex(),
+			Status:     types.BTCDelegationStatus_ANY,
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		// invalid finality provider BTC PK
+		_, err = keeper.FinalityProviderDelegationCount(ctx, &types.QueryFinalityProviderDelegationCountRequest{
+			FpBtcPkHex: "invalid",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDelegationCountByParamsVersion(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 100}).AnyTimes()
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+	k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	// versions 0 to 3
+	for i := 0; i < 3; i++ {
+		err := k.SetParams(ctx, types.DefaultParams())
+		require.NoError(t, err)
+	}
+
+	setBTCDelegation := func(paramsVersion uint32, unbonded bool, expiredBtcHeight uint32) {
+		btcDel := &types.BTCDelegation{
+			ParamsVersion:    paramsVersion,
+			BtcUndelegation:  &types.BTCUndelegation{},
+			ExpiredBtcHeight: expiredBtcHeight,
+		}
+		if unbonded {
+			btcDel.BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+		}
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := datagen.GenRandomBtcdHash(r)
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+	}
+
+	// no BTC delegation at all
+	resp, err := k.DelegationCountByParamsVersion(ctx, &types.QueryDelegationCountByParamsVersionRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Counts)
+
+	// version 0 only has an unbonded BTC delegation, version 1 has 2 pending
+	// BTC delegations and an unbonded one, version 3 has a pending BTC
+	// delegation, and an expired BTC delegation references a pruned params
+	// version
+	setBTCDelegation(0, true, 0)
+	setBTCDelegation(1, false, 0)
+	setBTCDelegation(1, false, 0)
+	setBTCDelegation(1, true, 0)
+	setBTCDelegation(3, false, 0)
+	setBTCDelegation(10, false, 50)
+
+	resp, err = k.DelegationCountByParamsVersion(ctx, &types.QueryDelegationCountByParamsVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ParamsVersionDelegationCount{
+		{Version: 1, Count: 2},
+		{Version: 3, Count: 1},
+	}, resp.Counts)
+
+	// a BTC delegation that is not unbonded yet must not reference a pruned
+	// params version
+	setBTCDelegation(10, false, 0)
+	_, err = k.DelegationCountByParamsVersion(ctx, &types.QueryDelegationCountByParamsVersionRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = k.DelegationCountByParamsVersion(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBTCDelegationCovenantSigs(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// a BTC delegation restaking to 3 finality providers with adaptor
+	// signatures from 4 covenant members
+	numFps, numCovenants := 3, 4
+	btcDel := &types.BTCDelegation{}
+	for i := 0; i < numFps; i++ {
+		fpPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		btcDel.FpBtcPkList = append(btcDel.FpBtcPkList, *fpPK)
+	}
+	for i := 0; i < numCovenants; i++ {
+		covPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		covSigs := &types.CovenantAdaptorSignatures{CovPk: covPK}
+		for j := 0; j < numFps; j++ {
+			covSigs.AdaptorSigs = append(covSigs.AdaptorSigs, datagen.GenRandomByteArray(r, 65))
+		}
+		btcDel.CovenantSigs = append(btcDel.CovenantSigs, covSigs)
+	}
+	bz, err := btcDel.Marshal()
+	require.NoError(t, err)
+	stakingTxHash := datagen.GenRandomBtcdHash(r)
+	k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+
+	// page through all covenant signatures
+	var entries []*types.CovenantSlashingSigEntry
+	pagination := &query.PageRequest{Limit: 5}
+	for {
+		resp, err := k.BTCDelegationCovenantSigs(ctx, &types.QueryBTCDelegationCovenantSigsRequest{
+			StakingTxHashHex: stakingTxHash.String(),
+			Pagination:       pagination,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.CovenantSigs), 5)
+		entries = append(entries, resp.CovenantSigs...)
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		pagination.Key = resp.Pagination.NextKey
+	}
+	require.Len(t, entries, numFps*numCovenants)
+
+	// entries are ordered by covenant PK and then by finality provider index
+	for i, entry := range entries {
+		require.Equal(t, uint32(i%numFps), entry.FpIndex)
+		require.Equal(t, btcDel.FpBtcPkList[entry.FpIndex].MarshalHex(), entry.FpBtcPkHex)
+		if i > 0 && entry.FpIndex == 0 {
+			require.Less(t, entries[i-1].CovPkHex, entry.CovPkHex)
+		}
+		found := false
+		for _, covSigs := range btcDel.CovenantSigs {
+			if covSigs.CovPk.MarshalHex() == entry.CovPkHex {
+				require.Equal(t, hex.EncodeToString(covSigs.AdaptorSigs[entry.FpIndex]), entry.AdaptorSigHex)
+				found = true
+			}
+		}
+		require.True(t, found)
+	}
+
+	// offset-based pagination is not supported
+	_, err = k.BTCDelegationCovenantSigs(ctx, &types.QueryBTCDelegationCovenantSigsRequest{
+		StakingTxHashHex: stakingTxHash.String(),
+		Pagination:       &query.PageRequest{Offset: 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// unknown BTC delegation
+	_, err = k.BTCDelegationCovenantSigs(ctx, &types.QueryBTCDelegationCovenantSigsRequest{
+		StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBTCDelegationCovenantUnbondingSigs(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// a BTC delegation without any covenant unbonding signature yet
+	btcDel := &types.BTCDelegation{BtcUndelegation: &types.BTCUndelegation{}}
+	bz, err := btcDel.Marshal()
+	require.NoError(t, err)
+	stakingTxHash := datagen.GenRandomBtcdHash(r)
+	k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+
+	resp, err := k.BTCDelegationCovenantUnbondingSigs(ctx, &types.QueryBTCDelegationCovenantUnbondingSigsRequest{
+		StakingTxHashHex: stakingTxHash.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.CovenantUnbondingSigs)
+
+	// add unbonding signatures from 4 covenant members
+	numCovenants := 4
+	for i := 0; i < numCovenants; i++ {
+		covPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		sig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+		btcDel.BtcUndelegation.CovenantUnbondingSigList = append(btcDel.BtcUndelegation.CovenantUnbondingSigList, &types.SignatureInfo{
+			Pk:  covPK,
+			Sig: &sig,
+		})
+	}
+	bz, err = btcDel.Marshal()
+	require.NoError(t, err)
+	k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+
+	resp, err = k.BTCDelegationCovenantUnbondingSigs(ctx, &types.QueryBTCDelegationCovenantUnbondingSigsRequest{
+		StakingTxHashHex: stakingTxHash.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.CovenantUnbondingSigs, numCovenants)
+
+	// entries are ordered by covenant PK
+	for i, entry := range resp.CovenantUnbondingSigs {
+		if i > 0 {
+			require.Less(t, resp.CovenantUnbondingSigs[i-1].CovPkHex, entry.CovPkHex)
+		}
+		found := false
+		for _, sigInfo := range btcDel.BtcUndelegation.CovenantUnbondingSigList {
+			if sigInfo.Pk.MarshalHex() == entry.CovPkHex {
+				require.Equal(t, sigInfo.Sig.ToHexStr(), entry.SigHex)
+				found = true
+			}
+		}
+		require.True(t, found)
+	}
+
+	// unknown BTC delegation
+	_, err = k.BTCDelegationCovenantUnbondingSigs(ctx, &types.QueryBTCDelegationCovenantUnbondingSigsRequest{
+		StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBTCDelegationSlashingRate(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// params version 1 changes the slashing rate of the default params at
+	// version 0, and splits the slashed funds among two destinations
+	params0 := k.GetParams(ctx)
+	params1 := params0
+	params1.SlashingRate = sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+	require.NotEqual(t, params0.SlashingRate, params1.SlashingRate)
+	params1.SlashingDestinations = nil
+	for _, weight := range []sdkmath.LegacyDec{sdkmath.LegacyNewDecWithPrec(3, 1), sdkmath.LegacyNewDecWithPrec(7, 1)} {
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		params1.SlashingDestinations = append(params1.SlashingDestinations, types.SlashingDestination{
+			PkScript: slashingPkScript,
+			Weight:   weight,
+		})
+	}
+	err := k.SetParams(ctx, params1)
+	require.NoError(t, err)
+
+	// version 0 sends the slashed funds to the single slashing pk_script
+	require.Empty(t, params0.SlashingDestinations)
+	expectedDestinations := [][]types.SlashingDestination{
+		{{PkScript: params0.SlashingPkScript, Weight: sdkmath.LegacyOneDec()}},
+		params1.SlashingDestinations,
+	}
+
+	setBTCDel := func(paramsVersion uint32) *chainhash.Hash {
+		bz, err := (&types.BTCDelegation{TotalSat: 1, ParamsVersion: paramsVersion}).Marshal()
+		require.NoError(t, err)
+		stakingTxHash := datagen.GenRandomBtcdHash(r)
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+		return &stakingTxHash
+	}
+
+	// each BTC delegation gets the slashing rate of its own params version
+	for version, params := range []types.Params{params0, params1} {
+		stakingTxHash := setBTCDel(uint32(version))
+		resp, err := k.BTCDelegationSlashingRate(ctx, &types.QueryBTCDelegationSlashingRateRequest{
+			StakingTxHashHex: stakingTxHash.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(version), resp.ParamsVersion)
+		require.True(t, params.SlashingRate.Equal(resp.SlashingRate))
+		require.Equal(t, hex.EncodeToString(params.SlashingPkScript), resp.SlashingPkScriptHex)
+		require.Len(t, resp.SlashingDestinations, len(expectedDestinations[version]))
+		for i, d := range expectedDestinations[version] {
+			require.Equal(t, d.PkScript, resp.SlashingDestinations[i].PkScript)
+			require.True(t, d.Weight.Equal(resp.SlashingDestinations[i].Weight))
+		}
+	}
+
+	// BTC delegation with an unknown params version
+	stakingTxHash := setBTCDel(100)
+	_, err = k.BTCDelegationSlashingRate(ctx, &types.QueryBTCDelegationSlashingRateRequest{
+		StakingTxHashHex: stakingTxHash.String(),
+	})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	// unknown BTC delegation
+	_, err = k.BTCDelegationSlashingRate(ctx, &types.QueryBTCDelegationSlashingRateRequest{
+		StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDelegationsExpiringWithin(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btcTipHeight := uint32(1000)
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+	k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	params := types.DefaultParams()
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+	// w is 100 under the default btccheckpoint params, so a BTC delegation is
+	// active iff its end height is at least 1100
+	w := btcctypes.DefaultParams().CheckpointFinalizationTimeout
+
+	// setBTCDelegation stores a BTC delegation with the given end height,
+	// identified by its staking value
+	setBTCDelegation := func(totalSat uint64, endHeight uint32, hasQuorum bool) chainhash.Hash {
+		delSig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+		stakingTx := datagen.GenRandomTx(r)
+		stakingTxBytes, err := bbn.SerializeBTCTx(stakingTx)
+		require.NoError(t, err)
+		btcDel := &types.BTCDelegation{
+			StakerAddr:      datagen.GenRandomAccount().Address,
+			StakingTx:       stakingTxBytes,
+			DelegatorSig:    &delSig,
+			StartHeight:     btcTipHeight - 10,
+			EndHeight:       endHeight,
+			TotalSat:        totalSat,
+			BtcUndelegation: &types.BTCUndelegation{},
+		}
+		if hasQuorum {
+			for i := uint32(0); i < params.CovenantQuorum; i++ {
+				btcDel.CovenantSigs = append(btcDel.CovenantSigs, &types.CovenantAdaptorSignatures{})
+				btcDel.BtcUndelegation.CovenantSlashingSigs = append(btcDel.BtcUndelegation.CovenantSlashingSigs, &types.CovenantAdaptorSignatures{})
+				btcDel.BtcUndelegation.CovenantUnbondingSigList = append(btcDel.BtcUndelegation.CovenantUnbondingSigList, &types.SignatureInfo{})
+			}
+		}
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := stakingTx.TxHash()
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+		k.SetBTCDelegationEndHeightIndex(ctx, endHeight, stakingTxHash)
+		return stakingTxHash
+	}
+
+	// active and expiring within 200 blocks
+	stakingTxHash1 := setBTCDelegation(1, btcTipHeight+w+50, true)
+	setBTCDelegation(2, btcTipHeight+200, true)   // active and expiring exactly in 200 blocks
+	setBTCDelegation(3, btcTipHeight+300, true)   // active but not expiring within 200 blocks
+	setBTCDelegation(4, btcTipHeight+w-1, true)   // already unbonded as less than w blocks are left
+	setBTCDelegation(5, btcTipHeight+w+50, false) // pending covenant quorum
+
+	queryTotalSats := func(nBlocks uint32) []uint64 {
+		resp, err := k.DelegationsExpiringWithin(ctx, &types.QueryDelegationsExpiringWithinRequest{NBlocks: nBlocks})
+		require.NoError(t, err)
+		require.Equal(t, btcTipHeight, resp.BtcTipHeight)
+		totalSats := []uint64{}
+		for _, btcDel := range resp.BtcDelegations {
+			require.True(t, btcDel.Active)
+			totalSats = append(totalSats, btcDel.TotalSat)
+		}
+		return totalSats
+	}
+
+	// BTC delegations are in ascending order of end height
+	require.Equal(t, []uint64{1, 2}, queryTotalSats(200))
+	require.Equal(t, []uint64{1, 2, 3}, queryTotalSats(math.MaxUint32))
+	require.Empty(t, queryTotalSats(w-1))
+
+	// page through the BTC delegations one by one
+	totalSats := []uint64{}
+	pagination := &query.PageRequest{Limit: 1}
+	for {
+		resp, err := k.DelegationsExpiringWithin(ctx, &types.QueryDelegationsExpiringWithinRequest{
+			NBlocks:    math.MaxUint32,
+			Pagination: pagination,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.BtcDelegations), 1)
+		for _, btcDel := range resp.BtcDelegations {
+			totalSats = append(totalSats, btcDel.TotalSat)
+		}
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		pagination.Key = resp.Pagination.NextKey
+	}
+	require.Equal(t, []uint64{1, 2, 3}, totalSats)
+
+	// offset-based pagination is not supported
+	_, err = k.DelegationsExpiringWithin(ctx, &types.QueryDelegationsExpiringWithinRequest{
+		NBlocks:    200,
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = k.DelegationsExpiringWithin(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// an expired BTC delegation is removed from the index by end height
+	err = k.MarkBTCDelegationExpired(ctx, stakingTxHash1.String(), btcTipHeight)
+	require.NoError(t, err)
+	k.IterateDelegationsByEndHeightRange(ctx, 0, math.MaxUint32, func(btcDel *types.BTCDelegation) bool {
+		require.NotEqual(t, stakingTxHash1, btcDel.MustGetStakingTxHash())
+		return false
+	})
+	require.Equal(t, []uint64{2}, queryTotalSats(200))
+}
+
+func TestIsStakingTxRegistered(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// register a BTC delegation
+	bz, err := (&types.BTCDelegation{TotalSat: 1000}).Marshal()
+	require.NoError(t, err)
+	stakingTxHash := datagen.GenRandomBtcdHash(r)
+	k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+
+	resp, err := k.IsStakingTxRegistered(ctx, &types.QueryIsStakingTxRegisteredRequest{
+		StakingTxHashHex: stakingTxHash.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Registered)
+
+	// an unknown staking tx is not registered
+	resp, err = k.IsStakingTxRegistered(ctx, &types.QueryIsStakingTxRegisteredRequest{
+		StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Registered)
+
+	// an invalid staking tx hash is rejected
+	_, err = k.IsStakingTxRegistered(ctx, &types.QueryIsStakingTxRegisteredRequest{
+		StakingTxHashHex: "invalid",
+	})
+	require.Error(t, err)
+}
+
+func TestBTCDelegationUnbondingStatus(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btcTipHeight := uint32(1000)
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+	k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	params := types.DefaultParams()
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// an active BTC delegation with numCovUnbondingSigs covenant signatures on
+	// the unbonding tx
+	setBTCDelegation := func(numCovUnbondingSigs uint32, unbondingInfo *types.DelegatorUnbondingInfo) string {
+		delSig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+		btcDel := &types.BTCDelegation{
+			DelegatorSig:    &delSig,
+			StartHeight:     btcTipHeight - 10,
+			EndHeight:       btcTipHeight + 1000,
+			TotalSat:        1000,
+			BtcUndelegation: &types.BTCUndelegation{DelegatorUnbondingInfo: unbondingInfo},
+		}
+		for i := uint32(0); i < params.CovenantQuorum; i++ {
+			btcDel.CovenantSigs = append(btcDel.CovenantSigs, &types.CovenantAdaptorSignatures{})
+			btcDel.BtcUndelegation.CovenantSlashingSigs = append(btcDel.BtcUndelegation.CovenantSlashingSigs, &types.CovenantAdaptorSignatures{})
+		}
+		for i := uint32(0); i < numCovUnbondingSigs; i++ {
+			btcDel.BtcUndelegation.CovenantUnbondingSigList = append(btcDel.BtcUndelegation.CovenantUnbondingSigList, &types.SignatureInfo{})
+		}
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := datagen.GenRandomBtcdHash(r)
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+		return stakingTxHash.String()
+	}
+	queryUnbondingStatus := func(stakingTxHashHex string) *types.QueryBTCDelegationUnbondingStatusResponse {
+		resp, err := k.BTCDelegationUnbondingStatus(ctx, &types.QueryBTCDelegationUnbondingStatusRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// the covenant unbonding signatures have not reached quorum
+	resp := queryUnbondingStatus(setBTCDelegation(params.CovenantQuorum-1, nil))
+	require.False(t, resp.DelegatorUnbonded)
+	require.Zero(t, resp.UnbondingBtcHeight)
+	require.Equal(t, params.CovenantQuorum-1, resp.NumCovenantUnbondingSigs)
+	require.False(t, resp.CovenantUnbondingQuorum)
+	require.Equal(t, types.BTCDelegationStatus_PENDING, resp.Status)
+
+	// the covenant unbonding signatures have reached quorum
+	resp = queryUnbondingStatus(setBTCDelegation(params.CovenantQuorum, nil))
+	require.False(t, resp.DelegatorUnbonded)
+	require.True(t, resp.CovenantUnbondingQuorum)
+	require.Equal(t, types.BTCDelegationStatus_ACTIVE, resp.Status)
+
+	// the delegator has unbonded early
+	resp = queryUnbondingStatus(setBTCDelegation(params.CovenantQuorum, &types.DelegatorUnbondingInfo{
+		SpendStakeTxBtcHeight: btcTipHeight - 1,
+	}))
+	require.True(t, resp.DelegatorUnbonded)
+	require.Equal(t, btcTipHeight-1, resp.UnbondingBtcHeight)
+	require.Equal(t, types.BTCDelegationStatus_UNBONDED, resp.Status)
+
+	// unknown BTC delegation
+	_, err = k.BTCDelegationUnbondingStatus(ctx, &types.QueryBTCDelegationUnbondingStatusRequest{
+		StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+	})
+	require.Error(t, err)
+}
+
+func TestSelectiveSlashingEvidenceList(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// no evidence is submitted yet
+	resp, err := k.SelectiveSlashingEvidenceList(ctx, &types.QuerySelectiveSlashingEvidenceListRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Evidences)
+
+	// record evidences at ascending block heights
+	numEvidences := 5
+	evidences := make([]*types.SelectiveSlashingEvidence, 0, numEvidences)
+	for i := 0; i < numEvidences; i++ {
+		fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		evidence := &types.SelectiveSlashingEvidence{
+			StakingTxHash:    datagen.GenRandomBtcdHash(r).String(),
+			FpBtcPk:          bbn.NewBIP340PubKeyFromBTCPK(fpPK),
+			RecoveredFpBtcSk: fpSK.Serialize(),
+			BlockHeight:      uint64(10 * (i + 1)),
+		}
+		k.SetSelectiveSlashingEvidence(ctx, evidence)
+		evidences = append(evidences, evidence)
+	}
+
+	// the evidences are returned by block height descending
+	resp, err = k.SelectiveSlashingEvidenceList(ctx, &types.QuerySelectiveSlashingEvidenceListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Evidences, numEvidences)
+	for i, evidence := range resp.Evidences {
+		require.Equal(t, evidences[numEvidences-1-i], evidence)
+	}
+
+	// paginating keeps the order
+	var paginated []*types.SelectiveSlashingEvidence
+	pagination := &query.PageRequest{Limit: 2}
+	for {
+		resp, err = k.SelectiveSlashingEvidenceList(ctx, &types.QuerySelectiveSlashingEvidenceListRequest{Pagination: pagination})
+		require.NoError(t, err)
+		paginated = append(paginated, resp.Evidences...)
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+	}
+	require.Len(t, paginated, numEvidences)
+	for i, evidence := range paginated {
+		require.Equal(t, evidences[numEvidences-1-i], evidence)
+	}
+}
+
+func FuzzDelegationsByFpSet(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fpPKs := make([]bbn.BIP340PubKey, 0, 3)
+		for i := 0; i < 3; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			AddFinalityProvider(t, ctx, *keeper, fp)
+			fpPKs = append(fpPKs, *fp.BtcPk)
+		}
+
+		// the queried set restakes to the first two finality providers, and
+		// BTC delegations list them in either order
+		fpSets := [][]bbn.BIP340PubKey{
+			{fpPKs[0]},
+			{fpPKs[0], fpPKs[1]},
+			{fpPKs[1], fpPKs[0]},
+			{fpPKs[0], fpPKs[1], fpPKs[2]},
+		}
+		numBTCDels := datagen.RandomInt(r, 20) + 1
+		expected := []chainhash.Hash{}
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			setIdx := r.Intn(len(fpSets))
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				fpSets[setIdx],
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+			if setIdx == 1 || setIdx == 2 {
+				expected = append(expected, btcDel.MustGetStakingTxHash())
+			}
+		}
+
+		// the BTC delegations are returned in ascending order of staking tx hash
+		sort.Slice(expected, func(i, j int) bool {
+			return bytes.Compare(expected[i][:], expected[j][:]) < 0
+		})
+
+		limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+		req := &types.QueryDelegationsByFpSetRequest{
+			FpBtcPkHexList: []string{fpPKs[1].MarshalHex(), fpPKs[0].MarshalHex()},
+			Pagination:     &query.PageRequest{Limit: limit},
+		}
+		found := []chainhash.Hash{}
+		for {
+			resp, err := keeper.DelegationsByFpSet(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.BtcDelegations)), limit)
+			for _, btcDel := range resp.BtcDelegations {
+				stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+				require.NoError(t, err)
+				found = append(found, stakingTx.TxHash())
+			}
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: limit}
+		}
+		require.Equal(t, expected, found)
+
+		// duplicated finality providers are rejected
+		_, err = keeper.DelegationsByFpSet(ctx, &types.QueryDelegationsByFpSetRequest{
+			FpBtcPkHexList: []string{fpPKs[0].MarshalHex(), fpPKs[0].MarshalHex()},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestBTCDelegationTransactions(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	setBTCDelegation := func(btcDel *types.BTCDelegation) string {
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := datagen.GenRandomBtcdHash(r)
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+		return stakingTxHash.String()
+	}
+	queryTransactions := func(stakingTxHashHex string) *types.QueryBTCDelegationTransactionsResponse {
+		resp, err := k.BTCDelegationTransactions(ctx, &types.QueryBTCDelegationTransactionsRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	stakingTx := datagen.GenRandomByteArray(r, 100)
+	slashingTx := types.BTCSlashingTx(datagen.GenRandomByteArray(r, 100))
+	delSig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+	unbondingTx := datagen.GenRandomByteArray(r, 100)
+	unbondingSlashingTx := types.BTCSlashingTx(datagen.GenRandomByteArray(r, 100))
+	delUnbondingSlashingSig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+
+	// a BTC delegation with all transactions and delegator signatures
+	resp := queryTransactions(setBTCDelegation(&types.BTCDelegation{
+		StakingTx:    stakingTx,
+		SlashingTx:   &slashingTx,
+		DelegatorSig: &delSig,
+		BtcUndelegation: &types.BTCUndelegation{
+			UnbondingTx:          unbondingTx,
+			SlashingTx:           &unbondingSlashingTx,
+			DelegatorSlashingSig: &delUnbondingSlashingSig,
+		},
+	}))
+	require.Equal(t, hex.EncodeToString(stakingTx), resp.StakingTxHex)
+	require.Equal(t, slashingTx.ToHexStr(), resp.SlashingTxHex)
+	require.Equal(t, delSig.ToHexStr(), resp.DelegatorSlashingSigHex)
+	require.Equal(t, hex.EncodeToString(unbondingTx), resp.UnbondingTxHex)
+	require.Equal(t, unbondingSlashingTx.ToHexStr(), resp.UnbondingSlashingTxHex)
+	require.Equal(t, delUnbondingSlashingSig.ToHexStr(), resp.DelegatorUnbondingSlashingSigHex)
+
+	// a BTC delegation without the unbonding transactions
+	resp = queryTransactions(setBTCDelegation(&types.BTCDelegation{
+		StakingTx:    stakingTx,
+		SlashingTx:   &slashingTx,
+		DelegatorSig: &delSig,
+	}))
+	require.Equal(t, hex.EncodeToString(stakingTx), resp.StakingTxHex)
+	require.Equal(t, slashingTx.ToHexStr(), resp.SlashingTxHex)
+	require.Equal(t, delSig.ToHexStr(), resp.DelegatorSlashingSigHex)
+	require.Empty(t, resp.UnbondingTxHex)
+	require.Empty(t, resp.UnbondingSlashingTxHex)
+	require.Empty(t, resp.DelegatorUnbondingSlashingSigHex)
+
+	// unknown BTC delegation
+	_, err := k.BTCDelegationTransactions(ctx, &types.QueryBTCDelegationTransactionsRequest{
+		StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+	})
+	require.Error(t, err)
+}
+
+func FuzzBTCDelegationScriptPaths(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, _, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+
+		resp, err := h.BTCStakingKeeper.BTCDelegationScriptPaths(h.Ctx, &types.QueryBTCDelegationScriptPathsRequest{
+			StakingTxHashHex: stakingTxHash,
+		})
+		h.NoError(err)
+		require.Equal(t, actualDel.ParamsVersion, resp.ParamsVersion)
+
+		// the staking output pk script matches the one in the staking tx
+		stakingMsgTx, err := bbn.NewBTCTxFromBytes(actualDel.StakingTx)
+		h.NoError(err)
+		pkScript, err := hex.DecodeString(resp.StakingOutputPkScriptHex)
+		h.NoError(err)
+		require.Equal(t, stakingMsgTx.TxOut[actualDel.StakingOutputIdx].PkScript, pkScript)
+
+		// the internal key and merkle root commit to the staking output
+		internalKeyBytes, err := hex.DecodeString(resp.InternalKeyHex)
+		h.NoError(err)
+		internalKey, err := schnorr.ParsePubKey(internalKeyBytes)
+		h.NoError(err)
+		merkleRoot, err := hex.DecodeString(resp.MerkleRootHex)
+		h.NoError(err)
+		outputKey := txscript.ComputeTaprootOutputKey(internalKey, merkleRoot)
+		require.Equal(t, schnorr.SerializePubKey(outputKey), pkScript[2:])
+
+		// each script path is committed in the staking output
+		for _, path := range []*types.ScriptPath{resp.TimelockPath, resp.UnbondingPath, resp.SlashingPath} {
+			script, err := hex.DecodeString(path.ScriptHex)
+			h.NoError(err)
+			controlBlockBytes, err := hex.DecodeString(path.ControlBlockHex)
+			h.NoError(err)
+			controlBlock, err := txscript.ParseControlBlock(controlBlockBytes)
+			h.NoError(err)
+			h.NoError(txscript.VerifyTaprootLeafCommitment(controlBlock, pkScript[2:], script))
+
+			leafHash := txscript.NewBaseTapLeaf(script).TapHash()
+			require.Equal(t, hex.EncodeToString(leafHash[:]), path.LeafHashHex)
+		}
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.BTCDelegationScriptPaths(h.Ctx, &types.QueryBTCDelegationScriptPathsRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzBTCDelegationsByFlags(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations, each with a random
+		// combination of inclusion proof, covenant quorum and delegator
+		// unbonding signature
+		type delFlags struct{ hasProof, hasQuorum, hasUnbondingSig bool }
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		btcDelFlags := make(map[string]delFlags)
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+
+			flags := delFlags{hasProof: true, hasQuorum: true}
+			if r.Intn(2) == 0 {
+				btcDel.StartHeight, btcDel.EndHeight = 0, 0
+				flags.hasProof = false
+			}
+			if r.Intn(2) == 0 {
+				btcDel.CovenantSigs = nil
+				flags.hasQuorum = false
+			}
+			if r.Intn(2) == 0 {
+				btcDel.BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+				flags.hasUnbondingSig = true
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+			btcDelFlags[btcDel.MustGetStakingTxHash().String()] = flags
+		}
+
+		// querying paginated BTC delegations under each combination of the
+		// flag filters and assert
+		filters := []types.FlagFilter{types.FlagFilter_FLAG_FILTER_ANY, types.FlagFilter_FLAG_FILTER_YES, types.FlagFilter_FLAG_FILTER_NO}
+		for _, hasProof := range filters {
+			for _, hasQuorum := range filters {
+				for _, hasUnbondingSig := range filters {
+					expected := make(map[string]bool)
+					for stakingTxHash, flags := range btcDelFlags {
+						if hasProof.Matches(flags.hasProof) && hasQuorum.Matches(flags.hasQuorum) && hasUnbondingSig.Matches(flags.hasUnbondingSig) {
+							expected[stakingTxHash] = true
+						}
+					}
+
+					limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+					pagination := constructRequestWithLimit(r, limit)
+					req := &types.QueryBTCDelegationsByFlagsRequest{
+						HasProof:        hasProof,
+						HasQuorum:       hasQuorum,
+						HasUnbondingSig: hasUnbondingSig,
+						Pagination:      pagination,
+					}
+					found := make(map[string]bool)
+					for {
+						resp, err := keeper.BTCDelegationsByFlags(ctx, req)
+						require.NoError(t, err)
+						for _, btcDel := range resp.BtcDelegations {
+							stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+							require.NoError(t, err)
+							found[stakingTx.TxHash().String()] = true
+						}
+						if len(resp.Pagination.NextKey) == 0 {
+							break
+						}
+						// Construct the next page request
+						pagination.Key = resp.Pagination.NextKey
+					}
+					require.Equal(t, expected, found)
+				}
+			}
+		}
+
+		// invalid flag filter
+		_, err = keeper.BTCDelegationsByFlags(ctx, &types.QueryBTCDelegationsByFlagsRequest{
+			HasProof: types.FlagFilter(3),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func FuzzFindStakingOutputIndex(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+
+		fpBTCPKHexList := make([]string, 0, len(actualDel.FpBtcPkList))
+		for _, fpBTCPK := range actualDel.FpBtcPkList {
+			fpBTCPKHexList = append(fpBTCPKHexList, fpBTCPK.MarshalHex())
+		}
+		req := &types.QueryFindStakingOutputIndexRequest{
+			StakingTxHex:   hex.EncodeToString(actualDel.StakingTx),
+			ParamsVersion:  actualDel.ParamsVersion,
+			StakerBtcPkHex: actualDel.BtcPk.MarshalHex(),
+			FpBtcPkHexList: fpBTCPKHexList,
+			StakingTime:    actualDel.StakingTime,
+			StakingValue:   int64(actualDel.TotalSat),
+		}
+
+		// the found index is the one selected upon the BTC delegation creation
+		resp, err := h.BTCStakingKeeper.FindStakingOutputIndex(h.Ctx, req)
+		h.NoError(err)
+		require.Equal(t, actualDel.StakingOutputIdx, resp.StakingOutputIdx)
+		stakingMsgTx, err := bbn.NewBTCTxFromBytes(actualDel.StakingTx)
+		h.NoError(err)
+		require.Equal(t, hex.EncodeToString(stakingMsgTx.TxOut[resp.StakingOutputIdx].PkScript), resp.StakingOutputPkScriptHex)
+
+		// no output matches a different staking value
+		req.StakingValue++
+		_, err = h.BTCStakingKeeper.FindStakingOutputIndex(h.Ctx, req)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+		req.StakingValue--
+
+		// unknown params version
+		req.ParamsVersion = actualDel.ParamsVersion + 1
+		_, err = h.BTCStakingKeeper.FindStakingOutputIndex(h.Ctx, req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func FuzzCovenantSigningHashes(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// generate a random number of finality providers
+		numFps := datagen.RandomInt(r, 3) + 1
+		fpBTCPKs := make([]bbn.BIP340PubKey, 0, numFps)
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			AddFinalityProvider(t, ctx, *keeper, fp)
+			fpBTCPKs = append(fpBTCPKs, *fp.BtcPk)
+		}
+
+		// generate a BTC delegation restaking to all finality providers,
+		// signed by the covenant committee
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			fpBTCPKs,
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingPkScript,
+			1000, 1, 1001, 10000,
+			slashingRate,
+			slashingChangeLockTime,
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+
+		res, err := keeper.CovenantSigningHashes(ctx, &types.QueryCovenantSigningHashesRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.ParamsVersion, res.ParamsVersion)
+		require.Len(t, res.SlashingSigHashes, len(fpBTCPKs))
+
+		// the covenant unbonding signatures are valid Schnorr signatures
+		// over the unbonding tx sighash
+		unbondingSigHash, err := hex.DecodeString(res.UnbondingTxSigHashHex)
+		require.NoError(t, err)
+		for _, covSig := range btcDel.BtcUndelegation.CovenantUnbondingSigList {
+			require.True(t, covSig.Sig.MustToBTCSig().Verify(unbondingSigHash, covSig.Pk.MustToBTCPK()))
+		}
+
+		// the covenant adaptor signatures encrypted by each finality
+		// provider's PK are valid over the slashing tx and unbonding
+		// slashing tx sighashes
+		for i, fpSigHashes := range res.SlashingSigHashes {
+			require.Equal(t, fpBTCPKs[i].MarshalHex(), fpSigHashes.FpBtcPkHex)
+			encKey, err := asig.NewEncryptionKeyFromBTCPK(fpBTCPKs[i].MustToBTCPK())
+			require.NoError(t, err)
+
+			slashingSigHash, err := hex.DecodeString(fpSigHashes.SlashingTxSigHashHex)
+			require.NoError(t, err)
+			for _, covSigs := range btcDel.CovenantSigs {
+				adaptorSig, err := asig.NewAdaptorSignatureFromBytes(covSigs.AdaptorSigs[i])
+				require.NoError(t, err)
+				require.NoError(t, adaptorSig.EncVerify(covSigs.CovPk.MustToBTCPK(), encKey, slashingSigHash))
+			}
+
+			unbondingSlashingSigHash, err := hex.DecodeString(fpSigHashes.SlashingUnbondingTxSigHashHex)
+			require.NoError(t, err)
+			for _, covSigs := range btcDel.BtcUndelegation.CovenantSlashingSigs {
+				adaptorSig, err := asig.NewAdaptorSignatureFromBytes(covSigs.AdaptorSigs[i])
+				require.NoError(t, err)
+				require.NoError(t, adaptorSig.EncVerify(covSigs.CovPk.MustToBTCPK(), encKey, unbondingSlashingSigHash))
+			}
+		}
+
+		// querying an unknown BTC delegation fails
+		_, err = keeper.CovenantSigningHashes(ctx, &types.QueryCovenantSigningHashesRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzAllBTCDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations, each being either
+		// pending or active
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		stakingTxHashes := make([]chainhash.Hash, 0, numBTCDels)
+		btcDelStatus := make(map[string]types.BTCDelegationStatus)
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+
+			status := types.BTCDelegationStatus_ACTIVE
+			if r.Intn(2) == 0 {
+				btcDel.CovenantSigs = nil
+				status = types.BTCDelegationStatus_PENDING
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			stakingTxHashes = append(stakingTxHashes, stakingTxHash)
+			btcDelStatus[stakingTxHash.String()] = status
+		}
+		// BTC delegations are enumerated in the order of the staking tx
+		// hashes as stored
+		sort.Slice(stakingTxHashes, func(i, j int) bool {
+			return bytes.Compare(stakingTxHashes[i][:], stakingTxHashes[j][:]) < 0
+		})
+
+		queryAll := func(statusFilter []types.BTCDelegationStatus) []string {
+			var (
+				result  []string
+				nextKey []byte
+			)
+			limit := datagen.RandomInt(r, 10) + 1
+			for {
+				resp, err := keeper.AllBTCDelegations(ctx, &types.QueryAllBTCDelegationsRequest{
+					StatusFilter: statusFilter,
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: limit,
+					},
+				})
+				require.NoError(t, err)
+				for _, btcDel := range resp.BtcDelegations {
+					stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+					require.NoError(t, err)
+					stakingTxHash := stakingTx.TxHash()
+					require.Equal(t, btcDelStatus[stakingTxHash.String()].String(), btcDel.StatusDesc)
+					result = append(result, stakingTxHash.String())
+				}
+				nextKey = resp.Pagination.NextKey
+				if nextKey == nil {
+					break
+				}
+			}
+			return result
+		}
+
+		// without status filter, all BTC delegations are returned in order
+		expected := make([]string, 0, len(stakingTxHashes))
+		for _, stakingTxHash := range stakingTxHashes {
+			expected = append(expected, stakingTxHash.String())
+		}
+		require.Equal(t, expected, queryAll(nil))
+		require.Equal(t, expected, queryAll([]types.BTCDelegationStatus{types.BTCDelegationStatus_ANY}))
+
+		// with status filter, only the BTC delegations under the given
+		// statuses are returned in order
+		for _, status := range []types.BTCDelegationStatus{types.BTCDelegationStatus_PENDING, types.BTCDelegationStatus_ACTIVE} {
+			var expected []string
+			for _, stakingTxHash := range stakingTxHashes {
+				if btcDelStatus[stakingTxHash.String()] == status {
+					expected = append(expected, stakingTxHash.String())
+				}
+			}
+			require.Equal(t, expected, queryAll([]types.BTCDelegationStatus{status}))
+		}
+		require.Empty(t, queryAll([]types.BTCDelegationStatus{types.BTCDelegationStatus_UNBONDED}))
+	})
+}
+
+func FuzzFinalityProviderRewardGauge(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, iKeeper)
+
+		// nil request
+		_, err := keeper.FinalityProviderRewardGauge(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// invalid BTC PK
+		_, err = keeper.FinalityProviderRewardGauge(ctx, &types.QueryFinalityProviderRewardGaugeRequest{FpBtcPkHex: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// non-existing finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		req := &types.QueryFinalityProviderRewardGaugeRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()}
+		_, err = keeper.FinalityProviderRewardGauge(ctx, req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		AddFinalityProvider(t, ctx, *keeper, fp)
+		fpAddr := sdk.MustAccAddressFromBech32(fp.Addr)
+
+		// finality provider without a reward gauge
+		iKeeper.EXPECT().GetRewardGauge(gomock.Any(), itypes.FinalityProviderType, fpAddr).Return(nil).Times(1)
+		resp, err := keeper.FinalityProviderRewardGauge(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, fp.Addr, resp.FpAddr)
+		require.True(t, resp.Coins.IsZero())
+		require.True(t, resp.WithdrawnCoins.IsZero())
+		require.True(t, resp.WithdrawableCoins.IsZero())
+
+		// finality provider with a partially withdrawn reward gauge
+		rg := datagen.GenRandomRewardGauge(r)
+		rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+		iKeeper.EXPECT().GetRewardGauge(gomock.Any(), itypes.FinalityProviderType, fpAddr).Return(rg).Times(1)
+		resp, err = keeper.FinalityProviderRewardGauge(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, fp.Addr, resp.FpAddr)
+		require.True(t, rg.Coins.Equal(resp.Coins))
+		require.True(t, rg.WithdrawnCoins.Equal(resp.WithdrawnCoins))
+		require.True(t, rg.Coins.Sub(rg.WithdrawnCoins...).Equal(resp.WithdrawableCoins))
+	})
+}
+
+func FuzzDelegationActivationRate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations, each either with a
+		// random start height or without an inclusion proof
+		startHeights := []uint32{}
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			startHeight := uint32(datagen.RandomInt(r, 200)) + 1
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, startHeight, startHeight+1000, 10000,
+				slashingRate,
+				101,
+			)
+			require.NoError(t, err)
+			if r.Intn(3) == 0 {
+				btcDel.StartHeight = 0
+				btcDel.EndHeight = 0
+			} else {
+				startHeights = append(startHeights, startHeight)
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+		}
+
+		// invalid requests
+		_, err = keeper.DelegationActivationRate(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = keeper.DelegationActivationRate(ctx, &types.QueryDelegationActivationRateRequest{FromBtcHeight: 1, ToBtcHeight: 10})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = keeper.DelegationActivationRate(ctx, &types.QueryDelegationActivationRateRequest{FromBtcHeight: 10, ToBtcHeight: 1, BucketSize: 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = keeper.DelegationActivationRate(ctx, &types.QueryDelegationActivationRateRequest{FromBtcHeight: 0, ToBtcHeight: math.MaxUint32, BucketSize: 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// query a random range with a random bucket size
+		fromHeight := uint32(datagen.RandomInt(r, 100))
+		toHeight := fromHeight + uint32(datagen.RandomInt(r, 150))
+		bucketSize := uint32(datagen.RandomInt(r, 20)) + 1
+		resp, err := keeper.DelegationActivationRate(ctx, &types.QueryDelegationActivationRateRequest{
+			FromBtcHeight: fromHeight,
+			ToBtcHeight:   toHeight,
+			BucketSize:    bucketSize,
+		})
+		require.NoError(t, err)
+
+		// the buckets are consecutive and cover the whole range
+		require.Len(t, resp.Buckets, int((toHeight-fromHeight)/bucketSize+1))
+		require.Equal(t, fromHeight, resp.Buckets[0].StartBtcHeight)
+		require.Equal(t, toHeight, resp.Buckets[len(resp.Buckets)-1].EndBtcHeight)
+		for i, bucket := range resp.Buckets {
+			if i > 0 {
+				require.Equal(t, resp.Buckets[i-1].EndBtcHeight+1, bucket.StartBtcHeight)
+			}
+
+			expectedCount := uint64(0)
+			for _, h := range startHeights {
+				if h >= bucket.StartBtcHeight && h <= bucket.EndBtcHeight {
+					expectedCount++
+				}
+			}
+			require.Equal(t, expectedCount, bucket.Count)
+		}
+	})
+}
+
+func FuzzStakerDelegationAttestation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations, some of which belong
+		// to the staker
+		stakerAddr := datagen.GenRandomAccount().GetAddress()
+		expectedSats := make(map[string]uint64)
+		numBTCDels := datagen.RandomInt(r, 20) + 1
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			totalSat := datagen.RandomInt(r, 100000) + 10000
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, totalSat,
+				slashingRate,
+				101,
+			)
+			require.NoError(t, err)
+			if r.Intn(2) == 0 {
+				btcDel.StakerAddr = stakerAddr.String()
+				expectedSats[btcDel.MustGetStakingTxHash().String()] = totalSat
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+		}
+
+		// invalid requests
+		_, err = keeper.StakerDelegationAttestation(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = keeper.StakerDelegationAttestation(ctx, &types.QueryStakerDelegationAttestationRequest{StakerAddr: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		resp, err := keeper.StakerDelegationAttestation(ctx, &types.QueryStakerDelegationAttestationRequest{StakerAddr: stakerAddr.String()})
+		require.NoError(t, err)
+		require.Equal(t, stakerAddr.String(), resp.StakerAddr)
+		require.Len(t, resp.Delegations, len(expectedSats))
+
+		// the BTC delegations of the staker are returned in ascending order
+		// of staking tx hash bytes with their amounts
+		totalSat := uint64(0)
+		h := sha256.New()
+		for i, del := range resp.Delegations {
+			expectedSat, ok := expectedSats[del.StakingTxHashHex]
+			require.True(t, ok)
+			require.Equal(t, expectedSat, del.TotalSat)
+			totalSat += del.TotalSat
+
+			stakingTxHash, err := chainhash.NewHashFromStr(del.StakingTxHashHex)
+			require.NoError(t, err)
+			if i > 0 {
+				prevStakingTxHash, err := chainhash.NewHashFromStr(resp.Delegations[i-1].StakingTxHashHex)
+				require.NoError(t, err)
+				require.Negative(t, bytes.Compare(prevStakingTxHash[:], stakingTxHash[:]))
+			}
+			h.Write(stakingTxHash[:])
+			h.Write(sdk.Uint64ToBigEndian(del.TotalSat))
+		}
+		require.Equal(t, totalSat, resp.TotalSat)
+
+		// the digest is reproducible from the returned BTC delegations
+		require.Equal(t, hex.EncodeToString(h.Sum(nil)), resp.DigestHex)
+		resp2, err := keeper.StakerDelegationAttestation(ctx, &types.QueryStakerDelegationAttestationRequest{StakerAddr: stakerAddr.String()})
+		require.NoError(t, err)
+		require.Equal(t, resp.DigestHex, resp2.DigestHex)
+	})
+}
+
+func FuzzFinalityProviderRewardBreakdown(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, iKeeper)
+
+		// nil request
+		_, err := keeper.FinalityProviderRewardBreakdown(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// invalid BTC PK
+		_, err = keeper.FinalityProviderRewardBreakdown(ctx, &types.QueryFinalityProviderRewardBreakdownRequest{FpBtcPkHex: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// non-existing finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		req := &types.QueryFinalityProviderRewardBreakdownRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()}
+		_, err = keeper.FinalityProviderRewardBreakdown(ctx, req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		AddFinalityProvider(t, ctx, *keeper, fp)
+		fpAddr := sdk.MustAccAddressFromBech32(fp.Addr)
+
+		// finality provider without any rewards
+		iKeeper.EXPECT().GetRewardGauge(gomock.Any(), itypes.FinalityProviderType, fpAddr).Return(nil).Times(1)
+		iKeeper.EXPECT().GetFpSelfDelRewards(gomock.Any(), fp.BtcPk.MustMarshal()).Return(sdk.NewCoins(), nil).Times(1)
+		resp, err := keeper.FinalityProviderRewardBreakdown(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, fp.Addr, resp.FpAddr)
+		require.True(t, resp.CommissionCoins.IsZero())
+		require.True(t, resp.WithdrawableCommissionCoins.IsZero())
+		require.True(t, resp.SelfDelegationCoins.IsZero())
+
+		// finality provider with a partially withdrawn commission and
+		// self-delegation rewards
+		rg := datagen.GenRandomRewardGauge(r)
+		rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+		selfDelCoins := datagen.GenRandomCoins(r)
+		iKeeper.EXPECT().GetRewardGauge(gomock.Any(), itypes.FinalityProviderType, fpAddr).Return(rg).Times(1)
+		iKeeper.EXPECT().GetFpSelfDelRewards(gomock.Any(), fp.BtcPk.MustMarshal()).Return(selfDelCoins, nil).Times(1)
+		resp, err = keeper.FinalityProviderRewardBreakdown(ctx, req)
+		require.NoError(t, err)
+		require.True(t, rg.Coins.Equal(resp.CommissionCoins))
+		require.True(t, rg.Coins.Sub(rg.WithdrawnCoins...).Equal(resp.WithdrawableCommissionCoins))
+		require.True(t, selfDelCoins.Equal(resp.SelfDelegationCoins))
+	})
+}
+
+func FuzzCanReachCovenantQuorum(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// nil request and non-existing BTC delegation
+		_, err = keeper.CanReachCovenantQuorum(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = keeper.CanReachCovenantQuorum(ctx, &types.QueryCanReachCovenantQuorumRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		// generate a BTC delegation signed by a random subset of the covenant
+		// committee
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingPkScript,
+			1000, 1, 1001, 10000,
+			slashingRate,
+			101,
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		covenantSigs := []*types.CovenantAdaptorSignatures{}
+		for _, covSig := range btcDel.CovenantSigs {
+			if r.Intn(2) == 0 {
+				covenantSigs = append(covenantSigs, covSig)
+			}
+		}
+		btcDel.CovenantSigs = covenantSigs
+		err = keeper.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+		require.NoError(t, err)
+
+		// set a new params version retaining a random subset of the covenant
+		// committee and adding a new member
+		var newPks []bbn.BIP340PubKey
+		for _, pk := range params.CovenantPks {
+			if r.Intn(2) == 0 {
+				newPks = append(newPks, pk)
+			}
+		}
+		newPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		newPks = append(newPks, *newPk)
+		newParams := types.DefaultParams()
+		newParams.CovenantPks = newPks
+		newParams.CovenantQuorum = uint32(len(newPks)/2 + 1)
+		err = keeper.SetParams(ctx, newParams)
+		require.NoError(t, err)
+
+		// expected values are computed against the covenant committee of the
+		// BTC delegation's params version
+		var (
+			expectedMissing     []string
+			expectedUnavailable []string
+		)
+		signedCount := uint32(0)
+		for _, pk := range params.CovenantPks {
+			pk := pk
+			if btcDel.IsSignedByCovMember(&pk) {
+				signedCount++
+				continue
+			}
+			expectedMissing = append(expectedMissing, pk.MarshalHex())
+			if !newParams.HasCovenantPK(&pk) {
+				expectedUnavailable = append(expectedUnavailable, pk.MarshalHex())
+			}
+		}
+
+		resp, err := keeper.CanReachCovenantQuorum(ctx, &types.QueryCanReachCovenantQuorumRequest{
+			StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.ParamsVersion, resp.ParamsVersion)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, signedCount, resp.SignedCount)
+		require.ElementsMatch(t, expectedMissing, resp.MissingSignersPksHex)
+		require.ElementsMatch(t, expectedUnavailable, resp.UnavailableSignersPksHex)
+		numAvailable := uint32(len(expectedMissing) - len(expectedUnavailable))
+		require.Equal(t, signedCount+numAvailable >= covenantQuorum, resp.CanReachQuorum)
+	})
+}
+
+func FuzzBTCTip(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client
+		btcTip := datagen.GenRandomBTCHeaderInfo(r)
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(btcTip).Times(1)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, nil, nil)
+
+		_, err := keeper.BTCTip(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		resp, err := keeper.BTCTip(ctx, &types.QueryBTCTipRequest{})
+		require.NoError(t, err)
+		require.Equal(t, btcTip.Height, resp.Height)
+		require.Equal(t, btcTip.Hash.MarshalHex(), resp.HashHex)
+	})
+}
+
+func FuzzDelegationsWithUnbondingScheduleIssues(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC checkpoint module, such that the minimum unbonding time is w
+		btccParams := btcctypes.DefaultParams()
+		w := btccParams.CheckpointFinalizationTimeout
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btccParams).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, btccKeeper, nil)
+		require.Zero(t, keeper.GetParams(ctx).MinUnbondingTimeBlocks)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		// Generate a random number of BTC delegations, some of them without
+		// inclusion proof and some of them ending no later than w
+		expectedIssues := map[string]uint32{}
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, 10000,
+				slashingRate,
+				101,
+			)
+			require.NoError(t, err)
+			switch r.Intn(3) {
+			case 0:
+				btcDel.StartHeight = 0
+				btcDel.EndHeight = 0
+			case 1:
+				btcDel.EndHeight = uint32(datagen.RandomInt(r, int(w))) + 1
+				expectedIssues[btcDel.MustGetStakingTxHash().String()] = btcDel.EndHeight
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel, w)
+			require.NoError(t, err)
+		}
+
+		_, err = keeper.DelegationsWithUnbondingScheduleIssues(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		resp, err := keeper.DelegationsWithUnbondingScheduleIssues(ctx, &types.QueryDelegationsWithUnbondingScheduleIssuesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Issues, len(expectedIssues))
+		for _, issue := range resp.Issues {
+			endHeight, ok := expectedIssues[issue.StakingTxHashHex]
+			require.True(t, ok)
+			require.Equal(t, endHeight, issue.EndHeight)
+			require.Equal(t, w, issue.MinUnbondingTime)
+			require.Equal(t, endHeight-w, issue.UnbondingHeight)
+		}
+	})
+}
+
+func FuzzFinalityProviderStats(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		_, err = h.BTCStakingKeeper.FinalityProviderStats(h.Ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// generate a random number of finality providers under each status,
+		// where active and slashed ones have an active BTC delegation
+		numActive := datagen.RandomInt(r, 3) + 1
+		numDormant := datagen.RandomInt(r, 3)
+		numJailed := datagen.RandomInt(r, 3)
+		numSlashed := datagen.RandomInt(r, 3)
+		createFP := func(withActiveDelegation bool) *types.FinalityProvider {
+			_, fpPK, fp := h.CreateFinalityProvider(r)
+			if withActiveDelegation {
+				delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+				h.NoError(err)
+				_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+					r,
+					delSK,
+					fpPK,
+					changeAddress.EncodeAddress(),
+					int64(2*10e8),
+					1000,
+					0,
+					0,
+					false,
+				)
+				h.NoError(err)
+				h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+			}
+			return fp
+		}
+		for i := uint64(0); i < numActive; i++ {
+			createFP(true)
+		}
+		for i := uint64(0); i < numDormant; i++ {
+			createFP(false)
+		}
+		for i := uint64(0); i < numJailed; i++ {
+			fp := createFP(false)
+			err := h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+			h.NoError(err)
+		}
+		for i := uint64(0); i < numSlashed; i++ {
+			fp := createFP(true)
+			err := h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), types.SlashingReason_SLASHING_REASON_EQUIVOCATION)
+			h.NoError(err)
+		}
+
+		resp, err := h.BTCStakingKeeper.FinalityProviderStats(h.Ctx, &types.QueryFinalityProviderStatsRequest{})
+		h.NoError(err)
+		require.Equal(t, numActive+numDormant+numJailed+numSlashed, resp.Total)
+		require.Equal(t, numActive, resp.Active)
+		require.Equal(t, numDormant, resp.Dormant)
+		require.Equal(t, numJailed, resp.Jailed)
+		require.Equal(t, numSlashed, resp.Slashed)
+	})
+}
+
+func FuzzDelegationsAffectedBySlashing(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate a random number of BTC delegations, where only those
+		// receiving covenant signatures become active
+		numDels := int(datagen.RandomInt(r, 10)) + 1
+		expectedDels := map[string]uint64{}
+		expectedTotalSat := uint64(0)
+		for i := 0; i < numDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			stakingValue := int64(2 * 10e8)
+			stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+				r,
+				delSK,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				stakingValue,
+				1000,
+				0,
+				0,
+				false,
+			)
+			h.NoError(err)
+			if datagen.OneInN(r, 2) {
+				h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+				expectedDels[stakingTxHash] = uint64(stakingValue)
+				expectedTotalSat += uint64(stakingValue)
+			}
+		}
+
+		req := &types.QueryDelegationsAffectedBySlashingRequest{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+		}
+
+		// the finality provider is not slashed yet
+		_, err = h.BTCStakingKeeper.DelegationsAffectedBySlashing(h.Ctx, req)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), types.SlashingReason_SLASHING_REASON_EQUIVOCATION)
+		h.NoError(err)
+		slashedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+
+		resp, err := h.BTCStakingKeeper.DelegationsAffectedBySlashing(h.Ctx, req)
+		h.NoError(err)
+		require.Equal(t, slashedFp.SlashedBabylonHeight, resp.SlashedBabylonHeight)
+		require.Equal(t, slashedFp.SlashedBtcHeight, resp.SlashedBtcHeight)
+		require.Len(t, resp.Delegations, len(expectedDels))
+		for _, del := range resp.Delegations {
+			totalSat, ok := expectedDels[del.StakingTxHashHex]
+			require.True(t, ok)
+			require.Equal(t, totalSat, del.TotalSat)
+		}
+		require.Equal(t, expectedTotalSat, resp.TotalSat)
+
+		// unknown finality provider
+		unknownFpPK, err := datagen.GenRandomBIP340PubKey(r)
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.DelegationsAffectedBySlashing(h.Ctx, &types.QueryDelegationsAffectedBySlashingRequest{
+			FpBtcPkHex: unknownFpPK.MarshalHex(),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	bbn "github.com/babylonlabs-io/babylon/types"
 	btcckpttypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
@@ -16,14 +18,50 @@ type delegationTimeRangeInfo struct {
 }
 
 // VerifyInclusionProofAndGetHeight verifies the inclusion proof of the given staking tx
-// and returns the start height and end height
+// and returns the start height and end height. If maxHeaderSkew is positive, the
+// timestamp of the header that includes the staking tx must not deviate from the
+// timestamp of the BTC tip by more than maxHeaderSkew seconds
 func (k Keeper) VerifyInclusionProofAndGetHeight(
 	ctx sdk.Context,
 	stakingTx *btcutil.Tx,
 	confirmationDepth uint32,
 	stakingTime uint32,
 	minUnbondingTime uint32,
+	maxHeaderSkew uint32,
 	inclusionProof *types.ParsedProofOfInclusion,
+) (*delegationTimeRangeInfo, error) {
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if maxHeaderSkew > 0 {
+		// a missing header is reported when verifying the inclusion proof
+		stakingTxHeader := k.btclcKeeper.GetHeaderByHash(ctx, inclusionProof.HeaderHash)
+		if stakingTxHeader != nil {
+			if err := verifyHeaderSkew(stakingTxHeader.Header, btcTip.Header, maxHeaderSkew); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return k.verifyInclusionProofAtTip(
+		ctx,
+		stakingTx,
+		confirmationDepth,
+		stakingTime,
+		minUnbondingTime,
+		inclusionProof,
+		btcTip.Height,
+	)
+}
+
+// verifyInclusionProofAtTip verifies the inclusion proof of the given staking
+// tx as of the BTC tip at the given height, and returns the start height and
+// end height
+func (k Keeper) verifyInclusionProofAtTip(
+	ctx sdk.Context,
+	stakingTx *btcutil.Tx,
+	confirmationDepth uint32,
+	stakingTime uint32,
+	minUnbondingTime uint32,
+	inclusionProof *types.ParsedProofOfInclusion,
+	btcTipHeight uint32,
 ) (*delegationTimeRangeInfo, error) {
 	// Check:
 	// - timelock of staking tx
@@ -50,16 +88,23 @@ func (k Keeper) VerifyInclusionProofAndGetHeight(
 		return nil, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain")
 	}
 
+	// the header is always below the current BTC tip, but not necessarily
+	// below a historical one
+	if stakingTxHeader.Height > btcTipHeight {
+		return nil, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain as of height %d", btcTipHeight)
+	}
+
 	startHeight := stakingTxHeader.Height
 	endHeight := stakingTxHeader.Height + stakingTime
 
-	btcTip := k.btclcKeeper.GetTipInfo(ctx)
-	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
+	stakingTxDepth := btcTipHeight - stakingTxHeader.Height
 	if stakingTxDepth < confirmationDepth {
 		return nil, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", confirmationDepth, stakingTxDepth)
 	}
-	// ensure staking tx's timelock has more than unbonding BTC blocks left
-	if btcTip.Height+minUnbondingTime >= endHeight {
+	// ensure staking tx's timelock has more than unbonding BTC blocks left.
+	// This also ensures that the BTC height `endHeight - minUnbondingTime`, at
+	// which the BTC delegation is unbonded, does not underflow
+	if uint64(btcTipHeight)+uint64(minUnbondingTime) >= uint64(endHeight) {
 		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than unbonding(=%d) blocks left", minUnbondingTime)
 	}
 
@@ -68,3 +113,20 @@ func (k Keeper) VerifyInclusionProofAndGetHeight(
 		endHeight:   endHeight,
 	}, nil
 }
+
+// verifyHeaderSkew verifies that the timestamp of the given header does not
+// deviate from the timestamp of the given BTC tip by more than maxHeaderSkew
+// seconds. BTC header timestamps are not monotonic, so the header may be
+// later than the tip
+func verifyHeaderSkew(header *bbn.BTCHeaderBytes, btcTip *bbn.BTCHeaderBytes, maxHeaderSkew uint32) error {
+	skew := header.Time().Sub(btcTip.Time())
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > time.Duration(maxHeaderSkew)*time.Second {
+		return types.ErrInvalidStakingTx.Wrapf(
+			"timestamp %s of the header that includes the staking tx deviates from timestamp %s of the BTC tip by more than %d seconds",
+			header.Time().UTC(), btcTip.Time().UTC(), maxHeaderSkew)
+	}
+	return nil
+}
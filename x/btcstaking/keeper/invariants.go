@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// RegisterInvariants registers the btcstaking module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "active-delegation-fps", ActiveDelegationFpsInvariant(k))
+}
+
+// ActiveDelegationFpsInvariant checks that every finality provider that an
+// active BTC delegation restakes to exists.
+// NOTE: an active BTC delegation may restake to a slashed finality provider,
+// as slashing a finality provider removes it from the voting power
+// distribution without changing the status of its BTC delegations
+func ActiveDelegationFpsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// the BTC light client might not be initialised yet, e.g., when the
+		// crisis module asserts invariants upon genesis
+		btcTip := k.btclcKeeper.GetTipInfo(ctx)
+		if btcTip == nil {
+			return sdk.FormatInvariant(types.ModuleName, "active-delegation-fps", "BTC tip is not available\n"), false
+		}
+		btcTipHeight := btcTip.Height
+		wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+		iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			var btcDel types.BTCDelegation
+			k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+			params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if params == nil {
+				count++
+				msg += fmt.Sprintf("\tBTC delegation %s references unknown params version %d\n",
+					btcDel.MustGetStakingTxHash(), btcDel.ParamsVersion)
+				continue
+			}
+			if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+				continue
+			}
+
+			for _, fpBTCPK := range btcDel.FpBtcPkList {
+				if !k.HasFinalityProvider(ctx, fpBTCPK) {
+					count++
+					msg += fmt.Sprintf("\tactive BTC delegation %s restakes to unknown finality provider %s\n",
+						btcDel.MustGetStakingTxHash(), fpBTCPK.MarshalHex())
+				}
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "active-delegation-fps",
+			fmt.Sprintf("amount of dangling finality provider references in active BTC delegations found %d\n%s", count, msg),
+		), broken
+	}
+}
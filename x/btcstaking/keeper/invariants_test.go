@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func FuzzActiveDelegationFpsInvariant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+		invariant := keeper.ActiveDelegationFpsInvariant(*k)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *k, fp)
+		// a finality provider that is never registered
+		_, unknownFpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		unknownFpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(unknownFpPK)
+
+		addBTCDel := func(fpBTCPKs []bbn.BIP340PubKey, active bool) {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				fpBTCPKs,
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				1000, 1, 1001, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			if !active {
+				btcDel.CovenantSigs = nil
+			}
+			err = k.AddBTCDelegation(ctx, btcDel, btcDel.UnbondingTime-1)
+			require.NoError(t, err)
+		}
+
+		// active BTC delegations restaking to the registered finality
+		// provider do not break the invariant
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numBTCDels; i++ {
+			addBTCDel([]bbn.BIP340PubKey{*fp.BtcPk}, true)
+		}
+		_, broken := invariant(ctx)
+		require.False(t, broken)
+
+		// a non-active BTC delegation restaking to an unknown finality
+		// provider does not break the invariant either
+		addBTCDel([]bbn.BIP340PubKey{*unknownFpBTCPK}, false)
+		_, broken = invariant(ctx)
+		require.False(t, broken)
+
+		// an active BTC delegation restaking to an unknown finality provider
+		// breaks the invariant
+		addBTCDel([]bbn.BIP340PubKey{*fp.BtcPk, *unknownFpBTCPK}, true)
+		msg, broken := invariant(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, unknownFpBTCPK.MarshalHex())
+	})
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// FlagEmitJSONEvents is the app option enabling the emission of JSON events,
+// which can be set either in app.toml or as a flag of the start command
+const FlagEmitJSONEvents = "btcstaking.emit-json-events"
+
+// Option configures optional behaviours of the keeper
+type Option func(*Keeper)
+
+// WithJSONEvents sets whether the keeper emits, alongside the typed events
+// of BTC delegation state updates and finality provider creations, events
+// carrying their JSON-serialized payload. It is meant for off-chain consumers
+// that cannot decode protobuf, and is disabled by default to not bloat the
+// event stream of every node.
+func WithJSONEvents(enabled bool) Option {
+	return func(k *Keeper) {
+		k.emitJSONEvents = enabled
+	}
+}
+
+// EmitJSONEvent emits an event carrying the name and the JSON serialization
+// of the given typed event, if JSON events are enabled. It is a no-op
+// otherwise.
+func (k Keeper) EmitJSONEvent(ctx context.Context, ev proto.Message) {
+	if !k.emitJSONEvents {
+		return
+	}
+
+	payload, err := codec.ProtoMarshalJSON(ev, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal %s to JSON: %w", proto.MessageName(ev), err))
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeJSON,
+		sdk.NewAttribute(types.AttributeKeyEventName, proto.MessageName(ev)),
+		sdk.NewAttribute(types.AttributeKeyPayload, string(payload)),
+	))
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func TestEmitJSONEvent(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	ev := types.NewEventFinalityProviderCreated(fp)
+
+	// JSON events are disabled by default
+	k.EmitJSONEvent(ctx, ev)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// once enabled, the typed event is emitted as a JSON event
+	keeper.WithJSONEvents(true)(k)
+	k.EmitJSONEvent(ctx, ev)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeJSON, events[0].Type)
+
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, proto.MessageName(ev), attrs[types.AttributeKeyEventName])
+
+	// the payload is the JSON serialization of the typed event
+	var decoded types.EventFinalityProviderCreated
+	err = jsonpb.UnmarshalString(attrs[types.AttributeKeyPayload], &decoded)
+	require.NoError(t, err)
+	require.Equal(t, ev, &decoded)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/log"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -18,12 +19,20 @@ type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService corestoretypes.KVStoreService
+		tsKey        *storetypes.TransientStoreKey
 
 		btclcKeeper types.BTCLightClientKeeper
 		btccKeeper  types.BtcCheckpointKeeper
 		iKeeper     types.IncentiveKeeper
+		bankKeeper  types.BankKeeper
+		hooks       types.BtcStakingHooks
 
 		btcNet *chaincfg.Params
+		// stakingInfoCache memoizes staking info reconstructed within a block
+		stakingInfoCache *stakingInfoCache
+		// emitJSONEvents indicates whether JSON events are emitted alongside
+		// the typed events, see WithJSONEvents
+		emitJSONEvents bool
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -33,25 +42,46 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
+	tsKey *storetypes.TransientStoreKey,
 
 	btclcKeeper types.BTCLightClientKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	iKeeper types.IncentiveKeeper,
+	bankKeeper types.BankKeeper,
 
 	btcNet *chaincfg.Params,
 	authority string,
+	opts ...Option,
 ) Keeper {
-	return Keeper{
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
+		tsKey:        tsKey,
 
 		btclcKeeper: btclcKeeper,
 		btccKeeper:  btccKeeper,
 		iKeeper:     iKeeper,
+		bankKeeper:  bankKeeper,
 
-		btcNet:    btcNet,
-		authority: authority,
+		btcNet:           btcNet,
+		stakingInfoCache: newStakingInfoCache(),
+		authority:        authority,
 	}
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	return k
+}
+
+// SetHooks sets the btcstaking hooks
+func (k *Keeper) SetHooks(bh types.BtcStakingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set btcstaking hooks twice")
+	}
+	k.hooks = bh
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -64,6 +94,10 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // the voting power distribution cache used for computing voting power table
 // and distributing rewards once the block is finalised by finality providers.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
+	// staking info cached in the previous block might have been built with
+	// params that are no longer valid
+	k.stakingInfoCache.reset()
+
 	// index BTC height at the current height
 	k.IndexBTCHeight(ctx)
 
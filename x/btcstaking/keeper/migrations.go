@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/btcstaking store from consensus version 1 to 2.
+// It sets the params introduced in version 2 to their intended values, and
+// backfills the indices and counters introduced in version 2 from the
+// BTC delegations and finality providers in the store, as they are otherwise
+// only maintained for the ones written after the upgrade.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.migrateAllowFreeCommissionDecrease(ctx)
+
+	fps, err := m.keeper.finalityProviders(ctx)
+	if err != nil {
+		return err
+	}
+	m.keeper.backfillFinalityProviderCommissionHistory(ctx, fps)
+
+	btcDels, err := m.keeper.btcDelegations(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := m.keeper.backfillParamsVersionLiveBTCDelegations(ctx, btcDels); err != nil {
+		return err
+	}
+	m.keeper.backfillBTCDelegationEndHeightIndex(ctx, btcDels)
+	m.keeper.backfillBTCDelegationFpSetIndex(ctx, btcDels)
+	m.keeper.backfillBTCDelegationValueIndex(ctx, btcDels)
+	m.keeper.backfillStakerBTCDelegations(ctx, btcDels)
+	return nil
+}
+
+// migrateAllowFreeCommissionDecrease sets AllowFreeCommissionDecrease of the
+// latest params, which decodes as false from the params stored before it was
+// introduced, to its default of true. The params are overwritten in place
+// without being validated again, so that the upgrade does not fail on
+// validation rules introduced after they were set
+func (k Keeper) migrateAllowFreeCommissionDecrease(ctx context.Context) {
+	sp := mustGetLastParams(ctx, k)
+	sp.Params.AllowFreeCommissionDecrease = true
+	k.paramsStore(ctx).Set(uint32ToBytes(sp.Version), k.cdc.MustMarshal(&sp))
+}
+
+// backfillParamsVersionLiveBTCDelegations rebuilds the number of live BTC
+// delegations referencing each params version, so that the params versions
+// referenced by the BTC delegations created before the upgrade are not pruned
+func (k Keeper) backfillParamsVersionLiveBTCDelegations(ctx context.Context, btcDels []*types.BTCDelegation) error {
+	clearStore(k.paramsVersionLiveBTCDelegationsStore(ctx))
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	for _, btcDel := range btcDels {
+		if err := k.indexParamsVersionLiveBTCDelegation(ctx, btcDel, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillBTCDelegationEndHeightIndex rebuilds the index of BTC delegations
+// by end height, so that the BTC delegations created before the upgrade are
+// expired as well
+func (k Keeper) backfillBTCDelegationEndHeightIndex(ctx context.Context, btcDels []*types.BTCDelegation) {
+	clearStore(k.btcDelegationEndHeightStore(ctx))
+
+	for _, btcDel := range btcDels {
+		if btcDel.HasInclusionProof() && !btcDel.IsUnbondedEarly() && btcDel.ExpiredBtcHeight == 0 {
+			k.setBTCDelegationEndHeightIndex(ctx, btcDel.EndHeight, btcDel.MustGetStakingTxHash())
+		}
+	}
+}
+
+// backfillBTCDelegationFpSetIndex rebuilds the index of BTC delegations by
+// the set of finality providers they restake to, so that the BTC delegations
+// created before the upgrade are found by finality provider set as well
+func (k Keeper) backfillBTCDelegationFpSetIndex(ctx context.Context, btcDels []*types.BTCDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	clearStore(prefix.NewStore(storeAdapter, types.BTCDelegationFpSetKey))
+
+	for _, btcDel := range btcDels {
+		k.setBTCDelegationFpSetIndex(ctx, btcDel.FpBtcPkList, btcDel.MustGetStakingTxHash())
+	}
+}
+
+// backfillBTCDelegationValueIndex rebuilds the index of BTC delegations by
+// staking value, so that the BTC delegations created before the upgrade are
+// found by staking value range as well
+func (k Keeper) backfillBTCDelegationValueIndex(ctx context.Context, btcDels []*types.BTCDelegation) {
+	clearStore(k.btcDelegationValueStore(ctx))
+
+	for _, btcDel := range btcDels {
+		k.setBTCDelegationValueIndex(ctx, btcDel.TotalSat, btcDel.MustGetStakingTxHash())
+	}
+}
+
+// backfillStakerBTCDelegations rebuilds the index of BTC delegations by staker
+// and the number of BTC delegations of each staker that are not unbonded yet,
+// so that the BTC delegations created before the upgrade count towards
+// MaxActiveDelegationsPerStaker
+func (k Keeper) backfillStakerBTCDelegations(ctx context.Context, btcDels []*types.BTCDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	clearStore(prefix.NewStore(storeAdapter, types.BTCDelegationStakerKey))
+	clearStore(k.stakerActiveBTCDelegationStore(ctx))
+
+	for _, btcDel := range btcDels {
+		stakerAddr := sdk.MustAccAddressFromBech32(btcDel.StakerAddr)
+		k.setBTCDelegationStakerIndex(ctx, stakerAddr, btcDel.MustGetStakingTxHash())
+		if !btcDel.IsUnbondedEarly() && btcDel.ExpiredBtcHeight == 0 {
+			k.incrementStakerActiveBTCDelegations(ctx, stakerAddr)
+		}
+	}
+}
+
+// backfillFinalityProviderCommissionHistory records the current commission
+// rate of each finality provider without commission history from the upgrade
+// height onwards, so that the commission rate of the finality providers
+// created before the upgrade is found for the BTC delegations created after it
+func (k Keeper) backfillFinalityProviderCommissionHistory(ctx context.Context, fps []*types.FinalityProvider) {
+	for _, fp := range fps {
+		if !k.hasFinalityProviderCommissionHistory(ctx, fp.BtcPk) {
+			k.setFinalityProviderCommission(ctx, fp.BtcPk, *fp.Commission)
+		}
+	}
+}
+
+// clearStore deletes all keys of the given store, so that a backfill does not
+// double count entries written before it
+func clearStore(store prefix.Store) {
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
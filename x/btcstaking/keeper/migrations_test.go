@@ -0,0 +1,273 @@
+package keeper_test
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func TestMigrate1to2ParamsVersionLiveBTCDelegations(t *testing.T) {
+	r := rand.New(rand.NewSource(17))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	ctx = datagen.WithCtxHeight(ctx, 100)
+
+	// version 0 disables the covenant quorum deadline, while version 1 sets
+	// it to 10 blocks
+	params := types.DefaultParams()
+	params.CovenantQuorumDeadlineBlocks = 10
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// addBTCDelegation stores a BTC delegation as of before the upgrade, i.e.,
+	// without counting it towards its params version
+	addBTCDelegation := func(paramsVersion uint32, creationHeight uint64, expired bool, hasQuorum bool) {
+		stakingTxBytes, err := bbn.SerializeBTCTx(datagen.GenRandomTx(r))
+		require.NoError(t, err)
+		btcDel := &types.BTCDelegation{
+			StakerAddr:      datagen.GenRandomAccount().Address,
+			StakingTx:       stakingTxBytes,
+			ParamsVersion:   paramsVersion,
+			CreationHeight:  creationHeight,
+			BtcUndelegation: &types.BTCUndelegation{},
+		}
+		if expired {
+			btcDel.ExpiredBtcHeight = 100
+		}
+		if hasQuorum {
+			btcDel.CovenantSigs = make([]*types.CovenantAdaptorSignatures, params.CovenantQuorum)
+			btcDel.BtcUndelegation.CovenantSlashingSigs = make([]*types.CovenantAdaptorSignatures, params.CovenantQuorum)
+			btcDel.BtcUndelegation.CovenantUnbondingSigList = make([]*types.SignatureInfo, params.CovenantQuorum)
+			for i := 0; i < int(params.CovenantQuorum); i++ {
+				btcDel.CovenantSigs[i] = &types.CovenantAdaptorSignatures{}
+				btcDel.BtcUndelegation.CovenantSlashingSigs[i] = &types.CovenantAdaptorSignatures{}
+				btcDel.BtcUndelegation.CovenantUnbondingSigList[i] = &types.SignatureInfo{}
+			}
+		}
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := btcDel.MustGetStakingTxHash()
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+	}
+
+	// under version 0, only the BTC delegation that is not expired is live
+	addBTCDelegation(0, 1, false, true)
+	addBTCDelegation(0, 1, true, true)
+	addBTCDelegation(0, 1, true, false)
+	// under version 1, the BTC delegation that is not expired and the expired
+	// one without a covenant quorum whose deadline is not processed yet are
+	// live, while the one whose deadline is processed is not
+	addBTCDelegation(1, 1, false, false)
+	addBTCDelegation(1, 95, true, false)
+	addBTCDelegation(1, 50, true, false)
+
+	// a stale number is overwritten rather than added to
+	k.IncrementParamsVersionLiveBTCDelegations(ctx, 0)
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, k.GetParamsVersionLiveBTCDelegations(ctx, 0))
+	require.EqualValues(t, 2, k.GetParamsVersionLiveBTCDelegations(ctx, 1))
+}
+
+func TestMigrate1to2BTCDelegationEndHeightIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(19))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	dels := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 4, 3)
+	// the second BTC delegation is unbonded early, the third one does not
+	// have an inclusion proof yet and the fourth one is expired, so none of
+	// them is indexed
+	dels[1].BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+	dels[2].StartHeight, dels[2].EndHeight = 0, 0
+	dels[3].ExpiredBtcHeight = dels[3].EndHeight
+	setPreUpgradeBTCDelegations(t, ctx, k, dels)
+	// a stale entry of a BTC delegation that is not found is removed
+	k.SetBTCDelegationEndHeightIndex(ctx, 1, datagen.GenRandomBtcdHash(r))
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	indexed := []string{}
+	k.IterateDelegationsByEndHeightRange(ctx, 0, math.MaxUint32, func(btcDel *types.BTCDelegation) bool {
+		indexed = append(indexed, btcDel.MustGetStakingTxHash().String())
+		return false
+	})
+	require.Equal(t, []string{dels[0].MustGetStakingTxHash().String()}, indexed)
+}
+
+func TestMigrate1to2BTCDelegationFpSetIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(20))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+	k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	dels := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 4, 3)
+	setPreUpgradeBTCDelegations(t, ctx, k, dels)
+	// a stale entry of a BTC delegation that is not found is removed
+	k.SetBTCDelegationFpSetIndex(ctx, dels[0].FpBtcPkList, datagen.GenRandomBtcdHash(r))
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	resp, err := k.DelegationsByFpSet(ctx, &types.QueryDelegationsByFpSetRequest{
+		FpBtcPkHexList: []string{bbn.NewBIP340PubKeyFromBTCPK(fpPK).MarshalHex()},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.BtcDelegations, len(dels))
+}
+
+func TestMigrate1to2BTCDelegationValueIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+	k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	dels := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 4, 3)
+	setPreUpgradeBTCDelegations(t, ctx, k, dels)
+	// a stale entry of a BTC delegation that is not found is removed
+	k.SetBTCDelegationValueIndex(ctx, 10000, datagen.GenRandomBtcdHash(r))
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	resp, err := k.BTCDelegationsByValueRange(ctx, &types.QueryBTCDelegationsByValueRangeRequest{
+		MinSat: 0,
+		MaxSat: math.MaxUint64,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.BtcDelegations, len(dels))
+}
+
+func TestMigrate1to2StakerBTCDelegations(t *testing.T) {
+	r := rand.New(rand.NewSource(22))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	dels := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 4, 3)
+	// the first three BTC delegations share a staker, where the second one is
+	// unbonded early and the third one is expired
+	staker := datagen.GenRandomAccount().GetAddress()
+	for _, btcDel := range dels[:3] {
+		btcDel.StakerAddr = staker.String()
+	}
+	dels[1].BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+	dels[2].ExpiredBtcHeight = dels[2].EndHeight
+	setPreUpgradeBTCDelegations(t, ctx, k, dels)
+	// a stale number is overwritten rather than added to
+	k.IncrementStakerActiveBTCDelegations(ctx, staker)
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1, k.GetStakerActiveBTCDelegations(ctx, staker))
+	require.EqualValues(t, 1, k.GetStakerActiveBTCDelegations(ctx, sdk.MustAccAddressFromBech32(dels[3].StakerAddr)))
+
+	resp, err := k.StakerDelegationAttestation(ctx, &types.QueryStakerDelegationAttestationRequest{
+		StakerAddr: staker.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Delegations, 3)
+}
+
+func TestMigrate1to2FinalityProviderCommissionHistory(t *testing.T) {
+	r := rand.New(rand.NewSource(23))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	ctx = datagen.WithCtxHeight(ctx, 10)
+
+	// the first finality provider has no commission history, while the
+	// second one has a commission rate recorded before the upgrade
+	fp1, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	k.SetFinalityProvider(ctx, fp1)
+	fp2, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	k.SetFinalityProvider(ctx, fp2)
+	recorded := sdkmath.LegacyNewDecWithPrec(5, 2)
+	k.SetFinalityProviderCommissionAt(ctx, fp2.BtcPk, 5, recorded)
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	// the current commission rate of the first finality provider is recorded
+	// from the upgrade height onwards
+	commission, err := k.GetFinalityProviderCommissionAt(ctx, fp1.BtcPk, 10)
+	require.NoError(t, err)
+	require.Equal(t, *fp1.Commission, commission)
+	_, err = k.GetFinalityProviderCommissionAt(ctx, fp1.BtcPk, 9)
+	require.ErrorIs(t, err, types.ErrFpCommissionNotFound)
+
+	// the commission history of the second one is kept as is
+	commission, err = k.GetFinalityProviderCommissionAt(ctx, fp2.BtcPk, 10)
+	require.NoError(t, err)
+	require.Equal(t, recorded, commission)
+}
+
+func TestMigrate1to2AllowFreeCommissionDecrease(t *testing.T) {
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// the params stored before the upgrade decode with
+	// AllowFreeCommissionDecrease unset
+	params := types.DefaultParams()
+	params.AllowFreeCommissionDecrease = false
+	err := k.OverwriteParamsAtVersion(ctx, 0, params)
+	require.NoError(t, err)
+	ctx = datagen.WithCtxHeight(ctx, 10)
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	ctx = datagen.WithCtxHeight(ctx, 20)
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	// only the latest params are updated, keeping their version and
+	// activation height
+	sp := k.GetParamsWithVersion(ctx)
+	require.True(t, sp.Params.AllowFreeCommissionDecrease)
+	require.EqualValues(t, 1, sp.Version)
+	require.EqualValues(t, 10, sp.ActivationHeight)
+	params.AllowFreeCommissionDecrease = true
+	require.Equal(t, params, sp.Params)
+	require.False(t, k.GetParamsByVersion(ctx, 0).AllowFreeCommissionDecrease)
+}
+
+// setPreUpgradeBTCDelegations stores the given BTC delegations as of before
+// the upgrade, i.e., without indexing them
+func setPreUpgradeBTCDelegations(t *testing.T, ctx context.Context, k *keeper.Keeper, dels []*types.BTCDelegation) {
+	for _, btcDel := range dels {
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := btcDel.MustGetStakingTxHash()
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+	}
+}
@@ -75,9 +75,27 @@ func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.Msg
 		return nil, status.Errorf(codes.InvalidArgument, "invalid proof of possession: %v", err)
 	}
 
+	// ensure the finality provider's account holds the minimum balance, if any
+	if minBalance := ms.GetParams(ctx).MinFinalityProviderBalance; minBalance != nil && minBalance.IsPositive() {
+		balance := ms.bankKeeper.GetBalance(ctx, fpAddr, minBalance.Denom)
+		if balance.IsLT(*minBalance) {
+			return nil, types.ErrInsufficientFpBalance.Wrapf(
+				"balance %s is below the minimum %s by %s", balance, minBalance, minBalance.Sub(balance))
+		}
+	}
+
 	if err := ms.AddFinalityProvider(ctx, req); err != nil {
 		return nil, err
 	}
+
+	// process the self delegation, if any. Failing it fails the whole message,
+	// so that the finality provider is not created without it
+	if req.SelfDelegation != nil {
+		if _, err := ms.CreateBTCDelegation(ctx, req.SelfDelegation); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgCreateFinalityProviderResponse{}, nil
 }
 
@@ -100,7 +118,10 @@ func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEd
 	if req.Commission.GT(sdkmath.LegacyOneDec()) {
 		return nil, types.ErrCommissionGTMaxRate
 	}
-
+	// ensure the description is within the length limits in parameters
+	if err := ms.GetParams(goCtx).ValidateDescriptionLength(req.Description); err != nil {
+		return nil, err
+	}
 	// TODO: check to index the finality provider by his address instead of the BTC pk
 	// find the finality provider with the given BTC PK
 	fp, err := ms.GetFinalityProvider(goCtx, req.BtcPk)
@@ -118,10 +139,43 @@ func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEd
 		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
 	}
 
+	// ensure the commission change is within the maximum commission change
+	// rate
+	if err := ms.GetParams(goCtx).ValidateCommissionChange(*fp.Commission, *req.Commission); err != nil {
+		return nil, err
+	}
+
+	// ensure the finality provider was not edited too recently
+	if minInterval := ms.GetParams(goCtx).MinEditIntervalBlocks; minInterval > 0 {
+		if lastEditHeight, ok := ms.getFinalityProviderLastEditHeight(goCtx, fp.BtcPk); ok {
+			height := uint64(sdk.UnwrapSDKContext(goCtx).HeaderInfo().Height)
+			if height < lastEditHeight+minInterval {
+				return nil, types.ErrFpEditTooFrequent.Wrapf(
+					"finality provider %s was last edited at height %d and cannot be edited again before height %d",
+					fp.BtcPk.MarshalHex(), lastEditHeight, lastEditHeight+minInterval)
+			}
+		}
+	}
+
 	// all good, update the finality provider and set back
+	commissionUpdated := !fp.Commission.Equal(*req.Commission)
 	fp.Description = req.Description
 	fp.Commission = req.Commission
 	ms.setFinalityProvider(goCtx, fp)
+	ms.setFinalityProviderCommission(goCtx, fp.BtcPk, *fp.Commission)
+	ms.setFinalityProviderLastEditHeight(goCtx, fp.BtcPk)
+
+	// record the commission update. The next `BeginBlock` will consume this
+	// event for updating the commission in the voting power distribution
+	// cache, which is used for distributing rewards
+	if commissionUpdated {
+		btcTip := ms.btclcKeeper.GetTipInfo(goCtx)
+		if btcTip == nil {
+			return nil, fmt.Errorf("failed to get current BTC tip")
+		}
+		powerUpdateEvent := types.NewEventPowerDistUpdateWithCommissionUpdatedFP(fp.BtcPk, *fp.Commission)
+		ms.addPowerDistUpdateEvent(goCtx, btcTip.Height, powerUpdateEvent)
+	}
 
 	// notify subscriber
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -132,6 +186,44 @@ func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEd
 	return &types.MsgEditFinalityProviderResponse{}, nil
 }
 
+// CancelFinalityProvider cancels the registration of a finality provider that
+// has not received any BTC delegation yet
+func (ms msgServer) CancelFinalityProvider(goCtx context.Context, req *types.MsgCancelFinalityProvider) (*types.MsgCancelFinalityProviderResponse, error) {
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// find the finality provider with the given BTC PK
+	fp, err := ms.GetFinalityProvider(goCtx, req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	fpAddr, err := sdk.AccAddressFromBech32(req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Addr, err)
+	}
+
+	// ensure the signer corresponds to the finality provider's Babylon address
+	if !strings.EqualFold(fpAddr.String(), fp.Addr) {
+		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
+	}
+
+	// all good, delete the finality provider if it has no BTC delegation
+	if err := ms.cancelFinalityProvider(goCtx, fp.BtcPk); err != nil {
+		return nil, err
+	}
+
+	// notify subscriber
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderDeleted(fp)); err != nil {
+		panic(fmt.Errorf("failed to emit EventFinalityProviderDeleted event: %w", err))
+	}
+
+	return &types.MsgCancelFinalityProviderResponse{}, nil
+}
+
 // CreateBTCDelegation creates a BTC delegation
 func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCreateBTCDelegation) (*types.MsgCreateBTCDelegationResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateBTCDelegation)
@@ -183,6 +275,28 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, err
 	}
 
+	// ensure the staker has not reached the maximum number of active BTC
+	// delegations
+	if maxDels := vp.Params.MaxActiveDelegationsPerStaker; maxDels > 0 {
+		numDels := ms.getStakerActiveBTCDelegations(ctx, parsedMsg.StakerAddress)
+		if numDels >= maxDels {
+			return nil, types.ErrTooManyActiveDelegations.Wrapf(
+				"staker %s has %d active BTC delegations, reaching the maximum of %d",
+				parsedMsg.StakerAddress.String(), numDels, maxDels)
+		}
+	}
+
+	// if the staker claims the refund of a previous BTC delegation that did
+	// not receive a covenant quorum before the deadline, the staker is
+	// compensated by refunding this message
+	if parsedMsg.RefundStakingTxHash != nil {
+		if !ms.consumeRefundableBTCDelegation(ctx, parsedMsg.StakerAddress, *parsedMsg.RefundStakingTxHash) {
+			return nil, types.ErrRefundableDelNotFound.Wrapf(
+				"BTC delegation %s of staker %s", parsedMsg.RefundStakingTxHash, parsedMsg.StakerAddress)
+		}
+		ms.iKeeper.IndexRefundableMsg(ctx, req)
+	}
+
 	// 6. If the delegation contains the inclusion proof, we need to verify the proof
 	// and set start height and end height
 	var startHeight, endHeight uint32
@@ -193,6 +307,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 			btccParams.BtcConfirmationDepth,
 			uint32(parsedMsg.StakingTime),
 			paramsValidationResult.MinUnbondingTime,
+			vp.Params.MaxInclusionProofHeaderSkew,
 			parsedMsg.StakingTxProofOfInclusion)
 		if err != nil {
 			return nil, fmt.Errorf("invalid inclusion proof: %w", err)
@@ -232,7 +347,9 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 			CovenantUnbondingSigList: nil, // NOTE: covenant signature will be submitted in a separate msg by covenant
 			DelegatorUnbondingInfo:   nil,
 		},
-		ParamsVersion: vp.Version, // version of the params against delegations was validated
+		ParamsVersion:  vp.Version, // version of the params against delegations was validated
+		RewardAddress:  parsedMsg.RewardAddress.String(),
+		CreationHeight: uint64(ctx.HeaderInfo().Height),
 	}
 
 	// add this BTC delegation, and emit corresponding events
@@ -240,6 +357,11 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
 	}
 
+	// index this BTC delegation by its covenant quorum deadline, if enabled
+	if deadlineBlocks := vp.Params.CovenantQuorumDeadlineBlocks; deadlineBlocks > 0 {
+		ms.setCovenantQuorumDeadlineIndex(ctx, newBTCDel.CreationHeight+deadlineBlocks, stakingTxHash)
+	}
+
 	return &types.MsgCreateBTCDelegationResponse{}, nil
 }
 
@@ -260,17 +382,20 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 
 	// 2. check if the delegation already has inclusion proof
 	if btcDel.HasInclusionProof() {
-		return nil, fmt.Errorf("the delegation %s already has inclusion proof", req.StakingTxHash)
+		return nil, types.ErrInclusionProofAlreadyExists.Wrapf("staking tx hash: %s", req.StakingTxHash)
 	}
 
-	// 3. check if the delegation has received a quorum of covenant sigs
-	if !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
-		return nil, fmt.Errorf("the delegation %s has not received a quorum of covenant signatures", req.StakingTxHash)
+	// 3. check if the delegation has received a quorum of covenant sigs. If
+	// allowed by the current params, the inclusion proof is recorded before
+	// the quorum and the delegation becomes active once the quorum is reached
+	hasQuorum := btcDel.HasCovenantQuorums(params.CovenantQuorum)
+	if !hasQuorum && !ms.GetParams(ctx).AllowInclusionProofBeforeCovenantQuorum {
+		return nil, types.ErrNoCovenantQuorum.Wrapf("staking tx hash: %s, covenant quorum: %d", req.StakingTxHash, params.CovenantQuorum)
 	}
 
 	// 4. check if the delegation is already unbonded
 	if btcDel.BtcUndelegation.DelegatorUnbondingInfo != nil {
-		return nil, fmt.Errorf("the delegation %s is already unbonded", req.StakingTxHash)
+		return nil, types.ErrDelegationAlreadyUnbonded.Wrapf("staking tx hash: %s", req.StakingTxHash)
 	}
 
 	// 5. verify inclusion proof
@@ -293,6 +418,7 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 		btccParams.BtcConfirmationDepth,
 		btcDel.StakingTime,
 		minUnbondingTime,
+		params.MaxInclusionProofHeaderSkew,
 		parsedInclusionProof,
 	)
 
@@ -300,33 +426,43 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 		return nil, fmt.Errorf("invalid inclusion proof: %w", err)
 	}
 
-	// 6. set start height and end height and save it to db
+	// 6. set start height and end height, save it to db and index it by end height
 	btcDel.StartHeight = timeInfo.startHeight
 	btcDel.EndHeight = timeInfo.endHeight
 	ms.setBTCDelegation(ctx, btcDel)
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	ms.setBTCDelegationEndHeightIndex(ctx, btcDel.EndHeight, stakingTxHash)
 
 	// 7. emit events
-	stakingTxHash := btcDel.MustGetStakingTxHash()
+	// without a quorum of covenant sigs, the delegation remains pending and
+	// becomes active upon the covenant sig completing the quorum
+	newState := types.BTCDelegationStatus_PENDING
+	if hasQuorum {
+		newState = types.BTCDelegationStatus_ACTIVE
+	}
 
 	newInclusionProofEvent := types.NewInclusionProofEvent(
 		stakingTxHash.String(),
 		btcDel.StartHeight,
 		btcDel.EndHeight,
-		types.BTCDelegationStatus_ACTIVE,
+		newState,
 	)
 
 	if err := ctx.EventManager().EmitTypedEvents(newInclusionProofEvent); err != nil {
 		panic(fmt.Errorf("failed to emit events for the new active BTC delegation: %w", err))
 	}
+	ms.EmitJSONEvent(ctx, newInclusionProofEvent)
 
-	activeEvent := types.NewEventPowerDistUpdateWithBTCDel(
-		&types.EventBTCDelegationStateUpdate{
-			StakingTxHash: stakingTxHash.String(),
-			NewState:      types.BTCDelegationStatus_ACTIVE,
-		},
-	)
-	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
-	ms.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+	if hasQuorum {
+		activeEvent := types.NewEventPowerDistUpdateWithBTCDel(
+			&types.EventBTCDelegationStateUpdate{
+				StakingTxHash: stakingTxHash.String(),
+				NewState:      types.BTCDelegationStatus_ACTIVE,
+			},
+		)
+		btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+		ms.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+	}
 
 	// record event that the BTC delegation will become unbonded at endHeight-w
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
@@ -344,6 +480,9 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 	return &types.MsgAddBTCDelegationInclusionProofResponse{}, nil
 }
 
+// getBTCDelWithParams returns the BTC delegation with the given staking tx hash
+// along with the params it was validated against. Query handlers should use
+// queryBTCDelWithParams instead, which returns gRPC status errors.
 func (ms msgServer) getBTCDelWithParams(
 	ctx context.Context,
 	stakingTxHash string) (*types.BTCDelegation, *types.Params, error) {
@@ -352,9 +491,9 @@ func (ms msgServer) getBTCDelWithParams(
 		return nil, nil, err
 	}
 
-	bsParams := ms.GetParamsByVersion(ctx, btcDel.ParamsVersion)
-	if bsParams == nil {
-		panic("params version in BTC delegation is not found")
+	bsParams, err := ms.getBTCDelegationParams(ctx, btcDel)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return btcDel, bsParams, nil
@@ -398,6 +537,21 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrInvalidCovenantSig.Wrap("the BTC delegation is already unbonded")
 	}
 
+	// a BTC delegation whose inclusion proof was recorded before the covenant
+	// quorum already has its unbonded event scheduled at
+	// endHeight - minUnbondingTime. Once the BTC tip reaches that height, the
+	// BTC delegation can no longer become active
+	if btcDel.HasInclusionProof() && !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		btccParams := ms.btccKeeper.GetParams(ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(params, &btccParams)
+		if btcTipHeight+minUnbondingTime >= btcDel.EndHeight {
+			ms.Logger(ctx).Debug("Received covenant signature after the BTC delegation is about to expire", "covenant pk", req.Pk.MarshalHex())
+			return nil, types.ErrInvalidCovenantSig.Wrapf(
+				"the BTC delegation is about to expire: BTC tip height %d, end height %d, minimum unbonding time %d",
+				btcTipHeight, btcDel.EndHeight, minUnbondingTime)
+		}
+	}
+
 	// Check that the number of covenant sigs and number of the
 	// finality providers are matched
 	if len(req.SlashingTxSigs) != len(btcDel.FpBtcPkList) {
@@ -406,10 +560,16 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 			len(req.SlashingTxSigs), len(btcDel.FpBtcPkList))
 	}
 
+	// NOTE: we consume gas proportional to the number of adaptor signatures
+	// to be verified, so that the gas cost of the message scales with the
+	// number of finality providers the BTC delegation restakes to
+	verifyGasPerSig := ms.GetParams(ctx).CovenantSigVerifyGasPerSig
+	ctx.GasMeter().ConsumeGas(verifyGasPerSig*uint64(len(req.SlashingTxSigs)), "covenant slashing tx signatures verification")
+
 	/*
 		Verify each covenant adaptor signature over slashing tx
 	*/
-	stakingInfo, err := btcDel.GetStakingInfo(params, ms.btcNet)
+	stakingInfo, err := ms.getStakingInfo(ctx, btcDel, params)
 	if err != nil {
 		panic(fmt.Errorf("failed to get staking info from a verified delegation: %w", err))
 	}
@@ -464,6 +624,7 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 	/*
 		verify each adaptor signature on slashing unbonding tx
 	*/
+	ctx.GasMeter().ConsumeGas(verifyGasPerSig*uint64(len(req.SlashingUnbondingTxSigs)), "covenant unbonding slashing tx signatures verification")
 	unbondingOutput := unbondingMsgTx.TxOut[0] // unbonding tx always have only one output
 	unbondingInfo, err := btcDel.GetUnbondingInfo(params, ms.btcNet)
 	if err != nil {
@@ -589,10 +750,12 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 		delegatorUnbondingInfo = &types.DelegatorUnbondingInfo{
 			// if the stake spending tx is the same as the registered unbonding tx,
 			// we do not need to save it in the database
-			SpendStakeTx: []byte{},
+			SpendStakeTx:          []byte{},
+			SpendStakeTxBtcHeight: stakerSpendigTxHeader.Height,
 		}
 
 		types.EmitEarlyUnbondedEvent(ctx, btcDel.MustGetStakingTxHash().String(), stakerSpendigTxHeader.Height)
+		ms.EmitJSONEvent(ctx, types.NewDelegationUnbondedEarlyEvent(btcDel.MustGetStakingTxHash().String(), stakerSpendigTxHeader.Height))
 	} else {
 		// stakeSpendingTx is not unbonding tx, first we need to verify whether it
 		// acutally spends staking output
@@ -603,12 +766,15 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 			panic(fmt.Errorf("failed to parse staking tx hash from existing delegation with hash %s: %w", req.StakingTxHash, err))
 		}
 
+		// MsgBTCUndelegate carries no staker signature over the unbonding tx,
+		// so any included tx spending the staking output is accepted
 		if !containsInput(stakeSpendingTx, stakingTxHash, btcDel.StakingOutputIdx) {
 			return nil, types.ErrInvalidBTCUndelegateReq.Wrap("stake spending tx does not spend staking output")
 		}
 
 		delegatorUnbondingInfo = &types.DelegatorUnbondingInfo{
-			SpendStakeTx: req.StakeSpendingTx,
+			SpendStakeTx:          req.StakeSpendingTx,
+			SpendStakeTxBtcHeight: stakerSpendigTxHeader.Height,
 		}
 
 		types.EmitUnexpectedUnbondingTxEvent(ctx,
@@ -676,16 +842,18 @@ func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.
 	// adversarial
 
 	// slash the finality provider now
-	if err := ms.SlashFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+	if err := ms.SlashFinalityProvider(ctx, fpBTCPK.MustMarshal(), types.SlashingReason_SLASHING_REASON_SELECTIVE_SLASHING); err != nil {
 		panic(err) // failed to slash the finality provider, must be programming error
 	}
 
-	// emit selective slashing event
+	// record the evidence and emit selective slashing event
 	evidence := &types.SelectiveSlashingEvidence{
 		StakingTxHash:    req.StakingTxHash,
 		FpBtcPk:          fpBTCPK,
 		RecoveredFpBtcSk: fpSK.Serialize(),
+		BlockHeight:      uint64(ctx.HeaderInfo().Height),
 	}
+	ms.setSelectiveSlashingEvidence(ctx, evidence)
 	event := &types.EventSelectiveSlashing{Evidence: evidence}
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
 		panic(fmt.Errorf("failed to emit EventSelectiveSlashing event: %w", err))
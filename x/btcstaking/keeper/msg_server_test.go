@@ -5,26 +5,35 @@ import (
 	"errors"
 	"math"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	appparams "github.com/babylonlabs-io/babylon/app/params"
 	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	testutil "github.com/babylonlabs-io/babylon/testutil/btcstaking-helper"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testhelper "github.com/babylonlabs-io/babylon/testutil/helper"
 	bbn "github.com/babylonlabs-io/babylon/types"
 	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
 )
 
 func FuzzMsgCreateFinalityProvider(f *testing.F) {
@@ -81,6 +90,176 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 	})
 }
 
+func TestCreateFinalityProviderMinBalance(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters, requiring a minimum balance
+	h.GenAndApplyParams(r)
+	minBalance := sdk.NewInt64Coin(appparams.DefaultBondDenom, 1000)
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.MinFinalityProviderBalance = &minBalance
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+
+	createFP := func(balance int64) error {
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		h.BankKeeper.EXPECT().GetBalance(gomock.Any(), sdk.MustAccAddressFromBech32(fp.Addr), appparams.DefaultBondDenom).
+			Return(sdk.NewInt64Coin(appparams.DefaultBondDenom, balance)).Times(1)
+		_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
+			Addr:        fp.Addr,
+			Description: fp.Description,
+			Commission:  fp.Commission,
+			BtcPk:       fp.BtcPk,
+			Pop:         fp.Pop,
+		})
+		return err
+	}
+
+	// the shortfall is reported for a balance below the minimum
+	err = createFP(999)
+	require.ErrorIs(t, err, types.ErrInsufficientFpBalance)
+	require.ErrorContains(t, err, "by 1"+appparams.DefaultBondDenom)
+
+	// the minimum balance is enough
+	err = createFP(1000)
+	require.NoError(t, err)
+}
+
+func TestMsgCreateFinalityProviderCommissionOutOfRange(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+	minCommissionRate := h.BTCStakingKeeper.GetParams(h.Ctx).MinCommissionRate
+
+	for _, tc := range []struct {
+		desc       string
+		commission sdkmath.LegacyDec
+		expErr     error
+	}{
+		{
+			desc:       "commission below the minimum commission rate",
+			commission: minCommissionRate.Sub(sdkmath.LegacyNewDecWithPrec(1, 3)),
+			expErr:     types.ErrCommissionLTMinRate,
+		},
+		{
+			desc:       "commission above 1",
+			commission: sdkmath.LegacyOneDec().Add(sdkmath.LegacyNewDecWithPrec(1, 3)),
+			expErr:     types.ErrCommissionGTMaxRate,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			msg := &types.MsgCreateFinalityProvider{
+				Addr:        fp.Addr,
+				Description: fp.Description,
+				Commission:  &tc.commission,
+				BtcPk:       fp.BtcPk,
+				Pop:         fp.Pop,
+			}
+			_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
+			require.ErrorIs(t, err, tc.expErr)
+			require.False(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+		})
+	}
+}
+
+func TestMsgCreateFinalityProviderWithSelfDelegation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	genMsg := func() *types.MsgCreateFinalityProvider {
+		fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
+		require.NoError(t, err)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, msgCreateBTCDel, _, _, _ := h.CreateDelegationMsg(
+			r,
+			sdk.MustAccAddressFromBech32(fp.Addr),
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+		)
+		return &types.MsgCreateFinalityProvider{
+			Addr:           fp.Addr,
+			Description:    fp.Description,
+			Commission:     fp.Commission,
+			BtcPk:          fp.BtcPk,
+			Pop:            fp.Pop,
+			SelfDelegation: msgCreateBTCDel,
+		}
+	}
+
+	// the finality provider is created and self-delegated
+	msg := genMsg()
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
+	require.NoError(t, err)
+	require.True(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *msg.BtcPk))
+	stakingTx, err := bbn.NewBTCTxFromBytes(msg.SelfDelegation.StakingTx)
+	require.NoError(t, err)
+	btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTx.TxHash().String())
+	require.NoError(t, err)
+	require.Equal(t, msg.Addr, btcDel.StakerAddr)
+	require.Equal(t, []bbn.BIP340PubKey{*msg.BtcPk}, btcDel.FpBtcPkList)
+
+	// the self delegation must be staked by the finality provider address
+	msg = genMsg()
+	msg.SelfDelegation.StakerAddr = datagen.GenRandomAccount().Address
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
+	require.Error(t, err)
+	require.False(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *msg.BtcPk))
+
+	// the self delegation must delegate to the finality provider
+	msg = genMsg()
+	otherFpPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+	msg.SelfDelegation.FpBtcPkList = []bbn.BIP340PubKey{*otherFpPK}
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
+	require.Error(t, err)
+	require.False(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *msg.BtcPk))
+
+	// the whole message fails if the self delegation fails, in which case the
+	// state changes of the message are reverted along with the tx
+	msg = genMsg()
+	msg.SelfDelegation.StakingTime++
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
+	require.Error(t, err)
+}
+
 func FuzzMsgEditFinalityProvider(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -95,6 +274,8 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
 
 		h.GenAndApplyParams(r)
+		btcTipHeight := uint32(datagen.RandomInt(r, 1000)) + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
 
 		// insert the finality provider
 		_, _, fp := h.CreateFinalityProvider(r)
@@ -103,6 +284,9 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 
 		// updated commission and description
 		newCommission := datagen.GenRandomCommission(r)
+		for newCommission.Equal(*fp.Commission) {
+			newCommission = datagen.GenRandomCommission(r)
+		}
 		newDescription := datagen.GenRandomDescription(r)
 
 		// scenario 1: editing finality provider should succeed
@@ -118,6 +302,13 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 		h.NoError(err)
 		require.Equal(t, newCommission, *editedFp.Commission)
 		require.Equal(t, newDescription, editedFp.Description)
+		// the commission update is recorded for the voting power distribution
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTipHeight, btcTipHeight)
+		require.Len(t, events, 1)
+		commissionUpdate := events[0].GetCommissionUpdatedFp()
+		require.NotNil(t, commissionUpdate)
+		require.Equal(t, fp.BtcPk, commissionUpdate.Pk)
+		require.Equal(t, newCommission, *commissionUpdate.Commission)
 
 		// scenario 2: message from an unauthorised signer should fail
 		newCommission = datagen.GenRandomCommission(r)
@@ -136,6 +327,286 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 	})
 }
 
+func TestEditFinalityProviderMaxCommissionChangeRate(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters, limiting commission increases to 0.05 per edit
+	h.GenAndApplyParams(r)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.MinCommissionRate = sdkmath.LegacyZeroDec()
+	maxChangeRate := sdkmath.LegacyMustNewDecFromStr("0.05")
+	params.MaxCommissionChangeRate = &maxChangeRate
+	params.AllowFreeCommissionDecrease = true
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+
+	_, _, fp := h.CreateFinalityProvider(r)
+	editFP := func(commission sdkmath.LegacyDec) error {
+		_, err := h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+			Addr:        fp.Addr,
+			BtcPk:       *fp.BtcPk,
+			Description: fp.Description,
+			Commission:  &commission,
+		})
+		return err
+	}
+
+	// increasing the commission beyond the limit fails
+	commission := *fp.Commission
+	require.ErrorIs(t, editFP(commission.Add(sdkmath.LegacyMustNewDecFromStr("0.06"))), types.ErrCommissionChangeTooLarge)
+	// increasing the commission within the limit succeeds
+	commission = commission.Add(sdkmath.LegacyMustNewDecFromStr("0.05"))
+	require.NoError(t, editFP(commission))
+	// decreasing the commission is not limited
+	require.NoError(t, editFP(sdkmath.LegacyZeroDec()))
+}
+
+func TestEditFinalityProviderMinEditInterval(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters, requiring 10 blocks between edits
+	h.GenAndApplyParams(r)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.MinEditIntervalBlocks = 10
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+
+	_, _, fp := h.CreateFinalityProvider(r)
+	editFP := func() error {
+		commission := datagen.GenRandomCommission(r)
+		_, err := h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+			Addr:        fp.Addr,
+			BtcPk:       *fp.BtcPk,
+			Description: datagen.GenRandomDescription(r),
+			Commission:  &commission,
+		})
+		return err
+	}
+
+	// the first edit is not limited
+	h.SetCtxHeight(100)
+	require.NoError(t, editFP())
+
+	// editing again within the interval fails
+	h.SetCtxHeight(109)
+	require.ErrorIs(t, editFP(), types.ErrFpEditTooFrequent)
+
+	// editing again once the interval has passed succeeds
+	h.SetCtxHeight(110)
+	require.NoError(t, editFP())
+	h.SetCtxHeight(111)
+	require.ErrorIs(t, editFP(), types.ErrFpEditTooFrequent)
+}
+
+func TestFinalityProviderMaxDescriptionLength(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters, limiting the moniker length
+	h.GenAndApplyParams(r)
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.MaxMonikerLength = 10
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+
+	// creating a finality provider with a too long moniker fails
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	msgCreate := &types.MsgCreateFinalityProvider{
+		Addr:        fp.Addr,
+		Description: &stakingtypes.Description{Moniker: strings.Repeat("a", 11)},
+		Commission:  fp.Commission,
+		BtcPk:       fp.BtcPk,
+		Pop:         fp.Pop,
+	}
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msgCreate)
+	require.ErrorIs(t, err, types.ErrDescriptionTooLong)
+
+	// a moniker within the limit is fine
+	msgCreate.Description.Moniker = strings.Repeat("a", 10)
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msgCreate)
+	require.NoError(t, err)
+
+	// editing the finality provider to a too long moniker fails
+	msgEdit := &types.MsgEditFinalityProvider{
+		Addr:        fp.Addr,
+		BtcPk:       *fp.BtcPk,
+		Description: &stakingtypes.Description{Moniker: strings.Repeat("b", 11)},
+		Commission:  fp.Commission,
+	}
+	_, err = h.MsgServer.EditFinalityProvider(h.Ctx, msgEdit)
+	require.ErrorIs(t, err, types.ErrDescriptionTooLong)
+
+	msgEdit.Description.Moniker = strings.Repeat("b", 10)
+	_, err = h.MsgServer.EditFinalityProvider(h.Ctx, msgEdit)
+	require.NoError(t, err)
+}
+
+func FuzzMsgCancelFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		h.GenAndApplyParams(r)
+
+		// insert a finality provider without BTC delegations
+		_, _, fp := h.CreateFinalityProvider(r)
+		require.True(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+
+		// scenario 1: message from an unauthorised signer should fail
+		msg := &types.MsgCancelFinalityProvider{
+			Addr:  datagen.GenRandomAccount().Address,
+			BtcPk: *fp.BtcPk,
+		}
+		_, err := h.MsgServer.CancelFinalityProvider(h.Ctx, msg)
+		require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+		require.True(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+
+		// scenario 2: canceling finality provider without BTC delegations
+		// should succeed and emit a deletion event
+		msg.Addr = fp.Addr
+		_, err = h.MsgServer.CancelFinalityProvider(h.Ctx, msg)
+		h.NoError(err)
+		require.False(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+		_, err = h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		require.ErrorIs(t, err, types.ErrFpNotFound)
+
+		expectedEvent := types.NewEventFinalityProviderDeleted(fp)
+		found := false
+		for _, ev := range h.Ctx.EventManager().Events() {
+			if ev.Type == proto.MessageName(expectedEvent) {
+				found = true
+			}
+		}
+		require.True(t, found)
+
+		// scenario 3: canceling a deleted finality provider should fail
+		_, err = h.MsgServer.CancelFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrFpNotFound)
+
+		// scenario 4: canceling finality provider with a BTC delegation
+		// should fail
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, _, _, _, _, err = h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			datagen.OneInN(r, 2),
+		)
+		h.NoError(err)
+
+		msg = &types.MsgCancelFinalityProvider{
+			Addr:  fp.Addr,
+			BtcPk: *fp.BtcPk,
+		}
+		_, err = h.MsgServer.CancelFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrFpHasDelegations)
+		require.True(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+	})
+}
+
+func TestCancelFinalityProviderDeletesFinalityState(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	h.GenAndApplyParams(r)
+
+	fpSK, _, fp := h.CreateFinalityProvider(r)
+
+	// the finality provider commits public randomness, and accumulates
+	// signing info and missed blocks
+	randListInfo, msgCommitPubRand, err := datagen.GenRandomMsgCommitPubRandList(r, fpSK, 1, 100)
+	require.NoError(t, err)
+	h.FinalityKeeper.SetPubRandCommit(h.Ctx, fp.BtcPk, &ftypes.PubRandCommit{
+		StartHeight: msgCommitPubRand.StartHeight,
+		NumPubRand:  msgCommitPubRand.NumPubRand,
+		Commitment:  msgCommitPubRand.Commitment,
+	})
+	h.FinalityKeeper.SetPubRand(h.Ctx, fp.BtcPk, 1, randListInfo.PRList[0])
+	signingInfo := ftypes.NewFinalityProviderSigningInfo(fp.BtcPk, 1, 1)
+	err = h.FinalityKeeper.FinalityProviderSigningTracker.Set(h.Ctx, fp.BtcPk.MustMarshal(), signingInfo)
+	require.NoError(t, err)
+	err = h.FinalityKeeper.SetMissedBlockBitmapValue(h.Ctx, fp.BtcPk, 0, true)
+	require.NoError(t, err)
+
+	assertNoFinalityState := func() {
+		require.Nil(t, h.FinalityKeeper.GetLastPubRandCommit(h.Ctx, fp.BtcPk))
+		require.False(t, h.FinalityKeeper.HasPubRand(h.Ctx, fp.BtcPk, 1))
+		has, err := h.FinalityKeeper.FinalityProviderSigningTracker.Has(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		require.False(t, has)
+		missedBlocks, err := h.FinalityKeeper.GetFinalityProviderMissedBlocks(h.Ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Empty(t, missedBlocks)
+	}
+
+	// canceling the finality provider deletes its finality state
+	_, err = h.MsgServer.CancelFinalityProvider(h.Ctx, &types.MsgCancelFinalityProvider{
+		Addr:  fp.Addr,
+		BtcPk: *fp.BtcPk,
+	})
+	require.NoError(t, err)
+	assertNoFinalityState()
+
+	// the finality provider can be re-registered with the same BTC PK, and
+	// starts without any finality state
+	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
+		Addr:        fp.Addr,
+		Description: fp.Description,
+		Commission:  fp.Commission,
+		BtcPk:       fp.BtcPk,
+		Pop:         fp.Pop,
+	})
+	require.NoError(t, err)
+	require.True(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+	assertNoFinalityState()
+}
+
 func FuzzCreateBTCDelegation(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -200,8 +671,8 @@ func FuzzCreateBTCDelegation(f *testing.F) {
 	})
 }
 
-func TestProperVersionInDelegation(t *testing.T) {
-	r := rand.New(rand.NewSource(time.Now().Unix()))
+func TestMaxActiveDelegationsPerStaker(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -210,38 +681,109 @@ func TestProperVersionInDelegation(t *testing.T) {
 	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
 
-	// set all parameters
+	// set all parameters, with at most 2 active BTC delegations per staker
 	h.GenAndApplyParams(r)
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.MaxActiveDelegationsPerStaker = 2
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
 
 	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
 	require.NoError(t, err)
-
-	// generate and insert new finality provider
 	_, fpPK, _ := h.CreateFinalityProvider(r)
 
-	// generate and insert new BTC delegation
-	stakingValue := int64(2 * 10e8)
-	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
-	h.NoError(err)
-	stakingTxHash, _, _, _, _, _, err := h.CreateDelegation(
-		r,
-		delSK,
-		fpPK,
-		changeAddress.EncodeAddress(),
-		stakingValue,
-		1000,
-		0,
-		0,
-		false,
-	)
-	h.NoError(err)
-
-	// ensure consistency between the msg and the BTC delegation in DB
-	actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-	h.NoError(err)
-	err = actualDel.ValidateBasic()
-	h.NoError(err)
-	// Current version will be `1` as:
+	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
+	createDelegation := func(staker sdk.AccAddress) (string, error) {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		stakingTxHash, msgCreateBTCDel, _, _, _ := h.CreateDelegationMsg(
+			r,
+			staker,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			true,
+		)
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		return stakingTxHash, err
+	}
+
+	// the staker can create BTC delegations up to the cap
+	stakingTxHash, err := createDelegation(staker)
+	require.NoError(t, err)
+	_, err = createDelegation(staker)
+	require.NoError(t, err)
+
+	// the staker cannot create more BTC delegations
+	_, err = createDelegation(staker)
+	require.ErrorIs(t, err, types.ErrTooManyActiveDelegations)
+
+	// other stakers are not affected
+	_, err = createDelegation(sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address))
+	require.NoError(t, err)
+
+	// expired BTC delegations do not count towards the cap
+	err = h.BTCStakingKeeper.MarkBTCDelegationExpired(h.Ctx, stakingTxHash, 30)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, h.BTCStakingKeeper.GetStakerActiveBTCDelegations(h.Ctx, staker))
+	_, err = createDelegation(staker)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, h.BTCStakingKeeper.GetStakerActiveBTCDelegations(h.Ctx, staker))
+
+	// marking the same BTC delegation as expired again does not change the count
+	err = h.BTCStakingKeeper.MarkBTCDelegationExpired(h.Ctx, stakingTxHash, 31)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, h.BTCStakingKeeper.GetStakerActiveBTCDelegations(h.Ctx, staker))
+	_, err = createDelegation(staker)
+	require.ErrorIs(t, err, types.ErrTooManyActiveDelegations)
+}
+
+func TestProperVersionInDelegation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate and insert new BTC delegation
+	stakingValue := int64(2 * 10e8)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, _, _, _, _, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+		0,
+		0,
+		false,
+	)
+	h.NoError(err)
+
+	// ensure consistency between the msg and the BTC delegation in DB
+	actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	err = actualDel.ValidateBasic()
+	h.NoError(err)
+	// Current version will be `1` as:
 	// - version `0` is initialized by `NewHelper`
 	// - version `1` is set by `GenAndApplyParams`
 	require.Equal(t, uint32(1), actualDel.ParamsVersion)
@@ -249,6 +791,7 @@ func TestProperVersionInDelegation(t *testing.T) {
 	customMinUnbondingTime := uint32(2000)
 	currentParams := h.BTCStakingKeeper.GetParams(h.Ctx)
 	currentParams.MinUnbondingTimeBlocks = 2000
+	currentParams.MinStakingTimeBlocks = 2001
 	// Update new params
 	err = h.BTCStakingKeeper.SetParams(h.Ctx, currentParams)
 	require.NoError(t, err)
@@ -273,92 +816,635 @@ func TestProperVersionInDelegation(t *testing.T) {
 	require.Equal(t, uint32(2), actualDel1.ParamsVersion)
 }
 
-func FuzzAddCovenantSigs(f *testing.F) {
-	datagen.AddRandomSeedsToFuzzer(f, 10)
+func FuzzAddCovenantSigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		usePreApproval := datagen.OneInN(r, 2)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, _, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			usePreApproval,
+		)
+		h.NoError(err)
+
+		// ensure consistency between the msg and the BTC delegation in DB
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		// delegation is not activated by covenant yet
+		require.False(h.T(), actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// ensure the system does not panick due to a bogus covenant sig request
+		bogusMsg := *msgs[0]
+		bogusMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &bogusMsg)
+		h.Error(err)
+
+		for i, msg := range msgs {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+			// check that submitting the same covenant signature returns error
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.Error(err, "i: %d", i)
+		}
+
+		// ensure the BTC delegation now has voting power
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(h.T(), actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+		require.True(h.T(), actualDel.BtcUndelegation.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+
+		tipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		checkpointTimeout := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+		status := actualDel.GetStatus(tipHeight, checkpointTimeout, covenantQuorum)
+		votingPower := actualDel.VotingPower(tipHeight, checkpointTimeout, covenantQuorum)
+
+		if usePreApproval {
+			require.Equal(t, status, types.BTCDelegationStatus_VERIFIED)
+			require.Zero(t, votingPower)
+		} else {
+			require.Equal(t, status, types.BTCDelegationStatus_ACTIVE)
+			require.Equal(t, uint64(stakingValue), votingPower)
+		}
+	})
+}
+
+func TestAddCovenantSigsGasConsumption(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate and insert new BTC delegation
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+		0,
+		0,
+		false,
+	)
+	h.NoError(err)
+
+	msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+	numSigs := uint64(len(msgs[0].SlashingTxSigs) + len(msgs[0].SlashingUnbondingTxSigs))
+
+	// the BTC tip is mocked against h.Ctx only, so return the same tip under
+	// the derived contexts below
+	btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx)
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(btcTip).AnyTimes()
+
+	// submits the same covenant signatures under the given gas per signature
+	// and returns the consumed gas
+	gasConsumed := func(gasPerSig uint64) uint64 {
+		ctx, _ := h.Ctx.CacheContext()
+		params := h.BTCStakingKeeper.GetParams(ctx)
+		params.CovenantSigVerifyGasPerSig = gasPerSig
+		err := h.BTCStakingKeeper.SetParams(ctx, params)
+		h.NoError(err)
+
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err = h.MsgServer.AddCovenantSigs(ctx, msgs[0])
+		h.NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the consumed gas grows by the gas per signature for each verified
+	// adaptor signature
+	gasPerSig := uint64(datagen.RandomInt(r, 1000)) + 1000
+	require.Equal(t, 1000*numSigs, gasConsumed(gasPerSig+1000)-gasConsumed(gasPerSig))
+}
+
+func FuzzAddBTCDelegationInclusionProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+		)
+		h.NoError(err)
+
+		// add covenant signatures to this BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+
+		// ensure the BTC delegation is now verified and does not have voting power
+		tipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		checkpointTimeout := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+		status := actualDel.GetStatus(tipHeight, checkpointTimeout, covenantQuorum)
+		votingPower := actualDel.VotingPower(tipHeight, checkpointTimeout, covenantQuorum)
+
+		require.Equal(t, status, types.BTCDelegationStatus_VERIFIED)
+		require.Zero(t, votingPower)
+
+		// activate the BTC delegation, such that the BTC delegation becomes active
+		// and has voting power
+		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof)
+
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		status = actualDel.GetStatus(tipHeight, checkpointTimeout, covenantQuorum)
+		votingPower = actualDel.VotingPower(tipHeight, checkpointTimeout, covenantQuorum)
+
+		require.Equal(t, status, types.BTCDelegationStatus_ACTIVE)
+		require.Equal(t, uint64(stakingValue), votingPower)
+	})
+}
+
+func TestAddBTCDelegationInclusionProofBeforeCovenantQuorum(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate and insert new BTC delegation
+	stakingValue := int64(2 * 10e8)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+		0,
+		0,
+		true,
+	)
+	h.NoError(err)
+
+	btcTipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+	checkpointTimeout := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+	btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeaderInfo.Header.Hash())).Return(btcHeaderInfo).AnyTimes()
+	msg := &types.MsgAddBTCDelegationInclusionProof{
+		StakingTxHash:           stakingTxHash,
+		StakingTxInclusionProof: inclusionProof,
+	}
+	hasActiveEvent := func() bool {
+		for _, event := range h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTipHeight, btcTipHeight) {
+			delEvent := event.GetBtcDelStateUpdate()
+			if delEvent != nil && delEvent.StakingTxHash == stakingTxHash && delEvent.NewState == types.BTCDelegationStatus_ACTIVE {
+				return true
+			}
+		}
+		return false
+	}
+
+	// by default, the inclusion proof is rejected before the covenant quorum
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, msg)
+	require.ErrorIs(t, err, types.ErrNoCovenantQuorum)
+
+	// once allowed, the inclusion proof is recorded before the covenant quorum
+	// while the BTC delegation remains pending
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.AllowInclusionProofBeforeCovenantQuorum = true
+	err = h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, msg)
+	h.NoError(err)
+
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.True(t, actualDel.HasInclusionProof())
+	require.Equal(t, types.BTCDelegationStatus_PENDING, actualDel.GetStatus(btcTipHeight, checkpointTimeout, params.CovenantQuorum))
+	require.False(t, hasActiveEvent())
+
+	// the inclusion proof cannot be submitted twice
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, msg)
+	require.ErrorIs(t, err, types.ErrInclusionProofAlreadyExists)
+
+	// the BTC delegation becomes active once the covenant quorum is reached
+	for _, covMsg := range h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel) {
+		_, err := h.MsgServer.AddCovenantSigs(h.Ctx, covMsg)
+		h.NoError(err)
+	}
+
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.Equal(t, types.BTCDelegationStatus_ACTIVE, actualDel.GetStatus(btcTipHeight, checkpointTimeout, params.CovenantQuorum))
+	require.Equal(t, uint64(stakingValue), actualDel.VotingPower(btcTipHeight, checkpointTimeout, params.CovenantQuorum))
+	require.True(t, hasActiveEvent())
+}
+
+func TestAddCovenantSigsAfterExpiryBeforeCovenantQuorum(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters, allowing inclusion proofs before covenant quorum
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.AllowInclusionProofBeforeCovenantQuorum = true
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate and insert new BTC delegation
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+		0,
+		0,
+		true,
+	)
+	h.NoError(err)
+
+	// record the inclusion proof before the covenant quorum
+	btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeaderInfo.Header.Hash())).Return(btcHeaderInfo).AnyTimes()
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+		StakingTxHash:           stakingTxHash,
+		StakingTxInclusionProof: inclusionProof,
+	})
+	h.NoError(err)
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+
+	// the BTC tip reaches the height of the scheduled unbonded event
+	btccParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+	minUnbondingTime := types.MinimumUnbondingTime(&params, &btccParams)
+	h.SetCtxHeight(uint64(h.Ctx.HeaderInfo().Height) + 1)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: actualDel.EndHeight - minUnbondingTime}).AnyTimes()
+
+	// the covenant signatures can no longer activate the BTC delegation
+	covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+	_, err = h.MsgServer.AddCovenantSigs(h.Ctx, covMsgs[0])
+	require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.Empty(t, actualDel.CovenantSigs)
+}
+
+func TestAddBTCDelegationInclusionProofToUnbondedDelegation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate and insert new BTC delegation with a covenant quorum but
+	// without inclusion proof
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+		0,
+		0,
+		true,
+	)
+	h.NoError(err)
+	h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+	// the staker unbonds the BTC delegation before its inclusion proof is
+	// submitted
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	actualDel.BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+	btcDelBytes, err := actualDel.Marshal()
+	require.NoError(t, err)
+	btcDelKey := actualDel.MustGetStakingTxHash()
+	h.BTCStakingKeeper.BTCDelegationStore(h.Ctx).Set(btcDelKey[:], btcDelBytes)
+
+	btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeaderInfo.Header.Hash())).Return(btcHeaderInfo).AnyTimes()
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+		StakingTxHash:           stakingTxHash,
+		StakingTxInclusionProof: inclusionProof,
+	})
+	require.ErrorIs(t, err, types.ErrDelegationAlreadyUnbonded)
+}
+
+func TestAddBTCDelegationInclusionProofHeaderSkew(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// submits the inclusion proof of a new BTC delegation with a covenant
+	// quorum under the given maximum header skew
+	addInclusionProof := func(maxHeaderSkew uint32) error {
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		params.MaxInclusionProofHeaderSkew = maxHeaderSkew
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+		h.NoError(err)
 
-	f.Fuzz(func(t *testing.T, seed int64) {
-		r := rand.New(rand.NewSource(seed))
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			true,
+		)
+		h.NoError(err)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
 
-		// mock BTC light client and BTC checkpoint modules
-		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
-		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
-		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+		btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeaderInfo.Header.Hash())).Return(btcHeaderInfo).AnyTimes()
+		_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+			StakingTxHash:           stakingTxHash,
+			StakingTxInclusionProof: inclusionProof,
+		})
+		return err
+	}
 
-		// set all parameters
-		covenantSKs, _ := h.GenAndApplyParams(r)
+	// the BTC tip is mined about 200 minutes after the header that includes
+	// the staking tx
+	err = addInclusionProof(uint32((time.Hour).Seconds()))
+	require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+	err = addInclusionProof(uint32((24 * time.Hour).Seconds()))
+	require.NoError(t, err)
+}
 
-		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
-		require.NoError(t, err)
+func TestCreateBTCDelegationStakingTimeNotLargerThanW(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-		// generate and insert new finality provider
-		_, fpPK, _ := h.CreateFinalityProvider(r)
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
 
-		usePreApproval := datagen.OneInN(r, 2)
+	// set all parameters, such that the minimum staking time is lower than w
+	w := uint32(100)
+	h.GenAndApplyCustomParams(r, w, 0)
+	require.Less(t, h.BTCStakingKeeper.GetParams(h.Ctx).MinStakingTimeBlocks, w)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
 
-		// generate and insert new BTC delegation
-		stakingValue := int64(2 * 10e8)
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// a BTC delegation whose staking time does not exceed w is rejected, with
+	// or without inclusion proof, instead of underflowing the BTC height at
+	// which it is unbonded
+	for _, usePreApproval := range []bool{true, false} {
 		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
-		h.NoError(err)
-		stakingTxHash, msgCreateBTCDel, _, _, _, _, err := h.CreateDelegation(
+		require.NoError(t, err)
+		_, _, _, _, _, _, err = h.CreateDelegation(
 			r,
 			delSK,
 			fpPK,
 			changeAddress.EncodeAddress(),
-			stakingValue,
-			1000,
+			int64(2*10e8),
+			uint16(w),
 			0,
 			0,
 			usePreApproval,
 		)
-		h.NoError(err)
+		require.ErrorIs(t, err, types.ErrStakingTimeTooShort)
+	}
+}
 
-		// ensure consistency between the msg and the BTC delegation in DB
-		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-		h.NoError(err)
-		// delegation is not activated by covenant yet
-		require.False(h.T(), actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+func TestProcessCovenantQuorumDeadlines(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
 
-		// ensure the system does not panick due to a bogus covenant sig request
-		bogusMsg := *msgs[0]
-		bogusMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
-		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &bogusMsg)
-		h.Error(err)
+	// set all parameters, with a covenant quorum deadline of 10 blocks
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.CovenantQuorumDeadlineBlocks = 10
+	params.DeleteDelegationsPastCovenantQuorumDeadline = true
+	err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
 
-		for i, msg := range msgs {
-			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
-			h.NoError(err)
-			// check that submitting the same covenant signature returns error
-			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
-			h.Error(err, "i: %d", i)
-		}
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
 
-		// ensure the BTC delegation now has voting power
-		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-		h.NoError(err)
-		require.True(h.T(), actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
-		require.True(h.T(), actualDel.BtcUndelegation.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+	// the same staker creates a BTC delegation that never receives covenant
+	// signatures and one that receives a covenant quorum
+	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
+	createDelegation := func() *types.MsgCreateBTCDelegation {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, msgCreateBTCDel, _, _, _ := h.CreateDelegationMsg(
+			r, staker, delSK, fpPK, changeAddress.EncodeAddress(), int64(2*10e8), 1000, 0, 0, true)
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.NoError(t, err)
+		return msgCreateBTCDel
+	}
+	stuckMsg := createDelegation()
+	stuckTx, err := bbn.NewBTCTxFromBytes(stuckMsg.StakingTx)
+	require.NoError(t, err)
+	signedMsg := createDelegation()
+	signedTx, err := bbn.NewBTCTxFromBytes(signedMsg.StakingTx)
+	require.NoError(t, err)
+	signedDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, signedTx.TxHash().String())
+	require.NoError(t, err)
+	h.CreateCovenantSigs(r, covenantSKs, signedMsg, signedDel)
 
-		tipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
-		checkpointTimeout := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
-		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
-		status := actualDel.GetStatus(tipHeight, checkpointTimeout, covenantQuorum)
-		votingPower := actualDel.VotingPower(tipHeight, checkpointTimeout, covenantQuorum)
+	refundableBTCDelegations := func() []string {
+		resp, err := h.BTCStakingKeeper.RefundableBTCDelegations(h.Ctx, &types.QueryRefundableBTCDelegationsRequest{
+			StakerAddr: staker.String(),
+		})
+		require.NoError(t, err)
+		return resp.StakingTxHashHexList
+	}
 
-		if usePreApproval {
-			require.Equal(t, status, types.BTCDelegationStatus_VERIFIED)
-			require.Zero(t, votingPower)
-		} else {
-			require.Equal(t, status, types.BTCDelegationStatus_ACTIVE)
-			require.Equal(t, uint64(stakingValue), votingPower)
-		}
-	})
+	// nothing happens before the deadline
+	h.SetCtxHeight(10)
+	h.BTCStakingKeeper.ProcessCovenantQuorumDeadlines(h.Ctx)
+	_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stuckTx.TxHash().String())
+	require.NoError(t, err)
+	require.Empty(t, refundableBTCDelegations())
+
+	// at the deadline, the BTC delegation without covenant quorum is deleted
+	// and becomes refundable, while the other one is left untouched
+	h.SetCtxHeight(11)
+	h.BTCStakingKeeper.ProcessCovenantQuorumDeadlines(h.Ctx)
+	_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stuckTx.TxHash().String())
+	require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, signedTx.TxHash().String())
+	require.NoError(t, err)
+	require.Equal(t, []string{stuckTx.TxHash().String()}, refundableBTCDelegations())
+	// the deleted BTC delegation no longer counts towards the staker's BTC
+	// delegations that are not unbonded yet
+	require.EqualValues(t, 1, h.BTCStakingKeeper.GetStakerActiveBTCDelegations(h.Ctx, staker))
+
+	// the deleted BTC delegation can be submitted again, claiming its refund
+	stuckMsg.RefundStakingTxHash = stuckTx.TxHash().String()
+	_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, stuckMsg)
+	require.NoError(t, err)
+	require.Empty(t, refundableBTCDelegations())
+
+	// the refund cannot be claimed twice
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	_, msgCreateBTCDel, _, _, _ := h.CreateDelegationMsg(
+		r, staker, delSK, fpPK, changeAddress.EncodeAddress(), int64(2*10e8), 1000, 0, 0, true)
+	msgCreateBTCDel.RefundStakingTxHash = stuckTx.TxHash().String()
+	_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+	require.ErrorIs(t, err, types.ErrRefundableDelNotFound)
+
+	// a BTC delegation whose params version is not found is skipped rather
+	// than halting the chain
+	orphanMsg := createDelegation()
+	orphanTx, err := bbn.NewBTCTxFromBytes(orphanMsg.StakingTx)
+	require.NoError(t, err)
+	orphanDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, orphanTx.TxHash().String())
+	require.NoError(t, err)
+	err = h.BTCStakingKeeper.SetParams(h.Ctx, params)
+	require.NoError(t, err)
+	h.BTCStakingKeeper.DeleteParamsVersion(h.Ctx, orphanDel.ParamsVersion)
+	h.SetCtxHeight(21)
+	require.NotPanics(t, func() { h.BTCStakingKeeper.ProcessCovenantQuorumDeadlines(h.Ctx) })
+	require.Empty(t, refundableBTCDelegations())
+	_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, orphanTx.TxHash().String())
+	require.NoError(t, err)
 }
 
-func FuzzAddBTCDelegationInclusionProof(f *testing.F) {
+func FuzzPowerDistUpdateScheduledEvents(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -379,11 +1465,12 @@ func FuzzAddBTCDelegationInclusionProof(f *testing.F) {
 		// generate and insert new finality provider
 		_, fpPK, _ := h.CreateFinalityProvider(r)
 
-		// generate and insert new BTC delegation
+		// generate and insert new BTC delegation with inclusion proof, then
+		// activate it via covenant signatures
 		stakingValue := int64(2 * 10e8)
 		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
 		h.NoError(err)
-		stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+		stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
 			r,
 			delSK,
 			fpPK,
@@ -392,37 +1479,45 @@ func FuzzAddBTCDelegationInclusionProof(f *testing.F) {
 			1000,
 			0,
 			0,
-			true,
+			false,
 		)
 		h.NoError(err)
-
-		// add covenant signatures to this BTC delegation
 		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
 
-		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-		h.NoError(err)
-
-		// ensure the BTC delegation is now verified and does not have voting power
-		tipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
-		checkpointTimeout := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
-		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
-		status := actualDel.GetStatus(tipHeight, checkpointTimeout, covenantQuorum)
-		votingPower := actualDel.VotingPower(tipHeight, checkpointTimeout, covenantQuorum)
-
-		require.Equal(t, status, types.BTCDelegationStatus_VERIFIED)
-		require.Zero(t, votingPower)
-
-		// activate the BTC delegation, such that the BTC delegation becomes active
-		// and has voting power
-		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof)
-
-		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-		h.NoError(err)
-		status = actualDel.GetStatus(tipHeight, checkpointTimeout, covenantQuorum)
-		votingPower = actualDel.VotingPower(tipHeight, checkpointTimeout, covenantQuorum)
+		// collect all scheduled power distribution update events
+		scheduledEvents := []*types.EventPowerDistUpdateScheduled{}
+		for _, abciEvent := range h.Ctx.EventManager().ABCIEvents() {
+			if abciEvent.Type != proto.MessageName(&types.EventPowerDistUpdateScheduled{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(abciEvent)
+			h.NoError(err)
+			scheduledEvent, ok := msg.(*types.EventPowerDistUpdateScheduled)
+			require.True(t, ok)
+			scheduledEvents = append(scheduledEvents, scheduledEvent)
+		}
 
-		require.Equal(t, status, types.BTCDelegationStatus_ACTIVE)
-		require.Equal(t, uint64(stakingValue), votingPower)
+		// the BTC delegation is scheduled to become unbonded upon creation
+		// and to become active upon reaching the covenant quorum
+		require.Len(t, scheduledEvents, 2)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, scheduledEvents[0].NewState)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, scheduledEvents[1].NewState)
+		require.Equal(t, h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height, scheduledEvents[1].BtcHeight)
+
+		// each emitted event matches an event stored at the same BTC height
+		for _, scheduledEvent := range scheduledEvents {
+			require.Equal(t, stakingTxHash, scheduledEvent.StakingTxHash)
+
+			storedEvents := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, scheduledEvent.BtcHeight, scheduledEvent.BtcHeight)
+			found := false
+			for _, storedEvent := range storedEvents {
+				delEvent := storedEvent.GetBtcDelStateUpdate()
+				if delEvent != nil && delEvent.StakingTxHash == scheduledEvent.StakingTxHash && delEvent.NewState == scheduledEvent.NewState {
+					found = true
+				}
+			}
+			require.True(t, found)
+		}
 	})
 }
 
@@ -480,6 +1575,17 @@ func FuzzBTCUndelegate(f *testing.F) {
 		status := actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
 		require.Equal(t, types.BTCDelegationStatus_ACTIVE, status)
 
+		// ensure the BTC delegation is indexed under its end height
+		endHeightIndexed := func() bool {
+			found := false
+			h.BTCStakingKeeper.IterateDelegationsByEndHeightRange(h.Ctx, actualDel.EndHeight, actualDel.EndHeight, func(btcDel *types.BTCDelegation) bool {
+				found = found || btcDel.MustGetStakingTxHash().String() == stakingTxHash
+				return false
+			})
+			return found
+		}
+		require.True(t, endHeightIndexed())
+
 		msg := &types.MsgBTCUndelegate{
 			Signer:                        datagen.GenRandomAccount().Address,
 			StakingTxHash:                 stakingTxHash,
@@ -502,7 +1608,101 @@ func FuzzBTCUndelegate(f *testing.F) {
 		h.NoError(err)
 		status = actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
 		require.Equal(t, types.BTCDelegationStatus_UNBONDED, status)
+		// ensure the BTC height of the stake spending tx is recorded
+		require.Equal(t, unbondingInfo.UnbondingHeaderInfo.Height, actualDel.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTxBtcHeight)
+		// ensure the BTC delegation no longer counts towards its staker's BTC
+		// delegations that are not unbonded yet
+		require.Zero(t, h.BTCStakingKeeper.GetStakerActiveBTCDelegations(h.Ctx, sdk.MustAccAddressFromBech32(actualDel.StakerAddr)))
+
+		// ensure the BTC delegation is no longer indexed under its end height
+		require.False(t, endHeightIndexed())
+	})
+}
+
+func TestBTCUndelegateWithStakeSpendingTxOtherThanUnbondingTx(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate, cover and activate a BTC delegation
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+		0,
+		0,
+		true,
+	)
+	h.NoError(err)
+	h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+	h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof)
+
+	// includeTx includes the given tx in a new BTC block known by the BTC
+	// light client, and returns the inclusion proof of the tx
+	includeTx := func(tx *wire.MsgTx) *types.InclusionProof {
+		prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+		blockWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, tx)
+		header := blockWithProof.HeaderBytes
+		headerInfo := &btclctypes.BTCHeaderInfo{Header: &header, Height: 12}
+		btclcKeeper.EXPECT().GetHeaderByHash(gomock.Any(), gomock.Eq(header.Hash())).Return(headerInfo).AnyTimes()
+		return types.NewInclusionProof(
+			&btcctypes.TransactionKey{Index: 1, Hash: header.Hash()},
+			blockWithProof.SpvProof.MerkleNodes,
+		)
+	}
+
+	unbondingTx, err := bbn.NewBTCTxFromBytes(actualDel.BtcUndelegation.UnbondingTx)
+	require.NoError(t, err)
+
+	// a stake spending tx that does not spend the staking output is rejected
+	otherTx := unbondingTx.Copy()
+	otherTx.TxIn[0].PreviousOutPoint.Hash = datagen.GenRandomBtcdHash(r)
+	otherTxBytes, err := bbn.SerializeBTCTx(otherTx)
+	require.NoError(t, err)
+	_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+		Signer:                        datagen.GenRandomAccount().Address,
+		StakingTxHash:                 stakingTxHash,
+		StakeSpendingTx:               otherTxBytes,
+		StakeSpendingTxInclusionProof: includeTx(otherTx),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidBTCUndelegateReq)
+
+	// a stake spending tx that spends the staking output but is not the
+	// registered unbonding tx unbonds the BTC delegation, and is recorded
+	spendingTx := unbondingTx.Copy()
+	spendingTx.LockTime++
+	spendingTxBytes, err := bbn.SerializeBTCTx(spendingTx)
+	require.NoError(t, err)
+	_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+		Signer:                        datagen.GenRandomAccount().Address,
+		StakingTxHash:                 stakingTxHash,
+		StakeSpendingTx:               spendingTxBytes,
+		StakeSpendingTxInclusionProof: includeTx(spendingTx),
 	})
+	h.NoError(err)
+
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.True(t, actualDel.IsUnbondedEarly())
+	require.Equal(t, spendingTxBytes, actualDel.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTx)
+	require.Equal(t, uint32(12), actualDel.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTxBtcHeight)
 }
 
 func FuzzSelectiveSlashing(f *testing.F) {
@@ -574,10 +1774,22 @@ func FuzzSelectiveSlashing(f *testing.F) {
 		_, err = h.MsgServer.SelectiveSlashingEvidence(h.Ctx, msg)
 		h.NoError(err)
 
-		// ensure the finality provider is slashed
+		// ensure the finality provider is slashed due to selective slashing
 		slashedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fpBtcPk.MustMarshal())
 		h.NoError(err)
 		require.True(t, slashedFp.IsSlashed())
+		fpResp, err := h.BTCStakingKeeper.FinalityProvider(h.Ctx, &types.QueryFinalityProviderRequest{FpBtcPkHex: fpBtcPk.MarshalHex()})
+		h.NoError(err)
+		require.Equal(t, types.SlashingReason_SLASHING_REASON_SELECTIVE_SLASHING, fpResp.FinalityProvider.SlashingReason)
+
+		// ensure the evidence is recorded
+		evidenceResp, err := h.BTCStakingKeeper.SelectiveSlashingEvidenceList(h.Ctx, &types.QuerySelectiveSlashingEvidenceListRequest{})
+		h.NoError(err)
+		require.Len(t, evidenceResp.Evidences, 1)
+		require.Equal(t, msg.StakingTxHash, evidenceResp.Evidences[0].StakingTxHash)
+		require.True(t, fpBtcPk.Equals(evidenceResp.Evidences[0].FpBtcPk))
+		require.Equal(t, msg.RecoveredFpBtcSk, evidenceResp.Evidences[0].RecoveredFpBtcSk)
+		require.Equal(t, uint64(h.Ctx.HeaderInfo().Height), evidenceResp.Evidences[0].BlockHeight)
 	})
 }
 
@@ -668,6 +1880,89 @@ func FuzzSelectiveSlashing_StakingTx(f *testing.F) {
 	})
 }
 
+func TestSelectiveSlashingAfterParamsPruning(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	fpSK, fpPK, _ := h.CreateFinalityProvider(r)
+	fpBtcPk := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+
+	// generate and insert new BTC delegation
+	stakingValue := int64(2 * 10e8)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, unbondingInfo, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+		0,
+		0,
+		true,
+	)
+	h.NoError(err)
+
+	// add covenant signatures and activate the BTC delegation
+	h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+	h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof)
+
+	// unbond the BTC delegation early
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+		Signer:                        datagen.GenRandomAccount().Address,
+		StakingTxHash:                 stakingTxHash,
+		StakeSpendingTx:               actualDel.BtcUndelegation.UnbondingTx,
+		StakeSpendingTxInclusionProof: unbondingInfo.UnbondingTxInclusionProof,
+	})
+	h.NoError(err)
+
+	// update the params several times while retaining only the latest params
+	// version, so that all params versions but the one of the BTC delegation
+	// unbonded early and those after it are prunable
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.MinRetainedParamsVersions = 1
+	for i := 0; i < 3; i++ {
+		_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Params:    params,
+		})
+		h.NoError(err)
+	}
+	// prune the params versions, as in EndBlocker
+	h.BTCStakingKeeper.PruneParamsVersions(h.Ctx)
+	versions := h.BTCStakingKeeper.GetParamsVersions(h.Ctx)
+	require.Equal(t, actualDel.ParamsVersion, versions[0])
+
+	// the finality provider can still be slashed by the BTC delegation
+	// unbonded early
+	_, err = h.MsgServer.SelectiveSlashingEvidence(h.Ctx, &types.MsgSelectiveSlashingEvidence{
+		Signer:           datagen.GenRandomAccount().Address,
+		StakingTxHash:    stakingTxHash,
+		RecoveredFpBtcSk: fpSK.Serialize(),
+	})
+	h.NoError(err)
+
+	slashedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fpBtcPk.MustMarshal())
+	h.NoError(err)
+	require.True(t, slashedFp.IsSlashed())
+}
+
 func TestDoNotAllowDelegationWithoutFinalityProvider(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // cosmos-sdk does not have utils for uint32
@@ -51,6 +52,19 @@ func (k Keeper) nextParamsVersion(ctx context.Context) uint32 {
 	return mustUint32FromBytes(it.Key()) + 1
 }
 
+// firstParamsVersion returns the oldest params version that is not pruned
+func (k Keeper) firstParamsVersion(ctx context.Context) uint32 {
+	paramsStore := k.paramsStore(ctx)
+	it := paramsStore.Iterator(nil, nil)
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0
+	}
+
+	return mustUint32FromBytes(it.Key())
+}
+
 func (k Keeper) getLastParams(ctx context.Context) *types.StoredParams {
 	paramsStore := k.paramsStore(ctx)
 	it := paramsStore.ReverseIterator(nil, nil)
@@ -66,19 +80,23 @@ func (k Keeper) getLastParams(ctx context.Context) *types.StoredParams {
 
 // SetParams sets the x/btcstaking module parameters.
 func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	return k.setParamsAtVersion(ctx, k.nextParamsVersion(ctx), p)
+}
+
+func (k Keeper) setParamsAtVersion(ctx context.Context, v uint32, p types.Params) error {
 	if err := p.Validate(); err != nil {
 		return err
 	}
 
-	nextVersion := k.nextParamsVersion(ctx)
 	paramsStore := k.paramsStore(ctx)
 
 	sp := types.StoredParams{
-		Params:  p,
-		Version: nextVersion,
+		Params:           p,
+		Version:          v,
+		ActivationHeight: uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height),
 	}
 
-	paramsStore.Set(uint32ToBytes(nextVersion), k.cdc.MustMarshal(&sp))
+	paramsStore.Set(uint32ToBytes(v), k.cdc.MustMarshal(&sp))
 	return nil
 }
 
@@ -96,10 +114,10 @@ func (k Keeper) OverwriteParamsAtVersion(ctx context.Context, v uint32, p types.
 		return fmt.Errorf("params at version %d not found", v)
 	}
 
-	sp := types.StoredParams{
-		Params:  p,
-		Version: v,
-	}
+	// the overwritten params keep the activation height of the version
+	var sp types.StoredParams
+	k.cdc.MustUnmarshal(spBytes, &sp)
+	sp.Params = p
 
 	paramsStore.Set(uint32ToBytes(v), k.cdc.MustMarshal(&sp))
 	return nil
@@ -120,6 +138,21 @@ func (k Keeper) GetAllParams(ctx context.Context) []*types.Params {
 	return p
 }
 
+// GetParamsVersions returns all the params versions that are not pruned in
+// ascending order
+func (k Keeper) GetParamsVersions(ctx context.Context) []uint32 {
+	paramsStore := k.paramsStore(ctx)
+	it := paramsStore.Iterator(nil, nil)
+	defer it.Close()
+
+	var versions []uint32
+	for ; it.Valid(); it.Next() {
+		versions = append(versions, mustUint32FromBytes(it.Key()))
+	}
+
+	return versions
+}
+
 func (k Keeper) GetParamsByVersion(ctx context.Context, v uint32) *types.Params {
 	paramsStore := k.paramsStore(ctx)
 	spBytes := paramsStore.Get(uint32ToBytes(v))
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// PruneParamsVersions prunes the oldest params versions that are no longer
+// referenced by any BTC delegation that can still be slashed or become
+// active. The latest `MinRetainedParamsVersions` params versions are always
+// retained. Params versions are pruned in ascending order so that the
+// retained params versions are always contiguous.
+// It is invoked in every EndBlocker. Each pruned params version costs a
+// single lookup of the number of live BTC delegations referencing it, so it
+// does not iterate over the BTC delegations.
+func (k Keeper) PruneParamsVersions(ctx context.Context) {
+	lastParams := k.GetParamsWithVersion(ctx)
+	minRetained := lastParams.Params.MinRetainedParamsVersions
+	if minRetained == 0 || lastParams.Version < minRetained {
+		// pruning is disabled, or there are not enough params versions yet
+		return
+	}
+
+	// params versions before pruneBefore are not among the latest
+	// `MinRetainedParamsVersions` ones
+	pruneBefore := lastParams.Version + 1 - minRetained
+	firstVersion := k.firstParamsVersion(ctx)
+
+	// never prune a params version referenced by a live BTC delegation, nor
+	// any params version after it
+	paramsStore := k.paramsStore(ctx)
+	v := firstVersion
+	for ; v < pruneBefore && k.getParamsVersionLiveBTCDelegations(ctx, v) == 0; v++ {
+		paramsStore.Delete(uint32ToBytes(v))
+	}
+	if v == firstVersion {
+		return
+	}
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Info(
+		"pruned params versions",
+		"first_pruned_version", firstVersion,
+		"last_pruned_version", v-1,
+	)
+}
+
+// isLiveBTCDelegation returns whether the given BTC delegation is live at the
+// given Babylon height, i.e., whether its params version must be retained.
+// A BTC delegation is live unless its unbonded event due to its timelock
+// being about to expire has been processed. In particular, a BTC delegation
+// that is unbonded early remains live forever, as selective slashing evidence
+// against it is accepted at any time. An expired BTC delegation without a
+// covenant quorum remains live until its covenant quorum deadline is
+// processed, which requires its params.
+func isLiveBTCDelegation(btcDel *types.BTCDelegation, params *types.Params, height uint64) bool {
+	if btcDel.ExpiredBtcHeight == 0 || btcDel.IsUnbondedEarly() {
+		return true
+	}
+	if btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		return false
+	}
+	return isCovenantQuorumDeadlinePending(btcDel, params, height)
+}
+
+// isCovenantQuorumDeadlinePending returns whether the covenant quorum deadline
+// of the given BTC delegation is not processed yet at the given Babylon
+// height. The deadline is processed in the EndBlocker of its height
+func isCovenantQuorumDeadlinePending(btcDel *types.BTCDelegation, params *types.Params, height uint64) bool {
+	return params.CovenantQuorumDeadlineBlocks > 0 &&
+		btcDel.CreationHeight+params.CovenantQuorumDeadlineBlocks >= height
+}
+
+// indexParamsVersionLiveBTCDelegation counts the given BTC delegation towards
+// the live BTC delegations of its params version if it is live at the given
+// Babylon height. It is used for rebuilding the counts from the BTC
+// delegations. It returns an error if the params version of a live BTC
+// delegation is not found
+func (k Keeper) indexParamsVersionLiveBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation, height uint64) error {
+	p := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if p == nil {
+		// the params version of a BTC delegation that is no longer live
+		// might be pruned already
+		if btcDel.ExpiredBtcHeight == 0 || btcDel.IsUnbondedEarly() {
+			return fmt.Errorf("params version %d of live BTC delegation %s is not found",
+				btcDel.ParamsVersion, btcDel.MustGetStakingTxHash())
+		}
+		return nil
+	}
+	if isLiveBTCDelegation(btcDel, p, height) {
+		k.incrementParamsVersionLiveBTCDelegations(ctx, btcDel.ParamsVersion)
+	}
+	return nil
+}
+
+// incrementParamsVersionLiveBTCDelegations increments the number of live BTC
+// delegations referencing the given params version
+func (k Keeper) incrementParamsVersionLiveBTCDelegations(ctx context.Context, version uint32) {
+	store := k.paramsVersionLiveBTCDelegationsStore(ctx)
+	count := k.getParamsVersionLiveBTCDelegations(ctx, version)
+	store.Set(uint32ToBytes(version), sdk.Uint64ToBigEndian(count+1))
+}
+
+// decrementParamsVersionLiveBTCDelegations decrements the number of live BTC
+// delegations referencing the given params version
+func (k Keeper) decrementParamsVersionLiveBTCDelegations(ctx context.Context, version uint32) {
+	store := k.paramsVersionLiveBTCDelegationsStore(ctx)
+	count := k.getParamsVersionLiveBTCDelegations(ctx, version)
+	if count <= 1 {
+		store.Delete(uint32ToBytes(version))
+		return
+	}
+	store.Set(uint32ToBytes(version), sdk.Uint64ToBigEndian(count-1))
+}
+
+// getParamsVersionLiveBTCDelegations returns the number of live BTC
+// delegations referencing the given params version
+func (k Keeper) getParamsVersionLiveBTCDelegations(ctx context.Context, version uint32) uint64 {
+	bz := k.paramsVersionLiveBTCDelegationsStore(ctx).Get(uint32ToBytes(version))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// paramsVersionLiveBTCDelegationsStore returns the KVStore of the number of
+// live BTC delegations referencing each params version
+// prefix: ParamsVersionLiveBTCDelegationsKey
+// key: params version
+// value: number of live BTC delegations
+func (k Keeper) paramsVersionLiveBTCDelegationsStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ParamsVersionLiveBTCDelegationsKey)
+}
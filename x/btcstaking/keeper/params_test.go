@@ -9,6 +9,7 @@ import (
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
@@ -67,6 +68,7 @@ func FuzzParamsVersioning(f *testing.F) {
 			// randomize two parameters so each params are slightly different
 			params.MinSlashingTxFeeSat = r.Int63()
 			params.MinUnbondingTimeBlocks = uint32(r.Intn(math.MaxUint16))
+			params.MinStakingTimeBlocks = params.MinUnbondingTimeBlocks + 1
 			err := k.SetParams(ctx, params)
 			require.NoError(t, err)
 			generatedParams = append(generatedParams, &params)
@@ -92,3 +94,163 @@ func FuzzParamsVersioning(f *testing.F) {
 		require.EqualValues(t, lastParams, *lastVer)
 	})
 }
+
+func TestPruneParamsVersions(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// versions 0 to 5, where the latest params retain at least 2 versions
+	params := types.DefaultParams()
+	params.MinRetainedParamsVersions = 2
+	for i := 0; i < 5; i++ {
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []uint32{0, 1, 2, 3, 4, 5}, k.GetParamsVersions(ctx))
+
+	// covenant signatures making up a quorum under the params
+	covSigs := make([]*types.CovenantAdaptorSignatures, params.CovenantQuorum)
+	covUnbondingSigs := make([]*types.SignatureInfo, params.CovenantQuorum)
+	for i := range covSigs {
+		covSigs[i] = &types.CovenantAdaptorSignatures{}
+		covUnbondingSigs[i] = &types.SignatureInfo{}
+	}
+
+	// addBTCDelegation stores a live BTC delegation with a covenant quorum
+	// referencing the given params version
+	addBTCDelegation := func(paramsVersion uint32, unbondedEarly bool) string {
+		stakingTxBytes, err := bbn.SerializeBTCTx(datagen.GenRandomTx(r))
+		require.NoError(t, err)
+		btcDel := &types.BTCDelegation{
+			StakerAddr:    datagen.GenRandomAccount().Address,
+			StakingTx:     stakingTxBytes,
+			ParamsVersion: paramsVersion,
+			CovenantSigs:  covSigs,
+			BtcUndelegation: &types.BTCUndelegation{
+				CovenantSlashingSigs:     covSigs,
+				CovenantUnbondingSigList: covUnbondingSigs,
+			},
+		}
+		if unbondedEarly {
+			btcDel.BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{}
+		}
+		bz, err := btcDel.Marshal()
+		require.NoError(t, err)
+		stakingTxHash := btcDel.MustGetStakingTxHash()
+		k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+		k.IncrementParamsVersionLiveBTCDelegations(ctx, paramsVersion)
+		return stakingTxHash.String()
+	}
+
+	// versions 1 and 2 are referenced by BTC delegations that can become
+	// active, and version 3 is referenced by a BTC delegation that is unbonded
+	// early, which can still be slashed
+	delV1 := addBTCDelegation(1, false)
+	delV2 := addBTCDelegation(2, false)
+	delV3 := addBTCDelegation(3, true)
+
+	// only version 0 is pruned
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{1, 2, 3, 4, 5}, k.GetParamsVersions(ctx))
+
+	// once the BTC delegation referencing version 2 is expired, version 2 is
+	// retained as long as version 1 is, so that retained versions remain
+	// contiguous
+	err := k.MarkBTCDelegationExpired(ctx, delV2, 100)
+	require.NoError(t, err)
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{1, 2, 3, 4, 5}, k.GetParamsVersions(ctx))
+
+	// once the BTC delegation referencing version 1 is expired as well, both
+	// versions are pruned
+	err = k.MarkBTCDelegationExpired(ctx, delV1, 100)
+	require.NoError(t, err)
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{3, 4, 5}, k.GetParamsVersions(ctx))
+
+	// version 3 remains retained even after the BTC delegation unbonded early
+	// has its unbonded event processed
+	err = k.MarkBTCDelegationExpired(ctx, delV3, 100)
+	require.NoError(t, err)
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{3, 4, 5}, k.GetParamsVersions(ctx))
+
+	resp, err := k.ParamsVersions(ctx, &types.QueryParamsVersionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{3, 4, 5}, resp.Versions)
+
+	// new params versions are appended after the retained ones
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, k.GetParamsWithVersion(ctx).Version)
+
+	// expiring a BTC delegation whose params version is missing is an error
+	// rather than being skipped
+	delV5 := addBTCDelegation(5, false)
+	k.DeleteParamsVersion(ctx, 5)
+	err = k.MarkBTCDelegationExpired(ctx, delV5, 100)
+	require.ErrorIs(t, err, types.ErrParamsNotFound)
+}
+
+func TestPruneParamsVersionsExpiredWithoutCovenantQuorum(t *testing.T) {
+	r := rand.New(rand.NewSource(18))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	params := types.DefaultParams()
+	params.MinRetainedParamsVersions = 1
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// a BTC delegation without a covenant quorum referencing version 0, which
+	// disables the covenant quorum deadline
+	stakingTxBytes, err := bbn.SerializeBTCTx(datagen.GenRandomTx(r))
+	require.NoError(t, err)
+	btcDel := &types.BTCDelegation{
+		StakerAddr:      datagen.GenRandomAccount().Address,
+		StakingTx:       stakingTxBytes,
+		BtcUndelegation: &types.BTCUndelegation{},
+	}
+	bz, err := btcDel.Marshal()
+	require.NoError(t, err)
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	k.BTCDelegationStore(ctx).Set(stakingTxHash[:], bz)
+	k.IncrementParamsVersionLiveBTCDelegations(ctx, 0)
+
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{0, 1}, k.GetParamsVersions(ctx))
+
+	// once expired, the BTC delegation can no longer get a covenant quorum,
+	// so its params version is pruned
+	err = k.MarkBTCDelegationExpired(ctx, stakingTxHash.String(), 100)
+	require.NoError(t, err)
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{1}, k.GetParamsVersions(ctx))
+}
+
+func TestPruneParamsVersionsRetainsLatest(t *testing.T) {
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	params := types.DefaultParams()
+	params.MinRetainedParamsVersions = 2
+	for i := 0; i < 5; i++ {
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+	}
+
+	// without BTC delegations, all versions but the latest 2 are pruned
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{4, 5}, k.GetParamsVersions(ctx))
+}
+
+func TestPruneParamsVersionsDisabled(t *testing.T) {
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	for i := 0; i < 5; i++ {
+		err := k.SetParams(ctx, types.DefaultParams())
+		require.NoError(t, err)
+	}
+
+	// the default params do not prune params versions
+	k.PruneParamsVersions(ctx)
+	require.Equal(t, []uint32{0, 1, 2, 3, 4, 5}, k.GetParamsVersions(ctx))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
@@ -12,7 +13,8 @@ import (
 /* voting power distribution update event store */
 
 // addPowerDistUpdateEvent appends an event that affect voting power distribution
-// to the store
+// to the store. If the event is a state update of a BTC delegation, an
+// EventPowerDistUpdateScheduled is also emitted at scheduling time
 func (k Keeper) addPowerDistUpdateEvent(
 	ctx context.Context,
 	btcHeight uint32,
@@ -31,6 +33,15 @@ func (k Keeper) addPowerDistUpdateEvent(
 
 	// key is event index, and value is the event bytes
 	store.Set(sdk.Uint64ToBigEndian(eventIdx), k.cdc.MustMarshal(event))
+
+	// notify external systems in advance about the scheduled state update
+	// of the BTC delegation
+	if delEvent := event.GetBtcDelStateUpdate(); delEvent != nil {
+		scheduledEvent := types.NewEventPowerDistUpdateScheduled(btcHeight, delEvent)
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(scheduledEvent); err != nil {
+			panic(fmt.Errorf("failed to emit EventPowerDistUpdateScheduled: %w", err))
+		}
+	}
 }
 
 // ClearPowerDistUpdateEvents removes all BTC delegation state update events
@@ -70,6 +81,24 @@ func (k Keeper) GetAllPowerDistUpdateEvents(ctx context.Context, lastBTCTip uint
 	return events
 }
 
+// GetNextPowerDistUpdateHeight returns the smallest BTC height, starting from
+// the current BTC tip, that has a pending voting power distribution update
+// event. It returns false if there is no such BTC height
+func (k Keeper) GetNextPowerDistUpdateHeight(ctx context.Context) (uint32, bool) {
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	// events are keyed by (BTC height || event index), so the first key
+	// no smaller than the BTC tip carries the next BTC height
+	store := k.powerDistUpdateEventStore(ctx)
+	iter := store.Iterator(sdk.Uint64ToBigEndian(uint64(btcTipHeight)), nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+
+	return uint32(sdk.BigEndianToUint64(iter.Key()[:8])), true
+}
+
 // iteratePowerDistUpdateEvents uses the given handler function to handle each
 // voting power distribution update event that happens at the given BTC height.
 // This is called in `BeginBlocker`
@@ -32,3 +32,62 @@ func (k Keeper) ParamsByVersion(goCtx context.Context, req *types.QueryParamsByV
 
 	return &types.QueryParamsByVersionResponse{Params: *pv}, nil
 }
+
+func (k Keeper) ParamsVersions(goCtx context.Context, req *types.QueryParamsVersionsRequest) (*types.QueryParamsVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsVersionsResponse{Versions: k.GetParamsVersions(ctx)}, nil
+}
+
+// CurrentParams returns the latest params together with their version, their
+// activation height, and the authority allowed to update them
+func (k Keeper) CurrentParams(goCtx context.Context, req *types.QueryCurrentParamsRequest) (*types.QueryCurrentParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sp := k.GetParamsWithVersion(ctx)
+
+	return &types.QueryCurrentParamsResponse{
+		Params:           sp.Params,
+		Version:          sp.Version,
+		ActivationHeight: sp.ActivationHeight,
+		Authority:        k.authority,
+	}, nil
+}
+
+// UnbondingParams returns the exact unbonding fee and the minimum unbonding
+// time the unbonding tx of a BTC delegation is validated against under the
+// latest params
+func (k Keeper) UnbondingParams(goCtx context.Context, req *types.QueryUnbondingParamsRequest) (*types.QueryUnbondingParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StakingValueSat < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "staking value %d must not be negative", req.StakingValueSat)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sp := k.GetParamsWithVersion(ctx)
+	btccParams := k.btccKeeper.GetParams(ctx)
+
+	resp := &types.QueryUnbondingParamsResponse{
+		UnbondingFeeSat:        sp.Params.UnbondingFeeSat,
+		MinUnbondingTimeBlocks: types.MinimumUnbondingTime(&sp.Params, &btccParams),
+		Version:                sp.Version,
+	}
+
+	if req.StakingValueSat > 0 {
+		if req.StakingValueSat <= sp.Params.UnbondingFeeSat {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"staking value %d must be larger than the unbonding fee %d", req.StakingValueSat, sp.Params.UnbondingFeeSat)
+		}
+		resp.UnbondingOutputValueSat = req.StakingValueSat - sp.Params.UnbondingFeeSat
+	}
+
+	return resp, nil
+}
@@ -3,9 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
@@ -27,10 +32,13 @@ func TestParamsByVersionQuery(t *testing.T) {
 	// starting with `1` as BTCStakingKeeper creates params with version 0
 	params1 := types.DefaultParams()
 	params1.MinUnbondingTimeBlocks = 10000
+	params1.MinStakingTimeBlocks = 10000 + 1
 	params2 := types.DefaultParams()
 	params2.MinUnbondingTimeBlocks = 20000
+	params2.MinStakingTimeBlocks = 20000 + 1
 	params3 := types.DefaultParams()
 	params3.MinUnbondingTimeBlocks = 30000
+	params3.MinStakingTimeBlocks = 30000 + 1
 
 	// Check that after update we always return the latest version of params through Params query
 	err := keeper.SetParams(ctx, params1)
@@ -64,3 +72,92 @@ func TestParamsByVersionQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsByVersionResponse{Params: params3}, resp2)
 }
+
+func TestCurrentParamsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// BTCStakingKeeper creates params with version 0
+	response, err := keeper.CurrentParams(ctx, &types.QueryCurrentParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), response.Version)
+	require.Equal(t, authority, response.Authority)
+
+	// update the params at a later height
+	params := types.DefaultParams()
+	params.MinSlashingTxFeeSat = 23400
+	header := sdkCtx.HeaderInfo()
+	header.Height = 100
+	sdkCtx = sdkCtx.WithHeaderInfo(header)
+	err = keeper.SetParams(sdkCtx, params)
+	require.NoError(t, err)
+
+	response, err = keeper.CurrentParams(sdkCtx, &types.QueryCurrentParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCurrentParamsResponse{
+		Params:           params,
+		Version:          1,
+		ActivationHeight: 100,
+		Authority:        authority,
+	}, response)
+
+	// overwriting the params keeps the version and the activation height
+	params.MinSlashingTxFeeSat = 34500
+	header.Height = 200
+	sdkCtx = sdkCtx.WithHeaderInfo(header)
+	err = keeper.OverwriteParamsAtVersion(sdkCtx, 1, params)
+	require.NoError(t, err)
+
+	response, err = keeper.CurrentParams(sdkCtx, &types.QueryCurrentParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, response.Params)
+	require.Equal(t, uint32(1), response.Version)
+	require.Equal(t, uint64(100), response.ActivationHeight)
+}
+
+func TestUnbondingParamsQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btccParams := btcctypes.DefaultParams()
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btccParams).AnyTimes()
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, btccKeeper, nil)
+
+	params := types.DefaultParams()
+	params.UnbondingFeeSat = 1500
+	params.MinUnbondingTimeBlocks = btccParams.CheckpointFinalizationTimeout + 10
+	params.MinStakingTimeBlocks = params.MinUnbondingTimeBlocks + 1
+	err := keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// without staking value, only the fee and the unbonding time are returned
+	response, err := keeper.UnbondingParams(ctx, &types.QueryUnbondingParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryUnbondingParamsResponse{
+		UnbondingFeeSat:        1500,
+		MinUnbondingTimeBlocks: params.MinUnbondingTimeBlocks,
+		Version:                1,
+	}, response)
+
+	// the unbonding output value is derived from the staking value
+	response, err = keeper.UnbondingParams(ctx, &types.QueryUnbondingParamsRequest{StakingValueSat: 100000})
+	require.NoError(t, err)
+	require.Equal(t, int64(98500), response.UnbondingOutputValueSat)
+
+	// the staking value has to cover the unbonding fee
+	_, err = keeper.UnbondingParams(ctx, &types.QueryUnbondingParamsRequest{StakingValueSat: 1500})
+	require.Error(t, err)
+	_, err = keeper.UnbondingParams(ctx, &types.QueryUnbondingParamsRequest{StakingValueSat: -1})
+	require.Error(t, err)
+
+	// the checkpoint finalization timeout prevails if it is bigger
+	params.MinUnbondingTimeBlocks = btccParams.CheckpointFinalizationTimeout - 1
+	err = keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+	response, err = keeper.UnbondingParams(ctx, &types.QueryUnbondingParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, btccParams.CheckpointFinalizationTimeout, response.MinUnbondingTimeBlocks)
+	require.Equal(t, uint32(2), response.Version)
+}
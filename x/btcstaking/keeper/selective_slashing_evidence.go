@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// setSelectiveSlashingEvidence records the given selective slashing evidence
+// at the block height it was submitted at. A finality provider can be slashed
+// only once, thus there is at most one evidence per finality provider
+func (k Keeper) setSelectiveSlashingEvidence(ctx context.Context, evidence *types.SelectiveSlashingEvidence) {
+	store := k.selectiveSlashingEvidenceStore(ctx)
+	key := selectiveSlashingEvidenceKey(evidence)
+	store.Set(key, k.cdc.MustMarshal(evidence))
+}
+
+func selectiveSlashingEvidenceKey(evidence *types.SelectiveSlashingEvidence) []byte {
+	return append(sdk.Uint64ToBigEndian(evidence.BlockHeight), evidence.FpBtcPk.MustMarshal()...)
+}
+
+// selectiveSlashingEvidenceStore returns the KVStore of the selective slashing
+// evidences
+// prefix: SelectiveSlashingEvidenceKey
+// key: (block height || finality provider BTC PK)
+// value: SelectiveSlashingEvidence
+func (k Keeper) selectiveSlashingEvidenceStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SelectiveSlashingEvidenceKey)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// stakingInfoCacheKey identifies a reconstructed staking info by the staking tx
+// hash of the BTC delegation and the version of the params the BTC delegation
+// was validated against
+type stakingInfoCacheKey struct {
+	stakingTxHash chainhash.Hash
+	paramsVersion uint32
+}
+
+// stakingInfoCache memoizes the staking info of BTC delegations within a block.
+// Reconstructing the staking info requires building the taproot script tree of
+// the staking output, which is expensive and is repeated by every covenant
+// member submitting signatures for the same BTC delegation.
+// The cache is reset at the beginning of each block so that entries never
+// outlive the params they were built from, and is only used when executing
+// the txs of a block, so that CheckTx and simulations, which run concurrently
+// against other states, never share it.
+type stakingInfoCache struct {
+	mu    sync.Mutex
+	infos map[stakingInfoCacheKey]*btcstaking.StakingInfo
+}
+
+func newStakingInfoCache() *stakingInfoCache {
+	return &stakingInfoCache{
+		infos: make(map[stakingInfoCacheKey]*btcstaking.StakingInfo),
+	}
+}
+
+func (c *stakingInfoCache) get(key stakingInfoCacheKey) (*btcstaking.StakingInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	info, ok := c.infos[key]
+	return info, ok
+}
+
+func (c *stakingInfoCache) set(key stakingInfoCacheKey, info *btcstaking.StakingInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.infos[key] = info
+}
+
+// reset removes all entries from the cache
+func (c *stakingInfoCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.infos = make(map[stakingInfoCacheKey]*btcstaking.StakingInfo)
+}
+
+// getStakingInfo returns the staking info of the given BTC delegation built
+// with the given params. When executing the txs of a block, it reuses the
+// staking info reconstructed earlier in the same block if there is any
+func (k Keeper) getStakingInfo(ctx context.Context, btcDel *types.BTCDelegation, params *types.Params) (*btcstaking.StakingInfo, error) {
+	if sdk.UnwrapSDKContext(ctx).ExecMode() != sdk.ExecModeFinalize {
+		return btcDel.GetStakingInfo(params, k.btcNet)
+	}
+
+	stakingTxHash, err := btcDel.GetStakingTxHash()
+	if err != nil {
+		return nil, err
+	}
+	key := stakingInfoCacheKey{
+		stakingTxHash: stakingTxHash,
+		paramsVersion: btcDel.ParamsVersion,
+	}
+
+	if info, ok := k.stakingInfoCache.get(key); ok {
+		return info, nil
+	}
+
+	info, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, err
+	}
+	k.stakingInfoCache.set(key, info)
+
+	return info, nil
+}
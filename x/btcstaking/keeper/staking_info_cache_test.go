@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+)
+
+func TestStakingInfoCacheOnlyInFinalizeBlock(t *testing.T) {
+	r := rand.New(rand.NewSource(24))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	params := k.GetParams(ctx)
+
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	btcDel := createNDelegationsForFinalityProvider(r, t, fpPK, 10000, 1, 3)[0]
+
+	// the staking info is rebuilt in CheckTx and simulations, without being
+	// cached for the txs of the block
+	for _, mode := range []sdk.ExecMode{sdk.ExecModeCheck, sdk.ExecModeReCheck, sdk.ExecModeSimulate} {
+		modeCtx := ctx.WithExecMode(mode)
+		info1, err := k.GetStakingInfo(modeCtx, btcDel, &params)
+		require.NoError(t, err)
+		info2, err := k.GetStakingInfo(modeCtx, btcDel, &params)
+		require.NoError(t, err)
+		require.NotSame(t, info1, info2)
+		require.Equal(t, info1.StakingOutput, info2.StakingOutput)
+	}
+
+	// the staking info is reused within the txs of the block
+	finalizeCtx := ctx.WithExecMode(sdk.ExecModeFinalize)
+	info1, err := k.GetStakingInfo(finalizeCtx, btcDel, &params)
+	require.NoError(t, err)
+	info2, err := k.GetStakingInfo(finalizeCtx, btcDel, &params)
+	require.NoError(t, err)
+	require.Same(t, info1, info2)
+
+	// and is still not shared with CheckTx
+	info3, err := k.GetStakingInfo(ctx.WithExecMode(sdk.ExecModeCheck), btcDel, &params)
+	require.NoError(t, err)
+	require.NotSame(t, info1, info3)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -19,21 +20,41 @@ import (
 	bbn "github.com/babylonlabs-io/babylon/types"
 )
 
+// ParseBTCDelegationStatus parses the given case-insensitive name of a BTC
+// delegation status, e.g., "active" or "ACTIVE"
+func ParseBTCDelegationStatus(statusStr string) (BTCDelegationStatus, error) {
+	status, ok := BTCDelegationStatus_value[strings.ToUpper(strings.TrimSpace(statusStr))]
+	if !ok {
+		return -1, fmt.Errorf("invalid BTC delegation status %q; should be one of {%s}",
+			statusStr, strings.Join(btcDelegationStatusNames(), ", "))
+	}
+	return BTCDelegationStatus(status), nil
+}
+
+// NewBTCDelegationStatusFromString parses the given name of a BTC delegation
+// status.
+//
+// Deprecated: use ParseBTCDelegationStatus instead
 func NewBTCDelegationStatusFromString(statusStr string) (BTCDelegationStatus, error) {
-	switch statusStr {
-	case "pending":
-		return BTCDelegationStatus_PENDING, nil
-	case "verified":
-		return BTCDelegationStatus_VERIFIED, nil
-	case "active":
-		return BTCDelegationStatus_ACTIVE, nil
-	case "unbonded":
-		return BTCDelegationStatus_UNBONDED, nil
-	case "any":
-		return BTCDelegationStatus_ANY, nil
-	default:
-		return -1, fmt.Errorf("invalid status string; should be one of {pending, verified, active, unbonded, any}")
+	return ParseBTCDelegationStatus(statusStr)
+}
+
+// Name returns the lowercase name of the BTC delegation status, which is meant
+// for CLI and client output and is accepted by ParseBTCDelegationStatus.
+// NOTE: String returns the name in the proto definition instead, which is
+// used in events and metrics and thus has to remain unchanged
+func (s BTCDelegationStatus) Name() string {
+	return strings.ToLower(s.String())
+}
+
+// btcDelegationStatusNames returns the names of all BTC delegation statuses
+// in the order of their values
+func btcDelegationStatusNames() []string {
+	names := make([]string, 0, len(BTCDelegationStatus_name))
+	for i := 0; i < len(BTCDelegationStatus_name); i++ {
+		names = append(names, BTCDelegationStatus(i).Name())
 	}
+	return names
 }
 
 func (d *BTCDelegation) MustGetValidStakingTime() uint16 {
@@ -87,6 +108,13 @@ func (d *BTCDelegation) IsUnbondedEarly() bool {
 	return d.BtcUndelegation.DelegatorUnbondingInfo != nil
 }
 
+// IsExpiredAt returns whether the unbonded event of the BTC delegation due to
+// its timelock being about to expire has been processed at or before the
+// given BTC height
+func (d *BTCDelegation) IsExpiredAt(btcHeight uint32) bool {
+	return d.ExpiredBtcHeight > 0 && btcHeight >= d.ExpiredBtcHeight
+}
+
 func (d *BTCDelegation) FinalityProviderKeys() []string {
 	var fpPks = make([]string, len(d.FpBtcPkList))
 
@@ -100,9 +128,10 @@ func (d *BTCDelegation) FinalityProviderKeys() []string {
 // GetStatus returns the status of the BTC Delegation based on BTC height, w value, and covenant quorum
 // Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures
 // Active: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation has quorum number of signatures over slashing tx, unbonding tx, and slashing unbonding tx from covenant committee
-// Unbonded: the BTC height is larger than `endHeight-w` or the BTC delegation has received a signature on unbonding tx from the delegator
+// Unbonded: the BTC height is larger than `endHeight-w`, the BTC delegation has received a signature on unbonding tx from the delegator,
+// or the BTC delegation has expired at or before the BTC height
 func (d *BTCDelegation) GetStatus(btcHeight uint32, w uint32, covenantQuorum uint32) BTCDelegationStatus {
-	if d.IsUnbondedEarly() {
+	if d.IsUnbondedEarly() || d.IsExpiredAt(btcHeight) {
 		return BTCDelegationStatus_UNBONDED
 	}
 
@@ -172,10 +201,24 @@ func (d *BTCDelegation) MustGetStakingTx() *wire.MsgTx {
 	return stakingTx
 }
 
+// GetRewardRecipient returns the address to receive rewards from the BTC
+// delegation, which is the staker address if no reward address is set
+func (d *BTCDelegation) GetRewardRecipient() string {
+	if len(d.RewardAddress) > 0 {
+		return d.RewardAddress
+	}
+	return d.StakerAddr
+}
+
 func (d *BTCDelegation) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(d.StakerAddr); err != nil {
 		return fmt.Errorf("invalid staker address: %s - %w", d.StakerAddr, err)
 	}
+	if len(d.RewardAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(d.RewardAddress); err != nil {
+			return fmt.Errorf("invalid reward address: %s - %w", d.RewardAddress, err)
+		}
+	}
 	if d.BtcPk == nil {
 		return fmt.Errorf("empty BTC public key")
 	}
@@ -215,6 +258,28 @@ func (d *BTCDelegation) HasCovenantQuorums(quorum uint32) bool {
 	return len(d.CovenantSigs) >= int(quorum) && d.BtcUndelegation.HasCovenantQuorums(quorum)
 }
 
+// FpSignatureReadiness returns whether there is a quorum number of covenant
+// adaptor signatures encrypted by the PK of the finality provider at the given
+// index, on the slashing tx and the unbonding slashing tx respectively
+func (d *BTCDelegation) FpSignatureReadiness(fpIdx int, quorum uint32) (bool, bool) {
+	stakingSlashingQuorum := numCovAdaptorSigsForFp(d.CovenantSigs, fpIdx) >= int(quorum)
+	unbondingSlashingQuorum := numCovAdaptorSigsForFp(d.BtcUndelegation.CovenantSlashingSigs, fpIdx) >= int(quorum)
+	return stakingSlashingQuorum, unbondingSlashingQuorum
+}
+
+// numCovAdaptorSigsForFp returns the number of covenant members that have
+// submitted an adaptor signature encrypted by the PK of the finality provider
+// at the given index
+func numCovAdaptorSigsForFp(covSigs []*CovenantAdaptorSignatures, fpIdx int) int {
+	num := 0
+	for _, covASigs := range covSigs {
+		if fpIdx < len(covASigs.AdaptorSigs) && len(covASigs.AdaptorSigs[fpIdx]) > 0 {
+			num++
+		}
+	}
+	return num
+}
+
 // IsSignedByCovMember checks whether the given covenant PK has signed the delegation
 func (d *BTCDelegation) IsSignedByCovMember(covPk *bbn.BIP340PubKey) bool {
 	for _, sigInfo := range d.CovenantSigs {
@@ -226,6 +291,18 @@ func (d *BTCDelegation) IsSignedByCovMember(covPk *bbn.BIP340PubKey) bool {
 	return false
 }
 
+// NumCovenantSigners returns the number of members of the given covenant
+// committee that have submitted their signatures for the BTC delegation
+func (d *BTCDelegation) NumCovenantSigners(covenantPks []bbn.BIP340PubKey) uint32 {
+	num := uint32(0)
+	for i := range covenantPks {
+		if d.IsSignedByCovMember(&covenantPks[i]) {
+			num++
+		}
+	}
+	return num
+}
+
 // AddCovenantSigs adds signatures on the slashing tx from the given
 // covenant, where each signature is an adaptor signature encrypted by
 // each finality provider's PK this BTC delegation restakes to
@@ -275,6 +352,35 @@ func (d *BTCDelegation) GetStakingInfo(bsParams *Params, btcNet *chaincfg.Params
 	return stakingInfo, nil
 }
 
+// EstimateSlashingTxVSize estimates the virtual size of the slashing tx of the
+// given BTC delegation once it is fully witnessed through the slashing path of
+// the staking output, under the given params
+func EstimateSlashingTxVSize(d *BTCDelegation, bsParams *Params, btcNet *chaincfg.Params) (int64, error) {
+	if d.SlashingTx == nil {
+		return 0, fmt.Errorf("the BTC delegation does not have a slashing tx")
+	}
+	slashingMsgTx, err := d.SlashingTx.ToMsgTx()
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse the slashing tx: %w", err)
+	}
+	stakingInfo, err := d.GetStakingInfo(bsParams, btcNet)
+	if err != nil {
+		return 0, err
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return 0, fmt.Errorf("failed to construct the slashing path: %w", err)
+	}
+
+	return btcstaking.EstimateSlashingPathTxVSize(
+		slashingMsgTx,
+		slashingSpendInfo,
+		len(bsParams.CovenantPks),
+		bsParams.CovenantQuorum,
+		len(d.FpBtcPkList),
+	)
+}
+
 func (d *BTCDelegation) SignUnbondingTx(bsParams *Params, btcNet *chaincfg.Params, sk *btcec.PrivateKey) (*schnorr.Signature, error) {
 	stakingTx, err := bbn.NewBTCTxFromBytes(d.StakingTx)
 	if err != nil {
@@ -487,6 +593,16 @@ func (i *BTCDelegatorDelegationIndex) Add(stakingTxHash chainhash.Hash) error {
 	return nil
 }
 
+// Remove removes the given staking tx hash from the index, if present
+func (i *BTCDelegatorDelegationIndex) Remove(stakingTxHash chainhash.Hash) {
+	for idx, hash := range i.StakingTxHashList {
+		if bytes.Equal(stakingTxHash[:], hash) {
+			i.StakingTxHashList = append(i.StakingTxHashList[:idx], i.StakingTxHashList[idx+1:]...)
+			return
+		}
+	}
+}
+
 // VotingPower calculates the total voting power of all BTC delegations
 func (dels *BTCDelegatorDelegations) VotingPower(btcHeight uint32, w uint32, covenantQuorum uint32) uint64 {
 	power := uint64(0)
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -18,6 +19,24 @@ import (
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
+func TestParseBTCDelegationStatus(t *testing.T) {
+	for status := range types.BTCDelegationStatus_name {
+		status := types.BTCDelegationStatus(status)
+		// the lowercase name is stable and round-trips
+		require.Equal(t, strings.ToLower(status.String()), status.Name())
+		parsed, err := types.ParseBTCDelegationStatus(status.Name())
+		require.NoError(t, err)
+		require.Equal(t, status, parsed)
+		// the name in the proto definition is accepted as well
+		parsed, err = types.ParseBTCDelegationStatus(status.String())
+		require.NoError(t, err)
+		require.Equal(t, status, parsed)
+	}
+
+	_, err := types.ParseBTCDelegationStatus("unbonding")
+	require.ErrorContains(t, err, "{pending, verified, active, unbonded, any}")
+}
+
 func FuzzBTCDelegation(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 100)
 
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -41,19 +46,64 @@ func (fp *FinalityProvider) ValidateBasic() error {
 	return nil
 }
 
+// Validate ensures the selective slashing evidence is well-formed, i.e., the
+// staking tx hash is valid and the recovered BTC SK corresponds to the BTC PK
+// of the finality provider
+func (e *SelectiveSlashingEvidence) Validate() error {
+	if _, err := chainhash.NewHashFromStr(e.StakingTxHash); err != nil {
+		return fmt.Errorf("invalid staking tx hash %s: %w", e.StakingTxHash, err)
+	}
+	if e.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC public key")
+	}
+	if len(e.RecoveredFpBtcSk) != btcec.PrivKeyBytesLen {
+		return fmt.Errorf("malformed recovered BTC secret key of finality provider %s", e.FpBtcPk.MarshalHex())
+	}
+	_, fpPK := btcec.PrivKeyFromBytes(e.RecoveredFpBtcSk)
+	if !e.FpBtcPk.Equals(bbn.NewBIP340PubKeyFromBTCPK(fpPK)) {
+		return fmt.Errorf("recovered BTC secret key does not correspond to finality provider %s", e.FpBtcPk.MarshalHex())
+	}
+
+	return nil
+}
+
+// FpSetHash returns the hash of the given set of finality provider BTC PKs,
+// which does not depend on the order of the PKs. It is used for indexing BTC
+// delegations by the set of finality providers they restake to
+func FpSetHash(fpBTCPKs []bbn.BIP340PubKey) []byte {
+	sortedPKs := make([]bbn.BIP340PubKey, len(fpBTCPKs))
+	copy(sortedPKs, fpBTCPKs)
+	sort.Slice(sortedPKs, func(i, j int) bool {
+		return bytes.Compare(sortedPKs[i], sortedPKs[j]) < 0
+	})
+
+	h := sha256.New()
+	for _, pk := range sortedPKs {
+		h.Write(pk)
+	}
+	return h.Sum(nil)
+}
+
 func ExistsDup(btcPKs []bbn.BIP340PubKey) bool {
+	_, found := FindDup(btcPKs)
+	return found
+}
+
+// FindDup returns the first BTC PK that appears more than once in the given
+// list, if any
+func FindDup(btcPKs []bbn.BIP340PubKey) (*bbn.BIP340PubKey, bool) {
 	seen := make(map[string]struct{})
 
-	for _, btcPK := range btcPKs {
-		pkStr := string(btcPK)
+	for i := range btcPKs {
+		pkStr := string(btcPKs[i])
 		if _, found := seen[pkStr]; found {
-			return true
+			return &btcPKs[i], true
 		} else {
 			seen[pkStr] = struct{}{}
 		}
 	}
 
-	return false
+	return nil, false
 }
 
 func NewSignatureInfo(pk *bbn.BIP340PubKey, sig *bbn.BIP340Signature) *SignatureInfo {
@@ -28,6 +28,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashingReason is the reason why a finality provider is slashed.
+type SlashingReason int32
+
+const (
+	// SLASHING_REASON_UNSPECIFIED defines a finality provider that is not
+	// slashed, or was slashed before the reason was recorded.
+	SlashingReason_SLASHING_REASON_UNSPECIFIED SlashingReason = 0
+	// SLASHING_REASON_EQUIVOCATION defines a finality provider that is slashed
+	// due to double-signing a finality vote.
+	SlashingReason_SLASHING_REASON_EQUIVOCATION SlashingReason = 1
+	// SLASHING_REASON_SELECTIVE_SLASHING defines a finality provider that is
+	// slashed due to selectively slashing a BTC delegation.
+	SlashingReason_SLASHING_REASON_SELECTIVE_SLASHING SlashingReason = 2
+)
+
+var SlashingReason_name = map[int32]string{
+	0: "SLASHING_REASON_UNSPECIFIED",
+	1: "SLASHING_REASON_EQUIVOCATION",
+	2: "SLASHING_REASON_SELECTIVE_SLASHING",
+}
+
+var SlashingReason_value = map[string]int32{
+	"SLASHING_REASON_UNSPECIFIED":        0,
+	"SLASHING_REASON_EQUIVOCATION":       1,
+	"SLASHING_REASON_SELECTIVE_SLASHING": 2,
+}
+
+func (x SlashingReason) String() string {
+	return proto.EnumName(SlashingReason_name, int32(x))
+}
+
+func (SlashingReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{0}
+}
+
 // BTCDelegationStatus is the status of a delegation.
 // There are two possible valid state transition paths for a BTC delegation:
 // - PENDING -> ACTIVE -> UNBONDED
@@ -77,7 +112,7 @@ func (x BTCDelegationStatus) String() string {
 }
 
 func (BTCDelegationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{0}
+	return fileDescriptor_3851ae95ccfaf7db, []int{1}
 }
 
 // FinalityProvider defines a finality provider
@@ -104,6 +139,9 @@ type FinalityProvider struct {
 	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
 	// jailed defines whether the finality provider is jailed
 	Jailed bool `protobuf:"varint,8,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// slashing_reason indicates why the finality provider is slashed.
+	// if the finality provider is not slashed then it is unspecified
+	SlashingReason SlashingReason `protobuf:"varint,9,opt,name=slashing_reason,json=slashingReason,proto3,enum=babylon.btcstaking.v1.SlashingReason" json:"slashing_reason,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -181,6 +219,13 @@ func (m *FinalityProvider) GetJailed() bool {
 	return false
 }
 
+func (m *FinalityProvider) GetSlashingReason() SlashingReason {
+	if m != nil {
+		return m.SlashingReason
+	}
+	return SlashingReason_SLASHING_REASON_UNSPECIFIED
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -200,6 +245,9 @@ type FinalityProviderWithMeta struct {
 	SlashedBtcHeight uint32 `protobuf:"varint,5,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
 	// jailed defines whether the finality provider is detected jailed
 	Jailed bool `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// slashing_reason indicates why the finality provider is slashed.
+	// if the finality provider is not slashed then it is unspecified
+	SlashingReason SlashingReason `protobuf:"varint,7,opt,name=slashing_reason,json=slashingReason,proto3,enum=babylon.btcstaking.v1.SlashingReason" json:"slashing_reason,omitempty"`
 }
 
 func (m *FinalityProviderWithMeta) Reset()         { *m = FinalityProviderWithMeta{} }
@@ -270,6 +318,13 @@ func (m *FinalityProviderWithMeta) GetJailed() bool {
 	return false
 }
 
+func (m *FinalityProviderWithMeta) GetSlashingReason() SlashingReason {
+	if m != nil {
+		return m.SlashingReason
+	}
+	return SlashingReason_SLASHING_REASON_UNSPECIFIED
+}
+
 // BTCDelegation defines a BTC delegation
 type BTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -318,6 +373,18 @@ type BTCDelegation struct {
 	BtcUndelegation *BTCUndelegation `protobuf:"bytes,15,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
 	// version of the params used to validate the delegation
 	ParamsVersion uint32 `protobuf:"varint,16,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// reward_address is the address to receive rewards from the BTC delegation.
+	// It is empty for BTC delegations created before it was introduced, in
+	// which case rewards are sent to staker_addr
+	RewardAddress string `protobuf:"bytes,17,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// creation_height is the Babylon block height at which the BTC delegation
+	// was created. It is 0 for BTC delegations created before it was introduced
+	CreationHeight uint64 `protobuf:"varint,18,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// expired_btc_height is the BTC tip height at which the unbonded event
+	// scheduled at `end_height - min_unbonding_time` was processed, i.e., at
+	// which the BTC delegation became unbonded due to its timelock being about
+	// to expire. It is 0 if the BTC delegation has not expired yet
+	ExpiredBtcHeight uint32 `protobuf:"varint,19,opt,name=expired_btc_height,json=expiredBtcHeight,proto3" json:"expired_btc_height,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
@@ -437,6 +504,27 @@ func (m *BTCDelegation) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *BTCDelegation) GetCreationHeight() uint64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetExpiredBtcHeight() uint32 {
+	if m != nil {
+		return m.ExpiredBtcHeight
+	}
+	return 0
+}
+
 // DelegatorUnbondingInfo contains the information about transaction which spent
 // the staking output. It contains:
 // - spend_stake_tx: the transaction which spent the staking output
@@ -445,6 +533,10 @@ type DelegatorUnbondingInfo struct {
 	// filled only if spend_stake_tx is different than unbonding_tx registered
 	// on the Babylon chain.
 	SpendStakeTx []byte `protobuf:"bytes,1,opt,name=spend_stake_tx,json=spendStakeTx,proto3" json:"spend_stake_tx,omitempty"`
+	// spend_stake_tx_btc_height is the height of the BTC block including the
+	// transaction which spent the staking output. It is 0 for BTC delegations
+	// unbonded before it was introduced
+	SpendStakeTxBtcHeight uint32 `protobuf:"varint,2,opt,name=spend_stake_tx_btc_height,json=spendStakeTxBtcHeight,proto3" json:"spend_stake_tx_btc_height,omitempty"`
 }
 
 func (m *DelegatorUnbondingInfo) Reset()         { *m = DelegatorUnbondingInfo{} }
@@ -487,6 +579,13 @@ func (m *DelegatorUnbondingInfo) GetSpendStakeTx() []byte {
 	return nil
 }
 
+func (m *DelegatorUnbondingInfo) GetSpendStakeTxBtcHeight() uint32 {
+	if m != nil {
+		return m.SpendStakeTxBtcHeight
+	}
+	return 0
+}
+
 // BTCUndelegation contains the information about the early unbonding path of the BTC delegation
 type BTCUndelegation struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -770,6 +869,9 @@ type SelectiveSlashingEvidence struct {
 	// the covenant adaptor/Schnorr signature pair. It is the consequence
 	// of selective slashing.
 	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
+	// block_height is the Babylon block height at which the evidence was
+	// submitted
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
 func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvidence{} }
@@ -819,6 +921,13 @@ func (m *SelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
 	return nil
 }
 
+func (m *SelectiveSlashingEvidence) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // InclusionProof proves the existence of tx on BTC blockchain
 // including
 // - the position of the tx on BTC blockchain
@@ -878,6 +987,7 @@ func (m *InclusionProof) GetProof() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.SlashingReason", SlashingReason_name, SlashingReason_value)
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
 	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
@@ -897,91 +1007,102 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0xce, 0xdf, 0xb1, 0x9d, 0xb8, 0xd3, 0x10, 0xb6, 0x8d, 0x48, 0x82, 0x29, 0x95,
-	0x05, 0x8d, 0xdd, 0xa4, 0x95, 0x28, 0x20, 0x90, 0xe2, 0x38, 0xa5, 0x16, 0x6d, 0x62, 0xd6, 0x4e,
-	0x11, 0x48, 0x68, 0x59, 0xef, 0x4e, 0xd6, 0x83, 0xed, 0x9d, 0x65, 0x67, 0x6c, 0x9c, 0xa7, 0x00,
-	0x6e, 0x78, 0x00, 0xae, 0x78, 0x80, 0x3e, 0x44, 0x2f, 0xab, 0x5e, 0xa1, 0x5c, 0x44, 0x28, 0x7d,
-	0x11, 0x34, 0xb3, 0xe3, 0xdd, 0x75, 0x49, 0x4a, 0xdb, 0xe4, 0xce, 0x73, 0xfe, 0xe7, 0x3b, 0xdf,
-	0x9c, 0xb3, 0x86, 0x9b, 0x6d, 0xab, 0x7d, 0xd4, 0xa3, 0x5e, 0xa5, 0xcd, 0x6d, 0xc6, 0xad, 0x2e,
-	0xf1, 0xdc, 0xca, 0x70, 0x33, 0x71, 0x2a, 0xfb, 0x01, 0xe5, 0x14, 0xbd, 0xa3, 0xec, 0xca, 0x09,
-	0xcd, 0x70, 0xf3, 0xfa, 0x92, 0x4b, 0x5d, 0x2a, 0x2d, 0x2a, 0xe2, 0x57, 0x68, 0x7c, 0xfd, 0x9a,
-	0x4d, 0x59, 0x9f, 0x32, 0x33, 0x54, 0x84, 0x07, 0xa5, 0xba, 0x11, 0x9e, 0x2a, 0x71, 0xae, 0x36,
-	0xe6, 0xd6, 0x66, 0x65, 0x22, 0xdb, 0xf5, 0xb5, 0xb3, 0xab, 0xf2, 0xa9, 0xaf, 0x0c, 0x6e, 0x25,
-	0x0c, 0xec, 0x0e, 0xb6, 0xbb, 0x3e, 0x25, 0x1e, 0x57, 0x95, 0xc7, 0x82, 0xd0, 0xba, 0x78, 0x9a,
-	0x86, 0xc2, 0x7d, 0xe2, 0x59, 0x3d, 0xc2, 0x8f, 0x1a, 0x01, 0x1d, 0x12, 0x07, 0x07, 0xe8, 0x16,
-	0x64, 0x2c, 0xc7, 0x09, 0x74, 0x6d, 0x5d, 0x2b, 0xcd, 0x57, 0xf5, 0xe7, 0x4f, 0x36, 0x96, 0x54,
-	0xa5, 0xdb, 0x8e, 0x13, 0x60, 0xc6, 0x9a, 0x3c, 0x20, 0x9e, 0x6b, 0x48, 0x2b, 0xb4, 0x0b, 0x59,
-	0x07, 0x33, 0x3b, 0x20, 0x3e, 0x27, 0xd4, 0xd3, 0x53, 0xeb, 0x5a, 0x29, 0xbb, 0xf5, 0x41, 0x59,
-	0x79, 0xc4, 0x88, 0xc8, 0xdb, 0x94, 0x6b, 0xb1, 0xa9, 0x91, 0xf4, 0x43, 0x8f, 0x00, 0x6c, 0xda,
-	0xef, 0x13, 0xc6, 0x44, 0x94, 0xb4, 0x4c, 0xbd, 0x71, 0x7c, 0xb2, 0xb6, 0x12, 0x06, 0x62, 0x4e,
-	0xb7, 0x4c, 0x68, 0xa5, 0x6f, 0xf1, 0x4e, 0xf9, 0x21, 0x76, 0x2d, 0xfb, 0xa8, 0x86, 0xed, 0xe7,
-	0x4f, 0x36, 0x40, 0xe5, 0xa9, 0x61, 0xdb, 0x48, 0x04, 0x40, 0xfb, 0x30, 0xd3, 0xe6, 0xb6, 0xe9,
-	0x77, 0xf5, 0xcc, 0xba, 0x56, 0xca, 0x55, 0xef, 0x1d, 0x9f, 0xac, 0xdd, 0x75, 0x09, 0xef, 0x0c,
-	0xda, 0x65, 0x9b, 0xf6, 0x2b, 0x0a, 0xa5, 0x9e, 0xd5, 0x66, 0x1b, 0x84, 0x8e, 0x8f, 0x15, 0x7e,
-	0xe4, 0x63, 0x56, 0xae, 0xd6, 0x1b, 0x77, 0xee, 0xde, 0x6e, 0x0c, 0xda, 0x5f, 0xe3, 0x23, 0x63,
-	0xba, 0xcd, 0xed, 0x46, 0x17, 0x7d, 0x01, 0x69, 0x9f, 0xfa, 0xfa, 0xb4, 0xbc, 0xde, 0xc7, 0xe5,
-	0x33, 0x9b, 0x5e, 0x6e, 0x04, 0x94, 0x1e, 0xee, 0x1f, 0x36, 0x28, 0x63, 0x58, 0xd6, 0x51, 0x6d,
-	0xed, 0x18, 0xc2, 0x0f, 0xdd, 0x85, 0x65, 0xd6, 0xb3, 0x58, 0x07, 0x3b, 0xa6, 0x72, 0x35, 0x3b,
-	0x98, 0xb8, 0x1d, 0xae, 0xcf, 0xac, 0x6b, 0xa5, 0x8c, 0xb1, 0xa4, 0xb4, 0xd5, 0x50, 0xf9, 0x40,
-	0xea, 0xd0, 0x2d, 0x40, 0x91, 0x17, 0xb7, 0xc7, 0x1e, 0xb3, 0xeb, 0x5a, 0x29, 0x6f, 0x14, 0xc6,
-	0x1e, 0xdc, 0x56, 0xd6, 0xcb, 0x30, 0xf3, 0x93, 0x45, 0x7a, 0xd8, 0xd1, 0xe7, 0xd6, 0xb5, 0xd2,
-	0x9c, 0xa1, 0x4e, 0xc5, 0x3f, 0x53, 0xa0, 0xbf, 0xdc, 0xe4, 0x6f, 0x09, 0xef, 0x3c, 0xc2, 0xdc,
-	0x4a, 0x00, 0xa5, 0x5d, 0x0e, 0x50, 0xcb, 0x30, 0xa3, 0xea, 0x4c, 0xc9, 0x9b, 0xa9, 0x13, 0x7a,
-	0x1f, 0x72, 0x43, 0xca, 0x89, 0xe7, 0x9a, 0x3e, 0xfd, 0x05, 0x07, 0xb2, 0xc5, 0x19, 0x23, 0x1b,
-	0xca, 0x1a, 0x42, 0xf4, 0x0a, 0x90, 0x32, 0x6f, 0x0c, 0xd2, 0xf4, 0xff, 0x82, 0x34, 0x33, 0x01,
-	0xd2, 0x1f, 0xb3, 0x90, 0xaf, 0xb6, 0x76, 0x6a, 0xb8, 0x87, 0x5d, 0x4b, 0x32, 0xf2, 0x53, 0xc8,
-	0x8a, 0xd6, 0xe2, 0xc0, 0x7c, 0xad, 0xd7, 0x00, 0xa1, 0xb1, 0x10, 0x26, 0x40, 0x4d, 0x5d, 0x2a,
-	0xfb, 0xd2, 0x6f, 0xc9, 0xbe, 0x1f, 0x60, 0xe1, 0xd0, 0x37, 0xc3, 0x92, 0xcc, 0x1e, 0x61, 0x02,
-	0xd0, 0xf4, 0x85, 0xea, 0xca, 0x1e, 0xfa, 0x55, 0x51, 0xd9, 0x43, 0xc2, 0x64, 0x6b, 0x55, 0x19,
-	0x26, 0x27, 0x7d, 0xac, 0xb0, 0xcf, 0x2a, 0x59, 0x8b, 0xf4, 0xb1, 0x32, 0x09, 0x78, 0x92, 0xf5,
-	0xa1, 0x49, 0xc0, 0x55, 0x67, 0xde, 0x03, 0xc0, 0x9e, 0x33, 0x49, 0xf2, 0x79, 0xec, 0x39, 0x4a,
-	0xbd, 0x02, 0xf3, 0x9c, 0x72, 0xab, 0x67, 0x32, 0x8b, 0x4b, 0x82, 0x67, 0x8c, 0x39, 0x29, 0x68,
-	0x5a, 0xd2, 0x37, 0xaa, 0x60, 0xa4, 0xcf, 0x0b, 0xd0, 0x8d, 0xf9, 0x71, 0xfe, 0x91, 0xa4, 0x88,
-	0x52, 0xd3, 0x01, 0xf7, 0x07, 0xdc, 0x24, 0xce, 0x48, 0x07, 0x45, 0x91, 0x50, 0xb3, 0x2f, 0x15,
-	0x75, 0x67, 0x84, 0xb6, 0x20, 0x2b, 0x69, 0xa3, 0xa2, 0x65, 0x65, 0x0b, 0xaf, 0x1c, 0x9f, 0xac,
-	0x09, 0x82, 0x34, 0x95, 0xa6, 0x35, 0x32, 0x80, 0x45, 0xbf, 0xd1, 0x8f, 0x90, 0x77, 0x42, 0xea,
-	0xd0, 0xc0, 0x64, 0xc4, 0xd5, 0x73, 0xd2, 0xeb, 0xf3, 0xe3, 0x93, 0xb5, 0x4f, 0xde, 0x0c, 0xe0,
-	0x26, 0x71, 0x3d, 0x8b, 0x0f, 0x02, 0x6c, 0xe4, 0xa2, 0x88, 0x4d, 0xe2, 0xa2, 0x03, 0xc8, 0xdb,
-	0x74, 0x88, 0x3d, 0xcb, 0xe3, 0x22, 0x01, 0xd3, 0xf3, 0xeb, 0xe9, 0x52, 0x76, 0xeb, 0xf6, 0x39,
-	0x64, 0xd8, 0x51, 0xb6, 0xdb, 0x8e, 0xe5, 0x87, 0x11, 0xc2, 0xa8, 0xcc, 0xc8, 0x8d, 0xc3, 0x34,
-	0x89, 0xcb, 0xd0, 0x87, 0xb0, 0x30, 0xf0, 0xda, 0xd4, 0x73, 0xa2, 0xee, 0x2d, 0x48, 0x58, 0xf2,
-	0x91, 0x54, 0xf6, 0xef, 0x1b, 0x28, 0x08, 0xfa, 0x0c, 0x3c, 0x27, 0x7a, 0x20, 0xfa, 0xa2, 0x64,
-	0xe3, 0xcd, 0x73, 0x0a, 0xa8, 0xb6, 0x76, 0x0e, 0x12, 0xd6, 0xc6, 0x62, 0x9b, 0xdb, 0x49, 0x81,
-	0xc8, 0xec, 0x5b, 0x81, 0xd5, 0x67, 0xe6, 0x10, 0x07, 0x72, 0xea, 0x17, 0xc2, 0xcc, 0xa1, 0xf4,
-	0x71, 0x28, 0x2c, 0x7e, 0x09, 0xcb, 0xb5, 0x31, 0x0e, 0x07, 0xe3, 0x9a, 0xea, 0xde, 0x21, 0x45,
-	0x37, 0x60, 0x81, 0xf9, 0x82, 0x32, 0xf2, 0xe5, 0x89, 0x56, 0xc9, 0x11, 0x66, 0xe4, 0xa4, 0xb4,
-	0x29, 0x84, 0xad, 0x51, 0xf1, 0xf7, 0x0c, 0x2c, 0xbe, 0x54, 0x8b, 0x60, 0x63, 0xe2, 0xd2, 0x63,
-	0xbf, 0x6c, 0x7c, 0xe5, 0xff, 0x90, 0x20, 0xf5, 0x3a, 0x24, 0xf8, 0x19, 0x96, 0x13, 0x24, 0x18,
-	0x7b, 0x0b, 0x36, 0xa4, 0x2f, 0xce, 0x86, 0xa5, 0x98, 0x0d, 0x2a, 0xb2, 0x60, 0xc5, 0x21, 0x2c,
-	0xc7, 0xac, 0x48, 0x64, 0x64, 0xf2, 0x85, 0xbf, 0x0d, 0x3d, 0x96, 0x22, 0x7a, 0xc4, 0x69, 0x18,
-	0xb2, 0x61, 0x25, 0xca, 0x13, 0x43, 0xc7, 0x88, 0x1b, 0x8e, 0x93, 0x69, 0x99, 0xec, 0xc6, 0x39,
-	0xc9, 0xa2, 0xe8, 0xa2, 0x6d, 0x86, 0x3e, 0x0e, 0x14, 0x75, 0xb3, 0x49, 0x5c, 0x39, 0x47, 0x5c,
-	0xd0, 0x63, 0xfc, 0xe2, 0x2c, 0xc4, 0x3b, 0xa4, 0x72, 0x60, 0x64, 0xb7, 0x36, 0xce, 0xc9, 0x70,
-	0x36, 0x43, 0x8c, 0xb8, 0x1d, 0x13, 0xf2, 0x62, 0x13, 0xde, 0x8d, 0x67, 0x3d, 0x0d, 0xe2, 0xa1,
-	0xcf, 0xd0, 0x3d, 0xc8, 0x38, 0xb8, 0xc7, 0x74, 0xed, 0x95, 0x37, 0x9a, 0xd8, 0x14, 0x86, 0xf4,
-	0x28, 0xee, 0xc1, 0xca, 0xd9, 0x41, 0xeb, 0x9e, 0x83, 0x47, 0xa8, 0x02, 0x4b, 0xf1, 0x88, 0x32,
-	0x3b, 0x16, 0xeb, 0x84, 0xd0, 0x89, 0x44, 0x39, 0xe3, 0x4a, 0x34, 0xac, 0x1e, 0x58, 0xac, 0x23,
-	0xd0, 0x28, 0xfe, 0xa5, 0x41, 0x7e, 0x02, 0x39, 0xf4, 0x00, 0x52, 0x97, 0xb0, 0xa7, 0x53, 0x7e,
-	0x17, 0x3d, 0x82, 0xb4, 0xa0, 0x65, 0xea, 0xe2, 0xb4, 0x14, 0x71, 0x8a, 0xbf, 0x6a, 0x70, 0xed,
-	0x5c, 0x46, 0x89, 0x6d, 0x68, 0xd3, 0xe1, 0xa5, 0x7c, 0x62, 0xd8, 0x74, 0xd8, 0xe8, 0x8a, 0xe7,
-	0x6b, 0x85, 0x59, 0x42, 0xaa, 0xa7, 0x24, 0x84, 0x59, 0x2b, 0xca, 0xcc, 0x8a, 0x4f, 0x35, 0xb8,
-	0xd6, 0xc4, 0x3d, 0x6c, 0x73, 0x32, 0xc4, 0x63, 0x26, 0xef, 0x8a, 0x4f, 0x1f, 0xcf, 0xc6, 0xe8,
-	0x26, 0x2c, 0xbe, 0xd4, 0x8b, 0x70, 0xbd, 0x1b, 0xf9, 0x89, 0x36, 0xa0, 0x16, 0xcc, 0x47, 0x7b,
-	0xf3, 0xc2, 0xab, 0x7c, 0x56, 0xad, 0x4c, 0xb4, 0x01, 0x57, 0x03, 0x2c, 0x1e, 0x41, 0x80, 0x1d,
-	0x53, 0xc5, 0x67, 0xdd, 0x70, 0x46, 0x18, 0x85, 0x48, 0x75, 0x5f, 0x98, 0x37, 0xbb, 0xc5, 0x36,
-	0x2c, 0xd4, 0x3d, 0xbb, 0x37, 0x10, 0xd3, 0x50, 0xae, 0x78, 0xf4, 0x19, 0xa4, 0xbb, 0xf8, 0x48,
-	0x96, 0x9c, 0xdd, 0x2a, 0x25, 0x29, 0x9a, 0xf8, 0xc0, 0x1f, 0x6e, 0x96, 0x5b, 0x81, 0xe5, 0x31,
-	0xcb, 0x16, 0x1c, 0x14, 0x05, 0x08, 0x27, 0xb4, 0x04, 0xd3, 0xbe, 0x08, 0x12, 0x5e, 0xc7, 0x08,
-	0x0f, 0x1f, 0x35, 0xe1, 0xea, 0x04, 0xa5, 0x9b, 0xdc, 0xe2, 0x03, 0x86, 0xb2, 0x30, 0xdb, 0xd8,
-	0xdd, 0xab, 0xd5, 0xf7, 0xbe, 0x2a, 0x4c, 0xa1, 0x1c, 0xcc, 0x3d, 0xde, 0x35, 0xea, 0xf7, 0xeb,
-	0xbb, 0xb5, 0x82, 0x86, 0x00, 0x66, 0xb6, 0x77, 0x5a, 0xf5, 0xc7, 0xbb, 0x85, 0x94, 0xd0, 0x1c,
-	0xec, 0x55, 0xf7, 0xf7, 0x6a, 0xbb, 0xb5, 0x42, 0x1a, 0xcd, 0x42, 0x7a, 0x7b, 0xef, 0xbb, 0x42,
-	0xa6, 0xba, 0xf7, 0xf4, 0x74, 0x55, 0x7b, 0x76, 0xba, 0xaa, 0xfd, 0x73, 0xba, 0xaa, 0xfd, 0xf6,
-	0x62, 0x75, 0xea, 0xd9, 0x8b, 0xd5, 0xa9, 0xbf, 0x5f, 0xac, 0x4e, 0x7d, 0xff, 0x1a, 0x00, 0x8e,
-	0x92, 0xff, 0x70, 0x24, 0x9a, 0xed, 0x19, 0xf9, 0x9f, 0xe5, 0xce, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x16, 0x11, 0x9e, 0xc4, 0x9a, 0x0d, 0x00, 0x00,
+	// 1517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5b, 0x6f, 0x1b, 0x4f,
+	0x15, 0xcf, 0xda, 0xce, 0xed, 0xf8, 0x12, 0x77, 0x9a, 0x7f, 0xd8, 0x34, 0x90, 0x18, 0xd3, 0x7f,
+	0xb0, 0x4a, 0x63, 0x37, 0x69, 0x25, 0x0a, 0x08, 0xa1, 0x38, 0x76, 0x89, 0x45, 0xea, 0xb8, 0xbb,
+	0x4e, 0x10, 0x48, 0x68, 0x59, 0xef, 0x4e, 0xd6, 0x8b, 0xed, 0x9d, 0x65, 0x67, 0xec, 0x3a, 0xe2,
+	0x43, 0x00, 0xdf, 0x82, 0x27, 0x1e, 0x50, 0x3f, 0x04, 0x8f, 0x55, 0x9f, 0x50, 0x1e, 0x22, 0xd4,
+	0x3e, 0xf2, 0xc6, 0x27, 0x40, 0x33, 0x7b, 0x75, 0x48, 0x7a, 0x4b, 0xde, 0x76, 0xce, 0xed, 0x37,
+	0x73, 0xce, 0xef, 0x9c, 0x99, 0x85, 0xed, 0x9e, 0xde, 0x3b, 0x1f, 0x12, 0xa7, 0xd6, 0x63, 0x06,
+	0x65, 0xfa, 0xc0, 0x76, 0xac, 0xda, 0x64, 0x37, 0xb1, 0xaa, 0xba, 0x1e, 0x61, 0x04, 0x7d, 0x13,
+	0xd8, 0x55, 0x13, 0x9a, 0xc9, 0xee, 0x83, 0x55, 0x8b, 0x58, 0x44, 0x58, 0xd4, 0xf8, 0x97, 0x6f,
+	0xfc, 0x60, 0xdd, 0x20, 0x74, 0x44, 0xa8, 0xe6, 0x2b, 0xfc, 0x45, 0xa0, 0x7a, 0xe8, 0xaf, 0x6a,
+	0x31, 0x56, 0x0f, 0x33, 0x7d, 0xb7, 0x36, 0x83, 0xf6, 0x60, 0xeb, 0xfa, 0x5d, 0xb9, 0xc4, 0x0d,
+	0x0c, 0x1e, 0x27, 0x0c, 0x8c, 0x3e, 0x36, 0x06, 0x2e, 0xb1, 0x1d, 0x16, 0xec, 0x3c, 0x16, 0xf8,
+	0xd6, 0xe5, 0x7f, 0x64, 0xa0, 0xf8, 0xc2, 0x76, 0xf4, 0xa1, 0xcd, 0xce, 0x3b, 0x1e, 0x99, 0xd8,
+	0x26, 0xf6, 0xd0, 0x63, 0xc8, 0xe8, 0xa6, 0xe9, 0xc9, 0x52, 0x49, 0xaa, 0x2c, 0xd7, 0xe5, 0x77,
+	0x6f, 0x76, 0x56, 0x83, 0x9d, 0xee, 0x9b, 0xa6, 0x87, 0x29, 0x55, 0x99, 0x67, 0x3b, 0x96, 0x22,
+	0xac, 0x50, 0x13, 0xb2, 0x26, 0xa6, 0x86, 0x67, 0xbb, 0xcc, 0x26, 0x8e, 0x9c, 0x2a, 0x49, 0x95,
+	0xec, 0xde, 0x0f, 0xaa, 0x81, 0x47, 0x9c, 0x11, 0x71, 0x9a, 0x6a, 0x23, 0x36, 0x55, 0x92, 0x7e,
+	0xe8, 0x25, 0x80, 0x41, 0x46, 0x23, 0x9b, 0x52, 0x1e, 0x25, 0x2d, 0xa0, 0x77, 0x2e, 0x2e, 0xb7,
+	0x36, 0xfc, 0x40, 0xd4, 0x1c, 0x54, 0x6d, 0x52, 0x1b, 0xe9, 0xac, 0x5f, 0x3d, 0xc2, 0x96, 0x6e,
+	0x9c, 0x37, 0xb0, 0xf1, 0xee, 0xcd, 0x0e, 0x04, 0x38, 0x0d, 0x6c, 0x28, 0x89, 0x00, 0xe8, 0x18,
+	0x16, 0x7a, 0xcc, 0xd0, 0xdc, 0x81, 0x9c, 0x29, 0x49, 0x95, 0x5c, 0xfd, 0xf9, 0xc5, 0xe5, 0xd6,
+	0x33, 0xcb, 0x66, 0xfd, 0x71, 0xaf, 0x6a, 0x90, 0x51, 0x2d, 0xc8, 0xd2, 0x50, 0xef, 0xd1, 0x1d,
+	0x9b, 0x84, 0xcb, 0x1a, 0x3b, 0x77, 0x31, 0xad, 0xd6, 0x5b, 0x9d, 0xa7, 0xcf, 0x9e, 0x74, 0xc6,
+	0xbd, 0x5f, 0xe1, 0x73, 0x65, 0xbe, 0xc7, 0x8c, 0xce, 0x00, 0xfd, 0x1c, 0xd2, 0x2e, 0x71, 0xe5,
+	0x79, 0x71, 0xbc, 0x1f, 0x55, 0xaf, 0x2d, 0x7a, 0xb5, 0xe3, 0x11, 0x72, 0x76, 0x7c, 0xd6, 0x21,
+	0x94, 0x62, 0xb1, 0x8f, 0x7a, 0xf7, 0x40, 0xe1, 0x7e, 0xe8, 0x19, 0xac, 0xd1, 0xa1, 0x4e, 0xfb,
+	0xd8, 0xd4, 0x02, 0x57, 0xad, 0x8f, 0x6d, 0xab, 0xcf, 0xe4, 0x85, 0x92, 0x54, 0xc9, 0x28, 0xab,
+	0x81, 0xb6, 0xee, 0x2b, 0x0f, 0x85, 0x0e, 0x3d, 0x06, 0x14, 0x79, 0x31, 0x23, 0xf4, 0x58, 0x2c,
+	0x49, 0x95, 0xbc, 0x52, 0x0c, 0x3d, 0x98, 0x11, 0x58, 0xaf, 0xc1, 0xc2, 0x1f, 0x74, 0x7b, 0x88,
+	0x4d, 0x79, 0xa9, 0x24, 0x55, 0x96, 0x94, 0x60, 0x85, 0xda, 0xb0, 0x22, 0x6c, 0x6d, 0xc7, 0xd2,
+	0x3c, 0xac, 0x53, 0xe2, 0xc8, 0xcb, 0x25, 0xa9, 0x52, 0xd8, 0xfb, 0xf6, 0x86, 0x63, 0xa8, 0x81,
+	0xb5, 0x22, 0x8c, 0x95, 0x02, 0x9d, 0x59, 0x97, 0xff, 0x9b, 0x02, 0xf9, 0x2a, 0x69, 0x7e, 0x6d,
+	0xb3, 0xfe, 0x4b, 0xcc, 0xf4, 0x44, 0xe2, 0xa5, 0xbb, 0x49, 0xfc, 0x1a, 0x2c, 0x04, 0xe7, 0x4e,
+	0x89, 0x4c, 0x05, 0x2b, 0xf4, 0x7d, 0xc8, 0x4d, 0x08, 0xe3, 0x67, 0x72, 0xc9, 0x6b, 0xec, 0x09,
+	0xca, 0x64, 0x94, 0xac, 0x2f, 0xeb, 0x70, 0xd1, 0x47, 0x92, 0x9e, 0xf9, 0xe2, 0xa4, 0xcf, 0x7f,
+	0x32, 0xe9, 0x0b, 0x9f, 0x4a, 0xfa, 0xe2, 0x6d, 0x92, 0xfe, 0xf7, 0x25, 0xc8, 0xd7, 0xbb, 0x07,
+	0x0d, 0x3c, 0xc4, 0x96, 0x2e, 0x3a, 0xe6, 0x27, 0x90, 0xe5, 0xee, 0xd8, 0xd3, 0x3e, 0xab, 0x5b,
+	0xc1, 0x37, 0xe6, 0xc2, 0x44, 0x91, 0x52, 0x77, 0xda, 0x1d, 0xe9, 0xaf, 0xec, 0x8e, 0xdf, 0x41,
+	0xe1, 0xcc, 0xd5, 0xfc, 0x2d, 0x69, 0x43, 0x9b, 0xf2, 0x02, 0xa5, 0x6f, 0xb5, 0xaf, 0xec, 0x99,
+	0x5b, 0xe7, 0x3b, 0x3b, 0xb2, 0xa9, 0xa0, 0x4a, 0xb0, 0x0d, 0x8d, 0xd9, 0x23, 0x1c, 0xd4, 0x32,
+	0x1b, 0xc8, 0xba, 0xf6, 0x08, 0x07, 0x26, 0x1e, 0x4b, 0x76, 0xa5, 0x6f, 0xe2, 0xb1, 0xa0, 0xd2,
+	0xdf, 0x03, 0xc0, 0x8e, 0x39, 0xdb, 0x84, 0xcb, 0xd8, 0x31, 0x03, 0xf5, 0x06, 0x2c, 0x33, 0xc2,
+	0xf4, 0xa1, 0x46, 0x75, 0x26, 0x1a, 0x30, 0xa3, 0x2c, 0x09, 0x81, 0xaa, 0x0b, 0xdf, 0x68, 0x07,
+	0x53, 0xd1, 0x7d, 0x39, 0x65, 0x39, 0xc4, 0x9f, 0x0a, 0xca, 0x05, 0x6a, 0x32, 0x66, 0xee, 0x98,
+	0x69, 0xb6, 0x39, 0x95, 0x21, 0xa0, 0x9c, 0xaf, 0x39, 0x16, 0x8a, 0x96, 0x39, 0x45, 0x7b, 0x90,
+	0x8d, 0xa8, 0xc5, 0xa6, 0x72, 0x56, 0x94, 0xf0, 0xde, 0xc5, 0xe5, 0x16, 0x27, 0x48, 0x48, 0xa3,
+	0xee, 0x54, 0x01, 0x1a, 0x7d, 0xa3, 0xdf, 0x43, 0xde, 0xf4, 0xa9, 0x43, 0x3c, 0x8d, 0xda, 0x96,
+	0x9c, 0x13, 0x5e, 0x3f, 0xbb, 0xb8, 0xdc, 0xfa, 0xf1, 0x97, 0x25, 0x58, 0xb5, 0x2d, 0x47, 0x67,
+	0x63, 0x0f, 0x2b, 0xb9, 0x28, 0xa2, 0x6a, 0x5b, 0xe8, 0x04, 0xf2, 0x06, 0x99, 0x60, 0x47, 0x77,
+	0x18, 0x07, 0xa0, 0x72, 0xbe, 0x94, 0xae, 0x64, 0xf7, 0x9e, 0xdc, 0x40, 0x86, 0x83, 0xc0, 0x76,
+	0xdf, 0xd4, 0x5d, 0x3f, 0x82, 0x1f, 0x95, 0x2a, 0xb9, 0x30, 0x8c, 0x6a, 0x5b, 0x14, 0x7d, 0x0b,
+	0x85, 0xb1, 0xd3, 0x23, 0x8e, 0x19, 0x55, 0xaf, 0x20, 0xd2, 0x92, 0x8f, 0xa4, 0xa2, 0x7e, 0xaf,
+	0xa0, 0xc8, 0xe9, 0x33, 0x76, 0xcc, 0xa8, 0x41, 0xe4, 0x15, 0xc1, 0xc6, 0xed, 0x1b, 0x36, 0x50,
+	0xef, 0x1e, 0x9c, 0x24, 0xac, 0x95, 0x95, 0x1e, 0x33, 0x92, 0x02, 0x8e, 0xec, 0xea, 0x9e, 0x3e,
+	0xa2, 0xda, 0x04, 0x7b, 0xe2, 0x56, 0x2a, 0xfa, 0xc8, 0xbe, 0xf4, 0xd4, 0x17, 0xa2, 0x5f, 0x40,
+	0xc1, 0xc3, 0xaf, 0x75, 0xcf, 0x14, 0x6d, 0x88, 0x29, 0x95, 0xef, 0x7d, 0xa2, 0x13, 0xf3, 0xbe,
+	0x7d, 0x20, 0x44, 0x3f, 0x84, 0x15, 0xc3, 0xc3, 0x02, 0x33, 0x24, 0x17, 0x12, 0xf4, 0x29, 0x84,
+	0xe2, 0x78, 0x30, 0xe1, 0xa9, 0x6b, 0x7b, 0xb3, 0x83, 0xe9, 0xbe, 0xcf, 0x92, 0x40, 0x13, 0x0d,
+	0xa6, 0xf2, 0x14, 0xd6, 0x1a, 0x61, 0x7d, 0x4e, 0xc2, 0x5c, 0xb5, 0x9c, 0x33, 0x82, 0x1e, 0x42,
+	0x81, 0xba, 0x9c, 0xca, 0x62, 0x22, 0x70, 0x0a, 0x89, 0x51, 0xad, 0xe4, 0x84, 0x54, 0xe5, 0xc2,
+	0xee, 0x14, 0x3d, 0x87, 0xf5, 0x59, 0xab, 0x24, 0x68, 0x4a, 0x80, 0x7e, 0x93, 0x74, 0x88, 0x91,
+	0xff, 0x9a, 0x81, 0x95, 0x2b, 0xd9, 0xe5, 0xfd, 0x95, 0x28, 0x63, 0x88, 0x98, 0x8d, 0x8b, 0xf8,
+	0x7f, 0xb4, 0x4e, 0x7d, 0x0e, 0xad, 0xff, 0x08, 0x6b, 0x09, 0x5a, 0x87, 0xde, 0x9c, 0xdf, 0xe9,
+	0xdb, 0xf3, 0x7b, 0x35, 0xe6, 0x77, 0x10, 0x99, 0xf3, 0xfc, 0x0c, 0xd6, 0x62, 0x9e, 0x27, 0x10,
+	0xa9, 0x9c, 0xf9, 0x4a, 0xc2, 0xaf, 0x46, 0x84, 0x8f, 0x61, 0x28, 0x32, 0x60, 0x23, 0xc2, 0x89,
+	0x53, 0x47, 0x6d, 0xcb, 0x1f, 0x90, 0xf3, 0x02, 0xec, 0xe1, 0x4d, 0x97, 0x49, 0x18, 0x9d, 0x17,
+	0x5c, 0x91, 0xc3, 0x40, 0x11, 0x0f, 0x54, 0xdb, 0x12, 0x93, 0xd1, 0x02, 0x39, 0xce, 0x5f, 0x8c,
+	0x62, 0x3b, 0x67, 0x44, 0x8c, 0xc0, 0xec, 0xde, 0xce, 0x0d, 0x08, 0xd7, 0x73, 0x4b, 0x59, 0x33,
+	0xaf, 0x95, 0x97, 0x55, 0xf8, 0x4e, 0x7c, 0x7b, 0x11, 0x2f, 0xbe, 0xc6, 0x28, 0x7a, 0x0e, 0x19,
+	0x13, 0x0f, 0xa9, 0x2c, 0x7d, 0xf4, 0x44, 0x33, 0x77, 0x9f, 0x22, 0x3c, 0xca, 0x6d, 0xd8, 0xb8,
+	0x3e, 0x68, 0xcb, 0x31, 0xf1, 0x14, 0xd5, 0x60, 0x35, 0x1e, 0xba, 0x5a, 0x5f, 0xa7, 0x7d, 0x3f,
+	0x75, 0x1c, 0x28, 0xa7, 0xdc, 0x8b, 0xc6, 0xef, 0xa1, 0x4e, 0xfb, 0x3c, 0x1b, 0xe5, 0xbf, 0x49,
+	0x90, 0x9f, 0xc9, 0x1c, 0x3a, 0x84, 0xd4, 0x1d, 0xbc, 0x64, 0x52, 0xee, 0x00, 0xbd, 0x84, 0x34,
+	0xa7, 0x65, 0xea, 0xf6, 0xb4, 0xe4, 0x71, 0xca, 0x7f, 0x96, 0x60, 0xfd, 0x46, 0x46, 0xf1, 0xfb,
+	0xdd, 0x20, 0x93, 0x3b, 0x79, 0x84, 0x19, 0x64, 0xd2, 0x19, 0xf0, 0xf6, 0xd5, 0x7d, 0x14, 0x9f,
+	0xea, 0x29, 0x91, 0xc2, 0xac, 0x1e, 0x21, 0xd3, 0xf2, 0x7f, 0x24, 0x58, 0x57, 0xf1, 0x10, 0x1b,
+	0xcc, 0x9e, 0xe0, 0x90, 0xc9, 0x4d, 0xfe, 0x38, 0x74, 0x0c, 0x8c, 0xb6, 0x61, 0xe5, 0x4a, 0x2d,
+	0xfc, 0x07, 0x8b, 0x92, 0x9f, 0x29, 0x03, 0xea, 0xc2, 0x72, 0xf4, 0x12, 0xb8, 0xf5, 0xe3, 0x64,
+	0x31, 0x78, 0x04, 0xa0, 0x1d, 0xb8, 0xef, 0x61, 0xde, 0x04, 0x7c, 0x76, 0x06, 0xf1, 0xe9, 0xc0,
+	0x9f, 0x11, 0x4a, 0x31, 0x52, 0xbd, 0xe0, 0xe6, 0xaa, 0x38, 0x6d, 0x6f, 0x48, 0x8c, 0xc1, 0xec,
+	0x6b, 0x31, 0x2b, 0x64, 0xc1, 0x8c, 0xeb, 0x41, 0xa1, 0xe5, 0x18, 0xc3, 0x31, 0xbf, 0x02, 0xc4,
+	0xbb, 0x06, 0xfd, 0x14, 0xd2, 0x03, 0x7c, 0x2e, 0x4e, 0x95, 0xdd, 0xab, 0x24, 0x59, 0x9c, 0xf8,
+	0xeb, 0x9a, 0xec, 0x56, 0xbb, 0x9e, 0xee, 0x50, 0xdd, 0xe0, 0x34, 0xe5, 0x7b, 0xe4, 0x4e, 0x68,
+	0x15, 0xe6, 0x5d, 0x1e, 0xc4, 0x3f, 0xb1, 0xe2, 0x2f, 0x1e, 0xfd, 0x09, 0x0a, 0xb3, 0x8f, 0x42,
+	0xb4, 0x05, 0x1b, 0xea, 0xd1, 0xbe, 0x7a, 0xd8, 0x6a, 0xff, 0x52, 0x53, 0x9a, 0xfb, 0xea, 0x71,
+	0x5b, 0x3b, 0x69, 0xab, 0x9d, 0xe6, 0x41, 0xeb, 0x45, 0xab, 0xd9, 0x28, 0xce, 0xa1, 0x12, 0x7c,
+	0xf7, 0xaa, 0x41, 0xf3, 0xd5, 0x49, 0xeb, 0xf4, 0xf8, 0x60, 0xbf, 0xdb, 0x3a, 0x6e, 0x17, 0x25,
+	0xb4, 0x0d, 0xe5, 0xab, 0x16, 0x6a, 0xf3, 0xa8, 0x79, 0xd0, 0x6d, 0x9d, 0x36, 0xb5, 0x50, 0x53,
+	0x4c, 0x3d, 0x52, 0xe1, 0xfe, 0x4c, 0xcb, 0xa9, 0x4c, 0x67, 0x63, 0x8a, 0xb2, 0xb0, 0xd8, 0x69,
+	0xb6, 0x1b, 0xdc, 0x66, 0x0e, 0xe5, 0x60, 0xe9, 0xb4, 0xa9, 0xf8, 0xd8, 0x12, 0x02, 0x58, 0xd8,
+	0x17, 0x61, 0x8a, 0x29, 0xae, 0x39, 0x69, 0xd7, 0x8f, 0xdb, 0x8d, 0x66, 0xa3, 0x98, 0x46, 0x8b,
+	0x90, 0xde, 0x6f, 0xff, 0xa6, 0x98, 0xa9, 0xb7, 0xff, 0xf9, 0x7e, 0x53, 0x7a, 0xfb, 0x7e, 0x53,
+	0xfa, 0xf7, 0xfb, 0x4d, 0xe9, 0x2f, 0x1f, 0x36, 0xe7, 0xde, 0x7e, 0xd8, 0x9c, 0xfb, 0xd7, 0x87,
+	0xcd, 0xb9, 0xdf, 0x7e, 0x46, 0x81, 0xa7, 0xc9, 0x7f, 0x5e, 0x51, 0xed, 0xde, 0x82, 0xf8, 0x8b,
+	0x7d, 0xfa, 0xbf, 0x01, 0x00, 0x58, 0xb9, 0xf0, 0x22, 0xac, 0x0f, 0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -1004,6 +1125,11 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashingReason != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashingReason))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Jailed {
 		i--
 		if m.Jailed {
@@ -1102,6 +1228,11 @@ func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.SlashingReason != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashingReason))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Jailed {
 		i--
 		if m.Jailed {
@@ -1167,6 +1298,29 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiredBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ExpiredBtcHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ParamsVersion != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
 		i--
@@ -1329,6 +1483,11 @@ func (m *DelegatorUnbondingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.SpendStakeTxBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SpendStakeTxBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.SpendStakeTx) > 0 {
 		i -= len(m.SpendStakeTx)
 		copy(dAtA[i:], m.SpendStakeTx)
@@ -1613,6 +1772,11 @@ func (m *SelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.RecoveredFpBtcSk) > 0 {
 		i -= len(m.RecoveredFpBtcSk)
 		copy(dAtA[i:], m.RecoveredFpBtcSk)
@@ -1730,6 +1894,9 @@ func (m *FinalityProvider) Size() (n int) {
 	if m.Jailed {
 		n += 2
 	}
+	if m.SlashingReason != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SlashingReason))
+	}
 	return n
 }
 
@@ -1758,6 +1925,9 @@ func (m *FinalityProviderWithMeta) Size() (n int) {
 	if m.Jailed {
 		n += 2
 	}
+	if m.SlashingReason != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SlashingReason))
+	}
 	return n
 }
 
@@ -1828,6 +1998,16 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 2 + sovBtcstaking(uint64(m.ParamsVersion))
 	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.CreationHeight))
+	}
+	if m.ExpiredBtcHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.ExpiredBtcHeight))
+	}
 	return n
 }
 
@@ -1841,6 +2021,9 @@ func (m *DelegatorUnbondingInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBtcstaking(uint64(l))
 	}
+	if m.SpendStakeTxBtcHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SpendStakeTxBtcHeight))
+	}
 	return n
 }
 
@@ -1965,6 +2148,9 @@ func (m *SelectiveSlashingEvidence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBtcstaking(uint64(l))
 	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.BlockHeight))
+	}
 	return n
 }
 
@@ -2253,6 +2439,25 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Jailed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingReason", wireType)
+			}
+			m.SlashingReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingReason |= SlashingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2434,6 +2639,25 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Jailed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingReason", wireType)
+			}
+			m.SlashingReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingReason |= SlashingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2929,6 +3153,76 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredBtcHeight", wireType)
+			}
+			m.ExpiredBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -3013,6 +3307,25 @@ func (m *DelegatorUnbondingInfo) Unmarshal(dAtA []byte) error {
 				m.SpendStakeTx = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxBtcHeight", wireType)
+			}
+			m.SpendStakeTxBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendStakeTxBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -3825,6 +4138,25 @@ func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 				m.RecoveredFpBtcSk = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -1,12 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -74,6 +76,96 @@ func NewBtcTransaction(transactionBytes []byte) (*ParsedBtcTransaction, error) {
 	}, nil
 }
 
+// NewBtcTransactionWithWitness parses a witness-stripped transaction and
+// attaches to it the given witness, which contains one serialized witness
+// stack per input, as in the final script witness of a PSBT input. The
+// returned transaction bytes are the serialization of the normalized
+// transaction, i.e., including the witness. It fails if the stripped
+// transaction already carries a witness, or if expectedTxHash is not nil and
+// does not match the txid of the normalized transaction. As the txid does not
+// commit to the witness, the expected txid binds the stripped transaction
+// rather than the witness. The witness is not checked against the spent
+// outputs, which are unknown to Babylon, so it is up to Bitcoin to reject a
+// transaction with an invalid witness
+func NewBtcTransactionWithWitness(
+	strippedTxBytes []byte,
+	witness [][]byte,
+	expectedTxHash *chainhash.Hash,
+) (*ParsedBtcTransaction, error) {
+	tx, err := bbn.NewBTCTxFromBytes(strippedTxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.HasWitness() {
+		return nil, fmt.Errorf("transaction already carries a witness")
+	}
+
+	if len(witness) != len(tx.TxIn) {
+		return nil, fmt.Errorf("number of witness stacks %d does not match number of inputs %d", len(witness), len(tx.TxIn))
+	}
+
+	for i, witnessBytes := range witness {
+		txWitness, err := parseWitnessStack(witnessBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid witness of input %d: %w", i, err)
+		}
+		tx.TxIn[i].Witness = txWitness
+	}
+
+	normalizedTxBytes, err := bbn.SerializeBTCTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the normalized tx is deserialized again to ensure it is the one that
+	// will be processed and stored
+	normalizedTx, err := bbn.NewBTCTxFromBytes(normalizedTxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize normalized transaction: %w", err)
+	}
+
+	if normalizedTxHash := normalizedTx.TxHash(); expectedTxHash != nil && !normalizedTxHash.IsEqual(expectedTxHash) {
+		return nil, fmt.Errorf("txid %s of normalized transaction does not match expected txid %s",
+			normalizedTxHash, expectedTxHash)
+	}
+
+	return &ParsedBtcTransaction{
+		Transaction:      normalizedTx,
+		TransactionBytes: normalizedTxBytes,
+	}, nil
+}
+
+// parseWitnessStack parses a witness stack serialized as the number of items
+// followed by the length-prefixed items
+func parseWitnessStack(witnessBytes []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(witnessBytes)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	// each item takes at least one byte for its length
+	if numItems > uint64(r.Len()) {
+		return nil, fmt.Errorf("number of witness items %d exceeds the size of the witness", numItems)
+	}
+
+	txWitness := make(wire.TxWitness, 0, numItems)
+	for i := uint64(0); i < numItems; i++ {
+		item, err := wire.ReadVarBytes(r, 0, uint32(len(witnessBytes)), "witness item")
+		if err != nil {
+			return nil, err
+		}
+		txWitness = append(txWitness, item)
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d unexpected trailing bytes", r.Len())
+	}
+
+	return txWitness, nil
+}
+
 type ParsedPublicKeyList struct {
 	PublicKeys          []*btcec.PublicKey
 	PublicKeysBbnFormat []bbn.BIP340PubKey
@@ -121,8 +213,55 @@ func NewParsedProofOfInclusion(
 	}, nil
 }
 
+// Fields of MsgCreateBTCDelegation identified by ParseFieldError
+const (
+	FieldStakerAddr                    = "staker_addr"
+	FieldRewardAddress                 = "reward_address"
+	FieldPop                           = "pop"
+	FieldBtcPk                         = "btc_pk"
+	FieldFpBtcPkList                   = "fp_btc_pk_list"
+	FieldStakingTime                   = "staking_time"
+	FieldStakingValue                  = "staking_value"
+	FieldStakingTx                     = "staking_tx"
+	FieldStakingTxWitness              = "staking_tx_witness"
+	FieldStakingTxInclusionProof       = "staking_tx_inclusion_proof"
+	FieldSlashingTx                    = "slashing_tx"
+	FieldDelegatorSlashingSig          = "delegator_slashing_sig"
+	FieldUnbondingTime                 = "unbonding_time"
+	FieldUnbondingTx                   = "unbonding_tx"
+	FieldUnbondingValue                = "unbonding_value"
+	FieldUnbondingSlashingTx           = "unbonding_slashing_tx"
+	FieldDelegatorUnbondingSlashingSig = "delegator_unbonding_slashing_sig"
+	FieldRefundStakingTxHash           = "refund_staking_tx_hash"
+)
+
+// ParseFieldError is the error returned by ParseCreateDelegationMessage when
+// a field of the message cannot be parsed. It identifies the malformed field
+// so that the creator of the message can tell exactly which part of it to fix
+type ParseFieldError struct {
+	// Field is the name of the malformed field of MsgCreateBTCDelegation
+	Field string
+	// Err is the reason why the field cannot be parsed
+	Err error
+}
+
+func newParseFieldError(field string, err error) *ParseFieldError {
+	return &ParseFieldError{Field: field, Err: err}
+}
+
+func (e *ParseFieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %v", e.Field, e.Err)
+}
+
+func (e *ParseFieldError) Unwrap() error {
+	return e.Err
+}
+
 type ParsedCreateDelegationMessage struct {
 	StakerAddress sdk.AccAddress
+	// RewardAddress is the address to receive rewards from the BTC delegation.
+	// It defaults to StakerAddress if not specified in the message
+	RewardAddress sdk.AccAddress
 	StakingTx     *ParsedBtcTransaction
 	// StakingTxInclusionProof is optional is and it is up to the caller to verify
 	// whether it is present or not
@@ -139,12 +278,17 @@ type ParsedCreateDelegationMessage struct {
 	StakerUnbondingSlashingSig *ParsedBIP340Signature
 	FinalityProviderKeys       *ParsedPublicKeyList
 	ParsedPop                  *ProofOfPossessionBTC
+	// RefundStakingTxHash is the staking tx hash of the refundable BTC
+	// delegation whose refund is claimed. It is nil if no refund is claimed
+	RefundStakingTxHash *chainhash.Hash
 }
 
 // ParseCreateDelegationMessage parses a MsgCreateBTCDelegation message and performs some basic
 // stateless checks:
 // - unbonding transaction is a simple transfer
 // - there is no duplicated keys in the finality provider key list
+// If a field of the message is malformed, the returned error is a
+// *ParseFieldError identifying the field
 func ParseCreateDelegationMessage(msg *MsgCreateBTCDelegation) (*ParsedCreateDelegationMessage, error) {
 	if msg == nil {
 		return nil, fmt.Errorf("cannot parse nil MsgCreateBTCDelegation")
@@ -154,101 +298,136 @@ func ParseCreateDelegationMessage(msg *MsgCreateBTCDelegation) (*ParsedCreateDel
 	stakingTxProofOfInclusion, err := NewParsedProofOfInclusion(msg.StakingTxInclusionProof)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse staking tx proof of inclusion: %v", err)
+		return nil, newParseFieldError(FieldStakingTxInclusionProof, fmt.Errorf("failed to parse staking tx proof of inclusion: %w", err))
 	}
 
 	// 1. Parse all transactions
 	stakingTx, err := NewBtcTransaction(msg.StakingTx)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize staking tx: %v", err)
+		return nil, newParseFieldError(FieldStakingTx, fmt.Errorf("failed to deserialize staking tx: %w", err))
 	}
 
 	stakingSlashingTx, err := NewBtcTransaction(msg.SlashingTx.MustMarshal())
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize staking slashing tx: %v", err)
+		return nil, newParseFieldError(FieldSlashingTx, fmt.Errorf("failed to deserialize staking slashing tx: %w", err))
 	}
 
 	unbondingTx, err := NewBtcTransaction(msg.UnbondingTx)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize unbonding tx: %v", err)
+		return nil, newParseFieldError(FieldUnbondingTx, fmt.Errorf("failed to deserialize unbonding tx: %w", err))
+	}
+
+	// the staking tx might be submitted stripped of its witness, which is
+	// then attached to it. The normalized staking tx must have the txid
+	// expected by the unbonding tx, which spends the staking output
+	if len(msg.StakingTxWitness) > 0 {
+		var expectedStakingTxHash *chainhash.Hash
+		if len(unbondingTx.Transaction.TxIn) == 1 {
+			expectedStakingTxHash = &unbondingTx.Transaction.TxIn[0].PreviousOutPoint.Hash
+		}
+
+		stakingTx, err = NewBtcTransactionWithWitness(msg.StakingTx, msg.StakingTxWitness, expectedStakingTxHash)
+
+		if err != nil {
+			return nil, newParseFieldError(FieldStakingTxWitness, fmt.Errorf("failed to attach witness to staking tx: %w", err))
+		}
 	}
 
 	unbondingSlashingTx, err := NewBtcTransaction(msg.UnbondingSlashingTx.MustMarshal())
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize unbonding slashing tx: %v", err)
+		return nil, newParseFieldError(FieldUnbondingSlashingTx, fmt.Errorf("failed to deserialize unbonding slashing tx: %w", err))
 	}
 
 	// 2. Check all timelocks
 	if msg.UnbondingTime > math.MaxUint16 {
-		return nil, fmt.Errorf("unbonding time %d must be lower than %d", msg.UnbondingTime, math.MaxUint16)
+		return nil, newParseFieldError(FieldUnbondingTime, fmt.Errorf("unbonding time %d must be lower than %d", msg.UnbondingTime, math.MaxUint16))
 	}
 
 	if msg.StakingTime > math.MaxUint16 {
-		return nil, fmt.Errorf("staking time %d must be lower than %d", msg.StakingTime, math.MaxUint16)
+		return nil, newParseFieldError(FieldStakingTime, fmt.Errorf("staking time %d must be lower than %d", msg.StakingTime, math.MaxUint16))
 	}
 
-	// 3. Parse staker address
+	// 3. Parse staker and reward address
 	stakerAddr, err := sdk.AccAddressFromBech32(msg.StakerAddr)
 
 	if err != nil {
-		return nil, fmt.Errorf("invalid staker address %s: %v", msg.StakerAddr, err)
+		return nil, newParseFieldError(FieldStakerAddr, fmt.Errorf("invalid staker address %s: %w", msg.StakerAddr, err))
+	}
+
+	rewardAddr := stakerAddr
+	if len(msg.RewardAddress) > 0 {
+		rewardAddr, err = sdk.AccAddressFromBech32(msg.RewardAddress)
+
+		if err != nil {
+			return nil, newParseFieldError(FieldRewardAddress, fmt.Errorf("invalid reward address %s: %w", msg.RewardAddress, err))
+		}
 	}
 
 	// 4. Parse proof of possession
 	if msg.Pop == nil {
-		return nil, fmt.Errorf("empty proof of possession")
+		return nil, newParseFieldError(FieldPop, fmt.Errorf("empty proof of possession"))
 	}
 
 	if err := msg.Pop.ValidateBasic(); err != nil {
-		return nil, err
+		return nil, newParseFieldError(FieldPop, err)
 	}
 
 	// 5. Parse signatures for slashing transaction
 	stakerStakingSlashingTxSig, err := NewParsedBIP340Signature(msg.DelegatorSlashingSig)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse staker staking slashing signature: %v", err)
+		return nil, newParseFieldError(FieldDelegatorSlashingSig, fmt.Errorf("failed to parse staker staking slashing signature: %w", err))
 	}
 
 	stakerUnbondingSlashingSig, err := NewParsedBIP340Signature(msg.DelegatorUnbondingSlashingSig)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse staker unbonding slashing signature: %v", err)
+		return nil, newParseFieldError(FieldDelegatorUnbondingSlashingSig, fmt.Errorf("failed to parse staker unbonding slashing signature: %w", err))
 	}
 
 	// 6. Parse finality provider public keys and check for duplicates
 	fpPKs, err := NewParsedPublicKeyList(msg.FpBtcPkList)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse finality provider public keys: %v", err)
+		return nil, newParseFieldError(FieldFpBtcPkList, fmt.Errorf("failed to parse finality provider public keys: %w", err))
 	}
 
 	if ExistsDup(fpPKs.PublicKeysBbnFormat) {
-		return nil, ErrDuplicatedFp
+		return nil, newParseFieldError(FieldFpBtcPkList, ErrDuplicatedFp)
 	}
 
 	// 7. Parse staker public key
 	stakerPK, err := NewParsedPublicKey(msg.BtcPk)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse staker public key: %v", err)
+		return nil, newParseFieldError(FieldBtcPk, fmt.Errorf("failed to parse staker public key: %w", err))
 	}
 
 	// 8. Parse staking and unbonding value
 	if msg.StakingValue < 0 {
-		return nil, fmt.Errorf("staking value must be positive")
+		return nil, newParseFieldError(FieldStakingValue, fmt.Errorf("staking value must be positive"))
 	}
 
 	if msg.UnbondingValue < 0 {
-		return nil, fmt.Errorf("unbonding value must be positive")
+		return nil, newParseFieldError(FieldUnbondingValue, fmt.Errorf("unbonding value must be positive"))
+	}
+
+	var refundStakingTxHash *chainhash.Hash
+	if len(msg.RefundStakingTxHash) > 0 {
+		refundStakingTxHash, err = chainhash.NewHashFromStr(msg.RefundStakingTxHash)
+
+		if err != nil {
+			return nil, newParseFieldError(FieldRefundStakingTxHash, fmt.Errorf("invalid staking tx hash %s: %w", msg.RefundStakingTxHash, err))
+		}
 	}
 
 	return &ParsedCreateDelegationMessage{
 		StakerAddress:              stakerAddr,
+		RewardAddress:              rewardAddr,
 		StakingTx:                  stakingTx,
 		StakingTxProofOfInclusion:  stakingTxProofOfInclusion,
 		StakingTime:                uint16(msg.StakingTime),
@@ -263,5 +442,6 @@ func ParseCreateDelegationMessage(msg *MsgCreateBTCDelegation) (*ParsedCreateDel
 		StakerUnbondingSlashingSig: stakerUnbondingSlashingSig,
 		FinalityProviderKeys:       fpPKs,
 		ParsedPop:                  msg.Pop,
+		RefundStakingTxHash:        refundStakingTxHash,
 	}, nil
 }
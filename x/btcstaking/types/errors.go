@@ -6,27 +6,43 @@ import (
 
 // x/btcstaking module sentinel errors
 var (
-	ErrFpNotFound               = errorsmod.Register(ModuleName, 1100, "the finality provider is not found")
-	ErrBTCDelegatorNotFound     = errorsmod.Register(ModuleName, 1101, "the BTC delegator is not found")
-	ErrBTCDelegationNotFound    = errorsmod.Register(ModuleName, 1102, "the BTC delegation is not found")
-	ErrFpRegistered             = errorsmod.Register(ModuleName, 1103, "the finality provider has already been registered")
-	ErrFpAlreadySlashed         = errorsmod.Register(ModuleName, 1104, "the finality provider has already been slashed")
-	ErrBTCHeightNotFound        = errorsmod.Register(ModuleName, 1105, "the BTC height is not found")
-	ErrReusedStakingTx          = errorsmod.Register(ModuleName, 1106, "the BTC staking tx is already used")
-	ErrInvalidCovenantPK        = errorsmod.Register(ModuleName, 1107, "the BTC staking tx specifies a wrong covenant PK")
-	ErrInvalidStakingTx         = errorsmod.Register(ModuleName, 1108, "the BTC staking tx is not valid")
-	ErrInvalidSlashingTx        = errorsmod.Register(ModuleName, 1109, "the BTC slashing tx is not valid")
-	ErrInvalidCovenantSig       = errorsmod.Register(ModuleName, 1110, "the covenant signature is not valid")
-	ErrCommissionLTMinRate      = errorsmod.Register(ModuleName, 1111, "commission cannot be less than min rate")
-	ErrCommissionGTMaxRate      = errorsmod.Register(ModuleName, 1112, "commission cannot be more than one")
-	ErrInvalidDelegationState   = errorsmod.Register(ModuleName, 1113, "Unexpected delegation state")
-	ErrInvalidUnbondingTx       = errorsmod.Register(ModuleName, 1114, "the BTC unbonding tx is not valid")
-	ErrEmptyFpList              = errorsmod.Register(ModuleName, 1115, "the finality provider list is empty")
-	ErrInvalidProofOfPossession = errorsmod.Register(ModuleName, 1116, "the proof of possession is not valid")
-	ErrDuplicatedFp             = errorsmod.Register(ModuleName, 1117, "the staking request contains duplicated finality provider public key")
-	ErrInvalidBTCUndelegateReq  = errorsmod.Register(ModuleName, 1118, "invalid undelegation request")
-	ErrParamsNotFound           = errorsmod.Register(ModuleName, 1119, "the parameters are not found")
-	ErrFpAlreadyJailed          = errorsmod.Register(ModuleName, 1120, "the finality provider has already been jailed")
-	ErrFpNotJailed              = errorsmod.Register(ModuleName, 1121, "the finality provider is not jailed")
-	ErrDuplicatedCovenantSig    = errorsmod.Register(ModuleName, 1122, "the covenant signature is already submitted")
+	ErrFpNotFound                  = errorsmod.Register(ModuleName, 1100, "the finality provider is not found")
+	ErrBTCDelegatorNotFound        = errorsmod.Register(ModuleName, 1101, "the BTC delegator is not found")
+	ErrBTCDelegationNotFound       = errorsmod.Register(ModuleName, 1102, "the BTC delegation is not found")
+	ErrFpRegistered                = errorsmod.Register(ModuleName, 1103, "the finality provider has already been registered")
+	ErrFpAlreadySlashed            = errorsmod.Register(ModuleName, 1104, "the finality provider has already been slashed")
+	ErrBTCHeightNotFound           = errorsmod.Register(ModuleName, 1105, "the BTC height is not found")
+	ErrReusedStakingTx             = errorsmod.Register(ModuleName, 1106, "the BTC staking tx is already used")
+	ErrInvalidCovenantPK           = errorsmod.Register(ModuleName, 1107, "the BTC staking tx specifies a wrong covenant PK")
+	ErrInvalidStakingTx            = errorsmod.Register(ModuleName, 1108, "the BTC staking tx is not valid")
+	ErrInvalidSlashingTx           = errorsmod.Register(ModuleName, 1109, "the BTC slashing tx is not valid")
+	ErrInvalidCovenantSig          = errorsmod.Register(ModuleName, 1110, "the covenant signature is not valid")
+	ErrCommissionLTMinRate         = errorsmod.Register(ModuleName, 1111, "commission cannot be less than min rate")
+	ErrCommissionGTMaxRate         = errorsmod.Register(ModuleName, 1112, "commission cannot be more than one")
+	ErrInvalidDelegationState      = errorsmod.Register(ModuleName, 1113, "Unexpected delegation state")
+	ErrInvalidUnbondingTx          = errorsmod.Register(ModuleName, 1114, "the BTC unbonding tx is not valid")
+	ErrEmptyFpList                 = errorsmod.Register(ModuleName, 1115, "the finality provider list is empty")
+	ErrInvalidProofOfPossession    = errorsmod.Register(ModuleName, 1116, "the proof of possession is not valid")
+	ErrDuplicatedFp                = errorsmod.Register(ModuleName, 1117, "the staking request contains duplicated finality provider public key")
+	ErrInvalidBTCUndelegateReq     = errorsmod.Register(ModuleName, 1118, "invalid undelegation request")
+	ErrParamsNotFound              = errorsmod.Register(ModuleName, 1119, "the parameters are not found")
+	ErrFpAlreadyJailed             = errorsmod.Register(ModuleName, 1120, "the finality provider has already been jailed")
+	ErrFpNotJailed                 = errorsmod.Register(ModuleName, 1121, "the finality provider is not jailed")
+	ErrDuplicatedCovenantSig       = errorsmod.Register(ModuleName, 1122, "the covenant signature is already submitted")
+	ErrInvalidStakingTxHash        = errorsmod.Register(ModuleName, 1123, "the staking tx hash is not valid")
+	ErrFpHasDelegations            = errorsmod.Register(ModuleName, 1124, "the finality provider has BTC delegations")
+	ErrFpCommissionNotFound        = errorsmod.Register(ModuleName, 1125, "the commission of the finality provider at the given height is not found")
+	ErrBTCHeaderNotRetained        = errorsmod.Register(ModuleName, 1126, "the BTC header at the given height is not retained by the BTC light client")
+	ErrStakingTimeTooShort         = errorsmod.Register(ModuleName, 1127, "the staking time is shorter than the minimum staking time")
+	ErrTooManyActiveDelegations    = errorsmod.Register(ModuleName, 1128, "the staker has reached the maximum number of active BTC delegations")
+	ErrDelegationAlreadyUnbonded   = errorsmod.Register(ModuleName, 1129, "the BTC delegation is already unbonded")
+	ErrInclusionProofAlreadyExists = errorsmod.Register(ModuleName, 1130, "the BTC delegation already has an inclusion proof")
+	ErrNoCovenantQuorum            = errorsmod.Register(ModuleName, 1131, "the BTC delegation has not received a quorum of covenant signatures")
+	ErrInsufficientFpBalance       = errorsmod.Register(ModuleName, 1132, "the finality provider's account balance is below the minimum")
+	ErrFpEditTooFrequent           = errorsmod.Register(ModuleName, 1133, "the finality provider was edited too recently")
+	ErrDescriptionTooLong          = errorsmod.Register(ModuleName, 1134, "the finality provider description is too long")
+	ErrCovenantSigsPerBlockLimit   = errorsmod.Register(ModuleName, 1135, "the block has reached the maximum number of covenant signatures, retry in a later block")
+	ErrRefundableDelNotFound       = errorsmod.Register(ModuleName, 1136, "the BTC delegation is not refundable to the staker")
+	ErrCommissionChangeTooLarge    = errorsmod.Register(ModuleName, 1137, "the commission change exceeds the maximum commission change rate")
+	ErrMaxCommissionChangeTooLarge = errorsmod.Register(ModuleName, 1138, "the maximum commission change rate exceeds the range of commission rates above the minimum commission rate")
 )
@@ -5,11 +5,26 @@ import (
 	"fmt"
 	"strconv"
 
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	bbn "github.com/babylonlabs-io/babylon/types"
 )
 
+const (
+	// EventTypeJSON is the type of the events carrying the JSON serialization
+	// of typed events, which are emitted only if enabled on the node
+	EventTypeJSON = "btcstaking_json_event"
+	// AttributeKeyEventName is the attribute of JSON events holding the
+	// proto message name of the typed event
+	AttributeKeyEventName = "event_name"
+	// AttributeKeyPayload is the attribute of JSON events holding the JSON
+	// serialization of the typed event
+	AttributeKeyPayload = "payload"
+)
+
 func NewEventPowerDistUpdateWithBTCDel(ev *EventBTCDelegationStateUpdate) *EventPowerDistUpdate {
 	return &EventPowerDistUpdate{
 		Ev: &EventPowerDistUpdate_BtcDelStateUpdate{
@@ -18,6 +33,14 @@ func NewEventPowerDistUpdateWithBTCDel(ev *EventBTCDelegationStateUpdate) *Event
 	}
 }
 
+func NewEventPowerDistUpdateScheduled(btcHeight uint32, ev *EventBTCDelegationStateUpdate) *EventPowerDistUpdateScheduled {
+	return &EventPowerDistUpdateScheduled{
+		BtcHeight:     btcHeight,
+		StakingTxHash: ev.StakingTxHash,
+		NewState:      ev.NewState,
+	}
+}
+
 func NewEventPowerDistUpdateWithSlashedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
 	return &EventPowerDistUpdate{
 		Ev: &EventPowerDistUpdate_SlashedFp{
@@ -48,6 +71,21 @@ func NewEventPowerDistUpdateWithUnjailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowe
 	}
 }
 
+// NewEventPowerDistUpdateWithCommissionUpdatedFP creates an event recording the
+// commission update of the given finality provider. The commission is taken by
+// value so that the event always carries a non-nil commission, which is
+// required upon distributing rewards
+func NewEventPowerDistUpdateWithCommissionUpdatedFP(fpBTCPK *bbn.BIP340PubKey, commission sdkmath.LegacyDec) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_CommissionUpdatedFp{
+			CommissionUpdatedFp: &EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider{
+				Pk:         fpBTCPK,
+				Commission: &commission,
+			},
+		},
+	}
+}
+
 func NewEventFinalityProviderCreated(fp *FinalityProvider) *EventFinalityProviderCreated {
 	return &EventFinalityProviderCreated{
 		BtcPkHex:        fp.BtcPk.MarshalHex(),
@@ -73,6 +111,13 @@ func NewEventFinalityProviderEdited(fp *FinalityProvider) *EventFinalityProvider
 	}
 }
 
+func NewEventFinalityProviderDeleted(fp *FinalityProvider) *EventFinalityProviderDeleted {
+	return &EventFinalityProviderDeleted{
+		BtcPkHex: fp.BtcPk.MarshalHex(),
+		Addr:     fp.Addr,
+	}
+}
+
 func NewInclusionProofEvent(
 	stakingTxHash string,
 	startHeight uint32,
@@ -215,3 +260,50 @@ func EmitJailedFPEvent(sdkCtx sdk.Context, fpBTCPK *bbn.BIP340PubKey) {
 			FinalityProviderStatus_FINALITY_PROVIDER_STATUS_JAILED.String(), err))
 	}
 }
+
+// ParseBTCDelegationStateUpdate extracts the state update of a BTC delegation
+// from the given ABCI event, which is one of the typed events emitted when a
+// BTC delegation changes its state. It returns false if the event is not such
+// an event.
+func ParseBTCDelegationStateUpdate(event abci.Event) (*EventBTCDelegationStateUpdate, bool, error) {
+	switch event.Type {
+	case proto.MessageName(&EventBTCDelegationCreated{}),
+		proto.MessageName(&EventCovenantQuorumReached{}),
+		proto.MessageName(&EventBTCDelegationInclusionProofReceived{}),
+		proto.MessageName(&EventBTCDelgationUnbondedEarly{}),
+		proto.MessageName(&EventBTCDelegationExpired{}):
+	default:
+		return nil, false, nil
+	}
+
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse event %s: %w", event.Type, err)
+	}
+
+	var stakingTxHash, newState string
+	switch ev := msg.(type) {
+	case *EventBTCDelegationCreated:
+		stakingTxHash, newState = ev.StakingTxHash, ev.NewState
+	case *EventCovenantQuorumReached:
+		stakingTxHash, newState = ev.StakingTxHash, ev.NewState
+	case *EventBTCDelegationInclusionProofReceived:
+		stakingTxHash, newState = ev.StakingTxHash, ev.NewState
+	case *EventBTCDelgationUnbondedEarly:
+		stakingTxHash, newState = ev.StakingTxHash, ev.NewState
+	case *EventBTCDelegationExpired:
+		stakingTxHash, newState = ev.StakingTxHash, ev.NewState
+	default:
+		return nil, false, fmt.Errorf("unexpected event %T", msg)
+	}
+
+	status, ok := BTCDelegationStatus_value[newState]
+	if !ok {
+		return nil, false, fmt.Errorf("invalid BTC delegation status %s in event %s", newState, event.Type)
+	}
+
+	return &EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash,
+		NewState:      BTCDelegationStatus(status),
+	}, true, nil
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -274,6 +275,62 @@ func (m *EventFinalityProviderEdited) GetDetails() string {
 	return ""
 }
 
+// EventFinalityProviderDeleted is the event emitted when a finality provider
+// cancels its registration and is deleted
+type EventFinalityProviderDeleted struct {
+	// btc_pk_hex is the hex string of Bitcoin secp256k1 PK of this finality provider
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// addr is the babylon address of the finality provider
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (m *EventFinalityProviderDeleted) Reset()         { *m = EventFinalityProviderDeleted{} }
+func (m *EventFinalityProviderDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderDeleted) ProtoMessage()    {}
+func (*EventFinalityProviderDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{2}
+}
+func (m *EventFinalityProviderDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderDeleted.Merge(m, src)
+}
+func (m *EventFinalityProviderDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderDeleted proto.InternalMessageInfo
+
+func (m *EventFinalityProviderDeleted) GetBtcPkHex() string {
+	if m != nil {
+		return m.BtcPkHex
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderDeleted) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
 // EventBTCDelegationStateUpdate is the event emitted when a BTC delegation's state is
 // updated. There are the following possible state transitions:
 // - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
@@ -291,7 +348,7 @@ func (m *EventBTCDelegationStateUpdate) Reset()         { *m = EventBTCDelegatio
 func (m *EventBTCDelegationStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationStateUpdate) ProtoMessage()    {}
 func (*EventBTCDelegationStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{2}
+	return fileDescriptor_74118427820fff75, []int{3}
 }
 func (m *EventBTCDelegationStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +403,7 @@ func (m *EventSelectiveSlashing) Reset()         { *m = EventSelectiveSlashing{}
 func (m *EventSelectiveSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSelectiveSlashing) ProtoMessage()    {}
 func (*EventSelectiveSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{3}
+	return fileDescriptor_74118427820fff75, []int{4}
 }
 func (m *EventSelectiveSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,6 +449,7 @@ type EventPowerDistUpdate struct {
 	//	*EventPowerDistUpdate_JailedFp
 	//	*EventPowerDistUpdate_UnjailedFp
 	//	*EventPowerDistUpdate_BtcDelStateUpdate
+	//	*EventPowerDistUpdate_CommissionUpdatedFp
 	Ev isEventPowerDistUpdate_Ev `protobuf_oneof:"ev"`
 }
 
@@ -399,7 +457,7 @@ func (m *EventPowerDistUpdate) Reset()         { *m = EventPowerDistUpdate{} }
 func (m *EventPowerDistUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventPowerDistUpdate) ProtoMessage()    {}
 func (*EventPowerDistUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4}
+	return fileDescriptor_74118427820fff75, []int{5}
 }
 func (m *EventPowerDistUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,11 +504,15 @@ type EventPowerDistUpdate_UnjailedFp struct {
 type EventPowerDistUpdate_BtcDelStateUpdate struct {
 	BtcDelStateUpdate *EventBTCDelegationStateUpdate `protobuf:"bytes,4,opt,name=btc_del_state_update,json=btcDelStateUpdate,proto3,oneof" json:"btc_del_state_update,omitempty"`
 }
+type EventPowerDistUpdate_CommissionUpdatedFp struct {
+	CommissionUpdatedFp *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider `protobuf:"bytes,5,opt,name=commission_updated_fp,json=commissionUpdatedFp,proto3,oneof" json:"commission_updated_fp,omitempty"`
+}
 
-func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()         {}
-func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()          {}
-func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()        {}
-func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev() {}
+func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()           {}
+func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()            {}
+func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()          {}
+func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev()   {}
+func (*EventPowerDistUpdate_CommissionUpdatedFp) isEventPowerDistUpdate_Ev() {}
 
 func (m *EventPowerDistUpdate) GetEv() isEventPowerDistUpdate_Ev {
 	if m != nil {
@@ -487,6 +549,13 @@ func (m *EventPowerDistUpdate) GetBtcDelStateUpdate() *EventBTCDelegationStateUp
 	return nil
 }
 
+func (m *EventPowerDistUpdate) GetCommissionUpdatedFp() *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_CommissionUpdatedFp); ok {
+		return x.CommissionUpdatedFp
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -494,6 +563,7 @@ func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
 		(*EventPowerDistUpdate_JailedFp)(nil),
 		(*EventPowerDistUpdate_UnjailedFp)(nil),
 		(*EventPowerDistUpdate_BtcDelStateUpdate)(nil),
+		(*EventPowerDistUpdate_CommissionUpdatedFp)(nil),
 	}
 }
 
@@ -512,7 +582,7 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventSlashedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventSlashedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 0}
+	return fileDescriptor_74118427820fff75, []int{5, 0}
 }
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +625,7 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventJailedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 1}
+	return fileDescriptor_74118427820fff75, []int{5, 1}
 }
 func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +668,7 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 2}
+	return fileDescriptor_74118427820fff75, []int{5, 2}
 }
 func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,6 +697,116 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_DiscardUnknown(
 
 var xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider proto.InternalMessageInfo
 
+// EventCommissionUpdatedFinalityProvider defines an event that the
+// commission rate of a finality provider is updated
+type EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider struct {
+	Pk         *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+	Commission *cosmossdk_io_math.LegacyDec                          `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{5, 3}
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider proto.InternalMessageInfo
+
+// EventPowerDistUpdateScheduled is the event emitted when a state update of a
+// BTC delegation is scheduled to be processed at a given BTC height. It gives
+// external systems advance notice of upcoming activations and unbondings.
+type EventPowerDistUpdateScheduled struct {
+	// btc_height is the BTC height at which the update will be processed
+	BtcHeight uint32 `protobuf:"varint,1,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// new_state is the state the BTC delegation will transition to
+	NewState BTCDelegationStatus `protobuf:"varint,3,opt,name=new_state,json=newState,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"new_state,omitempty"`
+}
+
+func (m *EventPowerDistUpdateScheduled) Reset()         { *m = EventPowerDistUpdateScheduled{} }
+func (m *EventPowerDistUpdateScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventPowerDistUpdateScheduled) ProtoMessage()    {}
+func (*EventPowerDistUpdateScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{6}
+}
+func (m *EventPowerDistUpdateScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdateScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdateScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdateScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdateScheduled.Merge(m, src)
+}
+func (m *EventPowerDistUpdateScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdateScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdateScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdateScheduled proto.InternalMessageInfo
+
+func (m *EventPowerDistUpdateScheduled) GetBtcHeight() uint32 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func (m *EventPowerDistUpdateScheduled) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventPowerDistUpdateScheduled) GetNewState() BTCDelegationStatus {
+	if m != nil {
+		return m.NewState
+	}
+	return BTCDelegationStatus_PENDING
+}
+
 // A finality provider starts with status INACTIVE once registered.
 // Possible status transitions are when:
 // 1. it has accumulated sufficient delegations and has
@@ -655,7 +835,7 @@ func (m *EventFinalityProviderStatusChange) Reset()         { *m = EventFinality
 func (m *EventFinalityProviderStatusChange) String() string { return proto.CompactTextString(m) }
 func (*EventFinalityProviderStatusChange) ProtoMessage()    {}
 func (*EventFinalityProviderStatusChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{5}
+	return fileDescriptor_74118427820fff75, []int{7}
 }
 func (m *EventFinalityProviderStatusChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +914,7 @@ func (m *EventBTCDelegationCreated) Reset()         { *m = EventBTCDelegationCre
 func (m *EventBTCDelegationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationCreated) ProtoMessage()    {}
 func (*EventBTCDelegationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{6}
+	return fileDescriptor_74118427820fff75, []int{8}
 }
 func (m *EventBTCDelegationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +1038,7 @@ func (m *EventCovenantSignatureReceived) Reset()         { *m = EventCovenantSig
 func (m *EventCovenantSignatureReceived) String() string { return proto.CompactTextString(m) }
 func (*EventCovenantSignatureReceived) ProtoMessage()    {}
 func (*EventCovenantSignatureReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{7}
+	return fileDescriptor_74118427820fff75, []int{9}
 }
 func (m *EventCovenantSignatureReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1102,7 @@ func (m *EventCovenantQuorumReached) Reset()         { *m = EventCovenantQuorumR
 func (m *EventCovenantQuorumReached) String() string { return proto.CompactTextString(m) }
 func (*EventCovenantQuorumReached) ProtoMessage()    {}
 func (*EventCovenantQuorumReached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{8}
+	return fileDescriptor_74118427820fff75, []int{10}
 }
 func (m *EventCovenantQuorumReached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +1167,7 @@ func (m *EventBTCDelegationInclusionProofReceived) Reset() {
 func (m *EventBTCDelegationInclusionProofReceived) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationInclusionProofReceived) ProtoMessage()    {}
 func (*EventBTCDelegationInclusionProofReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{9}
+	return fileDescriptor_74118427820fff75, []int{11}
 }
 func (m *EventBTCDelegationInclusionProofReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1240,7 @@ func (m *EventBTCDelgationUnbondedEarly) Reset()         { *m = EventBTCDelgatio
 func (m *EventBTCDelgationUnbondedEarly) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelgationUnbondedEarly) ProtoMessage()    {}
 func (*EventBTCDelgationUnbondedEarly) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{10}
+	return fileDescriptor_74118427820fff75, []int{12}
 }
 func (m *EventBTCDelgationUnbondedEarly) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,7 +1304,7 @@ func (m *EventBTCDelegationExpired) Reset()         { *m = EventBTCDelegationExp
 func (m *EventBTCDelegationExpired) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationExpired) ProtoMessage()    {}
 func (*EventBTCDelegationExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{11}
+	return fileDescriptor_74118427820fff75, []int{13}
 }
 func (m *EventBTCDelegationExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1185,7 +1365,7 @@ func (m *EventUnexpectedUnbondingTx) Reset()         { *m = EventUnexpectedUnbon
 func (m *EventUnexpectedUnbondingTx) String() string { return proto.CompactTextString(m) }
 func (*EventUnexpectedUnbondingTx) ProtoMessage()    {}
 func (*EventUnexpectedUnbondingTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{12}
+	return fileDescriptor_74118427820fff75, []int{14}
 }
 func (m *EventUnexpectedUnbondingTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,16 +1422,97 @@ func (m *EventUnexpectedUnbondingTx) GetSpendStakeTxBlockIndex() uint32 {
 	return 0
 }
 
+// EventBTCDelegationCovenantQuorumDeadlinePassed is the event emitted when a
+// BTC delegation does not receive a covenant quorum before the deadline
+type EventBTCDelegationCovenantQuorumDeadlinePassed struct {
+	// staking_tx_hash uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// staker_addr is the address of the staker that is entitled to a refund
+	StakerAddr string `protobuf:"bytes,2,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// deadline_height is the Babylon height at which the deadline passed
+	DeadlineHeight uint64 `protobuf:"varint,3,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	// deleted indicates whether the BTC delegation is deleted
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) Reset() {
+	*m = EventBTCDelegationCovenantQuorumDeadlinePassed{}
+}
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventBTCDelegationCovenantQuorumDeadlinePassed) ProtoMessage() {}
+func (*EventBTCDelegationCovenantQuorumDeadlinePassed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{15}
+}
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationCovenantQuorumDeadlinePassed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationCovenantQuorumDeadlinePassed.Merge(m, src)
+}
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationCovenantQuorumDeadlinePassed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationCovenantQuorumDeadlinePassed proto.InternalMessageInfo
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.FinalityProviderStatus", FinalityProviderStatus_name, FinalityProviderStatus_value)
 	proto.RegisterType((*EventFinalityProviderCreated)(nil), "babylon.btcstaking.v1.EventFinalityProviderCreated")
 	proto.RegisterType((*EventFinalityProviderEdited)(nil), "babylon.btcstaking.v1.EventFinalityProviderEdited")
+	proto.RegisterType((*EventFinalityProviderDeleted)(nil), "babylon.btcstaking.v1.EventFinalityProviderDeleted")
 	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
 	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
 	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
 	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
 	proto.RegisterType((*EventPowerDistUpdate_EventJailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventJailedFinalityProvider")
 	proto.RegisterType((*EventPowerDistUpdate_EventUnjailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventUnjailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventCommissionUpdatedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdateScheduled)(nil), "babylon.btcstaking.v1.EventPowerDistUpdateScheduled")
 	proto.RegisterType((*EventFinalityProviderStatusChange)(nil), "babylon.btcstaking.v1.EventFinalityProviderStatusChange")
 	proto.RegisterType((*EventBTCDelegationCreated)(nil), "babylon.btcstaking.v1.EventBTCDelegationCreated")
 	proto.RegisterType((*EventCovenantSignatureReceived)(nil), "babylon.btcstaking.v1.EventCovenantSignatureReceived")
@@ -1260,6 +1521,7 @@ func init() {
 	proto.RegisterType((*EventBTCDelgationUnbondedEarly)(nil), "babylon.btcstaking.v1.EventBTCDelgationUnbondedEarly")
 	proto.RegisterType((*EventBTCDelegationExpired)(nil), "babylon.btcstaking.v1.EventBTCDelegationExpired")
 	proto.RegisterType((*EventUnexpectedUnbondingTx)(nil), "babylon.btcstaking.v1.EventUnexpectedUnbondingTx")
+	proto.RegisterType((*EventBTCDelegationCovenantQuorumDeadlinePassed)(nil), "babylon.btcstaking.v1.EventBTCDelegationCovenantQuorumDeadlinePassed")
 }
 
 func init() {
@@ -1267,88 +1529,100 @@ func init() {
 }
 
 var fileDescriptor_74118427820fff75 = []byte{
-	// 1290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6f, 0xdb, 0xc6,
-	0x13, 0xb6, 0x64, 0xd9, 0xb1, 0xc7, 0x79, 0x38, 0xfc, 0x39, 0x81, 0xac, 0x5f, 0xa2, 0x38, 0xca,
-	0x03, 0x6e, 0xd0, 0x48, 0x79, 0x18, 0x68, 0x4f, 0x05, 0x24, 0x5b, 0x8e, 0x94, 0x1a, 0x8e, 0x2a,
-	0xd9, 0x01, 0xda, 0x0b, 0xb1, 0x24, 0xc7, 0xd2, 0x56, 0xd4, 0x2e, 0x41, 0x2e, 0x65, 0xe9, 0x5e,
-	0xa0, 0xd7, 0x9c, 0x0b, 0xf4, 0xde, 0x5b, 0xfb, 0x27, 0xf4, 0xd8, 0x4b, 0x81, 0x5c, 0x0a, 0x14,
-	0x3d, 0x14, 0x45, 0x7c, 0xe8, 0x7f, 0x51, 0x14, 0xdc, 0x25, 0x25, 0x51, 0xa6, 0x12, 0xbb, 0x48,
-	0x2e, 0x86, 0x77, 0xe7, 0x9b, 0xf9, 0x66, 0x3f, 0xce, 0x03, 0x82, 0x82, 0x41, 0x8c, 0xa1, 0xcd,
-	0x59, 0xc9, 0x10, 0xa6, 0x27, 0x48, 0x97, 0xb2, 0x76, 0xa9, 0xff, 0xb8, 0x84, 0x7d, 0x64, 0xc2,
-	0x2b, 0x3a, 0x2e, 0x17, 0x5c, 0xbb, 0x16, 0x62, 0x8a, 0x63, 0x4c, 0xb1, 0xff, 0x38, 0xb7, 0xd6,
-	0xe6, 0x6d, 0x2e, 0x11, 0xa5, 0xe0, 0x3f, 0x05, 0xce, 0xdd, 0x35, 0xb9, 0xd7, 0xe3, 0x5e, 0x69,
-	0x1c, 0xcc, 0x40, 0x41, 0x1e, 0x47, 0xe7, 0x10, 0x75, 0x3f, 0x99, 0x76, 0x82, 0x40, 0xe1, 0xd6,
-	0x55, 0x34, 0x5d, 0xd1, 0xa8, 0x43, 0x68, 0xba, 0x4a, 0x7a, 0x94, 0xf1, 0x92, 0xfc, 0xab, 0xae,
-	0x0a, 0xdf, 0xa5, 0xe1, 0x46, 0x35, 0xc8, 0x7c, 0x97, 0x32, 0x62, 0x53, 0x31, 0x6c, 0xb8, 0xbc,
-	0x4f, 0x2d, 0x74, 0xb7, 0x5d, 0x24, 0x02, 0x2d, 0xed, 0x0e, 0x80, 0x21, 0x4c, 0xdd, 0xe9, 0xea,
-	0x1d, 0x1c, 0x64, 0x53, 0x1b, 0xa9, 0xcd, 0xe5, 0xca, 0xc2, 0x0f, 0x7f, 0xff, 0xf4, 0x20, 0xd5,
-	0x5c, 0x32, 0x84, 0xd9, 0xe8, 0xd6, 0x70, 0xa0, 0xad, 0x43, 0x86, 0x58, 0x96, 0x9b, 0x4d, 0x4f,
-	0x9a, 0xe5, 0x95, 0x76, 0x0f, 0xc0, 0xe4, 0xbd, 0x1e, 0xf5, 0x3c, 0xca, 0x59, 0x76, 0x7e, 0x12,
-	0x30, 0x61, 0xd0, 0xb2, 0x70, 0xa1, 0xc7, 0x19, 0xed, 0xa2, 0x9b, 0xcd, 0x04, 0x98, 0x66, 0x74,
-	0xd4, 0x72, 0xb0, 0x44, 0x2d, 0x64, 0x82, 0x8a, 0x61, 0x76, 0x41, 0x9a, 0x46, 0xe7, 0xc0, 0xeb,
-	0x18, 0x0d, 0x8f, 0x0a, 0xcc, 0x2e, 0x2a, 0xaf, 0xf0, 0xa8, 0x7d, 0x04, 0xab, 0x1e, 0x9a, 0xbe,
-	0x4b, 0xc5, 0x50, 0x37, 0x39, 0x13, 0xc4, 0x14, 0xd9, 0x0b, 0x12, 0x72, 0x25, 0xba, 0xdf, 0x56,
-	0xd7, 0x41, 0x10, 0x0b, 0x05, 0xa1, 0xb6, 0x97, 0x5d, 0x52, 0x41, 0xc2, 0x63, 0xe1, 0x9f, 0x14,
-	0xfc, 0x3f, 0x51, 0x9c, 0xaa, 0x45, 0xcf, 0xac, 0x4d, 0x5c, 0x80, 0xf4, 0x19, 0x04, 0x98, 0x9f,
-	0x2d, 0x40, 0x66, 0xb6, 0x00, 0x0b, 0xef, 0x16, 0x60, 0xf1, 0x9d, 0x02, 0x5c, 0x88, 0x0b, 0xf0,
-	0x2a, 0x05, 0x37, 0xa5, 0x00, 0x95, 0x83, 0xed, 0x1d, 0xb4, 0xb1, 0x4d, 0x04, 0xe5, 0xac, 0x25,
-	0x88, 0xc0, 0x43, 0xc7, 0x22, 0x02, 0xb5, 0xfb, 0x70, 0x25, 0x2c, 0x3f, 0x5d, 0x0c, 0xf4, 0x0e,
-	0xf1, 0x3a, 0x4a, 0x87, 0xe6, 0xa5, 0xf0, 0xfa, 0x60, 0x50, 0x23, 0x5e, 0x47, 0x7b, 0x06, 0xcb,
-	0x0c, 0x8f, 0x75, 0x2f, 0x70, 0x95, 0x22, 0x5c, 0x7e, 0xf2, 0xa0, 0x98, 0xd8, 0x24, 0xc5, 0x53,
-	0x5c, 0xbe, 0xd7, 0x5c, 0x62, 0x78, 0x2c, 0x69, 0x0b, 0x47, 0x70, 0x5d, 0x66, 0xd4, 0x42, 0x1b,
-	0x4d, 0x41, 0xfb, 0xd8, 0xb2, 0x89, 0xd7, 0xa1, 0xac, 0xad, 0xed, 0xc1, 0x12, 0x06, 0x5f, 0x87,
-	0x99, 0x28, 0x73, 0x58, 0x79, 0xf2, 0x68, 0x06, 0xc3, 0x29, 0xdf, 0x6a, 0xe8, 0xd7, 0x1c, 0x45,
-	0x28, 0x7c, 0xb3, 0x08, 0x6b, 0x92, 0xa8, 0xc1, 0x8f, 0xd1, 0xdd, 0xa1, 0x9e, 0x08, 0x5f, 0x4c,
-	0x01, 0xbc, 0xc0, 0x0d, 0x2d, 0xfd, 0xc8, 0x09, 0x89, 0x6a, 0x33, 0x88, 0x92, 0x02, 0xa8, 0xcb,
-	0x96, 0x0a, 0x31, 0x5d, 0x58, 0xb5, 0xb9, 0xe6, 0x72, 0x18, 0x7d, 0xd7, 0xd1, 0x8e, 0x60, 0xf9,
-	0x6b, 0x42, 0x6d, 0xc5, 0x94, 0x96, 0x4c, 0xcf, 0xce, 0xcd, 0xf4, 0x5c, 0x46, 0x48, 0x20, 0x5a,
-	0x52, 0xb1, 0x77, 0x1d, 0xcd, 0x86, 0x15, 0x9f, 0x8d, 0x99, 0xe6, 0x25, 0x53, 0xfd, 0xdc, 0x4c,
-	0x87, 0x61, 0x8c, 0x04, 0x2e, 0x88, 0xe2, 0xef, 0x3a, 0x5a, 0x1b, 0xd6, 0x82, 0xae, 0xb1, 0xd0,
-	0x56, 0xe5, 0xa0, 0xfb, 0x32, 0x86, 0xac, 0xed, 0x95, 0x27, 0x5b, 0x6f, 0xa3, 0x9d, 0x55, 0x86,
-	0xb5, 0xb9, 0xe6, 0x55, 0x43, 0x98, 0x3b, 0x68, 0x4f, 0x5c, 0xe6, 0x3a, 0xe1, 0x68, 0x9b, 0xa1,
-	0xb5, 0x56, 0x83, 0xb4, 0xd3, 0x95, 0x5f, 0xf0, 0x62, 0xe5, 0xd3, 0x3f, 0xfe, 0xbc, 0xb5, 0xd5,
-	0xa6, 0xa2, 0xe3, 0x1b, 0x45, 0x93, 0xf7, 0x4a, 0x61, 0x12, 0x36, 0x31, 0xbc, 0x87, 0x94, 0x47,
-	0xc7, 0x92, 0x18, 0x3a, 0xe8, 0x15, 0x2b, 0xf5, 0xc6, 0xd3, 0xad, 0x47, 0x0d, 0xdf, 0xf8, 0x1c,
-	0x87, 0xcd, 0xb4, 0xd3, 0xcd, 0xb5, 0xc3, 0x39, 0x91, 0xac, 0xf5, 0x7b, 0x24, 0xa2, 0x61, 0x3f,
-	0xce, 0x92, 0xfa, 0xfd, 0x51, 0x55, 0x32, 0x90, 0xc6, 0x7e, 0x01, 0xe1, 0x76, 0xe2, 0x04, 0x54,
-	0x7d, 0xb9, 0xdd, 0x21, 0xac, 0x8d, 0xda, 0x0d, 0x58, 0x54, 0x73, 0x30, 0x3e, 0x03, 0x17, 0xe4,
-	0x0c, 0xd4, 0x0a, 0xd3, 0xad, 0x3f, 0x1e, 0x92, 0xa3, 0xae, 0xfe, 0x39, 0x03, 0xeb, 0xa7, 0xbf,
-	0x70, 0xb4, 0x83, 0x1e, 0xce, 0x18, 0x32, 0x51, 0x9c, 0xa9, 0x59, 0xf3, 0x19, 0x64, 0x23, 0x38,
-	0xf7, 0x85, 0xe3, 0x8b, 0x60, 0x42, 0x7b, 0xa6, 0x4b, 0x1d, 0x11, 0xe7, 0xbf, 0x16, 0xc2, 0x5e,
-	0x48, 0x54, 0xa3, 0xdb, 0x92, 0x18, 0xed, 0x13, 0x58, 0x9b, 0xf2, 0xa7, 0xcc, 0xc2, 0x41, 0x7c,
-	0x79, 0x69, 0x31, 0xdf, 0x7a, 0x00, 0xd0, 0x3e, 0x86, 0xcb, 0x0e, 0x71, 0x49, 0xcf, 0xd3, 0xfb,
-	0xe8, 0xca, 0x71, 0x9f, 0x89, 0xa5, 0xa9, 0x8c, 0x2f, 0x95, 0x4d, 0x7b, 0x06, 0x37, 0x8f, 0x42,
-	0x55, 0x83, 0x65, 0x2d, 0x65, 0xd5, 0x95, 0x8e, 0x9e, 0x5c, 0x28, 0x0b, 0x1b, 0xf3, 0x63, 0xe7,
-	0xf5, 0xa3, 0xa9, 0x2f, 0x50, 0x09, 0xc4, 0xf5, 0x82, 0x0d, 0xf3, 0x08, 0xae, 0x06, 0xc9, 0x8c,
-	0xbc, 0xa5, 0xf3, 0xe2, 0x24, 0xf3, 0x65, 0x65, 0xaf, 0x44, 0x3b, 0x69, 0x13, 0x2e, 0x8e, 0x04,
-	0xa5, 0x3d, 0x54, 0x63, 0x3f, 0x02, 0xaf, 0x44, 0x6a, 0xd2, 0x1e, 0x06, 0x4f, 0x8a, 0x90, 0xa4,
-	0xc7, 0x7d, 0x26, 0xd4, 0x8e, 0x9c, 0x56, 0xbe, 0x2c, 0x6d, 0x01, 0xda, 0x67, 0x06, 0x67, 0xd6,
-	0x28, 0xf2, 0x72, 0x0c, 0x3d, 0x32, 0xca, 0xd8, 0x9b, 0x70, 0x71, 0x02, 0x3d, 0xc8, 0x42, 0x2c,
-	0x8b, 0x31, 0x76, 0x10, 0x2f, 0xa1, 0x95, 0xe4, 0x12, 0xfa, 0x2d, 0x05, 0x79, 0x59, 0x42, 0xdb,
-	0xbc, 0x8f, 0x8c, 0x30, 0xd1, 0xa2, 0x6d, 0x46, 0x84, 0xef, 0x62, 0x13, 0x4d, 0xa4, 0xfd, 0xf3,
-	0xd7, 0xd1, 0x16, 0xfc, 0xcf, 0x0c, 0x63, 0x4d, 0x2a, 0x1b, 0x2b, 0xa1, 0xd5, 0x08, 0x31, 0xd2,
-	0x76, 0x1f, 0x36, 0x46, 0x5e, 0xe3, 0xe7, 0x79, 0x51, 0x32, 0x32, 0x44, 0xac, 0x92, 0x6e, 0x46,
-	0xf0, 0xc3, 0x08, 0x3d, 0xca, 0xbc, 0x86, 0x83, 0x02, 0x87, 0x5c, 0xec, 0x59, 0x5f, 0xf8, 0xdc,
-	0xf5, 0x7b, 0x4d, 0x24, 0x66, 0xe7, 0xfc, 0x4f, 0x3a, 0x4b, 0x2f, 0xfe, 0x9a, 0x82, 0xcd, 0xd3,
-	0xbd, 0x58, 0x67, 0xa6, 0xed, 0x07, 0x75, 0xdb, 0x70, 0x39, 0x3f, 0xfa, 0xaf, 0x92, 0xaa, 0xc2,
-	0x73, 0x85, 0xde, 0x41, 0xda, 0xee, 0x4c, 0xb5, 0xe3, 0x8a, 0x34, 0xd5, 0xa4, 0x45, 0xbb, 0x0b,
-	0x80, 0xcc, 0x8a, 0x70, 0x31, 0xc1, 0x96, 0x91, 0x59, 0x21, 0x2a, 0xf6, 0x9e, 0x4c, 0xf2, 0x7b,
-	0xbe, 0x8f, 0x0a, 0x43, 0xbd, 0x47, 0x3d, 0x47, 0x69, 0x8d, 0x56, 0x95, 0xb8, 0xf6, 0xf0, 0xc3,
-	0xbd, 0x22, 0x96, 0xdf, 0x7c, 0x72, 0x7e, 0x2c, 0x69, 0xf4, 0x55, 0x07, 0x0e, 0x75, 0x3f, 0xcc,
-	0xf7, 0xfd, 0x36, 0x1d, 0x56, 0xd4, 0x21, 0xc3, 0x81, 0x83, 0xa6, 0x40, 0xeb, 0x70, 0xa2, 0xd7,
-	0xce, 0xdf, 0x24, 0x9e, 0x13, 0x7c, 0x29, 0x39, 0x62, 0x46, 0x2e, 0xf1, 0x26, 0x91, 0x88, 0x56,
-	0x00, 0x08, 0xbd, 0xca, 0x90, 0x9b, 0xf6, 0x42, 0x12, 0xcc, 0x3f, 0xe9, 0x1c, 0x13, 0xea, 0x7a,
-	0xcc, 0x59, 0xa2, 0x66, 0x84, 0x30, 0x6c, 0x6e, 0x76, 0xc3, 0x59, 0x1d, 0xd4, 0xc2, 0xa5, 0xc4,
-	0x10, 0x95, 0x00, 0x25, 0xe7, 0xf5, 0x83, 0x1f, 0x53, 0x70, 0x3d, 0x79, 0xb1, 0x69, 0xf7, 0xe0,
-	0xf6, 0x6e, 0x7d, 0xbf, 0xbc, 0x57, 0x3f, 0xf8, 0x52, 0x6f, 0x34, 0x5f, 0xbc, 0xac, 0xef, 0x54,
-	0x9b, 0x7a, 0xeb, 0xa0, 0x7c, 0x70, 0xd8, 0xd2, 0xeb, 0xfb, 0xe5, 0xed, 0x83, 0xfa, 0xcb, 0xea,
-	0xea, 0x9c, 0x76, 0x07, 0x6e, 0xcd, 0x84, 0x85, 0xa0, 0xd4, 0x5b, 0x41, 0xcf, 0xcb, 0xf5, 0xbd,
-	0xea, 0xce, 0x6a, 0x5a, 0xbb, 0x0b, 0x1b, 0x33, 0x41, 0xad, 0xbd, 0x72, 0xab, 0x56, 0xdd, 0x59,
-	0x9d, 0xaf, 0xec, 0xff, 0xf2, 0x26, 0x9f, 0x7a, 0xfd, 0x26, 0x9f, 0xfa, 0xeb, 0x4d, 0x3e, 0xf5,
-	0xea, 0x24, 0x3f, 0xf7, 0xfa, 0x24, 0x3f, 0xf7, 0xfb, 0x49, 0x7e, 0xee, 0xab, 0x33, 0x2c, 0xfa,
-	0xc1, 0xe4, 0x4f, 0x47, 0xb9, 0xf5, 0x8d, 0x45, 0xf9, 0x2b, 0xf0, 0xe9, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x85, 0xad, 0xdc, 0x00, 0xd4, 0x0e, 0x00, 0x00,
+	// 1482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0xb7, 0x64, 0xd9, 0x96, 0xc7, 0xf9, 0xe3, 0x30, 0x4e, 0x20, 0x3b, 0xf1, 0x9f, 0x28, 0x89,
+	0xe1, 0x17, 0x3c, 0x4b, 0xf9, 0x63, 0xe0, 0xbd, 0xd3, 0x03, 0x24, 0x4b, 0x8e, 0x94, 0xe7, 0x3a,
+	0xaa, 0x64, 0x07, 0x68, 0x0f, 0x25, 0x56, 0xe4, 0x58, 0xda, 0x88, 0x5a, 0x12, 0xe4, 0x52, 0x96,
+	0x3e, 0x41, 0x7b, 0xcc, 0xb9, 0x40, 0xef, 0xbd, 0xa5, 0x87, 0x7e, 0x80, 0xf6, 0xd6, 0x4b, 0x81,
+	0xa0, 0x45, 0x81, 0x20, 0x87, 0xa0, 0x48, 0x0e, 0xfd, 0x16, 0x45, 0xc1, 0x5d, 0x52, 0x12, 0x65,
+	0xca, 0xb1, 0x5b, 0xe7, 0x62, 0x98, 0x3b, 0xbf, 0x99, 0xdf, 0xce, 0xec, 0x6f, 0x67, 0x48, 0x41,
+	0xba, 0x4e, 0xea, 0x3d, 0xc3, 0x64, 0xd9, 0x3a, 0xd7, 0x1c, 0x4e, 0x5a, 0x94, 0x35, 0xb2, 0x9d,
+	0x07, 0x59, 0xec, 0x20, 0xe3, 0x4e, 0xc6, 0xb2, 0x4d, 0x6e, 0x2a, 0xd7, 0x7c, 0x4c, 0x66, 0x80,
+	0xc9, 0x74, 0x1e, 0x2c, 0x2d, 0x34, 0xcc, 0x86, 0x29, 0x10, 0x59, 0xef, 0x3f, 0x09, 0x5e, 0xba,
+	0xa3, 0x99, 0x4e, 0xdb, 0x74, 0xb2, 0x83, 0x60, 0x75, 0xe4, 0xe4, 0x41, 0xf0, 0xec, 0xa3, 0xd6,
+	0xa3, 0x69, 0x87, 0x08, 0x24, 0x6e, 0x51, 0x46, 0x53, 0x25, 0x8d, 0x7c, 0xf0, 0x4d, 0x57, 0x48,
+	0x9b, 0x32, 0x33, 0x2b, 0xfe, 0xca, 0xa5, 0xf4, 0xd7, 0x71, 0xb8, 0x59, 0xf4, 0x76, 0xbe, 0x43,
+	0x19, 0x31, 0x28, 0xef, 0x55, 0x6c, 0xb3, 0x43, 0x75, 0xb4, 0xb7, 0x6d, 0x24, 0x1c, 0x75, 0xe5,
+	0x36, 0x40, 0x9d, 0x6b, 0xaa, 0xd5, 0x52, 0x9b, 0xd8, 0x4d, 0xc5, 0xd6, 0x62, 0x1b, 0xb3, 0xf9,
+	0xa9, 0x6f, 0xff, 0xf8, 0xee, 0x5e, 0xac, 0x9a, 0xac, 0x73, 0xad, 0xd2, 0x2a, 0x61, 0x57, 0x59,
+	0x84, 0x04, 0xd1, 0x75, 0x3b, 0x15, 0x1f, 0x36, 0x8b, 0x25, 0xe5, 0x2e, 0x80, 0x66, 0xb6, 0xdb,
+	0xd4, 0x71, 0xa8, 0xc9, 0x52, 0x93, 0xc3, 0x80, 0x21, 0x83, 0x92, 0x82, 0x99, 0xb6, 0xc9, 0x68,
+	0x0b, 0xed, 0x54, 0xc2, 0xc3, 0x54, 0x83, 0x47, 0x65, 0x09, 0x92, 0x54, 0x47, 0xc6, 0x29, 0xef,
+	0xa5, 0xa6, 0x84, 0xa9, 0xff, 0xec, 0x79, 0x1d, 0x61, 0xdd, 0xa1, 0x1c, 0x53, 0xd3, 0xd2, 0xcb,
+	0x7f, 0x54, 0xfe, 0x05, 0xf3, 0x0e, 0x6a, 0xae, 0x4d, 0x79, 0x4f, 0xd5, 0x4c, 0xc6, 0x89, 0xc6,
+	0x53, 0x33, 0x02, 0x72, 0x39, 0x58, 0xdf, 0x96, 0xcb, 0x5e, 0x10, 0x1d, 0x39, 0xa1, 0x86, 0x93,
+	0x4a, 0xca, 0x20, 0xfe, 0x63, 0xfa, 0xcf, 0x18, 0xdc, 0x88, 0x2c, 0x4e, 0x51, 0xa7, 0xa7, 0xae,
+	0x4d, 0xb8, 0x00, 0xf1, 0x53, 0x14, 0x60, 0x72, 0x7c, 0x01, 0x12, 0xe3, 0x0b, 0x30, 0xf5, 0xe1,
+	0x02, 0x4c, 0x7f, 0xb0, 0x00, 0x33, 0xe1, 0x02, 0x7c, 0x31, 0x46, 0x1c, 0x05, 0x34, 0xf0, 0x1c,
+	0xc4, 0x91, 0x7e, 0x11, 0x83, 0x65, 0x41, 0x90, 0xdf, 0xdf, 0xf6, 0x62, 0x36, 0x08, 0xa7, 0x26,
+	0xab, 0x71, 0xc2, 0xf1, 0xc0, 0xd2, 0x09, 0x47, 0x65, 0x1d, 0x2e, 0xfb, 0xf2, 0x56, 0x79, 0x57,
+	0x6d, 0x12, 0xa7, 0x29, 0x69, 0xaa, 0x17, 0xfd, 0xe5, 0xfd, 0x6e, 0x89, 0x38, 0x4d, 0xe5, 0x31,
+	0xcc, 0x32, 0x3c, 0x52, 0x1d, 0xcf, 0x55, 0x30, 0x5d, 0x7a, 0x78, 0x2f, 0x13, 0x79, 0x09, 0x33,
+	0xc7, 0xb8, 0x5c, 0xa7, 0x9a, 0x64, 0x78, 0x24, 0x68, 0xd3, 0x87, 0x70, 0x5d, 0xec, 0xa8, 0x86,
+	0x06, 0x6a, 0x9c, 0x76, 0xb0, 0x66, 0x10, 0xa7, 0x49, 0x59, 0x43, 0xd9, 0x85, 0x24, 0x7a, 0xd9,
+	0x33, 0x0d, 0xc5, 0x1e, 0xe6, 0x1e, 0xde, 0x1f, 0xc3, 0x70, 0xcc, 0xb7, 0xe8, 0xfb, 0x55, 0xfb,
+	0x11, 0xd2, 0xbf, 0x26, 0x61, 0x41, 0x10, 0x55, 0xcc, 0x23, 0xb4, 0x0b, 0xd4, 0xe1, 0x7e, 0xc6,
+	0x14, 0xc0, 0xf1, 0xdc, 0x50, 0x57, 0x0f, 0x2d, 0x9f, 0xa8, 0x34, 0x86, 0x28, 0x2a, 0x80, 0x5c,
+	0xac, 0xc9, 0x10, 0xa3, 0x07, 0x57, 0x9a, 0xa8, 0xce, 0xfa, 0xd1, 0x77, 0x2c, 0xe5, 0x10, 0x66,
+	0x9f, 0x13, 0x6a, 0x48, 0xa6, 0xb8, 0x60, 0x7a, 0x7c, 0x66, 0xa6, 0x27, 0x22, 0x42, 0x04, 0x51,
+	0x52, 0xc6, 0xde, 0xb1, 0x14, 0x03, 0xe6, 0x5c, 0x36, 0x60, 0x9a, 0x14, 0x4c, 0xe5, 0x33, 0x33,
+	0x1d, 0xb0, 0xe7, 0xe3, 0xb8, 0x20, 0x88, 0xbf, 0x63, 0x29, 0x0d, 0x58, 0xf0, 0x44, 0xa9, 0xa3,
+	0x21, 0xe5, 0xa0, 0xba, 0x22, 0x86, 0xb8, 0x3b, 0x73, 0x0f, 0xb7, 0x4e, 0xa2, 0x1d, 0x27, 0xc3,
+	0xd2, 0x44, 0xf5, 0x4a, 0x9d, 0x6b, 0x05, 0x34, 0x86, 0xb5, 0xf9, 0x55, 0x0c, 0xae, 0x0d, 0x6e,
+	0xb0, 0x4f, 0x23, 0x32, 0x9c, 0x12, 0x54, 0xd5, 0x33, 0x67, 0xb8, 0xdd, 0x8f, 0x26, 0x57, 0xa3,
+	0x52, 0xbd, 0xaa, 0x1d, 0x03, 0x59, 0x4b, 0x4d, 0xb8, 0x79, 0xd2, 0xb1, 0x2b, 0x25, 0x88, 0x5b,
+	0x2d, 0x21, 0xa6, 0x0b, 0xf9, 0xff, 0xbe, 0x79, 0xbb, 0xba, 0xd5, 0xa0, 0xbc, 0xe9, 0xd6, 0x33,
+	0x9a, 0xd9, 0xce, 0xfa, 0x9b, 0x34, 0x48, 0xdd, 0xd9, 0xa4, 0x66, 0xf0, 0x98, 0xe5, 0x3d, 0x0b,
+	0x9d, 0x4c, 0xbe, 0x5c, 0x79, 0xb4, 0x75, 0xbf, 0xe2, 0xd6, 0xff, 0x8f, 0xbd, 0x6a, 0xdc, 0x6a,
+	0x2d, 0x35, 0xe0, 0xc6, 0x09, 0xc7, 0x7e, 0x8e, 0x44, 0x14, 0x96, 0x4f, 0x3c, 0xf5, 0x73, 0xa4,
+	0xfa, 0x31, 0x06, 0xeb, 0xa7, 0xab, 0xff, 0xf9, 0x91, 0x2a, 0x9f, 0x44, 0xcc, 0x85, 0xcd, 0x37,
+	0x6f, 0x57, 0x6f, 0xc8, 0x91, 0xed, 0xe8, 0xad, 0x0c, 0x35, 0xb3, 0x6d, 0xc2, 0x9b, 0x99, 0x5d,
+	0x6c, 0x10, 0xad, 0x57, 0x40, 0xed, 0x97, 0xef, 0x37, 0x41, 0x9a, 0x33, 0x05, 0xd4, 0x86, 0xe7,
+	0x47, 0x3e, 0x01, 0x71, 0xec, 0xa4, 0x5f, 0x06, 0x0d, 0x75, 0x44, 0x5e, 0x35, 0xad, 0x89, 0xba,
+	0x6b, 0xa0, 0xae, 0x2c, 0xcb, 0x96, 0xdd, 0x44, 0xda, 0x68, 0x72, 0x91, 0xc8, 0xc5, 0xea, 0x6c,
+	0x9d, 0x6b, 0x25, 0xb1, 0x10, 0xd5, 0x6f, 0xe3, 0x1f, 0xec, 0xb7, 0x93, 0xff, 0xa0, 0xdf, 0x22,
+	0xdc, 0x8a, 0x1c, 0x31, 0x12, 0xb8, 0xdd, 0x24, 0xac, 0x81, 0xca, 0x4d, 0x98, 0x96, 0x73, 0x26,
+	0x3c, 0x63, 0xa6, 0xc4, 0x8c, 0x51, 0xd2, 0xa3, 0xbd, 0x7f, 0x30, 0x84, 0xfa, 0x34, 0x3f, 0x24,
+	0x60, 0xf1, 0xf8, 0x15, 0x0f, 0x5e, 0x72, 0x36, 0xc7, 0x4c, 0x99, 0x20, 0xce, 0x48, 0xf2, 0xff,
+	0x83, 0x54, 0x00, 0x37, 0x5d, 0x6e, 0xb9, 0xdc, 0x9b, 0x80, 0x8e, 0x66, 0x53, 0x8b, 0x87, 0xf9,
+	0xaf, 0xf9, 0xb0, 0xa7, 0x02, 0x55, 0x69, 0xd5, 0x04, 0x46, 0xf9, 0x0f, 0x2c, 0x8c, 0xf8, 0x53,
+	0xa6, 0x63, 0x37, 0xfc, 0x76, 0xa4, 0x84, 0x7c, 0xcb, 0x1e, 0x40, 0xf9, 0x37, 0x5c, 0xb2, 0x88,
+	0x4d, 0xda, 0x8e, 0xda, 0x41, 0x5b, 0xe8, 0x26, 0x11, 0xda, 0xa6, 0x34, 0x3e, 0x93, 0x36, 0xe5,
+	0x31, 0x2c, 0x1f, 0xfa, 0x55, 0x55, 0x2d, 0xbf, 0xac, 0xaa, 0xac, 0xa3, 0x23, 0x06, 0xf6, 0xd4,
+	0xda, 0xe4, 0xc0, 0x79, 0xf1, 0x70, 0xe4, 0x04, 0xf2, 0x5e, 0x71, 0x1d, 0x6f, 0x82, 0xdf, 0x87,
+	0x2b, 0xde, 0x66, 0xfa, 0xde, 0xc2, 0x79, 0x7a, 0x98, 0xf9, 0x92, 0xb4, 0xe7, 0x83, 0x99, 0xbf,
+	0x01, 0x17, 0xfa, 0x05, 0xa5, 0x6d, 0x4c, 0xcd, 0x0c, 0x83, 0xe7, 0x82, 0x6a, 0xd2, 0x36, 0x7a,
+	0x29, 0x05, 0x48, 0xd2, 0x36, 0x5d, 0xc6, 0x53, 0xc9, 0x61, 0x6c, 0x50, 0xf9, 0x9c, 0xb0, 0x79,
+	0x68, 0x97, 0xd5, 0x4d, 0xa6, 0xf7, 0x23, 0xcf, 0x86, 0xd0, 0x7d, 0xa3, 0x88, 0xbd, 0x01, 0x17,
+	0x86, 0xd0, 0xdd, 0x14, 0x84, 0x76, 0x31, 0xc0, 0x76, 0xc3, 0x12, 0x9a, 0x8b, 0x96, 0xd0, 0x6f,
+	0x31, 0x58, 0xf1, 0xbb, 0x44, 0x07, 0x19, 0x61, 0xbc, 0x46, 0x1b, 0x8c, 0x70, 0xd7, 0xc6, 0x2a,
+	0x6a, 0x48, 0x3b, 0x67, 0xd7, 0xd1, 0x16, 0x5c, 0xd5, 0xfc, 0x58, 0xc3, 0x95, 0x0d, 0x49, 0x68,
+	0x3e, 0x40, 0xf4, 0x6b, 0xbb, 0x07, 0x6b, 0x7d, 0xaf, 0x41, 0x7a, 0x4e, 0xb0, 0x19, 0xb5, 0x39,
+	0xaa, 0xa4, 0xe5, 0x00, 0x7e, 0x10, 0xa0, 0xfb, 0x3b, 0x2f, 0x61, 0x37, 0x6d, 0xc2, 0x52, 0x28,
+	0xad, 0x4f, 0x5d, 0xd3, 0x76, 0xdb, 0x55, 0x24, 0x5a, 0xf3, 0xec, 0x29, 0x9d, 0xe6, 0x2e, 0xfe,
+	0x1c, 0x83, 0x8d, 0xe3, 0x77, 0xb1, 0xcc, 0x34, 0xc3, 0xf5, 0x74, 0x5b, 0xb1, 0x4d, 0xf3, 0xf0,
+	0xef, 0x96, 0x54, 0x0a, 0xcf, 0xe6, 0x41, 0x83, 0x8b, 0x8f, 0x0a, 0xcf, 0xe6, 0x7e, 0xa7, 0xbb,
+	0x03, 0x80, 0x4c, 0x0f, 0x70, 0xa1, 0x82, 0xcd, 0x22, 0xd3, 0x7d, 0x54, 0x28, 0x9f, 0x44, 0x74,
+	0x3e, 0xdf, 0x04, 0xc2, 0x90, 0xf9, 0xc8, 0x74, 0x64, 0xad, 0x51, 0x2f, 0x12, 0xdb, 0xe8, 0x7d,
+	0xbc, 0x2c, 0xd2, 0xa3, 0x7d, 0x38, 0x62, 0x7f, 0x2c, 0xaa, 0xf5, 0x15, 0xbb, 0x16, 0xb5, 0x3f,
+	0xce, 0xf9, 0x7e, 0x19, 0xf7, 0x15, 0x75, 0xc0, 0xb0, 0x6b, 0xa1, 0xc6, 0x51, 0x3f, 0x18, 0xba,
+	0x6b, 0x67, 0xbf, 0x24, 0x8e, 0xe5, 0x9d, 0x94, 0xb7, 0x8c, 0xe1, 0xa9, 0xd4, 0xbf, 0x24, 0x02,
+	0x51, 0xf3, 0x00, 0xbe, 0x57, 0x0e, 0x96, 0x46, 0xbd, 0x90, 0x78, 0xfd, 0x4f, 0x38, 0x87, 0x0a,
+	0x75, 0x3d, 0xe4, 0x2c, 0x50, 0x63, 0x42, 0xd4, 0x0d, 0x53, 0x6b, 0xf9, 0xbd, 0xda, 0xd3, 0xc2,
+	0xc5, 0xc8, 0x10, 0x79, 0x0f, 0x25, 0xfa, 0x75, 0xfa, 0x75, 0x0c, 0x22, 0x5e, 0x2c, 0xc3, 0x17,
+	0xad, 0x80, 0x44, 0x37, 0x28, 0xc3, 0x0a, 0x71, 0x9c, 0xb3, 0x9f, 0xc7, 0x3a, 0xcc, 0xf9, 0xad,
+	0xf9, 0xf8, 0x37, 0x16, 0x48, 0x4b, 0xce, 0xfb, 0x0c, 0xcf, 0xc0, 0x65, 0xdd, 0x27, 0x1a, 0x96,
+	0x7c, 0xa2, 0xdf, 0xc0, 0x03, 0xab, 0xaf, 0xab, 0x55, 0xef, 0x9b, 0x50, 0x7c, 0xe4, 0x89, 0x4c,
+	0x93, 0x01, 0x2e, 0x58, 0xbd, 0xf7, 0x32, 0x06, 0xd7, 0xa3, 0x67, 0xb6, 0x72, 0x17, 0x6e, 0xed,
+	0x94, 0xf7, 0x72, 0xbb, 0xe5, 0xfd, 0xcf, 0xd4, 0x4a, 0xf5, 0xe9, 0xb3, 0x72, 0xa1, 0x58, 0x55,
+	0x6b, 0xfb, 0xb9, 0xfd, 0x83, 0x9a, 0x5a, 0xde, 0xcb, 0x6d, 0xef, 0x97, 0x9f, 0x15, 0xe7, 0x27,
+	0x94, 0xdb, 0xb0, 0x3a, 0x16, 0xe6, 0x83, 0x62, 0x27, 0x82, 0x9e, 0xe4, 0xca, 0xbb, 0xc5, 0xc2,
+	0x7c, 0x5c, 0xb9, 0x03, 0x6b, 0x63, 0x41, 0xb5, 0xdd, 0x5c, 0xad, 0x54, 0x2c, 0xcc, 0x4f, 0xe6,
+	0xf7, 0x7e, 0x7a, 0xb7, 0x12, 0x7b, 0xf5, 0x6e, 0x25, 0xf6, 0xfb, 0xbb, 0x95, 0xd8, 0x8b, 0xf7,
+	0x2b, 0x13, 0xaf, 0xde, 0xaf, 0x4c, 0xbc, 0x7e, 0xbf, 0x32, 0xf1, 0xf9, 0x29, 0x5e, 0xe2, 0xba,
+	0xc3, 0x3f, 0xbb, 0x88, 0x37, 0xba, 0xfa, 0xb4, 0xf8, 0x05, 0xe5, 0xd1, 0x5f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xe7, 0x67, 0xc8, 0x66, 0x10, 0x12, 0x00, 0x00,
 }
 
 func (m *EventFinalityProviderCreated) Marshal() (dAtA []byte, err error) {
@@ -1502,6 +1776,43 @@ func (m *EventFinalityProviderEdited) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFinalityProviderDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1688,6 +1999,27 @@ func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byt
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventPowerDistUpdate_CommissionUpdatedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_CommissionUpdatedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommissionUpdatedFp != nil {
+		{
+			size, err := m.CommissionUpdatedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1793,7 +2125,7 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalToSizedBuffe
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFinalityProviderStatusChange) Marshal() (dAtA []byte, err error) {
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1803,34 +2135,44 @@ func (m *EventFinalityProviderStatusChange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventFinalityProviderStatusChange) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFinalityProviderStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewState) > 0 {
-		i -= len(m.NewState)
-		copy(dAtA[i:], m.NewState)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BtcPk) > 0 {
-		i -= len(m.BtcPk)
-		copy(dAtA[i:], m.BtcPk)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcPk)))
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBTCDelegationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventPowerDistUpdateScheduled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1840,20 +2182,97 @@ func (m *EventBTCDelegationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBTCDelegationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPowerDistUpdateScheduled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBTCDelegationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPowerDistUpdateScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewState) > 0 {
-		i -= len(m.NewState)
-		copy(dAtA[i:], m.NewState)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
+	if m.NewState != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BtcHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalityProviderStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewState) > 0 {
+		i -= len(m.NewState)
+		copy(dAtA[i:], m.NewState)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcPk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelegationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewState) > 0 {
+		i -= len(m.NewState)
+		copy(dAtA[i:], m.NewState)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -2194,6 +2613,58 @@ func (m *EventUnexpectedUnbondingTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -2283,6 +2754,23 @@ func (m *EventFinalityProviderEdited) Size() (n int) {
 	return n
 }
 
+func (m *EventFinalityProviderDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBTCDelegationStateUpdate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2372,6 +2860,18 @@ func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
 	}
 	return n
 }
+func (m *EventPowerDistUpdate_CommissionUpdatedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommissionUpdatedFp != nil {
+		l = m.CommissionUpdatedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2411,6 +2911,42 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdateScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BtcHeight))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewState != 0 {
+		n += 1 + sovEvents(uint64(m.NewState))
+	}
+	return n
+}
+
 func (m *EventFinalityProviderStatusChange) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2608,6 +3144,29 @@ func (m *EventUnexpectedUnbondingTx) Size() (n int) {
 	return n
 }
 
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovEvents(uint64(m.DeadlineHeight))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3194,6 +3753,120 @@ func (m *EventFinalityProviderEdited) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFinalityProviderDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3517,9 +4190,214 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBTCDelegationStateUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdatedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_CommissionUpdatedFp{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3529,26 +4407,26 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBTCDelegationStateUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3571,7 +4449,7 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3594,10 +4472,10 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3656,7 +4534,7 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3679,10 +4557,10 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCommissionUpdatedFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCommissionUpdatedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3720,6 +4598,42 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3741,7 +4655,7 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdateScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3764,17 +4678,36 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPowerDistUpdateScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPowerDistUpdateScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3784,27 +4717,43 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []by
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			m.NewState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5205,6 +6154,159 @@ func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBTCDelegationCovenantQuorumDeadlinePassed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationCovenantQuorumDeadlinePassed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationCovenantQuorumDeadlinePassed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func TestParseBTCDelegationStateUpdate(t *testing.T) {
+	stakingTxHash := "0a4f1c2bf0ba1b3b47d5c47ae0e8fca37e2d4f4f8b2d4b2f6a3e5e1b9c7d6e5f"
+
+	for _, tc := range []struct {
+		desc          string
+		event         proto.Message
+		isStateUpdate bool
+		expectedState types.BTCDelegationStatus
+	}{
+		{
+			desc:          "BTC delegation created",
+			event:         &types.EventBTCDelegationCreated{StakingTxHash: stakingTxHash, NewState: types.BTCDelegationStatus_PENDING.String()},
+			isStateUpdate: true,
+			expectedState: types.BTCDelegationStatus_PENDING,
+		},
+		{
+			desc:          "covenant quorum reached",
+			event:         &types.EventCovenantQuorumReached{StakingTxHash: stakingTxHash, NewState: types.BTCDelegationStatus_VERIFIED.String()},
+			isStateUpdate: true,
+			expectedState: types.BTCDelegationStatus_VERIFIED,
+		},
+		{
+			desc:          "BTC delegation expired",
+			event:         types.NewExpiredDelegationEvent(stakingTxHash),
+			isStateUpdate: true,
+			expectedState: types.BTCDelegationStatus_UNBONDED,
+		},
+		{
+			desc:          "unrelated event",
+			event:         &types.EventCovenantSignatureReceived{StakingTxHash: stakingTxHash},
+			isStateUpdate: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			event, err := sdk.TypedEventToEvent(tc.event)
+			require.NoError(t, err)
+
+			update, ok, err := types.ParseBTCDelegationStateUpdate(abci.Event(event))
+			require.NoError(t, err)
+			require.Equal(t, tc.isStateUpdate, ok)
+			if tc.isStateUpdate {
+				require.Equal(t, stakingTxHash, update.StakingTxHash)
+				require.Equal(t, tc.expectedState, update.NewState)
+			}
+		})
+	}
+
+	// event with an invalid state
+	event, err := sdk.TypedEventToEvent(&types.EventBTCDelegationExpired{StakingTxHash: stakingTxHash, NewState: "invalid"})
+	require.NoError(t, err)
+	_, _, err = types.ParseBTCDelegationStateUpdate(abci.Event(event))
+	require.Error(t, err)
+}
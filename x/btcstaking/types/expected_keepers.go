@@ -6,6 +6,7 @@ import (
 	bbn "github.com/babylonlabs-io/babylon/types"
 	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	itypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,10 +20,20 @@ type BtcCheckpointKeeper interface {
 	GetParams(ctx context.Context) (p btcctypes.Params)
 }
 
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
 type FinalityKeeper interface {
 	HasTimestampedPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) bool
 }
 
+type BtcStakingHooks interface {
+	AfterFinalityProviderCanceled(ctx context.Context, fpBtcPk *bbn.BIP340PubKey) error // Must be called after a finality provider is canceled
+}
+
 type IncentiveKeeper interface {
 	IndexRefundableMsg(ctx context.Context, msg sdk.Msg)
+	GetRewardGauge(ctx context.Context, sType itypes.StakeholderType, addr sdk.AccAddress) *itypes.RewardGauge
+	GetFpSelfDelRewards(ctx context.Context, fpBTCPK []byte) (sdk.Coins, error)
 }
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default genesis state
@@ -28,6 +31,102 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	// a finality provider is slashed at most once, thus there is at most one
+	// selective slashing evidence per finality provider
+	slashedFps := make(map[string]struct{}, len(gs.SelectiveSlashingEvidences))
+	for _, evidence := range gs.SelectiveSlashingEvidences {
+		if err := evidence.Validate(); err != nil {
+			return err
+		}
+		fpBTCPKHex := evidence.FpBtcPk.MarshalHex()
+		if _, ok := slashedFps[fpBTCPKHex]; ok {
+			return fmt.Errorf("duplicated selective slashing evidence for finality provider %s", fpBTCPKHex)
+		}
+		slashedFps[fpBTCPKHex] = struct{}{}
+	}
+
+	refundables := make(map[string]struct{}, len(gs.RefundableBtcDelegations))
+	for _, refundable := range gs.RefundableBtcDelegations {
+		if err := refundable.Validate(); err != nil {
+			return err
+		}
+		key := refundable.StakerAddr + refundable.StakingTxHash
+		if _, ok := refundables[key]; ok {
+			return fmt.Errorf("duplicated refundable BTC delegation %s of staker %s", refundable.StakingTxHash, refundable.StakerAddr)
+		}
+		refundables[key] = struct{}{}
+	}
+
+	commissionRecords := make(map[string]struct{}, len(gs.FpCommissionHistory))
+	for _, record := range gs.FpCommissionHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", record.FpBtcPk.MarshalHex(), record.Height)
+		if _, ok := commissionRecords[key]; ok {
+			return fmt.Errorf("duplicated commission of finality provider %s at height %d", record.FpBtcPk.MarshalHex(), record.Height)
+		}
+		commissionRecords[key] = struct{}{}
+	}
+
+	editedFps := make(map[string]struct{}, len(gs.FpLastEdits))
+	for _, lastEdit := range gs.FpLastEdits {
+		if lastEdit.FpBtcPk == nil {
+			return fmt.Errorf("empty BTC public key of finality provider last edit")
+		}
+		if _, err := lastEdit.FpBtcPk.ToBTCPK(); err != nil {
+			return fmt.Errorf("invalid BTC public key of finality provider last edit: %w", err)
+		}
+		fpBTCPKHex := lastEdit.FpBtcPk.MarshalHex()
+		if _, ok := editedFps[fpBTCPKHex]; ok {
+			return fmt.Errorf("duplicated last edit of finality provider %s", fpBTCPKHex)
+		}
+		editedFps[fpBTCPKHex] = struct{}{}
+	}
+
+	for _, evt := range gs.Events {
+		if evt.Event == nil {
+			return fmt.Errorf("empty power distribution update event at index %d", evt.Idx)
+		}
+		// the commission of a commission update is used upon distributing
+		// rewards, thus it must be set
+		if commissionUpdate := evt.Event.GetCommissionUpdatedFp(); commissionUpdate != nil && commissionUpdate.Commission == nil {
+			return fmt.Errorf("empty commission of commission update event at index %d", evt.Idx)
+		}
+	}
+	return nil
+}
+
+// Validate performs stateless checks on the commission record of a finality
+// provider
+func (r *FinalityProviderCommissionRecord) Validate() error {
+	if r.FpBtcPk == nil {
+		return fmt.Errorf("empty BTC public key of finality provider commission")
+	}
+	if _, err := r.FpBtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid BTC public key of finality provider commission: %w", err)
+	}
+	if r.Commission.IsNil() || r.Commission.IsNegative() {
+		return fmt.Errorf("invalid commission of finality provider %s: %v", r.FpBtcPk.MarshalHex(), r.Commission)
+	}
+	if r.Commission.GT(sdkmath.LegacyOneDec()) {
+		return ErrCommissionGTMaxRate
+	}
+	return nil
+}
+
+// Validate performs stateless checks on the refundable BTC delegation
+func (r *RefundableBTCDelegation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.StakerAddr); err != nil {
+		return fmt.Errorf("invalid staker address of refundable BTC delegation: %w", err)
+	}
+	if len(r.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash of refundable BTC delegation is not %d", chainhash.MaxHashStringSize)
+	}
+	if _, err := chainhash.NewHashFromStr(r.StakingTxHash); err != nil {
+		return fmt.Errorf("invalid staking tx hash of refundable BTC delegation: %w", err)
+	}
 	return nil
 }
 
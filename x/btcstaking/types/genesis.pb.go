@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -38,6 +40,21 @@ type GenesisState struct {
 	BtcDelegators []*BTCDelegator `protobuf:"bytes,6,rep,name=btc_delegators,json=btcDelegators,proto3" json:"btc_delegators,omitempty"`
 	// all the events and its indexes.
 	Events []*EventIndex `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// first_params_version is the version of the first params in params.
+	// It is non-zero if earlier params versions have been pruned.
+	FirstParamsVersion uint32 `protobuf:"varint,8,opt,name=first_params_version,json=firstParamsVersion,proto3" json:"first_params_version,omitempty"`
+	// selective_slashing_evidences are all the submitted selective slashing
+	// evidences.
+	SelectiveSlashingEvidences []*SelectiveSlashingEvidence `protobuf:"bytes,9,rep,name=selective_slashing_evidences,json=selectiveSlashingEvidences,proto3" json:"selective_slashing_evidences,omitempty"`
+	// refundable_btc_delegations are the BTC delegations whose refunds are not
+	// claimed by their stakers yet.
+	RefundableBtcDelegations []*RefundableBTCDelegation `protobuf:"bytes,10,rep,name=refundable_btc_delegations,json=refundableBtcDelegations,proto3" json:"refundable_btc_delegations,omitempty"`
+	// fp_commission_history is the commission history of all finality
+	// providers.
+	FpCommissionHistory []*FinalityProviderCommissionRecord `protobuf:"bytes,11,rep,name=fp_commission_history,json=fpCommissionHistory,proto3" json:"fp_commission_history,omitempty"`
+	// fp_last_edits are the heights at which finality providers were last
+	// edited.
+	FpLastEdits []*FinalityProviderLastEdit `protobuf:"bytes,12,rep,name=fp_last_edits,json=fpLastEdits,proto3" json:"fp_last_edits,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +132,198 @@ func (m *GenesisState) GetEvents() []*EventIndex {
 	return nil
 }
 
+func (m *GenesisState) GetFirstParamsVersion() uint32 {
+	if m != nil {
+		return m.FirstParamsVersion
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSelectiveSlashingEvidences() []*SelectiveSlashingEvidence {
+	if m != nil {
+		return m.SelectiveSlashingEvidences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRefundableBtcDelegations() []*RefundableBTCDelegation {
+	if m != nil {
+		return m.RefundableBtcDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFpCommissionHistory() []*FinalityProviderCommissionRecord {
+	if m != nil {
+		return m.FpCommissionHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFpLastEdits() []*FinalityProviderLastEdit {
+	if m != nil {
+		return m.FpLastEdits
+	}
+	return nil
+}
+
+// FinalityProviderLastEdit is the Babylon height at which a finality provider
+// was last edited.
+type FinalityProviderLastEdit struct {
+	// fp_btc_pk is the Bitcoin secp256k1 PK of the finality provider.
+	FpBtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// height is the Babylon height of the last edit.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FinalityProviderLastEdit) Reset()         { *m = FinalityProviderLastEdit{} }
+func (m *FinalityProviderLastEdit) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderLastEdit) ProtoMessage()    {}
+func (*FinalityProviderLastEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{1}
+}
+func (m *FinalityProviderLastEdit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderLastEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderLastEdit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderLastEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderLastEdit.Merge(m, src)
+}
+func (m *FinalityProviderLastEdit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderLastEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderLastEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderLastEdit proto.InternalMessageInfo
+
+func (m *FinalityProviderLastEdit) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// FinalityProviderCommissionRecord is the commission rate of a finality
+// provider set at a Babylon height.
+type FinalityProviderCommissionRecord struct {
+	// fp_btc_pk is the Bitcoin secp256k1 PK of the finality provider.
+	FpBtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// height is the Babylon height at which the commission rate was set.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// commission is the commission rate set at the height.
+	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
+}
+
+func (m *FinalityProviderCommissionRecord) Reset()         { *m = FinalityProviderCommissionRecord{} }
+func (m *FinalityProviderCommissionRecord) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderCommissionRecord) ProtoMessage()    {}
+func (*FinalityProviderCommissionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{2}
+}
+func (m *FinalityProviderCommissionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderCommissionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderCommissionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderCommissionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderCommissionRecord.Merge(m, src)
+}
+func (m *FinalityProviderCommissionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderCommissionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderCommissionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderCommissionRecord proto.InternalMessageInfo
+
+func (m *FinalityProviderCommissionRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RefundableBTCDelegation is a BTC delegation that did not receive a covenant
+// quorum before its deadline, and whose refund is not claimed by its staker
+// yet.
+type RefundableBTCDelegation struct {
+	// staker_addr is the address of the staker.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// staking_tx_hash is the hash of the staking tx in btc format.
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+}
+
+func (m *RefundableBTCDelegation) Reset()         { *m = RefundableBTCDelegation{} }
+func (m *RefundableBTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*RefundableBTCDelegation) ProtoMessage()    {}
+func (*RefundableBTCDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{3}
+}
+func (m *RefundableBTCDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundableBTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundableBTCDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundableBTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundableBTCDelegation.Merge(m, src)
+}
+func (m *RefundableBTCDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundableBTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundableBTCDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundableBTCDelegation proto.InternalMessageInfo
+
+func (m *RefundableBTCDelegation) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *RefundableBTCDelegation) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
 // BlockHeightBbnToBtc stores the btc <-> bbn block.
 type BlockHeightBbnToBtc struct {
 	// block_height_bbn is the height of the block in the babylon chain.
@@ -127,7 +336,7 @@ func (m *BlockHeightBbnToBtc) Reset()         { *m = BlockHeightBbnToBtc{} }
 func (m *BlockHeightBbnToBtc) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightBbnToBtc) ProtoMessage()    {}
 func (*BlockHeightBbnToBtc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{1}
+	return fileDescriptor_85d7b95fa5620238, []int{4}
 }
 func (m *BlockHeightBbnToBtc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +393,7 @@ func (m *BTCDelegator) Reset()         { *m = BTCDelegator{} }
 func (m *BTCDelegator) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegator) ProtoMessage()    {}
 func (*BTCDelegator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{2}
+	return fileDescriptor_85d7b95fa5620238, []int{5}
 }
 func (m *BTCDelegator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +443,7 @@ func (m *EventIndex) Reset()         { *m = EventIndex{} }
 func (m *EventIndex) String() string { return proto.CompactTextString(m) }
 func (*EventIndex) ProtoMessage()    {}
 func (*EventIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{3}
+	return fileDescriptor_85d7b95fa5620238, []int{6}
 }
 func (m *EventIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,6 +495,9 @@ func (m *EventIndex) GetEvent() *EventPowerDistUpdate {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btcstaking.v1.GenesisState")
+	proto.RegisterType((*FinalityProviderLastEdit)(nil), "babylon.btcstaking.v1.FinalityProviderLastEdit")
+	proto.RegisterType((*FinalityProviderCommissionRecord)(nil), "babylon.btcstaking.v1.FinalityProviderCommissionRecord")
+	proto.RegisterType((*RefundableBTCDelegation)(nil), "babylon.btcstaking.v1.RefundableBTCDelegation")
 	proto.RegisterType((*BlockHeightBbnToBtc)(nil), "babylon.btcstaking.v1.BlockHeightBbnToBtc")
 	proto.RegisterType((*BTCDelegator)(nil), "babylon.btcstaking.v1.BTCDelegator")
 	proto.RegisterType((*EventIndex)(nil), "babylon.btcstaking.v1.EventIndex")
@@ -296,42 +508,62 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc7, 0x9b, 0x76, 0xeb, 0x9e, 0xc7, 0xed, 0xca, 0xe6, 0x81, 0x14, 0x55, 0x22, 0x94, 0x0e,
-	0x41, 0x05, 0x22, 0x61, 0xdd, 0x90, 0xe0, 0x48, 0x56, 0xfe, 0x0c, 0x04, 0x8a, 0x42, 0xd9, 0x61,
-	0x97, 0x2a, 0x76, 0xdc, 0xd4, 0x6a, 0x66, 0x47, 0xb1, 0x57, 0xda, 0x2b, 0x57, 0x2e, 0xbc, 0x2c,
-	0x6e, 0xec, 0x88, 0x38, 0x20, 0xd4, 0xbe, 0x11, 0x54, 0x27, 0x23, 0x2d, 0xb4, 0xa3, 0x12, 0xb7,
-	0x24, 0xfa, 0xfc, 0x3e, 0xfe, 0x7e, 0xf3, 0x93, 0x0c, 0x76, 0x91, 0x87, 0x46, 0x21, 0x67, 0x16,
-	0x92, 0x58, 0x48, 0xaf, 0x4f, 0x59, 0x60, 0x0d, 0xf6, 0xac, 0x80, 0x30, 0x22, 0xa8, 0x30, 0xa3,
-	0x98, 0x4b, 0x0e, 0xaf, 0xa5, 0x90, 0x99, 0x41, 0xe6, 0x60, 0xaf, 0x7a, 0x35, 0xe0, 0x01, 0x57,
-	0x84, 0x35, 0x7d, 0x4a, 0xe0, 0x6a, 0x7d, 0xb1, 0x31, 0xf2, 0x62, 0xef, 0x34, 0x15, 0x56, 0x6f,
-	0x2f, 0x66, 0x66, 0xf4, 0x97, 0xba, 0xc8, 0x80, 0x30, 0x99, 0xba, 0xea, 0x5f, 0x0a, 0xa0, 0xfc,
-	0x3c, 0x89, 0xfb, 0x56, 0x7a, 0x92, 0xc0, 0x87, 0xa0, 0x98, 0x1c, 0xa6, 0x6b, 0xb5, 0x42, 0xa3,
-	0xd4, 0xbc, 0x6e, 0x2e, 0x8c, 0x6f, 0x3a, 0x0a, 0x72, 0x53, 0x18, 0x1e, 0x03, 0xd8, 0xa5, 0xcc,
-	0x0b, 0xa9, 0x1c, 0x75, 0xa2, 0x98, 0x0f, 0xa8, 0x4f, 0x62, 0xa1, 0xe7, 0x95, 0xe2, 0xce, 0x12,
-	0xc5, 0xb3, 0x74, 0xc0, 0x49, 0x79, 0x77, 0xbb, 0xfb, 0xdb, 0x17, 0x01, 0x5f, 0x83, 0x2b, 0x48,
-	0xe2, 0x8e, 0x4f, 0x42, 0x12, 0x78, 0x92, 0x72, 0x26, 0xf4, 0x82, 0x92, 0xde, 0x5a, 0x22, 0xb5,
-	0xdb, 0x87, 0xad, 0x5f, 0xb0, 0x5b, 0x41, 0x12, 0x67, 0xaf, 0x02, 0x9e, 0x80, 0x1d, 0x14, 0x72,
-	0xdc, 0xef, 0xf4, 0x08, 0x0d, 0x7a, 0xb2, 0x83, 0x7b, 0x1e, 0x65, 0x42, 0x5f, 0x57, 0xca, 0xbb,
-	0xcb, 0x94, 0xd3, 0x89, 0x17, 0x6a, 0xc0, 0x46, 0xac, 0xcd, 0x6d, 0x89, 0xdd, 0x6d, 0x94, 0x7d,
-	0x3c, 0x54, 0x12, 0xf8, 0x12, 0x54, 0x66, 0xa2, 0xf2, 0x58, 0xe8, 0x45, 0xa5, 0xdd, 0xfd, 0x6b,
-	0x52, 0x1e, 0xbb, 0x9b, 0x59, 0x50, 0x1e, 0x0b, 0xf8, 0x18, 0x14, 0x93, 0x35, 0xe9, 0x1b, 0xca,
-	0x71, 0x73, 0x89, 0xe3, 0xe9, 0x14, 0x3a, 0x62, 0x3e, 0x19, 0xba, 0xe9, 0x40, 0x9d, 0x82, 0x9d,
-	0x05, 0x81, 0x61, 0x03, 0x6c, 0xcd, 0x35, 0x47, 0x88, 0xe9, 0x5a, 0x4d, 0x6b, 0xac, 0xb9, 0x15,
-	0x34, 0x87, 0xff, 0x49, 0x4a, 0xac, 0xe7, 0x6b, 0x5a, 0x63, 0x73, 0x9e, 0x94, 0xb8, 0xfe, 0x21,
-	0x0f, 0xca, 0xb3, 0x2d, 0x60, 0x0b, 0x14, 0xa8, 0x3f, 0x54, 0xde, 0x52, 0xb3, 0xb9, 0x42, 0xef,
-	0x6c, 0x37, 0x49, 0x89, 0xe9, 0x38, 0x6c, 0x83, 0xff, 0xbb, 0xd1, 0xf4, 0xd8, 0x4e, 0xd4, 0x57,
-	0x27, 0x97, 0xed, 0x47, 0xdf, 0xbe, 0xdf, 0x38, 0x08, 0xa8, 0xec, 0x9d, 0x21, 0x13, 0xf3, 0x53,
-	0x2b, 0x35, 0x87, 0x1e, 0x12, 0xf7, 0x29, 0xbf, 0x78, 0xb5, 0xe4, 0x28, 0x22, 0xc2, 0xb4, 0x8f,
-	0x9c, 0xfd, 0x83, 0x07, 0xce, 0x19, 0x7a, 0x45, 0x46, 0xee, 0x46, 0x37, 0xb2, 0x25, 0x76, 0xfa,
-	0xf0, 0x18, 0x00, 0x9f, 0x84, 0x17, 0xda, 0xc2, 0x3f, 0x6a, 0xff, 0xf3, 0x49, 0xa8, 0xbc, 0xf5,
-	0x8f, 0x1a, 0x00, 0xd9, 0x1a, 0xe0, 0x56, 0xf6, 0x0b, 0xd6, 0x92, 0x3a, 0x2b, 0xff, 0x4f, 0xf8,
-	0x04, 0xac, 0xab, 0x25, 0xaa, 0x74, 0xa5, 0xe6, 0xbd, 0xcb, 0x96, 0xee, 0xf0, 0xf7, 0x24, 0x6e,
-	0x51, 0x21, 0xdf, 0x45, 0xbe, 0x27, 0x89, 0x9b, 0x4c, 0xda, 0x6f, 0x3e, 0x8f, 0x0d, 0xed, 0x7c,
-	0x6c, 0x68, 0x3f, 0xc6, 0x86, 0xf6, 0x69, 0x62, 0xe4, 0xce, 0x27, 0x46, 0xee, 0xeb, 0xc4, 0xc8,
-	0x9d, 0xac, 0xd0, 0x73, 0x38, 0x7b, 0x53, 0xa8, 0xd2, 0xa8, 0xa8, 0xae, 0x89, 0xfd, 0x9f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x54, 0x96, 0xa0, 0xb9, 0xea, 0x04, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0xe2, 0xd6, 0xa9, 0x9f, 0xe3, 0xb4, 0xdd, 0xb4, 0x20, 0x02, 0x38, 0xc6, 0x65, 0x8a,
+	0x07, 0xa6, 0x72, 0x92, 0x96, 0x01, 0x8e, 0x55, 0x1c, 0x48, 0xa1, 0x30, 0x66, 0x13, 0x72, 0xe8,
+	0x45, 0xb3, 0x5a, 0xad, 0xa4, 0x1d, 0xcb, 0x5a, 0x8d, 0x76, 0x63, 0xec, 0x2b, 0x27, 0x66, 0xb8,
+	0xf0, 0x27, 0xf8, 0x07, 0xfc, 0x88, 0x1e, 0x3b, 0x9c, 0x98, 0x1e, 0x32, 0x4c, 0xf2, 0x47, 0x18,
+	0xaf, 0xe4, 0xc8, 0x2e, 0x51, 0xeb, 0x19, 0x18, 0x6e, 0xda, 0x7d, 0xdf, 0xfb, 0xbe, 0xf7, 0xde,
+	0x27, 0x3d, 0xc1, 0x3d, 0x97, 0xb8, 0x93, 0x48, 0xc4, 0x5d, 0x57, 0x51, 0xa9, 0xc8, 0x80, 0xc7,
+	0x41, 0x77, 0xb4, 0xdb, 0x0d, 0x58, 0xcc, 0x24, 0x97, 0x56, 0x92, 0x0a, 0x25, 0xd0, 0xdd, 0x1c,
+	0x64, 0x15, 0x20, 0x6b, 0xb4, 0xbb, 0x75, 0x27, 0x10, 0x81, 0xd0, 0x88, 0xee, 0xf4, 0x29, 0x03,
+	0x6f, 0xbd, 0x43, 0x85, 0x1c, 0x0a, 0xe9, 0x64, 0x81, 0xec, 0x90, 0x87, 0xda, 0x57, 0x8b, 0x25,
+	0x24, 0x25, 0xc3, 0x19, 0xe6, 0xfe, 0xd5, 0x98, 0x39, 0xe5, 0xd7, 0x72, 0xb1, 0x11, 0x8b, 0x55,
+	0xce, 0xd5, 0xfe, 0x6d, 0x0d, 0xd6, 0xbf, 0xca, 0x3a, 0x39, 0x52, 0x44, 0x31, 0xf4, 0x29, 0x54,
+	0x33, 0x31, 0xd3, 0x68, 0x55, 0x3a, 0xf5, 0xbd, 0xf7, 0xad, 0x2b, 0x3b, 0xb3, 0xfa, 0x1a, 0x84,
+	0x73, 0x30, 0x3a, 0x01, 0xe4, 0xf3, 0x98, 0x44, 0x5c, 0x4d, 0xa6, 0x6d, 0x8d, 0xb8, 0xc7, 0x52,
+	0x69, 0xae, 0x6a, 0x8a, 0x8f, 0x4a, 0x28, 0xbe, 0xcc, 0x13, 0xfa, 0x39, 0x1e, 0xdf, 0xf6, 0x5f,
+	0xb9, 0x91, 0xe8, 0x5b, 0xb8, 0xe9, 0x2a, 0xea, 0x78, 0x2c, 0x62, 0x01, 0x51, 0x5c, 0xc4, 0xd2,
+	0xac, 0x68, 0xd2, 0x0f, 0x4b, 0x48, 0xed, 0xe3, 0xfd, 0xde, 0x25, 0x18, 0x6f, 0xb8, 0x8a, 0x16,
+	0x47, 0x89, 0x9e, 0xc1, 0xa6, 0x1b, 0x09, 0x3a, 0x70, 0x42, 0xc6, 0x83, 0x50, 0x39, 0x34, 0x24,
+	0x3c, 0x96, 0xe6, 0x75, 0x4d, 0xf9, 0x71, 0x19, 0xe5, 0x34, 0xe3, 0x50, 0x27, 0xd8, 0x6e, 0x7c,
+	0x2c, 0x6c, 0x45, 0xf1, 0x6d, 0xb7, 0xb8, 0xdc, 0xd7, 0x24, 0xe8, 0x6b, 0xd8, 0x98, 0x2b, 0x55,
+	0xa4, 0xd2, 0xac, 0x6a, 0xda, 0x7b, 0x6f, 0xac, 0x54, 0xa4, 0xb8, 0x51, 0x14, 0x2a, 0x52, 0x89,
+	0xbe, 0x80, 0x6a, 0x66, 0x93, 0xb9, 0xa6, 0x39, 0x3e, 0x28, 0xe1, 0x38, 0x98, 0x82, 0x9e, 0xc4,
+	0x1e, 0x1b, 0xe3, 0x3c, 0x01, 0xed, 0xc0, 0x1d, 0x9f, 0xa7, 0x52, 0x39, 0x99, 0x33, 0xce, 0x88,
+	0xa5, 0x92, 0x8b, 0xd8, 0xbc, 0xd1, 0x32, 0x3a, 0x0d, 0x8c, 0x74, 0x2c, 0x33, 0xef, 0x24, 0x8b,
+	0xa0, 0x14, 0xde, 0x93, 0x2c, 0x62, 0x54, 0xf1, 0x11, 0x73, 0x64, 0x44, 0x64, 0xc8, 0xe3, 0xc0,
+	0x61, 0x53, 0x07, 0x62, 0xca, 0xa4, 0x59, 0xd3, 0x25, 0xec, 0x94, 0x94, 0x70, 0x34, 0x4b, 0x3d,
+	0xca, 0x33, 0x0f, 0xf2, 0x44, 0xbc, 0x25, 0xcb, 0x42, 0x12, 0x45, 0xb0, 0x95, 0x32, 0xff, 0x34,
+	0xf6, 0x88, 0x1b, 0x31, 0xe7, 0x55, 0x8b, 0x41, 0x2b, 0x5a, 0x25, 0x8a, 0xf8, 0x32, 0x71, 0xd1,
+	0x6c, 0xb3, 0x60, 0xb4, 0x17, 0x6d, 0x1f, 0xc0, 0x5d, 0x3f, 0x71, 0xa8, 0x18, 0x0e, 0xb9, 0x9c,
+	0xb6, 0xec, 0x84, 0x5c, 0x2a, 0x91, 0x4e, 0xcc, 0xba, 0x16, 0xfa, 0x6c, 0xc9, 0x17, 0x74, 0xff,
+	0x92, 0x00, 0x33, 0x2a, 0x52, 0x0f, 0x6f, 0xfa, 0x49, 0x71, 0x77, 0x98, 0x71, 0xa2, 0x23, 0x68,
+	0xf8, 0x89, 0x13, 0x11, 0xa9, 0x1c, 0xe6, 0x71, 0x25, 0xcd, 0x75, 0x2d, 0xd2, 0x5d, 0x52, 0xe4,
+	0x29, 0x91, 0xea, 0xc0, 0xe3, 0x0a, 0xd7, 0xfd, 0x64, 0xf6, 0x2c, 0xdb, 0x3f, 0x1b, 0x60, 0x96,
+	0x21, 0xd1, 0x31, 0xd4, 0xfc, 0x44, 0x0f, 0x31, 0x19, 0x98, 0x46, 0xcb, 0xe8, 0xac, 0xdb, 0x9f,
+	0xbf, 0x3c, 0xdb, 0x7e, 0x14, 0x70, 0x15, 0x9e, 0xba, 0x16, 0x15, 0xc3, 0x6e, 0xae, 0x1d, 0x11,
+	0x57, 0x3e, 0xe0, 0x62, 0x76, 0xec, 0xaa, 0x49, 0xc2, 0xa4, 0x65, 0x3f, 0xe9, 0x3f, 0x7c, 0xb4,
+	0xd3, 0x3f, 0x75, 0xbf, 0x61, 0x13, 0xbc, 0xe6, 0x27, 0xb6, 0xa2, 0xfd, 0x01, 0x7a, 0x0b, 0xaa,
+	0xd9, 0x57, 0x62, 0xae, 0xb6, 0x8c, 0xce, 0x35, 0x9c, 0x9f, 0xda, 0x17, 0x06, 0xb4, 0xde, 0x34,
+	0x99, 0xff, 0xb7, 0x24, 0xf4, 0x3d, 0x40, 0x61, 0xae, 0x59, 0x69, 0x19, 0x9d, 0x9a, 0xbd, 0xfb,
+	0xfc, 0x6c, 0x7b, 0xe5, 0xe5, 0xd9, 0xf6, 0xbb, 0xd9, 0x7e, 0x95, 0xde, 0xc0, 0xe2, 0xa2, 0x3b,
+	0x24, 0x2a, 0xb4, 0x9e, 0xb2, 0x80, 0xd0, 0x49, 0x8f, 0xd1, 0x3f, 0x7e, 0x7f, 0x00, 0x59, 0xd8,
+	0xea, 0x31, 0x8a, 0xe7, 0x48, 0xda, 0x2e, 0xbc, 0x5d, 0xf2, 0x9e, 0xa1, 0x6d, 0xa8, 0x4f, 0xfd,
+	0x63, 0xa9, 0x43, 0x3c, 0x2f, 0xd5, 0xdd, 0xd5, 0x30, 0x64, 0x57, 0x8f, 0x3d, 0x2f, 0x45, 0xf7,
+	0xe1, 0x66, 0x6e, 0xb0, 0xa3, 0xc6, 0x4e, 0x48, 0x64, 0xa8, 0xeb, 0xad, 0xe1, 0x46, 0x7e, 0x7d,
+	0x3c, 0x3e, 0x24, 0x32, 0x6c, 0x73, 0xd8, 0xbc, 0x62, 0xb7, 0xa0, 0x0e, 0xdc, 0x5a, 0x58, 0x52,
+	0xae, 0x1b, 0x6b, 0x91, 0x6b, 0x78, 0xc3, 0x5d, 0x80, 0xff, 0x13, 0xa9, 0xa8, 0x56, 0x6a, 0x2c,
+	0x22, 0x15, 0x6d, 0xff, 0xb4, 0x0a, 0xeb, 0xf3, 0x0b, 0x07, 0xf5, 0xa0, 0xc2, 0xbd, 0xb1, 0xe6,
+	0xad, 0xef, 0xed, 0x2d, 0xb1, 0xa2, 0x8a, 0x01, 0x64, 0xfb, 0x66, 0x9a, 0xbe, 0x68, 0xf3, 0xea,
+	0x7f, 0x65, 0xf3, 0x09, 0x80, 0xc7, 0xa2, 0x19, 0x6d, 0xe5, 0x5f, 0xd2, 0xde, 0xf0, 0x58, 0xa4,
+	0x79, 0xdb, 0xbf, 0x18, 0x00, 0xc5, 0xc6, 0x44, 0xb7, 0x8a, 0x11, 0x5c, 0xcb, 0xda, 0x59, 0x7a,
+	0x9e, 0xe8, 0x31, 0x5c, 0xd7, 0xfb, 0x56, 0x57, 0x57, 0xdf, 0xfb, 0xe4, 0x75, 0xfb, 0xb9, 0x2f,
+	0x7e, 0x64, 0x69, 0x8f, 0x4b, 0xf5, 0x43, 0xe2, 0x11, 0xc5, 0x70, 0x96, 0x69, 0x7f, 0xf7, 0xfc,
+	0xbc, 0x69, 0xbc, 0x38, 0x6f, 0x1a, 0x7f, 0x9d, 0x37, 0x8d, 0x5f, 0x2f, 0x9a, 0x2b, 0x2f, 0x2e,
+	0x9a, 0x2b, 0x7f, 0x5e, 0x34, 0x57, 0x9e, 0x2d, 0xd1, 0xe7, 0x78, 0xfe, 0xa7, 0xae, 0x9b, 0x76,
+	0xab, 0xfa, 0x8f, 0xfe, 0xf0, 0xef, 0x01, 0x00, 0x02, 0xea, 0xe9, 0x48, 0xb0, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -354,6 +586,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FpLastEdits) > 0 {
+		for iNdEx := len(m.FpLastEdits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpLastEdits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FpCommissionHistory) > 0 {
+		for iNdEx := len(m.FpCommissionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpCommissionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RefundableBtcDelegations) > 0 {
+		for iNdEx := len(m.RefundableBtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundableBtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SelectiveSlashingEvidences) > 0 {
+		for iNdEx := len(m.SelectiveSlashingEvidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectiveSlashingEvidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.FirstParamsVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FirstParamsVersion))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -441,6 +734,133 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalityProviderLastEdit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderLastEdit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderLastEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderCommissionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderCommissionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderCommissionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundableBTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundableBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundableBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -631,10 +1051,88 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *BlockHeightBbnToBtc) Size() (n int) {
+	if m.FirstParamsVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.FirstParamsVersion))
+	}
+	if len(m.SelectiveSlashingEvidences) > 0 {
+		for _, e := range m.SelectiveSlashingEvidences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefundableBtcDelegations) > 0 {
+		for _, e := range m.RefundableBtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FpCommissionHistory) > 0 {
+		for _, e := range m.FpCommissionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FpLastEdits) > 0 {
+		for _, e := range m.FpLastEdits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderLastEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FinalityProviderCommissionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RefundableBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeightBbnToBtc) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -928,6 +1426,517 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstParamsVersion", wireType)
+			}
+			m.FirstParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectiveSlashingEvidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectiveSlashingEvidences = append(m.SelectiveSlashingEvidences, &SelectiveSlashingEvidence{})
+			if err := m.SelectiveSlashingEvidences[len(m.SelectiveSlashingEvidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundableBtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundableBtcDelegations = append(m.RefundableBtcDelegations, &RefundableBTCDelegation{})
+			if err := m.RefundableBtcDelegations[len(m.RefundableBtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpCommissionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpCommissionHistory = append(m.FpCommissionHistory, &FinalityProviderCommissionRecord{})
+			if err := m.FpCommissionHistory[len(m.FpCommissionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpLastEdits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpLastEdits = append(m.FpLastEdits, &FinalityProviderLastEdit{})
+			if err := m.FpLastEdits[len(m.FpLastEdits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderLastEdit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderLastEdit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderLastEdit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderCommissionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderCommissionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundableBTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundableBTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundableBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
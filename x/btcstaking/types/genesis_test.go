@@ -1,15 +1,20 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
 	tests := []struct {
 		desc     string
 		genState func() *types.GenesisState
@@ -84,6 +89,202 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid selective slashing evidences",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.SelectiveSlashingEvidences = []*types.SelectiveSlashingEvidence{
+					genSelectiveSlashingEvidence(t, r), genSelectiveSlashingEvidence(t, r),
+				}
+				return d
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated selective slashing evidences",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				evidence := genSelectiveSlashingEvidence(t, r)
+				d.SelectiveSlashingEvidences = []*types.SelectiveSlashingEvidence{evidence, evidence}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "selective slashing evidence with mismatched secret key",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				evidence := genSelectiveSlashingEvidence(t, r)
+				evidence.RecoveredFpBtcSk = genSelectiveSlashingEvidence(t, r).RecoveredFpBtcSk
+				d.SelectiveSlashingEvidences = []*types.SelectiveSlashingEvidence{evidence}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "selective slashing evidence with invalid staking tx hash",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				evidence := genSelectiveSlashingEvidence(t, r)
+				evidence.StakingTxHash = "invalid"
+				d.SelectiveSlashingEvidences = []*types.SelectiveSlashingEvidence{evidence}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "valid refundable BTC delegations",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.RefundableBtcDelegations = []*types.RefundableBTCDelegation{
+					genRefundableBTCDelegation(r), genRefundableBTCDelegation(r),
+				}
+				return d
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated refundable BTC delegations",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				refundable := genRefundableBTCDelegation(r)
+				d.RefundableBtcDelegations = []*types.RefundableBTCDelegation{refundable, refundable}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "refundable BTC delegation with invalid staker address",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				refundable := genRefundableBTCDelegation(r)
+				refundable.StakerAddr = "invalid"
+				d.RefundableBtcDelegations = []*types.RefundableBTCDelegation{refundable}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "refundable BTC delegation with invalid staking tx hash",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				refundable := genRefundableBTCDelegation(r)
+				refundable.StakingTxHash = "invalid"
+				d.RefundableBtcDelegations = []*types.RefundableBTCDelegation{refundable}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "valid finality provider commission history",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				record := genFinalityProviderCommissionRecord(t, r)
+				later := *record
+				later.Height++
+				d.FpCommissionHistory = []*types.FinalityProviderCommissionRecord{record, &later}
+				return d
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated finality provider commission record",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				record := genFinalityProviderCommissionRecord(t, r)
+				d.FpCommissionHistory = []*types.FinalityProviderCommissionRecord{record, record}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "finality provider commission record without BTC PK",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				record := genFinalityProviderCommissionRecord(t, r)
+				record.FpBtcPk = nil
+				d.FpCommissionHistory = []*types.FinalityProviderCommissionRecord{record}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "finality provider commission record with commission larger than one",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				record := genFinalityProviderCommissionRecord(t, r)
+				record.Commission = sdkmath.LegacyMustNewDecFromStr("1.1")
+				d.FpCommissionHistory = []*types.FinalityProviderCommissionRecord{record}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "valid finality provider last edits",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.FpLastEdits = []*types.FinalityProviderLastEdit{
+					genFinalityProviderLastEdit(t, r), genFinalityProviderLastEdit(t, r),
+				}
+				return d
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated finality provider last edits",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				lastEdit := genFinalityProviderLastEdit(t, r)
+				later := *lastEdit
+				later.Height++
+				d.FpLastEdits = []*types.FinalityProviderLastEdit{lastEdit, &later}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "finality provider last edit without BTC PK",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				lastEdit := genFinalityProviderLastEdit(t, r)
+				lastEdit.FpBtcPk = nil
+				d.FpLastEdits = []*types.FinalityProviderLastEdit{lastEdit}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "valid commission update event",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+				require.NoError(t, err)
+				d.Events = []*types.EventIndex{{
+					Idx:            1,
+					BlockHeightBtc: 10,
+					Event:          types.NewEventPowerDistUpdateWithCommissionUpdatedFP(fpBTCPK, datagen.GenRandomCommission(r)),
+				}}
+				return d
+			},
+			valid: true,
+		},
+		{
+			desc: "commission update event without commission",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+				require.NoError(t, err)
+				evt := types.NewEventPowerDistUpdateWithCommissionUpdatedFP(fpBTCPK, datagen.GenRandomCommission(r))
+				evt.GetCommissionUpdatedFp().Commission = nil
+				d.Events = []*types.EventIndex{{
+					Idx:            1,
+					BlockHeightBtc: 10,
+					Event:          evt,
+				}}
+				return d
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -97,3 +298,40 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func genSelectiveSlashingEvidence(t *testing.T, r *rand.Rand) *types.SelectiveSlashingEvidence {
+	fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	return &types.SelectiveSlashingEvidence{
+		StakingTxHash:    datagen.GenRandomBtcdHash(r).String(),
+		FpBtcPk:          bbn.NewBIP340PubKeyFromBTCPK(fpPK),
+		RecoveredFpBtcSk: fpSK.Serialize(),
+		BlockHeight:      r.Uint64(),
+	}
+}
+
+func genRefundableBTCDelegation(r *rand.Rand) *types.RefundableBTCDelegation {
+	return &types.RefundableBTCDelegation{
+		StakerAddr:    datagen.GenRandomAccount().Address,
+		StakingTxHash: datagen.GenRandomBtcdHash(r).String(),
+	}
+}
+
+func genFinalityProviderCommissionRecord(t *testing.T, r *rand.Rand) *types.FinalityProviderCommissionRecord {
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	return &types.FinalityProviderCommissionRecord{
+		FpBtcPk:    bbn.NewBIP340PubKeyFromBTCPK(fpPK),
+		Height:     r.Uint64(),
+		Commission: sdkmath.LegacyMustNewDecFromStr("0.1"),
+	}
+}
+
+func genFinalityProviderLastEdit(t *testing.T, r *rand.Rand) *types.FinalityProviderLastEdit {
+	_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	return &types.FinalityProviderLastEdit{
+		FpBtcPk: bbn.NewBIP340PubKeyFromBTCPK(fpPK),
+		Height:  uint64(r.Uint32()),
+	}
+}
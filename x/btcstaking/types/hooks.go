@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+)
+
+var _ BtcStakingHooks = &MultiBtcStakingHooks{}
+
+type MultiBtcStakingHooks []BtcStakingHooks
+
+func NewMultiBtcStakingHooks(hooks ...BtcStakingHooks) MultiBtcStakingHooks {
+	return hooks
+}
+
+func (h MultiBtcStakingHooks) AfterFinalityProviderCanceled(ctx context.Context, fpBtcPk *bbn.BIP340PubKey) error {
+	for i := range h {
+		if err := h[i].AfterFinalityProviderCanceled(ctx, fpBtcPk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -10,6 +10,10 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 
+	// TStoreKey defines the transient store key, which is cleared after
+	// each block
+	TStoreKey = "transient_btcstaking"
+
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_btcstaking"
 )
@@ -22,5 +26,20 @@ var (
 	// 0x05 was used for something else in the past
 	BTCHeightKey = []byte{0x06} // key prefix for the BTC heights
 	// 0x07 was used for something else in the past
-	PowerDistUpdateKey = []byte{0x08} // key prefix for power distribution update events
+	PowerDistUpdateKey                 = []byte{0x08} // key prefix for power distribution update events
+	FinalityProviderCommissionKey      = []byte{0x09} // key prefix for the commission history of finality providers
+	BTCDelegationValueKey              = []byte{0x0a} // key prefix for the index of BTC delegations by staking value
+	BTCDelegationEndHeightKey          = []byte{0x0b} // key prefix for the index of BTC delegations by end height
+	SelectiveSlashingEvidenceKey       = []byte{0x0c} // key prefix for the selective slashing evidences
+	BTCDelegationFpSetKey              = []byte{0x0d} // key prefix for the index of BTC delegations by finality provider set
+	BTCDelegationStakerKey             = []byte{0x0e} // key prefix for the index of BTC delegations by staker address
+	CovenantQuorumDeadlineKey          = []byte{0x0f} // key prefix for the index of BTC delegations by covenant quorum deadline
+	RefundableBTCDelegationKey         = []byte{0x10} // key prefix for the BTC delegations refundable to their stakers
+	FinalityProviderLastEditKey        = []byte{0x11} // key prefix for the last edit heights of finality providers
+	StakerActiveBTCDelegationKey       = []byte{0x12} // key prefix for the number of BTC delegations of each staker that are not unbonded yet
+	ParamsVersionLiveBTCDelegationsKey = []byte{0x13} // key prefix for the number of live BTC delegations referencing each params version
+)
+
+var (
+	CovenantSigsInBlockKey = []byte{0x01} // transient key for the number of covenant signature messages in the current block
 )
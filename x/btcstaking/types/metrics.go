@@ -28,6 +28,10 @@ const (
 	// MetricsKeyStakedBitcoins is the key of the gauge recording the total
 	// amount of Bitcoins staked under active finality providers
 	MetricsKeyStakedBitcoins = "staked_bitcoins"
+	// MetricsKeyCovenantSigsPerBlockLimit is the key of the counter recording
+	// the number of covenant signature messages rejected for exceeding the
+	// cap of the block
+	MetricsKeyCovenantSigsPerBlockLimit = "covenant_sigs_per_block_limit"
 )
 
 // RecordActiveFinalityProviders records the number of active finality providers.
@@ -90,3 +94,16 @@ func RecordMetricsKeyStakedBitcoins(amount float32) {
 		labels,
 	)
 }
+
+// RecordCovenantSigsPerBlockLimitHit increments the number of covenant
+// signature messages rejected for exceeding the cap of the block.
+// It is triggered upon rejecting a MsgAddCovenantSigs.
+func RecordCovenantSigsPerBlockLimitHit() {
+	keys := []string{MetricsKeyCovenantSigsPerBlockLimit}
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, ModuleName)}
+	telemetry.IncrCounterWithLabels(
+		keys,
+		1,
+		labels,
+	)
+}
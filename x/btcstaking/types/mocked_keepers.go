@@ -11,7 +11,8 @@ import (
 	types "github.com/babylonlabs-io/babylon/types"
 	types0 "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
 	types1 "github.com/babylonlabs-io/babylon/x/btclightclient/types"
-	types2 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/babylonlabs-io/babylon/x/incentive/types"
+	types3 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -117,6 +118,43 @@ func (mr *MockBtcCheckpointKeeperMockRecorder) GetParams(ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockBtcCheckpointKeeper)(nil).GetParams), ctx)
 }
 
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockBankKeeper) GetBalance(ctx context.Context, addr types3.AccAddress, denom string) types3.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, addr, denom)
+	ret0, _ := ret[0].(types3.Coin)
+	return ret0
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
+}
+
 // MockFinalityKeeper is a mock of FinalityKeeper interface.
 type MockFinalityKeeper struct {
 	ctrl     *gomock.Controller
@@ -177,8 +215,37 @@ func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetFpSelfDelRewards mocks base method.
+func (m *MockIncentiveKeeper) GetFpSelfDelRewards(ctx context.Context, fpBTCPK []byte) (types3.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFpSelfDelRewards", ctx, fpBTCPK)
+	ret0, _ := ret[0].(types3.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFpSelfDelRewards indicates an expected call of GetFpSelfDelRewards.
+func (mr *MockIncentiveKeeperMockRecorder) GetFpSelfDelRewards(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFpSelfDelRewards", reflect.TypeOf((*MockIncentiveKeeper)(nil).GetFpSelfDelRewards), ctx, fpBTCPK)
+}
+
+// GetRewardGauge mocks base method.
+func (m *MockIncentiveKeeper) GetRewardGauge(ctx context.Context, sType types2.StakeholderType, addr types3.AccAddress) *types2.RewardGauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardGauge", ctx, sType, addr)
+	ret0, _ := ret[0].(*types2.RewardGauge)
+	return ret0
+}
+
+// GetRewardGauge indicates an expected call of GetRewardGauge.
+func (mr *MockIncentiveKeeperMockRecorder) GetRewardGauge(ctx, sType, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardGauge", reflect.TypeOf((*MockIncentiveKeeper)(nil).GetRewardGauge), ctx, sType, addr)
+}
+
 // IndexRefundableMsg mocks base method.
-func (m *MockIncentiveKeeper) IndexRefundableMsg(ctx context.Context, msg types2.Msg) {
+func (m *MockIncentiveKeeper) IndexRefundableMsg(ctx context.Context, msg types3.Msg) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IndexRefundableMsg", ctx, msg)
 }
@@ -15,6 +15,7 @@ var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgCreateFinalityProvider{}
 	_ sdk.Msg = &MsgEditFinalityProvider{}
+	_ sdk.Msg = &MsgCancelFinalityProvider{}
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
@@ -46,9 +47,33 @@ func (m *MsgCreateFinalityProvider) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
 		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
 	}
+	if m.SelfDelegation != nil {
+		if err := m.validateSelfDelegation(); err != nil {
+			return fmt.Errorf("invalid self delegation: %w", err)
+		}
+	}
 	return m.Pop.ValidateBasic()
 }
 
+// validateSelfDelegation ensures the embedded self delegation is a valid BTC
+// delegation staked by the finality provider to itself
+func (m *MsgCreateFinalityProvider) validateSelfDelegation() error {
+	if m.SelfDelegation.StakerAddr != m.Addr {
+		return fmt.Errorf("staker address %s is not the finality provider address %s", m.SelfDelegation.StakerAddr, m.Addr)
+	}
+	delegatesToSelf := false
+	for _, fpBTCPK := range m.SelfDelegation.FpBtcPkList {
+		if fpBTCPK.Equals(m.BtcPk) {
+			delegatesToSelf = true
+			break
+		}
+	}
+	if !delegatesToSelf {
+		return fmt.Errorf("the finality provider %s is not in the finality providers to delegate to", m.BtcPk.MarshalHex())
+	}
+	return m.SelfDelegation.ValidateBasic()
+}
+
 func (m *MsgEditFinalityProvider) ValidateBasic() error {
 	if m.Commission == nil {
 		return fmt.Errorf("empty commission")
@@ -72,6 +97,20 @@ func (m *MsgEditFinalityProvider) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgCancelFinalityProvider) ValidateBasic() error {
+	if len(m.BtcPk) != bbn.BIP340PubKeyLen {
+		return fmt.Errorf("malformed BTC PK")
+	}
+	if _, err := bbn.NewBIP340PubKey(m.BtcPk); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
+		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
+	}
+
+	return nil
+}
+
 func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	if _, err := ParseCreateDelegationMessage(m); err != nil {
 		return err
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"gopkg.in/yaml.v2"
 
 	"github.com/babylonlabs-io/babylon/btcstaking"
@@ -20,6 +21,24 @@ import (
 const (
 	// TODO: need to determine a proper default value
 	defaultDelegationCreationBaseGasFee = 1000
+	// defaultCovenantSigVerifyGasPerSig is the gas consumed for verifying a
+	// covenant adaptor signature. It matches the gas consumed by the auth
+	// module for verifying a secp256k1 signature, as verifying an adaptor
+	// signature costs about the same as verifying a Schnorr signature
+	defaultCovenantSigVerifyGasPerSig = 1000
+	// defaultMaxInclusionProofHeaderSkew is two years in seconds. It is
+	// generous enough to exceed the longest staking time of math.MaxUint16
+	// BTC blocks, which is about 455 days, so that the header of a staking
+	// tx is never rejected for being old compared to the BTC tip
+	defaultMaxInclusionProofHeaderSkew = 2 * 365 * 24 * 60 * 60
+	// defaultMaxCovenantSigsPerBlock is the default cap on the covenant
+	// signature messages in a block. Each BTC delegation needs one message
+	// per covenant member, so it allows more than a thousand BTC delegations
+	// to reach covenant quorum in a block under a committee of 9 members
+	defaultMaxCovenantSigsPerBlock = 10000
+	// MinCovenantCommitteeSize is the minimum number of members of the
+	// covenant committee
+	MinCovenantCommitteeSize = 1
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -77,6 +96,27 @@ func DefaultParams() Params {
 		MinUnbondingTimeBlocks:       0,
 		UnbondingFeeSat:              1000,
 		DelegationCreationBaseGasFee: defaultDelegationCreationBaseGasFee,
+		// The default minimum number of retained params versions is 0, which
+		// disables pruning of params versions.
+		MinRetainedParamsVersions:  0,
+		CovenantSigVerifyGasPerSig: defaultCovenantSigVerifyGasPerSig,
+		// Decreasing the commission is never blocked by default.
+		AllowFreeCommissionDecrease: true,
+		MaxInclusionProofHeaderSkew: defaultMaxInclusionProofHeaderSkew,
+		// The covenant quorum deadline is disabled by default.
+		CovenantQuorumDeadlineBlocks:                0,
+		DeleteDelegationsPastCovenantQuorumDeadline: false,
+		// Finality providers can be edited in every block by default.
+		MinEditIntervalBlocks: 0,
+		// The description limits of the Cosmos SDK apply by default.
+		MaxDescriptionLength:     0,
+		MaxMonikerLength:         0,
+		MaxIdentityLength:        0,
+		MaxWebsiteLength:         0,
+		MaxSecurityContactLength: 0,
+		MaxDetailsLength:         0,
+		// The cap is high enough not to affect normal operation.
+		MaxCovenantSigsPerBlock: defaultMaxCovenantSigsPerBlock,
 	}
 }
 
@@ -107,10 +147,53 @@ func validateMinCommissionRate(rate sdkmath.LegacyDec) error {
 	return nil
 }
 
-// validateCovenantPks checks whether the covenants list contains any duplicates
+// validateMaxCommissionChangeRate checks the maximum commission change rate,
+// which is optional
+func validateMaxCommissionChangeRate(rate *sdkmath.LegacyDec) error {
+	if rate == nil || rate.IsNil() {
+		return nil
+	}
+
+	if rate.IsNegative() {
+		return fmt.Errorf("maximum commission change rate cannot be negative")
+	}
+
+	if rate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("maximum commission change rate cannot be greater than 100%%")
+	}
+	return nil
+}
+
+// validateCommissionRates checks the maximum commission change rate against
+// the minimum commission rate. Commission rates are within [minRate, 1], so a
+// change rate larger than 1 - minRate can never be reached
+func validateCommissionRates(minRate sdkmath.LegacyDec, maxChangeRate *sdkmath.LegacyDec) error {
+	if maxChangeRate == nil || maxChangeRate.IsNil() || !maxChangeRate.IsPositive() {
+		return nil
+	}
+
+	if maxChangeRate.GT(sdkmath.LegacyOneDec().Sub(minRate)) {
+		return ErrMaxCommissionChangeTooLarge.Wrapf("maximum commission change rate %s, minimum commission rate %s",
+			maxChangeRate, minRate)
+	}
+	return nil
+}
+
+// validateCovenantPks checks whether the covenants list has at least
+// MinCovenantCommitteeSize members, and contains only valid keys without
+// duplicates
 func validateCovenantPks(covenantPks []bbn.BIP340PubKey) error {
-	if ExistsDup(covenantPks) {
-		return fmt.Errorf("duplicate covenant key")
+	if len(covenantPks) < MinCovenantCommitteeSize {
+		return fmt.Errorf("covenant committee size has to be at least %d, got %d", MinCovenantCommitteeSize, len(covenantPks))
+	}
+	for i, pk := range covenantPks {
+		if _, err := pk.ToBTCPK(); err != nil {
+			return fmt.Errorf("invalid covenant key at index %d: %w", i, err)
+		}
+	}
+	// each covenant member has to be counted only once towards the quorum
+	if dupPK, found := FindDup(covenantPks); found {
+		return fmt.Errorf("duplicate covenant key %s", dupPK.MarshalHex())
 	}
 	return nil
 }
@@ -171,6 +254,9 @@ func (p Params) Validate() error {
 	if int(p.CovenantQuorum)*2 <= len(p.CovenantPks) {
 		return fmt.Errorf("covenant quorum size has to be more than 1/2 of the covenant committee size")
 	}
+	if int(p.CovenantQuorum) > len(p.CovenantPks) {
+		return fmt.Errorf("covenant quorum size cannot be larger than the covenant committee size")
+	}
 
 	if err := validateStakingAmout(p.MinStakingValueSat, p.MaxStakingValueSat); err != nil {
 		return err
@@ -191,6 +277,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxCommissionChangeRate(p.MaxCommissionChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateCommissionRates(p.MinCommissionRate, p.MaxCommissionChangeRate); err != nil {
+		return err
+	}
+
 	if !btcstaking.IsRateValid(p.SlashingRate) {
 		return btcstaking.ErrInvalidSlashingRate
 	}
@@ -199,9 +293,124 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	// a staking tx has to be locked for longer than its unbonding tx, which
+	// also prevents dust locks with very short staking time
+	if p.MinStakingTimeBlocks <= p.MinUnbondingTimeBlocks {
+		return fmt.Errorf("minimum staking time %d has to be larger than minimum unbonding time %d",
+			p.MinStakingTimeBlocks, p.MinUnbondingTimeBlocks)
+	}
+
+	if len(p.SlashingDestinations) > 0 {
+		if err := btcstaking.ValidateSlashingDestinations(p.BTCSlashingDestinations()); err != nil {
+			return err
+		}
+	}
+
+	if p.MinFinalityProviderBalance != nil {
+		if err := p.MinFinalityProviderBalance.Validate(); err != nil {
+			return fmt.Errorf("invalid minimum finality provider balance: %w", err)
+		}
+	}
+
+	if err := p.validateDescriptionLimits(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateCommissionChange ensures that changing the commission rate of a
+// finality provider from oldRate to newRate is within the maximum commission
+// change rate. Decreases are not limited if AllowFreeCommissionDecrease is set
+func (p Params) ValidateCommissionChange(oldRate, newRate sdkmath.LegacyDec) error {
+	maxChangeRate := p.MaxCommissionChangeRate
+	if maxChangeRate == nil || maxChangeRate.IsNil() || !maxChangeRate.IsPositive() {
+		return nil
+	}
+	if newRate.LT(oldRate) && p.AllowFreeCommissionDecrease {
+		return nil
+	}
+	if change := newRate.Sub(oldRate).Abs(); change.GT(*maxChangeRate) {
+		return ErrCommissionChangeTooLarge.Wrapf("changing the commission from %s to %s exceeds the maximum change of %s",
+			oldRate, newRate, maxChangeRate)
+	}
+	return nil
+}
+
+// descriptionFieldLimit is the length limit in the params and the hard
+// ceiling of a field of a finality provider description, together with the
+// length of the field in a given description
+type descriptionFieldLimit struct {
+	name           string
+	limit, ceiling uint32
+	length         int
+}
+
+// descriptionFieldLimits returns the length limits of each field of the given
+// finality provider description
+func (p Params) descriptionFieldLimits(d *stakingtypes.Description) []descriptionFieldLimit {
+	return []descriptionFieldLimit{
+		{"moniker", p.MaxMonikerLength, stakingtypes.MaxMonikerLength, len(d.Moniker)},
+		{"identity", p.MaxIdentityLength, stakingtypes.MaxIdentityLength, len(d.Identity)},
+		{"website", p.MaxWebsiteLength, stakingtypes.MaxWebsiteLength, len(d.Website)},
+		{"security contact", p.MaxSecurityContactLength, stakingtypes.MaxSecurityContactLength, len(d.SecurityContact)},
+		{"details", p.MaxDetailsLength, stakingtypes.MaxDetailsLength, len(d.Details)},
+	}
+}
+
+// validateDescriptionLimits ensures that the description length limits do not
+// exceed the limits of the Cosmos SDK, which are enforced regardless of the
+// params
+func (p Params) validateDescriptionLimits() error {
+	var totalCeiling uint32
+	for _, f := range p.descriptionFieldLimits(&stakingtypes.Description{}) {
+		if f.limit > f.ceiling {
+			return fmt.Errorf("maximum %s length %d cannot exceed %d", f.name, f.limit, f.ceiling)
+		}
+		totalCeiling += f.ceiling
+	}
+	if p.MaxDescriptionLength > totalCeiling {
+		return fmt.Errorf("maximum description length %d cannot exceed %d", p.MaxDescriptionLength, totalCeiling)
+	}
+	return nil
+}
+
+// ValidateDescriptionLength ensures that the given finality provider
+// description is within the length limits in the params. Limits set to 0 are
+// not enforced, as the description already conforms to the limits of the
+// Cosmos SDK upon ValidateBasic
+func (p Params) ValidateDescriptionLength(d *stakingtypes.Description) error {
+	total := 0
+	for _, f := range p.descriptionFieldLimits(d) {
+		if f.limit > 0 && f.length > int(f.limit) {
+			return ErrDescriptionTooLong.Wrapf("%s length %d exceeds the maximum of %d", f.name, f.length, f.limit)
+		}
+		total += f.length
+	}
+	if p.MaxDescriptionLength > 0 && total > int(p.MaxDescriptionLength) {
+		return ErrDescriptionTooLong.Wrapf("description length %d exceeds the maximum of %d", total, p.MaxDescriptionLength)
+	}
 	return nil
 }
 
+// BTCSlashingDestinations returns the destinations among which the slashed
+// funds are split. If no slashing destinations are set, all slashed funds
+// are sent to the slashing pk script
+func (p Params) BTCSlashingDestinations() []btcstaking.SlashingDestination {
+	if len(p.SlashingDestinations) == 0 {
+		return btcstaking.NewSingleSlashingDestination(p.SlashingPkScript)
+	}
+
+	destinations := make([]btcstaking.SlashingDestination, 0, len(p.SlashingDestinations))
+	for _, d := range p.SlashingDestinations {
+		destinations = append(destinations, btcstaking.SlashingDestination{
+			PkScript: d.PkScript,
+			Weight:   d.Weight,
+		})
+	}
+	return destinations
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
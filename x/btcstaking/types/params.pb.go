@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -39,7 +40,8 @@ type Params struct {
 	MinStakingValueSat int64 `protobuf:"varint,3,opt,name=min_staking_value_sat,json=minStakingValueSat,proto3" json:"min_staking_value_sat,omitempty"`
 	// max_staking_value_sat is the maximum of satoshis locked in staking output
 	MaxStakingValueSat int64 `protobuf:"varint,4,opt,name=max_staking_value_sat,json=maxStakingValueSat,proto3" json:"max_staking_value_sat,omitempty"`
-	// min_staking_time is the minimum lock time specified in staking output script
+	// min_staking_time is the minimum lock time specified in staking output script.
+	// It has to be larger than min_unbonding_time_blocks
 	MinStakingTimeBlocks uint32 `protobuf:"varint,5,opt,name=min_staking_time_blocks,json=minStakingTimeBlocks,proto3" json:"min_staking_time_blocks,omitempty"`
 	// max_staking_time_blocks is the maximum lock time time specified in staking output script
 	MaxStakingTimeBlocks uint32 `protobuf:"varint,6,opt,name=max_staking_time_blocks,json=maxStakingTimeBlocks,proto3" json:"max_staking_time_blocks,omitempty"`
@@ -67,6 +69,99 @@ type Params struct {
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
 	// base gas fee for delegation creation
 	DelegationCreationBaseGasFee uint64 `protobuf:"varint,13,opt,name=delegation_creation_base_gas_fee,json=delegationCreationBaseGasFee,proto3" json:"delegation_creation_base_gas_fee,omitempty"`
+	// PARAMETERS COVERING PARAMS VERSIONS
+	// min_retained_params_versions is the number of the latest params versions
+	// that are always retained. At the end of each block, older params versions
+	// are pruned once they are no longer referenced by any BTC delegation that
+	// can still be slashed or become active, i.e., any BTC delegation other
+	// than the expired ones with a covenant quorum.
+	// 0 disables pruning of params versions.
+	MinRetainedParamsVersions uint32 `protobuf:"varint,14,opt,name=min_retained_params_versions,json=minRetainedParamsVersions,proto3" json:"min_retained_params_versions,omitempty"`
+	// slashing_destinations is the list of outputs among which the slashed
+	// funds are split, weighted by governance. If set, it takes precedence
+	// over slashing_pk_script and the slashing transaction must have one
+	// output per destination, in the given order, followed by the change
+	// output. The pk_scripts must be distinct and pay to standard addresses,
+	// and the weights must sum up to 1. If empty, all slashed funds are sent
+	// to slashing_pk_script.
+	SlashingDestinations []SlashingDestination `protobuf:"bytes,15,rep,name=slashing_destinations,json=slashingDestinations,proto3" json:"slashing_destinations"`
+	// covenant_sig_verify_gas_per_sig is the gas consumed for verifying each
+	// covenant adaptor signature in MsgAddCovenantSigs, so that the gas cost
+	// of the message scales with the number of finality providers the BTC
+	// delegation restakes to. 0 disables the charge.
+	CovenantSigVerifyGasPerSig uint64 `protobuf:"varint,16,opt,name=covenant_sig_verify_gas_per_sig,json=covenantSigVerifyGasPerSig,proto3" json:"covenant_sig_verify_gas_per_sig,omitempty"`
+	// max_active_delegations_per_staker is the maximum number of BTC
+	// delegations that are not unbonded yet a staker address can have. New BTC
+	// delegations of a staker that reached the cap are rejected. 0 means
+	// unlimited.
+	MaxActiveDelegationsPerStaker uint32 `protobuf:"varint,17,opt,name=max_active_delegations_per_staker,json=maxActiveDelegationsPerStaker,proto3" json:"max_active_delegations_per_staker,omitempty"`
+	// allow_inclusion_proof_before_covenant_quorum indicates whether the
+	// inclusion proof of a BTC delegation can be submitted before the BTC
+	// delegation receives a quorum of covenant signatures. If so, the inclusion
+	// proof is recorded and the BTC delegation becomes active once the quorum
+	// is reached. Covenant signatures are rejected once the BTC tip reaches
+	// the height at which the BTC delegation is scheduled to become unbonded.
+	AllowInclusionProofBeforeCovenantQuorum bool `protobuf:"varint,18,opt,name=allow_inclusion_proof_before_covenant_quorum,json=allowInclusionProofBeforeCovenantQuorum,proto3" json:"allow_inclusion_proof_before_covenant_quorum,omitempty"`
+	// allow_free_commission_decrease indicates whether a finality provider
+	// can lower its commission without being bound by
+	// max_commission_change_rate, which then only applies to increases.
+	AllowFreeCommissionDecrease bool `protobuf:"varint,19,opt,name=allow_free_commission_decrease,json=allowFreeCommissionDecrease,proto3" json:"allow_free_commission_decrease,omitempty"`
+	// max_inclusion_proof_header_skew is the maximum number of seconds by
+	// which the timestamp of the BTC header referenced by an inclusion proof
+	// can deviate from the timestamp of the BTC tip. It guards against
+	// inclusion proofs referencing headers with absurd timestamps. 0 disables
+	// the check.
+	MaxInclusionProofHeaderSkew uint32 `protobuf:"varint,20,opt,name=max_inclusion_proof_header_skew,json=maxInclusionProofHeaderSkew,proto3" json:"max_inclusion_proof_header_skew,omitempty"`
+	// covenant_quorum_deadline_blocks is the number of Babylon blocks after its
+	// creation within which a BTC delegation has to receive a covenant quorum.
+	// BTC delegations without a covenant quorum past the deadline are recorded
+	// as refundable for their staker. 0 disables the deadline.
+	CovenantQuorumDeadlineBlocks uint64 `protobuf:"varint,21,opt,name=covenant_quorum_deadline_blocks,json=covenantQuorumDeadlineBlocks,proto3" json:"covenant_quorum_deadline_blocks,omitempty"`
+	// delete_delegations_past_covenant_quorum_deadline indicates whether BTC
+	// delegations without a covenant quorum past the deadline are deleted.
+	// Only BTC delegations without an inclusion proof are deleted, as the ones
+	// with an inclusion proof are already scheduled to expire.
+	DeleteDelegationsPastCovenantQuorumDeadline bool `protobuf:"varint,22,opt,name=delete_delegations_past_covenant_quorum_deadline,json=deleteDelegationsPastCovenantQuorumDeadline,proto3" json:"delete_delegations_past_covenant_quorum_deadline,omitempty"`
+	// min_finality_provider_balance is the minimum bank balance that the
+	// Babylon account of a finality provider needs to hold upon registration.
+	// Unset or zero disables the requirement.
+	MinFinalityProviderBalance *types.Coin `protobuf:"bytes,23,opt,name=min_finality_provider_balance,json=minFinalityProviderBalance,proto3" json:"min_finality_provider_balance,omitempty"`
+	// min_edit_interval_blocks is the minimum number of Babylon blocks between
+	// two consecutive edits of the same finality provider. 0 disables the
+	// limit.
+	MinEditIntervalBlocks uint64 `protobuf:"varint,24,opt,name=min_edit_interval_blocks,json=minEditIntervalBlocks,proto3" json:"min_edit_interval_blocks,omitempty"`
+	// max_description_length is the maximum total length of all fields of the
+	// description of a finality provider. 0 disables the limit.
+	MaxDescriptionLength uint32 `protobuf:"varint,25,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
+	// max_moniker_length is the maximum length of the moniker of a finality
+	// provider. 0 falls back to the limit of the Cosmos SDK.
+	MaxMonikerLength uint32 `protobuf:"varint,26,opt,name=max_moniker_length,json=maxMonikerLength,proto3" json:"max_moniker_length,omitempty"`
+	// max_identity_length is the maximum length of the identity of a finality
+	// provider. 0 falls back to the limit of the Cosmos SDK.
+	MaxIdentityLength uint32 `protobuf:"varint,27,opt,name=max_identity_length,json=maxIdentityLength,proto3" json:"max_identity_length,omitempty"`
+	// max_website_length is the maximum length of the website of a finality
+	// provider. 0 falls back to the limit of the Cosmos SDK.
+	MaxWebsiteLength uint32 `protobuf:"varint,28,opt,name=max_website_length,json=maxWebsiteLength,proto3" json:"max_website_length,omitempty"`
+	// max_security_contact_length is the maximum length of the security
+	// contact of a finality provider. 0 falls back to the limit of the Cosmos
+	// SDK.
+	MaxSecurityContactLength uint32 `protobuf:"varint,29,opt,name=max_security_contact_length,json=maxSecurityContactLength,proto3" json:"max_security_contact_length,omitempty"`
+	// max_details_length is the maximum length of the details of a finality
+	// provider. 0 falls back to the limit of the Cosmos SDK.
+	MaxDetailsLength uint32 `protobuf:"varint,30,opt,name=max_details_length,json=maxDetailsLength,proto3" json:"max_details_length,omitempty"`
+	// max_covenant_sigs_per_block is the maximum number of covenant signature
+	// messages processed in a Babylon block, bounding the block execution time
+	// under a burst of covenant submissions. The messages are counted in the
+	// AnteHandler, so that failed messages consume the cap as well. Only
+	// messages signed on behalf of a covenant member are counted. Messages
+	// beyond the cap are rejected and can be resubmitted in a later block.
+	// 0 disables the cap.
+	MaxCovenantSigsPerBlock uint32 `protobuf:"varint,31,opt,name=max_covenant_sigs_per_block,json=maxCovenantSigsPerBlock,proto3" json:"max_covenant_sigs_per_block,omitempty"`
+	// max_commission_change_rate is the maximum change of the commission rate
+	// of a finality provider in a single edit, expressed as a decimal (e.g.,
+	// 0.01 for 1 percentage point). Unset or zero disables the limit. It
+	// cannot exceed 1 - min_commission_rate.
+	MaxCommissionChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,32,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -171,6 +266,182 @@ func (m *Params) GetDelegationCreationBaseGasFee() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinRetainedParamsVersions() uint32 {
+	if m != nil {
+		return m.MinRetainedParamsVersions
+	}
+	return 0
+}
+
+func (m *Params) GetSlashingDestinations() []SlashingDestination {
+	if m != nil {
+		return m.SlashingDestinations
+	}
+	return nil
+}
+
+func (m *Params) GetCovenantSigVerifyGasPerSig() uint64 {
+	if m != nil {
+		return m.CovenantSigVerifyGasPerSig
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveDelegationsPerStaker() uint32 {
+	if m != nil {
+		return m.MaxActiveDelegationsPerStaker
+	}
+	return 0
+}
+
+func (m *Params) GetAllowInclusionProofBeforeCovenantQuorum() bool {
+	if m != nil {
+		return m.AllowInclusionProofBeforeCovenantQuorum
+	}
+	return false
+}
+
+func (m *Params) GetAllowFreeCommissionDecrease() bool {
+	if m != nil {
+		return m.AllowFreeCommissionDecrease
+	}
+	return false
+}
+
+func (m *Params) GetMaxInclusionProofHeaderSkew() uint32 {
+	if m != nil {
+		return m.MaxInclusionProofHeaderSkew
+	}
+	return 0
+}
+
+func (m *Params) GetCovenantQuorumDeadlineBlocks() uint64 {
+	if m != nil {
+		return m.CovenantQuorumDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDeleteDelegationsPastCovenantQuorumDeadline() bool {
+	if m != nil {
+		return m.DeleteDelegationsPastCovenantQuorumDeadline
+	}
+	return false
+}
+
+func (m *Params) GetMinFinalityProviderBalance() *types.Coin {
+	if m != nil {
+		return m.MinFinalityProviderBalance
+	}
+	return nil
+}
+
+func (m *Params) GetMinEditIntervalBlocks() uint64 {
+	if m != nil {
+		return m.MinEditIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDescriptionLength() uint32 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMonikerLength() uint32 {
+	if m != nil {
+		return m.MaxMonikerLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIdentityLength() uint32 {
+	if m != nil {
+		return m.MaxIdentityLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxWebsiteLength() uint32 {
+	if m != nil {
+		return m.MaxWebsiteLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSecurityContactLength() uint32 {
+	if m != nil {
+		return m.MaxSecurityContactLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDetailsLength() uint32 {
+	if m != nil {
+		return m.MaxDetailsLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCovenantSigsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxCovenantSigsPerBlock
+	}
+	return 0
+}
+
+// SlashingDestination is an output of the slashing transaction receiving a
+// portion of the slashed funds
+type SlashingDestination struct {
+	// pk_script is the pk_script of the slashing output
+	PkScript []byte `protobuf:"bytes,1,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	// weight is the portion of the slashed funds sent to pk_script, expressed
+	// as a decimal (e.g., 0.5 for 50%)
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *SlashingDestination) Reset()         { *m = SlashingDestination{} }
+func (m *SlashingDestination) String() string { return proto.CompactTextString(m) }
+func (*SlashingDestination) ProtoMessage()    {}
+func (*SlashingDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1392776a3e15b9, []int{1}
+}
+func (m *SlashingDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingDestination.Merge(m, src)
+}
+func (m *SlashingDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingDestination proto.InternalMessageInfo
+
+func (m *SlashingDestination) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -178,13 +449,17 @@ type StoredParams struct {
 	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	// NOTE: Parameters must always be provided
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// activation_height is the Babylon block height at which the parameters
+	// were stored and became active. Parameters imported from genesis are
+	// active since the genesis height
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (m *StoredParams) Reset()         { *m = StoredParams{} }
 func (m *StoredParams) String() string { return proto.CompactTextString(m) }
 func (*StoredParams) ProtoMessage()    {}
 func (*StoredParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d1392776a3e15b9, []int{1}
+	return fileDescriptor_8d1392776a3e15b9, []int{2}
 }
 func (m *StoredParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,8 +502,16 @@ func (m *StoredParams) GetParams() Params {
 	return Params{}
 }
 
+func (m *StoredParams) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btcstaking.v1.Params")
+	proto.RegisterType((*SlashingDestination)(nil), "babylon.btcstaking.v1.SlashingDestination")
 	proto.RegisterType((*StoredParams)(nil), "babylon.btcstaking.v1.StoredParams")
 }
 
@@ -237,46 +520,85 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x63, 0x1a, 0x52, 0x3a, 0x4d, 0x29, 0x35, 0x2d, 0xb8, 0x05, 0x1c, 0xab, 0x2c, 0xb0,
-	0x10, 0xb5, 0x09, 0x2d, 0x12, 0x3f, 0x3b, 0xb7, 0x2a, 0x42, 0x20, 0x14, 0x9c, 0xd2, 0x05, 0x2c,
-	0xac, 0xb1, 0x33, 0xb8, 0x23, 0x7b, 0x3c, 0xc6, 0x33, 0x8e, 0x92, 0xb7, 0x60, 0xc9, 0x92, 0x25,
-	0x0f, 0xc0, 0x43, 0x74, 0x59, 0xb1, 0x42, 0x5d, 0x54, 0xa8, 0x79, 0x11, 0xe4, 0xf1, 0x38, 0xb1,
-	0xaa, 0x2e, 0xba, 0xf3, 0xcc, 0xb9, 0xe7, 0xde, 0xf3, 0x25, 0xbe, 0x06, 0x9b, 0x3e, 0xf4, 0xc7,
-	0x31, 0x4d, 0x6c, 0x9f, 0x07, 0x8c, 0xc3, 0x08, 0x27, 0xa1, 0x3d, 0xec, 0xda, 0x29, 0xcc, 0x20,
-	0x61, 0x56, 0x9a, 0x51, 0x4e, 0xd5, 0x35, 0x59, 0x63, 0xcd, 0x6a, 0xac, 0x61, 0x77, 0x63, 0x35,
-	0xa4, 0x21, 0x15, 0x15, 0x76, 0xf1, 0x54, 0x16, 0x6f, 0xac, 0x07, 0x94, 0x11, 0xca, 0xbc, 0x52,
-	0x28, 0x0f, 0xa5, 0xb4, 0xf9, 0xab, 0x05, 0x5a, 0x3d, 0xd1, 0x58, 0xfd, 0x02, 0xda, 0x01, 0x1d,
-	0xa2, 0x04, 0x26, 0xdc, 0x4b, 0x23, 0xa6, 0x29, 0xc6, 0x9c, 0xd9, 0x76, 0x5e, 0x9c, 0x9e, 0x75,
-	0x76, 0x42, 0xcc, 0x8f, 0x72, 0xdf, 0x0a, 0x28, 0xb1, 0xe5, 0xdc, 0x18, 0xfa, 0x6c, 0x0b, 0xd3,
-	0xea, 0x68, 0xf3, 0x71, 0x8a, 0x98, 0xe5, 0xbc, 0xed, 0x6d, 0xef, 0x3c, 0xed, 0xe5, 0xfe, 0x3b,
-	0x34, 0x76, 0x17, 0xab, 0x6e, 0xbd, 0x88, 0xa9, 0x8f, 0xc0, 0xf2, 0xb4, 0xf9, 0xb7, 0x9c, 0x66,
-	0x39, 0xd1, 0xae, 0x19, 0x8a, 0xb9, 0xe4, 0xde, 0xac, 0xae, 0x3f, 0x8a, 0x5b, 0xb5, 0x0b, 0xd6,
-	0x08, 0x4e, 0x3c, 0xc9, 0xe4, 0x0d, 0x61, 0x9c, 0x23, 0x8f, 0x41, 0xae, 0xcd, 0x19, 0x8a, 0x39,
-	0xe7, 0xaa, 0x04, 0x27, 0xfd, 0x52, 0x3b, 0x2c, 0xa4, 0x3e, 0xe4, 0xc2, 0x02, 0x47, 0x97, 0x58,
-	0x9a, 0xd2, 0x02, 0x47, 0x17, 0x2d, 0xcf, 0xc1, 0xdd, 0xfa, 0x14, 0x8e, 0x09, 0xf2, 0xfc, 0x98,
-	0x06, 0x11, 0xd3, 0xae, 0x8b, 0x58, 0xab, 0xb3, 0x39, 0x07, 0x98, 0x20, 0x47, 0x68, 0xc2, 0x56,
-	0x9b, 0x54, 0xb7, 0xb5, 0xa4, 0x6d, 0x3a, 0xab, 0x66, 0x7b, 0x02, 0x54, 0x16, 0x43, 0x76, 0x54,
-	0x78, 0xd2, 0xc8, 0x63, 0x41, 0x86, 0x53, 0xae, 0xcd, 0x1b, 0x8a, 0xd9, 0x76, 0x6f, 0x55, 0x4a,
-	0x2f, 0xea, 0x8b, 0x7b, 0x75, 0x47, 0x66, 0xab, 0x1c, 0x7c, 0xe4, 0x7d, 0x45, 0x25, 0xd0, 0x0d,
-	0x01, 0x74, 0xbb, 0xc8, 0x26, 0xd5, 0x83, 0xd1, 0x3e, 0x12, 0x44, 0x87, 0x60, 0x69, 0xea, 0xc8,
-	0x20, 0x47, 0xda, 0x82, 0xa1, 0x98, 0x0b, 0x4e, 0xf7, 0xf8, 0xac, 0xd3, 0x38, 0x3d, 0xeb, 0xdc,
-	0x2b, 0xff, 0x75, 0x36, 0x88, 0x2c, 0x4c, 0x6d, 0x02, 0xf9, 0x91, 0xf5, 0x1e, 0x85, 0x30, 0x18,
-	0xef, 0xa1, 0xe0, 0xcf, 0xef, 0x2d, 0x20, 0x5f, 0x8a, 0x3d, 0x14, 0xb8, 0xed, 0xaa, 0x8f, 0x0b,
-	0x39, 0x52, 0x5f, 0x82, 0xf5, 0x22, 0x4d, 0x9e, 0xf8, 0x34, 0x19, 0x5c, 0x84, 0x06, 0x02, 0xfa,
-	0x0e, 0xc1, 0xc9, 0xa7, 0x4a, 0xaf, 0x61, 0x3f, 0x06, 0x2b, 0x33, 0x5b, 0x85, 0xb0, 0x28, 0x10,
-	0x96, 0xa7, 0x82, 0x8c, 0xdf, 0x07, 0x05, 0x95, 0x17, 0x50, 0x42, 0x30, 0x63, 0x98, 0x26, 0x25,
-	0x44, 0x5b, 0x40, 0x3c, 0xbc, 0x02, 0x84, 0xbb, 0x42, 0x70, 0xb2, 0x3b, 0xb5, 0x8b, 0xec, 0xfb,
-	0xc0, 0x18, 0xa0, 0x18, 0x85, 0x90, 0x17, 0x0d, 0x83, 0x0c, 0x95, 0x0f, 0x3e, 0x64, 0xc8, 0x0b,
-	0x21, 0x2b, 0x32, 0x69, 0x4b, 0x86, 0x62, 0x36, 0xdd, 0xfb, 0xb3, 0xba, 0x5d, 0x59, 0xe6, 0x40,
-	0x86, 0xde, 0x40, 0xb6, 0x8f, 0xd0, 0xab, 0xe6, 0x8f, 0x9f, 0x9d, 0xc6, 0x26, 0x02, 0xed, 0x3e,
-	0xa7, 0x19, 0x1a, 0xc8, 0x7d, 0xd1, 0xc0, 0xfc, 0x10, 0x65, 0xc5, 0x30, 0x4d, 0x11, 0xbf, 0x43,
-	0x75, 0x54, 0x5f, 0x83, 0x56, 0xb9, 0xac, 0xe2, 0x1d, 0x5f, 0x7c, 0xf6, 0xc0, 0xba, 0x74, 0x5b,
-	0xad, 0xb2, 0x91, 0xd3, 0x2c, 0xf0, 0x5c, 0x69, 0x71, 0x3e, 0x1c, 0x9f, 0xeb, 0xca, 0xc9, 0xb9,
-	0xae, 0xfc, 0x3b, 0xd7, 0x95, 0xef, 0x13, 0xbd, 0x71, 0x32, 0xd1, 0x1b, 0x7f, 0x27, 0x7a, 0xe3,
-	0xf3, 0x15, 0xd6, 0x70, 0x54, 0xff, 0x66, 0x88, 0x9d, 0xf4, 0x5b, 0x62, 0xd1, 0xb7, 0xff, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0xd8, 0x41, 0xc8, 0x56, 0x04, 0x00, 0x00,
+	// 1246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xb6, 0x62, 0xd7, 0x49, 0xd6, 0x76, 0x62, 0xd3, 0x76, 0x42, 0xdb, 0xb1, 0xa4, 0xba, 0x87,
+	0x08, 0xf9, 0xa1, 0xe2, 0xc4, 0x45, 0xff, 0x51, 0x54, 0x52, 0x9c, 0x18, 0x4d, 0x0b, 0x95, 0x4a,
+	0x5d, 0xa0, 0x3f, 0x20, 0x96, 0xe4, 0x98, 0xda, 0x8a, 0xdc, 0x55, 0xb9, 0x2b, 0x59, 0x3e, 0xf4,
+	0x1d, 0x8a, 0x9e, 0x7a, 0xec, 0x43, 0xf4, 0x21, 0x72, 0x0c, 0x7a, 0x2a, 0x72, 0x08, 0x8a, 0xe4,
+	0xd0, 0xd7, 0x28, 0x76, 0x76, 0x29, 0xd9, 0x8e, 0x03, 0xa4, 0xbd, 0x91, 0xfc, 0xbe, 0x6f, 0x66,
+	0xbe, 0xdd, 0x9d, 0x59, 0x92, 0xad, 0x90, 0x86, 0x47, 0xa9, 0xe0, 0xf5, 0x50, 0x45, 0x52, 0xd1,
+	0x1e, 0xe3, 0x49, 0x7d, 0xb8, 0x5d, 0xef, 0xd3, 0x9c, 0x66, 0xd2, 0xeb, 0xe7, 0x42, 0x09, 0x67,
+	0xd5, 0x72, 0xbc, 0x09, 0xc7, 0x1b, 0x6e, 0xaf, 0xaf, 0x24, 0x22, 0x11, 0xc8, 0xa8, 0xeb, 0x27,
+	0x43, 0x5e, 0x5f, 0x8b, 0x84, 0xcc, 0x84, 0x0c, 0x0c, 0x60, 0x5e, 0x2c, 0x54, 0x36, 0x6f, 0xf5,
+	0x90, 0x4a, 0xa8, 0x0f, 0xb7, 0x43, 0x50, 0x74, 0xbb, 0x1e, 0x09, 0xc6, 0x0d, 0xbe, 0xf5, 0xcf,
+	0x22, 0x99, 0x6d, 0x63, 0x62, 0xe7, 0x3b, 0x32, 0x1f, 0x89, 0x21, 0x70, 0xca, 0x55, 0xd0, 0xef,
+	0x49, 0xb7, 0x54, 0x9d, 0xae, 0xcd, 0x37, 0xde, 0x7f, 0xf6, 0xbc, 0xb2, 0x93, 0x30, 0xd5, 0x1d,
+	0x84, 0x5e, 0x24, 0xb2, 0xba, 0xad, 0x2b, 0xa5, 0xa1, 0xbc, 0xcd, 0x44, 0xf1, 0x5a, 0x57, 0x47,
+	0x7d, 0x90, 0x5e, 0x63, 0xaf, 0x7d, 0x6f, 0xe7, 0x4e, 0x7b, 0x10, 0x7e, 0x0e, 0x47, 0xfe, 0x5c,
+	0x11, 0xad, 0xdd, 0x93, 0xce, 0x75, 0x72, 0x79, 0x1c, 0xfc, 0xa7, 0x81, 0xc8, 0x07, 0x99, 0x7b,
+	0xae, 0x5a, 0xaa, 0x2d, 0xf8, 0x97, 0x8a, 0xcf, 0x5f, 0xe1, 0x57, 0x67, 0x9b, 0xac, 0x66, 0x8c,
+	0x07, 0xd6, 0x73, 0x30, 0xa4, 0xe9, 0x00, 0x02, 0x49, 0x95, 0x3b, 0x5d, 0x2d, 0xd5, 0xa6, 0x7d,
+	0x27, 0x63, 0xbc, 0x63, 0xb0, 0x7d, 0x0d, 0x75, 0xa8, 0x42, 0x09, 0x1d, 0x9d, 0x21, 0x99, 0xb1,
+	0x12, 0x3a, 0x3a, 0x2d, 0x79, 0x97, 0x5c, 0x3d, 0x9e, 0x45, 0xb1, 0x0c, 0x82, 0x30, 0x15, 0x51,
+	0x4f, 0xba, 0x6f, 0x61, 0x59, 0x2b, 0x93, 0x3c, 0x8f, 0x59, 0x06, 0x0d, 0xc4, 0x50, 0x46, 0x47,
+	0x67, 0xca, 0x66, 0xad, 0x8c, 0x8e, 0x5e, 0x95, 0xdd, 0x22, 0x8e, 0x4c, 0xa9, 0xec, 0x6a, 0x4d,
+	0xbf, 0x17, 0xc8, 0x28, 0x67, 0x7d, 0xe5, 0x9e, 0xaf, 0x96, 0x6a, 0xf3, 0xfe, 0x62, 0x81, 0xb4,
+	0x7b, 0x1d, 0xfc, 0xee, 0xec, 0xd8, 0xda, 0x0a, 0x85, 0x1a, 0x05, 0x07, 0x60, 0x0c, 0x5d, 0x40,
+	0x43, 0xcb, 0xba, 0x36, 0x8b, 0x3e, 0x1e, 0xed, 0x02, 0x3a, 0xda, 0x27, 0x0b, 0x63, 0x45, 0x4e,
+	0x15, 0xb8, 0x17, 0xab, 0xa5, 0xda, 0xc5, 0xc6, 0xf6, 0x93, 0xe7, 0x95, 0xa9, 0x67, 0xcf, 0x2b,
+	0x1b, 0xe6, 0x1c, 0xc8, 0xb8, 0xe7, 0x31, 0x51, 0xcf, 0xa8, 0xea, 0x7a, 0x8f, 0x20, 0xa1, 0xd1,
+	0x51, 0x0b, 0xa2, 0x3f, 0xff, 0xb8, 0x4d, 0xec, 0xa1, 0x69, 0x41, 0xe4, 0xcf, 0x17, 0x71, 0x7c,
+	0xaa, 0xc0, 0xf9, 0x80, 0xac, 0xe9, 0x6a, 0x06, 0x3c, 0x14, 0x3c, 0x3e, 0x6d, 0x9a, 0xa0, 0xe9,
+	0x2b, 0x19, 0xe3, 0x5f, 0x17, 0xf8, 0x31, 0xdb, 0x37, 0xc8, 0xd2, 0x44, 0x56, 0x58, 0x98, 0x43,
+	0x0b, 0x97, 0xc7, 0x80, 0x2d, 0xbf, 0x43, 0xb4, 0xab, 0x20, 0x12, 0x59, 0xc6, 0xa4, 0x64, 0x82,
+	0x1b, 0x13, 0xf3, 0x68, 0xe2, 0x9d, 0x37, 0x30, 0xe1, 0x2f, 0x65, 0x8c, 0x37, 0xc7, 0x72, 0xac,
+	0x7d, 0x97, 0x54, 0x63, 0x48, 0x21, 0xa1, 0x4a, 0x07, 0x8c, 0x72, 0x30, 0x0f, 0xba, 0x17, 0x82,
+	0x84, 0x4a, 0x5d, 0x93, 0xbb, 0x50, 0x2d, 0xd5, 0x66, 0xfc, 0x6b, 0x13, 0x5e, 0xd3, 0xd2, 0x1a,
+	0x54, 0xc2, 0x03, 0x2a, 0x77, 0x01, 0x9c, 0x4f, 0xc9, 0x35, 0x5d, 0x5c, 0x0e, 0x8a, 0x32, 0x0e,
+	0x71, 0x60, 0x3a, 0x35, 0x18, 0x42, 0xae, 0x53, 0x49, 0xf7, 0x12, 0x2e, 0x83, 0x5e, 0x27, 0xdf,
+	0x52, 0x4c, 0x4b, 0xed, 0x5b, 0x82, 0x03, 0x64, 0x75, 0xbc, 0x39, 0x31, 0x48, 0xc5, 0x38, 0xa6,
+	0x90, 0xee, 0xe5, 0xea, 0x74, 0x6d, 0xee, 0xee, 0x0d, 0xef, 0xcc, 0x6e, 0xf7, 0x8a, 0x4d, 0x6e,
+	0x4d, 0x24, 0x8d, 0x19, 0xbd, 0x16, 0xfe, 0x8a, 0x7c, 0x15, 0x92, 0x4e, 0x93, 0x54, 0xc6, 0x4d,
+	0x26, 0x59, 0xa2, 0x0b, 0x64, 0x07, 0x47, 0x68, 0xb5, 0x0f, 0xb9, 0xfe, 0xe4, 0x2e, 0xa2, 0xdd,
+	0xf5, 0x82, 0xd6, 0x61, 0xc9, 0x3e, 0x92, 0x1e, 0x50, 0xd9, 0x86, 0xbc, 0xc3, 0x12, 0xe7, 0x21,
+	0x79, 0x5b, 0x9f, 0x71, 0x1a, 0x29, 0x36, 0x84, 0x60, 0xb2, 0x2e, 0x36, 0x86, 0xa2, 0x3d, 0xc8,
+	0xdd, 0x25, 0x74, 0xbc, 0x99, 0xd1, 0xd1, 0x67, 0xc8, 0x6b, 0x4d, 0x68, 0x3a, 0x0c, 0x92, 0x9c,
+	0x1f, 0xc8, 0x2d, 0x9a, 0xa6, 0xe2, 0x30, 0x60, 0x3c, 0x4a, 0x07, 0xb8, 0xa9, 0xfd, 0x5c, 0x88,
+	0x83, 0x20, 0x84, 0x03, 0x91, 0x43, 0x70, 0x7a, 0x20, 0x38, 0xd5, 0x52, 0xed, 0x82, 0x7f, 0x1d,
+	0x35, 0x7b, 0x85, 0xa4, 0xad, 0x15, 0x0d, 0x14, 0x34, 0x4f, 0x4e, 0x8a, 0x26, 0x29, 0x9b, 0xf0,
+	0x07, 0x39, 0xc0, 0xf1, 0x93, 0x13, 0x83, 0xde, 0x6a, 0x09, 0xee, 0x32, 0x06, 0xdc, 0x40, 0xd6,
+	0x6e, 0x0e, 0x30, 0x39, 0x1e, 0x2d, 0x4b, 0x71, 0x5a, 0xa4, 0xa2, 0xdd, 0x9e, 0xae, 0xb0, 0x0b,
+	0x34, 0xd6, 0x6e, 0x7b, 0x70, 0xe8, 0xae, 0xa0, 0xd7, 0x8d, 0x8c, 0x8e, 0x4e, 0x16, 0xf5, 0x10,
+	0x39, 0x9d, 0x1e, 0x1c, 0x3a, 0xf7, 0x49, 0xe5, 0x94, 0x99, 0x20, 0x06, 0x1a, 0xa7, 0x8c, 0x8f,
+	0x5b, 0x65, 0xd5, 0x9c, 0xb3, 0x93, 0xd3, 0xae, 0x65, 0x49, 0xb6, 0x61, 0x80, 0xdc, 0xd1, 0xeb,
+	0xad, 0x4e, 0x2d, 0x3b, 0x95, 0x2a, 0x78, 0x5d, 0x78, 0xf7, 0x0a, 0x7a, 0xbc, 0x69, 0x74, 0xc7,
+	0xb7, 0x81, 0x4a, 0xd5, 0x3c, 0x33, 0x99, 0xf3, 0x3d, 0xd9, 0xd4, 0xc7, 0xf9, 0x80, 0x71, 0x9a,
+	0x32, 0x75, 0xa4, 0x2d, 0x0f, 0x99, 0xb6, 0x1b, 0xd2, 0x94, 0xf2, 0x08, 0xdc, 0xab, 0xd5, 0x52,
+	0x6d, 0xee, 0xee, 0x9a, 0x67, 0x87, 0x82, 0xee, 0x17, 0xcf, 0xde, 0x1d, 0x5e, 0x53, 0x30, 0xee,
+	0xaf, 0x67, 0x8c, 0xef, 0x5a, 0x79, 0xdb, 0xaa, 0x1b, 0x46, 0xec, 0xbc, 0x47, 0x5c, 0x1d, 0x1d,
+	0x62, 0xa6, 0x02, 0xc6, 0x15, 0xe4, 0x43, 0x9a, 0x16, 0x8b, 0xe0, 0xe2, 0x22, 0xe8, 0x01, 0x7f,
+	0x3f, 0x66, 0x6a, 0xcf, 0xa2, 0xd6, 0xfd, 0x0e, 0xb9, 0xa2, 0xb7, 0x22, 0x06, 0x33, 0x1f, 0xf5,
+	0x66, 0xa4, 0xc0, 0x13, 0xd5, 0x75, 0xd7, 0xc6, 0xb3, 0xb5, 0x35, 0x01, 0x1f, 0x21, 0xa6, 0x67,
+	0xab, 0x56, 0x65, 0x82, 0xb3, 0x1e, 0xe4, 0x85, 0x62, 0x1d, 0x15, 0x8b, 0x19, 0x1d, 0x7d, 0x61,
+	0x00, 0xcb, 0xf6, 0xc8, 0x32, 0x6e, 0x77, 0x0c, 0x5c, 0x69, 0xeb, 0x96, 0xbe, 0x81, 0xf4, 0x25,
+	0xbd, 0xc5, 0x16, 0x39, 0x19, 0xfd, 0x10, 0x42, 0xc9, 0x14, 0x14, 0xf4, 0x6b, 0xe3, 0xe8, 0xdf,
+	0x18, 0xc0, 0xb2, 0x3f, 0x21, 0xfa, 0x94, 0x04, 0x12, 0xa2, 0x41, 0xae, 0xa3, 0x47, 0x82, 0x2b,
+	0x1a, 0xa9, 0x42, 0xb6, 0x89, 0x32, 0x57, 0x5f, 0x11, 0x96, 0xd1, 0x34, 0x84, 0x93, 0xc9, 0x62,
+	0x50, 0x94, 0xa5, 0xb2, 0x50, 0x95, 0xc7, 0xc9, 0x5a, 0x06, 0xb0, 0xec, 0x8f, 0x4d, 0xb2, 0xe3,
+	0x0d, 0x6f, 0x5a, 0x14, 0xd7, 0xda, 0xad, 0xa0, 0x4c, 0x5f, 0x57, 0xcd, 0x49, 0xaf, 0xeb, 0xe6,
+	0xc4, 0xd5, 0x76, 0x7e, 0x24, 0xeb, 0x46, 0x3d, 0xee, 0x9a, 0xa8, 0x4b, 0x79, 0x02, 0x66, 0xec,
+	0x56, 0x71, 0xec, 0xde, 0xfe, 0x6f, 0xf7, 0x86, 0xc9, 0x55, 0xc4, 0x6b, 0x62, 0x38, 0x3d, 0x86,
+	0x3f, 0x9c, 0xf9, 0xed, 0xf7, 0xca, 0xd4, 0xd6, 0xcf, 0x64, 0xf9, 0x8c, 0x79, 0xe6, 0x6c, 0x90,
+	0x8b, 0x93, 0x2b, 0xb1, 0x84, 0x57, 0xe2, 0x85, 0x7e, 0x71, 0x15, 0xee, 0x91, 0xd9, 0x43, 0x60,
+	0x49, 0x57, 0xb9, 0xe7, 0xfe, 0xef, 0x6d, 0x66, 0x03, 0x6c, 0xfd, 0x5a, 0x22, 0xf3, 0x1d, 0x25,
+	0xf2, 0x62, 0x36, 0x3b, 0x2e, 0x39, 0x6f, 0x07, 0x38, 0xa6, 0x5d, 0xf0, 0x8b, 0x57, 0xe7, 0x23,
+	0x32, 0x6b, 0x26, 0x3c, 0x66, 0x9d, 0xbb, 0xbb, 0xf9, 0x9a, 0xf1, 0x6c, 0x02, 0xd9, 0x89, 0x6c,
+	0x25, 0xce, 0x4d, 0xb2, 0x84, 0xa3, 0xd3, 0x5c, 0x35, 0x5d, 0x53, 0xfd, 0x34, 0x9e, 0xfb, 0xc5,
+	0x09, 0xf0, 0x10, 0xbf, 0x37, 0xbe, 0x7c, 0xf2, 0xa2, 0x5c, 0x7a, 0xfa, 0xa2, 0x5c, 0xfa, 0xfb,
+	0x45, 0xb9, 0xf4, 0xcb, 0xcb, 0xf2, 0xd4, 0xd3, 0x97, 0xe5, 0xa9, 0xbf, 0x5e, 0x96, 0xa7, 0xbe,
+	0x7d, 0x83, 0x5f, 0xae, 0xd1, 0xf1, 0xff, 0x47, 0xfc, 0xff, 0x0a, 0x67, 0xf1, 0xa7, 0xee, 0xde,
+	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xc7, 0x75, 0x93, 0x62, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -299,6 +621,173 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCommissionChangeRate != nil {
+		{
+			size := m.MaxCommissionChangeRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxCovenantSigsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCovenantSigsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxDetailsLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDetailsLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxSecurityContactLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSecurityContactLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.MaxWebsiteLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWebsiteLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxIdentityLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIdentityLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxMonikerLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMonikerLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxDescriptionLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDescriptionLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MinEditIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinEditIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MinFinalityProviderBalance != nil {
+		{
+			size, err := m.MinFinalityProviderBalance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.DeleteDelegationsPastCovenantQuorumDeadline {
+		i--
+		if m.DeleteDelegationsPastCovenantQuorumDeadline {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.CovenantQuorumDeadlineBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CovenantQuorumDeadlineBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxInclusionProofHeaderSkew != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxInclusionProofHeaderSkew))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.AllowFreeCommissionDecrease {
+		i--
+		if m.AllowFreeCommissionDecrease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AllowInclusionProofBeforeCovenantQuorum {
+		i--
+		if m.AllowInclusionProofBeforeCovenantQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxActiveDelegationsPerStaker != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveDelegationsPerStaker))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.CovenantSigVerifyGasPerSig != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CovenantSigVerifyGasPerSig))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.SlashingDestinations) > 0 {
+		for iNdEx := len(m.SlashingDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.MinRetainedParamsVersions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinRetainedParamsVersions))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.DelegationCreationBaseGasFee != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DelegationCreationBaseGasFee))
 		i--
@@ -388,6 +877,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PkScript) > 0 {
+		i -= len(m.PkScript)
+		copy(dAtA[i:], m.PkScript)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PkScript)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StoredParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -408,6 +937,11 @@ func (m *StoredParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -484,26 +1018,106 @@ func (m *Params) Size() (n int) {
 	if m.DelegationCreationBaseGasFee != 0 {
 		n += 1 + sovParams(uint64(m.DelegationCreationBaseGasFee))
 	}
+	if m.MinRetainedParamsVersions != 0 {
+		n += 1 + sovParams(uint64(m.MinRetainedParamsVersions))
+	}
+	if len(m.SlashingDestinations) > 0 {
+		for _, e := range m.SlashingDestinations {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CovenantSigVerifyGasPerSig != 0 {
+		n += 2 + sovParams(uint64(m.CovenantSigVerifyGasPerSig))
+	}
+	if m.MaxActiveDelegationsPerStaker != 0 {
+		n += 2 + sovParams(uint64(m.MaxActiveDelegationsPerStaker))
+	}
+	if m.AllowInclusionProofBeforeCovenantQuorum {
+		n += 3
+	}
+	if m.AllowFreeCommissionDecrease {
+		n += 3
+	}
+	if m.MaxInclusionProofHeaderSkew != 0 {
+		n += 2 + sovParams(uint64(m.MaxInclusionProofHeaderSkew))
+	}
+	if m.CovenantQuorumDeadlineBlocks != 0 {
+		n += 2 + sovParams(uint64(m.CovenantQuorumDeadlineBlocks))
+	}
+	if m.DeleteDelegationsPastCovenantQuorumDeadline {
+		n += 3
+	}
+	if m.MinFinalityProviderBalance != nil {
+		l = m.MinFinalityProviderBalance.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MinEditIntervalBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MinEditIntervalBlocks))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxDescriptionLength))
+	}
+	if m.MaxMonikerLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxMonikerLength))
+	}
+	if m.MaxIdentityLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxIdentityLength))
+	}
+	if m.MaxWebsiteLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxWebsiteLength))
+	}
+	if m.MaxSecurityContactLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxSecurityContactLength))
+	}
+	if m.MaxDetailsLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxDetailsLength))
+	}
+	if m.MaxCovenantSigsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxCovenantSigsPerBlock))
+	}
+	if m.MaxCommissionChangeRate != nil {
+		l = m.MaxCommissionChangeRate.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
-func (m *StoredParams) Size() (n int) {
+func (m *SlashingDestination) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovParams(uint64(m.Version))
+	l = len(m.PkScript)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
 	}
-	l = m.Params.Size()
+	l = m.Weight.Size()
 	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *StoredParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovParams(uint64(m.Version))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -550,32 +1164,396 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.CovenantPks = append(m.CovenantPks, v)
+			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingValueSat", wireType)
+			}
+			m.MinStakingValueSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStakingValueSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingValueSat", wireType)
+			}
+			m.MaxStakingValueSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakingValueSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingTimeBlocks", wireType)
+			}
+			m.MinStakingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStakingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingTimeBlocks", wireType)
+			}
+			m.MaxStakingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPkScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingPkScript = append(m.SlashingPkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlashingPkScript == nil {
+				m.SlashingPkScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSlashingTxFeeSat", wireType)
+			}
+			m.MinSlashingTxFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSlashingTxFeeSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingTimeBlocks", wireType)
+			}
+			m.MinUnbondingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnbondingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFeeSat", wireType)
+			}
+			m.UnbondingFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFeeSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCreationBaseGasFee", wireType)
+			}
+			m.DelegationCreationBaseGasFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationCreationBaseGasFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRetainedParamsVersions", wireType)
+			}
+			m.MinRetainedParamsVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRetainedParamsVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingDestinations = append(m.SlashingDestinations, SlashingDestination{})
+			if err := m.SlashingDestinations[len(m.SlashingDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigVerifyGasPerSig", wireType)
+			}
+			m.CovenantSigVerifyGasPerSig = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantSigVerifyGasPerSig |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveDelegationsPerStaker", wireType)
+			}
+			m.MaxActiveDelegationsPerStaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveDelegationsPerStaker |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.CovenantPks = append(m.CovenantPks, v)
-			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInclusionProofBeforeCovenantQuorum", wireType)
 			}
-			m.CovenantQuorum = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -585,16 +1563,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.AllowInclusionProofBeforeCovenantQuorum = bool(v != 0)
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingValueSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowFreeCommissionDecrease", wireType)
 			}
-			m.MinStakingValueSat = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -604,16 +1583,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStakingValueSat |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.AllowFreeCommissionDecrease = bool(v != 0)
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingValueSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInclusionProofHeaderSkew", wireType)
 			}
-			m.MaxStakingValueSat = 0
+			m.MaxInclusionProofHeaderSkew = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -623,16 +1603,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxStakingValueSat |= int64(b&0x7F) << shift
+				m.MaxInclusionProofHeaderSkew |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingTimeBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorumDeadlineBlocks", wireType)
 			}
-			m.MinStakingTimeBlocks = 0
+			m.CovenantQuorumDeadlineBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -642,16 +1622,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStakingTimeBlocks |= uint32(b&0x7F) << shift
+				m.CovenantQuorumDeadlineBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingTimeBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelegationsPastCovenantQuorumDeadline", wireType)
 			}
-			m.MaxStakingTimeBlocks = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -661,16 +1641,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxStakingTimeBlocks |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.DeleteDelegationsPastCovenantQuorumDeadline = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPkScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFinalityProviderBalance", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -680,31 +1661,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingPkScript = append(m.SlashingPkScript[:0], dAtA[iNdEx:postIndex]...)
-			if m.SlashingPkScript == nil {
-				m.SlashingPkScript = []byte{}
+			if m.MinFinalityProviderBalance == nil {
+				m.MinFinalityProviderBalance = &types.Coin{}
+			}
+			if err := m.MinFinalityProviderBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSlashingTxFeeSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEditIntervalBlocks", wireType)
 			}
-			m.MinSlashingTxFeeSat = 0
+			m.MinEditIntervalBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -714,16 +1697,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSlashingTxFeeSat |= int64(b&0x7F) << shift
+				m.MinEditIntervalBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
 			}
-			var stringLen uint64
+			m.MaxDescriptionLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -733,31 +1716,54 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxDescriptionLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonikerLength", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			m.MaxMonikerLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMonikerLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIdentityLength", wireType)
 			}
-			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxIdentityLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIdentityLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingTimeBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWebsiteLength", wireType)
 			}
-			m.MinUnbondingTimeBlocks = 0
+			m.MaxWebsiteLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -767,16 +1773,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinUnbondingTimeBlocks |= uint32(b&0x7F) << shift
+				m.MaxWebsiteLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFeeSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSecurityContactLength", wireType)
 			}
-			m.UnbondingFeeSat = 0
+			m.MaxSecurityContactLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -786,14 +1792,52 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingFeeSat |= int64(b&0x7F) << shift
+				m.MaxSecurityContactLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDetailsLength", wireType)
+			}
+			m.MaxDetailsLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDetailsLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCovenantSigsPerBlock", wireType)
+			}
+			m.MaxCovenantSigsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCovenantSigsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -821,15 +1865,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionChangeRate = &v
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCreationBaseGasFee", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DelegationCreationBaseGasFee = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkScript", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -839,11 +1935,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationCreationBaseGasFee |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkScript = append(m.PkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PkScript == nil {
+				m.PkScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -946,6 +2091,25 @@ func (m *StoredParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,312 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func TestParamsValidateCovenantCommittee(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		mutate func(p *types.Params)
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			mutate: func(p *types.Params) {},
+			valid:  true,
+		},
+		{
+			desc: "empty covenant committee",
+			mutate: func(p *types.Params) {
+				p.CovenantPks = []bbn.BIP340PubKey{}
+			},
+			valid: false,
+		},
+		{
+			desc: "covenant quorum larger than the covenant committee",
+			mutate: func(p *types.Params) {
+				p.CovenantPks = p.CovenantPks[:1]
+				p.CovenantQuorum = 2
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate covenant key",
+			mutate: func(p *types.Params) {
+				p.CovenantPks[1] = p.CovenantPks[0]
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid covenant key",
+			mutate: func(p *types.Params) {
+				p.CovenantPks[0] = bbn.BIP340PubKey(make([]byte, bbn.BIP340PubKeyLen))
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.mutate(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateDuplicateCovenantPk(t *testing.T) {
+	params := types.DefaultParams()
+	dupPK := params.CovenantPks[2]
+	params.CovenantPks = append(params.CovenantPks, dupPK)
+	params.CovenantQuorum = uint32(len(params.CovenantPks))
+
+	err := params.Validate()
+	require.ErrorContains(t, err, "duplicate covenant key "+dupPK.MarshalHex())
+}
+
+func TestParamsValidateMinStakingTime(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinUnbondingTimeBlocks = 100
+
+	params.MinStakingTimeBlocks = params.MinUnbondingTimeBlocks
+	require.Error(t, params.Validate())
+
+	params.MinStakingTimeBlocks = params.MinUnbondingTimeBlocks + 1
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidateMinFinalityProviderBalance(t *testing.T) {
+	params := types.DefaultParams()
+	require.Nil(t, params.MinFinalityProviderBalance)
+	require.NoError(t, params.Validate())
+
+	params.MinFinalityProviderBalance = &sdk.Coin{Denom: "ubbn", Amount: sdkmath.NewInt(-1)}
+	require.Error(t, params.Validate())
+
+	params.MinFinalityProviderBalance = &sdk.Coin{Denom: "ubbn", Amount: sdkmath.NewInt(1000)}
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidateDescriptionLimits(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	// limits up to the ones of the Cosmos SDK are valid
+	params.MaxMonikerLength = stakingtypes.MaxMonikerLength
+	params.MaxDescriptionLength = stakingtypes.MaxMonikerLength + stakingtypes.MaxIdentityLength +
+		stakingtypes.MaxWebsiteLength + stakingtypes.MaxSecurityContactLength + stakingtypes.MaxDetailsLength
+	require.NoError(t, params.Validate())
+
+	// limits beyond them are not
+	params.MaxMonikerLength = stakingtypes.MaxMonikerLength + 1
+	require.Error(t, params.Validate())
+	params.MaxMonikerLength = 0
+	params.MaxDescriptionLength++
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidateDescriptionLength(t *testing.T) {
+	desc := &stakingtypes.Description{Moniker: "moniker", Details: "details"}
+
+	// the default params do not limit the description further
+	params := types.DefaultParams()
+	require.NoError(t, params.ValidateDescriptionLength(desc))
+
+	// a field limit is enforced on its field only
+	params.MaxDetailsLength = 6
+	require.ErrorIs(t, params.ValidateDescriptionLength(desc), types.ErrDescriptionTooLong)
+	params.MaxDetailsLength = 7
+	require.NoError(t, params.ValidateDescriptionLength(desc))
+
+	// the total limit is enforced on all fields combined
+	params.MaxDescriptionLength = 13
+	require.ErrorIs(t, params.ValidateDescriptionLength(desc), types.ErrDescriptionTooLong)
+	params.MaxDescriptionLength = 14
+	require.NoError(t, params.ValidateDescriptionLength(desc))
+}
+
+func TestParamsValidateMinCommissionRate(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		rate  sdkmath.LegacyDec
+		valid bool
+	}{
+		{
+			desc:  "zero",
+			rate:  sdkmath.LegacyZeroDec(),
+			valid: true,
+		},
+		{
+			desc:  "one",
+			rate:  sdkmath.LegacyOneDec(),
+			valid: true,
+		},
+		{
+			desc:  "within range",
+			rate:  sdkmath.LegacyMustNewDecFromStr("0.05"),
+			valid: true,
+		},
+		{
+			desc:  "negative",
+			rate:  sdkmath.LegacyMustNewDecFromStr("-0.000000000000000001"),
+			valid: false,
+		},
+		{
+			desc:  "larger than one",
+			rate:  sdkmath.LegacyMustNewDecFromStr("1.000000000000000001"),
+			valid: false,
+		},
+		{
+			desc:  "nil",
+			rate:  sdkmath.LegacyDec{},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MinCommissionRate = tc.rate
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			// the genesis state is validated against the same bounds
+			gs := types.DefaultGenesis()
+			gs.Params[0] = &params
+			err = gs.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateMaxCommissionChangeRate(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		rate  *sdkmath.LegacyDec
+		valid bool
+	}{
+		{
+			desc:  "unset",
+			rate:  nil,
+			valid: true,
+		},
+		{
+			desc:  "zero",
+			rate:  decPtr("0"),
+			valid: true,
+		},
+		{
+			desc:  "one",
+			rate:  decPtr("1"),
+			valid: true,
+		},
+		{
+			desc:  "negative",
+			rate:  decPtr("-0.01"),
+			valid: false,
+		},
+		{
+			desc:  "larger than one",
+			rate:  decPtr("1.01"),
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxCommissionChangeRate = tc.rate
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateCommissionRates(t *testing.T) {
+	dec := sdkmath.LegacyMustNewDecFromStr
+	for _, tc := range []struct {
+		desc          string
+		minRate       sdkmath.LegacyDec
+		maxChangeRate *sdkmath.LegacyDec
+		valid         bool
+	}{
+		{"change rate unset", dec("1"), nil, true},
+		{"change rate disabled", dec("1"), decPtr("0"), true},
+		{"change rate within range", dec("0.05"), decPtr("0.5"), true},
+		{"change rate spanning the range", dec("0.05"), decPtr("0.95"), true},
+		{"change rate beyond range", dec("0.05"), decPtr("0.96"), false},
+		{"change rate with fixed commission", dec("1"), decPtr("0.01"), false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MinCommissionRate = tc.minRate
+			params.MaxCommissionChangeRate = tc.maxChangeRate
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrMaxCommissionChangeTooLarge)
+			}
+		})
+	}
+}
+
+func TestParamsValidateCommissionChange(t *testing.T) {
+	dec := sdkmath.LegacyMustNewDecFromStr
+	for _, tc := range []struct {
+		desc              string
+		maxChangeRate     *sdkmath.LegacyDec
+		allowFreeDecrease bool
+		oldRate, newRate  sdkmath.LegacyDec
+		valid             bool
+	}{
+		{"limit unset", nil, false, dec("0.1"), dec("0.9"), true},
+		{"limit disabled", decPtr("0"), false, dec("0.1"), dec("0.9"), true},
+		{"increase within limit", decPtr("0.05"), false, dec("0.1"), dec("0.15"), true},
+		{"increase beyond limit", decPtr("0.05"), true, dec("0.1"), dec("0.16"), false},
+		{"decrease within limit", decPtr("0.05"), false, dec("0.1"), dec("0.05"), true},
+		{"decrease beyond limit", decPtr("0.05"), false, dec("0.2"), dec("0.1"), false},
+		{"free decrease beyond limit", decPtr("0.05"), true, dec("0.2"), dec("0.1"), true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxCommissionChangeRate = tc.maxChangeRate
+			params.AllowFreeCommissionDecrease = tc.allowFreeDecrease
+
+			err := params.ValidateCommissionChange(tc.oldRate, tc.newRate)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrCommissionChangeTooLarge)
+			}
+		})
+	}
+}
+
+func decPtr(s string) *sdkmath.LegacyDec {
+	d := sdkmath.LegacyMustNewDecFromStr(s)
+	return &d
+}
@@ -1,7 +1,15 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
 )
 
 func delegatorUnbondingInfoToResponse(ui *DelegatorUnbondingInfo) *DelegatorUnbondingInfoResponse {
@@ -35,6 +43,7 @@ func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus)
 		UnbondingTime:        btcDel.UnbondingTime,
 		UndelegationResponse: nil,
 		ParamsVersion:        btcDel.ParamsVersion,
+		RewardAddress:        btcDel.GetRewardRecipient(),
 	}
 
 	if btcDel.SlashingTx != nil {
@@ -48,6 +57,48 @@ func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus)
 	return resp
 }
 
+// NewBTCDelegationTransactionsResponse collects the raw transactions of the
+// given BTC delegation and the delegator signatures on its slashing txs
+func NewBTCDelegationTransactionsResponse(btcDel *BTCDelegation) *QueryBTCDelegationTransactionsResponse {
+	resp := &QueryBTCDelegationTransactionsResponse{
+		StakingTxHex: hex.EncodeToString(btcDel.StakingTx),
+	}
+	if btcDel.SlashingTx != nil {
+		resp.SlashingTxHex = btcDel.SlashingTx.ToHexStr()
+	}
+	if btcDel.DelegatorSig != nil {
+		resp.DelegatorSlashingSigHex = btcDel.DelegatorSig.ToHexStr()
+	}
+
+	if ud := btcDel.BtcUndelegation; ud != nil {
+		resp.UnbondingTxHex = hex.EncodeToString(ud.UnbondingTx)
+		if ud.SlashingTx != nil {
+			resp.UnbondingSlashingTxHex = ud.SlashingTx.ToHexStr()
+		}
+		if ud.DelegatorSlashingSig != nil {
+			resp.DelegatorUnbondingSlashingSigHex = ud.DelegatorSlashingSig.ToHexStr()
+		}
+	}
+
+	return resp
+}
+
+// NewScriptPath creates the script path of the revealed leaf in the given
+// spend info
+func NewScriptPath(si *btcstaking.SpendInfo) (*ScriptPath, error) {
+	controlBlockBytes, err := si.ControlBlock.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	leafHash := si.RevealedLeaf.TapHash()
+
+	return &ScriptPath{
+		ScriptHex:       hex.EncodeToString(si.RevealedLeaf.Script),
+		LeafHashHex:     hex.EncodeToString(leafHash[:]),
+		ControlBlockHex: hex.EncodeToString(controlBlockBytes),
+	}, nil
+}
+
 // ToResponse parses an BTCUndelegation into BTCUndelegationResponse.
 func (ud *BTCUndelegation) ToResponse() (resp *BTCUndelegationResponse) {
 	resp = &BTCUndelegationResponse{
@@ -80,6 +131,100 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight uint64) *Fi
 		SlashedBabylonHeight: f.SlashedBabylonHeight,
 		SlashedBtcHeight:     f.SlashedBtcHeight,
 		Jailed:               f.Jailed,
+		SlashingReason:       f.SlashingReason,
 		Height:               bbnBlockHeight,
 	}
 }
+
+// ParseFlagFilter parses the given case-insensitive name of a flag filter,
+// i.e., one of "yes", "no" and "any"
+func ParseFlagFilter(filterStr string) (FlagFilter, error) {
+	switch strings.ToLower(strings.TrimSpace(filterStr)) {
+	case "yes":
+		return FlagFilter_FLAG_FILTER_YES, nil
+	case "no":
+		return FlagFilter_FLAG_FILTER_NO, nil
+	case "any":
+		return FlagFilter_FLAG_FILTER_ANY, nil
+	default:
+		return -1, fmt.Errorf("invalid flag filter %q; should be one of {yes, no, any}", filterStr)
+	}
+}
+
+// Matches returns whether a BTC delegation with the given value of the
+// filtered property passes the flag filter
+func (f FlagFilter) Matches(value bool) bool {
+	switch f {
+	case FlagFilter_FLAG_FILTER_YES:
+		return value
+	case FlagFilter_FLAG_FILTER_NO:
+		return !value
+	default:
+		return true
+	}
+}
+
+// MatchesStatusFilter returns whether a BTC delegation under the given status
+// passes the status filter, i.e., the filter is empty or contains either the
+// status or ANY
+func MatchesStatusFilter(status BTCDelegationStatus, statusFilter []BTCDelegationStatus) bool {
+	if len(statusFilter) == 0 {
+		return true
+	}
+	for _, s := range statusFilter {
+		if s == BTCDelegationStatus_ANY || s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxActivationRateBuckets is the maximum number of buckets that a
+// DelegationActivationRate query can return
+const MaxActivationRateBuckets = 10000
+
+// NewActivationRateBuckets returns the consecutive empty buckets of the given
+// size covering the BTC heights from fromHeight to toHeight, both inclusive.
+// The last bucket is truncated at toHeight
+func NewActivationRateBuckets(fromHeight, toHeight, bucketSize uint32) ([]*ActivationRateBucket, error) {
+	if bucketSize == 0 {
+		return nil, fmt.Errorf("bucket size must be positive")
+	}
+	if fromHeight > toHeight {
+		return nil, fmt.Errorf("from BTC height %d is larger than to BTC height %d", fromHeight, toHeight)
+	}
+	// use uint64 so that the bounds do not overflow
+	numBuckets := (uint64(toHeight)-uint64(fromHeight))/uint64(bucketSize) + 1
+	if numBuckets > MaxActivationRateBuckets {
+		return nil, fmt.Errorf("number of buckets %d exceeds the maximum %d", numBuckets, MaxActivationRateBuckets)
+	}
+
+	buckets := make([]*ActivationRateBucket, 0, numBuckets)
+	for start := uint64(fromHeight); start <= uint64(toHeight); start += uint64(bucketSize) {
+		end := start + uint64(bucketSize) - 1
+		if end > uint64(toHeight) {
+			end = uint64(toHeight)
+		}
+		buckets = append(buckets, &ActivationRateBucket{
+			StartBtcHeight: uint32(start),
+			EndBtcHeight:   uint32(end),
+		})
+	}
+	return buckets, nil
+}
+
+// StakerDelegationsDigest returns the SHA256 hash of the concatenation of the
+// staking tx hash bytes and the big-endian amount of each of the given BTC
+// delegations, in the given order
+func StakerDelegationsDigest(dels []*StakerDelegationEntry) ([]byte, error) {
+	h := sha256.New()
+	for _, del := range dels {
+		stakingTxHash, err := chainhash.NewHashFromStr(del.StakingTxHashHex)
+		if err != nil {
+			return nil, err
+		}
+		h.Write(stakingTxHash[:])
+		h.Write(sdk.Uint64ToBigEndian(del.TotalSat))
+	}
+	return h.Sum(nil), nil
+}
@@ -9,6 +9,8 @@ import (
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -34,6 +36,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FlagFilter is a tri-state filter on a boolean property of BTC delegations
+type FlagFilter int32
+
+const (
+	// FLAG_FILTER_ANY matches BTC delegations regardless of the property
+	FlagFilter_FLAG_FILTER_ANY FlagFilter = 0
+	// FLAG_FILTER_YES matches BTC delegations with the property
+	FlagFilter_FLAG_FILTER_YES FlagFilter = 1
+	// FLAG_FILTER_NO matches BTC delegations without the property
+	FlagFilter_FLAG_FILTER_NO FlagFilter = 2
+)
+
+var FlagFilter_name = map[int32]string{
+	0: "FLAG_FILTER_ANY",
+	1: "FLAG_FILTER_YES",
+	2: "FLAG_FILTER_NO",
+}
+
+var FlagFilter_value = map[string]int32{
+	"FLAG_FILTER_ANY": 0,
+	"FLAG_FILTER_YES": 1,
+	"FLAG_FILTER_NO":  2,
+}
+
+func (x FlagFilter) String() string {
+	return proto.EnumName(FlagFilter_name, int32(x))
+}
+
+func (FlagFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -208,6 +242,336 @@ func (m *QueryParamsByVersionResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryParamsVersionsRequest is the request type for the
+// Query/ParamsVersions RPC method.
+type QueryParamsVersionsRequest struct {
+}
+
+func (m *QueryParamsVersionsRequest) Reset()         { *m = QueryParamsVersionsRequest{} }
+func (m *QueryParamsVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsVersionsRequest) ProtoMessage()    {}
+func (*QueryParamsVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{4}
+}
+func (m *QueryParamsVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsVersionsRequest.Merge(m, src)
+}
+func (m *QueryParamsVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsVersionsRequest proto.InternalMessageInfo
+
+// QueryParamsVersionsResponse is the response type for the
+// Query/ParamsVersions RPC method.
+type QueryParamsVersionsResponse struct {
+	// versions contains the retained params versions in ascending order
+	Versions []uint32 `protobuf:"varint,1,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (m *QueryParamsVersionsResponse) Reset()         { *m = QueryParamsVersionsResponse{} }
+func (m *QueryParamsVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsVersionsResponse) ProtoMessage()    {}
+func (*QueryParamsVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{5}
+}
+func (m *QueryParamsVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsVersionsResponse.Merge(m, src)
+}
+func (m *QueryParamsVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsVersionsResponse) GetVersions() []uint32 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// QueryCurrentParamsRequest is the request type for the Query/CurrentParams
+// RPC method.
+type QueryCurrentParamsRequest struct {
+}
+
+func (m *QueryCurrentParamsRequest) Reset()         { *m = QueryCurrentParamsRequest{} }
+func (m *QueryCurrentParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentParamsRequest) ProtoMessage()    {}
+func (*QueryCurrentParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{6}
+}
+func (m *QueryCurrentParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentParamsRequest.Merge(m, src)
+}
+func (m *QueryCurrentParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentParamsRequest proto.InternalMessageInfo
+
+// QueryCurrentParamsResponse is the response type for the Query/CurrentParams
+// RPC method.
+type QueryCurrentParamsResponse struct {
+	// params holds the latest parameters of the module
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// version is the version of the latest parameters. Clients caching the
+	// parameters can use it to detect updates
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// activation_height is the Babylon block height at which the latest
+	// parameters became active
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// authority is the address allowed to update the parameters, i.e., the
+	// governance module account by default
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *QueryCurrentParamsResponse) Reset()         { *m = QueryCurrentParamsResponse{} }
+func (m *QueryCurrentParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentParamsResponse) ProtoMessage()    {}
+func (*QueryCurrentParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{7}
+}
+func (m *QueryCurrentParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentParamsResponse.Merge(m, src)
+}
+func (m *QueryCurrentParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentParamsResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryCurrentParamsResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryCurrentParamsResponse) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *QueryCurrentParamsResponse) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// QueryUnbondingParamsRequest is the request type for the
+// Query/UnbondingParams RPC method.
+type QueryUnbondingParamsRequest struct {
+	// staking_value_sat is the optional value of the staking output in satoshis.
+	// If provided, the response includes the value the unbonding output must
+	// have
+	StakingValueSat int64 `protobuf:"varint,1,opt,name=staking_value_sat,json=stakingValueSat,proto3" json:"staking_value_sat,omitempty"`
+}
+
+func (m *QueryUnbondingParamsRequest) Reset()         { *m = QueryUnbondingParamsRequest{} }
+func (m *QueryUnbondingParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingParamsRequest) ProtoMessage()    {}
+func (*QueryUnbondingParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{8}
+}
+func (m *QueryUnbondingParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingParamsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingParamsRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingParamsRequest) GetStakingValueSat() int64 {
+	if m != nil {
+		return m.StakingValueSat
+	}
+	return 0
+}
+
+// QueryUnbondingParamsResponse is the response type for the
+// Query/UnbondingParams RPC method.
+type QueryUnbondingParamsResponse struct {
+	// unbonding_fee_sat is the exact fee of the unbonding transaction, i.e.,
+	// the value of the staking output minus the value of the unbonding output.
+	// It does not depend on the staking value
+	UnbondingFeeSat int64 `protobuf:"varint,1,opt,name=unbonding_fee_sat,json=unbondingFeeSat,proto3" json:"unbonding_fee_sat,omitempty"`
+	// min_unbonding_time_blocks is the effective minimum unbonding time in BTC
+	// blocks, i.e., the bigger value from the min_unbonding_time_blocks
+	// parameter and the checkpoint finalization timeout. The timelock of the
+	// unbonding output has to be larger than it
+	MinUnbondingTimeBlocks uint32 `protobuf:"varint,2,opt,name=min_unbonding_time_blocks,json=minUnbondingTimeBlocks,proto3" json:"min_unbonding_time_blocks,omitempty"`
+	// unbonding_output_value_sat is the value the unbonding output must have,
+	// i.e., staking_value_sat minus unbonding_fee_sat. It is set only if
+	// staking_value_sat is provided
+	UnbondingOutputValueSat int64 `protobuf:"varint,3,opt,name=unbonding_output_value_sat,json=unbondingOutputValueSat,proto3" json:"unbonding_output_value_sat,omitempty"`
+	// version is the version of the parameters the values are derived from
+	Version uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryUnbondingParamsResponse) Reset()         { *m = QueryUnbondingParamsResponse{} }
+func (m *QueryUnbondingParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingParamsResponse) ProtoMessage()    {}
+func (*QueryUnbondingParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{9}
+}
+func (m *QueryUnbondingParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingParamsResponse.Merge(m, src)
+}
+func (m *QueryUnbondingParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingParamsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingParamsResponse) GetUnbondingFeeSat() int64 {
+	if m != nil {
+		return m.UnbondingFeeSat
+	}
+	return 0
+}
+
+func (m *QueryUnbondingParamsResponse) GetMinUnbondingTimeBlocks() uint32 {
+	if m != nil {
+		return m.MinUnbondingTimeBlocks
+	}
+	return 0
+}
+
+func (m *QueryUnbondingParamsResponse) GetUnbondingOutputValueSat() int64 {
+	if m != nil {
+		return m.UnbondingOutputValueSat
+	}
+	return 0
+}
+
+func (m *QueryUnbondingParamsResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // QueryFinalityProvidersRequest is the request type for the
 // Query/FinalityProviders RPC method.
 type QueryFinalityProvidersRequest struct {
@@ -219,7 +583,7 @@ func (m *QueryFinalityProvidersRequest) Reset()         { *m = QueryFinalityProv
 func (m *QueryFinalityProvidersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProvidersRequest) ProtoMessage()    {}
 func (*QueryFinalityProvidersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{4}
+	return fileDescriptor_74d49d26f7429697, []int{10}
 }
 func (m *QueryFinalityProvidersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +632,7 @@ func (m *QueryFinalityProvidersResponse) Reset()         { *m = QueryFinalityPro
 func (m *QueryFinalityProvidersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProvidersResponse) ProtoMessage()    {}
 func (*QueryFinalityProvidersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{5}
+	return fileDescriptor_74d49d26f7429697, []int{11}
 }
 func (m *QueryFinalityProvidersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +685,7 @@ func (m *QueryFinalityProviderRequest) Reset()         { *m = QueryFinalityProvi
 func (m *QueryFinalityProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{6}
+	return fileDescriptor_74d49d26f7429697, []int{12}
 }
 func (m *QueryFinalityProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +731,7 @@ func (m *QueryFinalityProviderResponse) Reset()         { *m = QueryFinalityProv
 func (m *QueryFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{7}
+	return fileDescriptor_74d49d26f7429697, []int{13}
 }
 func (m *QueryFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +780,7 @@ func (m *QueryBTCDelegationsRequest) Reset()         { *m = QueryBTCDelegationsR
 func (m *QueryBTCDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationsRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{8}
+	return fileDescriptor_74d49d26f7429697, []int{14}
 }
 func (m *QueryBTCDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +836,7 @@ func (m *QueryBTCDelegationsResponse) Reset()         { *m = QueryBTCDelegations
 func (m *QueryBTCDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationsResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{9}
+	return fileDescriptor_74d49d26f7429697, []int{15}
 }
 func (m *QueryBTCDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,31 +879,30 @@ func (m *QueryBTCDelegationsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryFinalityProviderDelegationsRequest is the request type for the
-// Query/FinalityProviderDelegations RPC method.
-type QueryFinalityProviderDelegationsRequest struct {
-	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality providerthat
-	// this BTC delegation delegates to
-	// the PK follows encoding in BIP-340 spec
-	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+// QueryDelegationsByParamsVersionRequest is the request type for the
+// Query/DelegationsByParamsVersion RPC method.
+type QueryDelegationsByParamsVersionRequest struct {
+	// version is the params version that the queried BTC delegations were
+	// validated against
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Reset() {
-	*m = QueryFinalityProviderDelegationsRequest{}
+func (m *QueryDelegationsByParamsVersionRequest) Reset() {
+	*m = QueryDelegationsByParamsVersionRequest{}
 }
-func (m *QueryFinalityProviderDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFinalityProviderDelegationsRequest) ProtoMessage()    {}
-func (*QueryFinalityProviderDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{10}
+func (m *QueryDelegationsByParamsVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByParamsVersionRequest) ProtoMessage()    {}
+func (*QueryDelegationsByParamsVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{16}
 }
-func (m *QueryFinalityProviderDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegationsByParamsVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFinalityProviderDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegationsByParamsVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFinalityProviderDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegationsByParamsVersionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -549,55 +912,174 @@ func (m *QueryFinalityProviderDelegationsRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryFinalityProviderDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFinalityProviderDelegationsRequest.Merge(m, src)
+func (m *QueryDelegationsByParamsVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByParamsVersionRequest.Merge(m, src)
 }
-func (m *QueryFinalityProviderDelegationsRequest) XXX_Size() int {
+func (m *QueryDelegationsByParamsVersionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFinalityProviderDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFinalityProviderDelegationsRequest.DiscardUnknown(m)
+func (m *QueryDelegationsByParamsVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByParamsVersionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFinalityProviderDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegationsByParamsVersionRequest proto.InternalMessageInfo
 
-func (m *QueryFinalityProviderDelegationsRequest) GetFpBtcPkHex() string {
+func (m *QueryDelegationsByParamsVersionRequest) GetVersion() uint32 {
 	if m != nil {
-		return m.FpBtcPkHex
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryDelegationsByParamsVersionRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryFinalityProviderDelegationsResponse is the response type for the
-// Query/FinalityProviderDelegations RPC method.
-type QueryFinalityProviderDelegationsResponse struct {
-	// btc_delegator_delegations contains all the queried BTC delegations.
-	BtcDelegatorDelegations []*BTCDelegatorDelegationsResponse `protobuf:"bytes,1,rep,name=btc_delegator_delegations,json=btcDelegatorDelegations,proto3" json:"btc_delegator_delegations,omitempty"`
+// QueryDelegationsByParamsVersionResponse is the response type for the
+// Query/DelegationsByParamsVersion RPC method.
+type QueryDelegationsByParamsVersionResponse struct {
+	// btc_delegations contains all the queried BTC delegations referencing the
+	// given params version
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Reset() {
-	*m = QueryFinalityProviderDelegationsResponse{}
+func (m *QueryDelegationsByParamsVersionResponse) Reset() {
+	*m = QueryDelegationsByParamsVersionResponse{}
 }
-func (m *QueryFinalityProviderDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFinalityProviderDelegationsResponse) ProtoMessage()    {}
-func (*QueryFinalityProviderDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{11}
+func (m *QueryDelegationsByParamsVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByParamsVersionResponse) ProtoMessage()    {}
+func (*QueryDelegationsByParamsVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{17}
 }
-func (m *QueryFinalityProviderDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegationsByParamsVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFinalityProviderDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegationsByParamsVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFinalityProviderDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegationsByParamsVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsByParamsVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByParamsVersionResponse.Merge(m, src)
+}
+func (m *QueryDelegationsByParamsVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsByParamsVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByParamsVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsByParamsVersionResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsByParamsVersionResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryDelegationsByParamsVersionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalityProviderDelegationsRequest is the request type for the
+// Query/FinalityProviderDelegations RPC method.
+type QueryFinalityProviderDelegationsRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality providerthat
+	// this BTC delegation delegates to
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Reset() {
+	*m = QueryFinalityProviderDelegationsRequest{}
+}
+func (m *QueryFinalityProviderDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderDelegationsRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{18}
+}
+func (m *QueryFinalityProviderDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderDelegationsRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderDelegationsRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalityProviderDelegationsResponse is the response type for the
+// Query/FinalityProviderDelegations RPC method.
+type QueryFinalityProviderDelegationsResponse struct {
+	// btc_delegator_delegations contains all the queried BTC delegations.
+	BtcDelegatorDelegations []*BTCDelegatorDelegationsResponse `protobuf:"bytes,1,rep,name=btc_delegator_delegations,json=btcDelegatorDelegations,proto3" json:"btc_delegator_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Reset() {
+	*m = QueryFinalityProviderDelegationsResponse{}
+}
+func (m *QueryFinalityProviderDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderDelegationsResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{19}
+}
+func (m *QueryFinalityProviderDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -644,7 +1126,7 @@ func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationReq
 func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{12}
+	return fileDescriptor_74d49d26f7429697, []int{20}
 }
 func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +1173,7 @@ func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationRe
 func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{13}
+	return fileDescriptor_74d49d26f7429697, []int{21}
 }
 func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,66 +1209,33 @@ func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
 	return nil
 }
 
-// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
-type BTCDelegationResponse struct {
-	// staker_addr is the address to receive rewards from BTC delegation.
-	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
-	// this BTC delegation delegates to
-	FpBtcPkList []github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// staking_time is the number of blocks for which the delegation is locked on BTC chain
-	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
-	// start_height is the start BTC height of the BTC delegation
-	// it is the start BTC height of the timelock
-	StartHeight uint32 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// end_height is the end height of the BTC delegation
-	// it is the end BTC height of the timelock - w
-	EndHeight uint32 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// total_sat is the total amount of BTC stakes in this delegation
-	// quantified in satoshi
-	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
-	// staking_tx_hex is the hex string of staking tx
-	StakingTxHex string `protobuf:"bytes,8,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
-	// slashing_tx_hex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slash_sig_hex is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
-	// It will be a part of the witness for the staking tx output.
-	DelegatorSlashSigHex string `protobuf:"bytes,10,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
-	// covenant_sigs is a list of adaptor signatures on the slashing tx
-	// by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,11,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
-	// staking_output_idx is the index of the staking output in the staking tx
-	StakingOutputIdx uint32 `protobuf:"varint,12,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
-	// whether this delegation is active
-	Active bool `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
-	// descriptive status of current delegation.
-	StatusDesc string `protobuf:"bytes,14,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// unbonding_time used in unbonding output timelock path and in slashing transactions
-	// change outputs
-	UnbondingTime uint32 `protobuf:"varint,15,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// undelegation_response is the undelegation info of this delegation.
-	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,16,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
-	// params version used to validate delegation
-	ParamsVersion uint32 `protobuf:"varint,17,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+// QueryVerifyCovenantSlashingSigRequest is the request type for the
+// Query/VerifyCovenantSlashingSig RPC method.
+type QueryVerifyCovenantSlashingSigRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// covenant_pk_hex is the hex str of the BIP-340 PK of the covenant member
+	// producing the signatures
+	CovenantPkHex string `protobuf:"bytes,2,opt,name=covenant_pk_hex,json=covenantPkHex,proto3" json:"covenant_pk_hex,omitempty"`
+	// slashing_tx_sigs is a list of adaptor signatures on the slashing tx
+	// by the covenant member, each encrypted by a finality provider's PK
+	// in the order of the finality providers of the BTC delegation
+	SlashingTxSigs [][]byte `protobuf:"bytes,3,rep,name=slashing_tx_sigs,json=slashingTxSigs,proto3" json:"slashing_tx_sigs,omitempty"`
 }
 
-func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
-func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegationResponse) ProtoMessage()    {}
-func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{14}
+func (m *QueryVerifyCovenantSlashingSigRequest) Reset()         { *m = QueryVerifyCovenantSlashingSigRequest{} }
+func (m *QueryVerifyCovenantSlashingSigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCovenantSlashingSigRequest) ProtoMessage()    {}
+func (*QueryVerifyCovenantSlashingSigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{22}
 }
-func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryVerifyCovenantSlashingSigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyCovenantSlashingSigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyCovenantSlashingSigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -796,143 +1245,188 @@ func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+func (m *QueryVerifyCovenantSlashingSigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCovenantSlashingSigRequest.Merge(m, src)
 }
-func (m *BTCDelegationResponse) XXX_Size() int {
+func (m *QueryVerifyCovenantSlashingSigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+func (m *QueryVerifyCovenantSlashingSigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCovenantSlashingSigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVerifyCovenantSlashingSigRequest proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetStakerAddr() string {
+func (m *QueryVerifyCovenantSlashingSigRequest) GetStakingTxHashHex() string {
 	if m != nil {
-		return m.StakerAddr
+		return m.StakingTxHashHex
 	}
 	return ""
 }
 
-func (m *BTCDelegationResponse) GetStakingTime() uint32 {
+func (m *QueryVerifyCovenantSlashingSigRequest) GetCovenantPkHex() string {
 	if m != nil {
-		return m.StakingTime
+		return m.CovenantPkHex
 	}
-	return 0
+	return ""
 }
 
-func (m *BTCDelegationResponse) GetStartHeight() uint32 {
+func (m *QueryVerifyCovenantSlashingSigRequest) GetSlashingTxSigs() [][]byte {
 	if m != nil {
-		return m.StartHeight
+		return m.SlashingTxSigs
 	}
-	return 0
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetEndHeight() uint32 {
-	if m != nil {
-		return m.EndHeight
-	}
-	return 0
+// QueryVerifyCovenantSlashingSigResponse is the response type for the
+// Query/VerifyCovenantSlashingSig RPC method.
+type QueryVerifyCovenantSlashingSigResponse struct {
+	// params_version is the version of the params the BTC delegation was
+	// validated against, which the signatures are verified under
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// results contains the verification result of the adaptor signature
+	// encrypted by each finality provider's PK
+	Results []*CovenantSlashingSigVerification `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetTotalSat() uint64 {
-	if m != nil {
-		return m.TotalSat
+func (m *QueryVerifyCovenantSlashingSigResponse) Reset() {
+	*m = QueryVerifyCovenantSlashingSigResponse{}
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCovenantSlashingSigResponse) ProtoMessage()    {}
+func (*QueryVerifyCovenantSlashingSigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{23}
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCovenantSlashingSigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCovenantSlashingSigResponse.Merge(m, src)
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCovenantSlashingSigResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetStakingTxHex() string {
+var xxx_messageInfo_QueryVerifyCovenantSlashingSigResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyCovenantSlashingSigResponse) GetParamsVersion() uint32 {
 	if m != nil {
-		return m.StakingTxHex
+		return m.ParamsVersion
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+func (m *QueryVerifyCovenantSlashingSigResponse) GetResults() []*CovenantSlashingSigVerification {
 	if m != nil {
-		return m.SlashingTxHex
+		return m.Results
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
-	if m != nil {
-		return m.DelegatorSlashSigHex
-	}
-	return ""
+// CovenantSlashingSigVerification is the verification result of a covenant
+// adaptor signature encrypted by a finality provider's PK
+type CovenantSlashingSigVerification struct {
+	// fp_btc_pk_hex is the hex str of the BIP-340 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// valid indicates whether the adaptor signature is valid
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// error is the reason why the adaptor signature is invalid, empty if valid
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
-	if m != nil {
-		return m.CovenantSigs
+func (m *CovenantSlashingSigVerification) Reset()         { *m = CovenantSlashingSigVerification{} }
+func (m *CovenantSlashingSigVerification) String() string { return proto.CompactTextString(m) }
+func (*CovenantSlashingSigVerification) ProtoMessage()    {}
+func (*CovenantSlashingSigVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{24}
+}
+func (m *CovenantSlashingSigVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantSlashingSigVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantSlashingSigVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *CovenantSlashingSigVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantSlashingSigVerification.Merge(m, src)
+}
+func (m *CovenantSlashingSigVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantSlashingSigVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantSlashingSigVerification.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
+var xxx_messageInfo_CovenantSlashingSigVerification proto.InternalMessageInfo
+
+func (m *CovenantSlashingSigVerification) GetFpBtcPkHex() string {
 	if m != nil {
-		return m.StakingOutputIdx
+		return m.FpBtcPkHex
 	}
-	return 0
+	return ""
 }
 
-func (m *BTCDelegationResponse) GetActive() bool {
+func (m *CovenantSlashingSigVerification) GetValid() bool {
 	if m != nil {
-		return m.Active
+		return m.Valid
 	}
 	return false
 }
 
-func (m *BTCDelegationResponse) GetStatusDesc() string {
+func (m *CovenantSlashingSigVerification) GetError() string {
 	if m != nil {
-		return m.StatusDesc
+		return m.Error
 	}
 	return ""
 }
 
-func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
-	if m != nil {
-		return m.UnbondingTime
-	}
-	return 0
-}
-
-func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
-	if m != nil {
-		return m.UndelegationResponse
-	}
-	return nil
+// QueryBTCDelegationSignatureReadinessRequest is the request type for the
+// Query/BTCDelegationSignatureReadiness RPC method.
+type QueryBTCDelegationSignatureReadinessRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
-	if m != nil {
-		return m.ParamsVersion
-	}
-	return 0
+func (m *QueryBTCDelegationSignatureReadinessRequest) Reset() {
+	*m = QueryBTCDelegationSignatureReadinessRequest{}
 }
-
-// DelegatorUnbondingInfoResponse provides all necessary info about transaction
-// which spent the staking output
-type DelegatorUnbondingInfoResponse struct {
-	// spend_stake_tx_hex is the transaction which spent the staking output. It is
-	// filled only if the spend_stake_tx_hex is different than the unbonding_tx_hex
-	SpendStakeTxHex string `protobuf:"bytes,1,opt,name=spend_stake_tx_hex,json=spendStakeTxHex,proto3" json:"spend_stake_tx_hex,omitempty"`
+func (m *QueryBTCDelegationSignatureReadinessRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *DelegatorUnbondingInfoResponse) Reset()         { *m = DelegatorUnbondingInfoResponse{} }
-func (m *DelegatorUnbondingInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegatorUnbondingInfoResponse) ProtoMessage()    {}
-func (*DelegatorUnbondingInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{15}
+func (*QueryBTCDelegationSignatureReadinessRequest) ProtoMessage() {}
+func (*QueryBTCDelegationSignatureReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{25}
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBTCDelegationSignatureReadinessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBTCDelegationSignatureReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorUnbondingInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBTCDelegationSignatureReadinessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -942,62 +1436,66 @@ func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorUnbondingInfoResponse.Merge(m, src)
+func (m *QueryBTCDelegationSignatureReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationSignatureReadinessRequest.Merge(m, src)
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Size() int {
+func (m *QueryBTCDelegationSignatureReadinessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorUnbondingInfoResponse.DiscardUnknown(m)
+func (m *QueryBTCDelegationSignatureReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationSignatureReadinessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorUnbondingInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBTCDelegationSignatureReadinessRequest proto.InternalMessageInfo
 
-func (m *DelegatorUnbondingInfoResponse) GetSpendStakeTxHex() string {
+func (m *QueryBTCDelegationSignatureReadinessRequest) GetStakingTxHashHex() string {
 	if m != nil {
-		return m.SpendStakeTxHex
+		return m.StakingTxHashHex
 	}
 	return ""
 }
 
-// BTCUndelegationResponse provides all necessary info about the undeleagation
-type BTCUndelegationResponse struct {
-	// unbonding_tx is the transaction which will transfer the funds from staking
-	// output to unbonding output. Unbonding output will usually have lower timelock
-	// than staking output. The unbonding tx as string hex.
-	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
-	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
-	// by covenant members
-	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,2,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
-	// slashingTxHex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slashing_sig is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It will be a part of the witness for the unbonding tx output.
-	// The delegator slashing sig as string hex.
-	DelegatorSlashingSigHex string `protobuf:"bytes,4,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
-	// covenant_slashing_sigs is a list of adaptor signatures on the
-	// unbonding slashing tx by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
-	// btc_undelegation_info contains all necessary info about the transaction
-	// which spent the staking output
-	DelegatorUnbondingInfoResponse *DelegatorUnbondingInfoResponse `protobuf:"bytes,6,opt,name=delegator_unbonding_info_response,json=delegatorUnbondingInfoResponse,proto3" json:"delegator_unbonding_info_response,omitempty"`
+// QueryBTCDelegationSignatureReadinessResponse is the response type for the
+// Query/BTCDelegationSignatureReadiness RPC method.
+type QueryBTCDelegationSignatureReadinessResponse struct {
+	// params_version is the version of the params the BTC delegation was
+	// validated against, which the covenant quorum is taken from
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// covenant_quorum is the number of covenant signatures needed on each path
+	CovenantQuorum uint32 `protobuf:"varint,2,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// fp_readiness contains the readiness of the adaptor signatures encrypted
+	// by each finality provider's PK, in the order of the finality providers
+	// of the BTC delegation
+	FpReadiness []*FpSignatureReadiness `protobuf:"bytes,3,rep,name=fp_readiness,json=fpReadiness,proto3" json:"fp_readiness,omitempty"`
+	// unbonding_quorum indicates whether there is a covenant quorum of
+	// Schnorr signatures on the unbonding tx
+	UnbondingQuorum bool `protobuf:"varint,4,opt,name=unbonding_quorum,json=unbondingQuorum,proto3" json:"unbonding_quorum,omitempty"`
+	// activation_ready indicates whether the BTC delegation has a covenant
+	// quorum on all the paths for all the finality providers, which is the
+	// condition for the BTC delegation to be activated
+	ActivationReady bool `protobuf:"varint,5,opt,name=activation_ready,json=activationReady,proto3" json:"activation_ready,omitempty"`
+	// has_inclusion_proof indicates whether the inclusion proof of the staking
+	// tx is submitted. A BTC delegation with a covenant quorum but without an
+	// inclusion proof is VERIFIED but not ACTIVE
+	HasInclusionProof bool `protobuf:"varint,6,opt,name=has_inclusion_proof,json=hasInclusionProof,proto3" json:"has_inclusion_proof,omitempty"`
 }
 
-func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
-func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCUndelegationResponse) ProtoMessage()    {}
-func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{16}
+func (m *QueryBTCDelegationSignatureReadinessResponse) Reset() {
+	*m = QueryBTCDelegationSignatureReadinessResponse{}
 }
-func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBTCDelegationSignatureReadinessResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationSignatureReadinessResponse) ProtoMessage() {}
+func (*QueryBTCDelegationSignatureReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{26}
+}
+func (m *QueryBTCDelegationSignatureReadinessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBTCDelegationSignatureReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBTCDelegationSignatureReadinessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1007,77 +1505,85 @@ func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+func (m *QueryBTCDelegationSignatureReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationSignatureReadinessResponse.Merge(m, src)
 }
-func (m *BTCUndelegationResponse) XXX_Size() int {
+func (m *QueryBTCDelegationSignatureReadinessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
+func (m *QueryBTCDelegationSignatureReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationSignatureReadinessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBTCDelegationSignatureReadinessResponse proto.InternalMessageInfo
 
-func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+func (m *QueryBTCDelegationSignatureReadinessResponse) GetParamsVersion() uint32 {
 	if m != nil {
-		return m.UnbondingTxHex
+		return m.ParamsVersion
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+func (m *QueryBTCDelegationSignatureReadinessResponse) GetCovenantQuorum() uint32 {
 	if m != nil {
-		return m.CovenantUnbondingSigList
+		return m.CovenantQuorum
 	}
-	return nil
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+func (m *QueryBTCDelegationSignatureReadinessResponse) GetFpReadiness() []*FpSignatureReadiness {
 	if m != nil {
-		return m.SlashingTxHex
+		return m.FpReadiness
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+func (m *QueryBTCDelegationSignatureReadinessResponse) GetUnbondingQuorum() bool {
 	if m != nil {
-		return m.DelegatorSlashingSigHex
+		return m.UnbondingQuorum
 	}
-	return ""
+	return false
 }
 
-func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+func (m *QueryBTCDelegationSignatureReadinessResponse) GetActivationReady() bool {
 	if m != nil {
-		return m.CovenantSlashingSigs
+		return m.ActivationReady
 	}
-	return nil
+	return false
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorUnbondingInfoResponse() *DelegatorUnbondingInfoResponse {
+func (m *QueryBTCDelegationSignatureReadinessResponse) GetHasInclusionProof() bool {
 	if m != nil {
-		return m.DelegatorUnbondingInfoResponse
+		return m.HasInclusionProof
 	}
-	return nil
+	return false
 }
 
-// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
-type BTCDelegatorDelegationsResponse struct {
-	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
+// FpSignatureReadiness is the readiness of the covenant adaptor signatures
+// encrypted by a finality provider's PK
+type FpSignatureReadiness struct {
+	// fp_btc_pk_hex is the hex str of the BIP-340 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// staking_slashing_quorum indicates whether there is a covenant quorum of
+	// adaptor signatures on the slashing tx of the staking tx
+	StakingSlashingQuorum bool `protobuf:"varint,2,opt,name=staking_slashing_quorum,json=stakingSlashingQuorum,proto3" json:"staking_slashing_quorum,omitempty"`
+	// unbonding_slashing_quorum indicates whether there is a covenant quorum of
+	// adaptor signatures on the slashing tx of the unbonding tx
+	UnbondingSlashingQuorum bool `protobuf:"varint,3,opt,name=unbonding_slashing_quorum,json=unbondingSlashingQuorum,proto3" json:"unbonding_slashing_quorum,omitempty"`
 }
 
-func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
-func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
-func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{17}
+func (m *FpSignatureReadiness) Reset()         { *m = FpSignatureReadiness{} }
+func (m *FpSignatureReadiness) String() string { return proto.CompactTextString(m) }
+func (*FpSignatureReadiness) ProtoMessage()    {}
+func (*FpSignatureReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{27}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *FpSignatureReadiness) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FpSignatureReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FpSignatureReadiness.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1087,65 +1593,56 @@ func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+func (m *FpSignatureReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FpSignatureReadiness.Merge(m, src)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+func (m *FpSignatureReadiness) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
+func (m *FpSignatureReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_FpSignatureReadiness.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_FpSignatureReadiness proto.InternalMessageInfo
 
-func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+func (m *FpSignatureReadiness) GetFpBtcPkHex() string {
 	if m != nil {
-		return m.Dels
+		return m.FpBtcPkHex
 	}
-	return nil
+	return ""
 }
 
-// FinalityProviderResponse defines a finality provider with voting power information.
-type FinalityProviderResponse struct {
-	// description defines the description terms for the finality provider.
-	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the commission rate of the finality provider.
-	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
-	// addr is the address to receive commission from delegations.
-	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// pop is the proof of possession of the BTC_PK by the fp addr.
-	// Essentially is the signature where the BTC SK sigs the fp addr.
-	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
-	// slashed_babylon_height indicates the Babylon height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
-	// slashed_btc_height indicates the BTC height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
-	// height is the queried Babylon height
-	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	// jailed defines whether the finality provider is jailed
-	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+func (m *FpSignatureReadiness) GetStakingSlashingQuorum() bool {
+	if m != nil {
+		return m.StakingSlashingQuorum
+	}
+	return false
 }
 
-func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
-func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
-func (*FinalityProviderResponse) ProtoMessage()    {}
-func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{18}
+func (m *FpSignatureReadiness) GetUnbondingSlashingQuorum() bool {
+	if m != nil {
+		return m.UnbondingSlashingQuorum
+	}
+	return false
 }
-func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryNextPowerDistUpdateHeightRequest is the request type for the
+// Query/NextPowerDistUpdateHeight RPC method.
+type QueryNextPowerDistUpdateHeightRequest struct {
+}
+
+func (m *QueryNextPowerDistUpdateHeightRequest) Reset()         { *m = QueryNextPowerDistUpdateHeightRequest{} }
+func (m *QueryNextPowerDistUpdateHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextPowerDistUpdateHeightRequest) ProtoMessage()    {}
+func (*QueryNextPowerDistUpdateHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{28}
+}
+func (m *QueryNextPowerDistUpdateHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNextPowerDistUpdateHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNextPowerDistUpdateHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1155,1857 +1652,26437 @@ func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+func (m *QueryNextPowerDistUpdateHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextPowerDistUpdateHeightRequest.Merge(m, src)
 }
-func (m *FinalityProviderResponse) XXX_Size() int {
+func (m *QueryNextPowerDistUpdateHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
+func (m *QueryNextPowerDistUpdateHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextPowerDistUpdateHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNextPowerDistUpdateHeightRequest proto.InternalMessageInfo
 
-func (m *FinalityProviderResponse) GetDescription() *types.Description {
-	if m != nil {
-		return m.Description
-	}
-	return nil
+// QueryNextPowerDistUpdateHeightResponse is the response type for the
+// Query/NextPowerDistUpdateHeight RPC method.
+type QueryNextPowerDistUpdateHeightResponse struct {
+	// found indicates whether there is any pending power distribution update
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// btc_height is the smallest BTC height with a pending power distribution
+	// update. It is only meaningful if found is true
+	BtcHeight uint32 `protobuf:"varint,2,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
 }
 
-func (m *FinalityProviderResponse) GetAddr() string {
-	if m != nil {
-		return m.Addr
+func (m *QueryNextPowerDistUpdateHeightResponse) Reset() {
+	*m = QueryNextPowerDistUpdateHeightResponse{}
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextPowerDistUpdateHeightResponse) ProtoMessage()    {}
+func (*QueryNextPowerDistUpdateHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{29}
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextPowerDistUpdateHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextPowerDistUpdateHeightResponse.Merge(m, src)
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextPowerDistUpdateHeightResponse.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
+var xxx_messageInfo_QueryNextPowerDistUpdateHeightResponse proto.InternalMessageInfo
+
+func (m *QueryNextPowerDistUpdateHeightResponse) GetFound() bool {
 	if m != nil {
-		return m.Pop
+		return m.Found
 	}
-	return nil
+	return false
 }
 
-func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+func (m *QueryNextPowerDistUpdateHeightResponse) GetBtcHeight() uint32 {
 	if m != nil {
-		return m.SlashedBabylonHeight
+		return m.BtcHeight
 	}
 	return 0
 }
 
-func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint32 {
-	if m != nil {
-		return m.SlashedBtcHeight
-	}
-	return 0
+// QueryCommissionRateBoundsRequest is the request type for the
+// Query/CommissionRateBounds RPC method.
+type QueryCommissionRateBoundsRequest struct {
 }
 
-func (m *FinalityProviderResponse) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
+func (m *QueryCommissionRateBoundsRequest) Reset()         { *m = QueryCommissionRateBoundsRequest{} }
+func (m *QueryCommissionRateBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionRateBoundsRequest) ProtoMessage()    {}
+func (*QueryCommissionRateBoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{30}
+}
+func (m *QueryCommissionRateBoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionRateBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionRateBoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryCommissionRateBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionRateBoundsRequest.Merge(m, src)
+}
+func (m *QueryCommissionRateBoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionRateBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionRateBoundsRequest.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) GetJailed() bool {
-	if m != nil {
-		return m.Jailed
+var xxx_messageInfo_QueryCommissionRateBoundsRequest proto.InternalMessageInfo
+
+// QueryCommissionRateBoundsResponse is the response type for the
+// Query/CommissionRateBounds RPC method.
+type QueryCommissionRateBoundsResponse struct {
+	// min_commission_rate is the chain-wide minimum commission rate that a
+	// finality provider can charge, as set by governance
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
+	// max_commission_rate is the maximum commission rate that a finality
+	// provider can charge, i.e., 1.0
+	MaxCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate"`
+}
+
+func (m *QueryCommissionRateBoundsResponse) Reset()         { *m = QueryCommissionRateBoundsResponse{} }
+func (m *QueryCommissionRateBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionRateBoundsResponse) ProtoMessage()    {}
+func (*QueryCommissionRateBoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{31}
+}
+func (m *QueryCommissionRateBoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionRateBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionRateBoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *QueryCommissionRateBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionRateBoundsResponse.Merge(m, src)
+}
+func (m *QueryCommissionRateBoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionRateBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionRateBoundsResponse.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
-	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
-	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
-	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
-	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
-	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
-	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
-	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
-	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
-	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
-	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
-	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
-	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
-	proto.RegisterType((*DelegatorUnbondingInfoResponse)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfoResponse")
-	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
-	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
-	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
-}
+var xxx_messageInfo_QueryCommissionRateBoundsResponse proto.InternalMessageInfo
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
-
-var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0xdb, 0xca,
-	0x15, 0x36, 0x6d, 0x59, 0xb1, 0x8f, 0x2c, 0xd9, 0x9e, 0xeb, 0xd8, 0x8c, 0x7c, 0x2d, 0x3b, 0x6a,
-	0x6e, 0xe2, 0x3c, 0x2c, 0xc6, 0x8f, 0xf4, 0xb6, 0x08, 0x6e, 0xdb, 0xc8, 0xce, 0xab, 0x89, 0x1b,
-	0x97, 0x8a, 0xbb, 0xe8, 0x4b, 0xa0, 0xc8, 0x11, 0xc5, 0x5a, 0x22, 0x19, 0xce, 0xc8, 0x90, 0x11,
-	0x18, 0x28, 0xb2, 0xe8, 0xba, 0x40, 0xfb, 0x23, 0x02, 0x74, 0x53, 0xa0, 0xd9, 0x74, 0xd1, 0x7d,
-	0xba, 0x0b, 0xd2, 0x4d, 0x91, 0x45, 0x50, 0x24, 0x05, 0xba, 0xea, 0xbe, 0xe8, 0xaa, 0xe0, 0xcc,
-	0xf0, 0x21, 0x59, 0xb4, 0x2d, 0xd7, 0x3b, 0x71, 0xce, 0xfb, 0x9b, 0xef, 0xf0, 0xf0, 0x08, 0x2e,
-	0xd7, 0xb4, 0xda, 0x41, 0xd3, 0xb1, 0x95, 0x1a, 0xd5, 0x09, 0xd5, 0xf6, 0x2c, 0xdb, 0x54, 0xf6,
-	0x57, 0x95, 0x17, 0x6d, 0xec, 0x1d, 0x94, 0x5c, 0xcf, 0xa1, 0x0e, 0xba, 0x28, 0x54, 0x4a, 0x91,
-	0x4a, 0x69, 0x7f, 0x35, 0x3f, 0x63, 0x3a, 0xa6, 0xc3, 0x34, 0x14, 0xff, 0x17, 0x57, 0xce, 0x7f,
-	0x69, 0x3a, 0x8e, 0xd9, 0xc4, 0x8a, 0xe6, 0x5a, 0x8a, 0x66, 0xdb, 0x0e, 0xd5, 0xa8, 0xe5, 0xd8,
-	0x44, 0x48, 0x2f, 0xe9, 0x0e, 0x69, 0x39, 0xa4, 0xca, 0xcd, 0xf8, 0x83, 0x10, 0x5d, 0xe1, 0x4f,
-	0x4a, 0x94, 0x44, 0x0d, 0x53, 0x6d, 0x35, 0x78, 0x16, 0x5a, 0x37, 0x84, 0x56, 0x4d, 0x23, 0x98,
-	0x27, 0x19, 0x2a, 0xba, 0x9a, 0x69, 0xd9, 0x2c, 0x9a, 0xd0, 0x2d, 0xf6, 0x2f, 0xcd, 0xd5, 0x3c,
-	0xad, 0x15, 0x44, 0xbd, 0xda, 0x5f, 0x27, 0x56, 0x29, 0xd7, 0x5b, 0x4c, 0xf0, 0xe5, 0xb8, 0x5c,
-	0xa1, 0x38, 0x03, 0xe8, 0xc7, 0x7e, 0x3a, 0x3b, 0xcc, 0xbb, 0x8a, 0x5f, 0xb4, 0x31, 0xa1, 0x45,
-	0x15, 0xbe, 0xe8, 0x3a, 0x25, 0xae, 0x63, 0x13, 0x8c, 0xee, 0x42, 0x9a, 0x67, 0x21, 0x4b, 0x4b,
-	0xd2, 0x72, 0x66, 0x6d, 0xa1, 0xd4, 0x17, 0xe2, 0x12, 0x37, 0x2b, 0xa7, 0xde, 0x7e, 0x5c, 0x1c,
-	0x52, 0x85, 0x49, 0xf1, 0x6b, 0x98, 0x8f, 0xf9, 0x2c, 0x1f, 0xfc, 0x04, 0x7b, 0xc4, 0x72, 0x6c,
-	0x11, 0x12, 0xc9, 0x70, 0x61, 0x9f, 0x9f, 0x30, 0xe7, 0x59, 0x35, 0x78, 0x2c, 0xfe, 0x0c, 0xbe,
-	0xec, 0x6f, 0x78, 0x1e, 0x59, 0x99, 0xb0, 0xc0, 0x9c, 0x3f, 0xb0, 0x6c, 0xad, 0x69, 0xd1, 0x83,
-	0x1d, 0xcf, 0xd9, 0xb7, 0x0c, 0xec, 0x05, 0x50, 0xa0, 0x07, 0x00, 0xd1, 0x0d, 0x89, 0x08, 0x57,
-	0x4b, 0x82, 0x02, 0xfe, 0x75, 0x96, 0x38, 0xe7, 0xc4, 0x75, 0x96, 0x76, 0x34, 0x13, 0x0b, 0x5b,
-	0x35, 0x66, 0x59, 0xfc, 0xab, 0x04, 0x85, 0xa4, 0x48, 0xa2, 0x90, 0x5f, 0x02, 0xaa, 0x0b, 0xa1,
-	0xcf, 0x34, 0x2e, 0x95, 0xa5, 0xa5, 0x91, 0xe5, 0xcc, 0x9a, 0x92, 0x50, 0x54, 0xaf, 0xb7, 0xc0,
-	0x99, 0x3a, 0x5d, 0xef, 0x8d, 0x83, 0x1e, 0x76, 0x95, 0x32, 0xcc, 0x4a, 0xb9, 0x76, 0x62, 0x29,
-	0xc2, 0x5f, 0xbc, 0x96, 0x7b, 0xe2, 0x46, 0x8e, 0x06, 0xe7, 0x98, 0x5d, 0x86, 0x6c, 0xdd, 0xad,
-	0xd6, 0xa8, 0x5e, 0x75, 0xf7, 0xaa, 0x0d, 0xdc, 0x61, 0xb0, 0x8d, 0xab, 0x50, 0x77, 0xcb, 0x54,
-	0xdf, 0xd9, 0x7b, 0x84, 0x3b, 0xc5, 0xc3, 0x04, 0xdc, 0x43, 0x30, 0x7e, 0x0e, 0xd3, 0x47, 0xc0,
-	0x10, 0xf0, 0x0f, 0x8c, 0xc5, 0x54, 0x2f, 0x16, 0xc5, 0xd7, 0x12, 0xe4, 0x59, 0xfc, 0xf2, 0xf3,
-	0xcd, 0x2d, 0xdc, 0xc4, 0x26, 0x6f, 0xf7, 0xa0, 0x80, 0x32, 0xa4, 0x09, 0xd5, 0x68, 0x9b, 0x53,
-	0x2a, 0xb7, 0x76, 0x23, 0x21, 0x62, 0x97, 0x75, 0x85, 0x59, 0xa8, 0xc2, 0xb2, 0x87, 0x38, 0xc3,
-	0x67, 0x26, 0xce, 0x5f, 0x24, 0xd1, 0x38, 0xbd, 0xa9, 0x0a, 0xa0, 0x76, 0x61, 0xd2, 0x47, 0xda,
-	0x88, 0x44, 0x82, 0x32, 0xb7, 0x4e, 0x93, 0x74, 0x88, 0x51, 0xae, 0x46, 0xf5, 0x98, 0xfb, 0xf3,
-	0x23, 0xcb, 0xef, 0x25, 0xb8, 0xd6, 0xf7, 0xaa, 0xfb, 0xe0, 0x7e, 0x32, 0x71, 0xce, 0x0d, 0xd6,
-	0x7f, 0x49, 0xb0, 0x7c, 0x72, 0x5a, 0x02, 0x63, 0x0f, 0x2e, 0xc5, 0x30, 0x76, 0xbc, 0x3e, 0x68,
-	0x7f, 0xfb, 0x44, 0xb4, 0x9d, 0x7e, 0xae, 0xd5, 0xb9, 0x08, 0xf7, 0x2e, 0x85, 0xf3, 0xbb, 0x80,
-	0x1f, 0xc2, 0xa5, 0xa3, 0xfc, 0x09, 0x10, 0x5f, 0x81, 0x2f, 0x44, 0xb2, 0x55, 0xda, 0xa9, 0x36,
-	0x34, 0xd2, 0x88, 0xe1, 0x3e, 0x25, 0x44, 0xcf, 0x3b, 0x8f, 0x34, 0xd2, 0xf0, 0xdb, 0xf6, 0x45,
-	0xbf, 0xb6, 0x09, 0x61, 0xaa, 0x40, 0xae, 0x9b, 0x8a, 0xa2, 0x61, 0x07, 0x63, 0x62, 0xb6, 0x8b,
-	0x89, 0xc5, 0xff, 0xa6, 0xe1, 0x62, 0xff, 0x70, 0xdf, 0x85, 0x8c, 0xef, 0x0c, 0x7b, 0x55, 0xcd,
-	0x30, 0xf8, 0xcb, 0x61, 0xbc, 0x2c, 0xbf, 0x7f, 0xb3, 0x32, 0x23, 0x50, 0xba, 0x67, 0x18, 0x1e,
-	0x26, 0xa4, 0x42, 0x3d, 0xcb, 0x36, 0x55, 0xe0, 0xca, 0xfe, 0x21, 0x7a, 0x06, 0x69, 0xce, 0x32,
-	0x06, 0xec, 0x44, 0xf9, 0x3b, 0x1f, 0x3e, 0x2e, 0x6e, 0x98, 0x16, 0x6d, 0xb4, 0x6b, 0x25, 0xdd,
-	0x69, 0x29, 0x22, 0xdf, 0xa6, 0x56, 0x23, 0x2b, 0x96, 0x13, 0x3c, 0x2a, 0xf4, 0xc0, 0xc5, 0xa4,
-	0x54, 0x7e, 0xbc, 0xb3, 0xbe, 0x71, 0x7b, 0xa7, 0x5d, 0x7b, 0x82, 0x0f, 0xd4, 0xd1, 0x9a, 0xcf,
-	0x4c, 0xf4, 0x0b, 0xc8, 0x45, 0xcc, 0x6d, 0x5a, 0x84, 0xca, 0x23, 0x4b, 0x23, 0xff, 0x97, 0xe3,
-	0x8c, 0x20, 0xfd, 0x53, 0x8b, 0x35, 0xc6, 0x44, 0x78, 0x4d, 0x56, 0x0b, 0xcb, 0x29, 0x36, 0x22,
-	0x33, 0xc1, 0xfd, 0x58, 0x2d, 0x2c, 0x54, 0x3c, 0x5a, 0x6d, 0x60, 0xcb, 0x6c, 0x50, 0x79, 0x34,
-	0x54, 0xf1, 0xe8, 0x23, 0x76, 0x84, 0x16, 0x00, 0xb0, 0x6d, 0x04, 0x0a, 0x69, 0xa6, 0x30, 0x8e,
-	0x6d, 0x43, 0x88, 0xe7, 0x61, 0x9c, 0x3a, 0x54, 0x6b, 0x56, 0x89, 0x46, 0xe5, 0x0b, 0x4b, 0xd2,
-	0x72, 0x4a, 0x1d, 0x63, 0x07, 0x15, 0x8d, 0xa2, 0x2b, 0x90, 0x8b, 0x13, 0x05, 0x77, 0xe4, 0x31,
-	0xc6, 0x91, 0x89, 0x88, 0x23, 0xb8, 0x83, 0xae, 0xc2, 0x24, 0x69, 0x6a, 0xa4, 0x11, 0x53, 0x1b,
-	0x67, 0x6a, 0xd9, 0xe0, 0x98, 0xeb, 0xdd, 0x81, 0xb9, 0xa8, 0x99, 0x98, 0xa8, 0x4a, 0x2c, 0x93,
-	0xe9, 0x03, 0xd3, 0x9f, 0x09, 0xc5, 0x15, 0x5f, 0x5a, 0xb1, 0x4c, 0xdf, 0x6c, 0x17, 0xb2, 0xba,
-	0xb3, 0x8f, 0x6d, 0xcd, 0xa6, 0xbe, 0x3e, 0x91, 0x33, 0xac, 0xf7, 0x6e, 0x27, 0xf0, 0x6b, 0x53,
-	0xe8, 0xde, 0x33, 0x34, 0xd7, 0xf7, 0x64, 0x99, 0xb6, 0x46, 0xdb, 0x1e, 0x26, 0xea, 0x44, 0xe0,
-	0xa6, 0x62, 0x99, 0x04, 0xdd, 0x02, 0x14, 0xd4, 0xe6, 0xb4, 0xa9, 0xdb, 0xa6, 0x55, 0xcb, 0xe8,
-	0xc8, 0x13, 0x0c, 0x9f, 0xa0, 0x07, 0x9e, 0x31, 0xc1, 0x63, 0xa3, 0x83, 0x66, 0x21, 0xad, 0xe9,
-	0xd4, 0xda, 0xc7, 0x72, 0x76, 0x49, 0x5a, 0x1e, 0x53, 0xc5, 0x13, 0x5a, 0x64, 0x74, 0xa4, 0x6d,
-	0x52, 0x35, 0x30, 0xd1, 0xe5, 0x1c, 0x7f, 0x75, 0xf1, 0xa3, 0x2d, 0x4c, 0x74, 0xf4, 0x15, 0xe4,
-	0xda, 0x76, 0xcd, 0xb1, 0x8d, 0xf0, 0x1a, 0x27, 0x59, 0x88, 0x6c, 0x78, 0xca, 0x2e, 0x52, 0x87,
-	0x8b, 0x6d, 0x3b, 0xea, 0xa1, 0xaa, 0x27, 0xf8, 0x2e, 0x4f, 0xb1, 0x66, 0x2a, 0x25, 0x37, 0xd3,
-	0x6e, 0xcc, 0x2c, 0x6c, 0xa7, 0x99, 0x76, 0x9f, 0x53, 0x3f, 0x17, 0xfe, 0x05, 0x54, 0x0d, 0xbe,
-	0xba, 0xa6, 0x79, 0x2e, 0xfc, 0x54, 0x7c, 0x63, 0x15, 0xb7, 0xa1, 0x10, 0xbe, 0x9c, 0x76, 0x83,
-	0x2c, 0x1f, 0xdb, 0x75, 0x27, 0x74, 0x74, 0x13, 0x10, 0x71, 0x7d, 0x56, 0xb1, 0xee, 0x0a, 0x2e,
-	0x9d, 0xbf, 0x3f, 0x26, 0x99, 0xa4, 0xe2, 0x0b, 0xd8, 0xb5, 0x17, 0xff, 0x33, 0x02, 0x73, 0x09,
-	0x79, 0xa2, 0x65, 0x98, 0x8a, 0xa1, 0x13, 0x77, 0x13, 0xa1, 0xc6, 0xc9, 0xa3, 0xc3, 0x7c, 0xc8,
-	0x82, 0xc8, 0xc4, 0xe7, 0x0f, 0x6b, 0xbc, 0x61, 0xc6, 0x89, 0x2b, 0x09, 0x30, 0x85, 0x24, 0x60,
-	0x55, 0xc8, 0x81, 0xa3, 0xb0, 0xb8, 0x8a, 0x65, 0xb2, 0x8e, 0xeb, 0xc3, 0xe4, 0x91, 0x7e, 0x4c,
-	0xbe, 0x0b, 0xf9, 0x1e, 0x26, 0x07, 0xc9, 0xf8, 0x26, 0x29, 0x66, 0x32, 0xd7, 0x4d, 0x66, 0x1e,
-	0xc5, 0x37, 0xae, 0xc3, 0x6c, 0xc4, 0xe7, 0x98, 0x2d, 0x91, 0x47, 0xcf, 0x48, 0xec, 0x99, 0x90,
-	0xd8, 0x51, 0x24, 0x82, 0x7e, 0x2d, 0xc1, 0xe5, 0x28, 0xcb, 0x08, 0x33, 0xcb, 0xae, 0x3b, 0x11,
-	0xbf, 0xd2, 0x8c, 0x5f, 0x77, 0x12, 0x62, 0x1e, 0xcf, 0x03, 0xb5, 0x60, 0x1c, 0x2b, 0x2f, 0xea,
-	0xb0, 0x78, 0xc2, 0x28, 0x44, 0x3f, 0x80, 0x94, 0x81, 0x9b, 0x67, 0xfb, 0x7c, 0x61, 0x96, 0xc5,
-	0x57, 0x29, 0x90, 0x13, 0xbf, 0x28, 0xef, 0x43, 0xc6, 0x6f, 0x4c, 0xcf, 0x72, 0x63, 0xa3, 0xe9,
-	0x5b, 0xc1, 0x44, 0x8d, 0x22, 0xf0, 0x71, 0xba, 0x15, 0xa9, 0xaa, 0x71, 0x3b, 0xb4, 0x0d, 0xa0,
-	0x3b, 0xad, 0x96, 0x45, 0x48, 0x30, 0x97, 0xc7, 0xcb, 0x2b, 0x1f, 0x3e, 0x2e, 0xce, 0x73, 0x47,
-	0xc4, 0xd8, 0x2b, 0x59, 0x8e, 0xd2, 0xd2, 0x68, 0xa3, 0xf4, 0x14, 0x9b, 0x9a, 0x7e, 0xb0, 0x85,
-	0xf5, 0xf7, 0x6f, 0x56, 0x40, 0xc4, 0xd9, 0xc2, 0xba, 0x1a, 0x73, 0x80, 0x6e, 0x41, 0x8a, 0x4d,
-	0xaf, 0x91, 0x13, 0xa6, 0x17, 0xd3, 0x8a, 0xcd, 0xad, 0xd4, 0xf9, 0xcc, 0xad, 0x6f, 0x60, 0xc4,
-	0x75, 0x5c, 0x36, 0x2c, 0x32, 0x6b, 0x37, 0x93, 0x36, 0x27, 0xcf, 0x71, 0xea, 0xcf, 0xea, 0x3b,
-	0x0e, 0x21, 0x98, 0x65, 0x5d, 0x7e, 0xbe, 0xa9, 0xfa, 0x76, 0x68, 0x03, 0x66, 0x19, 0x6f, 0xb1,
-	0x51, 0x15, 0xa6, 0xf1, 0xe9, 0x92, 0x52, 0x67, 0x84, 0xb4, 0xcc, 0x85, 0x62, 0xd0, 0xf8, 0xef,
-	0xdb, 0xc0, 0x8a, 0xea, 0x81, 0xc5, 0x05, 0xf1, 0xbe, 0x15, 0x16, 0x54, 0x17, 0xda, 0xb3, 0x90,
-	0x16, 0x1a, 0x63, 0xcc, 0xa7, 0x78, 0xf2, 0xcf, 0x7f, 0xa5, 0x59, 0x4d, 0x6c, 0xb0, 0x11, 0x33,
-	0xa6, 0x8a, 0xa7, 0xb5, 0xd7, 0x00, 0xa3, 0xec, 0x23, 0x05, 0xfd, 0x46, 0x82, 0x34, 0xdf, 0xfa,
-	0xd0, 0xf5, 0x84, 0xd2, 0x8e, 0x2e, 0xbf, 0xf9, 0x1b, 0xa7, 0x51, 0x15, 0xac, 0xfe, 0xea, 0xd5,
-	0xdf, 0xfe, 0xf9, 0xbb, 0xe1, 0x45, 0xb4, 0xa0, 0x1c, 0xb7, 0xb4, 0xa3, 0x3f, 0x48, 0x30, 0xd9,
-	0xb3, 0xbe, 0xa2, 0xb5, 0x93, 0xc3, 0xf4, 0x2e, 0xc9, 0xf9, 0xf5, 0x81, 0x6c, 0x44, 0x8e, 0x0a,
-	0xcb, 0xf1, 0x3a, 0xba, 0x76, 0x6c, 0x8e, 0xca, 0x4b, 0x31, 0x08, 0x0e, 0xd1, 0x9f, 0x24, 0x98,
-	0x3e, 0xb2, 0xa5, 0xa2, 0x8d, 0xe3, 0x62, 0x27, 0xad, 0xcf, 0xf9, 0x3b, 0x03, 0x5a, 0x89, 0x9c,
-	0x57, 0x59, 0xce, 0x37, 0xd1, 0xf5, 0x84, 0x9c, 0x8f, 0xee, 0xc9, 0xe8, 0xbd, 0x04, 0x53, 0xbd,
-	0x0e, 0xd1, 0xfa, 0x20, 0xe1, 0x83, 0x9c, 0x37, 0x06, 0x33, 0x12, 0x29, 0x57, 0x58, 0xca, 0xdb,
-	0xe8, 0xc9, 0xa9, 0x53, 0x56, 0x5e, 0x76, 0x2d, 0x3b, 0x87, 0x47, 0x55, 0xd0, 0x1f, 0x25, 0xc8,
-	0x75, 0xef, 0x7d, 0x68, 0xf5, 0xb8, 0xec, 0xfa, 0xae, 0xb3, 0xf9, 0xb5, 0x41, 0x4c, 0x44, 0x39,
-	0x5f, 0xb3, 0x72, 0x56, 0x91, 0xa2, 0x24, 0xfe, 0xd5, 0x14, 0xdf, 0x82, 0x94, 0x97, 0xfc, 0x43,
-	0xe7, 0x10, 0xfd, 0x5b, 0x82, 0xf9, 0x63, 0x76, 0x2a, 0xf4, 0xbd, 0x41, 0xd0, 0xed, 0x53, 0xcc,
-	0xf7, 0xcf, 0x6c, 0x2f, 0x2a, 0xdb, 0x66, 0x95, 0x3d, 0x44, 0xf7, 0xcf, 0x7e, 0x51, 0xb1, 0xc2,
-	0xd1, 0x9f, 0x25, 0xc8, 0x76, 0x61, 0x88, 0x6e, 0x9f, 0x1a, 0xee, 0xa0, 0xa6, 0xd5, 0x01, 0x2c,
-	0x44, 0x15, 0x9b, 0xac, 0x8a, 0x6f, 0xd0, 0xdd, 0x53, 0xdd, 0x0f, 0xbb, 0x9e, 0xde, 0x2d, 0xef,
-	0xb0, 0xfc, 0xa3, 0xb7, 0x9f, 0x0a, 0xd2, 0xbb, 0x4f, 0x05, 0xe9, 0x1f, 0x9f, 0x0a, 0xd2, 0x6f,
-	0x3f, 0x17, 0x86, 0xde, 0x7d, 0x2e, 0x0c, 0xfd, 0xfd, 0x73, 0x61, 0xe8, 0xa7, 0xa7, 0x18, 0x2a,
-	0x9d, 0x78, 0x44, 0x36, 0x61, 0x6a, 0x69, 0xf6, 0xa7, 0xe2, 0xfa, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xd4, 0x91, 0x2b, 0x9a, 0x9e, 0x15, 0x00, 0x00,
+// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
+type BTCDelegationResponse struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList []github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staking_time is the number of blocks for which the delegation is locked on BTC chain
+	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	// it is the start BTC height of the timelock
+	StartHeight uint32 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	// it is the end BTC height of the timelock - w
+	EndHeight uint32 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx_hex is the hex string of staking tx
+	StakingTxHex string `protobuf:"bytes,8,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slash_sig_hex is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
+	// It will be a part of the witness for the staking tx output.
+	DelegatorSlashSigHex string `protobuf:"bytes,10,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx
+	// by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,11,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,12,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// whether this delegation is active
+	Active bool `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	// descriptive status of current delegation.
+	StatusDesc string `protobuf:"bytes,14,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// unbonding_time used in unbonding output timelock path and in slashing transactions
+	// change outputs
+	UnbondingTime uint32 `protobuf:"varint,15,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// undelegation_response is the undelegation info of this delegation.
+	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,16,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
+	// params version used to validate delegation
+	ParamsVersion uint32 `protobuf:"varint,17,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// reward_address is the address to receive rewards from BTC delegation.
+	RewardAddress string `protobuf:"bytes,18,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
+func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationResponse) ProtoMessage()    {}
+func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{32}
+}
+func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+}
+func (m *BTCDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+func (m *BTCDelegationResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *BTCDelegationResponse) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *BTCDelegationResponse) GetStartHeight() uint32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetEndHeight() uint32 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
-	out := new(QueryParamsByVersionResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
-	out := new(QueryFinalityProvidersResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
-	out := new(QueryFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
-	out := new(QueryBTCDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashSigHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
-	out := new(QueryFinalityProviderDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSigs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
-	out := new(QueryBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+func (m *BTCDelegationResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *BTCDelegationResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+
+func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+	if m != nil {
+		return m.UndelegationResponse
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+
+func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+
+func (m *BTCDelegationResponse) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+
+// DelegatorUnbondingInfoResponse provides all necessary info about transaction
+// which spent the staking output
+type DelegatorUnbondingInfoResponse struct {
+	// spend_stake_tx_hex is the transaction which spent the staking output. It is
+	// filled only if the spend_stake_tx_hex is different than the unbonding_tx_hex
+	SpendStakeTxHex string `protobuf:"bytes,1,opt,name=spend_stake_tx_hex,json=spendStakeTxHex,proto3" json:"spend_stake_tx_hex,omitempty"`
 }
-func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+
+func (m *DelegatorUnbondingInfoResponse) Reset()         { *m = DelegatorUnbondingInfoResponse{} }
+func (m *DelegatorUnbondingInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegatorUnbondingInfoResponse) ProtoMessage()    {}
+func (*DelegatorUnbondingInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{33}
 }
-func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+func (m *DelegatorUnbondingInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorUnbondingInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorUnbondingInfoResponse.Merge(m, src)
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorUnbondingInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_DelegatorUnbondingInfoResponse proto.InternalMessageInfo
+
+func (m *DelegatorUnbondingInfoResponse) GetSpendStakeTxHex() string {
+	if m != nil {
+		return m.SpendStakeTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// BTCUndelegationResponse provides all necessary info about the undeleagation
+type BTCUndelegationResponse struct {
+	// unbonding_tx is the transaction which will transfer the funds from staking
+	// output to unbonding output. Unbonding output will usually have lower timelock
+	// than staking output. The unbonding tx as string hex.
+	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
+	// by covenant members
+	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,2,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// slashingTxHex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It will be a part of the witness for the unbonding tx output.
+	// The delegator slashing sig as string hex.
+	DelegatorSlashingSigHex string `protobuf:"bytes,4,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
+	// covenant_slashing_sigs is a list of adaptor signatures on the
+	// unbonding slashing tx by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
+	// btc_undelegation_info contains all necessary info about the transaction
+	// which spent the staking output
+	DelegatorUnbondingInfoResponse *DelegatorUnbondingInfoResponse `protobuf:"bytes,6,opt,name=delegator_unbonding_info_response,json=delegatorUnbondingInfoResponse,proto3" json:"delegator_unbonding_info_response,omitempty"`
 }
 
-func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
+func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCUndelegationResponse) ProtoMessage()    {}
+func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{34}
+}
+func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+}
+func (m *BTCUndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
 }
 
-func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+
+func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantUnbondingSigList
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegation(ctx, in)
+func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashingSigHex
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	return ""
+}
+
+func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSlashingSigs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	return nil
+}
+
+func (m *BTCUndelegationResponse) GetDelegatorUnbondingInfoResponse() *DelegatorUnbondingInfoResponse {
+	if m != nil {
+		return m.DelegatorUnbondingInfoResponse
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
-		{
-			MethodName: "FinalityProviderDelegations",
-			Handler:    _Query_FinalityProviderDelegations_Handler,
-		},
-		{
-			MethodName: "BTCDelegation",
-			Handler:    _Query_BTCDelegation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/query.proto",
+// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
+type BTCDelegatorDelegationsResponse struct {
+	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
+func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{35}
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+	if m != nil {
+		return m.Dels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FinalityProviderResponse defines a finality provider with voting power information.
+type FinalityProviderResponse struct {
+	// description defines the description terms for the finality provider.
+	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the commission rate of the finality provider.
+	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+	// addr is the address to receive commission from delegations.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of the BTC_PK by the fp addr.
+	// Essentially is the signature where the BTC SK sigs the fp addr.
+	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// slashed_babylon_height indicates the Babylon height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height indicates the BTC height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// height is the queried Babylon height
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// slashing_reason indicates why the finality provider is slashed.
+	// if the finality provider is not slashed then it is unspecified
+	SlashingReason SlashingReason `protobuf:"varint,10,opt,name=slashing_reason,json=slashingReason,proto3,enum=babylon.btcstaking.v1.SlashingReason" json:"slashing_reason,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
+func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderResponse) ProtoMessage()    {}
+func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{36}
+}
+func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
 }
-
-func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+
+func (m *FinalityProviderResponse) GetDescription() *types.Description {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
+	if m != nil {
+		return m.Pop
+	}
+	return nil
 }
 
-func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+	if m != nil {
+		return m.SlashedBabylonHeight
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint32 {
+	if m != nil {
+		return m.SlashedBtcHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *FinalityProviderResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSlashingReason() SlashingReason {
+	if m != nil {
+		return m.SlashingReason
 	}
-	return dAtA[:n], nil
+	return SlashingReason_SLASHING_REASON_UNSPECIFIED
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalityProviderCommissionAtDelegationRequest is the request type for
+// the Query/FinalityProviderCommissionAtDelegation RPC method.
+type QueryFinalityProviderCommissionAtDelegationRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) Reset() {
+	*m = QueryFinalityProviderCommissionAtDelegationRequest{}
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderCommissionAtDelegationRequest) ProtoMessage() {}
+func (*QueryFinalityProviderCommissionAtDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{37}
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalityProviderCommissionAtDelegationResponse is the response type
+// for the Query/FinalityProviderCommissionAtDelegation RPC method.
+type QueryFinalityProviderCommissionAtDelegationResponse struct {
+	// creation_height is the Babylon block height at which the BTC delegation
+	// was created
+	CreationHeight uint64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// fp_commissions contains the commission rate of each finality provider
+	// at creation_height, in the order of the finality providers of the BTC
+	// delegation
+	FpCommissions []*FpCommission `protobuf:"bytes,2,rep,name=fp_commissions,json=fpCommissions,proto3" json:"fp_commissions,omitempty"`
 }
 
-func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) Reset() {
+	*m = QueryFinalityProviderCommissionAtDelegationResponse{}
 }
-
-func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderCommissionAtDelegationResponse) ProtoMessage() {}
+func (*QueryFinalityProviderCommissionAtDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{38}
+}
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		{
-			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFinalityProviderCommissionAtDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) GetCreationHeight() uint64 {
+	if m != nil {
+		return m.CreationHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) GetFpCommissions() []*FpCommission {
+	if m != nil {
+		return m.FpCommissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FpCommission is the commission rate of a finality provider at a given height
+type FpCommission struct {
+	// fp_btc_pk_hex is the hex str of the BIP-340 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// commission is the commission rate of the finality provider
+	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *FpCommission) Reset()         { *m = FpCommission{} }
+func (m *FpCommission) String() string { return proto.CompactTextString(m) }
+func (*FpCommission) ProtoMessage()    {}
+func (*FpCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{39}
+}
+func (m *FpCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FpCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FpCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FpCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FpCommission.Merge(m, src)
+}
+func (m *FpCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *FpCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_FpCommission.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FpCommission proto.InternalMessageInfo
+
+func (m *FpCommission) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationCovenantCoverageRequest is the request type for the
+// Query/BTCDelegationCovenantCoverage RPC method.
+type QueryBTCDelegationCovenantCoverageRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BtcDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBTCDelegationCovenantCoverageRequest) Reset() {
+	*m = QueryBTCDelegationCovenantCoverageRequest{}
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationCovenantCoverageRequest) ProtoMessage() {}
+func (*QueryBTCDelegationCovenantCoverageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{40}
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationCovenantCoverageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationCovenantCoverageRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationCovenantCoverageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCDelegationCovenantCoverageRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationCovenantCoverageRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationCovenantCoverageResponse is the response type for the
+// Query/BTCDelegationCovenantCoverage RPC method.
+type QueryBTCDelegationCovenantCoverageResponse struct {
+	// params_version is the version of the params the BTC delegation was
+	// validated against, which the covenant committee is taken from
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// signed_count is the number of members of the covenant committee that
+	// have submitted their signatures for the BTC delegation
+	SignedCount uint32 `protobuf:"varint,2,opt,name=signed_count,json=signedCount,proto3" json:"signed_count,omitempty"`
+	// required_quorum is the number of covenant signatures required for the
+	// BTC delegation
+	RequiredQuorum uint32 `protobuf:"varint,3,opt,name=required_quorum,json=requiredQuorum,proto3" json:"required_quorum,omitempty"`
+	// coverage_ratio is signed_count / required_quorum, capped at 1
+	CoverageRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=coverage_ratio,json=coverageRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"coverage_ratio"`
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBTCDelegationCovenantCoverageResponse) Reset() {
+	*m = QueryBTCDelegationCovenantCoverageResponse{}
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationCovenantCoverageResponse) ProtoMessage() {}
+func (*QueryBTCDelegationCovenantCoverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{41}
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationCovenantCoverageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationCovenantCoverageResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationCovenantCoverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationCovenantCoverageResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationCovenantCoverageResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBTCDelegationCovenantCoverageResponse) GetSignedCount() uint32 {
+	if m != nil {
+		return m.SignedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBTCDelegationCovenantCoverageResponse) GetRequiredQuorum() uint32 {
+	if m != nil {
+		return m.RequiredQuorum
+	}
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryBTCDelegationsByValueRangeRequest is the request type for the
+// Query/BTCDelegationsByValueRange RPC method.
+type QueryBTCDelegationsByValueRangeRequest struct {
+	// min_sat is the minimum staking value in satoshis, inclusive
+	MinSat uint64 `protobuf:"varint,1,opt,name=min_sat,json=minSat,proto3" json:"min_sat,omitempty"`
+	// max_sat is the maximum staking value in satoshis, inclusive
+	MaxSat uint64 `protobuf:"varint,2,opt,name=max_sat,json=maxSat,proto3" json:"max_sat,omitempty"`
+	// pagination defines an optional pagination for the request. Only
+	// key-based pagination in ascending order is supported
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByValueRangeRequest) Reset() {
+	*m = QueryBTCDelegationsByValueRangeRequest{}
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByValueRangeRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationsByValueRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{42}
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByValueRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByValueRangeRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByValueRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByValueRangeRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByValueRangeRequest) GetMinSat() uint64 {
+	if m != nil {
+		return m.MinSat
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBTCDelegationsByValueRangeRequest) GetMaxSat() uint64 {
+	if m != nil {
+		return m.MaxSat
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBTCDelegationsByValueRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
-		i--
-		dAtA[i] = 0xa
+// QueryBTCDelegationsByValueRangeResponse is the response type for the
+// Query/BTCDelegationsByValueRange RPC method.
+type QueryBTCDelegationsByValueRangeResponse struct {
+	// btc_delegations contains the queried BTC delegations in ascending order
+	// of staking value
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByValueRangeResponse) Reset() {
+	*m = QueryBTCDelegationsByValueRangeResponse{}
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByValueRangeResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationsByValueRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{43}
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByValueRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByValueRangeResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByValueRangeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCDelegationsByValueRangeResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByValueRangeResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBTCDelegationsByValueRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryCovenantInfoRequest is the request type for the Query/CovenantInfo RPC
+// method.
+type QueryCovenantInfoRequest struct {
+}
+
+func (m *QueryCovenantInfoRequest) Reset()         { *m = QueryCovenantInfoRequest{} }
+func (m *QueryCovenantInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantInfoRequest) ProtoMessage()    {}
+func (*QueryCovenantInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{44}
+}
+func (m *QueryCovenantInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantInfoRequest.Merge(m, src)
+}
+func (m *QueryCovenantInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantInfoRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCovenantInfoRequest proto.InternalMessageInfo
+
+// QueryCovenantInfoResponse is the response type for the Query/CovenantInfo
+// RPC method.
+type QueryCovenantInfoResponse struct {
+	// params_version is the version of the latest params, from which the
+	// covenant committee is taken
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// covenant_pks_hex is the list of public keys of the covenant committee,
+	// each in hex format
+	CovenantPksHex []string `protobuf:"bytes,2,rep,name=covenant_pks_hex,json=covenantPksHex,proto3" json:"covenant_pks_hex,omitempty"`
+	// covenant_quorum is the minimum number of signatures needed from the
+	// covenant committee
+	CovenantQuorum uint32 `protobuf:"varint,3,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// committee_size is the number of members of the covenant committee
+	CommitteeSize uint32 `protobuf:"varint,4,opt,name=committee_size,json=committeeSize,proto3" json:"committee_size,omitempty"`
+	// threshold_percentage is covenant_quorum / committee_size * 100
+	ThresholdPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=threshold_percentage,json=thresholdPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold_percentage"`
+}
+
+func (m *QueryCovenantInfoResponse) Reset()         { *m = QueryCovenantInfoResponse{} }
+func (m *QueryCovenantInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantInfoResponse) ProtoMessage()    {}
+func (*QueryCovenantInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{45}
+}
+func (m *QueryCovenantInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryCovenantInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantInfoResponse.Merge(m, src)
+}
+func (m *QueryCovenantInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantInfoResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryCovenantInfoResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantInfoResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+func (m *QueryCovenantInfoResponse) GetCovenantPksHex() []string {
+	if m != nil {
+		return m.CovenantPksHex
 	}
-	if m.UndelegationResponse != nil {
-		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	return nil
+}
+
+func (m *QueryCovenantInfoResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
 	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x60
+	return 0
+}
+
+func (m *QueryCovenantInfoResponse) GetCommitteeSize() uint32 {
+	if m != nil {
+		return m.CommitteeSize
 	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+	return 0
+}
+
+// QueryVerifyInclusionProofAtRequest is the request type for the
+// Query/VerifyInclusionProofAt RPC method.
+type QueryVerifyInclusionProofAtRequest struct {
+	// staking_tx_hex is the staking tx in hex format
+	StakingTxHex string `protobuf:"bytes,1,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// staking_time is the timelock of the staking tx in BTC blocks
+	StakingTime uint32 `protobuf:"varint,2,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// staking_tx_inclusion_proof is the inclusion proof of the staking tx
+	StakingTxInclusionProof *InclusionProof `protobuf:"bytes,3,opt,name=staking_tx_inclusion_proof,json=stakingTxInclusionProof,proto3" json:"staking_tx_inclusion_proof,omitempty"`
+	// btc_height is the height of the BTC tip as of which the inclusion proof
+	// is verified
+	BtcHeight uint32 `protobuf:"varint,4,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) Reset()         { *m = QueryVerifyInclusionProofAtRequest{} }
+func (m *QueryVerifyInclusionProofAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyInclusionProofAtRequest) ProtoMessage()    {}
+func (*QueryVerifyInclusionProofAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{46}
+}
+func (m *QueryVerifyInclusionProofAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyInclusionProofAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyInclusionProofAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *QueryVerifyInclusionProofAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyInclusionProofAtRequest.Merge(m, src)
+}
+func (m *QueryVerifyInclusionProofAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyInclusionProofAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyInclusionProofAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyInclusionProofAtRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyInclusionProofAtRequest) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
 	}
-	if m.StakingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) GetStakingTxInclusionProof() *InclusionProof {
+	if m != nil {
+		return m.StakingTxInclusionProof
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return nil
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) GetBtcHeight() uint32 {
+	if m != nil {
+		return m.BtcHeight
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return 0
+}
+
+// QueryVerifyInclusionProofAtResponse is the response type for the
+// Query/VerifyInclusionProofAt RPC method.
+type QueryVerifyInclusionProofAtResponse struct {
+	// inclusion_height is the height of the BTC block that includes the
+	// staking tx, i.e., the start height of the BTC delegation
+	InclusionHeight uint32 `protobuf:"varint,1,opt,name=inclusion_height,json=inclusionHeight,proto3" json:"inclusion_height,omitempty"`
+	// end_height is the height at which the timelock of the staking tx
+	// expires, i.e., the end height of the BTC delegation
+	EndHeight uint32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryVerifyInclusionProofAtResponse) Reset()         { *m = QueryVerifyInclusionProofAtResponse{} }
+func (m *QueryVerifyInclusionProofAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyInclusionProofAtResponse) ProtoMessage()    {}
+func (*QueryVerifyInclusionProofAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{47}
+}
+func (m *QueryVerifyInclusionProofAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyInclusionProofAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyInclusionProofAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.StakerAddr) > 0 {
-		i -= len(m.StakerAddr)
-		copy(dAtA[i:], m.StakerAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryVerifyInclusionProofAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyInclusionProofAtResponse.Merge(m, src)
+}
+func (m *QueryVerifyInclusionProofAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyInclusionProofAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyInclusionProofAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyInclusionProofAtResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyInclusionProofAtResponse) GetInclusionHeight() uint32 {
+	if m != nil {
+		return m.InclusionHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegatorUnbondingInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyInclusionProofAtResponse) GetEndHeight() uint32 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegatorUnbondingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationFinalityProviderStatusesRequest is the request type for
+// the Query/BTCDelegationFinalityProviderStatuses RPC method.
+type QueryBTCDelegationFinalityProviderStatusesRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *DelegatorUnbondingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpendStakeTxHex) > 0 {
-		i -= len(m.SpendStakeTxHex)
-		copy(dAtA[i:], m.SpendStakeTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpendStakeTxHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) Reset() {
+	*m = QueryBTCDelegationFinalityProviderStatusesRequest{}
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationFinalityProviderStatusesRequest) ProtoMessage() {}
+func (*QueryBTCDelegationFinalityProviderStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{48}
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesRequest.DiscardUnknown(m)
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegationFinalityProviderStatus is the status of a finality provider of a
+// BTC delegation
+type DelegationFinalityProviderStatus struct {
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex format
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// slashed indicates whether the finality provider is slashed
+	Slashed bool `protobuf:"varint,2,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	// jailed indicates whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// has_active_delegations indicates whether the finality provider currently
+	// has at least one active BTC delegation. A finality provider that is
+	// neither slashed nor jailed but has no active BTC delegation is dormant
+	HasActiveDelegations bool `protobuf:"varint,4,opt,name=has_active_delegations,json=hasActiveDelegations,proto3" json:"has_active_delegations,omitempty"`
 }
 
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegatorUnbondingInfoResponse != nil {
-		{
-			size, err := m.DelegatorUnbondingInfoResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *DelegationFinalityProviderStatus) Reset()         { *m = DelegationFinalityProviderStatus{} }
+func (m *DelegationFinalityProviderStatus) String() string { return proto.CompactTextString(m) }
+func (*DelegationFinalityProviderStatus) ProtoMessage()    {}
+func (*DelegationFinalityProviderStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{49}
+}
+func (m *DelegationFinalityProviderStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationFinalityProviderStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationFinalityProviderStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+}
+func (m *DelegationFinalityProviderStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationFinalityProviderStatus.Merge(m, src)
+}
+func (m *DelegationFinalityProviderStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationFinalityProviderStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationFinalityProviderStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationFinalityProviderStatus proto.InternalMessageInfo
+
+func (m *DelegationFinalityProviderStatus) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *DelegationFinalityProviderStatus) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
 	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *DelegationFinalityProviderStatus) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return false
+}
+
+func (m *DelegationFinalityProviderStatus) GetHasActiveDelegations() bool {
+	if m != nil {
+		return m.HasActiveDelegations
+	}
+	return false
+}
+
+// QueryBTCDelegationFinalityProviderStatusesResponse is the response type
+// for the Query/BTCDelegationFinalityProviderStatuses RPC method.
+type QueryBTCDelegationFinalityProviderStatusesResponse struct {
+	// finality_providers contains the status of each finality provider of the
+	// BTC delegation, in the order of the BTC delegation's finality provider
+	// list
+	FinalityProviders []*DelegationFinalityProviderStatus `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) Reset() {
+	*m = QueryBTCDelegationFinalityProviderStatusesResponse{}
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationFinalityProviderStatusesResponse) ProtoMessage() {}
+func (*QueryBTCDelegationFinalityProviderStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{50}
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationFinalityProviderStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) GetFinalityProviders() []*DelegationFinalityProviderStatus {
+	if m != nil {
+		return m.FinalityProviders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryBTCNetworkRequest is the request type for the Query/BTCNetwork RPC
+// method.
+type QueryBTCNetworkRequest struct {
+}
+
+func (m *QueryBTCNetworkRequest) Reset()         { *m = QueryBTCNetworkRequest{} }
+func (m *QueryBTCNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCNetworkRequest) ProtoMessage()    {}
+func (*QueryBTCNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{51}
+}
+func (m *QueryBTCNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCNetworkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryBTCNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCNetworkRequest.Merge(m, src)
+}
+func (m *QueryBTCNetworkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCNetworkRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBTCNetworkRequest proto.InternalMessageInfo
+
+// QueryBTCNetworkResponse is the response type for the Query/BTCNetwork RPC
+// method.
+type QueryBTCNetworkResponse struct {
+	// network is the name of the BTC network the chain is configured for,
+	// e.g., mainnet, testnet3, signet, regtest or simnet. BTC addresses and
+	// proofs of possession are encoded and verified under this network
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBTCNetworkResponse) Reset()         { *m = QueryBTCNetworkResponse{} }
+func (m *QueryBTCNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCNetworkResponse) ProtoMessage()    {}
+func (*QueryBTCNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{52}
+}
+func (m *QueryBTCNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCNetworkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBTCNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCNetworkResponse.Merge(m, src)
+}
+func (m *QueryBTCNetworkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCNetworkResponse.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCNetworkResponse proto.InternalMessageInfo
+
+func (m *QueryBTCNetworkResponse) GetNetwork() string {
+	if m != nil {
+		return m.Network
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalityProviderDelegationCountRequest is the request type for the
+// Query/FinalityProviderDelegationCount RPC method.
+type QueryFinalityProviderDelegationCountRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality
+	// provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// status is the queried status for BTC delegations. ANY counts BTC
+	// delegations under all statuses
+	Status BTCDelegationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryFinalityProviderDelegationCountRequest) Reset() {
+	*m = QueryFinalityProviderDelegationCountRequest{}
+}
+func (m *QueryFinalityProviderDelegationCountRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderDelegationCountRequest) ProtoMessage() {}
+func (*QueryFinalityProviderDelegationCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{53}
+}
+func (m *QueryFinalityProviderDelegationCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderDelegationCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderDelegationCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
+		return b[:n], nil
 	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *QueryFinalityProviderDelegationCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderDelegationCountRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderDelegationCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderDelegationCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderDelegationCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderDelegationCountRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderDelegationCountRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *QueryFinalityProviderDelegationCountRequest) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return BTCDelegationStatus_PENDING
+}
+
+// QueryFinalityProviderDelegationCountResponse is the response type for the
+// Query/FinalityProviderDelegationCount RPC method.
+type QueryFinalityProviderDelegationCountResponse struct {
+	// count is the number of BTC delegations of the finality provider under
+	// the queried status
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryFinalityProviderDelegationCountResponse) Reset() {
+	*m = QueryFinalityProviderDelegationCountResponse{}
+}
+func (m *QueryFinalityProviderDelegationCountResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderDelegationCountResponse) ProtoMessage() {}
+func (*QueryFinalityProviderDelegationCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{54}
+}
+func (m *QueryFinalityProviderDelegationCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderDelegationCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderDelegationCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *QueryFinalityProviderDelegationCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderDelegationCountResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderDelegationCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderDelegationCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderDelegationCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderDelegationCountResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderDelegationCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return 0
+}
+
+// QueryDelegationCountByParamsVersionRequest is the request type for the
+// Query/DelegationCountByParamsVersion RPC method.
+type QueryDelegationCountByParamsVersionRequest struct {
+}
+
+func (m *QueryDelegationCountByParamsVersionRequest) Reset() {
+	*m = QueryDelegationCountByParamsVersionRequest{}
+}
+func (m *QueryDelegationCountByParamsVersionRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationCountByParamsVersionRequest) ProtoMessage() {}
+func (*QueryDelegationCountByParamsVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{55}
+}
+func (m *QueryDelegationCountByParamsVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationCountByParamsVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationCountByParamsVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryDelegationCountByParamsVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationCountByParamsVersionRequest.Merge(m, src)
+}
+func (m *QueryDelegationCountByParamsVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationCountByParamsVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationCountByParamsVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationCountByParamsVersionRequest proto.InternalMessageInfo
+
+// ParamsVersionDelegationCount is the number of BTC delegations that are not
+// unbonded yet under a params version
+type ParamsVersionDelegationCount struct {
+	// version is the params version
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// count is the number of BTC delegations validated against the params
+	// version that are not unbonded yet
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ParamsVersionDelegationCount) Reset()         { *m = ParamsVersionDelegationCount{} }
+func (m *ParamsVersionDelegationCount) String() string { return proto.CompactTextString(m) }
+func (*ParamsVersionDelegationCount) ProtoMessage()    {}
+func (*ParamsVersionDelegationCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{56}
+}
+func (m *ParamsVersionDelegationCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsVersionDelegationCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsVersionDelegationCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ParamsVersionDelegationCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsVersionDelegationCount.Merge(m, src)
+}
+func (m *ParamsVersionDelegationCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsVersionDelegationCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsVersionDelegationCount.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ParamsVersionDelegationCount proto.InternalMessageInfo
+
+func (m *ParamsVersionDelegationCount) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ParamsVersionDelegationCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryDelegationCountByParamsVersionResponse is the response type for the
+// Query/DelegationCountByParamsVersion RPC method.
+type QueryDelegationCountByParamsVersionResponse struct {
+	// counts is the number of BTC delegations that are not unbonded yet under
+	// each params version, in ascending order of params version. Params
+	// versions without such BTC delegations are omitted
+	Counts []*ParamsVersionDelegationCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
 }
 
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+func (m *QueryDelegationCountByParamsVersionResponse) Reset() {
+	*m = QueryDelegationCountByParamsVersionResponse{}
+}
+func (m *QueryDelegationCountByParamsVersionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationCountByParamsVersionResponse) ProtoMessage() {}
+func (*QueryDelegationCountByParamsVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{57}
+}
+func (m *QueryDelegationCountByParamsVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationCountByParamsVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationCountByParamsVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDelegationCountByParamsVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationCountByParamsVersionResponse.Merge(m, src)
+}
+func (m *QueryDelegationCountByParamsVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationCountByParamsVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationCountByParamsVersionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDelegationCountByParamsVersionResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationCountByParamsVersionResponse) GetCounts() []*ParamsVersionDelegationCount {
+	if m != nil {
+		return m.Counts
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBTCDelegationCovenantSigsRequest is the request type for the
+// Query/BTCDelegationCovenantSigs RPC method.
+type QueryBTCDelegationCovenantSigsRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// pagination defines an optional pagination for the request. Only
+	// key-based pagination in ascending order is supported
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationCovenantSigsRequest) Reset()         { *m = QueryBTCDelegationCovenantSigsRequest{} }
+func (m *QueryBTCDelegationCovenantSigsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationCovenantSigsRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationCovenantSigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{58}
+}
+func (m *QueryBTCDelegationCovenantSigsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationCovenantSigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationCovenantSigsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBTCDelegationCovenantSigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationCovenantSigsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationCovenantSigsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationCovenantSigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationCovenantSigsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBTCDelegationCovenantSigsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationCovenantSigsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return n
+	return ""
 }
 
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationCovenantSigsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// CovenantSlashingSigEntry is the adaptor signature of a covenant member on
+// the slashing tx of a BTC delegation, encrypted by the BTC PK of one of the
+// finality providers of the BTC delegation
+type CovenantSlashingSigEntry struct {
+	// cov_pk_hex is the BTC PK of the covenant member in hex format
+	CovPkHex string `protobuf:"bytes,1,opt,name=cov_pk_hex,json=covPkHex,proto3" json:"cov_pk_hex,omitempty"`
+	// fp_index is the index of the finality provider in the BTC delegation's
+	// finality provider list
+	FpIndex uint32 `protobuf:"varint,2,opt,name=fp_index,json=fpIndex,proto3" json:"fp_index,omitempty"`
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex format
+	FpBtcPkHex string `protobuf:"bytes,3,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// adaptor_sig_hex is the adaptor signature in hex format
+	AdaptorSigHex string `protobuf:"bytes,4,opt,name=adaptor_sig_hex,json=adaptorSigHex,proto3" json:"adaptor_sig_hex,omitempty"`
 }
 
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CovenantSlashingSigEntry) Reset()         { *m = CovenantSlashingSigEntry{} }
+func (m *CovenantSlashingSigEntry) String() string { return proto.CompactTextString(m) }
+func (*CovenantSlashingSigEntry) ProtoMessage()    {}
+func (*CovenantSlashingSigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{59}
+}
+func (m *CovenantSlashingSigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantSlashingSigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantSlashingSigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *CovenantSlashingSigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantSlashingSigEntry.Merge(m, src)
+}
+func (m *CovenantSlashingSigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantSlashingSigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantSlashingSigEntry.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_CovenantSlashingSigEntry proto.InternalMessageInfo
+
+func (m *CovenantSlashingSigEntry) GetCovPkHex() string {
+	if m != nil {
+		return m.CovPkHex
 	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *CovenantSlashingSigEntry) GetFpIndex() uint32 {
+	if m != nil {
+		return m.FpIndex
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *CovenantSlashingSigEntry) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return n
+	return ""
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CovenantSlashingSigEntry) GetAdaptorSigHex() string {
+	if m != nil {
+		return m.AdaptorSigHex
 	}
-	var l int
-	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryBTCDelegationCovenantSigsResponse is the response type for the
+// Query/BTCDelegationCovenantSigs RPC method.
+type QueryBTCDelegationCovenantSigsResponse struct {
+	// covenant_sigs contains the covenant adaptor signatures in ascending order
+	// of covenant PK, and then of finality provider index
+	CovenantSigs []*CovenantSlashingSigEntry `protobuf:"bytes,1,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationCovenantSigsResponse) Reset() {
+	*m = QueryBTCDelegationCovenantSigsResponse{}
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationCovenantSigsResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationCovenantSigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{60}
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationCovenantSigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationCovenantSigsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationCovenantSigsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBTCDelegationCovenantSigsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationCovenantSigsResponse) GetCovenantSigs() []*CovenantSlashingSigEntry {
+	if m != nil {
+		return m.CovenantSigs
 	}
-	return n
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationCovenantSigsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryDelegationsExpiringWithinRequest is the request type for the
+// Query/DelegationsExpiringWithin RPC method.
+type QueryDelegationsExpiringWithinRequest struct {
+	// n_blocks is the number of BTC blocks from the current BTC tip within
+	// which the end height of the queried BTC delegations falls
+	NBlocks uint32 `protobuf:"varint,1,opt,name=n_blocks,json=nBlocks,proto3" json:"n_blocks,omitempty"`
+	// pagination defines an optional pagination for the request. Only
+	// key-based pagination in ascending order is supported
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsExpiringWithinRequest) Reset()         { *m = QueryDelegationsExpiringWithinRequest{} }
+func (m *QueryDelegationsExpiringWithinRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsExpiringWithinRequest) ProtoMessage()    {}
+func (*QueryDelegationsExpiringWithinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{61}
+}
+func (m *QueryDelegationsExpiringWithinRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsExpiringWithinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsExpiringWithinRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StakingTime != 0 {
-		n += 1 + sovQuery(uint64(m.StakingTime))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
-	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
-	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
-	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDelegationsExpiringWithinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsExpiringWithinRequest.Merge(m, src)
+}
+func (m *QueryDelegationsExpiringWithinRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsExpiringWithinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsExpiringWithinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsExpiringWithinRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsExpiringWithinRequest) GetNBlocks() uint32 {
+	if m != nil {
+		return m.NBlocks
 	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryDelegationsExpiringWithinRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryDelegationsExpiringWithinResponse is the response type for the
+// Query/DelegationsExpiringWithin RPC method.
+type QueryDelegationsExpiringWithinResponse struct {
+	// btc_tip_height is the height of the BTC tip the end heights are
+	// compared against
+	BtcTipHeight uint32 `protobuf:"varint,1,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`
+	// btc_delegations contains the active BTC delegations whose end height is
+	// within n_blocks of the BTC tip, in ascending order of end height
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,2,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsExpiringWithinResponse) Reset() {
+	*m = QueryDelegationsExpiringWithinResponse{}
+}
+func (m *QueryDelegationsExpiringWithinResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsExpiringWithinResponse) ProtoMessage()    {}
+func (*QueryDelegationsExpiringWithinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{62}
+}
+func (m *QueryDelegationsExpiringWithinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsExpiringWithinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsExpiringWithinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
-	}
-	if m.Active {
-		n += 2
-	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
-	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.ParamsVersion != 0 {
-		n += 2 + sovQuery(uint64(m.ParamsVersion))
+}
+func (m *QueryDelegationsExpiringWithinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsExpiringWithinResponse.Merge(m, src)
+}
+func (m *QueryDelegationsExpiringWithinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsExpiringWithinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsExpiringWithinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsExpiringWithinResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsExpiringWithinResponse) GetBtcTipHeight() uint32 {
+	if m != nil {
+		return m.BtcTipHeight
 	}
-	return n
+	return 0
 }
 
-func (m *DelegatorUnbondingInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegationsExpiringWithinResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
 	}
-	var l int
-	_ = l
-	l = len(m.SpendStakeTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryDelegationsExpiringWithinResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryIsStakingTxRegisteredRequest is the request type for the
+// Query/IsStakingTxRegistered RPC method.
+type QueryIsStakingTxRegisteredRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryIsStakingTxRegisteredRequest) Reset()         { *m = QueryIsStakingTxRegisteredRequest{} }
+func (m *QueryIsStakingTxRegisteredRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsStakingTxRegisteredRequest) ProtoMessage()    {}
+func (*QueryIsStakingTxRegisteredRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{63}
+}
+func (m *QueryIsStakingTxRegisteredRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsStakingTxRegisteredRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsStakingTxRegisteredRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryIsStakingTxRegisteredRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsStakingTxRegisteredRequest.Merge(m, src)
+}
+func (m *QueryIsStakingTxRegisteredRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsStakingTxRegisteredRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsStakingTxRegisteredRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsStakingTxRegisteredRequest proto.InternalMessageInfo
+
+func (m *QueryIsStakingTxRegisteredRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryIsStakingTxRegisteredResponse is the response type for the
+// Query/IsStakingTxRegistered RPC method.
+type QueryIsStakingTxRegisteredResponse struct {
+	// registered is whether the staking tx is already registered as a BTC
+	// delegation
+	Registered bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *QueryIsStakingTxRegisteredResponse) Reset()         { *m = QueryIsStakingTxRegisteredResponse{} }
+func (m *QueryIsStakingTxRegisteredResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsStakingTxRegisteredResponse) ProtoMessage()    {}
+func (*QueryIsStakingTxRegisteredResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{64}
+}
+func (m *QueryIsStakingTxRegisteredResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsStakingTxRegisteredResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsStakingTxRegisteredResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryIsStakingTxRegisteredResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsStakingTxRegisteredResponse.Merge(m, src)
+}
+func (m *QueryIsStakingTxRegisteredResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsStakingTxRegisteredResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsStakingTxRegisteredResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsStakingTxRegisteredResponse proto.InternalMessageInfo
+
+func (m *QueryIsStakingTxRegisteredResponse) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+// QueryBTCDelegationUnbondingStatusRequest is the request type for the
+// Query/BTCDelegationUnbondingStatus RPC method.
+type QueryBTCDelegationUnbondingStatusRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationUnbondingStatusRequest) Reset() {
+	*m = QueryBTCDelegationUnbondingStatusRequest{}
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationUnbondingStatusRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationUnbondingStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{65}
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationUnbondingStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DelegatorUnbondingInfoResponse != nil {
-		l = m.DelegatorUnbondingInfoResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationUnbondingStatusRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationUnbondingStatusRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBTCDelegationUnbondingStatusRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationUnbondingStatusRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryBTCDelegationUnbondingStatusResponse is the response type for the
+// Query/BTCDelegationUnbondingStatus RPC method.
+type QueryBTCDelegationUnbondingStatusResponse struct {
+	// delegator_unbonded is whether the delegator has unbonded the BTC
+	// delegation early, i.e., the tx spending the staking output is included
+	// on BTC and reported to Babylon
+	DelegatorUnbonded bool `protobuf:"varint,1,opt,name=delegator_unbonded,json=delegatorUnbonded,proto3" json:"delegator_unbonded,omitempty"`
+	// unbonding_btc_height is the height of the BTC block including the tx
+	// spending the staking output. It is 0 if the delegator has not unbonded
+	// early, or if the height was not recorded
+	UnbondingBtcHeight uint32 `protobuf:"varint,2,opt,name=unbonding_btc_height,json=unbondingBtcHeight,proto3" json:"unbonding_btc_height,omitempty"`
+	// num_covenant_unbonding_sigs is the number of covenant signatures on the
+	// unbonding tx
+	NumCovenantUnbondingSigs uint32 `protobuf:"varint,3,opt,name=num_covenant_unbonding_sigs,json=numCovenantUnbondingSigs,proto3" json:"num_covenant_unbonding_sigs,omitempty"`
+	// covenant_unbonding_quorum is whether the covenant signatures on the
+	// unbonding tx have reached the covenant quorum
+	CovenantUnbondingQuorum bool `protobuf:"varint,4,opt,name=covenant_unbonding_quorum,json=covenantUnbondingQuorum,proto3" json:"covenant_unbonding_quorum,omitempty"`
+	// status is the resulting status of the BTC delegation
+	Status BTCDelegationStatus `protobuf:"varint,5,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) Reset() {
+	*m = QueryBTCDelegationUnbondingStatusResponse{}
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationUnbondingStatusResponse) ProtoMessage() {}
+func (*QueryBTCDelegationUnbondingStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{66}
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationUnbondingStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationUnbondingStatusResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationUnbondingStatusResponse.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBTCDelegationUnbondingStatusResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) GetDelegatorUnbonded() bool {
+	if m != nil {
+		return m.DelegatorUnbonded
 	}
-	var l int
-	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) GetUnbondingBtcHeight() uint32 {
+	if m != nil {
+		return m.UnbondingBtcHeight
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) GetNumCovenantUnbondingSigs() uint32 {
+	if m != nil {
+		return m.NumCovenantUnbondingSigs
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) GetCovenantUnbondingQuorum() bool {
+	if m != nil {
+		return m.CovenantUnbondingQuorum
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return BTCDelegationStatus_PENDING
+}
+
+// QuerySelectiveSlashingEvidenceListRequest is the request type for the
+// Query/SelectiveSlashingEvidenceList RPC method.
+type QuerySelectiveSlashingEvidenceListRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySelectiveSlashingEvidenceListRequest) Reset() {
+	*m = QuerySelectiveSlashingEvidenceListRequest{}
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QuerySelectiveSlashingEvidenceListRequest) ProtoMessage() {}
+func (*QuerySelectiveSlashingEvidenceListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{67}
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelectiveSlashingEvidenceListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelectiveSlashingEvidenceListRequest.Merge(m, src)
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelectiveSlashingEvidenceListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySelectiveSlashingEvidenceListRequest proto.InternalMessageInfo
+
+func (m *QuerySelectiveSlashingEvidenceListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	return nil
+}
+
+// QuerySelectiveSlashingEvidenceListResponse is the response type for the
+// Query/SelectiveSlashingEvidenceList RPC method.
+type QuerySelectiveSlashingEvidenceListResponse struct {
+	// evidences are the submitted selective slashing evidences, ordered by
+	// block height descending
+	Evidences []*SelectiveSlashingEvidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) Reset() {
+	*m = QuerySelectiveSlashingEvidenceListResponse{}
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QuerySelectiveSlashingEvidenceListResponse) ProtoMessage() {}
+func (*QuerySelectiveSlashingEvidenceListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{68}
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelectiveSlashingEvidenceListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelectiveSlashingEvidenceListResponse.Merge(m, src)
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelectiveSlashingEvidenceListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySelectiveSlashingEvidenceListResponse proto.InternalMessageInfo
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) GetEvidences() []*SelectiveSlashingEvidence {
+	if m != nil {
+		return m.Evidences
 	}
-	if m.Jailed {
-		n += 2
+	return nil
+}
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryDelegationsByFpSetRequest is the request type for the
+// Query/DelegationsByFpSet RPC method.
+type QueryDelegationsByFpSetRequest struct {
+	// fp_btc_pk_hex_list is the set of hex encoded BTC PKs of the finality
+	// providers. The order of the PKs does not matter
+	FpBtcPkHexList []string `protobuf:"bytes,1,rep,name=fp_btc_pk_hex_list,json=fpBtcPkHexList,proto3" json:"fp_btc_pk_hex_list,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDelegationsByFpSetRequest) Reset()         { *m = QueryDelegationsByFpSetRequest{} }
+func (m *QueryDelegationsByFpSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByFpSetRequest) ProtoMessage()    {}
+func (*QueryDelegationsByFpSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{69}
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+func (m *QueryDelegationsByFpSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsByFpSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsByFpSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsByFpSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByFpSetRequest.Merge(m, src)
+}
+func (m *QueryDelegationsByFpSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsByFpSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByFpSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsByFpSetRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsByFpSetRequest) GetFpBtcPkHexList() []string {
+	if m != nil {
+		return m.FpBtcPkHexList
+	}
+	return nil
+}
+
+func (m *QueryDelegationsByFpSetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegationsByFpSetResponse is the response type for the
+// Query/DelegationsByFpSet RPC method.
+type QueryDelegationsByFpSetResponse struct {
+	// btc_delegations contains the BTC delegations restaking to exactly the
+	// given set of finality providers
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsByFpSetResponse) Reset()         { *m = QueryDelegationsByFpSetResponse{} }
+func (m *QueryDelegationsByFpSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByFpSetResponse) ProtoMessage()    {}
+func (*QueryDelegationsByFpSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{70}
+}
+func (m *QueryDelegationsByFpSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsByFpSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsByFpSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsByFpSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByFpSetResponse.Merge(m, src)
+}
+func (m *QueryDelegationsByFpSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsByFpSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByFpSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsByFpSetResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsByFpSetResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryDelegationsByFpSetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBTCDelegationTransactionsRequest is the request type for the
+// Query/BTCDelegationTransactions RPC method.
+type QueryBTCDelegationTransactionsRequest struct {
+	// staking_tx_hash_hex specifies the hash of the staking tx of the BTC
+	// delegation to query, in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationTransactionsRequest) Reset()         { *m = QueryBTCDelegationTransactionsRequest{} }
+func (m *QueryBTCDelegationTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationTransactionsRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{71}
+}
+func (m *QueryBTCDelegationTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationTransactionsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationTransactionsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationTransactionsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryBTCDelegationTransactionsResponse is the response type for the
+// Query/BTCDelegationTransactions RPC method.
+type QueryBTCDelegationTransactionsResponse struct {
+	// staking_tx_hex is the hex string of the staking tx
+	StakingTxHex string `protobuf:"bytes,1,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of the slashing tx spending the
+	// staking tx
+	SlashingTxHex string `protobuf:"bytes,2,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slashing_sig_hex is the signature of the delegator on the
+	// slashing tx, as hex string
+	DelegatorSlashingSigHex string `protobuf:"bytes,3,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
+	// unbonding_tx_hex is the hex string of the unbonding tx
+	UnbondingTxHex string `protobuf:"bytes,4,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// unbonding_slashing_tx_hex is the hex string of the slashing tx spending
+	// the unbonding tx
+	UnbondingSlashingTxHex string `protobuf:"bytes,5,opt,name=unbonding_slashing_tx_hex,json=unbondingSlashingTxHex,proto3" json:"unbonding_slashing_tx_hex,omitempty"`
+	// delegator_unbonding_slashing_sig_hex is the signature of the delegator
+	// on the unbonding slashing tx, as hex string
+	DelegatorUnbondingSlashingSigHex string `protobuf:"bytes,6,opt,name=delegator_unbonding_slashing_sig_hex,json=delegatorUnbondingSlashingSigHex,proto3" json:"delegator_unbonding_slashing_sig_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) Reset() {
+	*m = QueryBTCDelegationTransactionsResponse{}
+}
+func (m *QueryBTCDelegationTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationTransactionsResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{72}
+}
+func (m *QueryBTCDelegationTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationTransactionsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationTransactionsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationTransactionsResponse) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) GetDelegatorSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashingSigHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) GetUnbondingSlashingTxHex() string {
+	if m != nil {
+		return m.UnbondingSlashingTxHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) GetDelegatorUnbondingSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorUnbondingSlashingSigHex
+	}
+	return ""
+}
+
+// QueryBTCDelegationScriptPathsRequest is the request type for the
+// Query/BTCDelegationScriptPaths RPC method.
+type QueryBTCDelegationScriptPathsRequest struct {
+	// staking_tx_hash_hex specifies the hash of the staking tx of the BTC
+	// delegation to query, in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationScriptPathsRequest) Reset()         { *m = QueryBTCDelegationScriptPathsRequest{} }
+func (m *QueryBTCDelegationScriptPathsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationScriptPathsRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationScriptPathsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{73}
+}
+func (m *QueryBTCDelegationScriptPathsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationScriptPathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationScriptPathsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationScriptPathsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationScriptPathsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationScriptPathsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationScriptPathsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationScriptPathsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationScriptPathsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationScriptPathsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryBTCDelegationScriptPathsResponse is the response type for the
+// Query/BTCDelegationScriptPaths RPC method.
+type QueryBTCDelegationScriptPathsResponse struct {
+	// params_version is the version of the params the script paths are
+	// reconstructed under
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// internal_key_hex is the hex encoded x-only taproot internal key of the
+	// staking output
+	InternalKeyHex string `protobuf:"bytes,2,opt,name=internal_key_hex,json=internalKeyHex,proto3" json:"internal_key_hex,omitempty"`
+	// merkle_root_hex is the hex encoded merkle root of the taproot script tree
+	// of the staking output
+	MerkleRootHex string `protobuf:"bytes,3,opt,name=merkle_root_hex,json=merkleRootHex,proto3" json:"merkle_root_hex,omitempty"`
+	// staking_output_pk_script_hex is the hex encoded pk script of the staking
+	// output expected by Babylon
+	StakingOutputPkScriptHex string `protobuf:"bytes,4,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	// timelock_path is the script path spendable by the staker after the
+	// staking timelock expires
+	TimelockPath *ScriptPath `protobuf:"bytes,5,opt,name=timelock_path,json=timelockPath,proto3" json:"timelock_path,omitempty"`
+	// unbonding_path is the script path spendable by the staker together with
+	// the covenant committee
+	UnbondingPath *ScriptPath `protobuf:"bytes,6,opt,name=unbonding_path,json=unbondingPath,proto3" json:"unbonding_path,omitempty"`
+	// slashing_path is the script path spendable by the staker together with
+	// the finality provider and the covenant committee
+	SlashingPath *ScriptPath `protobuf:"bytes,7,opt,name=slashing_path,json=slashingPath,proto3" json:"slashing_path,omitempty"`
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) Reset()         { *m = QueryBTCDelegationScriptPathsResponse{} }
+func (m *QueryBTCDelegationScriptPathsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationScriptPathsResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationScriptPathsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{74}
+}
+func (m *QueryBTCDelegationScriptPathsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationScriptPathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationScriptPathsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationScriptPathsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationScriptPathsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationScriptPathsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationScriptPathsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationScriptPathsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationScriptPathsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetInternalKeyHex() string {
+	if m != nil {
+		return m.InternalKeyHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetMerkleRootHex() string {
+	if m != nil {
+		return m.MerkleRootHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetStakingOutputPkScriptHex() string {
+	if m != nil {
+		return m.StakingOutputPkScriptHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetTimelockPath() *ScriptPath {
+	if m != nil {
+		return m.TimelockPath
+	}
+	return nil
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetUnbondingPath() *ScriptPath {
+	if m != nil {
+		return m.UnbondingPath
+	}
+	return nil
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) GetSlashingPath() *ScriptPath {
+	if m != nil {
+		return m.SlashingPath
+	}
+	return nil
+}
+
+// ScriptPath is a taproot script path of a staking output
+type ScriptPath struct {
+	// script_hex is the hex encoded script of the leaf
+	ScriptHex string `protobuf:"bytes,1,opt,name=script_hex,json=scriptHex,proto3" json:"script_hex,omitempty"`
+	// leaf_hash_hex is the hex encoded tap leaf hash of the script
+	LeafHashHex string `protobuf:"bytes,2,opt,name=leaf_hash_hex,json=leafHashHex,proto3" json:"leaf_hash_hex,omitempty"`
+	// control_block_hex is the hex encoded control block proving the inclusion
+	// of the leaf in the script tree
+	ControlBlockHex string `protobuf:"bytes,3,opt,name=control_block_hex,json=controlBlockHex,proto3" json:"control_block_hex,omitempty"`
+}
+
+func (m *ScriptPath) Reset()         { *m = ScriptPath{} }
+func (m *ScriptPath) String() string { return proto.CompactTextString(m) }
+func (*ScriptPath) ProtoMessage()    {}
+func (*ScriptPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{75}
+}
+func (m *ScriptPath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScriptPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScriptPath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScriptPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScriptPath.Merge(m, src)
+}
+func (m *ScriptPath) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScriptPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScriptPath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScriptPath proto.InternalMessageInfo
+
+func (m *ScriptPath) GetScriptHex() string {
+	if m != nil {
+		return m.ScriptHex
+	}
+	return ""
+}
+
+func (m *ScriptPath) GetLeafHashHex() string {
+	if m != nil {
+		return m.LeafHashHex
+	}
+	return ""
+}
+
+func (m *ScriptPath) GetControlBlockHex() string {
+	if m != nil {
+		return m.ControlBlockHex
+	}
+	return ""
+}
+
+// QueryBTCDelegationsByFlagsRequest is the request type for the
+// Query/BTCDelegationsByFlags RPC method.
+type QueryBTCDelegationsByFlagsRequest struct {
+	// has_proof filters BTC delegations by whether they have an inclusion proof
+	HasProof FlagFilter `protobuf:"varint,1,opt,name=has_proof,json=hasProof,proto3,enum=babylon.btcstaking.v1.FlagFilter" json:"has_proof,omitempty"`
+	// has_quorum filters BTC delegations by whether they have a quorum of
+	// covenant signatures under the current params
+	HasQuorum FlagFilter `protobuf:"varint,2,opt,name=has_quorum,json=hasQuorum,proto3,enum=babylon.btcstaking.v1.FlagFilter" json:"has_quorum,omitempty"`
+	// has_unbonding_sig filters BTC delegations by whether they have the
+	// delegator's signature on the unbonding tx
+	HasUnbondingSig FlagFilter `protobuf:"varint,3,opt,name=has_unbonding_sig,json=hasUnbondingSig,proto3,enum=babylon.btcstaking.v1.FlagFilter" json:"has_unbonding_sig,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) Reset()         { *m = QueryBTCDelegationsByFlagsRequest{} }
+func (m *QueryBTCDelegationsByFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByFlagsRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationsByFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{76}
+}
+func (m *QueryBTCDelegationsByFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByFlagsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByFlagsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByFlagsRequest) GetHasProof() FlagFilter {
+	if m != nil {
+		return m.HasProof
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) GetHasQuorum() FlagFilter {
+	if m != nil {
+		return m.HasQuorum
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) GetHasUnbondingSig() FlagFilter {
+	if m != nil {
+		return m.HasUnbondingSig
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBTCDelegationsByFlagsResponse is the response type for the
+// Query/BTCDelegationsByFlags RPC method.
+type QueryBTCDelegationsByFlagsResponse struct {
+	// btc_delegations contains the BTC delegations matching all the given flags
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByFlagsResponse) Reset()         { *m = QueryBTCDelegationsByFlagsResponse{} }
+func (m *QueryBTCDelegationsByFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByFlagsResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationsByFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{77}
+}
+func (m *QueryBTCDelegationsByFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByFlagsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByFlagsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByFlagsResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryBTCDelegationsByFlagsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFindStakingOutputIndexRequest is the request type for the
+// Query/FindStakingOutputIndex RPC method.
+type QueryFindStakingOutputIndexRequest struct {
+	// staking_tx_hex is the staking tx in hex format
+	StakingTxHex string `protobuf:"bytes,1,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// params_version is the version of the params the staking output is built
+	// under
+	ParamsVersion uint32 `protobuf:"varint,2,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// staker_btc_pk_hex is the hex encoded BTC PK of the staker
+	StakerBtcPkHex string `protobuf:"bytes,3,opt,name=staker_btc_pk_hex,json=stakerBtcPkHex,proto3" json:"staker_btc_pk_hex,omitempty"`
+	// fp_btc_pk_hex_list is the list of hex encoded BTC PKs of the finality
+	// providers the BTC delegation restakes to
+	FpBtcPkHexList []string `protobuf:"bytes,4,rep,name=fp_btc_pk_hex_list,json=fpBtcPkHexList,proto3" json:"fp_btc_pk_hex_list,omitempty"`
+	// staking_time is the timelock of the staking output in BTC blocks
+	StakingTime uint32 `protobuf:"varint,5,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// staking_value is the value of the staking output in satoshis
+	StakingValue int64 `protobuf:"varint,6,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
+}
+
+func (m *QueryFindStakingOutputIndexRequest) Reset()         { *m = QueryFindStakingOutputIndexRequest{} }
+func (m *QueryFindStakingOutputIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFindStakingOutputIndexRequest) ProtoMessage()    {}
+func (*QueryFindStakingOutputIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{78}
+}
+func (m *QueryFindStakingOutputIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFindStakingOutputIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFindStakingOutputIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFindStakingOutputIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFindStakingOutputIndexRequest.Merge(m, src)
+}
+func (m *QueryFindStakingOutputIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFindStakingOutputIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFindStakingOutputIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFindStakingOutputIndexRequest proto.InternalMessageInfo
+
+func (m *QueryFindStakingOutputIndexRequest) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
+	}
+	return ""
+}
+
+func (m *QueryFindStakingOutputIndexRequest) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryFindStakingOutputIndexRequest) GetStakerBtcPkHex() string {
+	if m != nil {
+		return m.StakerBtcPkHex
+	}
+	return ""
+}
+
+func (m *QueryFindStakingOutputIndexRequest) GetFpBtcPkHexList() []string {
+	if m != nil {
+		return m.FpBtcPkHexList
+	}
+	return nil
+}
+
+func (m *QueryFindStakingOutputIndexRequest) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
+	}
+	return 0
+}
+
+func (m *QueryFindStakingOutputIndexRequest) GetStakingValue() int64 {
+	if m != nil {
+		return m.StakingValue
+	}
+	return 0
+}
+
+// QueryFindStakingOutputIndexResponse is the response type for the
+// Query/FindStakingOutputIndex RPC method.
+type QueryFindStakingOutputIndexResponse struct {
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,1,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// staking_output_pk_script_hex is the hex encoded pk script of the staking
+	// output
+	StakingOutputPkScriptHex string `protobuf:"bytes,2,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+}
+
+func (m *QueryFindStakingOutputIndexResponse) Reset()         { *m = QueryFindStakingOutputIndexResponse{} }
+func (m *QueryFindStakingOutputIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFindStakingOutputIndexResponse) ProtoMessage()    {}
+func (*QueryFindStakingOutputIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{79}
+}
+func (m *QueryFindStakingOutputIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFindStakingOutputIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFindStakingOutputIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFindStakingOutputIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFindStakingOutputIndexResponse.Merge(m, src)
+}
+func (m *QueryFindStakingOutputIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFindStakingOutputIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFindStakingOutputIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFindStakingOutputIndexResponse proto.InternalMessageInfo
+
+func (m *QueryFindStakingOutputIndexResponse) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
+	}
+	return 0
+}
+
+func (m *QueryFindStakingOutputIndexResponse) GetStakingOutputPkScriptHex() string {
+	if m != nil {
+		return m.StakingOutputPkScriptHex
+	}
+	return ""
+}
+
+// QueryCovenantSigningHashesRequest is the request type for the
+// Query/CovenantSigningHashes RPC method.
+type QueryCovenantSigningHashesRequest struct {
+	// staking_tx_hash_hex specifies the hash of the staking tx of the BTC
+	// delegation to query, in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryCovenantSigningHashesRequest) Reset()         { *m = QueryCovenantSigningHashesRequest{} }
+func (m *QueryCovenantSigningHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSigningHashesRequest) ProtoMessage()    {}
+func (*QueryCovenantSigningHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{80}
+}
+func (m *QueryCovenantSigningHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSigningHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSigningHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantSigningHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSigningHashesRequest.Merge(m, src)
+}
+func (m *QueryCovenantSigningHashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSigningHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSigningHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSigningHashesRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantSigningHashesRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryCovenantSigningHashesResponse is the response type for the
+// Query/CovenantSigningHashes RPC method.
+type QueryCovenantSigningHashesResponse struct {
+	// params_version is the version of the params the sighashes are derived
+	// under
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// slashing_sig_hashes contains the sighashes of the adaptor signatures
+	// the covenant member produces for each finality provider, in the order
+	// of the finality providers of the BTC delegation
+	SlashingSigHashes []*FpCovenantSigningHashes `protobuf:"bytes,2,rep,name=slashing_sig_hashes,json=slashingSigHashes,proto3" json:"slashing_sig_hashes,omitempty"`
+	// unbonding_tx_sig_hash_hex is the hex encoded sighash of the Schnorr
+	// signature on the unbonding tx through the unbonding path of the staking
+	// output
+	UnbondingTxSigHashHex string `protobuf:"bytes,3,opt,name=unbonding_tx_sig_hash_hex,json=unbondingTxSigHashHex,proto3" json:"unbonding_tx_sig_hash_hex,omitempty"`
+}
+
+func (m *QueryCovenantSigningHashesResponse) Reset()         { *m = QueryCovenantSigningHashesResponse{} }
+func (m *QueryCovenantSigningHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSigningHashesResponse) ProtoMessage()    {}
+func (*QueryCovenantSigningHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{81}
+}
+func (m *QueryCovenantSigningHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSigningHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSigningHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantSigningHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSigningHashesResponse.Merge(m, src)
+}
+func (m *QueryCovenantSigningHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSigningHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSigningHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSigningHashesResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantSigningHashesResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryCovenantSigningHashesResponse) GetSlashingSigHashes() []*FpCovenantSigningHashes {
+	if m != nil {
+		return m.SlashingSigHashes
+	}
+	return nil
+}
+
+func (m *QueryCovenantSigningHashesResponse) GetUnbondingTxSigHashHex() string {
+	if m != nil {
+		return m.UnbondingTxSigHashHex
+	}
+	return ""
+}
+
+// FpCovenantSigningHashes contains the sighashes a covenant member signs
+// with adaptor signatures encrypted by a finality provider's BTC PK
+type FpCovenantSigningHashes struct {
+	// fp_btc_pk_hex is the hex encoded BTC PK of the finality provider
+	// encrypting the adaptor signatures
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// slashing_tx_sig_hash_hex is the hex encoded sighash of the slashing tx
+	// through the slashing path of the staking output
+	SlashingTxSigHashHex string `protobuf:"bytes,2,opt,name=slashing_tx_sig_hash_hex,json=slashingTxSigHashHex,proto3" json:"slashing_tx_sig_hash_hex,omitempty"`
+	// slashing_unbonding_tx_sig_hash_hex is the hex encoded sighash of the
+	// unbonding slashing tx through the slashing path of the unbonding output
+	SlashingUnbondingTxSigHashHex string `protobuf:"bytes,3,opt,name=slashing_unbonding_tx_sig_hash_hex,json=slashingUnbondingTxSigHashHex,proto3" json:"slashing_unbonding_tx_sig_hash_hex,omitempty"`
+}
+
+func (m *FpCovenantSigningHashes) Reset()         { *m = FpCovenantSigningHashes{} }
+func (m *FpCovenantSigningHashes) String() string { return proto.CompactTextString(m) }
+func (*FpCovenantSigningHashes) ProtoMessage()    {}
+func (*FpCovenantSigningHashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{82}
+}
+func (m *FpCovenantSigningHashes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FpCovenantSigningHashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FpCovenantSigningHashes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FpCovenantSigningHashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FpCovenantSigningHashes.Merge(m, src)
+}
+func (m *FpCovenantSigningHashes) XXX_Size() int {
+	return m.Size()
+}
+func (m *FpCovenantSigningHashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_FpCovenantSigningHashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FpCovenantSigningHashes proto.InternalMessageInfo
+
+func (m *FpCovenantSigningHashes) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *FpCovenantSigningHashes) GetSlashingTxSigHashHex() string {
+	if m != nil {
+		return m.SlashingTxSigHashHex
+	}
+	return ""
+}
+
+func (m *FpCovenantSigningHashes) GetSlashingUnbondingTxSigHashHex() string {
+	if m != nil {
+		return m.SlashingUnbondingTxSigHashHex
+	}
+	return ""
+}
+
+// QueryAllBTCDelegationsRequest is the request type for the
+// Query/AllBTCDelegations RPC method.
+type QueryAllBTCDelegationsRequest struct {
+	// status_filter restricts the BTC delegations to the ones under any of the
+	// given statuses at the current BTC tip. If empty, BTC delegations under
+	// any status are returned
+	StatusFilter []BTCDelegationStatus `protobuf:"varint,1,rep,packed,name=status_filter,json=statusFilter,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status_filter,omitempty"`
+	// pagination defines an optional pagination for the request. The key of
+	// the page is the raw store key of the BTC delegation, i.e., its staking
+	// tx hash
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBTCDelegationsRequest) Reset()         { *m = QueryAllBTCDelegationsRequest{} }
+func (m *QueryAllBTCDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBTCDelegationsRequest) ProtoMessage()    {}
+func (*QueryAllBTCDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{83}
+}
+func (m *QueryAllBTCDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBTCDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBTCDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBTCDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBTCDelegationsRequest.Merge(m, src)
+}
+func (m *QueryAllBTCDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBTCDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBTCDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBTCDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryAllBTCDelegationsRequest) GetStatusFilter() []BTCDelegationStatus {
+	if m != nil {
+		return m.StatusFilter
+	}
+	return nil
+}
+
+func (m *QueryAllBTCDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllBTCDelegationsResponse is the response type for the
+// Query/AllBTCDelegations RPC method.
+type QueryAllBTCDelegationsResponse struct {
+	// btc_delegations contains the BTC delegations in the order of their
+	// staking tx hashes
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBTCDelegationsResponse) Reset()         { *m = QueryAllBTCDelegationsResponse{} }
+func (m *QueryAllBTCDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBTCDelegationsResponse) ProtoMessage()    {}
+func (*QueryAllBTCDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{84}
+}
+func (m *QueryAllBTCDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBTCDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBTCDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBTCDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBTCDelegationsResponse.Merge(m, src)
+}
+func (m *QueryAllBTCDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBTCDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBTCDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBTCDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryAllBTCDelegationsResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryAllBTCDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCovenantCommitteeChangesRequest is the request type for the
+// Query/CovenantCommitteeChanges RPC method.
+type QueryCovenantCommitteeChangesRequest struct {
+}
+
+func (m *QueryCovenantCommitteeChangesRequest) Reset()         { *m = QueryCovenantCommitteeChangesRequest{} }
+func (m *QueryCovenantCommitteeChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeChangesRequest) ProtoMessage()    {}
+func (*QueryCovenantCommitteeChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{85}
+}
+func (m *QueryCovenantCommitteeChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteeChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantCommitteeChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantCommitteeChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeChangesRequest.Merge(m, src)
+}
+func (m *QueryCovenantCommitteeChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantCommitteeChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantCommitteeChangesRequest proto.InternalMessageInfo
+
+// QueryCovenantCommitteeChangesResponse is the response type for the
+// Query/CovenantCommitteeChanges RPC method.
+type QueryCovenantCommitteeChangesResponse struct {
+	// genesis_covenant_pks_hex is the list of public keys of the covenant
+	// committee under params version 0, each in hex format
+	GenesisCovenantPksHex []string `protobuf:"bytes,1,rep,name=genesis_covenant_pks_hex,json=genesisCovenantPksHex,proto3" json:"genesis_covenant_pks_hex,omitempty"`
+	// genesis_covenant_quorum is the covenant quorum under params version 0
+	GenesisCovenantQuorum uint32 `protobuf:"varint,2,opt,name=genesis_covenant_quorum,json=genesisCovenantQuorum,proto3" json:"genesis_covenant_quorum,omitempty"`
+	// current_params_version is the version of the latest params
+	CurrentParamsVersion uint32 `protobuf:"varint,3,opt,name=current_params_version,json=currentParamsVersion,proto3" json:"current_params_version,omitempty"`
+	// current_covenant_pks_hex is the list of public keys of the covenant
+	// committee under the latest params, each in hex format
+	CurrentCovenantPksHex []string `protobuf:"bytes,4,rep,name=current_covenant_pks_hex,json=currentCovenantPksHex,proto3" json:"current_covenant_pks_hex,omitempty"`
+	// current_covenant_quorum is the covenant quorum under the latest params
+	CurrentCovenantQuorum uint32 `protobuf:"varint,5,opt,name=current_covenant_quorum,json=currentCovenantQuorum,proto3" json:"current_covenant_quorum,omitempty"`
+	// added_pks_hex is the list of public keys in the current covenant
+	// committee but not in the genesis one, each in hex format
+	AddedPksHex []string `protobuf:"bytes,6,rep,name=added_pks_hex,json=addedPksHex,proto3" json:"added_pks_hex,omitempty"`
+	// removed_pks_hex is the list of public keys in the genesis covenant
+	// committee but not in the current one, each in hex format
+	RemovedPksHex []string `protobuf:"bytes,7,rep,name=removed_pks_hex,json=removedPksHex,proto3" json:"removed_pks_hex,omitempty"`
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) Reset()         { *m = QueryCovenantCommitteeChangesResponse{} }
+func (m *QueryCovenantCommitteeChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeChangesResponse) ProtoMessage()    {}
+func (*QueryCovenantCommitteeChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{86}
+}
+func (m *QueryCovenantCommitteeChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteeChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantCommitteeChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantCommitteeChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeChangesResponse.Merge(m, src)
+}
+func (m *QueryCovenantCommitteeChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantCommitteeChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantCommitteeChangesResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantCommitteeChangesResponse) GetGenesisCovenantPksHex() []string {
+	if m != nil {
+		return m.GenesisCovenantPksHex
+	}
+	return nil
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) GetGenesisCovenantQuorum() uint32 {
+	if m != nil {
+		return m.GenesisCovenantQuorum
+	}
+	return 0
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) GetCurrentParamsVersion() uint32 {
+	if m != nil {
+		return m.CurrentParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) GetCurrentCovenantPksHex() []string {
+	if m != nil {
+		return m.CurrentCovenantPksHex
+	}
+	return nil
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) GetCurrentCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CurrentCovenantQuorum
+	}
+	return 0
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) GetAddedPksHex() []string {
+	if m != nil {
+		return m.AddedPksHex
+	}
+	return nil
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) GetRemovedPksHex() []string {
+	if m != nil {
+		return m.RemovedPksHex
+	}
+	return nil
+}
+
+// QueryFinalityProviderRewardGaugeRequest is the request type for the
+// Query/FinalityProviderRewardGauge RPC method.
+type QueryFinalityProviderRewardGaugeRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality
+	// provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryFinalityProviderRewardGaugeRequest) Reset() {
+	*m = QueryFinalityProviderRewardGaugeRequest{}
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderRewardGaugeRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderRewardGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{87}
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderRewardGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderRewardGaugeRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderRewardGaugeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderRewardGaugeRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderRewardGaugeRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+// QueryFinalityProviderRewardGaugeResponse is the response type for the
+// Query/FinalityProviderRewardGauge RPC method.
+type QueryFinalityProviderRewardGaugeResponse struct {
+	// fp_addr is the Babylon address of the finality provider, under which its
+	// reward gauge is kept
+	FpAddr string `protobuf:"bytes,1,opt,name=fp_addr,json=fpAddr,proto3" json:"fp_addr,omitempty"`
+	// coins are the coins that have been in the reward gauge
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// withdrawn_coins are the coins that have been withdrawn by the finality
+	// provider already
+	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins"`
+	// withdrawable_coins are the coins that the finality provider can withdraw
+	WithdrawableCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=withdrawable_coins,json=withdrawableCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawable_coins"`
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) Reset() {
+	*m = QueryFinalityProviderRewardGaugeResponse{}
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderRewardGaugeResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderRewardGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{88}
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderRewardGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderRewardGaugeResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderRewardGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderRewardGaugeResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderRewardGaugeResponse) GetFpAddr() string {
+	if m != nil {
+		return m.FpAddr
+	}
+	return ""
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) GetWithdrawnCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnCoins
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) GetWithdrawableCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawableCoins
+	}
+	return nil
+}
+
+// QueryDelegationActivationRateRequest is the request type for the
+// Query/DelegationActivationRate RPC method.
+type QueryDelegationActivationRateRequest struct {
+	// from_btc_height is the first BTC height of the range, inclusive
+	FromBtcHeight uint32 `protobuf:"varint,1,opt,name=from_btc_height,json=fromBtcHeight,proto3" json:"from_btc_height,omitempty"`
+	// to_btc_height is the last BTC height of the range, inclusive
+	ToBtcHeight uint32 `protobuf:"varint,2,opt,name=to_btc_height,json=toBtcHeight,proto3" json:"to_btc_height,omitempty"`
+	// bucket_size is the number of BTC heights in each bucket
+	BucketSize uint32 `protobuf:"varint,3,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+}
+
+func (m *QueryDelegationActivationRateRequest) Reset()         { *m = QueryDelegationActivationRateRequest{} }
+func (m *QueryDelegationActivationRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationActivationRateRequest) ProtoMessage()    {}
+func (*QueryDelegationActivationRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{89}
+}
+func (m *QueryDelegationActivationRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationActivationRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationActivationRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationActivationRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationActivationRateRequest.Merge(m, src)
+}
+func (m *QueryDelegationActivationRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationActivationRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationActivationRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationActivationRateRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationActivationRateRequest) GetFromBtcHeight() uint32 {
+	if m != nil {
+		return m.FromBtcHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationActivationRateRequest) GetToBtcHeight() uint32 {
+	if m != nil {
+		return m.ToBtcHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationActivationRateRequest) GetBucketSize() uint32 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+// ActivationRateBucket is the number of BTC delegations activated within a
+// bucket of BTC heights
+type ActivationRateBucket struct {
+	// start_btc_height is the first BTC height of the bucket, inclusive
+	StartBtcHeight uint32 `protobuf:"varint,1,opt,name=start_btc_height,json=startBtcHeight,proto3" json:"start_btc_height,omitempty"`
+	// end_btc_height is the last BTC height of the bucket, inclusive
+	EndBtcHeight uint32 `protobuf:"varint,2,opt,name=end_btc_height,json=endBtcHeight,proto3" json:"end_btc_height,omitempty"`
+	// count is the number of BTC delegations whose start height is within the
+	// bucket
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ActivationRateBucket) Reset()         { *m = ActivationRateBucket{} }
+func (m *ActivationRateBucket) String() string { return proto.CompactTextString(m) }
+func (*ActivationRateBucket) ProtoMessage()    {}
+func (*ActivationRateBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{90}
+}
+func (m *ActivationRateBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivationRateBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivationRateBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivationRateBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivationRateBucket.Merge(m, src)
+}
+func (m *ActivationRateBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivationRateBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivationRateBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivationRateBucket proto.InternalMessageInfo
+
+func (m *ActivationRateBucket) GetStartBtcHeight() uint32 {
+	if m != nil {
+		return m.StartBtcHeight
+	}
+	return 0
+}
+
+func (m *ActivationRateBucket) GetEndBtcHeight() uint32 {
+	if m != nil {
+		return m.EndBtcHeight
+	}
+	return 0
+}
+
+func (m *ActivationRateBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryDelegationActivationRateResponse is the response type for the
+// Query/DelegationActivationRate RPC method.
+type QueryDelegationActivationRateResponse struct {
+	// buckets are the consecutive buckets covering the queried range in
+	// ascending order of BTC height, including the ones without any activated
+	// BTC delegation. The last bucket is truncated at to_btc_height
+	Buckets []*ActivationRateBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (m *QueryDelegationActivationRateResponse) Reset()         { *m = QueryDelegationActivationRateResponse{} }
+func (m *QueryDelegationActivationRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationActivationRateResponse) ProtoMessage()    {}
+func (*QueryDelegationActivationRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{91}
+}
+func (m *QueryDelegationActivationRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationActivationRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationActivationRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationActivationRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationActivationRateResponse.Merge(m, src)
+}
+func (m *QueryDelegationActivationRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationActivationRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationActivationRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationActivationRateResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationActivationRateResponse) GetBuckets() []*ActivationRateBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// QueryStakerDelegationAttestationRequest is the request type for the
+// Query/StakerDelegationAttestation RPC method.
+type QueryStakerDelegationAttestationRequest struct {
+	// staker_addr is the Babylon address of the staker
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+}
+
+func (m *QueryStakerDelegationAttestationRequest) Reset() {
+	*m = QueryStakerDelegationAttestationRequest{}
+}
+func (m *QueryStakerDelegationAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerDelegationAttestationRequest) ProtoMessage()    {}
+func (*QueryStakerDelegationAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{92}
+}
+func (m *QueryStakerDelegationAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerDelegationAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerDelegationAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerDelegationAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerDelegationAttestationRequest.Merge(m, src)
+}
+func (m *QueryStakerDelegationAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerDelegationAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerDelegationAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerDelegationAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryStakerDelegationAttestationRequest) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+// StakerDelegationEntry is a BTC delegation of a staker with its amount
+type StakerDelegationEntry struct {
+	// staking_tx_hash_hex is the hash of the staking tx in BTC format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,2,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+}
+
+func (m *StakerDelegationEntry) Reset()         { *m = StakerDelegationEntry{} }
+func (m *StakerDelegationEntry) String() string { return proto.CompactTextString(m) }
+func (*StakerDelegationEntry) ProtoMessage()    {}
+func (*StakerDelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{93}
+}
+func (m *StakerDelegationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerDelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerDelegationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerDelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerDelegationEntry.Merge(m, src)
+}
+func (m *StakerDelegationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerDelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerDelegationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerDelegationEntry proto.InternalMessageInfo
+
+func (m *StakerDelegationEntry) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *StakerDelegationEntry) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+// QueryStakerDelegationAttestationResponse is the response type for the
+// Query/StakerDelegationAttestation RPC method.
+type QueryStakerDelegationAttestationResponse struct {
+	// staker_addr is the Babylon address of the staker
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// delegations are all BTC delegations of the staker, including unbonded
+	// ones, in ascending order of staking tx hash bytes
+	Delegations []*StakerDelegationEntry `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// total_sat is the sum of the amounts of the BTC delegations
+	TotalSat uint64 `protobuf:"varint,3,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// digest_hex is the SHA256 hash of the concatenation of the staking tx
+	// hash bytes and the big-endian total_sat of each BTC delegation, in the
+	// order of delegations. It is not signed by the chain, but it can be
+	// recomputed by clients and checked against the state.
+	DigestHex string `protobuf:"bytes,4,opt,name=digest_hex,json=digestHex,proto3" json:"digest_hex,omitempty"`
+}
+
+func (m *QueryStakerDelegationAttestationResponse) Reset() {
+	*m = QueryStakerDelegationAttestationResponse{}
+}
+func (m *QueryStakerDelegationAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerDelegationAttestationResponse) ProtoMessage()    {}
+func (*QueryStakerDelegationAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{94}
+}
+func (m *QueryStakerDelegationAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerDelegationAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerDelegationAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerDelegationAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerDelegationAttestationResponse.Merge(m, src)
+}
+func (m *QueryStakerDelegationAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerDelegationAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerDelegationAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerDelegationAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryStakerDelegationAttestationResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *QueryStakerDelegationAttestationResponse) GetDelegations() []*StakerDelegationEntry {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryStakerDelegationAttestationResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+func (m *QueryStakerDelegationAttestationResponse) GetDigestHex() string {
+	if m != nil {
+		return m.DigestHex
+	}
+	return ""
+}
+
+// QueryFinalityProviderRewardBreakdownRequest is the request type for the
+// Query/FinalityProviderRewardBreakdown RPC method.
+type QueryFinalityProviderRewardBreakdownRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality
+	// provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryFinalityProviderRewardBreakdownRequest) Reset() {
+	*m = QueryFinalityProviderRewardBreakdownRequest{}
+}
+func (m *QueryFinalityProviderRewardBreakdownRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderRewardBreakdownRequest) ProtoMessage() {}
+func (*QueryFinalityProviderRewardBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{95}
+}
+func (m *QueryFinalityProviderRewardBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderRewardBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderRewardBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderRewardBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderRewardBreakdownRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderRewardBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderRewardBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderRewardBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderRewardBreakdownRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderRewardBreakdownRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+// QueryFinalityProviderRewardBreakdownResponse is the response type for the
+// Query/FinalityProviderRewardBreakdown RPC method.
+type QueryFinalityProviderRewardBreakdownResponse struct {
+	// fp_addr is the Babylon address of the finality provider
+	FpAddr string `protobuf:"bytes,1,opt,name=fp_addr,json=fpAddr,proto3" json:"fp_addr,omitempty"`
+	// commission_coins are the coins that have been in the finality provider's
+	// reward gauge, i.e., its commission on the rewards of the BTC delegations
+	// restaking to it
+	CommissionCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=commission_coins,json=commissionCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_coins"`
+	// withdrawable_commission_coins are the commission coins that the finality
+	// provider can withdraw
+	WithdrawableCommissionCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawable_commission_coins,json=withdrawableCommissionCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawable_commission_coins"`
+	// self_delegation_coins are the coins that have been credited to the BTC
+	// delegations whose staker is the finality provider's address and that
+	// restake to the finality provider. They are kept in the reward gauge of
+	// the BTC delegation's reward recipient together with the rewards of its
+	// other BTC delegations, so their withdrawal is not tracked separately.
+	SelfDelegationCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=self_delegation_coins,json=selfDelegationCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"self_delegation_coins"`
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) Reset() {
+	*m = QueryFinalityProviderRewardBreakdownResponse{}
+}
+func (m *QueryFinalityProviderRewardBreakdownResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderRewardBreakdownResponse) ProtoMessage() {}
+func (*QueryFinalityProviderRewardBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{96}
+}
+func (m *QueryFinalityProviderRewardBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderRewardBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderRewardBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderRewardBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderRewardBreakdownResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderRewardBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderRewardBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderRewardBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderRewardBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) GetFpAddr() string {
+	if m != nil {
+		return m.FpAddr
+	}
+	return ""
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) GetCommissionCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommissionCoins
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) GetWithdrawableCommissionCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawableCommissionCoins
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) GetSelfDelegationCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SelfDelegationCoins
+	}
+	return nil
+}
+
+// QueryCanReachCovenantQuorumRequest is the request type for the
+// Query/CanReachCovenantQuorum RPC method.
+type QueryCanReachCovenantQuorumRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryCanReachCovenantQuorumRequest) Reset()         { *m = QueryCanReachCovenantQuorumRequest{} }
+func (m *QueryCanReachCovenantQuorumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanReachCovenantQuorumRequest) ProtoMessage()    {}
+func (*QueryCanReachCovenantQuorumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{97}
+}
+func (m *QueryCanReachCovenantQuorumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanReachCovenantQuorumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanReachCovenantQuorumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanReachCovenantQuorumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanReachCovenantQuorumRequest.Merge(m, src)
+}
+func (m *QueryCanReachCovenantQuorumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanReachCovenantQuorumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanReachCovenantQuorumRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanReachCovenantQuorumRequest proto.InternalMessageInfo
+
+func (m *QueryCanReachCovenantQuorumRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryCanReachCovenantQuorumResponse is the response type for the
+// Query/CanReachCovenantQuorum RPC method.
+type QueryCanReachCovenantQuorumResponse struct {
+	// params_version is the version of the params the BTC delegation was
+	// validated against, from which the covenant committee is taken
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// covenant_quorum is the minimum number of covenant signatures needed by
+	// the BTC delegation
+	CovenantQuorum uint32 `protobuf:"varint,2,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// signed_count is the number of committee members that have submitted
+	// their signatures for the BTC delegation
+	SignedCount uint32 `protobuf:"varint,3,opt,name=signed_count,json=signedCount,proto3" json:"signed_count,omitempty"`
+	// can_reach_quorum indicates whether the signatures submitted so far,
+	// together with the ones that the available missing signers can submit,
+	// reach the covenant quorum
+	CanReachQuorum bool `protobuf:"varint,4,opt,name=can_reach_quorum,json=canReachQuorum,proto3" json:"can_reach_quorum,omitempty"`
+	// missing_signers_pks_hex is the list of public keys of the committee
+	// members that have not submitted their signatures, each in hex format
+	MissingSignersPksHex []string `protobuf:"bytes,5,rep,name=missing_signers_pks_hex,json=missingSignersPksHex,proto3" json:"missing_signers_pks_hex,omitempty"`
+	// unavailable_signers_pks_hex is the list of public keys of the missing
+	// signers that are no longer in the covenant committee under the latest
+	// params, each in hex format
+	UnavailableSignersPksHex []string `protobuf:"bytes,6,rep,name=unavailable_signers_pks_hex,json=unavailableSignersPksHex,proto3" json:"unavailable_signers_pks_hex,omitempty"`
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) Reset()         { *m = QueryCanReachCovenantQuorumResponse{} }
+func (m *QueryCanReachCovenantQuorumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanReachCovenantQuorumResponse) ProtoMessage()    {}
+func (*QueryCanReachCovenantQuorumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{98}
+}
+func (m *QueryCanReachCovenantQuorumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanReachCovenantQuorumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanReachCovenantQuorumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanReachCovenantQuorumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanReachCovenantQuorumResponse.Merge(m, src)
+}
+func (m *QueryCanReachCovenantQuorumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanReachCovenantQuorumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanReachCovenantQuorumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanReachCovenantQuorumResponse proto.InternalMessageInfo
+
+func (m *QueryCanReachCovenantQuorumResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
+	}
+	return 0
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) GetSignedCount() uint32 {
+	if m != nil {
+		return m.SignedCount
+	}
+	return 0
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) GetCanReachQuorum() bool {
+	if m != nil {
+		return m.CanReachQuorum
+	}
+	return false
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) GetMissingSignersPksHex() []string {
+	if m != nil {
+		return m.MissingSignersPksHex
+	}
+	return nil
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) GetUnavailableSignersPksHex() []string {
+	if m != nil {
+		return m.UnavailableSignersPksHex
+	}
+	return nil
+}
+
+// QueryBTCTipRequest is the request type for the Query/BTCTip RPC method.
+type QueryBTCTipRequest struct {
+}
+
+func (m *QueryBTCTipRequest) Reset()         { *m = QueryBTCTipRequest{} }
+func (m *QueryBTCTipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCTipRequest) ProtoMessage()    {}
+func (*QueryBTCTipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{99}
+}
+func (m *QueryBTCTipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCTipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCTipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCTipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCTipRequest.Merge(m, src)
+}
+func (m *QueryBTCTipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCTipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCTipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCTipRequest proto.InternalMessageInfo
+
+// QueryBTCTipResponse is the response type for the Query/BTCTip RPC method.
+type QueryBTCTipResponse struct {
+	// height is the height of the BTC tip
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hash_hex is the hash of the BTC tip in hex format
+	HashHex string `protobuf:"bytes,2,opt,name=hash_hex,json=hashHex,proto3" json:"hash_hex,omitempty"`
+}
+
+func (m *QueryBTCTipResponse) Reset()         { *m = QueryBTCTipResponse{} }
+func (m *QueryBTCTipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCTipResponse) ProtoMessage()    {}
+func (*QueryBTCTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{100}
+}
+func (m *QueryBTCTipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCTipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCTipResponse.Merge(m, src)
+}
+func (m *QueryBTCTipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCTipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCTipResponse proto.InternalMessageInfo
+
+func (m *QueryBTCTipResponse) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBTCTipResponse) GetHashHex() string {
+	if m != nil {
+		return m.HashHex
+	}
+	return ""
+}
+
+// QueryDelegationsWithUnbondingScheduleIssuesRequest is the request type for
+// the Query/DelegationsWithUnbondingScheduleIssues RPC method.
+type QueryDelegationsWithUnbondingScheduleIssuesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) Reset() {
+	*m = QueryDelegationsWithUnbondingScheduleIssuesRequest{}
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationsWithUnbondingScheduleIssuesRequest) ProtoMessage() {}
+func (*QueryDelegationsWithUnbondingScheduleIssuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{101}
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesRequest.Merge(m, src)
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// UnbondingScheduleIssue is a BTC delegation whose BTC height of unbonding
+// underflows
+type UnbondingScheduleIssue struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	StartHeight uint32 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end BTC height of the BTC delegation
+	EndHeight uint32 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// min_unbonding_time is the number of BTC blocks before the end height at
+	// which the BTC delegation is unbonded, i.e., the larger of the minimum
+	// unbonding time of its params and the checkpoint finalization timeout
+	MinUnbondingTime uint32 `protobuf:"varint,4,opt,name=min_unbonding_time,json=minUnbondingTime,proto3" json:"min_unbonding_time,omitempty"`
+	// unbonding_height is the BTC height `end_height - min_unbonding_time` at
+	// which the BTC delegation is unbonded, after wrapping around
+	UnbondingHeight uint32 `protobuf:"varint,5,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
+}
+
+func (m *UnbondingScheduleIssue) Reset()         { *m = UnbondingScheduleIssue{} }
+func (m *UnbondingScheduleIssue) String() string { return proto.CompactTextString(m) }
+func (*UnbondingScheduleIssue) ProtoMessage()    {}
+func (*UnbondingScheduleIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{102}
+}
+func (m *UnbondingScheduleIssue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingScheduleIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingScheduleIssue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingScheduleIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingScheduleIssue.Merge(m, src)
+}
+func (m *UnbondingScheduleIssue) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingScheduleIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingScheduleIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingScheduleIssue proto.InternalMessageInfo
+
+func (m *UnbondingScheduleIssue) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *UnbondingScheduleIssue) GetStartHeight() uint32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *UnbondingScheduleIssue) GetEndHeight() uint32 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *UnbondingScheduleIssue) GetMinUnbondingTime() uint32 {
+	if m != nil {
+		return m.MinUnbondingTime
+	}
+	return 0
+}
+
+func (m *UnbondingScheduleIssue) GetUnbondingHeight() uint32 {
+	if m != nil {
+		return m.UnbondingHeight
+	}
+	return 0
+}
+
+// QueryDelegationsWithUnbondingScheduleIssuesResponse is the response type
+// for the Query/DelegationsWithUnbondingScheduleIssues RPC method.
+type QueryDelegationsWithUnbondingScheduleIssuesResponse struct {
+	// issues is the list of BTC delegations whose BTC height of unbonding
+	// underflows
+	Issues []*UnbondingScheduleIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) Reset() {
+	*m = QueryDelegationsWithUnbondingScheduleIssuesResponse{}
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationsWithUnbondingScheduleIssuesResponse) ProtoMessage() {}
+func (*QueryDelegationsWithUnbondingScheduleIssuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{103}
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesResponse.Merge(m, src)
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsWithUnbondingScheduleIssuesResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) GetIssues() []*UnbondingScheduleIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalityProviderStatsRequest is the request type for the
+// Query/FinalityProviderStats RPC method.
+type QueryFinalityProviderStatsRequest struct {
+}
+
+func (m *QueryFinalityProviderStatsRequest) Reset()         { *m = QueryFinalityProviderStatsRequest{} }
+func (m *QueryFinalityProviderStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderStatsRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{104}
+}
+func (m *QueryFinalityProviderStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderStatsRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderStatsRequest proto.InternalMessageInfo
+
+// QueryFinalityProviderStatsResponse is the response type for the
+// Query/FinalityProviderStats RPC method. Each finality provider is counted
+// under exactly one of the statuses.
+type QueryFinalityProviderStatsResponse struct {
+	// total is the number of finality providers
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// active is the number of finality providers that are neither slashed nor
+	// jailed and have at least one active BTC delegation
+	Active uint64 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// dormant is the number of finality providers that are neither slashed nor
+	// jailed but have no active BTC delegation
+	Dormant uint64 `protobuf:"varint,3,opt,name=dormant,proto3" json:"dormant,omitempty"`
+	// jailed is the number of finality providers that are jailed but not
+	// slashed
+	Jailed uint64 `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// slashed is the number of finality providers that are slashed
+	Slashed uint64 `protobuf:"varint,5,opt,name=slashed,proto3" json:"slashed,omitempty"`
+}
+
+func (m *QueryFinalityProviderStatsResponse) Reset()         { *m = QueryFinalityProviderStatsResponse{} }
+func (m *QueryFinalityProviderStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderStatsResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{105}
+}
+func (m *QueryFinalityProviderStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderStatsResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderStatsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderStatsResponse) GetActive() uint64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderStatsResponse) GetDormant() uint64 {
+	if m != nil {
+		return m.Dormant
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderStatsResponse) GetJailed() uint64 {
+	if m != nil {
+		return m.Jailed
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderStatsResponse) GetSlashed() uint64 {
+	if m != nil {
+		return m.Slashed
+	}
+	return 0
+}
+
+// QueryBTCDelegationCovenantUnbondingSigsRequest is the request type for the
+// Query/BTCDelegationCovenantUnbondingSigs RPC method.
+type QueryBTCDelegationCovenantUnbondingSigsRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) Reset() {
+	*m = QueryBTCDelegationCovenantUnbondingSigsRequest{}
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationCovenantUnbondingSigsRequest) ProtoMessage() {}
+func (*QueryBTCDelegationCovenantUnbondingSigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{106}
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// CovenantUnbondingSigEntry is the Schnorr signature of a covenant member on
+// the unbonding tx of a BTC delegation
+type CovenantUnbondingSigEntry struct {
+	// cov_pk_hex is the BTC PK of the covenant member in hex format
+	CovPkHex string `protobuf:"bytes,1,opt,name=cov_pk_hex,json=covPkHex,proto3" json:"cov_pk_hex,omitempty"`
+	// sig_hex is the Schnorr signature in hex format
+	SigHex string `protobuf:"bytes,2,opt,name=sig_hex,json=sigHex,proto3" json:"sig_hex,omitempty"`
+}
+
+func (m *CovenantUnbondingSigEntry) Reset()         { *m = CovenantUnbondingSigEntry{} }
+func (m *CovenantUnbondingSigEntry) String() string { return proto.CompactTextString(m) }
+func (*CovenantUnbondingSigEntry) ProtoMessage()    {}
+func (*CovenantUnbondingSigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{107}
+}
+func (m *CovenantUnbondingSigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantUnbondingSigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantUnbondingSigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantUnbondingSigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantUnbondingSigEntry.Merge(m, src)
+}
+func (m *CovenantUnbondingSigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantUnbondingSigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantUnbondingSigEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantUnbondingSigEntry proto.InternalMessageInfo
+
+func (m *CovenantUnbondingSigEntry) GetCovPkHex() string {
+	if m != nil {
+		return m.CovPkHex
+	}
+	return ""
+}
+
+func (m *CovenantUnbondingSigEntry) GetSigHex() string {
+	if m != nil {
+		return m.SigHex
+	}
+	return ""
+}
+
+// QueryBTCDelegationCovenantUnbondingSigsResponse is the response type for
+// the Query/BTCDelegationCovenantUnbondingSigs RPC method.
+type QueryBTCDelegationCovenantUnbondingSigsResponse struct {
+	// covenant_unbonding_sigs contains the covenant signatures on the unbonding
+	// tx in ascending order of covenant PK, which is the order in which they
+	// appear in the unbonding witness
+	CovenantUnbondingSigs []*CovenantUnbondingSigEntry `protobuf:"bytes,1,rep,name=covenant_unbonding_sigs,json=covenantUnbondingSigs,proto3" json:"covenant_unbonding_sigs,omitempty"`
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) Reset() {
+	*m = QueryBTCDelegationCovenantUnbondingSigsResponse{}
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationCovenantUnbondingSigsResponse) ProtoMessage() {}
+func (*QueryBTCDelegationCovenantUnbondingSigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{108}
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationCovenantUnbondingSigsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) GetCovenantUnbondingSigs() []*CovenantUnbondingSigEntry {
+	if m != nil {
+		return m.CovenantUnbondingSigs
+	}
+	return nil
+}
+
+// QueryBTCDelegationSlashingRateRequest is the request type for the
+// Query/BTCDelegationSlashingRate RPC method.
+type QueryBTCDelegationSlashingRateRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationSlashingRateRequest) Reset()         { *m = QueryBTCDelegationSlashingRateRequest{} }
+func (m *QueryBTCDelegationSlashingRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationSlashingRateRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationSlashingRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{109}
+}
+func (m *QueryBTCDelegationSlashingRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationSlashingRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationSlashingRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationSlashingRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationSlashingRateRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationSlashingRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationSlashingRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationSlashingRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationSlashingRateRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationSlashingRateRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryBTCDelegationSlashingRateResponse is the response type for the
+// Query/BTCDelegationSlashingRate RPC method.
+type QueryBTCDelegationSlashingRateResponse struct {
+	// params_version is the version of the params the BTC delegation was
+	// created under
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// slashing_rate is the portion of the staked amount that is slashed
+	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
+	// slashing_pk_script_hex is the pk_script of the slashing output in hex
+	// format
+	SlashingPkScriptHex string `protobuf:"bytes,3,opt,name=slashing_pk_script_hex,json=slashingPkScriptHex,proto3" json:"slashing_pk_script_hex,omitempty"`
+	// slashing_destinations is the list of outputs among which the slashed
+	// funds are split, each with its pk_script and weight. If the params have
+	// no slashing destinations, it consists of the slashing pk_script with a
+	// weight of 1
+	SlashingDestinations []SlashingDestination `protobuf:"bytes,4,rep,name=slashing_destinations,json=slashingDestinations,proto3" json:"slashing_destinations"`
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) Reset() {
+	*m = QueryBTCDelegationSlashingRateResponse{}
+}
+func (m *QueryBTCDelegationSlashingRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationSlashingRateResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationSlashingRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{110}
+}
+func (m *QueryBTCDelegationSlashingRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationSlashingRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationSlashingRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationSlashingRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationSlashingRateResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationSlashingRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationSlashingRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationSlashingRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationSlashingRateResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationSlashingRateResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) GetSlashingPkScriptHex() string {
+	if m != nil {
+		return m.SlashingPkScriptHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) GetSlashingDestinations() []SlashingDestination {
+	if m != nil {
+		return m.SlashingDestinations
+	}
+	return nil
+}
+
+// QueryDelegationsAffectedBySlashingRequest is the request type for the
+// Query/DelegationsAffectedBySlashing RPC method.
+type QueryDelegationsAffectedBySlashingRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the slashed
+	// finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsAffectedBySlashingRequest) Reset() {
+	*m = QueryDelegationsAffectedBySlashingRequest{}
+}
+func (m *QueryDelegationsAffectedBySlashingRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationsAffectedBySlashingRequest) ProtoMessage() {}
+func (*QueryDelegationsAffectedBySlashingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{111}
+}
+func (m *QueryDelegationsAffectedBySlashingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsAffectedBySlashingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsAffectedBySlashingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsAffectedBySlashingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsAffectedBySlashingRequest.Merge(m, src)
+}
+func (m *QueryDelegationsAffectedBySlashingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsAffectedBySlashingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsAffectedBySlashingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsAffectedBySlashingRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsAffectedBySlashingRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *QueryDelegationsAffectedBySlashingRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// AffectedBTCDelegation is a BTC delegation exposed to the slashing of a
+// finality provider
+type AffectedBTCDelegation struct {
+	// staker_addr is the address of the staker
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// btc_pk_hex is the Bitcoin secp256k1 PK of the BTC delegator
+	BtcPkHex string `protobuf:"bytes,2,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// staking_tx_hash_hex is the hash of the staking tx in btc format
+	StakingTxHashHex string `protobuf:"bytes,3,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,4,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+}
+
+func (m *AffectedBTCDelegation) Reset()         { *m = AffectedBTCDelegation{} }
+func (m *AffectedBTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*AffectedBTCDelegation) ProtoMessage()    {}
+func (*AffectedBTCDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{112}
+}
+func (m *AffectedBTCDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AffectedBTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AffectedBTCDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AffectedBTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffectedBTCDelegation.Merge(m, src)
+}
+func (m *AffectedBTCDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AffectedBTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffectedBTCDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AffectedBTCDelegation proto.InternalMessageInfo
+
+func (m *AffectedBTCDelegation) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *AffectedBTCDelegation) GetBtcPkHex() string {
+	if m != nil {
+		return m.BtcPkHex
+	}
+	return ""
+}
+
+func (m *AffectedBTCDelegation) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *AffectedBTCDelegation) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+// QueryDelegationsAffectedBySlashingResponse is the response type for the
+// Query/DelegationsAffectedBySlashing RPC method.
+type QueryDelegationsAffectedBySlashingResponse struct {
+	// slashed_babylon_height is the Babylon height at which the finality
+	// provider was slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,1,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height is the BTC height at which the finality provider was
+	// slashed
+	SlashedBtcHeight uint32 `protobuf:"varint,2,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// delegations contains the BTC delegations that became active under the
+	// finality provider
+	Delegations []*AffectedBTCDelegation `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// total_sat is the sum of the amounts of the delegations in this page,
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,4,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) Reset() {
+	*m = QueryDelegationsAffectedBySlashingResponse{}
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationsAffectedBySlashingResponse) ProtoMessage() {}
+func (*QueryDelegationsAffectedBySlashingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{113}
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsAffectedBySlashingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsAffectedBySlashingResponse.Merge(m, src)
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsAffectedBySlashingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsAffectedBySlashingResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsAffectedBySlashingResponse) GetSlashedBabylonHeight() uint64 {
+	if m != nil {
+		return m.SlashedBabylonHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) GetSlashedBtcHeight() uint32 {
+	if m != nil {
+		return m.SlashedBtcHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) GetDelegations() []*AffectedBTCDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRefundableBTCDelegationsRequest is the request type for the
+// Query/RefundableBTCDelegations RPC method.
+type QueryRefundableBTCDelegationsRequest struct {
+	// staker_addr is the address of the staker
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRefundableBTCDelegationsRequest) Reset()         { *m = QueryRefundableBTCDelegationsRequest{} }
+func (m *QueryRefundableBTCDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRefundableBTCDelegationsRequest) ProtoMessage()    {}
+func (*QueryRefundableBTCDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{114}
+}
+func (m *QueryRefundableBTCDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefundableBTCDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefundableBTCDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRefundableBTCDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefundableBTCDelegationsRequest.Merge(m, src)
+}
+func (m *QueryRefundableBTCDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefundableBTCDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefundableBTCDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRefundableBTCDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryRefundableBTCDelegationsRequest) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *QueryRefundableBTCDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRefundableBTCDelegationsResponse is the response type for the
+// Query/RefundableBTCDelegations RPC method.
+type QueryRefundableBTCDelegationsResponse struct {
+	// staking_tx_hash_hex_list is the list of the staking tx hashes of the
+	// refundable BTC delegations in btc format
+	StakingTxHashHexList []string `protobuf:"bytes,1,rep,name=staking_tx_hash_hex_list,json=stakingTxHashHexList,proto3" json:"staking_tx_hash_hex_list,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRefundableBTCDelegationsResponse) Reset()         { *m = QueryRefundableBTCDelegationsResponse{} }
+func (m *QueryRefundableBTCDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRefundableBTCDelegationsResponse) ProtoMessage()    {}
+func (*QueryRefundableBTCDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{115}
+}
+func (m *QueryRefundableBTCDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefundableBTCDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefundableBTCDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRefundableBTCDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefundableBTCDelegationsResponse.Merge(m, src)
+}
+func (m *QueryRefundableBTCDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefundableBTCDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefundableBTCDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRefundableBTCDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryRefundableBTCDelegationsResponse) GetStakingTxHashHexList() []string {
+	if m != nil {
+		return m.StakingTxHashHexList
+	}
+	return nil
+}
+
+func (m *QueryRefundableBTCDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.FlagFilter", FlagFilter_name, FlagFilter_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
+	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
+	proto.RegisterType((*QueryParamsVersionsRequest)(nil), "babylon.btcstaking.v1.QueryParamsVersionsRequest")
+	proto.RegisterType((*QueryParamsVersionsResponse)(nil), "babylon.btcstaking.v1.QueryParamsVersionsResponse")
+	proto.RegisterType((*QueryCurrentParamsRequest)(nil), "babylon.btcstaking.v1.QueryCurrentParamsRequest")
+	proto.RegisterType((*QueryCurrentParamsResponse)(nil), "babylon.btcstaking.v1.QueryCurrentParamsResponse")
+	proto.RegisterType((*QueryUnbondingParamsRequest)(nil), "babylon.btcstaking.v1.QueryUnbondingParamsRequest")
+	proto.RegisterType((*QueryUnbondingParamsResponse)(nil), "babylon.btcstaking.v1.QueryUnbondingParamsResponse")
+	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
+	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
+	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
+	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
+	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
+	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
+	proto.RegisterType((*QueryDelegationsByParamsVersionRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsByParamsVersionRequest")
+	proto.RegisterType((*QueryDelegationsByParamsVersionResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsByParamsVersionResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
+	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*QueryVerifyCovenantSlashingSigRequest)(nil), "babylon.btcstaking.v1.QueryVerifyCovenantSlashingSigRequest")
+	proto.RegisterType((*QueryVerifyCovenantSlashingSigResponse)(nil), "babylon.btcstaking.v1.QueryVerifyCovenantSlashingSigResponse")
+	proto.RegisterType((*CovenantSlashingSigVerification)(nil), "babylon.btcstaking.v1.CovenantSlashingSigVerification")
+	proto.RegisterType((*QueryBTCDelegationSignatureReadinessRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationSignatureReadinessRequest")
+	proto.RegisterType((*QueryBTCDelegationSignatureReadinessResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationSignatureReadinessResponse")
+	proto.RegisterType((*FpSignatureReadiness)(nil), "babylon.btcstaking.v1.FpSignatureReadiness")
+	proto.RegisterType((*QueryNextPowerDistUpdateHeightRequest)(nil), "babylon.btcstaking.v1.QueryNextPowerDistUpdateHeightRequest")
+	proto.RegisterType((*QueryNextPowerDistUpdateHeightResponse)(nil), "babylon.btcstaking.v1.QueryNextPowerDistUpdateHeightResponse")
+	proto.RegisterType((*QueryCommissionRateBoundsRequest)(nil), "babylon.btcstaking.v1.QueryCommissionRateBoundsRequest")
+	proto.RegisterType((*QueryCommissionRateBoundsResponse)(nil), "babylon.btcstaking.v1.QueryCommissionRateBoundsResponse")
+	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
+	proto.RegisterType((*DelegatorUnbondingInfoResponse)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfoResponse")
+	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
+	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
+	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+	proto.RegisterType((*QueryFinalityProviderCommissionAtDelegationRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCommissionAtDelegationRequest")
+	proto.RegisterType((*QueryFinalityProviderCommissionAtDelegationResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCommissionAtDelegationResponse")
+	proto.RegisterType((*FpCommission)(nil), "babylon.btcstaking.v1.FpCommission")
+	proto.RegisterType((*QueryBTCDelegationCovenantCoverageRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationCovenantCoverageRequest")
+	proto.RegisterType((*QueryBTCDelegationCovenantCoverageResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationCovenantCoverageResponse")
+	proto.RegisterType((*QueryBTCDelegationsByValueRangeRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByValueRangeRequest")
+	proto.RegisterType((*QueryBTCDelegationsByValueRangeResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByValueRangeResponse")
+	proto.RegisterType((*QueryCovenantInfoRequest)(nil), "babylon.btcstaking.v1.QueryCovenantInfoRequest")
+	proto.RegisterType((*QueryCovenantInfoResponse)(nil), "babylon.btcstaking.v1.QueryCovenantInfoResponse")
+	proto.RegisterType((*QueryVerifyInclusionProofAtRequest)(nil), "babylon.btcstaking.v1.QueryVerifyInclusionProofAtRequest")
+	proto.RegisterType((*QueryVerifyInclusionProofAtResponse)(nil), "babylon.btcstaking.v1.QueryVerifyInclusionProofAtResponse")
+	proto.RegisterType((*QueryBTCDelegationFinalityProviderStatusesRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationFinalityProviderStatusesRequest")
+	proto.RegisterType((*DelegationFinalityProviderStatus)(nil), "babylon.btcstaking.v1.DelegationFinalityProviderStatus")
+	proto.RegisterType((*QueryBTCDelegationFinalityProviderStatusesResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationFinalityProviderStatusesResponse")
+	proto.RegisterType((*QueryBTCNetworkRequest)(nil), "babylon.btcstaking.v1.QueryBTCNetworkRequest")
+	proto.RegisterType((*QueryBTCNetworkResponse)(nil), "babylon.btcstaking.v1.QueryBTCNetworkResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationCountRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationCountRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationCountResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationCountResponse")
+	proto.RegisterType((*QueryDelegationCountByParamsVersionRequest)(nil), "babylon.btcstaking.v1.QueryDelegationCountByParamsVersionRequest")
+	proto.RegisterType((*ParamsVersionDelegationCount)(nil), "babylon.btcstaking.v1.ParamsVersionDelegationCount")
+	proto.RegisterType((*QueryDelegationCountByParamsVersionResponse)(nil), "babylon.btcstaking.v1.QueryDelegationCountByParamsVersionResponse")
+	proto.RegisterType((*QueryBTCDelegationCovenantSigsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationCovenantSigsRequest")
+	proto.RegisterType((*CovenantSlashingSigEntry)(nil), "babylon.btcstaking.v1.CovenantSlashingSigEntry")
+	proto.RegisterType((*QueryBTCDelegationCovenantSigsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationCovenantSigsResponse")
+	proto.RegisterType((*QueryDelegationsExpiringWithinRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsExpiringWithinRequest")
+	proto.RegisterType((*QueryDelegationsExpiringWithinResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsExpiringWithinResponse")
+	proto.RegisterType((*QueryIsStakingTxRegisteredRequest)(nil), "babylon.btcstaking.v1.QueryIsStakingTxRegisteredRequest")
+	proto.RegisterType((*QueryIsStakingTxRegisteredResponse)(nil), "babylon.btcstaking.v1.QueryIsStakingTxRegisteredResponse")
+	proto.RegisterType((*QueryBTCDelegationUnbondingStatusRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationUnbondingStatusRequest")
+	proto.RegisterType((*QueryBTCDelegationUnbondingStatusResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationUnbondingStatusResponse")
+	proto.RegisterType((*QuerySelectiveSlashingEvidenceListRequest)(nil), "babylon.btcstaking.v1.QuerySelectiveSlashingEvidenceListRequest")
+	proto.RegisterType((*QuerySelectiveSlashingEvidenceListResponse)(nil), "babylon.btcstaking.v1.QuerySelectiveSlashingEvidenceListResponse")
+	proto.RegisterType((*QueryDelegationsByFpSetRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsByFpSetRequest")
+	proto.RegisterType((*QueryDelegationsByFpSetResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsByFpSetResponse")
+	proto.RegisterType((*QueryBTCDelegationTransactionsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationTransactionsRequest")
+	proto.RegisterType((*QueryBTCDelegationTransactionsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationTransactionsResponse")
+	proto.RegisterType((*QueryBTCDelegationScriptPathsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationScriptPathsRequest")
+	proto.RegisterType((*QueryBTCDelegationScriptPathsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationScriptPathsResponse")
+	proto.RegisterType((*ScriptPath)(nil), "babylon.btcstaking.v1.ScriptPath")
+	proto.RegisterType((*QueryBTCDelegationsByFlagsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByFlagsRequest")
+	proto.RegisterType((*QueryBTCDelegationsByFlagsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByFlagsResponse")
+	proto.RegisterType((*QueryFindStakingOutputIndexRequest)(nil), "babylon.btcstaking.v1.QueryFindStakingOutputIndexRequest")
+	proto.RegisterType((*QueryFindStakingOutputIndexResponse)(nil), "babylon.btcstaking.v1.QueryFindStakingOutputIndexResponse")
+	proto.RegisterType((*QueryCovenantSigningHashesRequest)(nil), "babylon.btcstaking.v1.QueryCovenantSigningHashesRequest")
+	proto.RegisterType((*QueryCovenantSigningHashesResponse)(nil), "babylon.btcstaking.v1.QueryCovenantSigningHashesResponse")
+	proto.RegisterType((*FpCovenantSigningHashes)(nil), "babylon.btcstaking.v1.FpCovenantSigningHashes")
+	proto.RegisterType((*QueryAllBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryAllBTCDelegationsRequest")
+	proto.RegisterType((*QueryAllBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryAllBTCDelegationsResponse")
+	proto.RegisterType((*QueryCovenantCommitteeChangesRequest)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeChangesRequest")
+	proto.RegisterType((*QueryCovenantCommitteeChangesResponse)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeChangesResponse")
+	proto.RegisterType((*QueryFinalityProviderRewardGaugeRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRewardGaugeRequest")
+	proto.RegisterType((*QueryFinalityProviderRewardGaugeResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRewardGaugeResponse")
+	proto.RegisterType((*QueryDelegationActivationRateRequest)(nil), "babylon.btcstaking.v1.QueryDelegationActivationRateRequest")
+	proto.RegisterType((*ActivationRateBucket)(nil), "babylon.btcstaking.v1.ActivationRateBucket")
+	proto.RegisterType((*QueryDelegationActivationRateResponse)(nil), "babylon.btcstaking.v1.QueryDelegationActivationRateResponse")
+	proto.RegisterType((*QueryStakerDelegationAttestationRequest)(nil), "babylon.btcstaking.v1.QueryStakerDelegationAttestationRequest")
+	proto.RegisterType((*StakerDelegationEntry)(nil), "babylon.btcstaking.v1.StakerDelegationEntry")
+	proto.RegisterType((*QueryStakerDelegationAttestationResponse)(nil), "babylon.btcstaking.v1.QueryStakerDelegationAttestationResponse")
+	proto.RegisterType((*QueryFinalityProviderRewardBreakdownRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRewardBreakdownRequest")
+	proto.RegisterType((*QueryFinalityProviderRewardBreakdownResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRewardBreakdownResponse")
+	proto.RegisterType((*QueryCanReachCovenantQuorumRequest)(nil), "babylon.btcstaking.v1.QueryCanReachCovenantQuorumRequest")
+	proto.RegisterType((*QueryCanReachCovenantQuorumResponse)(nil), "babylon.btcstaking.v1.QueryCanReachCovenantQuorumResponse")
+	proto.RegisterType((*QueryBTCTipRequest)(nil), "babylon.btcstaking.v1.QueryBTCTipRequest")
+	proto.RegisterType((*QueryBTCTipResponse)(nil), "babylon.btcstaking.v1.QueryBTCTipResponse")
+	proto.RegisterType((*QueryDelegationsWithUnbondingScheduleIssuesRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsWithUnbondingScheduleIssuesRequest")
+	proto.RegisterType((*UnbondingScheduleIssue)(nil), "babylon.btcstaking.v1.UnbondingScheduleIssue")
+	proto.RegisterType((*QueryDelegationsWithUnbondingScheduleIssuesResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsWithUnbondingScheduleIssuesResponse")
+	proto.RegisterType((*QueryFinalityProviderStatsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderStatsRequest")
+	proto.RegisterType((*QueryFinalityProviderStatsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderStatsResponse")
+	proto.RegisterType((*QueryBTCDelegationCovenantUnbondingSigsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationCovenantUnbondingSigsRequest")
+	proto.RegisterType((*CovenantUnbondingSigEntry)(nil), "babylon.btcstaking.v1.CovenantUnbondingSigEntry")
+	proto.RegisterType((*QueryBTCDelegationCovenantUnbondingSigsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationCovenantUnbondingSigsResponse")
+	proto.RegisterType((*QueryBTCDelegationSlashingRateRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationSlashingRateRequest")
+	proto.RegisterType((*QueryBTCDelegationSlashingRateResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationSlashingRateResponse")
+	proto.RegisterType((*QueryDelegationsAffectedBySlashingRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsAffectedBySlashingRequest")
+	proto.RegisterType((*AffectedBTCDelegation)(nil), "babylon.btcstaking.v1.AffectedBTCDelegation")
+	proto.RegisterType((*QueryDelegationsAffectedBySlashingResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsAffectedBySlashingResponse")
+	proto.RegisterType((*QueryRefundableBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryRefundableBTCDelegationsRequest")
+	proto.RegisterType((*QueryRefundableBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryRefundableBTCDelegationsResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
+
+var fileDescriptor_74d49d26f7429697 = []byte{
+	// 6421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3d, 0x5b, 0x8c, 0x1c, 0xd9,
+	0x55, 0x5b, 0xdd, 0xf3, 0xf2, 0x99, 0xf7, 0xf5, 0x3c, 0x7a, 0xca, 0xf6, 0x8c, 0x5d, 0xb6, 0x67,
+	0xed, 0xb1, 0x3d, 0x6d, 0x8f, 0x5f, 0xeb, 0xb5, 0xbd, 0xbb, 0x33, 0x63, 0x7b, 0x3d, 0x5e, 0xef,
+	0x78, 0xb6, 0xc6, 0xde, 0xbc, 0xd3, 0x54, 0x77, 0xdf, 0xee, 0x2e, 0xa6, 0xa7, 0xaa, 0xb7, 0xaa,
+	0x7a, 0x3c, 0xb3, 0x8e, 0x05, 0x02, 0x04, 0x12, 0x08, 0x88, 0x08, 0x82, 0x0f, 0x50, 0x10, 0xe1,
+	0x03, 0x08, 0x8a, 0x84, 0x20, 0x1f, 0x01, 0x12, 0x11, 0x44, 0x02, 0x89, 0xf8, 0x89, 0x36, 0x3c,
+	0xa2, 0x28, 0x0a, 0xb0, 0x0b, 0x4a, 0x42, 0x20, 0xc0, 0x17, 0x2f, 0x09, 0xa1, 0xfb, 0xa8, 0x67,
+	0x57, 0x55, 0x57, 0xd7, 0xf4, 0x22, 0xed, 0x97, 0xdd, 0x75, 0xef, 0x39, 0xf7, 0x9c, 0x5b, 0xe7,
+	0xde, 0xf3, 0xae, 0x81, 0x63, 0x45, 0xa5, 0xb8, 0x57, 0xd7, 0xb5, 0x7c, 0xd1, 0x2a, 0x99, 0x96,
+	0xb2, 0xa5, 0x6a, 0xd5, 0xfc, 0xce, 0x85, 0xfc, 0x1b, 0x4d, 0x6c, 0xec, 0x2d, 0x36, 0x0c, 0xdd,
+	0xd2, 0xd1, 0x24, 0x9f, 0xb2, 0xe8, 0x4e, 0x59, 0xdc, 0xb9, 0x20, 0x4e, 0x54, 0xf5, 0xaa, 0x4e,
+	0x67, 0xe4, 0xc9, 0xff, 0xd8, 0x64, 0xf1, 0x70, 0x55, 0xd7, 0xab, 0x75, 0x9c, 0x57, 0x1a, 0x6a,
+	0x5e, 0xd1, 0x34, 0xdd, 0x52, 0x2c, 0x55, 0xd7, 0x4c, 0x3e, 0x3a, 0x53, 0xd2, 0xcd, 0x6d, 0xdd,
+	0x2c, 0x30, 0x30, 0xf6, 0x83, 0x0f, 0x9d, 0x60, 0xbf, 0xf2, 0x2e, 0x11, 0x45, 0x6c, 0x29, 0x17,
+	0xec, 0xdf, 0x7c, 0xd6, 0x02, 0x9f, 0x55, 0x54, 0x4c, 0xcc, 0x88, 0x74, 0x26, 0x36, 0x94, 0xaa,
+	0xaa, 0xd1, 0xd5, 0xf8, 0xdc, 0x59, 0xef, 0x5c, 0x7b, 0x56, 0x49, 0x57, 0xed, 0x71, 0x29, 0x9c,
+	0xf5, 0x86, 0x62, 0x28, 0xdb, 0x36, 0x55, 0xf3, 0xe1, 0x73, 0xdc, 0x5f, 0x7c, 0xde, 0x5c, 0x04,
+	0x2e, 0xbd, 0xc1, 0x26, 0x48, 0x13, 0x80, 0x5e, 0x23, 0xe4, 0x6e, 0x50, 0xec, 0x32, 0x7e, 0xa3,
+	0x89, 0x4d, 0x4b, 0x92, 0xe1, 0xa0, 0xef, 0xa9, 0xd9, 0xd0, 0x35, 0x13, 0xa3, 0xeb, 0xd0, 0xc7,
+	0xa8, 0xc8, 0x09, 0x47, 0x85, 0x53, 0x83, 0x4b, 0x47, 0x16, 0x43, 0x5f, 0xc1, 0x22, 0x03, 0x5b,
+	0xe9, 0xf9, 0xca, 0xb7, 0xe7, 0x9e, 0x91, 0x39, 0x88, 0x74, 0x15, 0x0e, 0x79, 0x70, 0xae, 0xec,
+	0xbd, 0x8e, 0x0d, 0x53, 0xd5, 0x35, 0xbe, 0x24, 0xca, 0x41, 0xff, 0x0e, 0x7b, 0x42, 0x91, 0x0f,
+	0xcb, 0xf6, 0x4f, 0xe9, 0x43, 0x70, 0x38, 0x1c, 0xb0, 0x1b, 0x54, 0x1d, 0x06, 0xd1, 0x83, 0x9c,
+	0xa3, 0x76, 0xf6, 0xe1, 0x1a, 0x1c, 0x0a, 0x1d, 0xe5, 0x2b, 0x8b, 0x30, 0xc0, 0x89, 0x24, 0x6b,
+	0x67, 0x4f, 0x0d, 0xcb, 0xce, 0x6f, 0xe9, 0x10, 0xcc, 0x50, 0xd0, 0xd5, 0xa6, 0x61, 0x60, 0xcd,
+	0xf2, 0xef, 0xef, 0x37, 0x04, 0x10, 0xc3, 0x46, 0xbb, 0xc0, 0x91, 0x77, 0x23, 0x33, 0xbe, 0x8d,
+	0x44, 0x67, 0x60, 0x5c, 0x29, 0x59, 0xea, 0x0e, 0x15, 0xc6, 0x42, 0x0d, 0xab, 0xd5, 0x9a, 0x95,
+	0xcb, 0x1e, 0x15, 0x4e, 0xf5, 0xc8, 0x63, 0xee, 0xc0, 0x5d, 0xfa, 0x1c, 0x5d, 0x81, 0x03, 0x4a,
+	0xd3, 0xaa, 0xe9, 0x86, 0x6a, 0xed, 0xe5, 0x7a, 0x8e, 0x0a, 0xa7, 0x0e, 0xac, 0xe4, 0xde, 0xfa,
+	0xec, 0xb9, 0x09, 0x7e, 0x38, 0x96, 0xcb, 0x65, 0x03, 0x9b, 0xe6, 0xa6, 0x65, 0xa8, 0x5a, 0x55,
+	0x76, 0xa7, 0x4a, 0x6b, 0x7c, 0xcb, 0x1e, 0x69, 0x45, 0x5d, 0x2b, 0xab, 0x5a, 0xd5, 0xc7, 0x39,
+	0x5a, 0x80, 0x71, 0xce, 0x40, 0x61, 0x47, 0xa9, 0x37, 0x71, 0xc1, 0x54, 0x2c, 0xca, 0x65, 0x56,
+	0x1e, 0xe5, 0x03, 0xaf, 0x93, 0xe7, 0x9b, 0x8a, 0x25, 0x7d, 0x4b, 0x80, 0xc3, 0xe1, 0xb8, 0xf8,
+	0x3e, 0x2d, 0xc0, 0x78, 0xd3, 0x1e, 0x2a, 0x54, 0xb0, 0x0f, 0x99, 0x33, 0x70, 0x07, 0x13, 0x64,
+	0xe8, 0x1a, 0xcc, 0x6c, 0xab, 0x5a, 0xc1, 0x9d, 0x6f, 0xa9, 0xdb, 0xb8, 0x50, 0xac, 0xeb, 0xa5,
+	0x2d, 0x93, 0x6f, 0xd4, 0xd4, 0xb6, 0xaa, 0x39, 0x4b, 0x3d, 0x54, 0xb7, 0xf1, 0x0a, 0x1d, 0x45,
+	0xd7, 0x41, 0x74, 0xc1, 0xf4, 0xa6, 0xd5, 0x68, 0x5a, 0x1e, 0xe2, 0xb3, 0x74, 0xbd, 0x69, 0x67,
+	0xc6, 0x03, 0x3a, 0xc1, 0x66, 0xc2, 0xfb, 0x3a, 0x7a, 0xfc, 0x72, 0x5d, 0x85, 0x23, 0x94, 0xbb,
+	0x3b, 0xaa, 0xa6, 0xd4, 0x55, 0x6b, 0x6f, 0xc3, 0xd0, 0x77, 0xd4, 0x32, 0x36, 0x9c, 0xbd, 0xba,
+	0x03, 0xe0, 0x5e, 0x1e, 0x5c, 0x14, 0xe6, 0x17, 0xf9, 0x0b, 0x20, 0xb7, 0xc7, 0x22, 0xbb, 0x0e,
+	0xf9, 0x1d, 0xb2, 0xb8, 0xa1, 0x54, 0x31, 0x87, 0x95, 0x3d, 0x90, 0xd2, 0x57, 0x05, 0x98, 0x8d,
+	0x5a, 0x89, 0xef, 0xe4, 0x47, 0x01, 0x55, 0xf8, 0x60, 0xa1, 0x61, 0x8f, 0x52, 0x99, 0x1e, 0x5c,
+	0xca, 0x47, 0x48, 0x5f, 0x10, 0x9b, 0x8d, 0x4c, 0x1e, 0xaf, 0x04, 0xd7, 0x41, 0x2f, 0xfb, 0x58,
+	0xc9, 0x50, 0x56, 0x9e, 0x6d, 0xcb, 0x0a, 0xc7, 0xe7, 0xe5, 0x65, 0x99, 0x8b, 0x44, 0xeb, 0xe2,
+	0x6c, 0xcf, 0x8e, 0xc1, 0x70, 0xa5, 0x51, 0x28, 0x5a, 0xa5, 0x42, 0x63, 0xab, 0x50, 0xc3, 0xbb,
+	0x74, 0xdb, 0x0e, 0xc8, 0x50, 0x69, 0xac, 0x58, 0xa5, 0x8d, 0xad, 0xbb, 0x78, 0x57, 0x7a, 0x1a,
+	0xb1, 0xef, 0xce, 0x66, 0x7c, 0x18, 0xc6, 0x5b, 0x36, 0x83, 0x6f, 0x7f, 0xc7, 0x7b, 0x31, 0x16,
+	0xdc, 0x0b, 0xe9, 0xb7, 0xec, 0xb3, 0xbf, 0xf2, 0x70, 0xf5, 0x16, 0xae, 0xe3, 0x2a, 0xd3, 0x44,
+	0x36, 0x03, 0x2b, 0xd0, 0x67, 0x5a, 0x8a, 0xd5, 0x64, 0x67, 0x7f, 0x64, 0x69, 0x21, 0x62, 0x45,
+	0x1f, 0xf4, 0x26, 0x85, 0x90, 0x39, 0x24, 0xba, 0x13, 0xb2, 0xdb, 0x69, 0x04, 0xe7, 0x0b, 0x02,
+	0x3f, 0xcc, 0x41, 0x52, 0xf9, 0x46, 0x3d, 0x82, 0x51, 0xb2, 0xd3, 0x65, 0x77, 0x88, 0x8b, 0xcc,
+	0xd9, 0x24, 0x44, 0x3b, 0x7b, 0x34, 0x52, 0xb4, 0x4a, 0x1e, 0xf4, 0xdd, 0x13, 0x96, 0x9f, 0x16,
+	0x60, 0x9e, 0xd2, 0xef, 0xc1, 0xbe, 0xe2, 0xbf, 0xcc, 0xdb, 0xaa, 0x9f, 0xae, 0x6d, 0xe6, 0x57,
+	0x05, 0x78, 0xb6, 0x2d, 0x31, 0xef, 0x91, 0x8d, 0xfd, 0x45, 0x9b, 0x97, 0xa0, 0xdc, 0x87, 0x08,
+	0x74, 0xfb, 0x13, 0xd9, 0xb5, 0x2d, 0xfe, 0x8e, 0x00, 0xa7, 0xda, 0x93, 0xc5, 0xf7, 0xd8, 0x80,
+	0x19, 0xcf, 0x1e, 0xeb, 0x46, 0xc8, 0x6e, 0x5f, 0x69, 0xbb, 0xdb, 0x7a, 0x18, 0x6a, 0x79, 0xda,
+	0xdd, 0x77, 0xdd, 0x78, 0x57, 0x5e, 0xc0, 0x3d, 0x6e, 0x5d, 0x04, 0xde, 0x3b, 0xdb, 0xf1, 0x73,
+	0x70, 0xd0, 0xd6, 0xb1, 0xd6, 0x6e, 0xa1, 0xa6, 0x98, 0x35, 0xcf, 0xbe, 0x8f, 0xf1, 0xa1, 0x87,
+	0xbb, 0x77, 0x15, 0xb3, 0x46, 0xee, 0xc3, 0x37, 0xc2, 0xee, 0x23, 0x67, 0x9b, 0x36, 0x61, 0xc4,
+	0x2f, 0x8a, 0xfc, 0x26, 0xec, 0x4c, 0x12, 0x87, 0x7d, 0x92, 0x48, 0xee, 0xc0, 0x93, 0x74, 0xcd,
+	0xd7, 0xb1, 0xa1, 0x56, 0xf6, 0x56, 0xf5, 0x1d, 0xac, 0x29, 0x9a, 0xb5, 0x59, 0x57, 0xcc, 0x9a,
+	0xaa, 0x55, 0x37, 0xd5, 0x6a, 0x3a, 0x5e, 0xd0, 0x3c, 0x8c, 0x96, 0x38, 0x32, 0x5b, 0xdc, 0x32,
+	0x74, 0xea, 0xb0, 0xfd, 0x98, 0x49, 0xdc, 0x29, 0x18, 0x33, 0xf9, 0x62, 0x04, 0xaf, 0xa9, 0x56,
+	0xcd, 0x5c, 0xf6, 0x68, 0xf6, 0xd4, 0x90, 0x3c, 0x62, 0x3f, 0x7f, 0xb8, 0xbb, 0xa9, 0x56, 0x4d,
+	0xe9, 0xd7, 0xed, 0x3b, 0x24, 0x86, 0x54, 0xbe, 0x55, 0x27, 0x61, 0x84, 0xd9, 0x60, 0x05, 0xff,
+	0x55, 0x32, 0xdc, 0xf0, 0x1e, 0x72, 0xb4, 0x01, 0xfd, 0x06, 0x36, 0x9b, 0x75, 0x8b, 0xd8, 0x1d,
+	0x71, 0x62, 0x16, 0xb2, 0x16, 0x25, 0x42, 0x2d, 0xb1, 0xcd, 0xb5, 0xd1, 0x48, 0x0d, 0x98, 0x6b,
+	0x33, 0x37, 0xc9, 0x29, 0x9c, 0x80, 0xde, 0x1d, 0xa5, 0xae, 0x96, 0xe9, 0x8e, 0x0d, 0xc8, 0xec,
+	0x07, 0x79, 0x8a, 0x0d, 0x43, 0x37, 0xa8, 0x9d, 0x73, 0x40, 0x66, 0x3f, 0xa4, 0x0f, 0xc3, 0x99,
+	0x56, 0x99, 0xd9, 0x54, 0xab, 0x9a, 0x62, 0x35, 0x0d, 0x2c, 0x63, 0xa5, 0xac, 0x6a, 0xd8, 0x34,
+	0x53, 0x4a, 0xe4, 0x5f, 0x66, 0xe0, 0x6c, 0x32, 0xf4, 0x9d, 0xed, 0xfc, 0xb3, 0x1e, 0xe9, 0x78,
+	0xa3, 0xa9, 0x1b, 0xcd, 0x6d, 0x6e, 0xf9, 0x8d, 0xd8, 0x8f, 0x5f, 0xa3, 0x4f, 0xd1, 0x3a, 0x0c,
+	0x55, 0x1a, 0x05, 0xc3, 0x5e, 0x87, 0x8a, 0xc6, 0xe0, 0xd2, 0x99, 0x28, 0xe5, 0xdf, 0x08, 0x21,
+	0x6d, 0xb0, 0xd2, 0x70, 0x7e, 0xa0, 0xd3, 0x30, 0xe6, 0x5a, 0x90, 0x7c, 0xe5, 0x1e, 0xba, 0xcb,
+	0xae, 0x9d, 0xca, 0x97, 0x3e, 0x0d, 0x1e, 0x5b, 0x9c, 0x92, 0xb0, 0x97, 0xeb, 0x65, 0x53, 0xdd,
+	0xe7, 0x04, 0xf3, 0x1e, 0x5a, 0x84, 0x83, 0x35, 0xc5, 0x2c, 0xa8, 0x5a, 0xa9, 0xde, 0x24, 0xfc,
+	0x11, 0x63, 0x45, 0xaf, 0xe4, 0xfa, 0xe8, 0xec, 0xf1, 0x9a, 0x62, 0xae, 0xd9, 0x23, 0x1b, 0x64,
+	0x40, 0xfa, 0x8c, 0x00, 0x13, 0x61, 0xb4, 0x26, 0x11, 0x8e, 0x2b, 0x30, 0x6d, 0xbf, 0x41, 0xe7,
+	0xe0, 0x78, 0xb6, 0x70, 0x40, 0x9e, 0xe4, 0xc3, 0xb6, 0x00, 0x72, 0x76, 0x9e, 0x87, 0x19, 0x97,
+	0xf3, 0x20, 0x64, 0x96, 0x42, 0xba, 0xa6, 0xb3, 0x1f, 0x56, 0x7a, 0x96, 0x5f, 0x12, 0xeb, 0x78,
+	0xd7, 0xda, 0xd0, 0x1f, 0x63, 0xe3, 0x96, 0x6a, 0x5a, 0x8f, 0x1a, 0x65, 0xc5, 0xc2, 0xcc, 0x49,
+	0xb1, 0xdd, 0xa9, 0x8f, 0xc0, 0x7c, 0xbb, 0x89, 0x5c, 0x50, 0x26, 0xa0, 0xb7, 0xa2, 0x37, 0xb5,
+	0x32, 0xe5, 0x70, 0x40, 0x66, 0x3f, 0xd0, 0x11, 0x00, 0xc2, 0x3c, 0xf7, 0x88, 0x98, 0x48, 0x1c,
+	0x28, 0x5a, 0x25, 0x06, 0x2c, 0x49, 0x70, 0x94, 0x39, 0x6b, 0xfa, 0xf6, 0xb6, 0x6a, 0x52, 0x45,
+	0xad, 0x58, 0x78, 0x85, 0x80, 0x3a, 0x1e, 0xdd, 0xf7, 0x04, 0x38, 0x16, 0x33, 0x89, 0x2f, 0xaf,
+	0xc0, 0x41, 0xe2, 0x84, 0x94, 0x9c, 0x39, 0x05, 0x43, 0xb1, 0x30, 0xdb, 0xee, 0x95, 0x0b, 0xc4,
+	0x8d, 0xfb, 0xe6, 0xb7, 0xe7, 0x0e, 0x31, 0x7d, 0x60, 0x96, 0xb7, 0x16, 0x55, 0x3d, 0xbf, 0xad,
+	0x58, 0xb5, 0xc5, 0xfb, 0xb8, 0xaa, 0x94, 0xf6, 0x6e, 0xe1, 0xd2, 0x5b, 0x9f, 0x3d, 0x07, 0x6c,
+	0x78, 0xf1, 0x16, 0x2e, 0xc9, 0xe3, 0xdb, 0xaa, 0xe6, 0x5f, 0x90, 0x2e, 0xa1, 0xec, 0xb6, 0x2c,
+	0x91, 0x49, 0xbf, 0x84, 0xb2, 0xeb, 0x5f, 0x42, 0xfa, 0xa3, 0x7e, 0x98, 0x0c, 0x57, 0x16, 0xd7,
+	0x60, 0x90, 0x88, 0x01, 0x36, 0x0a, 0x4a, 0xb9, 0x6c, 0xe4, 0x84, 0x36, 0x6e, 0x23, 0xb0, 0xc9,
+	0xe4, 0x21, 0x7a, 0x00, 0x7d, 0x4c, 0x00, 0x29, 0xa9, 0x43, 0x2b, 0xcf, 0x7d, 0xf3, 0xdb, 0x73,
+	0x97, 0xaa, 0xaa, 0x55, 0x6b, 0x16, 0x17, 0x4b, 0xfa, 0x76, 0x9e, 0x1f, 0xbd, 0xba, 0x52, 0x34,
+	0xcf, 0xa9, 0xba, 0xfd, 0x33, 0x6f, 0xed, 0x35, 0xb0, 0xb9, 0xb8, 0xb2, 0xb6, 0x71, 0xf1, 0xd2,
+	0xf9, 0x8d, 0x66, 0xf1, 0x15, 0xbc, 0x27, 0xf7, 0x16, 0x89, 0xd0, 0xa2, 0x8f, 0xc0, 0x88, 0x2b,
+	0xd4, 0x75, 0xd5, 0xb4, 0xd8, 0x05, 0xbf, 0x0f, 0xc4, 0x83, 0xfc, 0x3c, 0xdc, 0x57, 0xa9, 0x59,
+	0x33, 0xe4, 0x5c, 0x69, 0xea, 0x36, 0xe6, 0xce, 0xdd, 0xa0, 0x7d, 0x97, 0xa9, 0xdb, 0x98, 0x4f,
+	0x31, 0x2c, 0x5b, 0xb0, 0x7a, 0x9d, 0x29, 0x86, 0xc5, 0xbd, 0xec, 0x23, 0x00, 0x58, 0x2b, 0xdb,
+	0x13, 0xfa, 0x98, 0xe4, 0x61, 0xad, 0xcc, 0x87, 0x0f, 0xc1, 0x01, 0x4b, 0xb7, 0x94, 0x3a, 0x75,
+	0x34, 0xfb, 0xa9, 0xa7, 0x3e, 0x40, 0x1f, 0x10, 0xcf, 0xf2, 0x04, 0x8c, 0x78, 0x2f, 0x55, 0xbc,
+	0x9b, 0x1b, 0xa0, 0xc7, 0x76, 0xc8, 0xbd, 0x4f, 0x99, 0x46, 0xf4, 0x6a, 0x3a, 0x32, 0xed, 0x00,
+	0xd3, 0x88, 0xae, 0xa2, 0x23, 0xf3, 0x2e, 0xc3, 0xb4, 0x6b, 0x0a, 0xd1, 0x21, 0xa2, 0x15, 0xe9,
+	0x7c, 0xa0, 0xf3, 0x27, 0x9c, 0x61, 0x7a, 0x4c, 0x37, 0xd5, 0x2a, 0x01, 0x7b, 0x04, 0x8e, 0x66,
+	0x65, 0x5a, 0x74, 0x90, 0x5e, 0x95, 0xe7, 0xdb, 0xa8, 0xb4, 0xe5, 0xb2, 0xd2, 0x20, 0x98, 0xec,
+	0xbb, 0xc8, 0x94, 0x87, 0x6c, 0x34, 0x44, 0xeb, 0xa2, 0xb3, 0x80, 0x6c, 0xde, 0xb8, 0xc3, 0xad,
+	0x96, 0x77, 0x73, 0x43, 0x74, 0x7f, 0x6c, 0x7d, 0xc1, 0x1c, 0xed, 0xb5, 0xf2, 0x2e, 0x9a, 0x82,
+	0x3e, 0x7a, 0x37, 0xe2, 0xdc, 0x30, 0x3d, 0xd6, 0xfc, 0x17, 0x9a, 0xa3, 0xe2, 0x68, 0x35, 0xcd,
+	0x42, 0x19, 0x9b, 0xa5, 0xdc, 0x08, 0xbb, 0xd5, 0xd8, 0xa3, 0x5b, 0xd8, 0x2c, 0x11, 0xbd, 0xe1,
+	0x0f, 0x08, 0xe4, 0x46, 0x99, 0xde, 0x68, 0x7a, 0xc3, 0x00, 0xa8, 0x04, 0x93, 0x4d, 0xcd, 0xb5,
+	0x80, 0x0a, 0x06, 0x97, 0xf7, 0xdc, 0x18, 0x35, 0x85, 0x16, 0xa3, 0x4d, 0xa1, 0x47, 0x5a, 0xb9,
+	0xe5, 0x94, 0xc8, 0x13, 0xcd, 0x90, 0xa7, 0x21, 0x3a, 0x6c, 0x3c, 0x4c, 0x87, 0xbd, 0x08, 0x23,
+	0x06, 0x7e, 0xac, 0x18, 0x65, 0x7a, 0xc4, 0x88, 0x72, 0x42, 0x6d, 0x4e, 0xd9, 0x30, 0x9b, 0xcf,
+	0x1f, 0x4a, 0xaf, 0xc2, 0xac, 0x63, 0x9b, 0x3a, 0xd1, 0x8e, 0x35, 0xad, 0xa2, 0x3b, 0x94, 0x9c,
+	0x01, 0x64, 0x36, 0x88, 0x58, 0xd2, 0xe3, 0x69, 0x4b, 0x0d, 0xd3, 0x09, 0xa3, 0x74, 0x64, 0x93,
+	0x0c, 0x50, 0xb9, 0x91, 0xfe, 0x33, 0x0b, 0xd3, 0x11, 0x8c, 0x12, 0x2b, 0xcb, 0xb3, 0xbd, 0x5e,
+	0x34, 0xee, 0xb6, 0x33, 0xe9, 0x2b, 0xc1, 0x21, 0x47, 0x8c, 0x5c, 0x10, 0x22, 0x80, 0xf4, 0xe4,
+	0x32, 0x3b, 0xe9, 0x44, 0xc4, 0x3e, 0x3b, 0x52, 0x44, 0xb9, 0xc8, 0xd9, 0x88, 0x1c, 0xe6, 0x36,
+	0xd5, 0x2a, 0x3d, 0xb2, 0x21, 0x47, 0x21, 0x1b, 0x76, 0x14, 0xae, 0x83, 0x18, 0x38, 0x0a, 0x36,
+	0x31, 0x04, 0x84, 0xc6, 0xc2, 0xe4, 0x69, 0xff, 0x69, 0x60, 0xab, 0x10, 0xe0, 0x0a, 0x4c, 0xb9,
+	0x07, 0xc2, 0x03, 0x6b, 0xe6, 0x7a, 0x53, 0x9e, 0x8c, 0x89, 0x52, 0xab, 0x6d, 0x67, 0xa2, 0x1f,
+	0x15, 0xe0, 0x98, 0x4b, 0xa5, 0xbb, 0x67, 0xaa, 0x56, 0xd1, 0x5d, 0x01, 0xed, 0xa3, 0x02, 0x7a,
+	0x39, 0x62, 0xcd, 0x78, 0x39, 0x90, 0x67, 0xcb, 0xb1, 0xe3, 0x52, 0x09, 0xe6, 0xda, 0x78, 0x42,
+	0xe8, 0x25, 0xe8, 0x29, 0xe3, 0x7a, 0x3a, 0xef, 0x95, 0x42, 0x4a, 0x6f, 0xf5, 0x40, 0x2e, 0x32,
+	0x52, 0x73, 0x1b, 0x06, 0xc9, 0xc9, 0x36, 0xd4, 0x86, 0xc7, 0x33, 0x39, 0x6e, 0x3b, 0x54, 0xee,
+	0x0a, 0xcc, 0x9b, 0xba, 0xe5, 0x4e, 0x95, 0xbd, 0x70, 0xe8, 0x55, 0x00, 0x57, 0x5f, 0x72, 0x55,
+	0x79, 0xae, 0x33, 0x35, 0xe9, 0x41, 0x80, 0xce, 0x42, 0x0f, 0x55, 0x7f, 0xd9, 0x36, 0x07, 0xb3,
+	0x47, 0xf1, 0x2b, 0xbe, 0x9e, 0xee, 0x28, 0xbe, 0x9b, 0x90, 0x6d, 0xe8, 0x0d, 0xaa, 0x6d, 0xa2,
+	0x6d, 0x56, 0x6a, 0x11, 0x3e, 0xa8, 0x6c, 0xe8, 0xa6, 0x89, 0x29, 0xd5, 0x2b, 0x0f, 0x57, 0x65,
+	0x02, 0x87, 0x2e, 0xc1, 0x14, 0x95, 0x5b, 0x5c, 0x2e, 0x70, 0x50, 0xaf, 0x7a, 0xea, 0x91, 0x27,
+	0xf8, 0xe8, 0x0a, 0x1b, 0xe4, 0x9a, 0x8a, 0x5c, 0xd8, 0x36, 0x94, 0x6b, 0x4a, 0xf5, 0xf3, 0x0b,
+	0x9b, 0x43, 0xd8, 0x16, 0x15, 0xb9, 0xb0, 0xf9, 0x8c, 0x01, 0x8a, 0xb3, 0xaf, 0xe6, 0x3c, 0xff,
+	0x61, 0x45, 0xad, 0xe3, 0x32, 0xd5, 0x51, 0x03, 0x32, 0xff, 0x85, 0xd6, 0x3d, 0x27, 0xd7, 0xc0,
+	0x8a, 0xa9, 0x6b, 0x54, 0x29, 0x8d, 0x2c, 0x9d, 0x8c, 0xba, 0x12, 0xf8, 0x6c, 0x99, 0x4e, 0x76,
+	0x9d, 0x3a, 0xf6, 0x5b, 0x2a, 0xc1, 0x52, 0x68, 0x9c, 0xc0, 0x35, 0x74, 0x96, 0xad, 0x7d, 0xfb,
+	0xd5, 0x9f, 0x16, 0xe0, 0x62, 0x47, 0xab, 0x70, 0xa1, 0x26, 0x5e, 0x8a, 0x81, 0x7d, 0x41, 0x7a,
+	0x81, 0xee, 0xd2, 0x88, 0xfd, 0x98, 0xef, 0xe2, 0x3d, 0x6a, 0xe1, 0xb8, 0x82, 0x67, 0xfb, 0x93,
+	0xc7, 0x23, 0xfd, 0x14, 0x77, 0x65, 0x79, 0xb8, 0xe2, 0xf9, 0x65, 0x4a, 0x3f, 0x21, 0xc0, 0x90,
+	0x77, 0x3c, 0x89, 0x4f, 0xf0, 0x5a, 0xc8, 0xb1, 0x49, 0x61, 0x61, 0x7a, 0x90, 0x48, 0x1f, 0x84,
+	0xd3, 0xad, 0x8e, 0x9f, 0x7d, 0x35, 0x92, 0x7f, 0x0d, 0x37, 0xf4, 0xd3, 0xe9, 0xfb, 0xf8, 0x2f,
+	0x01, 0x16, 0x92, 0x20, 0xef, 0xcc, 0xa7, 0x24, 0x46, 0x9e, 0x5a, 0xd5, 0x70, 0xb9, 0x50, 0xd2,
+	0x9b, 0x9a, 0xed, 0x3d, 0x0c, 0xb2, 0x67, 0xab, 0xe4, 0x11, 0x79, 0xa1, 0x06, 0x7e, 0xa3, 0xa9,
+	0x1a, 0xb8, 0xec, 0xf5, 0x7c, 0x86, 0xe5, 0x11, 0xfb, 0x31, 0x77, 0x96, 0xde, 0x0f, 0x23, 0x25,
+	0x4e, 0x06, 0xb1, 0xda, 0x55, 0x3d, 0xd7, 0x93, 0x76, 0x53, 0x87, 0x6d, 0x44, 0x32, 0xc1, 0x23,
+	0x7d, 0xca, 0x8e, 0x62, 0xf8, 0x78, 0x27, 0xc9, 0x34, 0x92, 0xa7, 0x90, 0x15, 0xcd, 0xdd, 0xd5,
+	0x69, 0xe8, 0x27, 0x3e, 0x8a, 0x9d, 0x4a, 0xe9, 0x91, 0xfb, 0xb6, 0x55, 0x6d, 0x53, 0x61, 0x03,
+	0xca, 0x2e, 0x1d, 0xc8, 0xf0, 0x01, 0x65, 0x97, 0x0c, 0xf8, 0xc3, 0x77, 0xd9, 0xfd, 0x47, 0x48,
+	0xe3, 0x88, 0x7c, 0x8f, 0x44, 0x48, 0x45, 0xc8, 0x71, 0x77, 0x90, 0x89, 0x17, 0x53, 0x9c, 0xcc,
+	0x57, 0xfc, 0x54, 0x06, 0x66, 0x42, 0x06, 0x3b, 0x93, 0xbb, 0x53, 0x30, 0xe6, 0x89, 0x74, 0x99,
+	0x3c, 0xd4, 0x95, 0x25, 0xb6, 0x95, 0x1b, 0xea, 0x32, 0xc9, 0x31, 0x0d, 0x89, 0x7a, 0x64, 0x43,
+	0xa3, 0x1e, 0x27, 0x89, 0xf8, 0x6d, 0x6f, 0xab, 0x96, 0x85, 0x71, 0xc1, 0x54, 0xdf, 0xb4, 0x9d,
+	0x9a, 0x61, 0xe7, 0xe9, 0xa6, 0xfa, 0x26, 0x46, 0x65, 0x98, 0xb0, 0x6a, 0x06, 0x36, 0x6b, 0x7a,
+	0xbd, 0x5c, 0x68, 0x60, 0xa3, 0x84, 0x35, 0x4b, 0xa9, 0xe2, 0x5c, 0x6f, 0x5a, 0x59, 0x3d, 0xe8,
+	0xa0, 0xdb, 0x70, 0xb0, 0x49, 0xff, 0x26, 0x80, 0xe4, 0x89, 0xbb, 0xf9, 0x43, 0x19, 0xcb, 0xb6,
+	0xeb, 0x1f, 0xe2, 0x04, 0x09, 0x21, 0x4e, 0x50, 0xd0, 0x59, 0xcb, 0xb4, 0x3a, 0x6b, 0x45, 0x10,
+	0x3d, 0x88, 0x82, 0x31, 0x15, 0x26, 0xd4, 0x51, 0xda, 0xc6, 0x4f, 0x9c, 0x3c, 0xed, 0xac, 0xed,
+	0x1f, 0x08, 0xc4, 0x19, 0x7a, 0x82, 0x71, 0x06, 0x1d, 0x8e, 0xc7, 0x72, 0xcc, 0x05, 0xe4, 0x34,
+	0x8c, 0xb9, 0xe4, 0x79, 0x14, 0xc4, 0xb0, 0x3c, 0xea, 0x3c, 0x0f, 0x75, 0x2f, 0x33, 0x01, 0xf7,
+	0x52, 0x2a, 0xc2, 0x85, 0xd6, 0xf3, 0x16, 0xd4, 0x56, 0x2c, 0xb7, 0x84, 0xd3, 0xc6, 0xf2, 0x3e,
+	0x23, 0xc0, 0xd1, 0x76, 0xc8, 0x93, 0x28, 0x9b, 0x1c, 0xf4, 0x73, 0x33, 0x82, 0x07, 0x9c, 0xec,
+	0x9f, 0x1e, 0xa3, 0x21, 0xeb, 0x33, 0x1a, 0x2e, 0xc1, 0x14, 0x09, 0x8f, 0x31, 0x5f, 0xd0, 0x77,
+	0x53, 0xb0, 0xd0, 0xdb, 0x44, 0x4d, 0x31, 0x97, 0xe9, 0xa0, 0x4b, 0x9f, 0x29, 0xfd, 0xaa, 0x00,
+	0x4b, 0x9d, 0x6c, 0x0a, 0x7f, 0x29, 0x95, 0x98, 0x04, 0xea, 0xd5, 0x78, 0xf3, 0x3b, 0x12, 0x7d,
+	0x48, 0x22, 0x55, 0xca, 0xc1, 0x94, 0x4d, 0xdd, 0x3a, 0xb6, 0x1e, 0xeb, 0xc6, 0x96, 0x7d, 0xab,
+	0x5c, 0x84, 0xe9, 0x96, 0x11, 0x4e, 0x5c, 0x0e, 0xfa, 0x35, 0xf6, 0x88, 0x6f, 0xac, 0xfd, 0x93,
+	0x24, 0x72, 0xce, 0xb4, 0xc9, 0x98, 0x50, 0x1d, 0xd6, 0x41, 0x32, 0xc7, 0x4d, 0x60, 0x66, 0xd2,
+	0x26, 0x30, 0xa5, 0x5b, 0x70, 0x36, 0x19, 0x55, 0x6e, 0x58, 0x8f, 0x69, 0x5f, 0xa6, 0xb1, 0xd8,
+	0x0f, 0xe9, 0x2c, 0xd7, 0xf7, 0x01, 0xa8, 0xf0, 0x0c, 0xa0, 0xb4, 0x0e, 0x87, 0x7d, 0xcf, 0x03,
+	0x50, 0x31, 0x19, 0x42, 0x67, 0xf5, 0x8c, 0x77, 0xf5, 0x37, 0xf9, 0xce, 0xb6, 0x5b, 0x9d, 0xb3,
+	0xf0, 0x0a, 0xf4, 0x51, 0x38, 0x5b, 0x68, 0x2e, 0xc6, 0xd6, 0x7c, 0x84, 0xd3, 0x28, 0x73, 0x14,
+	0xd2, 0x27, 0xed, 0xfc, 0x4a, 0xa8, 0xa9, 0x43, 0xfc, 0xc7, 0x94, 0xf9, 0x95, 0x6e, 0x65, 0xea,
+	0x3e, 0x29, 0x40, 0x2e, 0x24, 0x65, 0x71, 0x5b, 0xb3, 0x8c, 0x3d, 0x74, 0x98, 0xd8, 0x95, 0x3b,
+	0x7e, 0x09, 0x1b, 0x28, 0xe9, 0x3b, 0x4c, 0xbe, 0x66, 0x60, 0xa0, 0xd2, 0x28, 0xa8, 0x5a, 0x99,
+	0xe7, 0x76, 0x86, 0xe5, 0xfe, 0x4a, 0x63, 0x8d, 0xfc, 0x6c, 0x95, 0xce, 0x6c, 0x8b, 0x74, 0xce,
+	0xc3, 0xa8, 0xc2, 0x3c, 0xec, 0x80, 0x43, 0x3f, 0xac, 0x38, 0x8e, 0x37, 0xb9, 0xb6, 0xfe, 0x3c,
+	0xd4, 0x60, 0xf2, 0xef, 0x20, 0x7f, 0x73, 0x0f, 0x83, 0x21, 0xb0, 0xf8, 0xb2, 0x89, 0x28, 0xb6,
+	0x03, 0x11, 0xb0, 0x6e, 0x26, 0xc1, 0x4f, 0x06, 0xf3, 0xce, 0xb7, 0x77, 0x1b, 0x2a, 0x71, 0x41,
+	0xdf, 0xa7, 0x5a, 0x35, 0xd5, 0xf1, 0x6f, 0x66, 0x60, 0x40, 0xb3, 0x2b, 0x62, 0xb8, 0x88, 0x6b,
+	0xbc, 0x04, 0xa6, 0x5b, 0xef, 0xfd, 0x07, 0x21, 0x19, 0xf9, 0x20, 0x31, 0x7c, 0x5b, 0x4f, 0xb0,
+	0xc4, 0xa3, 0xa5, 0x36, 0xfc, 0x4a, 0x6e, 0xa8, 0x68, 0x95, 0x1e, 0xaa, 0x0d, 0xae, 0xe1, 0x42,
+	0xec, 0xc0, 0x4c, 0xd7, 0xed, 0xc0, 0x6c, 0xfa, 0xdd, 0x97, 0x79, 0x5a, 0x60, 0xcd, 0xdc, 0xb4,
+	0xcf, 0x92, 0x8c, 0xab, 0xaa, 0x69, 0x61, 0x03, 0x97, 0x53, 0xaa, 0xd4, 0x5b, 0x20, 0xc5, 0xe1,
+	0xe4, 0xfb, 0x37, 0x0b, 0x60, 0x38, 0x4f, 0x79, 0xbe, 0xc3, 0xf3, 0x44, 0xfa, 0x00, 0xcf, 0x95,
+	0xfb, 0x36, 0xc4, 0x8d, 0x99, 0xb1, 0x0b, 0x39, 0x1d, 0x81, 0x7f, 0x91, 0x81, 0xd3, 0x09, 0x70,
+	0x73, 0x42, 0xcf, 0x01, 0x0a, 0x06, 0xb2, 0x1c, 0x82, 0xc7, 0x03, 0x21, 0x28, 0x5c, 0x46, 0xe7,
+	0x61, 0xc2, 0x8d, 0x76, 0xb5, 0xa4, 0x6d, 0x90, 0x33, 0xe6, 0x46, 0x1b, 0x6e, 0xc2, 0x21, 0xad,
+	0xb9, 0x5d, 0x08, 0x0f, 0x30, 0x9a, 0xdc, 0x18, 0xce, 0x69, 0xcd, 0xed, 0xd5, 0x90, 0xc8, 0xa1,
+	0x49, 0x52, 0x58, 0x21, 0xa0, 0xbe, 0x2c, 0xde, 0x74, 0x4b, 0xcc, 0x91, 0x9b, 0xd4, 0xae, 0x32,
+	0xec, 0x4d, 0xad, 0x0c, 0x4d, 0xbe, 0x99, 0x9b, 0xb8, 0x8e, 0xa9, 0xb9, 0x62, 0xdf, 0x1c, 0xb7,
+	0x89, 0x4e, 0xd4, 0x4a, 0x98, 0x04, 0x37, 0xbb, 0x5d, 0x33, 0xf6, 0x65, 0xdb, 0x59, 0x6e, 0xb3,
+	0x2a, 0x7f, 0x87, 0xeb, 0x70, 0x00, 0xf3, 0xe7, 0xf6, 0xfd, 0x17, 0x15, 0xe8, 0x8c, 0x44, 0x28,
+	0xbb, 0x28, 0xba, 0x5a, 0xa9, 0x32, 0xdb, 0x5a, 0x75, 0x73, 0xa7, 0xb1, 0x89, 0x2d, 0xb7, 0x24,
+	0x11, 0xf9, 0xb4, 0x06, 0x0b, 0x39, 0x0b, 0xcc, 0x97, 0x72, 0x55, 0xc7, 0x7d, 0xb5, 0x65, 0x7b,
+	0xd3, 0xdf, 0x83, 0x7f, 0x22, 0xc0, 0x5c, 0x24, 0x59, 0xef, 0x11, 0x17, 0xf7, 0xf5, 0x30, 0x1b,
+	0xe3, 0xa1, 0xa1, 0x68, 0xa6, 0x52, 0xe2, 0x51, 0xe0, 0x54, 0xb7, 0xc7, 0x77, 0x33, 0x30, 0xdf,
+	0x0e, 0xb1, 0xab, 0x23, 0x12, 0x78, 0x7f, 0x21, 0x71, 0xff, 0x4c, 0xe7, 0x71, 0xff, 0x6c, 0x7c,
+	0xdc, 0x3f, 0x2c, 0xd7, 0xd1, 0x13, 0x9a, 0xeb, 0xb8, 0x16, 0x9a, 0x12, 0xe7, 0x20, 0xd4, 0x89,
+	0x96, 0xa7, 0x5a, 0x52, 0xe2, 0x0c, 0x74, 0x1d, 0x4e, 0x84, 0xc5, 0xfc, 0x5b, 0x68, 0xed, 0xa3,
+	0x58, 0x8e, 0xb6, 0xc6, 0xef, 0xfd, 0x44, 0x4b, 0x8f, 0xe0, 0x44, 0x48, 0x9d, 0x05, 0x8d, 0x8b,
+	0x6f, 0x28, 0x56, 0x2d, 0xed, 0x1b, 0xfc, 0xc3, 0x2c, 0x9c, 0x6c, 0x83, 0xb7, 0xe3, 0x60, 0x87,
+	0xaa, 0x59, 0xd8, 0xd0, 0x94, 0x7a, 0x61, 0x0b, 0xef, 0x79, 0x5e, 0xe1, 0x88, 0xfd, 0xfc, 0x15,
+	0xbc, 0xc7, 0xdf, 0xf5, 0x36, 0x36, 0xb6, 0xea, 0xb8, 0x60, 0xe8, 0xba, 0xe5, 0xcd, 0xf1, 0xb0,
+	0xc7, 0xb2, 0xae, 0x5b, 0x64, 0xde, 0x0b, 0x70, 0x38, 0x90, 0x60, 0x6c, 0x6c, 0x15, 0x58, 0x46,
+	0xc0, 0xf3, 0xea, 0x72, 0xbe, 0x54, 0xe3, 0xc6, 0x16, 0x63, 0x81, 0x19, 0xc2, 0xc3, 0x24, 0x92,
+	0x40, 0xac, 0xa3, 0x42, 0x43, 0xb1, 0x6a, 0x3c, 0xdc, 0x7e, 0x2c, 0xea, 0xd2, 0x73, 0x78, 0x97,
+	0x87, 0x6c, 0x38, 0xf2, 0x0b, 0xdd, 0xf5, 0x66, 0x20, 0x29, 0xa2, 0xbe, 0xa4, 0x88, 0xdc, 0x24,
+	0x25, 0xc5, 0x74, 0x07, 0x1c, 0x71, 0x66, 0x88, 0xfa, 0x13, 0x53, 0x64, 0xc3, 0x91, 0x5f, 0xd2,
+	0x13, 0x00, 0x77, 0x8c, 0x44, 0x10, 0x3c, 0xbb, 0xc2, 0x5e, 0xf8, 0x01, 0xd3, 0xd9, 0x06, 0x09,
+	0x86, 0xeb, 0x58, 0xa9, 0xb8, 0x22, 0xc1, 0xde, 0xca, 0x20, 0x79, 0x68, 0xfb, 0x0c, 0x0b, 0x30,
+	0x5e, 0xd2, 0x35, 0xcb, 0xd0, 0xeb, 0xcc, 0xb8, 0xf4, 0xbc, 0x94, 0x51, 0x3e, 0x40, 0xad, 0x4c,
+	0x22, 0x39, 0x9f, 0xcf, 0xc0, 0xb1, 0x56, 0xc9, 0x21, 0x57, 0x63, 0x5d, 0x71, 0x9d, 0x96, 0x17,
+	0xe0, 0x00, 0xf1, 0xec, 0x59, 0x68, 0x86, 0x95, 0xc9, 0x46, 0xb1, 0x49, 0xe0, 0xee, 0xa8, 0x75,
+	0x0b, 0x1b, 0xf2, 0x40, 0x4d, 0x31, 0x59, 0x1c, 0xe6, 0x25, 0x00, 0x02, 0xef, 0xa9, 0x5f, 0x49,
+	0x84, 0x80, 0x2c, 0xca, 0xf5, 0xfa, 0xab, 0x40, 0xea, 0x6b, 0xfc, 0x96, 0x44, 0x2e, 0x9b, 0x14,
+	0xd1, 0x68, 0x4d, 0x31, 0xbd, 0x36, 0x46, 0x40, 0xad, 0xf4, 0xa4, 0x56, 0x2b, 0x5f, 0xb2, 0x83,
+	0x66, 0x11, 0xdb, 0xf7, 0x1e, 0xd1, 0x2c, 0x1f, 0xcf, 0x70, 0x36, 0xee, 0xa8, 0x2c, 0xd7, 0xec,
+	0x66, 0xfb, 0x89, 0x9f, 0xd7, 0x59, 0xec, 0xaf, 0xf5, 0x8a, 0xc9, 0x84, 0x5d, 0x31, 0xa7, 0x59,
+	0x63, 0x02, 0x36, 0x5a, 0xfd, 0xc7, 0x11, 0x36, 0xe0, 0xf8, 0x90, 0xe1, 0x06, 0x43, 0x4f, 0xa8,
+	0xc1, 0x10, 0x8c, 0x3c, 0xf6, 0xb6, 0x46, 0x1e, 0x8f, 0xc3, 0xb0, 0xaf, 0x25, 0x82, 0xde, 0x00,
+	0x59, 0x87, 0x0b, 0x1a, 0xfc, 0x96, 0x3e, 0x21, 0xc0, 0xf1, 0xd8, 0x2d, 0xe1, 0xaf, 0x36, 0xbc,
+	0x70, 0x42, 0x88, 0x28, 0x9c, 0x68, 0x77, 0x0b, 0x66, 0xe2, 0x6f, 0x41, 0xc7, 0xbb, 0xf1, 0xf8,
+	0xc5, 0x9a, 0xaa, 0x55, 0xc9, 0xc9, 0x4f, 0x1d, 0x30, 0xfc, 0x47, 0x5b, 0x86, 0x23, 0x90, 0x76,
+	0xa6, 0x39, 0x3e, 0x0a, 0x07, 0xfd, 0xda, 0x91, 0x62, 0xe1, 0x3e, 0xe2, 0x62, 0x4c, 0xa2, 0x2c,
+	0x6c, 0xed, 0x71, 0xd3, 0xa3, 0x3e, 0xe9, 0x23, 0xf4, 0x9c, 0x57, 0x99, 0x5b, 0xbb, 0xce, 0x1a,
+	0x1e, 0xf1, 0x99, 0xf4, 0xe8, 0x7f, 0x0e, 0x48, 0xf8, 0xfc, 0x63, 0x01, 0xa6, 0x23, 0x16, 0x4a,
+	0x56, 0x90, 0x97, 0x0b, 0x54, 0xb0, 0x06, 0x2f, 0xe1, 0x09, 0x5f, 0x25, 0xab, 0x7d, 0x1b, 0xaf,
+	0x81, 0xe4, 0xc0, 0xb5, 0xa3, 0xfc, 0x88, 0x3d, 0xf3, 0x51, 0x28, 0x07, 0x9f, 0x13, 0x78, 0x27,
+	0xc5, 0x72, 0xbd, 0x1e, 0xde, 0xcc, 0xf0, 0x00, 0x86, 0x79, 0x01, 0x4e, 0x85, 0xde, 0x7c, 0xf4,
+	0x9a, 0xe9, 0xcc, 0x0b, 0x1a, 0x62, 0x08, 0xd8, 0xcd, 0xd9, 0x35, 0xfb, 0xfb, 0x8b, 0xb6, 0x5b,
+	0x10, 0x42, 0xfa, 0x7b, 0xe4, 0x92, 0x9c, 0xe7, 0xb6, 0x9b, 0x9b, 0xc0, 0xe4, 0x49, 0x9a, 0xd5,
+	0x9a, 0xa2, 0x55, 0x9d, 0xe3, 0x27, 0xfd, 0x8c, 0x6d, 0x8c, 0x45, 0x4f, 0xe4, 0x1c, 0x5f, 0x85,
+	0x5c, 0x15, 0x6b, 0xd8, 0x54, 0xcd, 0x42, 0x4b, 0x6a, 0x89, 0xb9, 0x43, 0x93, 0x7c, 0x7c, 0xd5,
+	0x9f, 0x61, 0xba, 0x02, 0xd3, 0x2d, 0x80, 0xbe, 0xfa, 0xda, 0x20, 0x1c, 0xd7, 0xa2, 0x97, 0x60,
+	0xaa, 0xc4, 0x1a, 0xe0, 0x0a, 0x81, 0xb3, 0xcc, 0x7c, 0xf2, 0x89, 0x92, 0xb7, 0x3d, 0xce, 0x3e,
+	0xd2, 0x57, 0x21, 0x67, 0x43, 0xb5, 0x90, 0xc9, 0x2e, 0xe1, 0x49, 0x3e, 0xde, 0x4a, 0x66, 0x0b,
+	0x20, 0x27, 0x93, 0x5d, 0xcb, 0x41, 0x38, 0x4e, 0xa6, 0x04, 0xc3, 0x4a, 0xb9, 0x8c, 0xcb, 0xce,
+	0x2a, 0x7d, 0x74, 0x95, 0x41, 0xfa, 0x90, 0xe3, 0x9e, 0x27, 0x39, 0xde, 0x6d, 0x7d, 0xc7, 0x33,
+	0xab, 0x9f, 0xce, 0x1a, 0xe6, 0x8f, 0xd9, 0x3c, 0xe9, 0x7e, 0x44, 0xe3, 0x84, 0x4c, 0x6b, 0xb4,
+	0x5e, 0x56, 0x9a, 0x6e, 0x22, 0x36, 0x41, 0x2b, 0xd3, 0xef, 0x65, 0xe1, 0x54, 0x7b, 0x74, 0xfc,
+	0xf5, 0x5e, 0x80, 0xfe, 0x4a, 0x23, 0x59, 0x61, 0x66, 0x5f, 0xa5, 0x41, 0x1e, 0x20, 0x85, 0x44,
+	0xb6, 0x55, 0x27, 0xa6, 0x36, 0xe3, 0x93, 0x53, 0x5b, 0x42, 0x57, 0x75, 0x55, 0x5b, 0x39, 0x4f,
+	0xd2, 0x7e, 0x9f, 0xfe, 0xdb, 0xb9, 0x53, 0x9e, 0xca, 0x15, 0x36, 0x99, 0xff, 0x73, 0xce, 0x2c,
+	0x6f, 0xf1, 0xa2, 0x15, 0x02, 0x60, 0xca, 0x0c, 0x33, 0xb2, 0x60, 0xf4, 0xb1, 0x6a, 0xd5, 0xca,
+	0x86, 0xf2, 0x58, 0x2b, 0xb0, 0xc5, 0xb2, 0xdd, 0x5f, 0x6c, 0xc4, 0x59, 0x83, 0xfe, 0x46, 0x6f,
+	0x02, 0xb2, 0x9f, 0x28, 0xc5, 0x3a, 0xe6, 0x0b, 0xf7, 0x74, 0x7f, 0xe1, 0x71, 0xef, 0x32, 0xf4,
+	0x11, 0x51, 0xe5, 0x27, 0x02, 0xbe, 0xff, 0xb2, 0x5b, 0xd9, 0xad, 0x58, 0x8e, 0x00, 0xcc, 0xc3,
+	0x68, 0xc5, 0xd0, 0xb7, 0xbd, 0x41, 0x2e, 0xae, 0xe3, 0xc8, 0x63, 0x37, 0xbe, 0x25, 0xc1, 0xb0,
+	0xa5, 0xb7, 0x86, 0xc2, 0x06, 0x2d, 0xdd, 0x9d, 0x33, 0x07, 0x83, 0xc5, 0x66, 0x69, 0x0b, 0x5b,
+	0x2c, 0xb1, 0xcb, 0xce, 0x17, 0xb0, 0x47, 0x24, 0xab, 0x2b, 0x7d, 0x0c, 0x26, 0xfc, 0x54, 0xac,
+	0xd0, 0x31, 0xda, 0x29, 0x41, 0x8b, 0x58, 0x5b, 0xa8, 0x18, 0xa1, 0xcf, 0xdd, 0x25, 0x4e, 0xc0,
+	0x08, 0x49, 0x36, 0xb6, 0xd0, 0x31, 0x84, 0x35, 0x4f, 0xe9, 0x8f, 0x93, 0x2c, 0xc9, 0x7a, 0x93,
+	0x25, 0x5a, 0x4b, 0x8c, 0x3a, 0xb8, 0x25, 0x4e, 0xc5, 0x57, 0x3f, 0x23, 0xda, 0xbe, 0x8d, 0xa3,
+	0x0a, 0x9c, 0xc2, 0x98, 0x91, 0x6d, 0x58, 0xa9, 0xcc, 0x8f, 0x21, 0x2d, 0x64, 0xf4, 0xa4, 0x95,
+	0x96, 0x2d, 0x0b, 0x9b, 0x96, 0xaf, 0xea, 0x27, 0x7d, 0x4d, 0xb3, 0x54, 0x82, 0xc9, 0xe0, 0x02,
+	0x2c, 0xc3, 0xd1, 0x61, 0xd6, 0xc5, 0x57, 0x06, 0x9c, 0xf1, 0x97, 0x01, 0x4b, 0xff, 0x6e, 0x37,
+	0x3d, 0xc5, 0xf2, 0xb2, 0xff, 0x02, 0xed, 0x75, 0x52, 0x6b, 0x97, 0x34, 0xca, 0x1e, 0xca, 0xb6,
+	0xec, 0x45, 0xe0, 0x67, 0x2a, 0xeb, 0x67, 0x8a, 0xb8, 0x9d, 0x65, 0xb5, 0x8a, 0x4d, 0xaf, 0x33,
+	0x7e, 0x80, 0x3d, 0x21, 0xf7, 0xde, 0x06, 0x9c, 0x89, 0xb9, 0xf6, 0x56, 0x0c, 0xac, 0x6c, 0x95,
+	0xf5, 0xc7, 0x5a, 0x07, 0x37, 0xe9, 0x3f, 0x67, 0xe1, 0x6c, 0x32, 0x94, 0xe9, 0x6f, 0xd3, 0x1d,
+	0x18, 0x73, 0x4b, 0x9d, 0x0a, 0xef, 0xda, 0xc5, 0x3a, 0xea, 0x2e, 0x42, 0x1f, 0xa0, 0x9f, 0x17,
+	0xe0, 0x48, 0xe0, 0xb6, 0x0b, 0x50, 0xf1, 0x2e, 0xdc, 0xb8, 0x87, 0xfc, 0x17, 0x9f, 0x9f, 0xa2,
+	0x1f, 0x81, 0x49, 0x13, 0xd7, 0x2b, 0x1e, 0xe3, 0xea, 0xdd, 0xbb, 0x81, 0x0f, 0x92, 0x95, 0xbc,
+	0x29, 0x3c, 0x72, 0x07, 0x6f, 0xda, 0x3e, 0x86, 0xa2, 0xc9, 0x58, 0x29, 0xd5, 0xfc, 0x1a, 0x3f,
+	0xa5, 0xe7, 0xf2, 0xb9, 0x0c, 0x1c, 0x8f, 0xc5, 0xfa, 0x2e, 0x75, 0x2b, 0x05, 0x4b, 0xd0, 0xb2,
+	0xad, 0x25, 0x68, 0xa4, 0x5a, 0x48, 0xa1, 0xed, 0x44, 0xa5, 0x9a, 0x3f, 0x75, 0x31, 0x52, 0xe2,
+	0xc4, 0x72, 0x64, 0x97, 0x61, 0x9a, 0xbe, 0x2a, 0xe6, 0x2f, 0x69, 0xd8, 0x30, 0x1d, 0x83, 0xa6,
+	0x97, 0x1a, 0x34, 0x13, 0x7c, 0x78, 0x93, 0x8d, 0x72, 0xfb, 0xe7, 0x26, 0x1c, 0x6a, 0x6a, 0xca,
+	0x8e, 0xa2, 0xd6, 0xa9, 0x84, 0x05, 0x41, 0x99, 0xc5, 0x94, 0xf3, 0x4c, 0xf1, 0x81, 0x3b, 0x9f,
+	0xa1, 0x58, 0x79, 0xb8, 0xfa, 0x50, 0x6d, 0xd8, 0xa6, 0xeb, 0x5d, 0x38, 0xe8, 0x7b, 0xca, 0xf7,
+	0xcf, 0xad, 0x1e, 0x65, 0xfb, 0xc6, 0x7f, 0x91, 0xfc, 0x65, 0xc0, 0x05, 0xea, 0xaf, 0xf1, 0x57,
+	0xf3, 0x31, 0x5e, 0xd4, 0xe1, 0xb1, 0xc4, 0x49, 0xba, 0xd1, 0x0d, 0xc2, 0x94, 0x6a, 0xb8, 0xdc,
+	0xac, 0xe3, 0x35, 0xd3, 0x6c, 0xe2, 0xae, 0x37, 0xe0, 0xbf, 0x2d, 0xc0, 0x54, 0xf8, 0x52, 0x9d,
+	0x6a, 0x82, 0x60, 0x4b, 0x49, 0xa6, 0x5d, 0x4b, 0x49, 0x36, 0xd8, 0x52, 0x72, 0x16, 0x50, 0xeb,
+	0x77, 0x10, 0x78, 0x2d, 0xd2, 0x58, 0xf0, 0x03, 0x08, 0xfe, 0xc6, 0x35, 0x5f, 0x1b, 0x8b, 0xdb,
+	0xb8, 0xc6, 0x8b, 0x89, 0xbe, 0x6c, 0x97, 0xbb, 0x26, 0xdd, 0x63, 0x47, 0xa3, 0xf7, 0xa9, 0xf4,
+	0x09, 0x57, 0xe8, 0xe7, 0x22, 0x54, 0x4a, 0x38, 0x1e, 0x99, 0x03, 0x77, 0xcf, 0xaf, 0x3a, 0x0e,
+	0xc7, 0x42, 0x15, 0x01, 0xf1, 0x47, 0x1d, 0xa7, 0xea, 0x93, 0x02, 0x48, 0x71, 0xb3, 0xdc, 0xba,
+	0x14, 0xaa, 0xd2, 0xec, 0xba, 0x14, 0xfa, 0xc3, 0xd3, 0xae, 0xc2, 0xeb, 0x28, 0xd9, 0x2f, 0x52,
+	0x61, 0x52, 0xd6, 0x8d, 0x6d, 0xc5, 0x31, 0x8e, 0xec, 0x9f, 0x9e, 0x12, 0xa7, 0x1e, 0x06, 0xc1,
+	0x7e, 0x79, 0x8b, 0xa2, 0x7a, 0x19, 0x04, 0xff, 0x29, 0x15, 0x60, 0x31, 0xba, 0x7c, 0xc1, 0x97,
+	0xdf, 0x4c, 0x79, 0xd9, 0xc9, 0x30, 0x13, 0x86, 0x2e, 0x49, 0x05, 0xc7, 0x34, 0xf4, 0xdb, 0x89,
+	0x0a, 0x76, 0x4c, 0xfb, 0x4c, 0x96, 0x8e, 0xf8, 0x15, 0x01, 0xf2, 0x89, 0xa9, 0xe6, 0x5b, 0x5c,
+	0x83, 0xe9, 0xa8, 0xc4, 0xae, 0x90, 0xa8, 0xe1, 0xa2, 0x85, 0x7a, 0x79, 0x32, 0xac, 0x83, 0xc4,
+	0x0c, 0xcf, 0x77, 0x39, 0x85, 0xe6, 0x1e, 0xbb, 0xbd, 0xc3, 0x9d, 0xfc, 0xd3, 0xd0, 0x7c, 0x97,
+	0x1f, 0x71, 0x67, 0x9a, 0xe3, 0x75, 0x4f, 0x2a, 0x60, 0x7f, 0xdd, 0x7f, 0x43, 0xa6, 0x87, 0x0c,
+	0x74, 0x11, 0xa6, 0x1c, 0xbc, 0xfe, 0x40, 0x21, 0x8b, 0x17, 0x39, 0xa1, 0x36, 0x6f, 0xa6, 0x04,
+	0xc3, 0xa4, 0x03, 0x54, 0xc6, 0xa6, 0xc5, 0xcf, 0x99, 0xad, 0xeb, 0x17, 0xda, 0x54, 0xf0, 0xdf,
+	0x72, 0x41, 0xf8, 0x87, 0x6e, 0x26, 0xcc, 0xd6, 0x21, 0x53, 0xfa, 0x25, 0x81, 0xa7, 0xc9, 0x3d,
+	0xd7, 0xcf, 0x72, 0xa5, 0x82, 0x4b, 0x16, 0x2e, 0xaf, 0xec, 0x39, 0xbb, 0xf9, 0xff, 0xff, 0x51,
+	0x82, 0xcf, 0x0b, 0x30, 0xe9, 0x10, 0xe2, 0x7d, 0xc3, 0xfb, 0x31, 0xc6, 0x0f, 0x03, 0x78, 0x88,
+	0x67, 0xa7, 0x68, 0xa0, 0x68, 0x93, 0x1e, 0x21, 0x80, 0xd9, 0x24, 0xee, 0x45, 0x4f, 0xc0, 0xbd,
+	0xf8, 0xb3, 0x0c, 0x2c, 0x24, 0xd9, 0x57, 0x2e, 0xa1, 0xd1, 0xdd, 0x23, 0x42, 0xc7, 0xdd, 0x23,
+	0x99, 0x88, 0xee, 0x91, 0x80, 0x27, 0x92, 0x8d, 0xf5, 0x44, 0x42, 0xb7, 0x3e, 0xc6, 0x13, 0x09,
+	0xf0, 0x1f, 0xd0, 0x2b, 0xbd, 0xe9, 0xf5, 0xca, 0x6f, 0xdb, 0x6e, 0xbf, 0x8c, 0x2b, 0x4d, 0xad,
+	0x4c, 0x6c, 0xa0, 0xf0, 0xa0, 0xe9, 0x3e, 0xc4, 0xa2, 0x5b, 0x32, 0xeb, 0x7c, 0x9f, 0x21, 0x9a,
+	0x56, 0xfe, 0xbe, 0x49, 0x18, 0xba, 0x55, 0xd4, 0xbc, 0x25, 0x14, 0x13, 0x41, 0x79, 0xa3, 0x79,
+	0x91, 0x6e, 0xa9, 0xeb, 0x85, 0x7b, 0x00, 0x6e, 0x66, 0x0d, 0x1d, 0x84, 0xd1, 0x3b, 0xf7, 0x97,
+	0x5f, 0x2e, 0xdc, 0x59, 0xbb, 0xff, 0xf0, 0xb6, 0x5c, 0x58, 0x5e, 0xff, 0xc0, 0xd8, 0x33, 0xc1,
+	0x87, 0x1f, 0xb8, 0xbd, 0x39, 0x26, 0x20, 0x04, 0x23, 0xde, 0x87, 0xeb, 0x0f, 0xc6, 0x32, 0x4b,
+	0x7f, 0xb3, 0x09, 0xbd, 0x94, 0x6d, 0xf4, 0x93, 0x02, 0xf4, 0xb1, 0xa8, 0x23, 0x3a, 0x1d, 0x21,
+	0x58, 0xad, 0x9f, 0x4d, 0x13, 0x17, 0x92, 0x4c, 0xe5, 0xcd, 0x73, 0x27, 0x7f, 0xec, 0xeb, 0xff,
+	0xf0, 0x89, 0xcc, 0x1c, 0x3a, 0x92, 0x8f, 0xfb, 0xdc, 0x1b, 0xfa, 0x1d, 0x01, 0x46, 0x03, 0x1f,
+	0x3e, 0x43, 0x4b, 0xed, 0x97, 0x09, 0x7e, 0x5e, 0x4d, 0xbc, 0xd8, 0x11, 0x0c, 0xa7, 0x31, 0x4f,
+	0x69, 0x3c, 0x8d, 0x9e, 0x8d, 0xa5, 0x31, 0xff, 0x84, 0x2b, 0xa3, 0xa7, 0xe8, 0x37, 0x05, 0x18,
+	0xf1, 0x7f, 0x2b, 0x0d, 0x5d, 0x68, 0xbf, 0x70, 0xe0, 0xab, 0x6b, 0xe2, 0x52, 0x27, 0x20, 0x9c,
+	0xd4, 0x45, 0x4a, 0xea, 0x29, 0x34, 0x1f, 0x4b, 0xaa, 0xad, 0x36, 0x4d, 0xf4, 0x1b, 0x02, 0x0c,
+	0xfb, 0x3e, 0xbe, 0x86, 0xce, 0xc7, 0xad, 0x1a, 0xf6, 0x15, 0x37, 0xf1, 0x42, 0x07, 0x10, 0x9c,
+	0xcc, 0x73, 0x94, 0xcc, 0x67, 0xd1, 0xc9, 0x08, 0x32, 0xfd, 0xe1, 0x70, 0xfa, 0xf6, 0x03, 0x1f,
+	0x3f, 0x8b, 0x7f, 0xfb, 0xe1, 0x5f, 0x5d, 0x13, 0x2f, 0x76, 0x04, 0x93, 0xf0, 0xed, 0x7b, 0xeb,
+	0x16, 0x28, 0x65, 0xbf, 0x2f, 0xc0, 0x78, 0xcb, 0x27, 0xc6, 0xd0, 0xa5, 0xb8, 0xb5, 0xa3, 0xbe,
+	0x7d, 0x26, 0x5e, 0xee, 0x10, 0x8a, 0xd3, 0x7c, 0x81, 0xd2, 0x7c, 0x06, 0x9d, 0x8e, 0xa0, 0xb9,
+	0xb5, 0x46, 0x1f, 0xbd, 0x25, 0xc0, 0x58, 0x10, 0x21, 0xba, 0xd8, 0xc9, 0xf2, 0x36, 0xcd, 0x97,
+	0x3a, 0x03, 0xe2, 0x24, 0x6f, 0x52, 0x92, 0x5f, 0x45, 0xaf, 0x24, 0x26, 0x39, 0xff, 0xc4, 0x67,
+	0xbb, 0x3c, 0x6d, 0x9d, 0x82, 0x7e, 0x57, 0x80, 0x11, 0xff, 0x8d, 0x1d, 0x7f, 0x10, 0x43, 0x35,
+	0x91, 0xb8, 0xd4, 0x09, 0x08, 0x67, 0xe7, 0x2a, 0x65, 0xe7, 0x02, 0xca, 0xe7, 0x23, 0x3f, 0x51,
+	0xe9, 0xcd, 0xa9, 0xe5, 0x9f, 0xb0, 0xfc, 0xde, 0x53, 0xf4, 0x2d, 0x01, 0xc4, 0xe8, 0x4f, 0x63,
+	0xa1, 0x9b, 0x71, 0xb4, 0xb4, 0xfd, 0xbe, 0x97, 0xf8, 0x42, 0x5a, 0x70, 0xce, 0xd6, 0x8b, 0x94,
+	0xad, 0x6b, 0xe8, 0x6a, 0xc2, 0xab, 0x30, 0xc8, 0x27, 0xfa, 0x17, 0x01, 0x0e, 0xc5, 0x7c, 0x96,
+	0x0a, 0xbd, 0xd0, 0x89, 0xf0, 0x84, 0xbc, 0xab, 0x17, 0x53, 0xc3, 0x73, 0x0e, 0x5f, 0xa5, 0x1c,
+	0xbe, 0x8c, 0x6e, 0xa7, 0x97, 0x43, 0x2f, 0xbf, 0x7f, 0x20, 0xc0, 0xb0, 0xdf, 0xdc, 0x3d, 0x9f,
+	0x58, 0x9a, 0x12, 0x5d, 0xb0, 0xa1, 0xe9, 0x56, 0x69, 0x95, 0x72, 0x71, 0x13, 0x5d, 0x4f, 0x24,
+	0x7e, 0xf9, 0x27, 0x7c, 0xc8, 0x6b, 0xbc, 0x3c, 0x45, 0xff, 0x2d, 0xc0, 0x4c, 0xe4, 0xe7, 0x9e,
+	0xd0, 0x8d, 0x38, 0xaa, 0xda, 0x7d, 0xd0, 0x4a, 0xbc, 0x99, 0x12, 0x9a, 0xf3, 0xf7, 0x43, 0x94,
+	0xbf, 0x0f, 0xa2, 0xf7, 0xef, 0x83, 0xbf, 0xfc, 0x0e, 0x5d, 0xa6, 0x10, 0xfa, 0x9d, 0x02, 0xf4,
+	0xe3, 0x19, 0x98, 0xf3, 0x7b, 0xa2, 0xad, 0x1f, 0x0c, 0x5a, 0x49, 0xfc, 0x62, 0x22, 0xbf, 0x09,
+	0x25, 0xae, 0xee, 0x0b, 0x07, 0xdf, 0x8e, 0xf7, 0xd1, 0xed, 0x78, 0x0d, 0x3d, 0xd8, 0xcf, 0x76,
+	0x98, 0x36, 0x7e, 0xf7, 0x8b, 0x4f, 0xe8, 0xaf, 0x05, 0x98, 0x89, 0xfc, 0x9c, 0x50, 0xbc, 0x08,
+	0xb4, 0xfb, 0x5c, 0x91, 0x78, 0x33, 0x25, 0x34, 0xe7, 0xf9, 0x06, 0xe5, 0xf9, 0x0a, 0xba, 0x14,
+	0xc1, 0xb3, 0x86, 0x77, 0xad, 0x42, 0x83, 0xa0, 0x28, 0x94, 0x55, 0xd3, 0x2a, 0x34, 0x29, 0x12,
+	0xee, 0x54, 0xa1, 0x2f, 0x0a, 0x30, 0x11, 0xf6, 0x8d, 0x22, 0x74, 0x35, 0xd6, 0x9a, 0x89, 0xfe,
+	0xf4, 0x91, 0xf8, 0x5c, 0xe7, 0x80, 0x9c, 0x93, 0xcb, 0x94, 0x93, 0x3c, 0x3a, 0x17, 0x65, 0x0d,
+	0xf9, 0x3f, 0x62, 0x54, 0x28, 0x32, 0x4a, 0x7f, 0x21, 0x03, 0xf3, 0xc9, 0x7a, 0xea, 0xd1, 0x5a,
+	0x27, 0xb7, 0x62, 0x6c, 0xf7, 0xbf, 0x78, 0xaf, 0x1b, 0xa8, 0x38, 0xe3, 0xaf, 0x51, 0xc6, 0x5f,
+	0x41, 0x6b, 0xfb, 0x11, 0x5b, 0x5f, 0xef, 0x3f, 0xfa, 0x1f, 0x01, 0x8e, 0xc4, 0x36, 0xb6, 0xa3,
+	0x97, 0x12, 0x1f, 0xb8, 0x88, 0x86, 0x7b, 0x71, 0x79, 0x1f, 0x18, 0x38, 0xe7, 0x8f, 0x28, 0xe7,
+	0x0f, 0xd0, 0xab, 0xfb, 0xe1, 0xdc, 0xb9, 0xb8, 0xec, 0x26, 0x77, 0xf4, 0x5d, 0x01, 0xc4, 0xe8,
+	0xae, 0xf1, 0x78, 0xe3, 0xa1, 0x6d, 0x4b, 0xbc, 0xf8, 0x42, 0x5a, 0x70, 0xce, 0xf4, 0x2b, 0x94,
+	0xe9, 0xdb, 0x68, 0x35, 0x11, 0xd3, 0x66, 0xa1, 0xb8, 0xc7, 0x2a, 0x01, 0xf3, 0x4f, 0x78, 0x27,
+	0xfe, 0xd3, 0xfc, 0x13, 0xde, 0x7a, 0xff, 0x14, 0xfd, 0x9a, 0x00, 0x43, 0xde, 0xc6, 0x71, 0x94,
+	0x8f, 0x3f, 0x7f, 0x2d, 0xfd, 0xe7, 0xe2, 0xf9, 0xe4, 0x00, 0x9c, 0x81, 0xb3, 0x94, 0x81, 0x79,
+	0x74, 0x22, 0xf2, 0xa0, 0xf2, 0x17, 0xa2, 0x12, 0x82, 0xbe, 0x2e, 0xc0, 0x54, 0x78, 0x0f, 0x33,
+	0xba, 0xd6, 0x5e, 0xfb, 0x45, 0x74, 0x7a, 0x8b, 0xcf, 0xa7, 0x01, 0xe5, 0xf4, 0xaf, 0x50, 0xfa,
+	0x6f, 0xa0, 0xe7, 0x23, 0xe8, 0xe7, 0x0a, 0x31, 0xd0, 0xf5, 0x9d, 0x7f, 0xe2, 0x06, 0xa3, 0x9e,
+	0xa2, 0x9f, 0xcd, 0xc0, 0xc9, 0x44, 0x3d, 0xc1, 0xe8, 0x6e, 0x62, 0x71, 0x69, 0xd3, 0x6b, 0x2d,
+	0xae, 0x75, 0x01, 0x13, 0xdf, 0x82, 0x07, 0x74, 0x0b, 0xd6, 0xd0, 0xcb, 0xfb, 0xbc, 0x72, 0x4c,
+	0x9b, 0xcb, 0x5f, 0x16, 0x00, 0xdc, 0x5e, 0x63, 0x74, 0xae, 0x0d, 0xa9, 0xfe, 0x6e, 0x65, 0x71,
+	0x31, 0xe9, 0x74, 0x4e, 0xfe, 0x02, 0x25, 0xff, 0x04, 0x92, 0x62, 0xc8, 0xe7, 0x4d, 0xcd, 0xe8,
+	0x7f, 0x05, 0x98, 0x6b, 0xd3, 0x39, 0x1c, 0x6f, 0xc1, 0x24, 0x6b, 0x86, 0x16, 0x57, 0xf7, 0x85,
+	0x83, 0x33, 0x26, 0x53, 0xc6, 0xee, 0xa3, 0x7b, 0xdd, 0x30, 0xbb, 0x59, 0x02, 0x18, 0xfd, 0x93,
+	0x00, 0xb3, 0x81, 0xf5, 0x82, 0xee, 0xd4, 0x72, 0x32, 0x7f, 0x28, 0xa6, 0x61, 0x5a, 0x5c, 0xd9,
+	0x0f, 0x0a, 0xce, 0xfd, 0x32, 0xe5, 0xfe, 0x3a, 0xba, 0x16, 0xc1, 0x7d, 0x90, 0x35, 0x72, 0x35,
+	0xfa, 0x43, 0x39, 0xe8, 0xfb, 0x02, 0xcc, 0x44, 0x36, 0xe9, 0xc6, 0x5b, 0x6a, 0xed, 0xba, 0xa3,
+	0xc5, 0x9b, 0x29, 0xa1, 0xbb, 0xa9, 0xe6, 0x7d, 0xbd, 0xc5, 0xe8, 0x1d, 0x01, 0x66, 0x22, 0x7b,
+	0x67, 0xe3, 0xb9, 0x6d, 0xd7, 0xff, 0x2b, 0xde, 0x4c, 0x09, 0xcd, 0xb9, 0x5d, 0xa3, 0xdc, 0xae,
+	0xa2, 0xe5, 0x84, 0x9e, 0x3f, 0xe6, 0x68, 0x0a, 0x8f, 0x29, 0x9e, 0xfc, 0x13, 0xbb, 0xf9, 0xf8,
+	0x29, 0xfa, 0x86, 0x00, 0x93, 0xa1, 0xdd, 0xad, 0x28, 0xd6, 0xd8, 0x8c, 0x6b, 0xb2, 0x15, 0xaf,
+	0xa5, 0x80, 0xe4, 0x9c, 0xdd, 0xa3, 0x9c, 0xdd, 0x42, 0x2b, 0x11, 0x9c, 0xb9, 0xef, 0x2d, 0xe2,
+	0x1d, 0xba, 0x6d, 0xb7, 0xe8, 0x3f, 0x04, 0x38, 0x1c, 0xd7, 0x16, 0x8b, 0x5e, 0x4c, 0x2c, 0x73,
+	0xe1, 0xcd, 0xba, 0xe2, 0x4b, 0xe9, 0x11, 0x70, 0x7e, 0x1f, 0x52, 0x7e, 0xd7, 0xd1, 0xfd, 0xfd,
+	0xc8, 0xad, 0x27, 0x19, 0xcb, 0x18, 0xfb, 0x7b, 0x01, 0x8e, 0xc4, 0x76, 0x93, 0xc6, 0x5b, 0xa8,
+	0x49, 0xda, 0x5f, 0xc5, 0xe5, 0x7d, 0x60, 0xe0, 0xcc, 0x5f, 0xa7, 0xcc, 0x5f, 0x46, 0x17, 0xa3,
+	0x5e, 0xb6, 0x8d, 0xc5, 0x75, 0x9b, 0xdd, 0xbe, 0xd5, 0x2f, 0x08, 0x80, 0x5a, 0x5b, 0x3a, 0xd1,
+	0xe5, 0xc4, 0xd1, 0x27, 0x6f, 0x67, 0xaa, 0x78, 0xa5, 0x53, 0x30, 0xce, 0xc2, 0x73, 0x94, 0x85,
+	0x25, 0x74, 0x3e, 0xb9, 0xbd, 0x49, 0x34, 0x3b, 0xa6, 0x9a, 0x63, 0x26, 0xb2, 0xed, 0xb2, 0x83,
+	0xcb, 0x34, 0xa4, 0x0d, 0x54, 0xbc, 0x99, 0x12, 0x9a, 0x33, 0xb5, 0x41, 0x99, 0xba, 0x87, 0xee,
+	0xee, 0x47, 0x28, 0x2d, 0x2f, 0x3b, 0xdf, 0x11, 0x20, 0x17, 0xd5, 0xa1, 0x88, 0xae, 0x27, 0x0f,
+	0x4f, 0xb4, 0xf4, 0x4b, 0x8a, 0x37, 0xd2, 0x01, 0x77, 0x93, 0x53, 0x9e, 0x9c, 0x6f, 0x50, 0x66,
+	0xbe, 0x2c, 0x04, 0xbe, 0xd8, 0x6b, 0xb7, 0x84, 0xc5, 0xdf, 0xa7, 0x71, 0x4d, 0x78, 0xe2, 0xb5,
+	0x14, 0x90, 0xe9, 0x62, 0xc4, 0x54, 0x3e, 0x29, 0xb5, 0x7f, 0x25, 0xc0, 0x54, 0x78, 0x03, 0x54,
+	0xbc, 0x67, 0x11, 0xdb, 0x47, 0x26, 0x3e, 0x9f, 0x06, 0x94, 0xb3, 0x72, 0x8b, 0xb2, 0xf2, 0x02,
+	0xba, 0xd1, 0x46, 0x35, 0xd8, 0xcd, 0x58, 0x04, 0x38, 0xff, 0xc4, 0x6f, 0xc2, 0x3c, 0x45, 0xdf,
+	0x13, 0x60, 0x32, 0xbc, 0x13, 0xe8, 0xb9, 0x24, 0xbe, 0x5a, 0x58, 0xdb, 0x95, 0x78, 0x2d, 0x05,
+	0x24, 0x67, 0xea, 0x43, 0x94, 0xa9, 0x47, 0x68, 0xb3, 0x5b, 0x76, 0x0b, 0x59, 0x83, 0x0e, 0x61,
+	0x13, 0x7d, 0x56, 0x80, 0xf1, 0x96, 0xae, 0x9b, 0xf8, 0x2c, 0x51, 0x54, 0x7f, 0x91, 0x78, 0xb9,
+	0x43, 0x28, 0xce, 0xdf, 0x12, 0xe5, 0xef, 0x2c, 0x5a, 0x88, 0xe0, 0x4f, 0xa9, 0xd7, 0x0b, 0xc1,
+	0xf8, 0xfd, 0xd7, 0x3c, 0x5f, 0xac, 0x09, 0x76, 0xd0, 0xc4, 0x5f, 0x16, 0x6d, 0x1a, 0x74, 0xc4,
+	0x1b, 0xe9, 0x80, 0x39, 0x2f, 0xd7, 0x28, 0x2f, 0x17, 0xd1, 0x85, 0x76, 0xae, 0xb9, 0xfb, 0x69,
+	0xb7, 0x12, 0xa7, 0xfa, 0x07, 0x21, 0x29, 0x09, 0x4f, 0xe3, 0x48, 0x67, 0x29, 0x89, 0xd6, 0x06,
+	0x16, 0xf1, 0xc5, 0xd4, 0xf0, 0x9c, 0xb7, 0x75, 0xca, 0xdb, 0x5d, 0x74, 0x27, 0xbd, 0x6f, 0xc4,
+	0xbf, 0x95, 0x5c, 0xa5, 0x0c, 0x91, 0x77, 0x18, 0xd5, 0x61, 0x10, 0xff, 0x0e, 0xdb, 0xb4, 0x6a,
+	0x88, 0x37, 0xd2, 0x01, 0x27, 0x7c, 0x87, 0x1e, 0x2f, 0xc8, 0xfb, 0xb7, 0x01, 0x08, 0xd5, 0xdf,
+	0x17, 0xe0, 0x50, 0x4c, 0xe1, 0x7f, 0xfc, 0x3b, 0x6c, 0xdf, 0xfd, 0x20, 0xbe, 0x98, 0x1a, 0x3e,
+	0x61, 0xec, 0xcb, 0xa4, 0x38, 0x58, 0x1e, 0xd0, 0xae, 0x79, 0xf1, 0xb9, 0xb4, 0x8a, 0x87, 0x9b,
+	0x30, 0xcf, 0x3e, 0x50, 0xa0, 0xdf, 0x99, 0x67, 0x1f, 0xde, 0x30, 0x20, 0xae, 0xee, 0x0b, 0x47,
+	0xf7, 0x3c, 0x7b, 0x2e, 0xbd, 0x45, 0x87, 0xb9, 0x7f, 0x15, 0x60, 0x2a, 0xbc, 0xba, 0x3c, 0x5e,
+	0x01, 0xc6, 0xd6, 0xb9, 0x8b, 0xcf, 0xa7, 0x01, 0xe5, 0x5c, 0x7e, 0x94, 0x72, 0xf9, 0x7e, 0xf4,
+	0x7a, 0x07, 0xf9, 0xde, 0x10, 0x65, 0xe1, 0x14, 0xa7, 0x07, 0x4a, 0xde, 0xd1, 0x4f, 0x09, 0xd0,
+	0xc7, 0xea, 0xbf, 0xe3, 0x2b, 0x71, 0x7c, 0x95, 0xe3, 0xe2, 0x42, 0x92, 0xa9, 0x9c, 0x83, 0x79,
+	0xca, 0xc1, 0x51, 0x34, 0x1b, 0xc3, 0x81, 0xa5, 0x36, 0xd0, 0xcf, 0x65, 0x60, 0x3e, 0x59, 0x6d,
+	0x73, 0x7c, 0xda, 0xa1, 0xa3, 0x1a, 0x74, 0xf1, 0x5e, 0x37, 0x50, 0x25, 0x4c, 0xf1, 0x06, 0xed,
+	0x2e, 0xe2, 0x98, 0x7b, 0x8b, 0x6a, 0x39, 0xd6, 0x02, 0x2f, 0xb9, 0xfe, 0x92, 0x00, 0x93, 0xa1,
+	0xf5, 0xcf, 0xf1, 0x56, 0x4b, 0x5c, 0x61, 0xb5, 0x78, 0x2d, 0x05, 0x24, 0xe7, 0xee, 0x0a, 0xe5,
+	0xee, 0x3c, 0x5a, 0x4c, 0x7a, 0xde, 0xa8, 0x63, 0x6a, 0x22, 0xf2, 0xb5, 0x81, 0xf6, 0x05, 0xc7,
+	0xe8, 0x76, 0xc7, 0xb1, 0xa0, 0xb0, 0x32, 0x6b, 0xf1, 0xce, 0x7e, 0xd1, 0xbc, 0x2b, 0x36, 0x9a,
+	0xbf, 0x72, 0xba, 0x35, 0xa6, 0xe6, 0xad, 0x46, 0xee, 0xc0, 0x0d, 0x0c, 0xa9, 0x8e, 0x16, 0x6f,
+	0xa6, 0x84, 0xee, 0x66, 0x4c, 0xcd, 0x57, 0x1d, 0x4d, 0x53, 0x67, 0xb1, 0xd5, 0xad, 0xf1, 0x81,
+	0x89, 0x24, 0x05, 0xc7, 0xe2, 0xf2, 0x3e, 0x30, 0x24, 0x4c, 0x9d, 0x25, 0xd0, 0x27, 0x76, 0x91,
+	0xad, 0xd7, 0xb0, 0x7d, 0x5b, 0x80, 0x5c, 0x54, 0x99, 0x67, 0xbc, 0x51, 0xd4, 0xa6, 0x90, 0x55,
+	0xbc, 0x91, 0x0e, 0x78, 0x5f, 0x86, 0x83, 0xe1, 0xa0, 0xf5, 0x32, 0xb9, 0xb2, 0xfe, 0x95, 0xb7,
+	0x67, 0x85, 0xaf, 0xbd, 0x3d, 0x2b, 0xfc, 0xdd, 0xdb, 0xb3, 0xc2, 0xc7, 0xdf, 0x99, 0x7d, 0xe6,
+	0x6b, 0xef, 0xcc, 0x3e, 0xf3, 0x8d, 0x77, 0x66, 0x9f, 0xf9, 0x60, 0x82, 0x4f, 0xed, 0xef, 0x7a,
+	0x57, 0xa6, 0xad, 0x65, 0xc5, 0x3e, 0xfa, 0xd7, 0x73, 0x2f, 0xfe, 0xdf, 0x00, 0xef, 0xa0, 0x4a,
+	0x98, 0xa7, 0x78, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
+	// ParamsVersions queries the versions of the parameters of the module that
+	// are retained, i.e., not pruned yet.
+	ParamsVersions(ctx context.Context, in *QueryParamsVersionsRequest, opts ...grpc.CallOption) (*QueryParamsVersionsResponse, error)
+	// CurrentParams queries the latest parameters of the module together with
+	// their version, their activation height, and the authority allowed to
+	// update them.
+	CurrentParams(ctx context.Context, in *QueryCurrentParamsRequest, opts ...grpc.CallOption) (*QueryCurrentParamsResponse, error)
+	// UnbondingParams queries the exact unbonding fee and the minimum unbonding
+	// time expected from the unbonding transaction of a BTC delegation under
+	// the latest parameters.
+	UnbondingParams(ctx context.Context, in *QueryUnbondingParamsRequest, opts ...grpc.CallOption) (*QueryUnbondingParamsResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
+	// DelegationsByParamsVersion queries all BTC delegations validated against
+	// the given params version
+	DelegationsByParamsVersion(ctx context.Context, in *QueryDelegationsByParamsVersionRequest, opts ...grpc.CallOption) (*QueryDelegationsByParamsVersionResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// VerifyCovenantSlashingSig verifies the adaptor signatures of a covenant
+	// member on the slashing tx of a BTC delegation, without submitting them
+	VerifyCovenantSlashingSig(ctx context.Context, in *QueryVerifyCovenantSlashingSigRequest, opts ...grpc.CallOption) (*QueryVerifyCovenantSlashingSigResponse, error)
+	// BTCDelegationSignatureReadiness queries whether a BTC delegation has a
+	// covenant quorum of adaptor signatures for each of its finality providers
+	BTCDelegationSignatureReadiness(ctx context.Context, in *QueryBTCDelegationSignatureReadinessRequest, opts ...grpc.CallOption) (*QueryBTCDelegationSignatureReadinessResponse, error)
+	// NextPowerDistUpdateHeight queries the smallest BTC height, starting from
+	// the current BTC tip, at which a power distribution update is scheduled
+	NextPowerDistUpdateHeight(ctx context.Context, in *QueryNextPowerDistUpdateHeightRequest, opts ...grpc.CallOption) (*QueryNextPowerDistUpdateHeightResponse, error)
+	// CommissionRateBounds queries the range of commission rates that a
+	// finality provider is allowed to charge
+	CommissionRateBounds(ctx context.Context, in *QueryCommissionRateBoundsRequest, opts ...grpc.CallOption) (*QueryCommissionRateBoundsResponse, error)
+	// FinalityProviderCommissionAtDelegation queries the commission rate of
+	// each finality provider of a BTC delegation at the time the BTC
+	// delegation was created
+	FinalityProviderCommissionAtDelegation(ctx context.Context, in *QueryFinalityProviderCommissionAtDelegationRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCommissionAtDelegationResponse, error)
+	// BTCDelegationCovenantCoverage queries how much of the required covenant
+	// quorum of a BTC delegation has signed it
+	BTCDelegationCovenantCoverage(ctx context.Context, in *QueryBTCDelegationCovenantCoverageRequest, opts ...grpc.CallOption) (*QueryBTCDelegationCovenantCoverageResponse, error)
+	// BTCDelegationsByValueRange queries all BTC delegations whose staking value
+	// falls in the given range, in ascending order of staking value
+	BTCDelegationsByValueRange(ctx context.Context, in *QueryBTCDelegationsByValueRangeRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByValueRangeResponse, error)
+	// CovenantInfo queries the covenant committee and its quorum under the
+	// latest params
+	CovenantInfo(ctx context.Context, in *QueryCovenantInfoRequest, opts ...grpc.CallOption) (*QueryCovenantInfoResponse, error)
+	// VerifyInclusionProofAt verifies the inclusion proof of a staking tx as of
+	// the BTC tip at a given historical height
+	VerifyInclusionProofAt(ctx context.Context, in *QueryVerifyInclusionProofAtRequest, opts ...grpc.CallOption) (*QueryVerifyInclusionProofAtResponse, error)
+	// BTCDelegationFinalityProviderStatuses queries the status of each finality
+	// provider of a BTC delegation
+	BTCDelegationFinalityProviderStatuses(ctx context.Context, in *QueryBTCDelegationFinalityProviderStatusesRequest, opts ...grpc.CallOption) (*QueryBTCDelegationFinalityProviderStatusesResponse, error)
+	// BTCNetwork queries the BTC network the chain is configured for
+	BTCNetwork(ctx context.Context, in *QueryBTCNetworkRequest, opts ...grpc.CallOption) (*QueryBTCNetworkResponse, error)
+	// FinalityProviderDelegationCount queries the number of BTC delegations of
+	// the given finality provider under the given status
+	FinalityProviderDelegationCount(ctx context.Context, in *QueryFinalityProviderDelegationCountRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationCountResponse, error)
+	// DelegationCountByParamsVersion queries the number of BTC delegations that
+	// are not unbonded yet under each params version
+	DelegationCountByParamsVersion(ctx context.Context, in *QueryDelegationCountByParamsVersionRequest, opts ...grpc.CallOption) (*QueryDelegationCountByParamsVersionResponse, error)
+	// BTCDelegationCovenantSigs queries the covenant adaptor signatures on the
+	// slashing tx of a BTC delegation, one per (covenant PK, finality provider
+	// PK) pair
+	BTCDelegationCovenantSigs(ctx context.Context, in *QueryBTCDelegationCovenantSigsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationCovenantSigsResponse, error)
+	// DelegationsExpiringWithin queries active BTC delegations whose staking
+	// timelock expires within the given number of BTC blocks
+	DelegationsExpiringWithin(ctx context.Context, in *QueryDelegationsExpiringWithinRequest, opts ...grpc.CallOption) (*QueryDelegationsExpiringWithinResponse, error)
+	// IsStakingTxRegistered queries whether a staking tx is already registered
+	// as a BTC delegation, in which case it cannot be reused
+	IsStakingTxRegistered(ctx context.Context, in *QueryIsStakingTxRegisteredRequest, opts ...grpc.CallOption) (*QueryIsStakingTxRegisteredResponse, error)
+	// BTCDelegationUnbondingStatus queries the unbonding status of a BTC
+	// delegation, i.e., whether the delegator has unbonded it early and whether
+	// the covenant unbonding signatures have reached quorum
+	BTCDelegationUnbondingStatus(ctx context.Context, in *QueryBTCDelegationUnbondingStatusRequest, opts ...grpc.CallOption) (*QueryBTCDelegationUnbondingStatusResponse, error)
+	// SelectiveSlashingEvidenceList queries the submitted selective slashing
+	// evidences, ordered by block height descending.
+	SelectiveSlashingEvidenceList(ctx context.Context, in *QuerySelectiveSlashingEvidenceListRequest, opts ...grpc.CallOption) (*QuerySelectiveSlashingEvidenceListResponse, error)
+	// DelegationsByFpSet queries all BTC delegations restaking to exactly the
+	// given set of finality providers, in ascending order of staking tx hash.
+	DelegationsByFpSet(ctx context.Context, in *QueryDelegationsByFpSetRequest, opts ...grpc.CallOption) (*QueryDelegationsByFpSetResponse, error)
+	// BTCDelegationTransactions queries the raw transactions of a BTC delegation,
+	// i.e., the staking, slashing, unbonding and unbonding slashing txs, along
+	// with the delegator signatures on the slashing txs
+	BTCDelegationTransactions(ctx context.Context, in *QueryBTCDelegationTransactionsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationTransactionsResponse, error)
+	// BTCDelegationScriptPaths queries the taproot script paths of the staking
+	// output of a BTC delegation, reconstructed under the params version the
+	// BTC delegation was validated against
+	BTCDelegationScriptPaths(ctx context.Context, in *QueryBTCDelegationScriptPathsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationScriptPathsResponse, error)
+	// BTCDelegationsByFlags queries BTC delegations filtered by whether they
+	// have an inclusion proof, a covenant quorum and a delegator unbonding
+	// signature
+	BTCDelegationsByFlags(ctx context.Context, in *QueryBTCDelegationsByFlagsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByFlagsResponse, error)
+	// FindStakingOutputIndex finds the index of the staking output Babylon
+	// would select in a staking tx under a given params version
+	FindStakingOutputIndex(ctx context.Context, in *QueryFindStakingOutputIndexRequest, opts ...grpc.CallOption) (*QueryFindStakingOutputIndexResponse, error)
+	// CovenantSigningHashes queries the sighashes a covenant member signs for
+	// a BTC delegation, i.e., the slashing tx and unbonding slashing tx
+	// sighashes for each finality provider and the unbonding tx sighash
+	CovenantSigningHashes(ctx context.Context, in *QueryCovenantSigningHashesRequest, opts ...grpc.CallOption) (*QueryCovenantSigningHashesResponse, error)
+	// AllBTCDelegations queries all BTC delegations in the order of their
+	// staking tx hashes, optionally filtered by their statuses
+	AllBTCDelegations(ctx context.Context, in *QueryAllBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryAllBTCDelegationsResponse, error)
+	// CovenantCommitteeChanges queries the covenant committee at genesis, i.e.,
+	// under params version 0, against the one under the latest params
+	CovenantCommitteeChanges(ctx context.Context, in *QueryCovenantCommitteeChangesRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeChangesResponse, error)
+	// FinalityProviderRewardGauge queries the reward gauge of a finality
+	// provider by its BTC PK
+	FinalityProviderRewardGauge(ctx context.Context, in *QueryFinalityProviderRewardGaugeRequest, opts ...grpc.CallOption) (*QueryFinalityProviderRewardGaugeResponse, error)
+	// DelegationActivationRate queries the number of BTC delegations activated
+	// in each bucket of BTC heights within the given range
+	DelegationActivationRate(ctx context.Context, in *QueryDelegationActivationRateRequest, opts ...grpc.CallOption) (*QueryDelegationActivationRateResponse, error)
+	// StakerDelegationAttestation queries all BTC delegations of a staker
+	// together with a reproducible digest of the set
+	StakerDelegationAttestation(ctx context.Context, in *QueryStakerDelegationAttestationRequest, opts ...grpc.CallOption) (*QueryStakerDelegationAttestationResponse, error)
+	// FinalityProviderRewardBreakdown queries the rewards of a finality
+	// provider split into its commission and the rewards of its
+	// self-delegations
+	FinalityProviderRewardBreakdown(ctx context.Context, in *QueryFinalityProviderRewardBreakdownRequest, opts ...grpc.CallOption) (*QueryFinalityProviderRewardBreakdownResponse, error)
+	// CanReachCovenantQuorum queries whether a BTC delegation can still reach
+	// the covenant quorum of the params it was validated against
+	CanReachCovenantQuorum(ctx context.Context, in *QueryCanReachCovenantQuorumRequest, opts ...grpc.CallOption) (*QueryCanReachCovenantQuorumResponse, error)
+	// BTCTip queries the tip of the BTC light client that the module uses for
+	// computing the status of BTC delegations
+	BTCTip(ctx context.Context, in *QueryBTCTipRequest, opts ...grpc.CallOption) (*QueryBTCTipResponse, error)
+	// DelegationsWithUnbondingScheduleIssues queries the BTC delegations whose
+	// end height does not exceed their minimum unbonding time, such that the
+	// BTC height at which they are unbonded underflows
+	DelegationsWithUnbondingScheduleIssues(ctx context.Context, in *QueryDelegationsWithUnbondingScheduleIssuesRequest, opts ...grpc.CallOption) (*QueryDelegationsWithUnbondingScheduleIssuesResponse, error)
+	// FinalityProviderStats queries the number of finality providers in total
+	// and under each status
+	FinalityProviderStats(ctx context.Context, in *QueryFinalityProviderStatsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderStatsResponse, error)
+	// BTCDelegationCovenantUnbondingSigs queries the covenant Schnorr
+	// signatures on the unbonding tx of a BTC delegation, one per covenant PK
+	BTCDelegationCovenantUnbondingSigs(ctx context.Context, in *QueryBTCDelegationCovenantUnbondingSigsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationCovenantUnbondingSigsResponse, error)
+	// BTCDelegationSlashingRate queries the slashing rate and the slashing
+	// destinations applying to a BTC delegation, as given by the params version
+	// it was created under
+	BTCDelegationSlashingRate(ctx context.Context, in *QueryBTCDelegationSlashingRateRequest, opts ...grpc.CallOption) (*QueryBTCDelegationSlashingRateResponse, error)
+	// DelegationsAffectedBySlashing queries the BTC delegations that became
+	// active under a finality provider that was slashed afterwards
+	DelegationsAffectedBySlashing(ctx context.Context, in *QueryDelegationsAffectedBySlashingRequest, opts ...grpc.CallOption) (*QueryDelegationsAffectedBySlashingResponse, error)
+	// RefundableBTCDelegations queries the BTC delegations of a staker that
+	// did not receive a covenant quorum before their deadline, and can thus be
+	// claimed for a refund of a MsgCreateBTCDelegation
+	RefundableBTCDelegations(ctx context.Context, in *QueryRefundableBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryRefundableBTCDelegationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
+	out := new(QueryParamsByVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsVersions(ctx context.Context, in *QueryParamsVersionsRequest, opts ...grpc.CallOption) (*QueryParamsVersionsResponse, error) {
+	out := new(QueryParamsVersionsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentParams(ctx context.Context, in *QueryCurrentParamsRequest, opts ...grpc.CallOption) (*QueryCurrentParamsResponse, error) {
+	out := new(QueryCurrentParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CurrentParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingParams(ctx context.Context, in *QueryUnbondingParamsRequest, opts ...grpc.CallOption) (*QueryUnbondingParamsResponse, error) {
+	out := new(QueryUnbondingParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/UnbondingParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
+	out := new(QueryFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
+	out := new(QueryFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
+	out := new(QueryBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsByParamsVersion(ctx context.Context, in *QueryDelegationsByParamsVersionRequest, opts ...grpc.CallOption) (*QueryDelegationsByParamsVersionResponse, error) {
+	out := new(QueryDelegationsByParamsVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsByParamsVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
+	out := new(QueryFinalityProviderDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
+	out := new(QueryBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyCovenantSlashingSig(ctx context.Context, in *QueryVerifyCovenantSlashingSigRequest, opts ...grpc.CallOption) (*QueryVerifyCovenantSlashingSigResponse, error) {
+	out := new(QueryVerifyCovenantSlashingSigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/VerifyCovenantSlashingSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationSignatureReadiness(ctx context.Context, in *QueryBTCDelegationSignatureReadinessRequest, opts ...grpc.CallOption) (*QueryBTCDelegationSignatureReadinessResponse, error) {
+	out := new(QueryBTCDelegationSignatureReadinessResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationSignatureReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextPowerDistUpdateHeight(ctx context.Context, in *QueryNextPowerDistUpdateHeightRequest, opts ...grpc.CallOption) (*QueryNextPowerDistUpdateHeightResponse, error) {
+	out := new(QueryNextPowerDistUpdateHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/NextPowerDistUpdateHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommissionRateBounds(ctx context.Context, in *QueryCommissionRateBoundsRequest, opts ...grpc.CallOption) (*QueryCommissionRateBoundsResponse, error) {
+	out := new(QueryCommissionRateBoundsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CommissionRateBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderCommissionAtDelegation(ctx context.Context, in *QueryFinalityProviderCommissionAtDelegationRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCommissionAtDelegationResponse, error) {
+	out := new(QueryFinalityProviderCommissionAtDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCommissionAtDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationCovenantCoverage(ctx context.Context, in *QueryBTCDelegationCovenantCoverageRequest, opts ...grpc.CallOption) (*QueryBTCDelegationCovenantCoverageResponse, error) {
+	out := new(QueryBTCDelegationCovenantCoverageResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationCovenantCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationsByValueRange(ctx context.Context, in *QueryBTCDelegationsByValueRangeRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByValueRangeResponse, error) {
+	out := new(QueryBTCDelegationsByValueRangeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByValueRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantInfo(ctx context.Context, in *QueryCovenantInfoRequest, opts ...grpc.CallOption) (*QueryCovenantInfoResponse, error) {
+	out := new(QueryCovenantInfoResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyInclusionProofAt(ctx context.Context, in *QueryVerifyInclusionProofAtRequest, opts ...grpc.CallOption) (*QueryVerifyInclusionProofAtResponse, error) {
+	out := new(QueryVerifyInclusionProofAtResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/VerifyInclusionProofAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationFinalityProviderStatuses(ctx context.Context, in *QueryBTCDelegationFinalityProviderStatusesRequest, opts ...grpc.CallOption) (*QueryBTCDelegationFinalityProviderStatusesResponse, error) {
+	out := new(QueryBTCDelegationFinalityProviderStatusesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationFinalityProviderStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCNetwork(ctx context.Context, in *QueryBTCNetworkRequest, opts ...grpc.CallOption) (*QueryBTCNetworkResponse, error) {
+	out := new(QueryBTCNetworkResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderDelegationCount(ctx context.Context, in *QueryFinalityProviderDelegationCountRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationCountResponse, error) {
+	out := new(QueryFinalityProviderDelegationCountResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegationCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationCountByParamsVersion(ctx context.Context, in *QueryDelegationCountByParamsVersionRequest, opts ...grpc.CallOption) (*QueryDelegationCountByParamsVersionResponse, error) {
+	out := new(QueryDelegationCountByParamsVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationCountByParamsVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationCovenantSigs(ctx context.Context, in *QueryBTCDelegationCovenantSigsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationCovenantSigsResponse, error) {
+	out := new(QueryBTCDelegationCovenantSigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationCovenantSigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsExpiringWithin(ctx context.Context, in *QueryDelegationsExpiringWithinRequest, opts ...grpc.CallOption) (*QueryDelegationsExpiringWithinResponse, error) {
+	out := new(QueryDelegationsExpiringWithinResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsExpiringWithin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsStakingTxRegistered(ctx context.Context, in *QueryIsStakingTxRegisteredRequest, opts ...grpc.CallOption) (*QueryIsStakingTxRegisteredResponse, error) {
+	out := new(QueryIsStakingTxRegisteredResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/IsStakingTxRegistered", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationUnbondingStatus(ctx context.Context, in *QueryBTCDelegationUnbondingStatusRequest, opts ...grpc.CallOption) (*QueryBTCDelegationUnbondingStatusResponse, error) {
+	out := new(QueryBTCDelegationUnbondingStatusResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationUnbondingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SelectiveSlashingEvidenceList(ctx context.Context, in *QuerySelectiveSlashingEvidenceListRequest, opts ...grpc.CallOption) (*QuerySelectiveSlashingEvidenceListResponse, error) {
+	out := new(QuerySelectiveSlashingEvidenceListResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/SelectiveSlashingEvidenceList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsByFpSet(ctx context.Context, in *QueryDelegationsByFpSetRequest, opts ...grpc.CallOption) (*QueryDelegationsByFpSetResponse, error) {
+	out := new(QueryDelegationsByFpSetResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsByFpSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationTransactions(ctx context.Context, in *QueryBTCDelegationTransactionsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationTransactionsResponse, error) {
+	out := new(QueryBTCDelegationTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationScriptPaths(ctx context.Context, in *QueryBTCDelegationScriptPathsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationScriptPathsResponse, error) {
+	out := new(QueryBTCDelegationScriptPathsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationScriptPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationsByFlags(ctx context.Context, in *QueryBTCDelegationsByFlagsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByFlagsResponse, error) {
+	out := new(QueryBTCDelegationsByFlagsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FindStakingOutputIndex(ctx context.Context, in *QueryFindStakingOutputIndexRequest, opts ...grpc.CallOption) (*QueryFindStakingOutputIndexResponse, error) {
+	out := new(QueryFindStakingOutputIndexResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FindStakingOutputIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantSigningHashes(ctx context.Context, in *QueryCovenantSigningHashesRequest, opts ...grpc.CallOption) (*QueryCovenantSigningHashesResponse, error) {
+	out := new(QueryCovenantSigningHashesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantSigningHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBTCDelegations(ctx context.Context, in *QueryAllBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryAllBTCDelegationsResponse, error) {
+	out := new(QueryAllBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/AllBTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantCommitteeChanges(ctx context.Context, in *QueryCovenantCommitteeChangesRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeChangesResponse, error) {
+	out := new(QueryCovenantCommitteeChangesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantCommitteeChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderRewardGauge(ctx context.Context, in *QueryFinalityProviderRewardGaugeRequest, opts ...grpc.CallOption) (*QueryFinalityProviderRewardGaugeResponse, error) {
+	out := new(QueryFinalityProviderRewardGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderRewardGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationActivationRate(ctx context.Context, in *QueryDelegationActivationRateRequest, opts ...grpc.CallOption) (*QueryDelegationActivationRateResponse, error) {
+	out := new(QueryDelegationActivationRateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationActivationRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakerDelegationAttestation(ctx context.Context, in *QueryStakerDelegationAttestationRequest, opts ...grpc.CallOption) (*QueryStakerDelegationAttestationResponse, error) {
+	out := new(QueryStakerDelegationAttestationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/StakerDelegationAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderRewardBreakdown(ctx context.Context, in *QueryFinalityProviderRewardBreakdownRequest, opts ...grpc.CallOption) (*QueryFinalityProviderRewardBreakdownResponse, error) {
+	out := new(QueryFinalityProviderRewardBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderRewardBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanReachCovenantQuorum(ctx context.Context, in *QueryCanReachCovenantQuorumRequest, opts ...grpc.CallOption) (*QueryCanReachCovenantQuorumResponse, error) {
+	out := new(QueryCanReachCovenantQuorumResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CanReachCovenantQuorum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCTip(ctx context.Context, in *QueryBTCTipRequest, opts ...grpc.CallOption) (*QueryBTCTipResponse, error) {
+	out := new(QueryBTCTipResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsWithUnbondingScheduleIssues(ctx context.Context, in *QueryDelegationsWithUnbondingScheduleIssuesRequest, opts ...grpc.CallOption) (*QueryDelegationsWithUnbondingScheduleIssuesResponse, error) {
+	out := new(QueryDelegationsWithUnbondingScheduleIssuesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsWithUnbondingScheduleIssues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderStats(ctx context.Context, in *QueryFinalityProviderStatsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderStatsResponse, error) {
+	out := new(QueryFinalityProviderStatsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationCovenantUnbondingSigs(ctx context.Context, in *QueryBTCDelegationCovenantUnbondingSigsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationCovenantUnbondingSigsResponse, error) {
+	out := new(QueryBTCDelegationCovenantUnbondingSigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationCovenantUnbondingSigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationSlashingRate(ctx context.Context, in *QueryBTCDelegationSlashingRateRequest, opts ...grpc.CallOption) (*QueryBTCDelegationSlashingRateResponse, error) {
+	out := new(QueryBTCDelegationSlashingRateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationSlashingRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsAffectedBySlashing(ctx context.Context, in *QueryDelegationsAffectedBySlashingRequest, opts ...grpc.CallOption) (*QueryDelegationsAffectedBySlashingResponse, error) {
+	out := new(QueryDelegationsAffectedBySlashingResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsAffectedBySlashing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RefundableBTCDelegations(ctx context.Context, in *QueryRefundableBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryRefundableBTCDelegationsResponse, error) {
+	out := new(QueryRefundableBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/RefundableBTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
+	// ParamsVersions queries the versions of the parameters of the module that
+	// are retained, i.e., not pruned yet.
+	ParamsVersions(context.Context, *QueryParamsVersionsRequest) (*QueryParamsVersionsResponse, error)
+	// CurrentParams queries the latest parameters of the module together with
+	// their version, their activation height, and the authority allowed to
+	// update them.
+	CurrentParams(context.Context, *QueryCurrentParamsRequest) (*QueryCurrentParamsResponse, error)
+	// UnbondingParams queries the exact unbonding fee and the minimum unbonding
+	// time expected from the unbonding transaction of a BTC delegation under
+	// the latest parameters.
+	UnbondingParams(context.Context, *QueryUnbondingParamsRequest) (*QueryUnbondingParamsResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
+	// DelegationsByParamsVersion queries all BTC delegations validated against
+	// the given params version
+	DelegationsByParamsVersion(context.Context, *QueryDelegationsByParamsVersionRequest) (*QueryDelegationsByParamsVersionResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// VerifyCovenantSlashingSig verifies the adaptor signatures of a covenant
+	// member on the slashing tx of a BTC delegation, without submitting them
+	VerifyCovenantSlashingSig(context.Context, *QueryVerifyCovenantSlashingSigRequest) (*QueryVerifyCovenantSlashingSigResponse, error)
+	// BTCDelegationSignatureReadiness queries whether a BTC delegation has a
+	// covenant quorum of adaptor signatures for each of its finality providers
+	BTCDelegationSignatureReadiness(context.Context, *QueryBTCDelegationSignatureReadinessRequest) (*QueryBTCDelegationSignatureReadinessResponse, error)
+	// NextPowerDistUpdateHeight queries the smallest BTC height, starting from
+	// the current BTC tip, at which a power distribution update is scheduled
+	NextPowerDistUpdateHeight(context.Context, *QueryNextPowerDistUpdateHeightRequest) (*QueryNextPowerDistUpdateHeightResponse, error)
+	// CommissionRateBounds queries the range of commission rates that a
+	// finality provider is allowed to charge
+	CommissionRateBounds(context.Context, *QueryCommissionRateBoundsRequest) (*QueryCommissionRateBoundsResponse, error)
+	// FinalityProviderCommissionAtDelegation queries the commission rate of
+	// each finality provider of a BTC delegation at the time the BTC
+	// delegation was created
+	FinalityProviderCommissionAtDelegation(context.Context, *QueryFinalityProviderCommissionAtDelegationRequest) (*QueryFinalityProviderCommissionAtDelegationResponse, error)
+	// BTCDelegationCovenantCoverage queries how much of the required covenant
+	// quorum of a BTC delegation has signed it
+	BTCDelegationCovenantCoverage(context.Context, *QueryBTCDelegationCovenantCoverageRequest) (*QueryBTCDelegationCovenantCoverageResponse, error)
+	// BTCDelegationsByValueRange queries all BTC delegations whose staking value
+	// falls in the given range, in ascending order of staking value
+	BTCDelegationsByValueRange(context.Context, *QueryBTCDelegationsByValueRangeRequest) (*QueryBTCDelegationsByValueRangeResponse, error)
+	// CovenantInfo queries the covenant committee and its quorum under the
+	// latest params
+	CovenantInfo(context.Context, *QueryCovenantInfoRequest) (*QueryCovenantInfoResponse, error)
+	// VerifyInclusionProofAt verifies the inclusion proof of a staking tx as of
+	// the BTC tip at a given historical height
+	VerifyInclusionProofAt(context.Context, *QueryVerifyInclusionProofAtRequest) (*QueryVerifyInclusionProofAtResponse, error)
+	// BTCDelegationFinalityProviderStatuses queries the status of each finality
+	// provider of a BTC delegation
+	BTCDelegationFinalityProviderStatuses(context.Context, *QueryBTCDelegationFinalityProviderStatusesRequest) (*QueryBTCDelegationFinalityProviderStatusesResponse, error)
+	// BTCNetwork queries the BTC network the chain is configured for
+	BTCNetwork(context.Context, *QueryBTCNetworkRequest) (*QueryBTCNetworkResponse, error)
+	// FinalityProviderDelegationCount queries the number of BTC delegations of
+	// the given finality provider under the given status
+	FinalityProviderDelegationCount(context.Context, *QueryFinalityProviderDelegationCountRequest) (*QueryFinalityProviderDelegationCountResponse, error)
+	// DelegationCountByParamsVersion queries the number of BTC delegations that
+	// are not unbonded yet under each params version
+	DelegationCountByParamsVersion(context.Context, *QueryDelegationCountByParamsVersionRequest) (*QueryDelegationCountByParamsVersionResponse, error)
+	// BTCDelegationCovenantSigs queries the covenant adaptor signatures on the
+	// slashing tx of a BTC delegation, one per (covenant PK, finality provider
+	// PK) pair
+	BTCDelegationCovenantSigs(context.Context, *QueryBTCDelegationCovenantSigsRequest) (*QueryBTCDelegationCovenantSigsResponse, error)
+	// DelegationsExpiringWithin queries active BTC delegations whose staking
+	// timelock expires within the given number of BTC blocks
+	DelegationsExpiringWithin(context.Context, *QueryDelegationsExpiringWithinRequest) (*QueryDelegationsExpiringWithinResponse, error)
+	// IsStakingTxRegistered queries whether a staking tx is already registered
+	// as a BTC delegation, in which case it cannot be reused
+	IsStakingTxRegistered(context.Context, *QueryIsStakingTxRegisteredRequest) (*QueryIsStakingTxRegisteredResponse, error)
+	// BTCDelegationUnbondingStatus queries the unbonding status of a BTC
+	// delegation, i.e., whether the delegator has unbonded it early and whether
+	// the covenant unbonding signatures have reached quorum
+	BTCDelegationUnbondingStatus(context.Context, *QueryBTCDelegationUnbondingStatusRequest) (*QueryBTCDelegationUnbondingStatusResponse, error)
+	// SelectiveSlashingEvidenceList queries the submitted selective slashing
+	// evidences, ordered by block height descending.
+	SelectiveSlashingEvidenceList(context.Context, *QuerySelectiveSlashingEvidenceListRequest) (*QuerySelectiveSlashingEvidenceListResponse, error)
+	// DelegationsByFpSet queries all BTC delegations restaking to exactly the
+	// given set of finality providers, in ascending order of staking tx hash.
+	DelegationsByFpSet(context.Context, *QueryDelegationsByFpSetRequest) (*QueryDelegationsByFpSetResponse, error)
+	// BTCDelegationTransactions queries the raw transactions of a BTC delegation,
+	// i.e., the staking, slashing, unbonding and unbonding slashing txs, along
+	// with the delegator signatures on the slashing txs
+	BTCDelegationTransactions(context.Context, *QueryBTCDelegationTransactionsRequest) (*QueryBTCDelegationTransactionsResponse, error)
+	// BTCDelegationScriptPaths queries the taproot script paths of the staking
+	// output of a BTC delegation, reconstructed under the params version the
+	// BTC delegation was validated against
+	BTCDelegationScriptPaths(context.Context, *QueryBTCDelegationScriptPathsRequest) (*QueryBTCDelegationScriptPathsResponse, error)
+	// BTCDelegationsByFlags queries BTC delegations filtered by whether they
+	// have an inclusion proof, a covenant quorum and a delegator unbonding
+	// signature
+	BTCDelegationsByFlags(context.Context, *QueryBTCDelegationsByFlagsRequest) (*QueryBTCDelegationsByFlagsResponse, error)
+	// FindStakingOutputIndex finds the index of the staking output Babylon
+	// would select in a staking tx under a given params version
+	FindStakingOutputIndex(context.Context, *QueryFindStakingOutputIndexRequest) (*QueryFindStakingOutputIndexResponse, error)
+	// CovenantSigningHashes queries the sighashes a covenant member signs for
+	// a BTC delegation, i.e., the slashing tx and unbonding slashing tx
+	// sighashes for each finality provider and the unbonding tx sighash
+	CovenantSigningHashes(context.Context, *QueryCovenantSigningHashesRequest) (*QueryCovenantSigningHashesResponse, error)
+	// AllBTCDelegations queries all BTC delegations in the order of their
+	// staking tx hashes, optionally filtered by their statuses
+	AllBTCDelegations(context.Context, *QueryAllBTCDelegationsRequest) (*QueryAllBTCDelegationsResponse, error)
+	// CovenantCommitteeChanges queries the covenant committee at genesis, i.e.,
+	// under params version 0, against the one under the latest params
+	CovenantCommitteeChanges(context.Context, *QueryCovenantCommitteeChangesRequest) (*QueryCovenantCommitteeChangesResponse, error)
+	// FinalityProviderRewardGauge queries the reward gauge of a finality
+	// provider by its BTC PK
+	FinalityProviderRewardGauge(context.Context, *QueryFinalityProviderRewardGaugeRequest) (*QueryFinalityProviderRewardGaugeResponse, error)
+	// DelegationActivationRate queries the number of BTC delegations activated
+	// in each bucket of BTC heights within the given range
+	DelegationActivationRate(context.Context, *QueryDelegationActivationRateRequest) (*QueryDelegationActivationRateResponse, error)
+	// StakerDelegationAttestation queries all BTC delegations of a staker
+	// together with a reproducible digest of the set
+	StakerDelegationAttestation(context.Context, *QueryStakerDelegationAttestationRequest) (*QueryStakerDelegationAttestationResponse, error)
+	// FinalityProviderRewardBreakdown queries the rewards of a finality
+	// provider split into its commission and the rewards of its
+	// self-delegations
+	FinalityProviderRewardBreakdown(context.Context, *QueryFinalityProviderRewardBreakdownRequest) (*QueryFinalityProviderRewardBreakdownResponse, error)
+	// CanReachCovenantQuorum queries whether a BTC delegation can still reach
+	// the covenant quorum of the params it was validated against
+	CanReachCovenantQuorum(context.Context, *QueryCanReachCovenantQuorumRequest) (*QueryCanReachCovenantQuorumResponse, error)
+	// BTCTip queries the tip of the BTC light client that the module uses for
+	// computing the status of BTC delegations
+	BTCTip(context.Context, *QueryBTCTipRequest) (*QueryBTCTipResponse, error)
+	// DelegationsWithUnbondingScheduleIssues queries the BTC delegations whose
+	// end height does not exceed their minimum unbonding time, such that the
+	// BTC height at which they are unbonded underflows
+	DelegationsWithUnbondingScheduleIssues(context.Context, *QueryDelegationsWithUnbondingScheduleIssuesRequest) (*QueryDelegationsWithUnbondingScheduleIssuesResponse, error)
+	// FinalityProviderStats queries the number of finality providers in total
+	// and under each status
+	FinalityProviderStats(context.Context, *QueryFinalityProviderStatsRequest) (*QueryFinalityProviderStatsResponse, error)
+	// BTCDelegationCovenantUnbondingSigs queries the covenant Schnorr
+	// signatures on the unbonding tx of a BTC delegation, one per covenant PK
+	BTCDelegationCovenantUnbondingSigs(context.Context, *QueryBTCDelegationCovenantUnbondingSigsRequest) (*QueryBTCDelegationCovenantUnbondingSigsResponse, error)
+	// BTCDelegationSlashingRate queries the slashing rate and the slashing
+	// destinations applying to a BTC delegation, as given by the params version
+	// it was created under
+	BTCDelegationSlashingRate(context.Context, *QueryBTCDelegationSlashingRateRequest) (*QueryBTCDelegationSlashingRateResponse, error)
+	// DelegationsAffectedBySlashing queries the BTC delegations that became
+	// active under a finality provider that was slashed afterwards
+	DelegationsAffectedBySlashing(context.Context, *QueryDelegationsAffectedBySlashingRequest) (*QueryDelegationsAffectedBySlashingResponse, error)
+	// RefundableBTCDelegations queries the BTC delegations of a staker that
+	// did not receive a covenant quorum before their deadline, and can thus be
+	// claimed for a refund of a MsgCreateBTCDelegation
+	RefundableBTCDelegations(context.Context, *QueryRefundableBTCDelegationsRequest) (*QueryRefundableBTCDelegationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+}
+func (*UnimplementedQueryServer) ParamsVersions(ctx context.Context, req *QueryParamsVersionsRequest) (*QueryParamsVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsVersions not implemented")
+}
+func (*UnimplementedQueryServer) CurrentParams(ctx context.Context, req *QueryCurrentParamsRequest) (*QueryCurrentParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentParams not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingParams(ctx context.Context, req *QueryUnbondingParamsRequest) (*QueryUnbondingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingParams not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsByParamsVersion(ctx context.Context, req *QueryDelegationsByParamsVersionRequest) (*QueryDelegationsByParamsVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsByParamsVersion not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) VerifyCovenantSlashingSig(ctx context.Context, req *QueryVerifyCovenantSlashingSigRequest) (*QueryVerifyCovenantSlashingSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCovenantSlashingSig not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationSignatureReadiness(ctx context.Context, req *QueryBTCDelegationSignatureReadinessRequest) (*QueryBTCDelegationSignatureReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationSignatureReadiness not implemented")
+}
+func (*UnimplementedQueryServer) NextPowerDistUpdateHeight(ctx context.Context, req *QueryNextPowerDistUpdateHeightRequest) (*QueryNextPowerDistUpdateHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPowerDistUpdateHeight not implemented")
+}
+func (*UnimplementedQueryServer) CommissionRateBounds(ctx context.Context, req *QueryCommissionRateBoundsRequest) (*QueryCommissionRateBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionRateBounds not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderCommissionAtDelegation(ctx context.Context, req *QueryFinalityProviderCommissionAtDelegationRequest) (*QueryFinalityProviderCommissionAtDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCommissionAtDelegation not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationCovenantCoverage(ctx context.Context, req *QueryBTCDelegationCovenantCoverageRequest) (*QueryBTCDelegationCovenantCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationCovenantCoverage not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByValueRange(ctx context.Context, req *QueryBTCDelegationsByValueRangeRequest) (*QueryBTCDelegationsByValueRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByValueRange not implemented")
+}
+func (*UnimplementedQueryServer) CovenantInfo(ctx context.Context, req *QueryCovenantInfoRequest) (*QueryCovenantInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantInfo not implemented")
+}
+func (*UnimplementedQueryServer) VerifyInclusionProofAt(ctx context.Context, req *QueryVerifyInclusionProofAtRequest) (*QueryVerifyInclusionProofAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyInclusionProofAt not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationFinalityProviderStatuses(ctx context.Context, req *QueryBTCDelegationFinalityProviderStatusesRequest) (*QueryBTCDelegationFinalityProviderStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationFinalityProviderStatuses not implemented")
+}
+func (*UnimplementedQueryServer) BTCNetwork(ctx context.Context, req *QueryBTCNetworkRequest) (*QueryBTCNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCNetwork not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegationCount(ctx context.Context, req *QueryFinalityProviderDelegationCountRequest) (*QueryFinalityProviderDelegationCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegationCount not implemented")
+}
+func (*UnimplementedQueryServer) DelegationCountByParamsVersion(ctx context.Context, req *QueryDelegationCountByParamsVersionRequest) (*QueryDelegationCountByParamsVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationCountByParamsVersion not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationCovenantSigs(ctx context.Context, req *QueryBTCDelegationCovenantSigsRequest) (*QueryBTCDelegationCovenantSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationCovenantSigs not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsExpiringWithin(ctx context.Context, req *QueryDelegationsExpiringWithinRequest) (*QueryDelegationsExpiringWithinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsExpiringWithin not implemented")
+}
+func (*UnimplementedQueryServer) IsStakingTxRegistered(ctx context.Context, req *QueryIsStakingTxRegisteredRequest) (*QueryIsStakingTxRegisteredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsStakingTxRegistered not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationUnbondingStatus(ctx context.Context, req *QueryBTCDelegationUnbondingStatusRequest) (*QueryBTCDelegationUnbondingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationUnbondingStatus not implemented")
+}
+func (*UnimplementedQueryServer) SelectiveSlashingEvidenceList(ctx context.Context, req *QuerySelectiveSlashingEvidenceListRequest) (*QuerySelectiveSlashingEvidenceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidenceList not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsByFpSet(ctx context.Context, req *QueryDelegationsByFpSetRequest) (*QueryDelegationsByFpSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsByFpSet not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationTransactions(ctx context.Context, req *QueryBTCDelegationTransactionsRequest) (*QueryBTCDelegationTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationTransactions not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationScriptPaths(ctx context.Context, req *QueryBTCDelegationScriptPathsRequest) (*QueryBTCDelegationScriptPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationScriptPaths not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByFlags(ctx context.Context, req *QueryBTCDelegationsByFlagsRequest) (*QueryBTCDelegationsByFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByFlags not implemented")
+}
+func (*UnimplementedQueryServer) FindStakingOutputIndex(ctx context.Context, req *QueryFindStakingOutputIndexRequest) (*QueryFindStakingOutputIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindStakingOutputIndex not implemented")
+}
+func (*UnimplementedQueryServer) CovenantSigningHashes(ctx context.Context, req *QueryCovenantSigningHashesRequest) (*QueryCovenantSigningHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantSigningHashes not implemented")
+}
+func (*UnimplementedQueryServer) AllBTCDelegations(ctx context.Context, req *QueryAllBTCDelegationsRequest) (*QueryAllBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) CovenantCommitteeChanges(ctx context.Context, req *QueryCovenantCommitteeChangesRequest) (*QueryCovenantCommitteeChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantCommitteeChanges not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderRewardGauge(ctx context.Context, req *QueryFinalityProviderRewardGaugeRequest) (*QueryFinalityProviderRewardGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderRewardGauge not implemented")
+}
+func (*UnimplementedQueryServer) DelegationActivationRate(ctx context.Context, req *QueryDelegationActivationRateRequest) (*QueryDelegationActivationRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationActivationRate not implemented")
+}
+func (*UnimplementedQueryServer) StakerDelegationAttestation(ctx context.Context, req *QueryStakerDelegationAttestationRequest) (*QueryStakerDelegationAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakerDelegationAttestation not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderRewardBreakdown(ctx context.Context, req *QueryFinalityProviderRewardBreakdownRequest) (*QueryFinalityProviderRewardBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderRewardBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) CanReachCovenantQuorum(ctx context.Context, req *QueryCanReachCovenantQuorumRequest) (*QueryCanReachCovenantQuorumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanReachCovenantQuorum not implemented")
+}
+func (*UnimplementedQueryServer) BTCTip(ctx context.Context, req *QueryBTCTipRequest) (*QueryBTCTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCTip not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsWithUnbondingScheduleIssues(ctx context.Context, req *QueryDelegationsWithUnbondingScheduleIssuesRequest) (*QueryDelegationsWithUnbondingScheduleIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsWithUnbondingScheduleIssues not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderStats(ctx context.Context, req *QueryFinalityProviderStatsRequest) (*QueryFinalityProviderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderStats not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationCovenantUnbondingSigs(ctx context.Context, req *QueryBTCDelegationCovenantUnbondingSigsRequest) (*QueryBTCDelegationCovenantUnbondingSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationCovenantUnbondingSigs not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationSlashingRate(ctx context.Context, req *QueryBTCDelegationSlashingRateRequest) (*QueryBTCDelegationSlashingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationSlashingRate not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsAffectedBySlashing(ctx context.Context, req *QueryDelegationsAffectedBySlashingRequest) (*QueryDelegationsAffectedBySlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsAffectedBySlashing not implemented")
+}
+func (*UnimplementedQueryServer) RefundableBTCDelegations(ctx context.Context, req *QueryRefundableBTCDelegationsRequest) (*QueryRefundableBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundableBTCDelegations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsVersions(ctx, req.(*QueryParamsVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CurrentParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentParams(ctx, req.(*QueryCurrentParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/UnbondingParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingParams(ctx, req.(*QueryUnbondingParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsByParamsVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsByParamsVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsByParamsVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsByParamsVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsByParamsVersion(ctx, req.(*QueryDelegationsByParamsVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyCovenantSlashingSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCovenantSlashingSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCovenantSlashingSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/VerifyCovenantSlashingSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCovenantSlashingSig(ctx, req.(*QueryVerifyCovenantSlashingSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationSignatureReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationSignatureReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationSignatureReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationSignatureReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationSignatureReadiness(ctx, req.(*QueryBTCDelegationSignatureReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextPowerDistUpdateHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextPowerDistUpdateHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextPowerDistUpdateHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/NextPowerDistUpdateHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextPowerDistUpdateHeight(ctx, req.(*QueryNextPowerDistUpdateHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommissionRateBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionRateBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionRateBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CommissionRateBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionRateBounds(ctx, req.(*QueryCommissionRateBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderCommissionAtDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderCommissionAtDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderCommissionAtDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCommissionAtDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderCommissionAtDelegation(ctx, req.(*QueryFinalityProviderCommissionAtDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationCovenantCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationCovenantCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationCovenantCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationCovenantCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationCovenantCoverage(ctx, req.(*QueryBTCDelegationCovenantCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationsByValueRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByValueRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByValueRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByValueRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByValueRange(ctx, req.(*QueryBTCDelegationsByValueRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantInfo(ctx, req.(*QueryCovenantInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyInclusionProofAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyInclusionProofAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyInclusionProofAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/VerifyInclusionProofAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyInclusionProofAt(ctx, req.(*QueryVerifyInclusionProofAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationFinalityProviderStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationFinalityProviderStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationFinalityProviderStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationFinalityProviderStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationFinalityProviderStatuses(ctx, req.(*QueryBTCDelegationFinalityProviderStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCNetwork(ctx, req.(*QueryBTCNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderDelegationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegationCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegationCount(ctx, req.(*QueryFinalityProviderDelegationCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationCountByParamsVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationCountByParamsVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationCountByParamsVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationCountByParamsVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationCountByParamsVersion(ctx, req.(*QueryDelegationCountByParamsVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationCovenantSigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationCovenantSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationCovenantSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationCovenantSigs(ctx, req.(*QueryBTCDelegationCovenantSigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsExpiringWithin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsExpiringWithinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsExpiringWithin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsExpiringWithin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsExpiringWithin(ctx, req.(*QueryDelegationsExpiringWithinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsStakingTxRegistered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsStakingTxRegisteredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsStakingTxRegistered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/IsStakingTxRegistered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsStakingTxRegistered(ctx, req.(*QueryIsStakingTxRegisteredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationUnbondingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationUnbondingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationUnbondingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationUnbondingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationUnbondingStatus(ctx, req.(*QueryBTCDelegationUnbondingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SelectiveSlashingEvidenceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySelectiveSlashingEvidenceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SelectiveSlashingEvidenceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/SelectiveSlashingEvidenceList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SelectiveSlashingEvidenceList(ctx, req.(*QuerySelectiveSlashingEvidenceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsByFpSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsByFpSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsByFpSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsByFpSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsByFpSet(ctx, req.(*QueryDelegationsByFpSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationTransactions(ctx, req.(*QueryBTCDelegationTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationScriptPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationScriptPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationScriptPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationScriptPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationScriptPaths(ctx, req.(*QueryBTCDelegationScriptPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationsByFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByFlags(ctx, req.(*QueryBTCDelegationsByFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FindStakingOutputIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindStakingOutputIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindStakingOutputIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FindStakingOutputIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindStakingOutputIndex(ctx, req.(*QueryFindStakingOutputIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantSigningHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantSigningHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantSigningHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantSigningHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantSigningHashes(ctx, req.(*QueryCovenantSigningHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBTCDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/AllBTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBTCDelegations(ctx, req.(*QueryAllBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantCommitteeChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantCommitteeChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantCommitteeChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantCommitteeChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantCommitteeChanges(ctx, req.(*QueryCovenantCommitteeChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderRewardGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRewardGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderRewardGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderRewardGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderRewardGauge(ctx, req.(*QueryFinalityProviderRewardGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationActivationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationActivationRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationActivationRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationActivationRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationActivationRate(ctx, req.(*QueryDelegationActivationRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakerDelegationAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerDelegationAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakerDelegationAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/StakerDelegationAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakerDelegationAttestation(ctx, req.(*QueryStakerDelegationAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderRewardBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRewardBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderRewardBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderRewardBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderRewardBreakdown(ctx, req.(*QueryFinalityProviderRewardBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanReachCovenantQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanReachCovenantQuorumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanReachCovenantQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CanReachCovenantQuorum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanReachCovenantQuorum(ctx, req.(*QueryCanReachCovenantQuorumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCTip(ctx, req.(*QueryBTCTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsWithUnbondingScheduleIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsWithUnbondingScheduleIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsWithUnbondingScheduleIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsWithUnbondingScheduleIssues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsWithUnbondingScheduleIssues(ctx, req.(*QueryDelegationsWithUnbondingScheduleIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderStats(ctx, req.(*QueryFinalityProviderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationCovenantUnbondingSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationCovenantUnbondingSigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationCovenantUnbondingSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationCovenantUnbondingSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationCovenantUnbondingSigs(ctx, req.(*QueryBTCDelegationCovenantUnbondingSigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationSlashingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationSlashingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationSlashingRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationSlashingRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationSlashingRate(ctx, req.(*QueryBTCDelegationSlashingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsAffectedBySlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsAffectedBySlashingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsAffectedBySlashing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsAffectedBySlashing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsAffectedBySlashing(ctx, req.(*QueryDelegationsAffectedBySlashingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RefundableBTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefundableBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefundableBTCDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/RefundableBTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefundableBTCDelegations(ctx, req.(*QueryRefundableBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "ParamsVersions",
+			Handler:    _Query_ParamsVersions_Handler,
+		},
+		{
+			MethodName: "CurrentParams",
+			Handler:    _Query_CurrentParams_Handler,
+		},
+		{
+			MethodName: "UnbondingParams",
+			Handler:    _Query_UnbondingParams_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
+		{
+			MethodName: "DelegationsByParamsVersion",
+			Handler:    _Query_DelegationsByParamsVersion_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegations",
+			Handler:    _Query_FinalityProviderDelegations_Handler,
+		},
+		{
+			MethodName: "BTCDelegation",
+			Handler:    _Query_BTCDelegation_Handler,
+		},
+		{
+			MethodName: "VerifyCovenantSlashingSig",
+			Handler:    _Query_VerifyCovenantSlashingSig_Handler,
+		},
+		{
+			MethodName: "BTCDelegationSignatureReadiness",
+			Handler:    _Query_BTCDelegationSignatureReadiness_Handler,
+		},
+		{
+			MethodName: "NextPowerDistUpdateHeight",
+			Handler:    _Query_NextPowerDistUpdateHeight_Handler,
+		},
+		{
+			MethodName: "CommissionRateBounds",
+			Handler:    _Query_CommissionRateBounds_Handler,
+		},
+		{
+			MethodName: "FinalityProviderCommissionAtDelegation",
+			Handler:    _Query_FinalityProviderCommissionAtDelegation_Handler,
+		},
+		{
+			MethodName: "BTCDelegationCovenantCoverage",
+			Handler:    _Query_BTCDelegationCovenantCoverage_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByValueRange",
+			Handler:    _Query_BTCDelegationsByValueRange_Handler,
+		},
+		{
+			MethodName: "CovenantInfo",
+			Handler:    _Query_CovenantInfo_Handler,
+		},
+		{
+			MethodName: "VerifyInclusionProofAt",
+			Handler:    _Query_VerifyInclusionProofAt_Handler,
+		},
+		{
+			MethodName: "BTCDelegationFinalityProviderStatuses",
+			Handler:    _Query_BTCDelegationFinalityProviderStatuses_Handler,
+		},
+		{
+			MethodName: "BTCNetwork",
+			Handler:    _Query_BTCNetwork_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegationCount",
+			Handler:    _Query_FinalityProviderDelegationCount_Handler,
+		},
+		{
+			MethodName: "DelegationCountByParamsVersion",
+			Handler:    _Query_DelegationCountByParamsVersion_Handler,
+		},
+		{
+			MethodName: "BTCDelegationCovenantSigs",
+			Handler:    _Query_BTCDelegationCovenantSigs_Handler,
+		},
+		{
+			MethodName: "DelegationsExpiringWithin",
+			Handler:    _Query_DelegationsExpiringWithin_Handler,
+		},
+		{
+			MethodName: "IsStakingTxRegistered",
+			Handler:    _Query_IsStakingTxRegistered_Handler,
+		},
+		{
+			MethodName: "BTCDelegationUnbondingStatus",
+			Handler:    _Query_BTCDelegationUnbondingStatus_Handler,
+		},
+		{
+			MethodName: "SelectiveSlashingEvidenceList",
+			Handler:    _Query_SelectiveSlashingEvidenceList_Handler,
+		},
+		{
+			MethodName: "DelegationsByFpSet",
+			Handler:    _Query_DelegationsByFpSet_Handler,
+		},
+		{
+			MethodName: "BTCDelegationTransactions",
+			Handler:    _Query_BTCDelegationTransactions_Handler,
+		},
+		{
+			MethodName: "BTCDelegationScriptPaths",
+			Handler:    _Query_BTCDelegationScriptPaths_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByFlags",
+			Handler:    _Query_BTCDelegationsByFlags_Handler,
+		},
+		{
+			MethodName: "FindStakingOutputIndex",
+			Handler:    _Query_FindStakingOutputIndex_Handler,
+		},
+		{
+			MethodName: "CovenantSigningHashes",
+			Handler:    _Query_CovenantSigningHashes_Handler,
+		},
+		{
+			MethodName: "AllBTCDelegations",
+			Handler:    _Query_AllBTCDelegations_Handler,
+		},
+		{
+			MethodName: "CovenantCommitteeChanges",
+			Handler:    _Query_CovenantCommitteeChanges_Handler,
+		},
+		{
+			MethodName: "FinalityProviderRewardGauge",
+			Handler:    _Query_FinalityProviderRewardGauge_Handler,
+		},
+		{
+			MethodName: "DelegationActivationRate",
+			Handler:    _Query_DelegationActivationRate_Handler,
+		},
+		{
+			MethodName: "StakerDelegationAttestation",
+			Handler:    _Query_StakerDelegationAttestation_Handler,
+		},
+		{
+			MethodName: "FinalityProviderRewardBreakdown",
+			Handler:    _Query_FinalityProviderRewardBreakdown_Handler,
+		},
+		{
+			MethodName: "CanReachCovenantQuorum",
+			Handler:    _Query_CanReachCovenantQuorum_Handler,
+		},
+		{
+			MethodName: "BTCTip",
+			Handler:    _Query_BTCTip_Handler,
+		},
+		{
+			MethodName: "DelegationsWithUnbondingScheduleIssues",
+			Handler:    _Query_DelegationsWithUnbondingScheduleIssues_Handler,
+		},
+		{
+			MethodName: "FinalityProviderStats",
+			Handler:    _Query_FinalityProviderStats_Handler,
+		},
+		{
+			MethodName: "BTCDelegationCovenantUnbondingSigs",
+			Handler:    _Query_BTCDelegationCovenantUnbondingSigs_Handler,
+		},
+		{
+			MethodName: "BTCDelegationSlashingRate",
+			Handler:    _Query_BTCDelegationSlashingRate_Handler,
+		},
+		{
+			MethodName: "DelegationsAffectedBySlashing",
+			Handler:    _Query_DelegationsAffectedBySlashing_Handler,
+		},
+		{
+			MethodName: "RefundableBTCDelegations",
+			Handler:    _Query_RefundableBTCDelegations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		dAtA4 := make([]byte, len(m.Versions)*10)
+		var j3 int
+		for _, num := range m.Versions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakingValueSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingValueSat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnbondingOutputValueSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingOutputValueSat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinUnbondingTimeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinUnbondingTimeBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnbondingFeeSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingFeeSat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		{
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsByParamsVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsByParamsVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsByParamsVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsByParamsVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsByParamsVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsByParamsVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCovenantSlashingSigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCovenantSlashingSigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCovenantSlashingSigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CovenantPkHex) > 0 {
+		i -= len(m.CovenantPkHex)
+		copy(dAtA[i:], m.CovenantPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCovenantSlashingSigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCovenantSlashingSigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCovenantSlashingSigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantSlashingSigVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantSlashingSigVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantSlashingSigVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationSignatureReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationSignatureReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationSignatureReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationSignatureReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationSignatureReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationSignatureReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasInclusionProof {
+		i--
+		if m.HasInclusionProof {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActivationReady {
+		i--
+		if m.ActivationReady {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnbondingQuorum {
+		i--
+		if m.UnbondingQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpReadiness) > 0 {
+		for iNdEx := len(m.FpReadiness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpReadiness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FpSignatureReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FpSignatureReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FpSignatureReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingSlashingQuorum {
+		i--
+		if m.UnbondingSlashingQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StakingSlashingQuorum {
+		i--
+		if m.StakingSlashingQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextPowerDistUpdateHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextPowerDistUpdateHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextPowerDistUpdateHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextPowerDistUpdateHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextPowerDistUpdateHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextPowerDistUpdateHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionRateBoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionRateBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionRateBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionRateBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionRateBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionRateBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorUnbondingInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorUnbondingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorUnbondingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendStakeTxHex) > 0 {
+		i -= len(m.SpendStakeTxHex)
+		copy(dAtA[i:], m.SpendStakeTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpendStakeTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorUnbondingInfoResponse != nil {
+		{
+			size, err := m.DelegatorUnbondingInfoResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashingReason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashingReason))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpCommissions) > 0 {
+		for iNdEx := len(m.FpCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FpCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FpCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FpCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationCovenantCoverageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationCovenantCoverageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationCovenantCoverageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationCovenantCoverageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationCovenantCoverageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationCovenantCoverageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoverageRatio.Size()
+		i -= size
+		if _, err := m.CoverageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RequiredQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredQuorum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByValueRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByValueRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByValueRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinSat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByValueRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByValueRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByValueRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ThresholdPercentage.Size()
+		i -= size
+		if _, err := m.ThresholdPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CommitteeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitteeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CovenantPksHex) > 0 {
+		for iNdEx := len(m.CovenantPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CovenantPksHex[iNdEx])
+			copy(dAtA[i:], m.CovenantPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakingTxInclusionProof != nil {
+		{
+			size, err := m.StakingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyInclusionProofAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyInclusionProofAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyInclusionProofAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InclusionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InclusionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationFinalityProviderStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationFinalityProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationFinalityProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasActiveDelegations {
+		i--
+		if m.HasActiveDelegations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCNetworkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCNetworkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCNetworkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCNetworkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCNetworkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCNetworkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Network) > 0 {
+		i -= len(m.Network)
+		copy(dAtA[i:], m.Network)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Network)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationCountByParamsVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationCountByParamsVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationCountByParamsVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsVersionDelegationCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsVersionDelegationCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsVersionDelegationCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationCountByParamsVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationCountByParamsVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationCountByParamsVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationCovenantSigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationCovenantSigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationCovenantSigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantSlashingSigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantSlashingSigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantSlashingSigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdaptorSigHex) > 0 {
+		i -= len(m.AdaptorSigHex)
+		copy(dAtA[i:], m.AdaptorSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdaptorSigHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FpIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FpIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CovPkHex) > 0 {
+		i -= len(m.CovPkHex)
+		copy(dAtA[i:], m.CovPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsExpiringWithinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsExpiringWithinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsExpiringWithinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsExpiringWithinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsExpiringWithinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsExpiringWithinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BtcTipHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcTipHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsStakingTxRegisteredRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsStakingTxRegisteredRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsStakingTxRegisteredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsStakingTxRegisteredResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsStakingTxRegisteredResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsStakingTxRegisteredResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationUnbondingStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationUnbondingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationUnbondingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CovenantUnbondingQuorum {
+		i--
+		if m.CovenantUnbondingQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumCovenantUnbondingSigs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumCovenantUnbondingSigs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnbondingBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DelegatorUnbonded {
+		i--
+		if m.DelegatorUnbonded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelectiveSlashingEvidenceListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelectiveSlashingEvidenceListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelectiveSlashingEvidenceListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsByFpSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsByFpSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsByFpSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHexList) > 0 {
+		for iNdEx := len(m.FpBtcPkHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkHexList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkHexList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHexList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsByFpSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsByFpSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsByFpSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorUnbondingSlashingSigHex) > 0 {
+		i -= len(m.DelegatorUnbondingSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorUnbondingSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSlashingSigHex)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UnbondingSlashingTxHex) > 0 {
+		i -= len(m.UnbondingSlashingTxHex)
+		copy(dAtA[i:], m.UnbondingSlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingSlashingTxHex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationScriptPathsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationScriptPathsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationScriptPathsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashingPath != nil {
+		{
+			size, err := m.SlashingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UnbondingPath != nil {
+		{
+			size, err := m.UnbondingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TimelockPath != nil {
+		{
+			size, err := m.TimelockPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingOutputPkScriptHex) > 0 {
+		i -= len(m.StakingOutputPkScriptHex)
+		copy(dAtA[i:], m.StakingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MerkleRootHex) > 0 {
+		i -= len(m.MerkleRootHex)
+		copy(dAtA[i:], m.MerkleRootHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MerkleRootHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InternalKeyHex) > 0 {
+		i -= len(m.InternalKeyHex)
+		copy(dAtA[i:], m.InternalKeyHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InternalKeyHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScriptPath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScriptPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScriptPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControlBlockHex) > 0 {
+		i -= len(m.ControlBlockHex)
+		copy(dAtA[i:], m.ControlBlockHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControlBlockHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeafHashHex) > 0 {
+		i -= len(m.LeafHashHex)
+		copy(dAtA[i:], m.LeafHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeafHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptHex) > 0 {
+		i -= len(m.ScriptHex)
+		copy(dAtA[i:], m.ScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScriptHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HasUnbondingSig != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HasUnbondingSig))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HasQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasProof != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HasProof))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindStakingOutputIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindStakingOutputIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindStakingOutputIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakingValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FpBtcPkHexList) > 0 {
+		for iNdEx := len(m.FpBtcPkHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkHexList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkHexList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHexList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StakerBtcPkHex) > 0 {
+		i -= len(m.StakerBtcPkHex)
+		copy(dAtA[i:], m.StakerBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerBtcPkHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindStakingOutputIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindStakingOutputIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindStakingOutputIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingOutputPkScriptHex) > 0 {
+		i -= len(m.StakingOutputPkScriptHex)
+		copy(dAtA[i:], m.StakingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSigningHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSigningHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSigningHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSigningHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSigningHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSigningHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingTxSigHashHex) > 0 {
+		i -= len(m.UnbondingTxSigHashHex)
+		copy(dAtA[i:], m.UnbondingTxSigHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxSigHashHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SlashingSigHashes) > 0 {
+		for iNdEx := len(m.SlashingSigHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingSigHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FpCovenantSigningHashes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FpCovenantSigningHashes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FpCovenantSigningHashes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingUnbondingTxSigHashHex) > 0 {
+		i -= len(m.SlashingUnbondingTxSigHashHex)
+		copy(dAtA[i:], m.SlashingUnbondingTxSigHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingUnbondingTxSigHashHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SlashingTxSigHashHex) > 0 {
+		i -= len(m.SlashingTxSigHashHex)
+		copy(dAtA[i:], m.SlashingTxSigHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxSigHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StatusFilter) > 0 {
+		dAtA38 := make([]byte, len(m.StatusFilter)*10)
+		var j37 int
+		for _, num := range m.StatusFilter {
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintQuery(dAtA, i, uint64(j37))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantCommitteeChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteeChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteeChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedPksHex) > 0 {
+		for iNdEx := len(m.RemovedPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedPksHex[iNdEx])
+			copy(dAtA[i:], m.RemovedPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RemovedPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddedPksHex) > 0 {
+		for iNdEx := len(m.AddedPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedPksHex[iNdEx])
+			copy(dAtA[i:], m.AddedPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AddedPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CurrentCovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentCovenantQuorum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CurrentCovenantPksHex) > 0 {
+		for iNdEx := len(m.CurrentCovenantPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentCovenantPksHex[iNdEx])
+			copy(dAtA[i:], m.CurrentCovenantPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentCovenantPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CurrentParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentParamsVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GenesisCovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GenesisCovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GenesisCovenantPksHex) > 0 {
+		for iNdEx := len(m.GenesisCovenantPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GenesisCovenantPksHex[iNdEx])
+			copy(dAtA[i:], m.GenesisCovenantPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisCovenantPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRewardGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRewardGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRewardGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawableCoins) > 0 {
+		for iNdEx := len(m.WithdrawableCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawableCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FpAddr) > 0 {
+		i -= len(m.FpAddr)
+		copy(dAtA[i:], m.FpAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationActivationRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationActivationRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationActivationRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BucketSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromBtcHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivationRateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivationRateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivationRateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartBtcHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationActivationRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationActivationRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationActivationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerDelegationAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerDelegationAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerDelegationAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerDelegationAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerDelegationAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerDelegationAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DigestHex) > 0 {
+		i -= len(m.DigestHex)
+		copy(dAtA[i:], m.DigestHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DigestHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRewardBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRewardBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRewardBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SelfDelegationCoins) > 0 {
+		for iNdEx := len(m.SelfDelegationCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelfDelegationCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WithdrawableCommissionCoins) > 0 {
+		for iNdEx := len(m.WithdrawableCommissionCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawableCommissionCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CommissionCoins) > 0 {
+		for iNdEx := len(m.CommissionCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FpAddr) > 0 {
+		i -= len(m.FpAddr)
+		copy(dAtA[i:], m.FpAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanReachCovenantQuorumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanReachCovenantQuorumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanReachCovenantQuorumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnavailableSignersPksHex) > 0 {
+		for iNdEx := len(m.UnavailableSignersPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnavailableSignersPksHex[iNdEx])
+			copy(dAtA[i:], m.UnavailableSignersPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.UnavailableSignersPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MissingSignersPksHex) > 0 {
+		for iNdEx := len(m.MissingSignersPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingSignersPksHex[iNdEx])
+			copy(dAtA[i:], m.MissingSignersPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MissingSignersPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CanReachQuorum {
+		i--
+		if m.CanReachQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HashHex) > 0 {
+		i -= len(m.HashHex)
+		copy(dAtA[i:], m.HashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingScheduleIssue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingScheduleIssue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingScheduleIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinUnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinUnbondingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issues) > 0 {
+		for iNdEx := len(m.Issues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slashed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Slashed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Jailed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Jailed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Dormant != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Dormant))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Active != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Active))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantUnbondingSigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantUnbondingSigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantUnbondingSigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigHex) > 0 {
+		i -= len(m.SigHex)
+		copy(dAtA[i:], m.SigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovPkHex) > 0 {
+		i -= len(m.CovPkHex)
+		copy(dAtA[i:], m.CovPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantUnbondingSigs) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationSlashingRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationSlashingRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationSlashingRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingDestinations) > 0 {
+		for iNdEx := len(m.SlashingDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SlashingPkScriptHex) > 0 {
+		i -= len(m.SlashingPkScriptHex)
+		copy(dAtA[i:], m.SlashingPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingPkScriptHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.SlashingRate.Size()
+		i -= size
+		if _, err := m.SlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsAffectedBySlashingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsAffectedBySlashingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsAffectedBySlashingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AffectedBTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AffectedBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AffectedBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefundableBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefundableBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefundableBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefundableBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefundableBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefundableBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHexList) > 0 {
+		for iNdEx := len(m.StakingTxHashHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashHexList[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashHexList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHexList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		l = 0
+		for _, e := range m.Versions {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryCurrentParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ActivationHeight))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingValueSat != 0 {
+		n += 1 + sovQuery(uint64(m.StakingValueSat))
+	}
+	return n
+}
+
+func (m *QueryUnbondingParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingFeeSat != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingFeeSat))
+	}
+	if m.MinUnbondingTimeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MinUnbondingTimeBlocks))
+	}
+	if m.UnbondingOutputValueSat != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingOutputValueSat))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByParamsVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByParamsVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCovenantSlashingSigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CovenantPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for _, b := range m.SlashingTxSigs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifyCovenantSlashingSigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantSlashingSigVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationSignatureReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationSignatureReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if len(m.FpReadiness) > 0 {
+		for _, e := range m.FpReadiness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UnbondingQuorum {
+		n += 2
+	}
+	if m.ActivationReady {
+		n += 2
+	}
+	if m.HasInclusionProof {
+		n += 2
+	}
+	return n
+}
+
+func (m *FpSignatureReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingSlashingQuorum {
+		n += 2
+	}
+	if m.UnbondingSlashingQuorum {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNextPowerDistUpdateHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextPowerDistUpdateHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *QueryCommissionRateBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommissionRateBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorUnbondingInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpendStakeTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DelegatorUnbondingInfoResponse != nil {
+		l = m.DelegatorUnbondingInfoResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.SlashingReason != 0 {
+		n += 1 + sovQuery(uint64(m.SlashingReason))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreationHeight))
+	}
+	if len(m.FpCommissions) > 0 {
+		for _, e := range m.FpCommissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FpCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBTCDelegationCovenantCoverageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationCovenantCoverageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.SignedCount != 0 {
+		n += 1 + sovQuery(uint64(m.SignedCount))
+	}
+	if m.RequiredQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredQuorum))
+	}
+	l = m.CoverageRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBTCDelegationsByValueRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinSat != 0 {
+		n += 1 + sovQuery(uint64(m.MinSat))
+	}
+	if m.MaxSat != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSat))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByValueRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCovenantInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if len(m.CovenantPksHex) > 0 {
+		for _, s := range m.CovenantPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.CommitteeSize != 0 {
+		n += 1 + sovQuery(uint64(m.CommitteeSize))
+	}
+	l = m.ThresholdPercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVerifyInclusionProofAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	if m.StakingTxInclusionProof != nil {
+		l = m.StakingTxInclusionProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *QueryVerifyInclusionProofAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InclusionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.InclusionHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationFinalityProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.HasActiveDelegations {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCNetworkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBTCNetworkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Network)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryDelegationCountByParamsVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsVersionDelegationCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryDelegationCountByParamsVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationCovenantSigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantSlashingSigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FpIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FpIndex))
+	}
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AdaptorSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationCovenantSigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsExpiringWithinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.NBlocks))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsExpiringWithinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcTipHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcTipHeight))
+	}
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsStakingTxRegisteredRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsStakingTxRegisteredResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationUnbondingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationUnbondingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegatorUnbonded {
+		n += 2
+	}
+	if m.UnbondingBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingBtcHeight))
+	}
+	if m.NumCovenantUnbondingSigs != 0 {
+		n += 1 + sovQuery(uint64(m.NumCovenantUnbondingSigs))
+	}
+	if m.CovenantUnbondingQuorum {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QuerySelectiveSlashingEvidenceListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySelectiveSlashingEvidenceListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByFpSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FpBtcPkHexList) > 0 {
+		for _, s := range m.FpBtcPkHexList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByFpSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingSlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorUnbondingSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationScriptPathsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationScriptPathsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = len(m.InternalKeyHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MerkleRootHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimelockPath != nil {
+		l = m.TimelockPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingPath != nil {
+		l = m.UnbondingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashingPath != nil {
+		l = m.SlashingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScriptPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LeafHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ControlBlockHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasProof != 0 {
+		n += 1 + sovQuery(uint64(m.HasProof))
+	}
+	if m.HasQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.HasQuorum))
+	}
+	if m.HasUnbondingSig != 0 {
+		n += 1 + sovQuery(uint64(m.HasUnbondingSig))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindStakingOutputIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = len(m.StakerBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkHexList) > 0 {
+		for _, s := range m.FpBtcPkHexList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovQuery(uint64(m.StakingValue))
+	}
+	return n
+}
+
+func (m *QueryFindStakingOutputIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	l = len(m.StakingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSigningHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSigningHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if len(m.SlashingSigHashes) > 0 {
+		for _, e := range m.SlashingSigHashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.UnbondingTxSigHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FpCovenantSigningHashes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxSigHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingUnbondingTxSigHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StatusFilter) > 0 {
+		l = 0
+		for _, e := range m.StatusFilter {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantCommitteeChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCovenantCommitteeChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GenesisCovenantPksHex) > 0 {
+		for _, s := range m.GenesisCovenantPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GenesisCovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.GenesisCovenantQuorum))
+	}
+	if m.CurrentParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentParamsVersion))
+	}
+	if len(m.CurrentCovenantPksHex) > 0 {
+		for _, s := range m.CurrentCovenantPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentCovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentCovenantQuorum))
+	}
+	if len(m.AddedPksHex) > 0 {
+		for _, s := range m.AddedPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemovedPksHex) > 0 {
+		for _, s := range m.RemovedPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRewardGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRewardGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawableCoins) > 0 {
+		for _, e := range m.WithdrawableCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationActivationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromBtcHeight))
+	}
+	if m.ToBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToBtcHeight))
+	}
+	if m.BucketSize != 0 {
+		n += 1 + sovQuery(uint64(m.BucketSize))
+	}
+	return n
+}
+
+func (m *ActivationRateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartBtcHeight))
+	}
+	if m.EndBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndBtcHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryDelegationActivationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakerDelegationAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakerDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	return n
+}
+
+func (m *QueryStakerDelegationAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.DigestHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRewardBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRewardBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CommissionCoins) > 0 {
+		for _, e := range m.CommissionCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawableCommissionCoins) > 0 {
+		for _, e := range m.WithdrawableCommissionCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SelfDelegationCoins) > 0 {
+		for _, e := range m.SelfDelegationCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCanReachCovenantQuorumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanReachCovenantQuorumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.SignedCount != 0 {
+		n += 1 + sovQuery(uint64(m.SignedCount))
+	}
+	if m.CanReachQuorum {
+		n += 2
+	}
+	if len(m.MissingSignersPksHex) > 0 {
+		for _, s := range m.MissingSignersPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnavailableSignersPksHex) > 0 {
+		for _, s := range m.UnavailableSignersPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBTCTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.HashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbondingScheduleIssue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.MinUnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.MinUnbondingTime))
+	}
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingHeight))
+	}
+	return n
+}
+
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issues) > 0 {
+		for _, e := range m.Issues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFinalityProviderStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.Active != 0 {
+		n += 1 + sovQuery(uint64(m.Active))
+	}
+	if m.Dormant != 0 {
+		n += 1 + sovQuery(uint64(m.Dormant))
+	}
+	if m.Jailed != 0 {
+		n += 1 + sovQuery(uint64(m.Jailed))
+	}
+	if m.Slashed != 0 {
+		n += 1 + sovQuery(uint64(m.Slashed))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantUnbondingSigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantUnbondingSigs) > 0 {
+		for _, e := range m.CovenantUnbondingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationSlashingRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationSlashingRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = m.SlashingRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.SlashingPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SlashingDestinations) > 0 {
+		for _, e := range m.SlashingDestinations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationsAffectedBySlashingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AffectedBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	return n
+}
+
+func (m *QueryDelegationsAffectedBySlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefundableBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefundableBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakingTxHashHexList) > 0 {
+		for _, s := range m.StakingTxHashHexList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Versions = append(m.Versions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Versions) == 0 {
+					m.Versions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Versions = append(m.Versions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValueSat", wireType)
+			}
+			m.StakingValueSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValueSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFeeSat", wireType)
+			}
+			m.UnbondingFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFeeSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingTimeBlocks", wireType)
+			}
+			m.MinUnbondingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnbondingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOutputValueSat", wireType)
+			}
+			m.UnbondingOutputValueSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOutputValueSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsByParamsVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsByParamsVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsByParamsVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsByParamsVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsByParamsVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsByParamsVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyCovenantSlashingSigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyCovenantSlashingSigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyCovenantSlashingSigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyCovenantSlashingSigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyCovenantSlashingSigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyCovenantSlashingSigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &CovenantSlashingSigVerification{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantSlashingSigVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantSlashingSigVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantSlashingSigVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationSignatureReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationSignatureReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationSignatureReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationSignatureReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationSignatureReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationSignatureReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpReadiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpReadiness = append(m.FpReadiness, &FpSignatureReadiness{})
+			if err := m.FpReadiness[len(m.FpReadiness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingQuorum = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationReady", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActivationReady = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasInclusionProof", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasInclusionProof = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FpSignatureReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FpSignatureReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FpSignatureReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSlashingQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakingSlashingQuorum = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingSlashingQuorum = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextPowerDistUpdateHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextPowerDistUpdateHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextPowerDistUpdateHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextPowerDistUpdateHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextPowerDistUpdateHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextPowerDistUpdateHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionRateBoundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionRateBoundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionRateBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionRateBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionRateBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionRateBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusDesc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UndelegationResponse == nil {
+				m.UndelegationResponse = &BTCUndelegationResponse{}
+			}
+			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendStakeTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
+			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingInfoResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegatorUnbondingInfoResponse == nil {
+				m.DelegatorUnbondingInfoResponse = &DelegatorUnbondingInfoResponse{}
+			}
+			if err := m.DelegatorUnbondingInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dels = append(m.Dels, &BTCDelegationResponse{})
+			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingReason", wireType)
+			}
+			m.SlashingReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingReason |= SlashingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCommissionAtDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionAtDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionAtDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCommissionAtDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionAtDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionAtDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpCommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpCommissions = append(m.FpCommissions, &FpCommission{})
+			if err := m.FpCommissions[len(m.FpCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FpCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FpCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FpCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationCovenantCoverageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantCoverageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantCoverageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationCovenantCoverageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantCoverageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantCoverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedCount", wireType)
+			}
+			m.SignedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredQuorum", wireType)
+			}
+			m.RequiredQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoverageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByValueRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByValueRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByValueRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSat", wireType)
+			}
+			m.MinSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSat", wireType)
+			}
+			m.MaxSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByValueRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByValueRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByValueRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantPksHex = append(m.CovenantPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeSize", wireType)
+			}
+			m.CommitteeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ThresholdPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyInclusionProofAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyInclusionProofAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyInclusionProofAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTxInclusionProof == nil {
+				m.StakingTxInclusionProof = &InclusionProof{}
+			}
+			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyInclusionProofAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyInclusionProofAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyInclusionProofAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionHeight", wireType)
+			}
+			m.InclusionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationFinalityProviderStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationFinalityProviderStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationFinalityProviderStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationFinalityProviderStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationFinalityProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Slashed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasActiveDelegations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasActiveDelegations = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationFinalityProviderStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationFinalityProviderStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationFinalityProviderStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &DelegationFinalityProviderStatus{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCNetworkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCNetworkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCNetworkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCNetworkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCNetworkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCNetworkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Network = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationCountByParamsVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationCountByParamsVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationCountByParamsVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsVersionDelegationCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsVersionDelegationCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsVersionDelegationCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationCountByParamsVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationCountByParamsVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationCountByParamsVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counts = append(m.Counts, &ParamsVersionDelegationCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationCovenantSigsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantSigsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantSigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantSlashingSigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantSlashingSigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantSlashingSigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpIndex", wireType)
+			}
+			m.FpIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FpIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptorSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptorSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantSigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantSlashingSigEntry{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsExpiringWithinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsExpiringWithinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsExpiringWithinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NBlocks", wireType)
+			}
+			m.NBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsExpiringWithinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsExpiringWithinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsExpiringWithinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTipHeight", wireType)
+			}
+			m.BtcTipHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcTipHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsStakingTxRegisteredRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsStakingTxRegisteredRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsStakingTxRegisteredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsStakingTxRegisteredResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsStakingTxRegisteredResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsStakingTxRegisteredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationUnbondingStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationUnbondingStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationUnbondingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationUnbondingStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationUnbondingStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationUnbondingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbonded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DelegatorUnbonded = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingBtcHeight", wireType)
+			}
+			m.UnbondingBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCovenantUnbondingSigs", wireType)
+			}
+			m.NumCovenantUnbondingSigs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumCovenantUnbondingSigs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CovenantUnbondingQuorum = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySelectiveSlashingEvidenceListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySelectiveSlashingEvidenceListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySelectiveSlashingEvidenceListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySelectiveSlashingEvidenceListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySelectiveSlashingEvidenceListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySelectiveSlashingEvidenceListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, &SelectiveSlashingEvidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsByFpSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsByFpSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsByFpSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHexList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHexList = append(m.FpBtcPkHexList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsByFpSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsByFpSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsByFpSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingSlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorUnbondingSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationScriptPathsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptPathsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptPathsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationScriptPathsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptPathsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptPathsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKeyHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalKeyHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRootHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRootHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockPath == nil {
+				m.TimelockPath = &ScriptPath{}
+			}
+			if err := m.TimelockPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnbondingPath == nil {
+				m.UnbondingPath = &ScriptPath{}
+			}
+			if err := m.UnbondingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashingPath == nil {
+				m.SlashingPath = &ScriptPath{}
+			}
+			if err := m.SlashingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScriptPath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScriptPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScriptPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlBlockHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlBlockHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasProof", wireType)
+			}
+			m.HasProof = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasProof |= FlagFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasQuorum", wireType)
+			}
+			m.HasQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasQuorum |= FlagFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasUnbondingSig", wireType)
+			}
+			m.HasUnbondingSig = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasUnbondingSig |= FlagFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindStakingOutputIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindStakingOutputIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindStakingOutputIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHexList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHexList = append(m.FpBtcPkHexList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindStakingOutputIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindStakingOutputIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindStakingOutputIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSigningHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantSigningHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantSigningHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSigningHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantSigningHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantSigningHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingSigHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingSigHashes = append(m.SlashingSigHashes, &FpCovenantSigningHashes{})
+			if err := m.SlashingSigHashes[len(m.SlashingSigHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSigHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxSigHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FpCovenantSigningHashes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FpCovenantSigningHashes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FpCovenantSigningHashes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxSigHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingUnbondingTxSigHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v BTCDelegationStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= BTCDelegationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StatusFilter = append(m.StatusFilter, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.StatusFilter) == 0 {
+					m.StatusFilter = make([]BTCDelegationStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v BTCDelegationStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= BTCDelegationStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StatusFilter = append(m.StatusFilter, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantCommitteeChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantCommitteeChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisCovenantPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisCovenantPksHex = append(m.GenesisCovenantPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisCovenantQuorum", wireType)
+			}
+			m.GenesisCovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisCovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParamsVersion", wireType)
+			}
+			m.CurrentParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCovenantPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentCovenantPksHex = append(m.CurrentCovenantPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCovenantQuorum", wireType)
+			}
+			m.CurrentCovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentCovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedPksHex = append(m.AddedPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedPksHex = append(m.RemovedPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRewardGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRewardGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRewardGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRewardGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRewardGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRewardGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types1.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawableCoins = append(m.WithdrawableCoins, types1.Coin{})
+			if err := m.WithdrawableCoins[len(m.WithdrawableCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationActivationRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationActivationRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationActivationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBtcHeight", wireType)
+			}
+			m.FromBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBtcHeight", wireType)
+			}
+			m.ToBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
+			}
+			m.BucketSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivationRateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivationRateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivationRateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBtcHeight", wireType)
+			}
+			m.StartBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBtcHeight", wireType)
+			}
+			m.EndBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationActivationRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationActivationRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationActivationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &ActivationRateBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerDelegationAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerDelegationAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerDelegationAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerDelegationAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerDelegationAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerDelegationAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, &StakerDelegationEntry{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestHex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DigestHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3027,7 +28104,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderRewardBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,17 +28127,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderRewardBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderRewardBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3070,24 +28147,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3110,7 +28186,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderRewardBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3133,17 +28209,17 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderRewardBreakdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderRewardBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpAddr", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3153,64 +28229,61 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.FpAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionCoins", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CommissionCoins = append(m.CommissionCoins, types1.Coin{})
+			if err := m.CommissionCoins[len(m.CommissionCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableCommissionCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,7 +28310,42 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawableCommissionCoins = append(m.WithdrawableCommissionCoins, types1.Coin{})
+			if err := m.WithdrawableCommissionCoins[len(m.WithdrawableCommissionCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegationCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfDelegationCoins = append(m.SelfDelegationCoins, types1.Coin{})
+			if err := m.SelfDelegationCoins[len(m.SelfDelegationCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3262,7 +28370,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanReachCovenantQuorumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3285,17 +28393,17 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanReachCovenantQuorumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanReachCovenantQuorumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3305,27 +28413,23 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3348,7 +28452,7 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanReachCovenantQuorumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3371,17 +28475,74 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanReachCovenantQuorumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanReachCovenantQuorumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedCount", wireType)
+			}
+			m.SignedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanReachQuorum", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3391,31 +28552,17 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.CanReachQuorum = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSignersPksHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3425,81 +28572,27 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MissingSignersPksHex = append(m.MissingSignersPksHex, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableSignersPksHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3527,7 +28620,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.UnavailableSignersPksHex = append(m.UnavailableSignersPksHex, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3550,7 +28643,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCTipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3573,48 +28666,12 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCTipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
-			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3636,7 +28693,7 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCTipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3659,17 +28716,17 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCTipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Status = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3679,16 +28736,16 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				m.Height |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3698,27 +28755,23 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3741,7 +28794,7 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsWithUnbondingScheduleIssuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3764,47 +28817,13 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsWithUnbondingScheduleIssuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsWithUnbondingScheduleIssuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3834,7 +28853,7 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3861,7 +28880,7 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *UnbondingScheduleIssue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3884,15 +28903,15 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingScheduleIssue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingScheduleIssue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3920,13 +28939,13 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var msglen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3936,28 +28955,68 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingTime", wireType)
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.MinUnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3979,7 +29038,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsWithUnbondingScheduleIssuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4002,15 +29061,15 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsWithUnbondingScheduleIssuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsWithUnbondingScheduleIssuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4037,8 +29096,8 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Issues = append(m.Issues, &UnbondingScheduleIssue{})
+			if err := m.Issues[len(m.Issues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4099,89 +29158,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4204,48 +29181,12 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
-			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4267,7 +29208,7 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4290,17 +29231,17 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4310,29 +29251,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakerAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var byteLen int
+			m.Active = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4342,32 +29270,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Active |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dormant", wireType)
 			}
-			var byteLen int
+			m.Dormant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4377,32 +29289,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Dormant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			m.StakingTime = 0
+			m.Jailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4412,16 +29308,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingTime |= uint32(b&0x7F) << shift
+				m.Jailed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			m.StartHeight = 0
+			m.Slashed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4431,52 +29327,64 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint32(b&0x7F) << shift
+				m.Slashed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.EndHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndHeight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalSat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalSat |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantUnbondingSigsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantUnbondingSigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4504,11 +29412,61 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantUnbondingSigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantUnbondingSigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantUnbondingSigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPkHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4536,11 +29494,11 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			m.CovPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4568,11 +29526,61 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
+			m.SigHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationCovenantUnbondingSigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantUnbondingSigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationCovenantUnbondingSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4599,16 +29607,66 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CovenantUnbondingSigs = append(m.CovenantUnbondingSigs, &CovenantUnbondingSigEntry{})
+			if err := m.CovenantUnbondingSigs[len(m.CovenantUnbondingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StakingOutputIdx = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationSlashingRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationSlashingRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationSlashingRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4618,16 +29676,79 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationSlashingRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationSlashingRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationSlashingRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
 			}
-			var v int
+			m.ParamsVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4637,15 +29758,14 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ParamsVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4673,13 +29793,15 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusDesc = string(dAtA[iNdEx:postIndex])
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPkScriptHex", wireType)
 			}
-			m.UnbondingTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4689,14 +29811,27 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingPkScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingDestinations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4723,32 +29858,11 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UndelegationResponse == nil {
-				m.UndelegationResponse = &BTCUndelegationResponse{}
-			}
-			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashingDestinations = append(m.SlashingDestinations, SlashingDestination{})
+			if err := m.SlashingDestinations[len(m.SlashingDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
-			}
-			m.ParamsVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParamsVersion |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4770,7 +29884,7 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsAffectedBySlashingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4793,15 +29907,15 @@ func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsAffectedBySlashingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsAffectedBySlashingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4829,7 +29943,43 @@ func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendStakeTxHex = string(dAtA[iNdEx:postIndex])
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4852,7 +30002,7 @@ func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *AffectedBTCDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4875,15 +30025,15 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AffectedBTCDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AffectedBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4911,13 +30061,13 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4927,29 +30077,27 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
-			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4977,13 +30125,13 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
 			}
-			var stringLen uint64
+			m.TotalSat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4993,27 +30141,102 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalSat |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsAffectedBySlashingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsAffectedBySlashingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsAffectedBySlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5040,14 +30263,33 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, &AffectedBTCDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingInfoResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5074,10 +30316,10 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegatorUnbondingInfoResponse == nil {
-				m.DelegatorUnbondingInfoResponse = &DelegatorUnbondingInfoResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.DelegatorUnbondingInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5102,7 +30344,7 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRefundableBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5125,15 +30367,47 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRefundableBTCDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRefundableBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5160,8 +30434,10 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dels = append(m.Dels, &BTCDelegationResponse{})
-			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5186,7 +30462,7 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRefundableBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5209,87 +30485,15 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRefundableBTCDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRefundableBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHexList", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5317,46 +30521,11 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHexList = append(m.StakingTxHashHexList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5383,90 +30552,13 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
-			}
-			m.SlashedBabylonHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
-			}
-			m.SlashedBtcHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBtcHeight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -47,6 +47,12 @@ type MsgCreateFinalityProvider struct {
 	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
 	// pop is the proof of possession of btc_pk over the FP signer address.
 	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// self_delegation is an optional BTC delegation of the finality provider's
+	// own stake to itself. If set, it is processed atomically with the creation
+	// of the finality provider, such that the whole message fails if the BTC
+	// delegation fails. Its staker_addr must be addr and its fp_btc_pk_list must
+	// include btc_pk
+	SelfDelegation *MsgCreateBTCDelegation `protobuf:"bytes,6,opt,name=self_delegation,json=selfDelegation,proto3" json:"self_delegation,omitempty"`
 }
 
 func (m *MsgCreateFinalityProvider) Reset()         { *m = MsgCreateFinalityProvider{} }
@@ -103,6 +109,13 @@ func (m *MsgCreateFinalityProvider) GetPop() *ProofOfPossessionBTC {
 	return nil
 }
 
+func (m *MsgCreateFinalityProvider) GetSelfDelegation() *MsgCreateBTCDelegation {
+	if m != nil {
+		return m.SelfDelegation
+	}
+	return nil
+}
+
 // MsgCreateFinalityProviderResponse is the response for MsgCreateFinalityProvider
 type MsgCreateFinalityProviderResponse struct {
 }
@@ -243,6 +256,99 @@ func (m *MsgEditFinalityProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditFinalityProviderResponse proto.InternalMessageInfo
 
+// MsgCancelFinalityProvider is the message for canceling the registration of
+// a finality provider that has not received any BTC delegation yet
+type MsgCancelFinalityProvider struct {
+	// addr is the address of the finality provider that wishes to cancel its registration.
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of the finality provider to be canceled
+	BtcPk []byte `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3" json:"btc_pk,omitempty"`
+}
+
+func (m *MsgCancelFinalityProvider) Reset()         { *m = MsgCancelFinalityProvider{} }
+func (m *MsgCancelFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFinalityProvider) ProtoMessage()    {}
+func (*MsgCancelFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{4}
+}
+func (m *MsgCancelFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFinalityProvider.Merge(m, src)
+}
+func (m *MsgCancelFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgCancelFinalityProvider) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *MsgCancelFinalityProvider) GetBtcPk() []byte {
+	if m != nil {
+		return m.BtcPk
+	}
+	return nil
+}
+
+// MsgCancelFinalityProviderResponse is the response for MsgCancelFinalityProvider
+type MsgCancelFinalityProviderResponse struct {
+}
+
+func (m *MsgCancelFinalityProviderResponse) Reset()         { *m = MsgCancelFinalityProviderResponse{} }
+func (m *MsgCancelFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgCancelFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{5}
+}
+func (m *MsgCancelFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgCancelFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFinalityProviderResponse proto.InternalMessageInfo
+
 // MsgCreateBTCDelegation is the message for creating a BTC delegation
 type MsgCreateBTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -288,13 +394,29 @@ type MsgCreateBTCDelegation struct {
 	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,14,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
 	// delegator_unbonding_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
 	DelegatorUnbondingSlashingSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,15,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
+	// reward_address is the optional address to receive rewards from the BTC
+	// delegation. If empty, rewards are sent to staker_addr
+	RewardAddress string `protobuf:"bytes,16,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// staking_tx_witness is the optional witness of the staking tx, with one
+	// serialized witness stack per input, i.e., in the same format as the final
+	// script witness of a PSBT input. If provided, staking_tx must be stripped
+	// of its witness, and the witness is attached to it before the staking tx
+	// is processed. This allows PSBT-based signing flows to submit the unsigned
+	// tx and the witness separately
+	StakingTxWitness [][]byte `protobuf:"bytes,17,rep,name=staking_tx_witness,json=stakingTxWitness,proto3" json:"staking_tx_witness,omitempty"`
+	// refund_staking_tx_hash is the optional hash of the staking tx of a BTC
+	// delegation of the staker that did not receive a covenant quorum before
+	// its deadline, in btc format. If provided, the refund of that BTC
+	// delegation is claimed, such that this message is refunded. The message
+	// is rejected if the BTC delegation is not refundable to the staker
+	RefundStakingTxHash string `protobuf:"bytes,18,opt,name=refund_staking_tx_hash,json=refundStakingTxHash,proto3" json:"refund_staking_tx_hash,omitempty"`
 }
 
 func (m *MsgCreateBTCDelegation) Reset()         { *m = MsgCreateBTCDelegation{} }
 func (m *MsgCreateBTCDelegation) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateBTCDelegation) ProtoMessage()    {}
 func (*MsgCreateBTCDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{4}
+	return fileDescriptor_4baddb53e97f38f2, []int{6}
 }
 func (m *MsgCreateBTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,6 +508,27 @@ func (m *MsgCreateBTCDelegation) GetUnbondingValue() int64 {
 	return 0
 }
 
+func (m *MsgCreateBTCDelegation) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateBTCDelegation) GetStakingTxWitness() [][]byte {
+	if m != nil {
+		return m.StakingTxWitness
+	}
+	return nil
+}
+
+func (m *MsgCreateBTCDelegation) GetRefundStakingTxHash() string {
+	if m != nil {
+		return m.RefundStakingTxHash
+	}
+	return ""
+}
+
 // MsgCreateBTCDelegationResponse is the response for MsgCreateBTCDelegation
 type MsgCreateBTCDelegationResponse struct {
 }
@@ -394,7 +537,7 @@ func (m *MsgCreateBTCDelegationResponse) Reset()         { *m = MsgCreateBTCDele
 func (m *MsgCreateBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateBTCDelegationResponse) ProtoMessage()    {}
 func (*MsgCreateBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{5}
+	return fileDescriptor_4baddb53e97f38f2, []int{7}
 }
 func (m *MsgCreateBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +580,7 @@ func (m *MsgAddBTCDelegationInclusionProof) Reset()         { *m = MsgAddBTCDele
 func (m *MsgAddBTCDelegationInclusionProof) String() string { return proto.CompactTextString(m) }
 func (*MsgAddBTCDelegationInclusionProof) ProtoMessage()    {}
 func (*MsgAddBTCDelegationInclusionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{6}
+	return fileDescriptor_4baddb53e97f38f2, []int{8}
 }
 func (m *MsgAddBTCDelegationInclusionProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +642,7 @@ func (m *MsgAddBTCDelegationInclusionProofResponse) String() string {
 }
 func (*MsgAddBTCDelegationInclusionProofResponse) ProtoMessage() {}
 func (*MsgAddBTCDelegationInclusionProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{7}
+	return fileDescriptor_4baddb53e97f38f2, []int{9}
 }
 func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +697,7 @@ func (m *MsgAddCovenantSigs) Reset()         { *m = MsgAddCovenantSigs{} }
 func (m *MsgAddCovenantSigs) String() string { return proto.CompactTextString(m) }
 func (*MsgAddCovenantSigs) ProtoMessage()    {}
 func (*MsgAddCovenantSigs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{8}
+	return fileDescriptor_4baddb53e97f38f2, []int{10}
 }
 func (m *MsgAddCovenantSigs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +762,7 @@ func (m *MsgAddCovenantSigsResponse) Reset()         { *m = MsgAddCovenantSigsRe
 func (m *MsgAddCovenantSigsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddCovenantSigsResponse) ProtoMessage()    {}
 func (*MsgAddCovenantSigsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{9}
+	return fileDescriptor_4baddb53e97f38f2, []int{11}
 }
 func (m *MsgAddCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +811,7 @@ func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
 func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegate) ProtoMessage()    {}
 func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{10}
+	return fileDescriptor_4baddb53e97f38f2, []int{12}
 }
 func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +876,7 @@ func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateRespon
 func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
 func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{11}
+	return fileDescriptor_4baddb53e97f38f2, []int{13}
 }
 func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +923,7 @@ func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashi
 func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{12}
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
 }
 func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +981,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelecti
 func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{13}
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
 }
 func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1027,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{14}
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1078,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{15}
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,6 +1112,8 @@ func init() {
 	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
 	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
 	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
+	proto.RegisterType((*MsgCancelFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCancelFinalityProvider")
+	proto.RegisterType((*MsgCancelFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCancelFinalityProviderResponse")
 	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
 	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
 	proto.RegisterType((*MsgAddBTCDelegationInclusionProof)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProof")
@@ -986,91 +1131,99 @@ func init() {
 func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
 
 var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0xd3, 0x56,
-	0x14, 0xaf, 0x9b, 0xb6, 0xa3, 0x27, 0xfd, 0x87, 0x29, 0x6d, 0xea, 0xd1, 0x24, 0x0d, 0x50, 0x0a,
-	0xac, 0x0e, 0x05, 0xc6, 0x58, 0xab, 0x49, 0x23, 0x6d, 0x11, 0x68, 0x64, 0x44, 0x4e, 0xba, 0x87,
-	0x49, 0x53, 0xe4, 0xd8, 0xb7, 0xce, 0x55, 0x13, 0x5f, 0xcf, 0xd7, 0x89, 0x52, 0x4d, 0x9a, 0xa6,
-	0x6d, 0xd2, 0x9e, 0x26, 0xed, 0x69, 0x0f, 0xd3, 0x3e, 0x04, 0x0f, 0x7c, 0x08, 0x1e, 0x11, 0xda,
-	0xc3, 0xd4, 0x87, 0x6a, 0x82, 0x07, 0x3e, 0xc3, 0xa6, 0x49, 0x9b, 0x7c, 0x6d, 0x5f, 0x3b, 0x21,
-	0xee, 0x1f, 0xc2, 0x5b, 0xee, 0x3d, 0xbf, 0xf3, 0xef, 0x77, 0xce, 0x3d, 0xf7, 0xc6, 0x90, 0xae,
-	0xa9, 0xb5, 0xfd, 0x06, 0x31, 0xf3, 0x35, 0x47, 0xa3, 0x8e, 0xba, 0x87, 0x4d, 0x23, 0xdf, 0x5e,
-	0xcb, 0x3b, 0x1d, 0xd9, 0xb2, 0x89, 0x43, 0xc4, 0xf3, 0xbe, 0x5c, 0x0e, 0xe5, 0x72, 0x7b, 0x4d,
-	0x9a, 0x35, 0x88, 0x41, 0x18, 0x22, 0xef, 0xfe, 0xf2, 0xc0, 0xd2, 0x82, 0x46, 0x68, 0x93, 0xd0,
-	0xaa, 0x27, 0xf0, 0x16, 0xbe, 0x68, 0xde, 0x5b, 0xe5, 0x9b, 0x94, 0xd9, 0x6f, 0x52, 0xc3, 0x17,
-	0xe4, 0xfa, 0x07, 0x60, 0xa9, 0xb6, 0xda, 0x0c, 0x94, 0x2f, 0xf9, 0xca, 0xa1, 0xbc, 0x86, 0x1c,
-	0x75, 0x2d, 0x58, 0xfb, 0xa8, 0x4c, 0x8c, 0x25, 0x62, 0xf9, 0x80, 0xe5, 0xfe, 0x80, 0x48, 0x66,
-	0x0c, 0x97, 0xfb, 0x67, 0x18, 0x16, 0x8a, 0xd4, 0xd8, 0xb4, 0x91, 0xea, 0xa0, 0xfb, 0xd8, 0x54,
-	0x1b, 0xd8, 0xd9, 0x2f, 0xd9, 0xa4, 0x8d, 0x75, 0x64, 0x8b, 0x1f, 0xc0, 0x88, 0xaa, 0xeb, 0x76,
-	0x4a, 0xc8, 0x0a, 0x2b, 0xe3, 0x85, 0xd4, 0x8b, 0xa7, 0xab, 0xb3, 0x7e, 0xa6, 0xf7, 0x74, 0xdd,
-	0x46, 0x94, 0x96, 0x1d, 0x1b, 0x9b, 0x86, 0xc2, 0x50, 0xe2, 0x36, 0x24, 0x75, 0x44, 0x35, 0x1b,
-	0x5b, 0x0e, 0x26, 0x66, 0x6a, 0x38, 0x2b, 0xac, 0x24, 0x6f, 0x5e, 0x94, 0x7d, 0x8d, 0x90, 0x51,
-	0x96, 0x90, 0xbc, 0x15, 0x42, 0x95, 0xa8, 0x9e, 0x58, 0x04, 0xd0, 0x48, 0xb3, 0x89, 0x29, 0x75,
-	0xad, 0x24, 0x98, 0xeb, 0xd5, 0x83, 0xc3, 0xcc, 0xfb, 0x9e, 0x21, 0xaa, 0xef, 0xc9, 0x98, 0xe4,
-	0x9b, 0xaa, 0x53, 0x97, 0x1f, 0x21, 0x43, 0xd5, 0xf6, 0xb7, 0x90, 0xf6, 0xe2, 0xe9, 0x2a, 0xf8,
-	0x7e, 0xb6, 0x90, 0xa6, 0x44, 0x0c, 0x88, 0x8f, 0x61, 0xac, 0xe6, 0x68, 0x55, 0x6b, 0x2f, 0x35,
-	0x92, 0x15, 0x56, 0x26, 0x0a, 0x77, 0x0f, 0x0e, 0x33, 0xb7, 0x0d, 0xec, 0xd4, 0x5b, 0x35, 0x59,
-	0x23, 0xcd, 0xbc, 0x4f, 0x54, 0x43, 0xad, 0xd1, 0x55, 0x4c, 0x82, 0x65, 0xde, 0xd9, 0xb7, 0x10,
-	0x95, 0x0b, 0x0f, 0x4b, 0xb7, 0x6e, 0xdf, 0x28, 0xb5, 0x6a, 0x9f, 0xa1, 0x7d, 0x65, 0xb4, 0xe6,
-	0x68, 0xa5, 0x3d, 0xf1, 0x13, 0x48, 0x58, 0xc4, 0x4a, 0x8d, 0xb2, 0xf4, 0xae, 0xcb, 0x7d, 0x9b,
-	0x46, 0x2e, 0xd9, 0x84, 0xec, 0x3e, 0xde, 0x2d, 0x11, 0x4a, 0x11, 0x8b, 0xa3, 0x50, 0xd9, 0x54,
-	0x5c, 0xbd, 0xf5, 0xf1, 0xef, 0x5f, 0x3f, 0xb9, 0xc6, 0x08, 0xcb, 0x5d, 0x84, 0xa5, 0x58, 0xee,
-	0x15, 0x44, 0x2d, 0x62, 0x52, 0x94, 0xfb, 0x4f, 0x80, 0xf9, 0x22, 0x35, 0xb6, 0x75, 0xec, 0x0c,
-	0x58, 0x9f, 0xf3, 0x9c, 0x09, 0xb7, 0x34, 0x13, 0x41, 0x3e, 0x3d, 0x65, 0x4b, 0xbc, 0x93, 0xb2,
-	0x8d, 0x0c, 0x58, 0xb6, 0x28, 0x4d, 0x4b, 0x90, 0x89, 0x21, 0x80, 0x93, 0xf4, 0xd3, 0x19, 0x98,
-	0xe3, 0x54, 0x16, 0x2a, 0x9b, 0x5b, 0xa8, 0x81, 0x0c, 0x95, 0xc5, 0xf5, 0x31, 0x24, 0xdd, 0x1c,
-	0x90, 0x5d, 0x3d, 0x11, 0x55, 0xe0, 0x81, 0xdd, 0xcd, 0xa0, 0xd2, 0xc3, 0x6f, 0x57, 0xe9, 0x48,
-	0xe7, 0x25, 0xde, 0x4d, 0xe7, 0x7d, 0x05, 0x53, 0xbb, 0x56, 0xd5, 0xb3, 0x59, 0x6d, 0x60, 0xea,
-	0xa4, 0x46, 0xb2, 0x89, 0x81, 0x0c, 0x27, 0x77, 0xad, 0x82, 0x6b, 0xfa, 0x11, 0xa6, 0x8e, 0xb8,
-	0x04, 0x13, 0x7e, 0x5e, 0x55, 0x07, 0x37, 0x11, 0xeb, 0xf0, 0x49, 0x25, 0xe9, 0xef, 0x55, 0x70,
-	0x13, 0x89, 0x17, 0x61, 0x32, 0x80, 0xb4, 0xd5, 0x46, 0x0b, 0xa5, 0xc6, 0xb2, 0xc2, 0x4a, 0x42,
-	0x09, 0xf4, 0xbe, 0x70, 0xf7, 0xc4, 0x45, 0x00, 0x6e, 0xa7, 0x93, 0x7a, 0x8f, 0xf5, 0xda, 0x78,
-	0x60, 0xa5, 0x23, 0xd6, 0x40, 0x0a, 0xc5, 0x55, 0x6c, 0x6a, 0x8d, 0x96, 0x4b, 0x9b, 0x3b, 0x49,
-	0xc9, 0x6e, 0xea, 0x0c, 0x23, 0xfb, 0x72, 0x0c, 0xd9, 0x0f, 0x03, 0x34, 0x63, 0x5d, 0x99, 0xe7,
-	0x56, 0xbb, 0x05, 0xe2, 0x4d, 0x48, 0xd2, 0x86, 0x4a, 0xeb, 0x7e, 0x0c, 0xe3, 0x8c, 0xff, 0xb3,
-	0x07, 0x87, 0x99, 0xc9, 0x42, 0x65, 0xb3, 0xec, 0x4b, 0x2a, 0x1d, 0x05, 0x28, 0xff, 0x2d, 0x7e,
-	0x0d, 0x73, 0xba, 0xd7, 0x36, 0xc4, 0xae, 0x72, 0x6d, 0x8a, 0x8d, 0x14, 0x30, 0xf5, 0x8d, 0x83,
-	0xc3, 0xcc, 0x47, 0xa7, 0x63, 0xb9, 0x8c, 0x0d, 0x53, 0x75, 0x5a, 0x36, 0x52, 0x66, 0xb9, 0xe9,
-	0xc0, 0x7b, 0x19, 0x1b, 0xe2, 0x65, 0x98, 0x6a, 0x99, 0x35, 0x62, 0xea, 0x9c, 0xf3, 0x24, 0xe3,
-	0x7c, 0x92, 0xef, 0x32, 0xd6, 0x97, 0x60, 0x22, 0x02, 0xeb, 0xa4, 0x26, 0x18, 0xa5, 0xc9, 0x10,
-	0xd4, 0x11, 0xaf, 0xc0, 0x74, 0x08, 0xf1, 0x4a, 0x33, 0xc9, 0x4a, 0x13, 0x3a, 0xf0, 0x8a, 0xb3,
-	0x0d, 0xe7, 0x43, 0x60, 0x94, 0xa3, 0xa9, 0x38, 0x8e, 0xce, 0x71, 0x7c, 0xb8, 0x29, 0xfe, 0x28,
-	0x40, 0x36, 0x64, 0xab, 0x8f, 0x45, 0x97, 0xb7, 0xe9, 0xc1, 0x79, 0x5b, 0xe4, 0x4e, 0x76, 0x7a,
-	0xa3, 0x28, 0x63, 0x63, 0x7d, 0xc6, 0x9d, 0x12, 0xd1, 0xf3, 0x9d, 0xcb, 0x42, 0xba, 0xff, 0x20,
-	0xe0, 0xb3, 0xe2, 0x0f, 0x81, 0x8d, 0xdd, 0x7b, 0xba, 0xde, 0x25, 0xef, 0xe9, 0xa0, 0x39, 0x18,
-	0xa3, 0xd8, 0x30, 0x91, 0x3f, 0x31, 0x14, 0x7f, 0x25, 0x2e, 0xc3, 0x74, 0xa4, 0x7b, 0xeb, 0x2a,
-	0xad, 0xb3, 0xf9, 0x30, 0xae, 0x4c, 0xf2, 0x5e, 0x7c, 0xa0, 0xd2, 0xfa, 0x31, 0x5d, 0x9e, 0x78,
-	0x17, 0x5d, 0xbe, 0x9e, 0x74, 0xb3, 0xf7, 0x03, 0xcb, 0x5d, 0x87, 0xab, 0xc7, 0x66, 0xc5, 0x39,
-	0xf8, 0x7b, 0x18, 0x44, 0x0f, 0xbd, 0x49, 0xda, 0xc8, 0x54, 0x4d, 0xa7, 0x8c, 0x0d, 0x1a, 0x9b,
-	0xf4, 0x03, 0x18, 0x0e, 0x6e, 0x8d, 0x01, 0x86, 0xcd, 0xb0, 0xb5, 0xd7, 0x8f, 0xbe, 0x44, 0x3f,
-	0xfa, 0x56, 0x60, 0x26, 0xd2, 0x9c, 0x6e, 0x37, 0x51, 0x6f, 0xd8, 0x29, 0x53, 0xe1, 0x91, 0x65,
-	0x31, 0x23, 0x98, 0x89, 0x1e, 0x0e, 0xd6, 0x78, 0xa3, 0x83, 0x37, 0xde, 0x54, 0xe4, 0x74, 0xb9,
-	0x47, 0x75, 0x03, 0x24, 0x1e, 0x50, 0xaf, 0x3f, 0x9a, 0x1a, 0x63, 0xa1, 0xcd, 0x07, 0x88, 0x9d,
-	0x2e, 0x5d, 0xda, 0x5d, 0xa8, 0x0b, 0x20, 0xbd, 0x49, 0x3d, 0xaf, 0xcc, 0xbf, 0x02, 0xcc, 0x14,
-	0xa9, 0x51, 0xa8, 0x6c, 0xee, 0x98, 0x7e, 0xef, 0xa3, 0x81, 0x9b, 0xf1, 0x1a, 0x9c, 0x65, 0x67,
-	0xa4, 0x4a, 0x2d, 0xc4, 0xa7, 0x08, 0xbb, 0x94, 0x14, 0x66, 0x00, 0x95, 0xfd, 0xfd, 0x4a, 0x47,
-	0x24, 0xb0, 0xf4, 0x06, 0xf6, 0x8d, 0xfe, 0x1d, 0x39, 0x4d, 0xff, 0x2e, 0xf6, 0xb8, 0x38, 0xaa,
-	0x8b, 0x25, 0x48, 0xf5, 0x66, 0xcf, 0xa9, 0xf9, 0x4d, 0x80, 0x0b, 0x45, 0x6a, 0x94, 0x51, 0x03,
-	0x69, 0x0e, 0x6e, 0xa3, 0x60, 0x10, 0x6c, 0xbb, 0x6f, 0x01, 0x53, 0x1b, 0x9c, 0xa6, 0x55, 0x38,
-	0x67, 0x23, 0x8d, 0xb4, 0x91, 0x8d, 0xf4, 0xaa, 0x7f, 0xd3, 0x52, 0xff, 0xf6, 0x56, 0x66, 0xb8,
-	0xe8, 0xbe, 0x7b, 0x67, 0x96, 0xf7, 0xba, 0x03, 0x5f, 0x86, 0x4b, 0x47, 0xc5, 0xc6, 0x93, 0xf8,
-	0x55, 0x80, 0xe9, 0x22, 0x35, 0x76, 0x2c, 0x5d, 0x75, 0x50, 0x89, 0xbd, 0xfc, 0xc5, 0x3b, 0x30,
-	0xae, 0xb6, 0x9c, 0x3a, 0xb1, 0xb1, 0xb3, 0x7f, 0xec, 0x03, 0x25, 0x84, 0x8a, 0x1b, 0x30, 0xe6,
-	0xfd, 0x77, 0xf0, 0x9f, 0x28, 0x8b, 0x71, 0x4f, 0x14, 0x06, 0x2a, 0x8c, 0x3c, 0x3b, 0xcc, 0x0c,
-	0x29, 0xbe, 0xca, 0xfa, 0x94, 0x1b, 0x7d, 0x68, 0x2c, 0xb7, 0xc0, 0x9e, 0x99, 0xd1, 0xb8, 0x82,
-	0x98, 0x6f, 0xfe, 0x70, 0x06, 0x12, 0x45, 0x6a, 0xb8, 0x43, 0x7f, 0x2e, 0xe6, 0x9f, 0xc2, 0x8d,
-	0x18, 0xd7, 0xb1, 0xef, 0x5b, 0xe9, 0xee, 0x69, 0x35, 0x82, 0x70, 0xc4, 0x6f, 0x61, 0xb6, 0xef,
-	0x6b, 0x58, 0x8e, 0xb7, 0xd8, 0x0f, 0x2f, 0xdd, 0x39, 0x1d, 0x9e, 0xfb, 0xff, 0x06, 0xce, 0xf5,
-	0x7b, 0x68, 0xae, 0x1e, 0x97, 0x50, 0x17, 0x5c, 0xfa, 0xf0, 0x54, 0x70, 0xee, 0xfc, 0x77, 0x01,
-	0xd2, 0xc7, 0x5c, 0x5d, 0x47, 0x30, 0x7b, 0xb4, 0xa6, 0xf4, 0xe9, 0xdb, 0x6a, 0xf2, 0xf0, 0x08,
-	0x4c, 0xf7, 0x5e, 0x2a, 0x57, 0x8f, 0x34, 0x1a, 0x85, 0x4a, 0x6b, 0x27, 0x86, 0x72, 0x87, 0x18,
-	0x26, 0xbb, 0x67, 0xe5, 0x95, 0x78, 0x1b, 0x5d, 0x40, 0x29, 0x7f, 0x42, 0x20, 0x77, 0xf5, 0xb3,
-	0x00, 0x0b, 0xf1, 0xc3, 0xe7, 0x56, 0xbc, 0xb9, 0x58, 0x25, 0x69, 0xe3, 0x2d, 0x94, 0x78, 0x3c,
-	0xbb, 0x30, 0xd1, 0x35, 0x46, 0x96, 0xe3, 0x8d, 0x45, 0x71, 0x92, 0x7c, 0x32, 0x5c, 0xe0, 0x47,
-	0x1a, 0xfd, 0xee, 0xf5, 0x93, 0x6b, 0x42, 0xe1, 0xf3, 0x67, 0x2f, 0xd3, 0xc2, 0xf3, 0x97, 0x69,
-	0xe1, 0xaf, 0x97, 0x69, 0xe1, 0x97, 0x57, 0xe9, 0xa1, 0xe7, 0xaf, 0xd2, 0x43, 0x7f, 0xbe, 0x4a,
-	0x0f, 0x7d, 0x79, 0x82, 0xe7, 0x40, 0x27, 0xfa, 0x21, 0x82, 0xdd, 0xb8, 0xb5, 0x31, 0xf6, 0x05,
-	0xe2, 0xd6, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x58, 0x6d, 0xbc, 0x82, 0x97, 0x11, 0x00, 0x00,
+	// 1458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x36, 0x2d, 0xdb, 0xbf, 0x9f, 0x47, 0x96, 0xec, 0xd0, 0x8e, 0x4d, 0xb3, 0xb1, 0x64, 0x2b,
+	0x89, 0xe3, 0xfc, 0xb1, 0x14, 0xc7, 0x69, 0x9a, 0xda, 0x28, 0xda, 0xc8, 0x76, 0x90, 0xa0, 0x51,
+	0x23, 0x50, 0x72, 0x0a, 0x14, 0x28, 0x04, 0x8a, 0x5c, 0x51, 0x84, 0x24, 0x92, 0xe5, 0x52, 0x8a,
+	0x8c, 0x02, 0x45, 0x51, 0xf4, 0x54, 0xa0, 0x40, 0x4f, 0x3d, 0x14, 0x7d, 0x83, 0x5e, 0x72, 0xc8,
+	0x43, 0xe4, 0x18, 0x04, 0x3d, 0x14, 0x3e, 0x18, 0x45, 0x72, 0xc8, 0x33, 0x14, 0x28, 0xd0, 0x82,
+	0x4b, 0x72, 0x49, 0x29, 0xa4, 0x6d, 0x45, 0xe9, 0x4d, 0xbb, 0xfb, 0xcd, 0x37, 0xb3, 0xdf, 0xce,
+	0xcc, 0x2e, 0x05, 0xa9, 0xaa, 0x58, 0x3d, 0x68, 0xea, 0x5a, 0xae, 0x6a, 0x49, 0xd8, 0x12, 0x1b,
+	0xaa, 0xa6, 0xe4, 0x3a, 0x1b, 0x39, 0xab, 0x9b, 0x35, 0x4c, 0xdd, 0xd2, 0xd9, 0xb3, 0xee, 0x7a,
+	0xd6, 0x5f, 0xcf, 0x76, 0x36, 0xf8, 0x39, 0x45, 0x57, 0x74, 0x82, 0xc8, 0xd9, 0xbf, 0x1c, 0x30,
+	0xbf, 0x28, 0xe9, 0xb8, 0xa5, 0xe3, 0x8a, 0xb3, 0xe0, 0x0c, 0xdc, 0xa5, 0x05, 0x67, 0x94, 0x6b,
+	0x61, 0xc2, 0xdf, 0xc2, 0x8a, 0xbb, 0x90, 0x09, 0x0f, 0xc0, 0x10, 0x4d, 0xb1, 0xe5, 0x19, 0x5f,
+	0x70, 0x8d, 0xfd, 0xf5, 0x2a, 0xb2, 0xc4, 0x0d, 0x6f, 0xec, 0xa2, 0xd2, 0x11, 0x4c, 0xba, 0xe1,
+	0x02, 0x56, 0xc3, 0x01, 0xfe, 0xc8, 0xc1, 0x65, 0x5e, 0xc4, 0x60, 0xb1, 0x80, 0x95, 0x1d, 0x13,
+	0x89, 0x16, 0xba, 0xab, 0x6a, 0x62, 0x53, 0xb5, 0x0e, 0x8a, 0xa6, 0xde, 0x51, 0x65, 0x64, 0xb2,
+	0xd7, 0x60, 0x4c, 0x94, 0x65, 0x93, 0x63, 0x96, 0x99, 0xb5, 0xc9, 0x3c, 0xf7, 0xe2, 0xe9, 0xfa,
+	0x9c, 0xbb, 0xd3, 0x3b, 0xb2, 0x6c, 0x22, 0x8c, 0x4b, 0x96, 0xa9, 0x6a, 0x8a, 0x40, 0x50, 0xec,
+	0x1e, 0xc4, 0x65, 0x84, 0x25, 0x53, 0x35, 0x2c, 0x55, 0xd7, 0xb8, 0xd1, 0x65, 0x66, 0x2d, 0x7e,
+	0xe3, 0x7c, 0xd6, 0xb5, 0xf0, 0x15, 0x25, 0x1b, 0xca, 0xee, 0xfa, 0x50, 0x21, 0x68, 0xc7, 0x16,
+	0x00, 0x24, 0xbd, 0xd5, 0x52, 0x31, 0xb6, 0x59, 0x62, 0xc4, 0xf5, 0xfa, 0xe1, 0x51, 0xfa, 0x3d,
+	0x87, 0x08, 0xcb, 0x8d, 0xac, 0xaa, 0xe7, 0x5a, 0xa2, 0x55, 0xcf, 0x3e, 0x40, 0x8a, 0x28, 0x1d,
+	0xec, 0x22, 0xe9, 0xc5, 0xd3, 0x75, 0x70, 0xfd, 0xec, 0x22, 0x49, 0x08, 0x10, 0xb0, 0x0f, 0x61,
+	0xa2, 0x6a, 0x49, 0x15, 0xa3, 0xc1, 0x8d, 0x2d, 0x33, 0x6b, 0x53, 0xf9, 0xdb, 0x87, 0x47, 0xe9,
+	0x9b, 0x8a, 0x6a, 0xd5, 0xdb, 0xd5, 0xac, 0xa4, 0xb7, 0x72, 0xae, 0x50, 0x4d, 0xb1, 0x8a, 0xd7,
+	0x55, 0xdd, 0x1b, 0xe6, 0xac, 0x03, 0x03, 0xe1, 0x6c, 0xfe, 0x7e, 0x71, 0xf3, 0xe6, 0xf5, 0x62,
+	0xbb, 0xfa, 0x29, 0x3a, 0x10, 0xc6, 0xab, 0x96, 0x54, 0x6c, 0xb0, 0x1f, 0x41, 0xcc, 0xd0, 0x0d,
+	0x6e, 0x9c, 0x6c, 0xef, 0x6a, 0x36, 0x34, 0x69, 0xb2, 0x45, 0x53, 0xd7, 0x6b, 0x0f, 0x6b, 0x45,
+	0x1d, 0x63, 0x44, 0xe2, 0xc8, 0x97, 0x77, 0x04, 0xdb, 0x8e, 0x7d, 0x04, 0xd3, 0x18, 0x35, 0x6b,
+	0x15, 0x19, 0x35, 0x91, 0x22, 0x12, 0xa5, 0x26, 0x08, 0xd5, 0x7a, 0x04, 0x15, 0x3d, 0x9e, 0x7c,
+	0x79, 0x67, 0x97, 0x1a, 0x09, 0x49, 0x9b, 0xc5, 0x1f, 0x6f, 0x4d, 0x7e, 0xf7, 0xfa, 0xc9, 0x15,
+	0x72, 0x10, 0x99, 0xf3, 0xb0, 0x12, 0x79, 0xa6, 0x02, 0xc2, 0x86, 0xae, 0x61, 0x94, 0xf9, 0x87,
+	0x81, 0x85, 0x02, 0x56, 0xf6, 0x64, 0xd5, 0x1a, 0xf2, 0xdc, 0xcf, 0x52, 0x85, 0xed, 0x23, 0x9f,
+	0xf2, 0x74, 0xea, 0x4b, 0x87, 0xd8, 0x3b, 0x49, 0x87, 0xb1, 0x21, 0xd3, 0x21, 0x28, 0xd3, 0x0a,
+	0xa4, 0x23, 0x04, 0xa0, 0x22, 0x35, 0x9c, 0xea, 0x10, 0x35, 0x09, 0x35, 0xff, 0x13, 0x95, 0x42,
+	0x8e, 0x2d, 0xd4, 0x19, 0x8d, 0xe8, 0xb7, 0x49, 0x98, 0x0f, 0xcf, 0x08, 0xf6, 0x43, 0x88, 0xdb,
+	0xaa, 0x22, 0xb3, 0x72, 0xaa, 0xb0, 0xc0, 0x01, 0xdb, 0x93, 0x5e, 0x4e, 0x8f, 0xbe, 0x65, 0x4e,
+	0xfb, 0x35, 0x16, 0x7b, 0x37, 0x35, 0xf6, 0x25, 0x24, 0x6b, 0x46, 0xc5, 0xe1, 0xac, 0x34, 0x55,
+	0x6c, 0x71, 0x63, 0xcb, 0xb1, 0xa1, 0x88, 0xe3, 0x35, 0x23, 0x6f, 0x53, 0x3f, 0x50, 0xb1, 0xc5,
+	0xae, 0xc0, 0x94, 0xbb, 0xaf, 0x8a, 0xa5, 0xb6, 0x10, 0xa9, 0xe5, 0x84, 0x10, 0x77, 0xe7, 0xca,
+	0x6a, 0x0b, 0xb1, 0xe7, 0x21, 0xe1, 0x41, 0x3a, 0x62, 0xb3, 0x8d, 0x48, 0x91, 0xc6, 0x04, 0xcf,
+	0xee, 0x91, 0x3d, 0xc7, 0x2e, 0x01, 0x50, 0x9e, 0x2e, 0xf7, 0x3f, 0x72, 0xae, 0x93, 0x1e, 0x4b,
+	0x97, 0xad, 0x02, 0xef, 0x2f, 0x57, 0x54, 0x4d, 0x6a, 0xb6, 0x6d, 0xd9, 0xec, 0x3b, 0x43, 0xaf,
+	0x71, 0xff, 0x27, 0x62, 0x5f, 0x8c, 0x10, 0xfb, 0xbe, 0x87, 0x26, 0xaa, 0x0b, 0x0b, 0x94, 0xb5,
+	0x77, 0x81, 0xbd, 0x01, 0x71, 0xdc, 0x14, 0x71, 0xdd, 0x8d, 0x61, 0x92, 0xe8, 0x7f, 0xe6, 0xf0,
+	0x28, 0x9d, 0xc8, 0x97, 0x77, 0x4a, 0xee, 0x4a, 0xb9, 0x2b, 0x00, 0xa6, 0xbf, 0xd9, 0xaf, 0x60,
+	0xde, 0xed, 0x3e, 0xba, 0x59, 0xa1, 0xd6, 0x58, 0x55, 0x38, 0x20, 0xe6, 0xdb, 0x87, 0x47, 0xe9,
+	0x0f, 0x06, 0x53, 0xb9, 0xa4, 0x2a, 0x9a, 0x68, 0xb5, 0x4d, 0x24, 0xcc, 0x51, 0x6a, 0xcf, 0x7b,
+	0x49, 0x55, 0xd8, 0x8b, 0x90, 0x6c, 0x6b, 0x55, 0x5d, 0x93, 0xa9, 0xe6, 0x71, 0xa2, 0x79, 0x82,
+	0xce, 0x12, 0xd5, 0x57, 0x60, 0x2a, 0x00, 0xeb, 0x72, 0x53, 0x44, 0xd2, 0xb8, 0x0f, 0xea, 0xb2,
+	0x97, 0x60, 0xda, 0x87, 0x38, 0x47, 0x93, 0x20, 0x47, 0xe3, 0x3b, 0x70, 0x0e, 0x67, 0x0f, 0xce,
+	0xfa, 0xc0, 0xa0, 0x46, 0xc9, 0x28, 0x8d, 0x66, 0x29, 0xde, 0x9f, 0x64, 0xbf, 0x67, 0x60, 0xd9,
+	0x57, 0x2b, 0x84, 0xd1, 0xd6, 0x6d, 0x7a, 0x78, 0xdd, 0x96, 0xa8, 0x93, 0xfd, 0xfe, 0x28, 0x6c,
+	0x01, 0x3f, 0x86, 0xa4, 0x89, 0x1e, 0x8b, 0xa6, 0x4c, 0x8a, 0x1b, 0x61, 0xcc, 0xcd, 0x9c, 0x50,
+	0xdf, 0x09, 0x07, 0xef, 0x4e, 0xb2, 0xd7, 0x80, 0x0d, 0x24, 0xe3, 0x63, 0xd5, 0xd2, 0x6c, 0x92,
+	0x33, 0x76, 0x59, 0x09, 0x33, 0x34, 0xbb, 0x3e, 0x77, 0xe6, 0xd9, 0x4d, 0x98, 0x37, 0x51, 0xad,
+	0xad, 0xc9, 0x95, 0x80, 0x51, 0x5d, 0xc4, 0x75, 0x8e, 0xb5, 0xdd, 0x0a, 0xb3, 0xce, 0x6a, 0xc9,
+	0xb3, 0xbb, 0x27, 0xe2, 0xfa, 0xd6, 0x8c, 0xdd, 0xcb, 0x82, 0x3d, 0x28, 0xb3, 0x0c, 0xa9, 0x88,
+	0xeb, 0xcb, 0xeb, 0x67, 0xbf, 0x33, 0xa4, 0xeb, 0xdd, 0x91, 0xe5, 0x9e, 0xf5, 0xbe, 0x2c, 0x9f,
+	0x87, 0x09, 0xac, 0x2a, 0x1a, 0x72, 0xbb, 0x9a, 0xe0, 0x8e, 0xd8, 0x55, 0x98, 0xee, 0x8f, 0x6f,
+	0x94, 0x00, 0x12, 0x38, 0x18, 0xd9, 0x09, 0x95, 0x18, 0x7b, 0x17, 0x95, 0xb8, 0x15, 0xb7, 0x77,
+	0xef, 0x06, 0x96, 0xb9, 0x0a, 0x97, 0x4f, 0xdc, 0x15, 0xd5, 0xe0, 0xaf, 0x51, 0x60, 0x1d, 0xf4,
+	0x8e, 0xde, 0x41, 0x9a, 0xa8, 0x59, 0x25, 0x55, 0xc1, 0x91, 0x9b, 0xbe, 0x07, 0xa3, 0xde, 0x2d,
+	0x32, 0x44, 0x43, 0x1c, 0x35, 0x1a, 0x61, 0xf2, 0xc5, 0xc2, 0xe4, 0x5b, 0x83, 0x99, 0x40, 0x01,
+	0xd9, 0x19, 0x8f, 0x9d, 0x86, 0x2c, 0x24, 0xfd, 0xb6, 0x42, 0x62, 0x46, 0x30, 0x13, 0x2c, 0x60,
+	0x52, 0x1c, 0xe3, 0xc3, 0x17, 0x47, 0x32, 0xd0, 0x01, 0xec, 0x6a, 0xd8, 0x06, 0x9e, 0x06, 0xd4,
+	0xef, 0x0f, 0x73, 0x13, 0x24, 0xb4, 0x05, 0x0f, 0xb1, 0xdf, 0x63, 0x8b, 0x7b, 0x0f, 0xea, 0x1c,
+	0xf0, 0x6f, 0x4a, 0x4f, 0x4f, 0xe6, 0x6f, 0x06, 0x66, 0x0a, 0x58, 0xc9, 0x97, 0x77, 0xf6, 0x35,
+	0xb7, 0x3e, 0xd1, 0xd0, 0xc9, 0x78, 0x05, 0xce, 0xd8, 0x13, 0xa8, 0x82, 0x0d, 0x44, 0x3b, 0x1d,
+	0xb9, 0x38, 0x05, 0x42, 0x80, 0x4a, 0xee, 0x7c, 0xb9, 0xcb, 0xea, 0xb0, 0xf2, 0x06, 0xf6, 0x8d,
+	0xfc, 0x1d, 0x1b, 0x24, 0x7f, 0x97, 0xfa, 0x5c, 0x1c, 0x97, 0xc5, 0x3c, 0x70, 0xfd, 0xbb, 0xa7,
+	0xd2, 0xfc, 0xc2, 0xc0, 0xb9, 0x02, 0x56, 0x4a, 0xa8, 0x89, 0x24, 0x4b, 0xed, 0x20, 0xaf, 0x59,
+	0xed, 0xd9, 0xef, 0x15, 0x4d, 0x1a, 0x5e, 0xa6, 0x75, 0x98, 0x35, 0x91, 0xa4, 0x77, 0x90, 0x89,
+	0xe4, 0x8a, 0xfb, 0x1a, 0xc0, 0xee, 0x0b, 0x43, 0x98, 0xa1, 0x4b, 0x77, 0xed, 0x7b, 0xbd, 0xd4,
+	0xe8, 0x0d, 0x7c, 0x15, 0x2e, 0x1c, 0x17, 0x1b, 0xdd, 0xc4, 0xcf, 0x0c, 0x4c, 0x17, 0xb0, 0xb2,
+	0x6f, 0xc8, 0xa2, 0x85, 0x8a, 0xe4, 0x3b, 0x8c, 0xbd, 0x05, 0x93, 0x62, 0xdb, 0xaa, 0xeb, 0xa6,
+	0x6a, 0x1d, 0x9c, 0xf8, 0x88, 0xf2, 0xa1, 0xec, 0x36, 0x4c, 0x38, 0x5f, 0x72, 0xee, 0x33, 0x6a,
+	0x29, 0xea, 0x19, 0x45, 0x40, 0xf9, 0xb1, 0x67, 0x47, 0xe9, 0x11, 0xc1, 0x35, 0xd9, 0x4a, 0xda,
+	0xd1, 0xfb, 0x64, 0x99, 0x45, 0x58, 0xe8, 0x8b, 0xcb, 0x8b, 0xf9, 0xc6, 0x0f, 0x93, 0x10, 0x2b,
+	0x60, 0xc5, 0xbe, 0x98, 0xe6, 0x23, 0xbe, 0xdb, 0xae, 0x9f, 0xf4, 0x29, 0xd1, 0x6f, 0xc1, 0xdf,
+	0x1e, 0xd4, 0xc2, 0x0b, 0x87, 0xfd, 0x06, 0xe6, 0x42, 0xbf, 0x21, 0xb2, 0xd1, 0x8c, 0x61, 0x78,
+	0xfe, 0xd6, 0x60, 0x78, 0xea, 0x9f, 0xc8, 0x10, 0xfe, 0x40, 0x3f, 0x4e, 0x86, 0x50, 0x0b, 0xfe,
+	0xf6, 0xa0, 0x16, 0x34, 0x8c, 0xaf, 0x61, 0x36, 0xec, 0x4d, 0x3e, 0xd8, 0x47, 0x1d, 0xff, 0xfe,
+	0x40, 0x70, 0xea, 0xfc, 0x57, 0x06, 0x52, 0x27, 0xdc, 0xa0, 0xc7, 0xec, 0xec, 0x78, 0x4b, 0xfe,
+	0x93, 0xb7, 0xb5, 0xa4, 0xe1, 0xe9, 0x30, 0xdd, 0x7f, 0xb7, 0x5d, 0x3e, 0x96, 0x34, 0x08, 0xe5,
+	0x37, 0x4e, 0x0d, 0xa5, 0x0e, 0x55, 0x48, 0xf4, 0xb6, 0xec, 0x4b, 0xd1, 0x1c, 0x3d, 0x40, 0x3e,
+	0x77, 0x4a, 0x20, 0x75, 0xf5, 0x23, 0x03, 0x8b, 0xd1, 0x3d, 0x70, 0x33, 0x9a, 0x2e, 0xd2, 0x88,
+	0xdf, 0x7e, 0x0b, 0x23, 0x1a, 0x4f, 0x0d, 0xa6, 0x7a, 0xba, 0xd9, 0x6a, 0x34, 0x59, 0x10, 0xc7,
+	0x67, 0x4f, 0x87, 0xf3, 0xfc, 0xf0, 0xe3, 0xdf, 0xbe, 0x7e, 0x72, 0x85, 0xc9, 0x7f, 0xf6, 0xec,
+	0x65, 0x8a, 0x79, 0xfe, 0x32, 0xc5, 0xfc, 0xf9, 0x32, 0xc5, 0xfc, 0xf4, 0x2a, 0x35, 0xf2, 0xfc,
+	0x55, 0x6a, 0xe4, 0x8f, 0x57, 0xa9, 0x91, 0x2f, 0x4e, 0xf1, 0x2a, 0xe9, 0x06, 0xff, 0x9d, 0x22,
+	0x17, 0x7f, 0x75, 0x82, 0xfc, 0x2d, 0xb5, 0xf9, 0xef, 0x00, 0xcb, 0xc5, 0xf7, 0x80, 0xac, 0x13,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1089,6 +1242,9 @@ type MsgClient interface {
 	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
 	// EditFinalityProvider edits an existing finality provider
 	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
+	// CancelFinalityProvider deletes a finality provider that has not received
+	// any BTC delegation yet
+	CancelFinalityProvider(ctx context.Context, in *MsgCancelFinalityProvider, opts ...grpc.CallOption) (*MsgCancelFinalityProviderResponse, error)
 	// CreateBTCDelegation creates a new BTC delegation
 	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
 	// AddBTCDelegationInclusionProof adds inclusion proof of a given delegation on BTC chain
@@ -1130,6 +1286,15 @@ func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalit
 	return out, nil
 }
 
+func (c *msgClient) CancelFinalityProvider(ctx context.Context, in *MsgCancelFinalityProvider, opts ...grpc.CallOption) (*MsgCancelFinalityProviderResponse, error) {
+	out := new(MsgCancelFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CancelFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
 	out := new(MsgCreateBTCDelegationResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
@@ -1190,6 +1355,9 @@ type MsgServer interface {
 	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
 	// EditFinalityProvider edits an existing finality provider
 	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
+	// CancelFinalityProvider deletes a finality provider that has not received
+	// any BTC delegation yet
+	CancelFinalityProvider(context.Context, *MsgCancelFinalityProvider) (*MsgCancelFinalityProviderResponse, error)
 	// CreateBTCDelegation creates a new BTC delegation
 	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
 	// AddBTCDelegationInclusionProof adds inclusion proof of a given delegation on BTC chain
@@ -1215,6 +1383,9 @@ func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *
 func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
 }
+func (*UnimplementedMsgServer) CancelFinalityProvider(ctx context.Context, req *MsgCancelFinalityProvider) (*MsgCancelFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFinalityProvider not implemented")
+}
 func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
 }
@@ -1274,6 +1445,24 @@ func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CancelFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFinalityProvider(ctx, req.(*MsgCancelFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateBTCDelegation)
 	if err := dec(in); err != nil {
@@ -1394,6 +1583,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditFinalityProvider",
 			Handler:    _Msg_EditFinalityProvider_Handler,
 		},
+		{
+			MethodName: "CancelFinalityProvider",
+			Handler:    _Msg_CancelFinalityProvider_Handler,
+		},
 		{
 			MethodName: "CreateBTCDelegation",
 			Handler:    _Msg_CreateBTCDelegation_Handler,
@@ -1443,6 +1636,18 @@ func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.SelfDelegation != nil {
+		{
+			size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Pop != nil {
 		{
 			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
@@ -1608,6 +1813,66 @@ func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1628,6 +1893,35 @@ func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundStakingTxHash) > 0 {
+		i -= len(m.RefundStakingTxHash)
+		copy(dAtA[i:], m.RefundStakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundStakingTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.StakingTxWitness) > 0 {
+		for iNdEx := len(m.StakingTxWitness) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxWitness[iNdEx])
+			copy(dAtA[i:], m.StakingTxWitness[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxWitness[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.DelegatorUnbondingSlashingSig != nil {
 		{
 			size := m.DelegatorUnbondingSlashingSig.Size()
@@ -2213,6 +2507,10 @@ func (m *MsgCreateFinalityProvider) Size() (n int) {
 		l = m.Pop.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.SelfDelegation != nil {
+		l = m.SelfDelegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2259,6 +2557,32 @@ func (m *MsgEditFinalityProviderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcPk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateBTCDelegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2323,6 +2647,20 @@ func (m *MsgCreateBTCDelegation) Size() (n int) {
 		l = m.DelegatorUnbondingSlashingSig.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingTxWitness) > 0 {
+		for _, b := range m.StakingTxWitness {
+			l = len(b)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.RefundStakingTxHash)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2709,6 +3047,42 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelfDelegation == nil {
+				m.SelfDelegation = &MsgCreateBTCDelegation{}
+			}
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3018,6 +3392,172 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3505,6 +4045,102 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxWitness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxWitness = append(m.StakingTxWitness, make([]byte, postIndex-iNdEx))
+			copy(m.StakingTxWitness[len(m.StakingTxWitness)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundStakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
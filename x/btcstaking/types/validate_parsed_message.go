@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 
 	"github.com/babylonlabs-io/babylon/btcstaking"
 	bbn "github.com/babylonlabs-io/babylon/types"
@@ -17,6 +20,44 @@ type ParamsValidationResult struct {
 	MinUnbondingTime   uint32
 }
 
+// FindStakingOutputIdx builds the staking output expected for the given staker
+// key, finality provider keys, staking time and staking value under the given
+// parameters, and returns its staking info along with the index of the first
+// output of the staking tx matching it
+func FindStakingOutputIdx(
+	stakingTx *wire.MsgTx,
+	stakerPK *btcec.PublicKey,
+	fpPKs []*btcec.PublicKey,
+	stakingTime uint16,
+	stakingValue btcutil.Amount,
+	parameters *Params,
+	net *chaincfg.Params,
+) (*btcstaking.StakingInfo, uint32, error) {
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		stakerPK,
+		fpPKs,
+		parameters.MustGetCovenantPks(),
+		parameters.CovenantQuorum,
+		stakingTime,
+		stakingValue,
+		net,
+	)
+	if err != nil {
+		return nil, 0, ErrInvalidStakingTx.Wrapf("failed to build staking info: %v", err)
+	}
+
+	stakingOutputIdx, err := bbn.GetOutputIdxInBTCTx(stakingTx, stakingInfo.StakingOutput)
+	if err != nil {
+		return nil, 0, ErrInvalidStakingTx.Wrapf(
+			"staking tx does not contain expected staking output with pk script %x and value %d",
+			stakingInfo.StakingOutput.PkScript,
+			stakingInfo.StakingOutput.Value,
+		)
+	}
+
+	return stakingInfo, stakingOutputIdx, nil
+}
+
 // ValidateParsedMessageAgainstTheParams validates parsed message against parameters
 func ValidateParsedMessageAgainstTheParams(
 	pm *ParsedCreateDelegationMessage,
@@ -42,27 +83,39 @@ func ValidateParsedMessageAgainstTheParams(
 	// - that staking time and value are correct
 	// - slashing tx is relevant to staking tx
 	// - slashing tx signature is valid
-	stakingInfo, err := btcstaking.BuildStakingInfo(
+	stakingInfo, stakingOutputIdx, err := FindStakingOutputIdx(
+		pm.StakingTx.Transaction,
 		pm.StakerPK.PublicKey,
 		pm.FinalityProviderKeys.PublicKeys,
-		covenantPks,
-		parameters.CovenantQuorum,
 		pm.StakingTime,
 		pm.StakingValue,
+		parameters,
 		net,
 	)
 	if err != nil {
-		return nil, ErrInvalidStakingTx.Wrapf("failed to build staking info: %v", err)
+		return nil, err
 	}
 
-	stakingOutputIdx, err := bbn.GetOutputIdxInBTCTx(pm.StakingTx.Transaction, stakingInfo.StakingOutput)
+	if uint32(pm.StakingTime) < parameters.MinStakingTimeBlocks {
+		return nil, ErrStakingTimeTooShort.Wrapf(
+			"staking time %d is lower than the minimum staking time %d",
+			pm.StakingTime,
+			parameters.MinStakingTimeBlocks,
+		)
+	}
 
-	if err != nil {
-		return nil, ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
+	// the BTC delegation is unbonded `minUnbondingTime` BTC blocks before the
+	// end of its timelock, so the timelock has to outlast it. This also
+	// ensures that the timelock outlasts the checkpoint finalization timeout
+	if uint32(pm.StakingTime) <= minUnbondingTime {
+		return nil, ErrStakingTimeTooShort.Wrapf(
+			"staking time %d must be larger than the minimum unbonding time %d",
+			pm.StakingTime,
+			minUnbondingTime,
+		)
 	}
 
-	if uint32(pm.StakingTime) < parameters.MinStakingTimeBlocks ||
-		uint32(pm.StakingTime) > parameters.MaxStakingTimeBlocks {
+	if uint32(pm.StakingTime) > parameters.MaxStakingTimeBlocks {
 		return nil, ErrInvalidStakingTx.Wrapf(
 			"staking time %d is out of bounds. Min: %d, Max: %d",
 			pm.StakingTime,
@@ -81,13 +134,13 @@ func ValidateParsedMessageAgainstTheParams(
 		)
 	}
 
-	if err := btcstaking.CheckSlashingTxMatchFundingTx(
+	if err := btcstaking.CheckSlashingTxMatchFundingTxWithDestinations(
 		pm.StakingSlashingTx.Transaction,
 		pm.StakingTx.Transaction,
 		stakingOutputIdx,
 		parameters.MinSlashingTxFeeSat,
 		parameters.SlashingRate,
-		parameters.SlashingPkScript,
+		parameters.BTCSlashingDestinations(),
 		pm.StakerPK.PublicKey,
 		pm.UnbondingTime,
 		net,
@@ -110,6 +163,16 @@ func ValidateParsedMessageAgainstTheParams(
 		return nil, ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
 	}
 
+	if err := validateSlashingTxRelayFee(
+		pm.StakingSlashingTx.Transaction,
+		pm.StakingTx.Transaction.TxOut[stakingOutputIdx].Value,
+		slashingSpendInfo,
+		parameters,
+		len(pm.FinalityProviderKeys.PublicKeys),
+	); err != nil {
+		return nil, ErrInvalidSlashingTx.Wrap(err.Error())
+	}
+
 	// 3. Validate all data related to unbonding tx:
 	// - it is valid BTC pre-signed transaction
 	// - it has valid unbonding output
@@ -146,13 +209,13 @@ func ValidateParsedMessageAgainstTheParams(
 				unbondingInfo.UnbondingOutput.Value, unbondingTx.TxOut[0].Value)
 	}
 
-	err = btcstaking.CheckSlashingTxMatchFundingTx(
+	err = btcstaking.CheckSlashingTxMatchFundingTxWithDestinations(
 		pm.UnbondingSlashingTx.Transaction,
 		pm.UnbondingTx.Transaction,
 		0, // unbonding output always has only 1 output
 		parameters.MinSlashingTxFeeSat,
 		parameters.SlashingRate,
-		parameters.SlashingPkScript,
+		parameters.BTCSlashingDestinations(),
 		pm.StakerPK.PublicKey,
 		pm.UnbondingTime,
 		net,
@@ -176,13 +239,21 @@ func ValidateParsedMessageAgainstTheParams(
 		return nil, ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
 	}
 
-	// 4. Check that unbonding tx input is pointing to staking tx
-	if !pm.UnbondingTx.Transaction.TxIn[0].PreviousOutPoint.Hash.IsEqual(&stakingTxHash) {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding transaction must spend staking output")
+	if err := validateSlashingTxRelayFee(
+		pm.UnbondingSlashingTx.Transaction,
+		pm.UnbondingTx.Transaction.TxOut[0].Value,
+		unbondingSlashingSpendInfo,
+		parameters,
+		len(pm.FinalityProviderKeys.PublicKeys),
+	); err != nil {
+		return nil, ErrInvalidSlashingTx.Wrap(err.Error())
 	}
 
-	if pm.UnbondingTx.Transaction.TxIn[0].PreviousOutPoint.Index != stakingOutputIdx {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding transaction input must spend staking output")
+	// 4. Check that unbonding tx input is pointing to the staking output
+	stakingOutPoint := wire.NewOutPoint(&stakingTxHash, stakingOutputIdx)
+	if unbondingPrevOutPoint := pm.UnbondingTx.Transaction.TxIn[0].PreviousOutPoint; unbondingPrevOutPoint != *stakingOutPoint {
+		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding transaction must spend staking output %s, but it spends %s",
+			stakingOutPoint, unbondingPrevOutPoint)
 	}
 	// 5. Check unbonding tx fees against staking tx.
 	// - fee is larger than 0
@@ -209,3 +280,38 @@ func ValidateParsedMessageAgainstTheParams(
 		MinUnbondingTime:   minUnbondingTime,
 	}, nil
 }
+
+// validateSlashingTxRelayFee checks that the fee of the given slashing tx
+// covers its virtual size once fully witnessed through the slashing path at the
+// default min relay fee rate of BTC nodes, so that the slashing tx can be
+// relayed when needed. This complements the check against
+// `MinSlashingTxFeeSat`, which is independent of the size of the slashing tx.
+func validateSlashingTxRelayFee(
+	slashingTx *wire.MsgTx,
+	fundingOutputValue int64,
+	slashingSpendInfo *btcstaking.SpendInfo,
+	parameters *Params,
+	numFps int,
+) error {
+	vsize, err := btcstaking.EstimateSlashingPathTxVSize(
+		slashingTx,
+		slashingSpendInfo,
+		len(parameters.CovenantPks),
+		parameters.CovenantQuorum,
+		numFps,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to estimate the slashing tx vsize: %w", err)
+	}
+
+	slashingTxOutSum := int64(0)
+	for _, out := range slashingTx.TxOut {
+		slashingTxOutSum += out.Value
+	}
+	fee := fundingOutputValue - slashingTxOutSum
+	if minFee := btcstaking.MinRelayFeeForVSize(vsize); fee < minFee {
+		return fmt.Errorf("slashing tx fee %d is below %d, the min relay fee for its estimated vsize %d", fee, minFee, vsize)
+	}
+
+	return nil
+}
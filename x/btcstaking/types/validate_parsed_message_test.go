@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"bytes"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -369,7 +371,20 @@ func TestValidateParsedMessageAgainstTheParams(t *testing.T) {
 
 				return msg, params, checkpointParams
 			},
-			err: types.ErrInvalidStakingTx,
+			err: types.ErrStakingTimeTooShort,
+		},
+		{
+			name: "Msg.StakingTime is not larger than the minimum unbonding time",
+			fn: func(r *rand.Rand, t *testing.T) (*types.MsgCreateBTCDelegation, *types.Params, *btcckpttypes.Params) {
+				params := testStakingParams(r, t)
+				checkpointParams := testCheckpointParams()
+				// the checkpoint finalization timeout exceeds any valid staking time
+				checkpointParams.CheckpointFinalizationTimeout = params.MaxStakingTimeBlocks
+				msg, _ := createMsgDelegationForParams(r, t, params, checkpointParams)
+
+				return msg, params, checkpointParams
+			},
+			err: types.ErrStakingTimeTooShort,
 		},
 		{
 			name: "Msg.StakingTime is higher than params.MinStakingTimeBlocks",
@@ -559,6 +574,60 @@ func TestValidateParsedMessageAgainstTheParams(t *testing.T) {
 			},
 			err: types.ErrInvalidSlashingTx,
 		},
+		{
+			name: "Msg.SlashingTx fee does not cover the min relay fee",
+			fn: func(r *rand.Rand, t *testing.T) (*types.MsgCreateBTCDelegation, *types.Params, *btcckpttypes.Params) {
+				params := testStakingParams(r, t)
+				checkpointParams := testCheckpointParams()
+				msg, delSK := createMsgDelegationForParams(r, t, params, checkpointParams)
+
+				// lower the slashing tx fee below its min relay fee while keeping
+				// it above params.MinSlashingTxFeeSat
+				params.MinSlashingTxFeeSat = 1
+				stakingTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx)
+				require.NoError(t, err)
+				currentSlashingTx, err := bbn.NewBTCTxFromBytes(*msg.SlashingTx)
+				require.NoError(t, err)
+				slashingTxOutSum := int64(0)
+				for _, out := range currentSlashingTx.TxOut {
+					slashingTxOutSum += out.Value
+				}
+				// change output is always the second output
+				currentSlashingTx.TxOut[1].Value += stakingTx.TxOut[0].Value - slashingTxOutSum - 10
+
+				serializedNewSlashingTx, err := bbn.SerializeBTCTx(currentSlashingTx)
+				require.NoError(t, err)
+				msg.SlashingTx = types.NewBtcSlashingTxFromBytes(serializedNewSlashingTx)
+
+				// re-sign the slashing tx so that only the fee is invalid
+				covPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+				require.NoError(t, err)
+				fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(msg.FpBtcPkList)
+				require.NoError(t, err)
+				stakingInfo, err := btcstaking.BuildStakingInfo(
+					delSK.PubKey(),
+					fpPKs,
+					covPKs,
+					params.CovenantQuorum,
+					uint16(msg.StakingTime),
+					btcutil.Amount(msg.StakingValue),
+					&chaincfg.MainNetParams,
+				)
+				require.NoError(t, err)
+				slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+				require.NoError(t, err)
+				msg.DelegatorSlashingSig, err = msg.SlashingTx.Sign(
+					stakingTx,
+					0,
+					slashingSpendInfo.GetPkScriptPath(),
+					delSK,
+				)
+				require.NoError(t, err)
+
+				return msg, params, checkpointParams
+			},
+			err: types.ErrInvalidSlashingTx,
+		},
 		{
 			name: "Msg.UnbondingSlashingTx does not point to unbonding tx hash",
 			fn: func(r *rand.Rand, t *testing.T) (*types.MsgCreateBTCDelegation, *types.Params, *btcckpttypes.Params) {
@@ -724,6 +793,34 @@ func TestValidateParsedMessageAgainstTheParams(t *testing.T) {
 			},
 			err: types.ErrInvalidUnbondingTx,
 		},
+		{
+			name: "Msg.UnbondingTx points to an unrelated outpoint",
+			fn: func(r *rand.Rand, t *testing.T) (*types.MsgCreateBTCDelegation, *types.Params, *btcckpttypes.Params) {
+				params := testStakingParams(r, t)
+				checkpointParams := testCheckpointParams()
+				msg, delSk := createMsgDelegationForParams(r, t, params, checkpointParams)
+
+				// generate unbonding info spending a random outpoint
+				newUnbondingInfdo := generateUnbondingInfo(
+					r,
+					t,
+					delSk,
+					msg.FpBtcPkList[0].MustToBTCPK(),
+					datagen.GenRandomBtcdHash(r),
+					uint32(datagen.RandomInt(r, 10)),
+					uint16(msg.UnbondingTime),
+					msg.UnbondingValue,
+					params,
+				)
+
+				msg.UnbondingTx = newUnbondingInfdo.serializedUnbondingTx
+				msg.UnbondingSlashingTx = newUnbondingInfdo.unbondingSlashingTx
+				msg.DelegatorUnbondingSlashingSig = newUnbondingInfdo.unbondingSlashinSig
+
+				return msg, params, checkpointParams
+			},
+			err: types.ErrInvalidUnbondingTx,
+		},
 		{
 			name: "Msg.UnbondingTx does not have required fee",
 			fn: func(r *rand.Rand, t *testing.T) (*types.MsgCreateBTCDelegation, *types.Params, *btcckpttypes.Params) {
@@ -844,3 +941,238 @@ func TestValidateParsedMessageAgainstTheParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCreateDelegationMessageRewardAddress(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	params := testStakingParams(r, t)
+	checkpointParams := testCheckpointParams()
+
+	// reward address defaults to the staker address
+	msg, _ := createMsgDelegationForParams(r, t, params, checkpointParams)
+	parsed, err := types.ParseCreateDelegationMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, parsed.StakerAddress, parsed.RewardAddress)
+
+	// reward address distinct from the staker address
+	rewardAddr := datagen.GenRandomAccount().Address
+	msg.RewardAddress = rewardAddr
+	parsed, err = types.ParseCreateDelegationMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, rewardAddr, parsed.RewardAddress.String())
+	require.NotEqual(t, parsed.StakerAddress, parsed.RewardAddress)
+
+	// invalid reward address
+	msg.RewardAddress = "invalid"
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestParseCreateDelegationMessageMalformedFields(t *testing.T) {
+	invalidSlashingTx := types.BTCSlashingTx([]byte("invalid"))
+	invalidSig := bbn.BIP340Signature([]byte("invalid"))
+	invalidPK := bbn.BIP340PubKey([]byte("invalid"))
+
+	tests := []struct {
+		name   string
+		mutate func(msg *types.MsgCreateBTCDelegation)
+		field  string
+		err    error
+	}{
+		{
+			name:   "malformed staker address",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.StakerAddr = "invalid" },
+			field:  types.FieldStakerAddr,
+		},
+		{
+			name:   "malformed reward address",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.RewardAddress = "invalid" },
+			field:  types.FieldRewardAddress,
+		},
+		{
+			name:   "empty proof of possession",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.Pop = nil },
+			field:  types.FieldPop,
+		},
+		{
+			name: "malformed proof of possession",
+			mutate: func(msg *types.MsgCreateBTCDelegation) {
+				msg.Pop = &types.ProofOfPossessionBTC{BtcSigType: types.BTCSigType_BIP340, BtcSig: []byte("invalid")}
+			},
+			field: types.FieldPop,
+		},
+		{
+			name:   "malformed staker public key",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.BtcPk = &invalidPK },
+			field:  types.FieldBtcPk,
+		},
+		{
+			name:   "empty finality provider public keys",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.FpBtcPkList = nil },
+			field:  types.FieldFpBtcPkList,
+		},
+		{
+			name: "duplicated finality provider public keys",
+			mutate: func(msg *types.MsgCreateBTCDelegation) {
+				msg.FpBtcPkList = append(msg.FpBtcPkList, msg.FpBtcPkList[0])
+			},
+			field: types.FieldFpBtcPkList,
+			err:   types.ErrDuplicatedFp,
+		},
+		{
+			name:   "too large staking time",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.StakingTime = math.MaxUint16 + 1 },
+			field:  types.FieldStakingTime,
+		},
+		{
+			name:   "negative staking value",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.StakingValue = -1 },
+			field:  types.FieldStakingValue,
+		},
+		{
+			name:   "malformed staking tx",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.StakingTx = []byte("invalid") },
+			field:  types.FieldStakingTx,
+		},
+		{
+			name: "staking tx witness not matching the number of inputs",
+			mutate: func(msg *types.MsgCreateBTCDelegation) {
+				msg.StakingTxWitness = [][]byte{{0x00}, {0x00}, {0x00}}
+			},
+			field: types.FieldStakingTxWitness,
+		},
+		{
+			name: "malformed staking tx witness",
+			mutate: func(msg *types.MsgCreateBTCDelegation) {
+				msg.StakingTxWitness = [][]byte{{0x02, 0x01}}
+			},
+			field: types.FieldStakingTxWitness,
+		},
+		{
+			name: "malformed staking tx inclusion proof",
+			mutate: func(msg *types.MsgCreateBTCDelegation) {
+				msg.StakingTxInclusionProof = &types.InclusionProof{}
+			},
+			field: types.FieldStakingTxInclusionProof,
+		},
+		{
+			name:   "malformed slashing tx",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.SlashingTx = &invalidSlashingTx },
+			field:  types.FieldSlashingTx,
+		},
+		{
+			name:   "malformed delegator slashing signature",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.DelegatorSlashingSig = &invalidSig },
+			field:  types.FieldDelegatorSlashingSig,
+		},
+		{
+			name:   "too large unbonding time",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.UnbondingTime = math.MaxUint16 + 1 },
+			field:  types.FieldUnbondingTime,
+		},
+		{
+			name:   "malformed unbonding tx",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.UnbondingTx = []byte("invalid") },
+			field:  types.FieldUnbondingTx,
+		},
+		{
+			name:   "negative unbonding value",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.UnbondingValue = -1 },
+			field:  types.FieldUnbondingValue,
+		},
+		{
+			name:   "malformed unbonding slashing tx",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.UnbondingSlashingTx = &invalidSlashingTx },
+			field:  types.FieldUnbondingSlashingTx,
+		},
+		{
+			name:   "malformed delegator unbonding slashing signature",
+			mutate: func(msg *types.MsgCreateBTCDelegation) { msg.DelegatorUnbondingSlashingSig = &invalidSig },
+			field:  types.FieldDelegatorUnbondingSlashingSig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(time.Now().Unix()))
+			params := testStakingParams(r, t)
+			checkpointParams := testCheckpointParams()
+
+			msg, _ := createMsgDelegationForParams(r, t, params, checkpointParams)
+			tt.mutate(msg)
+
+			_, err := types.ParseCreateDelegationMessage(msg)
+			require.Error(t, err)
+
+			var fieldErr *types.ParseFieldError
+			require.ErrorAs(t, err, &fieldErr)
+			require.Equal(t, tt.field, fieldErr.Field)
+			require.Contains(t, err.Error(), tt.field)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseCreateDelegationMessageStakingTxWitness(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	params := testStakingParams(r, t)
+	checkpointParams := testCheckpointParams()
+
+	msg, _ := createMsgDelegationForParams(r, t, params, checkpointParams)
+	strippedTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx)
+	require.NoError(t, err)
+	require.False(t, strippedTx.HasWitness())
+	require.Len(t, strippedTx.TxIn, 1)
+
+	// the full staking tx carries the witness
+	txWitness := wire.TxWitness{datagen.GenRandomByteArray(r, 64), datagen.GenRandomByteArray(r, 33)}
+	fullTx := strippedTx.Copy()
+	fullTx.TxIn[0].Witness = txWitness
+	fullTxBytes, err := bbn.SerializeBTCTx(fullTx)
+	require.NoError(t, err)
+
+	var witnessBuf bytes.Buffer
+	require.NoError(t, wire.WriteVarInt(&witnessBuf, 0, uint64(len(txWitness))))
+	for _, item := range txWitness {
+		require.NoError(t, wire.WriteVarBytes(&witnessBuf, 0, item))
+	}
+
+	// the stripped staking tx with the witness is normalized into the full one
+	msg.StakingTxWitness = [][]byte{witnessBuf.Bytes()}
+	parsed, err := types.ParseCreateDelegationMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, fullTxBytes, parsed.StakingTx.TransactionBytes)
+	require.Equal(t, strippedTx.TxHash(), parsed.StakingTx.Transaction.TxHash())
+	require.Equal(t, txWitness, parsed.StakingTx.Transaction.TxIn[0].Witness)
+
+	// the full staking tx without separate witness is parsed as is
+	msg.StakingTx = fullTxBytes
+	msg.StakingTxWitness = nil
+	parsed, err = types.ParseCreateDelegationMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, fullTxBytes, parsed.StakingTx.TransactionBytes)
+
+	// the full staking tx cannot be submitted with a separate witness
+	msg.StakingTxWitness = [][]byte{witnessBuf.Bytes()}
+	_, err = types.ParseCreateDelegationMessage(msg)
+	var fieldErr *types.ParseFieldError
+	require.ErrorAs(t, err, &fieldErr)
+	require.Equal(t, types.FieldStakingTxWitness, fieldErr.Field)
+
+	// a stripped staking tx whose txid does not match the one spent by the
+	// unbonding tx is rejected
+	mismatchingTx := strippedTx.Copy()
+	mismatchingTx.LockTime++
+	mismatchingTxBytes, err := bbn.SerializeBTCTx(mismatchingTx)
+	require.NoError(t, err)
+	msg.StakingTx = mismatchingTxBytes
+	_, err = types.ParseCreateDelegationMessage(msg)
+	require.ErrorAs(t, err, &fieldErr)
+	require.Equal(t, types.FieldStakingTxWitness, fieldErr.Field)
+
+	expectedTxHash := strippedTx.TxHash()
+	_, err = types.NewBtcTransactionWithWitness(mismatchingTxBytes, msg.StakingTxWitness, &expectedTxHash)
+	require.ErrorContains(t, err, "does not match expected txid")
+	_, err = types.NewBtcTransactionWithWitness(msg.StakingTx, msg.StakingTxWitness, nil)
+	require.NoError(t, err)
+}
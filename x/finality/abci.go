@@ -41,5 +41,8 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		}
 	}
 
+	// prune the logged power distribution update events beyond the retention window
+	k.PrunePowerDistEventLog(ctx)
+
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -41,6 +41,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdListEvidences(),
 		CmdSigningInfo(),
 		CmdAllSigningInfo(),
+		CmdProjectedRewards(),
+		CmdVotingPowerDistributionAt(),
+		CmdFinalityProviderSlashableAmount(),
 	)
 
 	return cmd
@@ -408,3 +411,92 @@ func CmdAllSigningInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdProjectedRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-rewards [address] [num-epochs]",
+		Short: "estimate the BTC staking rewards of an address over the next epochs (non-binding)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numEpochs, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProjectedRewards(cmd.Context(), &types.QueryProjectedRewardsRequest{
+				Address:   args[0],
+				NumEpochs: numEpochs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVotingPowerDistributionAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-distribution-at [btc-height]",
+		Short: "reconstruct the active sats of each finality provider at a given BTC height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			btcHeight, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPowerDistributionAt(cmd.Context(), &types.QueryVotingPowerDistributionAtRequest{
+				BtcHeight: uint32(btcHeight),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderSlashableAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-slashable-amount [fp_btc_pk_hex]",
+		Short: "retrieve the amount of BTC stake that would be slashed if a given finality provider misbehaves",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderSlashableAmount(cmd.Context(), &types.QueryFinalityProviderSlashableAmountRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,9 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -15,6 +17,7 @@ import (
 	bbn "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/babylonlabs-io/babylon/x/finality/types"
+	itypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -90,6 +93,7 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 				VotingPower:          votingPower,
 				SlashedBabylonHeight: finalityProvider.SlashedBabylonHeight,
 				SlashedBtcHeight:     finalityProvider.SlashedBtcHeight,
+				SlashingReason:       finalityProvider.SlashingReason,
 			}
 			finalityProvidersWithMeta = append(finalityProvidersWithMeta, &finalityProviderWithMeta)
 		}
@@ -372,6 +376,140 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	return &types.QuerySigningInfosResponse{SigningInfos: convertToSigningInfosResponse(signInfos), Pagination: pageRes}, nil
 }
 
+// ProjectedRewards estimates the BTC staking rewards of the given address over
+// the next given number of epochs, based on the rewards accrued in the BTC
+// staking gauges over the most recent epoch and the address's share of the
+// current voting power distribution. The projection is non-binding
+func (k Keeper) ProjectedRewards(ctx context.Context, req *types.QueryProjectedRewardsRequest) (*types.QueryProjectedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Address, err)
+	}
+	if req.NumEpochs == 0 {
+		return nil, status.Error(codes.InvalidArgument, "number of epochs must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.HeaderInfo().Height)
+
+	accrualPerEpoch := k.getBTCStakingAccrualPerEpoch(ctx, height)
+
+	// no voting power distribution means no reward at all
+	rewardShare := sdkmath.LegacyZeroDec()
+	if dc := k.GetVotingPowerDistCache(ctx, height); dc != nil {
+		rewardShare = dc.GetRewardPortion(addr)
+	}
+
+	rewardsPerEpoch := itypes.GetCoinsPortion(accrualPerEpoch, rewardShare)
+	projectedRewards := rewardsPerEpoch.MulInt(sdkmath.NewIntFromUint64(req.NumEpochs))
+
+	return &types.QueryProjectedRewardsResponse{
+		AccrualPerEpoch:  accrualPerEpoch,
+		RewardShare:      rewardShare,
+		ProjectedRewards: projectedRewards,
+	}, nil
+}
+
+// VotingPowerDistributionAt returns the active sats of each finality provider
+// at the given BTC height, reconstructed by replaying the logged power
+// distribution update events
+func (k Keeper) VotingPowerDistributionAt(ctx context.Context, req *types.QueryVotingPowerDistributionAtRequest) (*types.QueryVotingPowerDistributionAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if currentBTCHeight := k.BTCStakingKeeper.GetCurrentBTCHeight(ctx); req.BtcHeight > currentBTCHeight {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"BTC height %d is higher than the current BTC tip height %d", req.BtcHeight, currentBTCHeight)
+	}
+
+	dc, err := k.GetVotingPowerDistCacheAtBTCHeight(ctx, req.BtcHeight)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	fps := make([]*types.FinalityProviderActiveSats, 0, len(dc.FinalityProviders))
+	totalActiveSats := uint64(0)
+	for _, fp := range dc.FinalityProviders {
+		fps = append(fps, &types.FinalityProviderActiveSats{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+			ActiveSats: fp.TotalBondedSat,
+			Jailed:     fp.IsJailed,
+		})
+		totalActiveSats += fp.TotalBondedSat
+	}
+	sort.Slice(fps, func(i, j int) bool {
+		return fps[i].FpBtcPkHex < fps[j].FpBtcPkHex
+	})
+
+	return &types.QueryVotingPowerDistributionAtResponse{
+		FinalityProviders: fps,
+		TotalActiveSats:   totalActiveSats,
+	}, nil
+}
+
+// FinalityProviderSlashableAmount returns the active sats of the given
+// finality provider under the voting power distribution at the current
+// height, all of which would be slashed if the finality provider misbehaves
+func (k Keeper) FinalityProviderSlashableAmount(ctx context.Context, req *types.QueryFinalityProviderSlashableAmountRequest) (*types.QueryFinalityProviderSlashableAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+	if !k.BTCStakingKeeper.HasFinalityProvider(ctx, *fpBTCPK) {
+		return nil, status.Errorf(codes.NotFound, "finality provider %s is not found", req.FpBtcPkHex)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.HeaderInfo().Height)
+
+	// a finality provider absent from the voting power distribution does
+	// not have any active BTC stake
+	slashableSats := uint64(0)
+	if dc := k.GetVotingPowerDistCache(ctx, height); dc != nil {
+		for _, fp := range dc.FinalityProviders {
+			if fp.BtcPk.Equals(fpBTCPK) {
+				slashableSats = fp.TotalBondedSat
+				break
+			}
+		}
+	}
+
+	return &types.QueryFinalityProviderSlashableAmountResponse{
+		Height:        height,
+		SlashableSats: slashableSats,
+	}, nil
+}
+
+// getBTCStakingAccrualPerEpoch returns the total rewards accrued in the BTC
+// staking gauges over the most recent epoch-long window ending at the given
+// height
+func (k Keeper) getBTCStakingAccrualPerEpoch(ctx context.Context, height uint64) sdk.Coins {
+	epochInterval := k.CheckpointingKeeper.GetEpoch(ctx).CurrentEpochInterval
+
+	startHeight := uint64(1)
+	if height > epochInterval {
+		startHeight = height - epochInterval + 1
+	}
+
+	accrual := sdk.NewCoins()
+	for h := startHeight; h <= height; h++ {
+		if gauge := k.IncentiveKeeper.GetBTCStakingGauge(ctx, h); gauge != nil {
+			accrual = accrual.Add(gauge.Coins...)
+		}
+	}
+
+	return accrual
+}
+
 func convertToSigningInfoResponse(info types.FinalityProviderSigningInfo) types.SigningInfoResponse {
 	return types.SigningInfoResponse{
 		FpBtcPkHex:          info.FpBtcPk.MarshalHex(),
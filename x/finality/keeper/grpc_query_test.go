@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -20,6 +24,7 @@ import (
 	epochingtypes "github.com/babylonlabs-io/babylon/x/epoching/types"
 	"github.com/babylonlabs-io/babylon/x/finality/keeper"
 	"github.com/babylonlabs-io/babylon/x/finality/types"
+	itypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 )
 
 func FuzzActivatedHeight(f *testing.F) {
@@ -638,6 +643,83 @@ func FuzzSigningInfo(f *testing.F) {
 	})
 }
 
+func FuzzProjectedRewards(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// random BTC staking gauges over the heights up to the current height
+		height := datagen.RandomInt(r, 100) + 1
+		epochInterval := datagen.RandomInt(r, 20) + 1
+		gauges := map[uint64]*itypes.Gauge{}
+		for h := uint64(1); h <= height; h++ {
+			if datagen.OneInN(r, 4) {
+				continue // no fee is collected at this height
+			}
+			gauges[h] = datagen.GenRandomGauge(r)
+		}
+
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		iKeeper.EXPECT().GetBTCStakingGauge(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, h uint64) *itypes.Gauge {
+			return gauges[h]
+		}).AnyTimes()
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		cKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{CurrentEpochInterval: epochInterval}).AnyTimes()
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, iKeeper, cKeeper)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height)})
+
+		// random voting power distribution at the current height
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 100)
+		require.NoError(t, err)
+		keeper.SetVotingPowerDistCache(ctx, height, dc)
+
+		// expected accrual over the most recent epoch-long window
+		expectedAccrual := sdk.NewCoins()
+		for h := uint64(1); h <= height; h++ {
+			if h+epochInterval > height && gauges[h] != nil {
+				expectedAccrual = expectedAccrual.Add(gauges[h].Coins...)
+			}
+		}
+
+		// pick a BTC delegation of a random active finality provider
+		fp := dc.FinalityProviders[r.Intn(int(dc.NumActiveFps))]
+		btcDel := fp.BtcDels[r.Intn(len(fp.BtcDels))]
+		numEpochs := datagen.RandomInt(r, 10) + 1
+
+		resp, err := keeper.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{
+			Address:   btcDel.GetAddress().String(),
+			NumEpochs: numEpochs,
+		})
+		require.NoError(t, err)
+
+		expectedShare := dc.GetFinalityProviderPortion(fp).
+			Mul(sdkmath.LegacyOneDec().Sub(*fp.Commission)).
+			Mul(fp.GetBTCDelPortion(btcDel))
+		require.True(t, expectedAccrual.Equal(resp.AccrualPerEpoch))
+		require.True(t, expectedShare.Equal(resp.RewardShare))
+		expectedRewards := itypes.GetCoinsPortion(expectedAccrual, expectedShare).MulInt(sdkmath.NewIntFromUint64(numEpochs))
+		require.True(t, expectedRewards.Equal(resp.ProjectedRewards))
+
+		// an address without any stake does not receive rewards
+		resp, err = keeper.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{
+			Address:   datagen.GenRandomAccount().Address,
+			NumEpochs: numEpochs,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.RewardShare.IsZero())
+		require.True(t, resp.ProjectedRewards.IsZero())
+
+		// the number of epochs has to be positive
+		_, err = keeper.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{
+			Address:   btcDel.GetAddress().String(),
+			NumEpochs: 0,
+		})
+		require.Error(t, err)
+	})
+}
+
 func convertToEvidence(er *types.EvidenceResponse) *types.Evidence {
 	fpBtcPk, err := bbn.NewBIP340PubKeyFromHex(er.FpBtcPkHex)
 	if err != nil {
@@ -672,3 +754,57 @@ func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *qu
 func constructRequestWithLimit(r *rand.Rand, limit uint64) *query.PageRequest {
 	return constructRequestWithKeyAndLimit(r, nil, limit)
 }
+
+func FuzzFinalityProviderSlashableAmount(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// random voting power distribution at the current height
+		height := datagen.RandomInt(r, 100) + 1
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 100)
+		require.NoError(t, err)
+
+		// a finality provider that is not registered
+		unknownFpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		// a registered finality provider without active BTC stake
+		noStakeFpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fpBTCPK []byte) bool {
+			return !bytes.Equal(unknownFpBTCPK.MustMarshal(), fpBTCPK)
+		}).AnyTimes()
+		keeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil, nil)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height)})
+		keeper.SetVotingPowerDistCache(ctx, height, dc)
+
+		// the slashable amount of each finality provider in the distribution
+		// is its active sats
+		for _, fp := range dc.FinalityProviders {
+			resp, err := keeper.FinalityProviderSlashableAmount(ctx, &types.QueryFinalityProviderSlashableAmountRequest{
+				FpBtcPkHex: fp.BtcPk.MarshalHex(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, height, resp.Height)
+			require.Equal(t, fp.TotalBondedSat, resp.SlashableSats)
+		}
+
+		// a finality provider absent from the distribution has nothing to
+		// be slashed
+		resp, err := keeper.FinalityProviderSlashableAmount(ctx, &types.QueryFinalityProviderSlashableAmountRequest{
+			FpBtcPkHex: noStakeFpBTCPK.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.SlashableSats)
+
+		// querying an unknown finality provider fails
+		_, err = keeper.FinalityProviderSlashableAmount(ctx, &types.QueryFinalityProviderSlashableAmountRequest{
+			FpBtcPkHex: unknownFpBTCPK.MarshalHex(),
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+type Hooks struct {
+	k Keeper
+}
+
+var _ bstypes.BtcStakingHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterFinalityProviderCanceled deletes the public randomness, the public
+// randomness commitments, the signing info and the missed block bitmap of the
+// canceled finality provider, so that a finality provider re-registered with
+// the same BTC PK does not inherit them
+func (h Hooks) AfterFinalityProviderCanceled(ctx context.Context, fpBtcPk *bbn.BIP340PubKey) error {
+	h.k.deletePubRandCommits(ctx, fpBtcPk)
+	h.k.deletePubRands(ctx, fpBtcPk)
+
+	if err := h.k.FinalityProviderSigningTracker.Remove(ctx, fpBtcPk.MustMarshal()); err != nil {
+		return err
+	}
+
+	return h.k.DeleteMissedBlockBitmap(ctx, fpBtcPk)
+}
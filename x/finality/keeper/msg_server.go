@@ -303,7 +303,7 @@ func (ms msgServer) UnjailFinalityProvider(ctx context.Context, req *types.MsgUn
 // and emit an event
 func (k Keeper) slashFinalityProvider(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, evidence *types.Evidence) {
 	// slash this finality provider, i.e., set its voting power to zero
-	if err := k.BTCStakingKeeper.SlashFinalityProvider(ctx, fpBtcPk.MustMarshal()); err != nil {
+	if err := k.BTCStakingKeeper.SlashFinalityProvider(ctx, fpBtcPk.MustMarshal(), bstypes.SlashingReason_SLASHING_REASON_EQUIVOCATION); err != nil {
 		panic(fmt.Errorf("failed to slash finality provider: %v", err))
 	}
 
@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -48,25 +49,128 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	// to construct the new distribution
 	newDc := k.ProcessAllPowerDistUpdateEvents(ctx, dc, events)
 
+	// apply the unbonded state to the BTC delegations that expired
+	k.applyExpiredBTCDelegations(ctx, btcTipHeight, events)
+
+	// log the consumed events so that the distribution at a past BTC height
+	// can be reconstructed
+	k.logPowerDistUpdateEvents(ctx, lastBTCTipHeight, btcTipHeight, height, dc, events)
+
 	// record voting power and cache for this height
-	k.recordVotingPowerAndCache(ctx, newDc)
+	k.recordVotingPowerAndCache(ctx, height, newDc)
 	// emit events for finality providers with state updates
 	k.handleFPStateUpdates(ctx, dc, newDc)
 	// record metrics
 	k.recordMetrics(newDc)
 }
 
+// applyExpiredBTCDelegations records the unbonded state to each BTC
+// delegation that has an unbonded event among the given events and is not
+// unbonded early, i.e., whose timelock is about to expire, so that its status
+// flips to unbonded together with its voting power
+func (k Keeper) applyExpiredBTCDelegations(ctx context.Context, btcTipHeight uint32, events []*types.EventPowerDistUpdate) {
+	for _, event := range events {
+		delEvent := event.GetBtcDelStateUpdate()
+		if delEvent == nil || delEvent.NewState != types.BTCDelegationStatus_UNBONDED {
+			continue
+		}
+		if err := k.BTCStakingKeeper.MarkBTCDelegationExpired(ctx, delEvent.StakingTxHash, btcTipHeight); err != nil {
+			panic(err) // only programming error
+		}
+	}
+}
+
+// RebuildFinalityProviderAggregates recomputes the total bonded satoshis and
+// the BTC delegations of each finality provider from the BTC delegations in
+// the BTC staking module, and overwrites the voting power distribution cache
+// and the voting power table with the result. This repairs the aggregates if
+// they drift from the BTC delegations, e.g., after a buggy upgrade.
+// The aggregates are rebuilt at the latest height with a recorded cache, i.e.,
+// the current height if `UpdatePowerDist` has been executed at this height,
+// and the previous height otherwise (e.g., when invoked in an upgrade handler),
+// so that the next `UpdatePowerDist` builds upon the repaired cache.
+func (k Keeper) RebuildFinalityProviderAggregates(ctx context.Context) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	prevDc := k.GetVotingPowerDistCache(ctx, height)
+	if prevDc == nil && height > 0 {
+		height--
+		prevDc = k.GetVotingPowerDistCache(ctx, height)
+	}
+	if prevDc == nil {
+		prevDc = ftypes.NewVotingPowerDistCache()
+	}
+	btcTipHeight := k.BTCStakingKeeper.GetBTCHeightAtBabylonHeight(ctx, height)
+
+	// a map where key is finality provider's BTC PK hex and value is a list
+	// of BTC delegations that are active under this provider
+	activeBTCDels := map[string][]*types.BTCDelegation{}
+	if err := k.BTCStakingKeeper.IterateActiveBTCDelegations(ctx, btcTipHeight, func(btcDel *types.BTCDelegation) bool {
+		for _, fpBTCPK := range btcDel.FpBtcPkList {
+			fpBTCPKHex := fpBTCPK.MarshalHex()
+			activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
+		}
+		return false
+	}); err != nil {
+		return fmt.Errorf("failed to iterate active BTC delegations: %w", err)
+	}
+
+	// sort finality providers to ensure determinism
+	fpBTCPKHexList := make([]string, 0, len(activeBTCDels))
+	for fpBTCPKHex := range activeBTCDels {
+		fpBTCPKHexList = append(fpBTCPKHexList, fpBTCPKHex)
+	}
+	sort.Strings(fpBTCPKHexList)
+
+	newDc := ftypes.NewVotingPowerDistCache()
+	for _, fpBTCPKHex := range fpBTCPKHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			return err
+		}
+		fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, *fpBTCPK)
+		if err != nil {
+			return fmt.Errorf("failed to get finality provider %s: %w", fpBTCPKHex, err)
+		}
+		// slashed finality providers are not assigned any delegation
+		if fp.IsSlashed() {
+			continue
+		}
+		fpDistInfo := ftypes.NewFinalityProviderDistInfo(fp)
+		fpDistInfo.IsJailed = fp.Jailed
+		for _, d := range activeBTCDels[fpBTCPKHex] {
+			fpDistInfo.AddBTCDel(d)
+		}
+		if fpDistInfo.TotalBondedSat > 0 {
+			newDc.AddFinalityProviderDistInfo(fpDistInfo)
+		}
+	}
+
+	// clear the voting power table so that finality providers that are no
+	// longer active do not keep their stale voting power
+	k.removeVotingPowerTable(ctx, height)
+
+	k.recordVotingPowerAndCache(ctx, height, newDc)
+	k.handleFPStateUpdates(ctx, prevDc, newDc)
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Info("rebuilt finality provider aggregates",
+		"height", height,
+		"btc_tip_height", btcTipHeight,
+		"num_fps", len(newDc.FinalityProviders),
+		"num_active_fps", newDc.NumActiveFps,
+	)
+
+	return nil
+}
+
 // recordVotingPowerAndCache assigns voting power to each active finality provider
 // with the following consideration:
 // 1. the fp must have timestamped pub rand
 // 2. the fp must in the top x ranked by the voting power (x is given by maxActiveFps)
-func (k Keeper) recordVotingPowerAndCache(ctx context.Context, newDc *ftypes.VotingPowerDistCache) {
+func (k Keeper) recordVotingPowerAndCache(ctx context.Context, babylonTipHeight uint64, newDc *ftypes.VotingPowerDistCache) {
 	if newDc == nil {
 		panic("the voting power distribution cache cannot be nil")
 	}
 
-	babylonTipHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
-
 	// label fps with whether it has timestamped pub rand so that these fps
 	// will not be assigned voting power
 	for _, fpDistInfo := range newDc.FinalityProviders {
@@ -167,6 +271,7 @@ func (k Keeper) recordMetrics(dc *ftypes.VotingPowerDistCache) {
 // - slashed finality providers
 // - newly jailed finality providers
 // - newly unjailed finality providers
+// - commission updates of finality providers
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *ftypes.VotingPowerDistCache,
@@ -183,6 +288,9 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	jailedFPs := map[string]struct{}{}
 	// a map where key is unjailed finality providers' BTC PK
 	unjailedFPs := map[string]struct{}{}
+	// a map where key is finality providers' BTC PK and value is the latest
+	// commission rate
+	fpCommissions := map[string]*sdkmath.LegacyDec{}
 
 	/*
 		filter and classify all events into new/expired BTC delegations and jailed/slashed FPs
@@ -207,6 +315,7 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 				// emit expired event if it is not early unbonding
 				if !btcDel.IsUnbondedEarly() {
 					types.EmitExpiredDelegationEvent(sdkCtx, delEvent.StakingTxHash)
+					k.BTCStakingKeeper.EmitJSONEvent(ctx, types.NewExpiredDelegationEvent(delEvent.StakingTxHash))
 				}
 				// add the unbonded BTC delegation to the map
 				unbondedBTCDels[delEvent.StakingTxHash] = struct{}{}
@@ -222,7 +331,26 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		case *types.EventPowerDistUpdate_UnjailedFp:
 			// record unjailed fps
 			unjailedFPs[typedEvent.UnjailedFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_CommissionUpdatedFp:
+			// record the latest commission rate of fps
+			fpCommissions[typedEvent.CommissionUpdatedFp.Pk.MarshalHex()] = typedEvent.CommissionUpdatedFp.Commission
+		}
+	}
+
+	// a BTC delegation that becomes active and unbonded in the same batch of
+	// events never gets voting power, regardless of the order of the events
+	for fpBTCPKHex, fpActiveBTCDels := range activeBTCDels {
+		filtered := make([]*types.BTCDelegation, 0, len(fpActiveBTCDels))
+		for _, d := range fpActiveBTCDels {
+			if _, ok := unbondedBTCDels[d.MustGetStakingTxHash().String()]; !ok {
+				filtered = append(filtered, d)
+			}
 		}
+		if len(filtered) == 0 {
+			delete(activeBTCDels, fpBTCPKHex)
+			continue
+		}
+		activeBTCDels[fpBTCPKHex] = filtered
 	}
 
 	/*
@@ -264,6 +392,11 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			fp.IsJailed = false
 		}
 
+		// update the commission rate if the fp's commission is updated
+		if commission, ok := fpCommissions[fpBTCPKHex]; ok {
+			fp.Commission = commission
+		}
+
 		// add all BTC delegations that are not unbonded to the new finality provider
 		for j := range dc.FinalityProviders[i].BtcDels {
 			btcDel := *dc.FinalityProviders[i].BtcDels[j]
@@ -5,7 +5,10 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -139,10 +142,10 @@ func FuzzSlashFinalityProviderEvent(f *testing.F) {
 			Slash the finality provider and execute BeginBlock
 			Then, ensure the finality provider does not have voting power anymore
 		*/
-		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), types.SlashingReason_SLASHING_REASON_EQUIVOCATION)
 		h.NoError(err)
 
-		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), types.SlashingReason_SLASHING_REASON_EQUIVOCATION)
 		require.ErrorIs(t, err, types.ErrFpAlreadySlashed)
 
 		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
@@ -503,12 +506,20 @@ func FuzzBTCDelegationEvents_NoPreApproval(f *testing.F) {
 		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip.Height, btcTip.Height)
 		require.Len(t, events, 0)
 
+		// the BTC delegation is still active at the scheduled height until the
+		// unbonded event is processed
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Zero(t, btcDel.ExpiredBtcHeight)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, btcDel.GetStatus(unbondedHeight, btckptParams.CheckpointFinalizationTimeout, stakingParams.CovenantQuorum))
+
 		/*
 			BTC height reaches end height - w, such that the BTC delegation becomes expired
 			ensure the finality provider does not have voting power anymore
 		*/
 		babylonHeight += 1
 		h.SetCtxHeight(babylonHeight)
+		h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
 		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: unbondedHeight}).AnyTimes()
 		h.BeginBlocker()
 		require.Zero(t, h.FinalityKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
@@ -516,6 +527,23 @@ func FuzzBTCDelegationEvents_NoPreApproval(f *testing.F) {
 		// ensure the unbonded event is processed and cleared
 		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, unbondedHeight, unbondedHeight)
 		require.Len(t, events, 0)
+
+		// ensure the BTC delegation reads as unbonded from the scheduled height
+		btcDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, unbondedHeight, btcDel.ExpiredBtcHeight)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, btcDel.GetStatus(unbondedHeight, btckptParams.CheckpointFinalizationTimeout, stakingParams.CovenantQuorum))
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, btcDel.GetStatus(unbondedHeight-1, btckptParams.CheckpointFinalizationTimeout, stakingParams.CovenantQuorum))
+
+		// ensure the state update of the BTC delegation is emitted
+		expiredEvent := types.NewExpiredDelegationEvent(stakingTxHash)
+		found := false
+		for _, ev := range h.Ctx.EventManager().Events() {
+			if ev.Type == proto.MessageName(expiredEvent) {
+				found = true
+			}
+		}
+		require.True(t, found)
 	})
 }
 
@@ -731,3 +759,386 @@ func TestDoNotGenerateDuplicateEventsAfterHavingCovenantQuorum(t *testing.T) {
 	require.Equal(t, expectedStakingTxHash, btcDelStateUpdate.StakingTxHash)
 	require.Equal(t, types.BTCDelegationStatus_ACTIVE, btcDelStateUpdate.NewState)
 }
+
+func TestActiveAndUnbondedBTCDelegationInSameBatch(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// generate and insert new active BTC delegation
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+		0,
+		0,
+		false,
+	)
+	h.NoError(err)
+	h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+	activeEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash,
+		NewState:      types.BTCDelegationStatus_ACTIVE,
+	})
+	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash,
+		NewState:      types.BTCDelegationStatus_UNBONDED,
+	})
+
+	// the BTC delegation never enters the voting power distribution cache,
+	// regardless of the order of the events
+	for _, events := range [][]*types.EventPowerDistUpdate{
+		{activeEvent, unbondedEvent},
+		{unbondedEvent, activeEvent},
+	} {
+		newDc := h.FinalityKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, ftypes.NewVotingPowerDistCache(), events)
+		require.Empty(t, newDc.FinalityProviders)
+	}
+
+	// the BTC delegation alone becomes active
+	newDc := h.FinalityKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, ftypes.NewVotingPowerDistCache(), []*types.EventPowerDistUpdate{activeEvent})
+	require.Len(t, newDc.FinalityProviders, 1)
+	require.Equal(t, actualDel.TotalSat, newDc.FinalityProviders[0].TotalBondedSat)
+}
+
+func TestCommissionUpdatedFinalityProviderEvent(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider with an active BTC delegation
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+		r,
+		delSK,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+		0,
+		0,
+		false,
+	)
+	h.NoError(err)
+	h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+	activeEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash,
+		NewState:      types.BTCDelegationStatus_ACTIVE,
+	})
+	dc := h.FinalityKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, ftypes.NewVotingPowerDistCache(), []*types.EventPowerDistUpdate{activeEvent})
+	require.Len(t, dc.FinalityProviders, 1)
+	require.Equal(t, fp.Commission, dc.FinalityProviders[0].Commission)
+
+	// the commission update applies to the new cache only, and the latest
+	// commission update wins
+	commission1 := datagen.GenRandomCommission(r)
+	commission2 := commission1.Add(sdkmath.LegacyNewDecWithPrec(1, 2))
+	events := []*types.EventPowerDistUpdate{
+		types.NewEventPowerDistUpdateWithCommissionUpdatedFP(fp.BtcPk, commission1),
+		types.NewEventPowerDistUpdateWithCommissionUpdatedFP(fp.BtcPk, commission2),
+	}
+	newDc := h.FinalityKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events)
+	require.Len(t, newDc.FinalityProviders, 1)
+	require.Equal(t, commission2, *newDc.FinalityProviders[0].Commission)
+	require.Equal(t, actualDel.TotalSat, newDc.FinalityProviders[0].TotalBondedSat)
+	require.Equal(t, fp.Commission, dc.FinalityProviders[0].Commission)
+}
+
+func FuzzRebuildFinalityProviderAggregates(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert a number of finality providers, each with a
+		// number of active BTC delegations
+		numFps := int(datagen.RandomInt(r, 3) + 1)
+		fps := []*types.FinalityProvider{}
+		for i := 0; i < numFps; i++ {
+			fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+			h.CommitPubRandList(r, fpSK, fp, 1, 100, true)
+			fps = append(fps, fp)
+
+			numDels := int(datagen.RandomInt(r, 3) + 1)
+			for j := 0; j < numDels; j++ {
+				delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+				h.NoError(err)
+				stakingValue := int64(datagen.RandomInt(r, 3)+1) * 10e8
+				_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+					r,
+					delSK,
+					fpPK,
+					changeAddress.EncodeAddress(),
+					stakingValue,
+					1000,
+					0,
+					0,
+					false,
+				)
+				h.NoError(err)
+				h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+			}
+		}
+
+		// execute BeginBlock to record the voting power and dist cache
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		h.BeginBlocker()
+
+		expectedDc := h.FinalityKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		require.NotNil(t, expectedDc)
+		require.Len(t, expectedDc.FinalityProviders, numFps)
+		expectedPowerTable := h.FinalityKeeper.GetVotingPowerTable(h.Ctx, babylonHeight)
+		require.Len(t, expectedPowerTable, numFps)
+
+		// corrupt the dist cache and the voting power table
+		corruptedDc := ftypes.NewVotingPowerDistCache()
+		corruptedFp := *expectedDc.FinalityProviders[0]
+		corruptedFp.TotalBondedSat += 1
+		corruptedFp.BtcDels = nil
+		corruptedDc.AddFinalityProviderDistInfo(&corruptedFp)
+		h.FinalityKeeper.SetVotingPowerDistCache(h.Ctx, babylonHeight, corruptedDc)
+		h.FinalityKeeper.SetVotingPower(h.Ctx, fps[0].BtcPk.MustMarshal(), babylonHeight, 1)
+		unknownFpPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		h.FinalityKeeper.SetVotingPower(h.Ctx, unknownFpPK.MustMarshal(), babylonHeight, 1)
+
+		// rebuild and ensure the aggregates are restored
+		err = h.FinalityKeeper.RebuildFinalityProviderAggregates(h.Ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, expectedPowerTable, h.FinalityKeeper.GetVotingPowerTable(h.Ctx, babylonHeight))
+		rebuiltDc := h.FinalityKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		require.NotNil(t, rebuiltDc)
+		require.Equal(t, expectedDc.TotalBondedSat, rebuiltDc.TotalBondedSat)
+		require.Equal(t, expectedDc.NumActiveFps, rebuiltDc.NumActiveFps)
+		rebuiltFps := rebuiltDc.GetActiveFinalityProviderSet()
+		for _, expectedFp := range expectedDc.FinalityProviders {
+			rebuiltFp, ok := rebuiltFps[expectedFp.BtcPk.MarshalHex()]
+			require.True(t, ok)
+			require.Equal(t, expectedFp.TotalBondedSat, rebuiltFp.TotalBondedSat)
+			require.ElementsMatch(t, expectedFp.BtcDels, rebuiltFp.BtcDels)
+		}
+	})
+}
+
+func FuzzVotingPowerDistributionAt(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		btckptParams := btccKeeper.GetParams(h.Ctx)
+		stakingParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Params
+		unbondedHeight := actualDel.EndHeight - types.MinimumUnbondingTime(&stakingParams, &btckptParams)
+
+		// no event is logged before the voting power distribution is updated
+		_, err = h.FinalityKeeper.GetVotingPowerDistCacheAtBTCHeight(h.Ctx, btcTip.Height)
+		require.ErrorIs(t, err, ftypes.ErrBTCHeightNotReconstructable)
+
+		// the BTC delegation becomes active at the current BTC tip
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		h.BeginBlocker()
+
+		// the BTC delegation becomes unbonded at end height - max(w, min_unbonding_time)
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: unbondedHeight}).AnyTimes()
+		h.BeginBlocker()
+
+		// the finality provider has the staked sats up to the unbonded height
+		resp, err := h.FinalityKeeper.VotingPowerDistributionAt(h.Ctx, &ftypes.QueryVotingPowerDistributionAtRequest{
+			BtcHeight: unbondedHeight - 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.FinalityProviders, 1)
+		require.Equal(t, fp.BtcPk.MarshalHex(), resp.FinalityProviders[0].FpBtcPkHex)
+		require.Equal(t, uint64(stakingValue), resp.FinalityProviders[0].ActiveSats)
+		require.Equal(t, uint64(stakingValue), resp.TotalActiveSats)
+
+		// the finality provider has no staked sats since the unbonded height
+		resp, err = h.FinalityKeeper.VotingPowerDistributionAt(h.Ctx, &ftypes.QueryVotingPowerDistributionAtRequest{
+			BtcHeight: unbondedHeight,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.FinalityProviders)
+		require.Zero(t, resp.TotalActiveSats)
+
+		// BTC heights beyond the current BTC tip cannot be queried
+		_, err = h.FinalityKeeper.VotingPowerDistributionAt(h.Ctx, &ftypes.QueryVotingPowerDistributionAtRequest{
+			BtcHeight: unbondedHeight + 1,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzPrunePowerDistEventLog(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, msgCreateBTCDel, actualDel, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			false,
+		)
+		h.NoError(err)
+
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		btckptParams := btccKeeper.GetParams(h.Ctx)
+		stakingParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Params
+		unbondedHeight := actualDel.EndHeight - types.MinimumUnbondingTime(&stakingParams, &btckptParams)
+
+		// the BTC delegation becomes active at the current BTC tip
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		h.BeginBlocker()
+
+		// the BTC delegation becomes unbonded at the unbonded height
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: unbondedHeight}).AnyTimes()
+		h.BeginBlocker()
+
+		// no event is pruned if pruning is disabled
+		fParams := h.FinalityKeeper.GetParams(h.Ctx)
+		fParams.PowerDistEventRetentionBlocks = 0
+		err = h.FinalityKeeper.SetParams(h.Ctx, fParams)
+		require.NoError(t, err)
+		h.FinalityKeeper.PrunePowerDistEventLog(h.Ctx)
+		_, err = h.FinalityKeeper.GetVotingPowerDistCacheAtBTCHeight(h.Ctx, btcTip.Height)
+		require.NoError(t, err)
+
+		// retain the events within the BTC blocks behind the unbonded height,
+		// such that the activation is folded into the base distribution
+		retentionBlocks := datagen.RandomInt(r, int(unbondedHeight-btcTip.Height)) + 1
+		fParams.PowerDistEventRetentionBlocks = uint32(retentionBlocks)
+		err = h.FinalityKeeper.SetParams(h.Ctx, fParams)
+		require.NoError(t, err)
+		h.FinalityKeeper.PrunePowerDistEventLog(h.Ctx)
+		earliestBTCHeight := unbondedHeight - uint32(retentionBlocks)
+
+		// the distribution before the retention window cannot be reconstructed
+		_, err = h.FinalityKeeper.GetVotingPowerDistCacheAtBTCHeight(h.Ctx, earliestBTCHeight-1)
+		require.ErrorIs(t, err, ftypes.ErrBTCHeightNotReconstructable)
+
+		// the distribution within the retention window can still be reconstructed
+		dc, err := h.FinalityKeeper.GetVotingPowerDistCacheAtBTCHeight(h.Ctx, earliestBTCHeight)
+		require.NoError(t, err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, uint64(stakingValue), dc.FinalityProviders[0].TotalBondedSat)
+		dc, err = h.FinalityKeeper.GetVotingPowerDistCacheAtBTCHeight(h.Ctx, unbondedHeight)
+		require.NoError(t, err)
+		require.Empty(t, dc.FinalityProviders)
+	})
+}
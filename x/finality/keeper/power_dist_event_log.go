@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+// powerDistEventBatchKeyLen is the length of the key prefix shared by the
+// events consumed in the same `UpdatePowerDist`, i.e., the BTC tip height and
+// the Babylon height
+const powerDistEventBatchKeyLen = 16
+
+// logPowerDistUpdateEvents records the power distribution update events
+// consumed by `UpdatePowerDist` at the given Babylon height, so that the
+// voting power distribution at a past BTC height can be reconstructed. Upon
+// the first invocation, the voting power distribution at the previous BTC tip
+// height is recorded as the base the events are replayed from.
+func (k Keeper) logPowerDistUpdateEvents(
+	ctx context.Context,
+	lastBTCTipHeight uint32,
+	btcTipHeight uint32,
+	babylonHeight uint64,
+	prevDc *ftypes.VotingPowerDistCache,
+	events []*types.EventPowerDistUpdate,
+) {
+	if k.getPowerDistEventLogBase(ctx) == nil {
+		k.setPowerDistEventLogBase(ctx, &ftypes.PowerDistEventLogBase{
+			BtcHeight: lastBTCTipHeight,
+			DistCache: prevDc,
+		})
+	}
+
+	store := k.powerDistEventLogStore(ctx)
+	for i, event := range events {
+		store.Set(powerDistEventLogKey(btcTipHeight, babylonHeight, uint64(i)), k.cdc.MustMarshal(event))
+	}
+}
+
+// GetVotingPowerDistCacheAtBTCHeight reconstructs the voting power
+// distribution at the given BTC height by replaying the logged power
+// distribution update events up to the BTC height upon the base distribution.
+// It returns ErrBTCHeightNotReconstructable if the BTC height is lower than
+// the base one, i.e., the earliest reconstructable BTC height.
+func (k Keeper) GetVotingPowerDistCacheAtBTCHeight(ctx context.Context, btcHeight uint32) (*ftypes.VotingPowerDistCache, error) {
+	base := k.getPowerDistEventLogBase(ctx)
+	if base == nil {
+		return nil, errorsmod.Wrap(ftypes.ErrBTCHeightNotReconstructable, "no power distribution update event is logged yet")
+	}
+	if btcHeight < base.BtcHeight {
+		return nil, errorsmod.Wrapf(ftypes.ErrBTCHeightNotReconstructable,
+			"BTC height %d is lower than the earliest reconstructable BTC height %d", btcHeight, base.BtcHeight)
+	}
+
+	dc := base.DistCache
+	if dc == nil {
+		dc = ftypes.NewVotingPowerDistCache()
+	}
+
+	// processing the events emits the corresponding events again, which
+	// shall not be surfaced upon replaying
+	replayCtx := sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+
+	store := k.powerDistEventLogStore(ctx)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(btcHeight)+1))
+	defer iter.Close()
+
+	// events consumed in the same `UpdatePowerDist` are replayed as a batch,
+	// in the same way they were processed
+	var (
+		batchKey []byte
+		batch    []*types.EventPowerDistUpdate
+	)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if batchKey != nil && !bytes.Equal(key[:powerDistEventBatchKeyLen], batchKey) {
+			dc = k.ProcessAllPowerDistUpdateEvents(replayCtx, dc, batch)
+			batch = nil
+		}
+		batchKey = bytes.Clone(key[:powerDistEventBatchKeyLen])
+
+		var event types.EventPowerDistUpdate
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		batch = append(batch, &event)
+	}
+	if len(batch) > 0 {
+		dc = k.ProcessAllPowerDistUpdateEvents(replayCtx, dc, batch)
+	}
+
+	return dc, nil
+}
+
+// PrunePowerDistEventLog folds the logged power distribution update events
+// consumed at BTC tip heights older than the retention window into the base
+// distribution, such that the distribution at any BTC height within the
+// retention window remains reconstructable. Only the events consumed by
+// `UpdatePowerDist` are logged, thus the scheduled events that are not
+// processed yet are never pruned. This is triggered upon each `EndBlock`
+func (k Keeper) PrunePowerDistEventLog(ctx context.Context) {
+	// record the size of the event log after pruning
+	defer func() {
+		ftypes.RecordPowerDistEventLogSize(k.powerDistEventLogSize(ctx))
+	}()
+
+	retentionBlocks := k.GetParams(ctx).PowerDistEventRetentionBlocks
+	if retentionBlocks == 0 {
+		// the events are never pruned
+		return
+	}
+	base := k.getPowerDistEventLogBase(ctx)
+	if base == nil {
+		// no event is logged yet
+		return
+	}
+
+	btcTipHeight := k.BTCStakingKeeper.GetCurrentBTCHeight(ctx)
+	if btcTipHeight <= retentionBlocks {
+		return
+	}
+	earliestBTCHeight := btcTipHeight - retentionBlocks
+	if earliestBTCHeight <= base.BtcHeight {
+		return
+	}
+
+	// the distribution at the earliest BTC height becomes the new base
+	dc, err := k.GetVotingPowerDistCacheAtBTCHeight(ctx, earliestBTCHeight)
+	if err != nil {
+		panic(err) // only programming error
+	}
+
+	// remove all events that are folded into the new base
+	store := k.powerDistEventLogStore(ctx)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(earliestBTCHeight)+1))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.setPowerDistEventLogBase(ctx, &ftypes.PowerDistEventLogBase{
+		BtcHeight: earliestBTCHeight,
+		DistCache: dc,
+	})
+}
+
+// powerDistEventLogSize returns the number of logged power distribution
+// update events
+func (k Keeper) powerDistEventLogSize(ctx context.Context) int {
+	iter := k.powerDistEventLogStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	size := 0
+	for ; iter.Valid(); iter.Next() {
+		size++
+	}
+	return size
+}
+
+func (k Keeper) setPowerDistEventLogBase(ctx context.Context, base *ftypes.PowerDistEventLogBase) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(ftypes.PowerDistEventLogBaseKey, k.cdc.MustMarshal(base)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) getPowerDistEventLogBase(ctx context.Context) *ftypes.PowerDistEventLogBase {
+	store := k.storeService.OpenKVStore(ctx)
+	baseBytes, err := store.Get(ftypes.PowerDistEventLogBaseKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(baseBytes) == 0 {
+		return nil
+	}
+	var base ftypes.PowerDistEventLogBase
+	k.cdc.MustUnmarshal(baseBytes, &base)
+	return &base
+}
+
+func powerDistEventLogKey(btcTipHeight uint32, babylonHeight uint64, idx uint64) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(btcTipHeight))
+	key = append(key, sdk.Uint64ToBigEndian(babylonHeight)...)
+	return append(key, sdk.Uint64ToBigEndian(idx)...)
+}
+
+// powerDistEventLogStore returns the KVStore of the log of consumed power
+// distribution update events
+// prefix: PowerDistEventLogKey
+// key: (BTC tip height || Babylon height || index of the event)
+// value: EventPowerDistUpdate
+func (k Keeper) powerDistEventLogStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, ftypes.PowerDistEventLogKey)
+}
@@ -93,6 +93,22 @@ func (k Keeper) GetVotingPowerTable(ctx context.Context, height uint64) map[stri
 	return fpSet
 }
 
+// removeVotingPowerTable removes the voting power of all finality providers
+// at a given height
+func (k Keeper) removeVotingPowerTable(ctx context.Context, height uint64) {
+	store := k.votingPowerBbnBlockHeightStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetBTCStakingActivatedHeight returns the height when the BTC staking protocol is activated
 // i.e., the first height where a finality provider has voting power
 // Before the BTC staking protocol is activated, we don't index or tally any block
@@ -112,7 +112,7 @@ func FuzzVotingPowerTable(f *testing.F) {
 		// slash a random finality provider
 		slashedIdx := datagen.RandomInt(r, int(numFpsWithVotingPower))
 		slashedFp := fps[slashedIdx]
-		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, slashedFp.BtcPk.MustMarshal())
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, slashedFp.BtcPk.MustMarshal(), types.SlashingReason_SLASHING_REASON_EQUIVOCATION)
 		require.NoError(t, err)
 		// index height and record power table
 		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
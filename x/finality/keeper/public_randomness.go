@@ -84,6 +84,12 @@ func (k Keeper) GetLastPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340Pub
 	return &prCommit
 }
 
+// deletePubRandCommits deletes all public randomness commitments of the given
+// finality provider
+func (k Keeper) deletePubRandCommits(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) {
+	deleteAllKeys(k.pubRandCommitFpStore(ctx, fpBtcPK))
+}
+
 // pubRandCommitFpStore returns the KVStore of the commitment of public randomness
 // prefix: PubRandKey
 // key: (finality provider PK || block height of the commitment)
@@ -147,6 +153,11 @@ func (k Keeper) GetLastPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) (
 	return height, pubRand, nil
 }
 
+// deletePubRands deletes all public randomness of the given finality provider
+func (k Keeper) deletePubRands(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) {
+	deleteAllKeys(k.pubRandFpStore(ctx, fpBtcPK))
+}
+
 // pubRandFpStore returns the KVStore of the public randomness
 // prefix: PubRandKey
 // key: (finality provider PK || block height)
@@ -164,3 +175,18 @@ func (k Keeper) pubRandStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.PubRandKey)
 }
+
+// deleteAllKeys deletes all keys of the given store. The keys are collected
+// before being deleted so that the store is not mutated during the iteration
+func deleteAllKeys(store prefix.Store) {
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
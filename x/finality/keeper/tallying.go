@@ -91,6 +91,14 @@ func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, vo
 	}
 	// filter out voted finality providers
 	filteredDc := dc.FilterVotedDistCache(voterBTCPKs)
+	// the commission rates in the cache are the ones in effect at the
+	// finalised height, as commission updates are applied to the cache
+	for _, fp := range filteredDc.FinalityProviders {
+		if fp.Commission == nil {
+			panic(fmt.Errorf("commission of finality provider %s not found in voting power distribution cache at height %d",
+				fp.BtcPk.MarshalHex(), block.Height))
+		}
+	}
 	// reward voted finality providers
 	k.IncentiveKeeper.RewardBTCStaking(ctx, block.Height, filteredDc)
 	// remove reward distribution cache afterwards
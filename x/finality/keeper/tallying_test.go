@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -94,8 +96,21 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 				require.NoError(t, err)
 			}
 		}
-		// we don't test incentive in this function
-		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any()).Return().Times(int(numWithQCs))
+		// the commission rates in the distribution cache at the finalised
+		// height are used for distributing rewards
+		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, height uint64, filteredDc *types.VotingPowerDistCache) {
+				dc := fKeeper.GetVotingPowerDistCache(ctx, height)
+				commissions := map[string]*sdkmath.LegacyDec{}
+				for _, fp := range dc.FinalityProviders {
+					commissions[fp.BtcPk.MarshalHex()] = fp.Commission
+				}
+				for _, fp := range filteredDc.FinalityProviders {
+					require.NotNil(t, fp.Commission)
+					require.Equal(t, commissions[fp.BtcPk.MarshalHex()], fp.Commission)
+				}
+			},
+		).Times(int(numWithQCs))
 		// tally blocks and none of them should be finalised
 		ctx = datagen.WithCtxHeight(ctx, activatedHeight+10-1)
 		fKeeper.TallyBlocks(ctx)
@@ -114,6 +129,7 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 
 func giveQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, height uint64) error {
 	dc := types.NewVotingPowerDistCache()
+	commission := datagen.GenRandomCommission(r)
 	// 3 votes
 	for i := 0; i < 3; i++ {
 		votedFpPK, err := datagen.GenRandomBIP340PubKey(r)
@@ -123,6 +139,7 @@ func giveQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, heigh
 		fKeeper.SetVotingPower(ctx, votedFpPK.MustMarshal(), height, 1)
 		dc.AddFinalityProviderDistInfo(&types.FinalityProviderDistInfo{
 			BtcPk:          votedFpPK,
+			Commission:     &commission,
 			TotalBondedSat: 1,
 		})
 		votedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
@@ -139,6 +156,7 @@ func giveQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, heigh
 
 func giveNoQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, height uint64) error {
 	dc := types.NewVotingPowerDistCache()
+	commission := datagen.GenRandomCommission(r)
 	// 1 vote
 	votedFpPK, err := datagen.GenRandomBIP340PubKey(r)
 	if err != nil {
@@ -147,6 +165,7 @@ func giveNoQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, hei
 	fKeeper.SetVotingPower(ctx, votedFpPK.MustMarshal(), height, 1)
 	dc.AddFinalityProviderDistInfo(&types.FinalityProviderDistInfo{
 		BtcPk:          votedFpPK,
+		Commission:     &commission,
 		TotalBondedSat: 1,
 	})
 	votedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
@@ -164,6 +183,7 @@ func giveNoQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, hei
 		fKeeper.SetVotingPower(ctx, fpPK.MustMarshal(), height, 1)
 		dc.AddFinalityProviderDistInfo(&types.FinalityProviderDistInfo{
 			BtcPk:          fpPK,
+			Commission:     &commission,
 			TotalBondedSat: 1,
 		})
 	}
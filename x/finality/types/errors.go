@@ -22,4 +22,5 @@ var (
 	ErrVotingPowerTableNotUpdated     = errorsmod.Register(ModuleName, 1113, "voting power table has not been updated")
 	ErrBTCStakingNotActivated         = errorsmod.Register(ModuleName, 1114, "the BTC staking protocol is not activated yet")
 	ErrFinalityNotActivated           = errorsmod.Register(ModuleName, 1115, "finality is not active yet")
+	ErrBTCHeightNotReconstructable    = errorsmod.Register(ModuleName, 1116, "the voting power distribution at the BTC height cannot be reconstructed")
 )
@@ -5,7 +5,9 @@ import (
 
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	etypes "github.com/babylonlabs-io/babylon/x/epoching/types"
+	itypes "github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 type BTCStakingKeeper interface {
@@ -14,12 +16,15 @@ type BTCStakingKeeper interface {
 	GetBTCHeightAtBabylonHeight(ctx context.Context, babylonHeight uint64) uint32
 	GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*bstypes.FinalityProvider, error)
 	HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool
-	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte, reason bstypes.SlashingReason) error
 	GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (*bstypes.BTCDelegation, error)
+	MarkBTCDelegationExpired(ctx context.Context, stakingTxHashStr string, btcHeight uint32) error
+	IterateActiveBTCDelegations(ctx context.Context, btcHeight uint32, handler func(btcDel *bstypes.BTCDelegation) (stop bool)) error
 	GetAllPowerDistUpdateEvents(ctx context.Context, lastBTCTipHeight, btcTipHeight uint32) []*bstypes.EventPowerDistUpdate
 	ClearPowerDistUpdateEvents(ctx context.Context, btcHeight uint32)
 	JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	EmitJSONEvent(ctx context.Context, ev proto.Message)
 }
 
 type CheckpointingKeeper interface {
@@ -31,5 +36,6 @@ type CheckpointingKeeper interface {
 // and refund transaction fee for finality signatures
 type IncentiveKeeper interface {
 	RewardBTCStaking(ctx context.Context, height uint64, filteredDc *VotingPowerDistCache)
+	GetBTCStakingGauge(ctx context.Context, height uint64) *itypes.Gauge
 	IndexRefundableMsg(ctx context.Context, msg sdk.Msg)
 }
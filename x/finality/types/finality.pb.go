@@ -188,6 +188,13 @@ type BTCDelDistInfo struct {
 	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
 	// total_sat is the amount of BTC stake (in Satoshi) of the BTC delegation
 	TotalSat uint64 `protobuf:"varint,4,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// reward_address is the address to receive rewards from the BTC delegation.
+	// If empty, rewards are sent to staker_addr
+	RewardAddress string `protobuf:"bytes,5,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// is_self_del indicates whether the BTC delegation is staked by the
+	// finality provider it restakes to, i.e., whether staker_addr is the
+	// address of the finality provider
+	IsSelfDel bool `protobuf:"varint,6,opt,name=is_self_del,json=isSelfDel,proto3" json:"is_self_del,omitempty"`
 }
 
 func (m *BTCDelDistInfo) Reset()         { *m = BTCDelDistInfo{} }
@@ -244,6 +251,20 @@ func (m *BTCDelDistInfo) GetTotalSat() uint64 {
 	return 0
 }
 
+func (m *BTCDelDistInfo) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *BTCDelDistInfo) GetIsSelfDel() bool {
+	if m != nil {
+		return m.IsSelfDel
+	}
+	return false
+}
+
 // IndexedBlock is the necessary metadata and finalization status of a block
 type IndexedBlock struct {
 	// height is the height of the block
@@ -530,6 +551,63 @@ func (m *FinalityProviderSigningInfo) GetJailedUntil() time.Time {
 	return time.Time{}
 }
 
+// PowerDistEventLogBase is the voting power distribution from which the
+// logged power distribution update events are replayed. Distributions at
+// BTC heights lower than btc_height cannot be reconstructed
+type PowerDistEventLogBase struct {
+	// btc_height is the BTC tip height at which dist_cache is effective
+	BtcHeight uint32 `protobuf:"varint,1,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// dist_cache is the voting power distribution at btc_height
+	DistCache *VotingPowerDistCache `protobuf:"bytes,2,opt,name=dist_cache,json=distCache,proto3" json:"dist_cache,omitempty"`
+}
+
+func (m *PowerDistEventLogBase) Reset()         { *m = PowerDistEventLogBase{} }
+func (m *PowerDistEventLogBase) String() string { return proto.CompactTextString(m) }
+func (*PowerDistEventLogBase) ProtoMessage()    {}
+func (*PowerDistEventLogBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{7}
+}
+func (m *PowerDistEventLogBase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerDistEventLogBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerDistEventLogBase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PowerDistEventLogBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerDistEventLogBase.Merge(m, src)
+}
+func (m *PowerDistEventLogBase) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerDistEventLogBase) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerDistEventLogBase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerDistEventLogBase proto.InternalMessageInfo
+
+func (m *PowerDistEventLogBase) GetBtcHeight() uint32 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func (m *PowerDistEventLogBase) GetDistCache() *VotingPowerDistCache {
+	if m != nil {
+		return m.DistCache
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VotingPowerDistCache)(nil), "babylon.finality.v1.VotingPowerDistCache")
 	proto.RegisterType((*FinalityProviderDistInfo)(nil), "babylon.finality.v1.FinalityProviderDistInfo")
@@ -538,6 +616,7 @@ func init() {
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
 	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*PowerDistEventLogBase)(nil), "babylon.finality.v1.PowerDistEventLogBase")
 }
 
 func init() {
@@ -545,70 +624,76 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x26, 0x4e, 0x6c, 0x8f, 0xed, 0xb4, 0x99, 0xe6, 0x5b, 0x6d, 0x93, 0x2f, 0x76, 0x6a,
-	0x7e, 0xc8, 0x42, 0x78, 0x4d, 0xd3, 0x0a, 0x41, 0x0f, 0x48, 0xd9, 0xb8, 0x55, 0x03, 0x81, 0x5a,
-	0x6b, 0x97, 0x03, 0x42, 0x1a, 0xcd, 0xee, 0x8e, 0x77, 0x07, 0xef, 0xce, 0xac, 0x76, 0x66, 0x43,
-	0xcc, 0x1f, 0x80, 0x38, 0x96, 0x1b, 0x12, 0x17, 0x8e, 0x1c, 0x39, 0xf4, 0x8f, 0xa8, 0x38, 0x55,
-	0x3d, 0xa1, 0x1c, 0x02, 0x24, 0x07, 0xfe, 0x0d, 0xb4, 0xb3, 0xeb, 0x75, 0x5c, 0x05, 0x81, 0xa0,
-	0x5c, 0xa2, 0x99, 0xcf, 0x3c, 0xbf, 0xcf, 0x7b, 0xef, 0xf3, 0xde, 0xdb, 0x80, 0xb6, 0x8d, 0xed,
-	0x69, 0xc0, 0x59, 0x6f, 0x4c, 0x19, 0x0e, 0xa8, 0x9c, 0xf6, 0x8e, 0x6e, 0x15, 0x67, 0x23, 0x8a,
-	0xb9, 0xe4, 0xf0, 0x5a, 0x6e, 0x63, 0x14, 0xf8, 0xd1, 0xad, 0xad, 0x1b, 0x0e, 0x17, 0x21, 0x17,
-	0x48, 0x99, 0xf4, 0xb2, 0x4b, 0x66, 0xbf, 0xb5, 0xe9, 0x71, 0x8f, 0x67, 0x78, 0x7a, 0xca, 0xd1,
-	0x0d, 0x1c, 0x52, 0xc6, 0x7b, 0xea, 0x6f, 0x0e, 0xb5, 0x3c, 0xce, 0xbd, 0x80, 0xf4, 0xd4, 0xcd,
-	0x4e, 0xc6, 0x3d, 0x49, 0x43, 0x22, 0x24, 0x0e, 0xa3, 0xcc, 0xa0, 0xfd, 0x93, 0x06, 0x36, 0x3f,
-	0xe1, 0x92, 0x32, 0x6f, 0xc0, 0xbf, 0x20, 0x71, 0x9f, 0x0a, 0xb9, 0x8f, 0x1d, 0x9f, 0xc0, 0x0e,
-	0xb8, 0x2a, 0xb9, 0xc4, 0x01, 0xb2, 0x39, 0x73, 0x89, 0x8b, 0x04, 0x96, 0xba, 0xb6, 0xa3, 0x75,
-	0x4a, 0xd6, 0xba, 0xc2, 0x4d, 0x05, 0x0f, 0xb1, 0x84, 0x9f, 0x01, 0x38, 0x0b, 0x3b, 0x8d, 0xf5,
-	0x88, 0xba, 0x24, 0x16, 0xfa, 0xf2, 0xce, 0x4a, 0xa7, 0xb6, 0xdb, 0x35, 0x2e, 0xc9, 0xcc, 0xb8,
-	0x9f, 0x9f, 0x07, 0xb9, 0x75, 0xca, 0x7a, 0xc0, 0xc6, 0xdc, 0xda, 0x18, 0xbf, 0xf0, 0x22, 0xe0,
-	0x6b, 0x60, 0x9d, 0x25, 0x21, 0xc2, 0x8e, 0xa4, 0x47, 0x04, 0x8d, 0x23, 0xa1, 0xaf, 0xec, 0x68,
-	0x9d, 0x86, 0x55, 0x67, 0x49, 0xb8, 0xa7, 0xc0, 0xfb, 0x91, 0xb8, 0x5b, 0xfa, 0xfa, 0xfb, 0xd6,
-	0x52, 0xfb, 0xbb, 0x15, 0xa0, 0xff, 0x99, 0x6f, 0xf8, 0x10, 0xac, 0xd9, 0xd2, 0x41, 0xd1, 0x44,
-	0xa5, 0x51, 0x37, 0xdf, 0x3d, 0x39, 0x6d, 0xdd, 0xf1, 0xa8, 0xf4, 0x13, 0xdb, 0x70, 0x78, 0xd8,
-	0xcb, 0x03, 0x0d, 0xb0, 0x2d, 0xba, 0x94, 0xcf, 0xae, 0x3d, 0x39, 0x8d, 0x88, 0x30, 0xcc, 0x83,
-	0xc1, 0xed, 0x3b, 0x6f, 0x0f, 0x12, 0xfb, 0x43, 0x32, 0xb5, 0x56, 0x6d, 0xe9, 0x0c, 0x26, 0x10,
-	0x82, 0x12, 0x76, 0xdd, 0x58, 0x5f, 0x4e, 0xdd, 0x59, 0xea, 0x0c, 0x3f, 0x02, 0xc0, 0xe1, 0x61,
-	0x48, 0x85, 0xa0, 0x9c, 0xa9, 0x48, 0xab, 0x66, 0xf7, 0xe4, 0xb4, 0xb5, 0x9d, 0xc9, 0x27, 0xdc,
-	0x89, 0x41, 0x79, 0x2f, 0xc4, 0xd2, 0x37, 0x0e, 0x89, 0x87, 0x9d, 0x69, 0x9f, 0x38, 0xcf, 0x9f,
-	0x74, 0x41, 0xae, 0x6e, 0x9f, 0x38, 0xd6, 0x05, 0x07, 0x97, 0x8a, 0x50, 0xba, 0x54, 0x84, 0xf7,
-	0x41, 0x25, 0xcd, 0xce, 0x25, 0x81, 0xd0, 0x57, 0x55, 0xe9, 0x5f, 0xbd, 0xb4, 0xf4, 0xe6, 0x68,
-	0xbf, 0x4f, 0x82, 0xa2, 0xe0, 0x65, 0x5b, 0x3a, 0x7d, 0x12, 0x08, 0xf8, 0x3a, 0x58, 0xa7, 0x02,
-	0x15, 0xdd, 0x41, 0x5c, 0x7d, 0x6d, 0x47, 0xeb, 0x54, 0xac, 0x06, 0x15, 0xa3, 0x39, 0x08, 0xb7,
-	0x41, 0x95, 0x0a, 0xf4, 0x39, 0xa6, 0x01, 0x71, 0xf5, 0xb2, 0xb2, 0xa8, 0x50, 0xf1, 0x81, 0xba,
-	0xc3, 0x57, 0x00, 0xa0, 0x02, 0x89, 0x00, 0x0b, 0x9f, 0xb8, 0x7a, 0x45, 0xbd, 0x56, 0xa9, 0x18,
-	0x66, 0x40, 0xfb, 0x37, 0x0d, 0xac, 0x2f, 0xd2, 0xbf, 0x7c, 0x4d, 0xde, 0x03, 0x35, 0x21, 0xf1,
-	0x84, 0xc4, 0xa8, 0x90, 0xa6, 0x6a, 0xea, 0xcf, 0x9f, 0x74, 0x37, 0xf3, 0x0a, 0xef, 0xb9, 0x6e,
-	0x4c, 0x84, 0x18, 0xca, 0x98, 0x32, 0xcf, 0x02, 0x99, 0x71, 0x0a, 0xc2, 0x37, 0xc0, 0x95, 0xf4,
-	0x46, 0x99, 0x87, 0xe4, 0x31, 0xf2, 0xb1, 0xf0, 0x33, 0xfd, 0xac, 0x46, 0x0e, 0x8f, 0x8e, 0x1f,
-	0x60, 0xe1, 0xa7, 0x25, 0xc8, 0x34, 0x99, 0x8b, 0x51, 0x51, 0xc0, 0x10, 0xcb, 0x36, 0x02, 0xf5,
-	0x03, 0xe6, 0x92, 0x63, 0xe2, 0x9a, 0x01, 0x77, 0x26, 0xf0, 0x3a, 0x58, 0xf3, 0x09, 0xf5, 0xfc,
-	0xd9, 0xec, 0xe4, 0x37, 0x78, 0x03, 0x54, 0x70, 0x14, 0x65, 0x2c, 0x59, 0xff, 0x94, 0x71, 0x14,
-	0x29, 0xff, 0xff, 0x07, 0xd5, 0x4c, 0xb0, 0x2f, 0x89, 0xab, 0x22, 0xa8, 0x58, 0x73, 0xa0, 0xfd,
-	0x8d, 0x06, 0x1a, 0x83, 0xc4, 0xb6, 0x30, 0x73, 0xf7, 0xd3, 0x3e, 0x91, 0xf0, 0x26, 0xa8, 0x0b,
-	0x89, 0x63, 0x89, 0x16, 0x88, 0x6a, 0x0a, 0x7b, 0x90, 0xb1, 0xed, 0x80, 0x74, 0x5a, 0x50, 0x94,
-	0xd8, 0x28, 0xc6, 0xcc, 0x55, 0x8c, 0x25, 0x0b, 0xb0, 0x24, 0xcc, 0x5d, 0xc1, 0x66, 0xde, 0xb7,
-	0x32, 0x24, 0x4c, 0x2a, 0xd6, 0xba, 0x75, 0x01, 0x49, 0x93, 0x26, 0x11, 0x77, 0x7c, 0xc4, 0x92,
-	0x70, 0x96, 0xb4, 0x02, 0x3e, 0x4e, 0xc2, 0xf6, 0x57, 0x25, 0x50, 0xb9, 0x97, 0x0e, 0x1b, 0x73,
-	0x08, 0x1c, 0x81, 0xea, 0x38, 0x42, 0x2f, 0x49, 0xd5, 0xf2, 0x38, 0x32, 0x95, 0xae, 0x37, 0x41,
-	0xdd, 0x4e, 0x0b, 0x3a, 0x4b, 0x32, 0xcb, 0xa0, 0xa6, 0xb0, 0x3c, 0xc9, 0x47, 0xa0, 0x52, 0x24,
-	0xa8, 0x12, 0x30, 0xef, 0x9e, 0x9c, 0xb6, 0xde, 0xf9, 0xbb, 0xbc, 0x43, 0xc7, 0x67, 0x3c, 0x8e,
-	0xf3, 0x82, 0x58, 0xe5, 0x28, 0xaf, 0xcc, 0x5b, 0x00, 0x3a, 0x98, 0x71, 0x46, 0x1d, 0x1c, 0xa0,
-	0x42, 0xb3, 0x92, 0xaa, 0xd0, 0xd5, 0xe2, 0x65, 0x2f, 0x17, 0xaf, 0x0d, 0x1a, 0x63, 0x1e, 0x4f,
-	0xe6, 0x86, 0xab, 0xca, 0xb0, 0x96, 0x82, 0x33, 0x9b, 0x08, 0x5c, 0x9f, 0x7b, 0x2c, 0x36, 0xa7,
-	0xa0, 0x9e, 0x1a, 0xb9, 0x7f, 0x16, 0xf6, 0xbd, 0x87, 0xa3, 0xe1, 0x90, 0x7a, 0xd6, 0x66, 0xe1,
-	0x79, 0xb6, 0x07, 0x87, 0xd4, 0x83, 0x63, 0xb0, 0xa1, 0xa2, 0x5a, 0x20, 0x2b, 0xff, 0x6b, 0xb2,
-	0x2b, 0xa9, 0xd3, 0x0b, 0x3c, 0xed, 0x6f, 0x97, 0xc1, 0xf6, 0x8b, 0xfb, 0x77, 0x48, 0x3d, 0x46,
-	0x99, 0xa7, 0xc6, 0xfd, 0x3f, 0xeb, 0x8d, 0x85, 0x01, 0x48, 0x7b, 0x63, 0x65, 0x71, 0x00, 0x76,
-	0xc1, 0xff, 0xd2, 0x95, 0x4a, 0x5c, 0xa4, 0x3a, 0x46, 0x20, 0x87, 0x27, 0x4c, 0x92, 0x58, 0x35,
-	0xca, 0x8a, 0x75, 0x2d, 0x7b, 0x54, 0x23, 0x2b, 0xf6, 0xb3, 0x27, 0x78, 0x08, 0xea, 0xd9, 0x9e,
-	0x43, 0x09, 0x93, 0x34, 0x50, 0x92, 0xd7, 0x76, 0xb7, 0x8c, 0xec, 0x8b, 0x6a, 0xcc, 0xbe, 0xa8,
-	0x46, 0xb1, 0x1e, 0xcd, 0xc6, 0xd3, 0xd3, 0xd6, 0xd2, 0xe3, 0x5f, 0x5a, 0xda, 0x0f, 0xbf, 0xff,
-	0xf8, 0xa6, 0x66, 0xd5, 0xb2, 0x9f, 0x3f, 0x4a, 0x7f, 0x6d, 0x1e, 0x3e, 0x3d, 0x6b, 0x6a, 0xcf,
-	0xce, 0x9a, 0xda, 0xaf, 0x67, 0x4d, 0xed, 0xf1, 0x79, 0x73, 0xe9, 0xd9, 0x79, 0x73, 0xe9, 0xe7,
-	0xf3, 0xe6, 0xd2, 0xa7, 0xbb, 0x7f, 0x9d, 0xfd, 0xf1, 0xfc, 0x7f, 0x07, 0x55, 0x08, 0x7b, 0x4d,
-	0xb1, 0xdf, 0xfe, 0x23, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xee, 0xba, 0xbd, 0x5c, 0x08, 0x00, 0x00,
+	// 1098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x8e, 0x3f, 0xc6, 0x76, 0xda, 0x4c, 0xd3, 0x6a, 0x9b, 0x80, 0x9d, 0x9a, 0x0f,
+	0x05, 0x44, 0x6c, 0x9a, 0x56, 0x08, 0x7a, 0x00, 0x65, 0xe3, 0x54, 0x09, 0x04, 0x6a, 0xad, 0x53,
+	0x0e, 0x08, 0x69, 0x34, 0xbb, 0x3b, 0x5e, 0x0f, 0xde, 0x9d, 0x59, 0xed, 0xcc, 0xa6, 0x09, 0x27,
+	0x4e, 0x88, 0x63, 0xb9, 0x21, 0x71, 0xe1, 0xc8, 0x91, 0x43, 0xff, 0x02, 0x4e, 0x15, 0xa7, 0xaa,
+	0x27, 0x94, 0x43, 0x40, 0xc9, 0x81, 0x7f, 0x03, 0xcd, 0xec, 0x7a, 0x9d, 0x54, 0xe6, 0x43, 0xd0,
+	0x5e, 0xac, 0x99, 0xdf, 0x3c, 0xcf, 0x7b, 0xef, 0xf7, 0x7b, 0xef, 0xcd, 0x82, 0x96, 0x83, 0x9d,
+	0xa3, 0x80, 0xb3, 0xce, 0x80, 0x32, 0x1c, 0x50, 0x79, 0xd4, 0x39, 0xb8, 0x99, 0xaf, 0xdb, 0x51,
+	0xcc, 0x25, 0x87, 0x57, 0x32, 0x9b, 0x76, 0x8e, 0x1f, 0xdc, 0x5c, 0xbe, 0xee, 0x72, 0x11, 0x72,
+	0x81, 0xb4, 0x49, 0x27, 0xdd, 0xa4, 0xf6, 0xcb, 0x4b, 0x3e, 0xf7, 0x79, 0x8a, 0xab, 0x55, 0x86,
+	0x2e, 0xe2, 0x90, 0x32, 0xde, 0xd1, 0xbf, 0x19, 0xd4, 0xf4, 0x39, 0xf7, 0x03, 0xd2, 0xd1, 0x3b,
+	0x27, 0x19, 0x74, 0x24, 0x0d, 0x89, 0x90, 0x38, 0x8c, 0x52, 0x83, 0xd6, 0x2f, 0x06, 0x58, 0xfa,
+	0x94, 0x4b, 0xca, 0xfc, 0x1e, 0x7f, 0x40, 0xe2, 0x2e, 0x15, 0x72, 0x0b, 0xbb, 0x43, 0x02, 0xd7,
+	0xc0, 0x65, 0xc9, 0x25, 0x0e, 0x90, 0xc3, 0x99, 0x47, 0x3c, 0x24, 0xb0, 0x34, 0x8d, 0x55, 0x63,
+	0xad, 0x60, 0x2f, 0x68, 0xdc, 0xd2, 0x70, 0x1f, 0x4b, 0xf8, 0x39, 0x80, 0xe3, 0xb0, 0x55, 0xac,
+	0x07, 0xd4, 0x23, 0xb1, 0x30, 0x67, 0x57, 0xe7, 0xd6, 0xaa, 0x1b, 0xeb, 0xed, 0x29, 0x99, 0xb5,
+	0xef, 0x66, 0xeb, 0x5e, 0x66, 0xad, 0xbc, 0xee, 0xb2, 0x01, 0xb7, 0x17, 0x07, 0xcf, 0x9c, 0x08,
+	0xf8, 0x2a, 0x58, 0x60, 0x49, 0x88, 0xb0, 0x2b, 0xe9, 0x01, 0x41, 0x83, 0x48, 0x98, 0x73, 0xab,
+	0xc6, 0x5a, 0xdd, 0xae, 0xb1, 0x24, 0xdc, 0xd4, 0xe0, 0xdd, 0x48, 0xdc, 0x29, 0x7c, 0xf3, 0x43,
+	0x73, 0xa6, 0xf5, 0xfd, 0x1c, 0x30, 0xff, 0xea, 0x6e, 0x78, 0x0f, 0x14, 0x1d, 0xe9, 0xa2, 0x68,
+	0xa4, 0xd3, 0xa8, 0x59, 0xef, 0x1e, 0x9f, 0x34, 0x6f, 0xfb, 0x54, 0x0e, 0x13, 0xa7, 0xed, 0xf2,
+	0xb0, 0x93, 0x05, 0x1a, 0x60, 0x47, 0xac, 0x53, 0x3e, 0xde, 0x76, 0xe4, 0x51, 0x44, 0x44, 0xdb,
+	0xda, 0xed, 0xdd, 0xba, 0xfd, 0x76, 0x2f, 0x71, 0x3e, 0x22, 0x47, 0xf6, 0xbc, 0x23, 0xdd, 0xde,
+	0x08, 0x42, 0x50, 0xc0, 0x9e, 0x17, 0x9b, 0xb3, 0xea, 0x3a, 0x5b, 0xaf, 0xe1, 0xc7, 0x00, 0xb8,
+	0x3c, 0x0c, 0xa9, 0x10, 0x94, 0x33, 0x1d, 0x69, 0xc5, 0x5a, 0x3f, 0x3e, 0x69, 0xae, 0xa4, 0xf2,
+	0x09, 0x6f, 0xd4, 0xa6, 0xbc, 0x13, 0x62, 0x39, 0x6c, 0xef, 0x11, 0x1f, 0xbb, 0x47, 0x5d, 0xe2,
+	0x3e, 0x7d, 0xb4, 0x0e, 0x32, 0x75, 0xbb, 0xc4, 0xb5, 0xcf, 0x5d, 0x30, 0x55, 0x84, 0xc2, 0x54,
+	0x11, 0xde, 0x07, 0x65, 0x95, 0x9d, 0x47, 0x02, 0x61, 0xce, 0x6b, 0xea, 0x5f, 0x99, 0x4a, 0xbd,
+	0xb5, 0xbf, 0xd5, 0x25, 0x41, 0x4e, 0x78, 0xc9, 0x91, 0x6e, 0x97, 0x04, 0x02, 0xbe, 0x06, 0x16,
+	0xa8, 0x40, 0x79, 0x75, 0x10, 0xcf, 0x2c, 0xae, 0x1a, 0x6b, 0x65, 0xbb, 0x4e, 0xc5, 0xfe, 0x04,
+	0x84, 0x2b, 0xa0, 0x42, 0x05, 0xfa, 0x02, 0xd3, 0x80, 0x78, 0x66, 0x49, 0x5b, 0x94, 0xa9, 0xf8,
+	0x50, 0xef, 0xe1, 0xcb, 0x00, 0x50, 0x81, 0x44, 0x80, 0xc5, 0x90, 0x78, 0x66, 0x59, 0x9f, 0x56,
+	0xa8, 0xe8, 0xa7, 0x40, 0xeb, 0xe7, 0x59, 0xb0, 0x70, 0xd1, 0xfd, 0xf3, 0xd7, 0xe4, 0x3d, 0x50,
+	0x15, 0x12, 0x8f, 0x48, 0x8c, 0x72, 0x69, 0x2a, 0x96, 0xf9, 0xf4, 0xd1, 0xfa, 0x52, 0xc6, 0xf0,
+	0xa6, 0xe7, 0xc5, 0x44, 0x88, 0xbe, 0x8c, 0x29, 0xf3, 0x6d, 0x90, 0x1a, 0x2b, 0x10, 0xbe, 0x0e,
+	0x2e, 0xa9, 0x1d, 0x65, 0x3e, 0x92, 0x87, 0x68, 0x88, 0xc5, 0x30, 0xd5, 0xcf, 0xae, 0x67, 0xf0,
+	0xfe, 0xe1, 0x0e, 0x16, 0x43, 0x45, 0x41, 0xaa, 0xc9, 0x44, 0x8c, 0xb2, 0x06, 0x94, 0x0c, 0x1f,
+	0x80, 0x85, 0x98, 0x3c, 0xc0, 0xb1, 0xa7, 0xfd, 0x13, 0xa1, 0xc4, 0xf8, 0xfb, 0x10, 0xea, 0xa9,
+	0x7d, 0x06, 0xc2, 0x06, 0xa8, 0x2a, 0x0e, 0x49, 0x30, 0x50, 0x5a, 0x9a, 0xc5, 0x9c, 0x44, 0x12,
+	0x0c, 0xba, 0x24, 0x68, 0x21, 0x50, 0xdb, 0x65, 0x1e, 0x39, 0x24, 0x9e, 0x15, 0x70, 0x77, 0x04,
+	0xaf, 0x81, 0xe2, 0x90, 0x50, 0x7f, 0x38, 0x6e, 0xce, 0x6c, 0x07, 0xaf, 0x83, 0x32, 0x8e, 0xa2,
+	0x34, 0x8d, 0xb4, 0x40, 0x4b, 0x38, 0x8a, 0x74, 0x02, 0x2f, 0x81, 0x4a, 0x5a, 0x11, 0x5f, 0x12,
+	0x4f, 0xa7, 0x58, 0xb6, 0x27, 0x40, 0xeb, 0x5b, 0x03, 0xd4, 0x7b, 0x89, 0x63, 0x63, 0xe6, 0x6d,
+	0xa9, 0x42, 0x94, 0xf0, 0x06, 0xa8, 0x09, 0x89, 0x63, 0x89, 0x2e, 0x38, 0xaa, 0x6a, 0x6c, 0x27,
+	0xf5, 0xb6, 0x0a, 0x54, 0x3b, 0xa2, 0x28, 0x71, 0x50, 0x8c, 0x99, 0xa7, 0x3d, 0x16, 0x6c, 0xc0,
+	0x92, 0x30, 0xbb, 0x0a, 0x36, 0xb2, 0xc6, 0x90, 0x21, 0x61, 0x52, 0x7b, 0xad, 0xd9, 0xe7, 0x10,
+	0xc5, 0x2a, 0x89, 0xb8, 0x3b, 0x44, 0x2c, 0x09, 0xc7, 0xac, 0x6a, 0xe0, 0x93, 0x24, 0x6c, 0x7d,
+	0x5d, 0x00, 0xe5, 0x6d, 0xd5, 0xcd, 0xcc, 0x25, 0x70, 0x1f, 0x54, 0x06, 0x11, 0x7a, 0x4e, 0x65,
+	0x53, 0x1a, 0x44, 0x96, 0x2e, 0x9c, 0x1b, 0xa0, 0xe6, 0x28, 0x42, 0xc7, 0x49, 0xa6, 0x19, 0x54,
+	0x35, 0x96, 0x25, 0x79, 0x1f, 0x94, 0xf3, 0x04, 0x75, 0x02, 0xd6, 0x9d, 0xe3, 0x93, 0xe6, 0x3b,
+	0xff, 0xd6, 0x6f, 0xdf, 0x1d, 0x32, 0x1e, 0xc7, 0x19, 0x21, 0x76, 0x29, 0xca, 0x98, 0x79, 0x0b,
+	0x40, 0x17, 0x33, 0xce, 0xa8, 0x8b, 0x03, 0x94, 0x6b, 0x56, 0xd0, 0x0c, 0x5d, 0xce, 0x4f, 0x36,
+	0x33, 0xf1, 0x5a, 0xa0, 0x3e, 0xe0, 0xf1, 0x68, 0x62, 0x38, 0xaf, 0x0d, 0xab, 0x0a, 0x1c, 0xdb,
+	0x44, 0xe0, 0xda, 0xe4, 0xc6, 0x7c, 0x34, 0x0b, 0xea, 0x9b, 0xc5, 0xff, 0x1c, 0xf6, 0xf6, 0xbd,
+	0xfd, 0x7e, 0x9f, 0xfa, 0xf6, 0x52, 0x7e, 0xf3, 0x78, 0xd0, 0xf6, 0xa9, 0x0f, 0x07, 0x60, 0x51,
+	0x47, 0x75, 0xc1, 0x59, 0xe9, 0x7f, 0x3b, 0xbb, 0xa4, 0x2e, 0x3d, 0xe7, 0xa7, 0xf5, 0xdd, 0x2c,
+	0x58, 0x79, 0x76, 0xc0, 0xf7, 0xa9, 0xcf, 0x28, 0xf3, 0xf5, 0x3c, 0x79, 0x61, 0xb5, 0x71, 0xa1,
+	0x01, 0x54, 0x6d, 0xcc, 0x5d, 0x6c, 0x80, 0x0d, 0x70, 0x55, 0xcd, 0x6c, 0xe2, 0x21, 0x5d, 0x31,
+	0x02, 0xb9, 0x3c, 0x61, 0x92, 0xc4, 0xba, 0x50, 0xe6, 0xec, 0x2b, 0xe9, 0xa1, 0x6e, 0x59, 0xb1,
+	0x95, 0x1e, 0xc1, 0x3d, 0x50, 0x4b, 0x07, 0x29, 0x4a, 0x98, 0xa4, 0x81, 0x96, 0xbc, 0xba, 0xb1,
+	0xdc, 0x4e, 0x9f, 0xec, 0xf6, 0xf8, 0xc9, 0x6e, 0xe7, 0xf3, 0xd7, 0xaa, 0x3f, 0x3e, 0x69, 0xce,
+	0x3c, 0xfc, 0xad, 0x69, 0xfc, 0xf8, 0xc7, 0x4f, 0x6f, 0x1a, 0x76, 0x35, 0xfd, 0xfb, 0x7d, 0xf5,
+	0xef, 0xd6, 0x57, 0x06, 0xb8, 0x9a, 0x3f, 0xe1, 0xdb, 0x07, 0x84, 0xc9, 0x3d, 0xee, 0x5b, 0x58,
+	0x10, 0x35, 0x96, 0x15, 0x23, 0xe7, 0xba, 0xb7, 0x6e, 0x57, 0x1c, 0xe9, 0x66, 0xa1, 0xef, 0x00,
+	0xe0, 0x51, 0x21, 0x91, 0xab, 0x9e, 0x7d, 0x9d, 0x5b, 0x75, 0xe3, 0x8d, 0xa9, 0x6f, 0xc7, 0xb4,
+	0xef, 0x04, 0xbb, 0xe2, 0x8d, 0x97, 0xd6, 0xde, 0xe3, 0xd3, 0x86, 0xf1, 0xe4, 0xb4, 0x61, 0xfc,
+	0x7e, 0xda, 0x30, 0x1e, 0x9e, 0x35, 0x66, 0x9e, 0x9c, 0x35, 0x66, 0x7e, 0x3d, 0x6b, 0xcc, 0x7c,
+	0xb6, 0xf1, 0xcf, 0x02, 0x1c, 0x4e, 0xbe, 0x8f, 0xb4, 0x16, 0x4e, 0x51, 0x13, 0x70, 0xeb, 0xcf,
+	0x01, 0x00, 0x00, 0x1f, 0x20, 0x9b, 0x40, 0x09, 0x00, 0x00,
 }
 
 func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
@@ -781,6 +866,23 @@ func (m *BTCDelDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsSelfDel {
+		i--
+		if m.IsSelfDel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.TotalSat != 0 {
 		i = encodeVarintFinality(dAtA, i, uint64(m.TotalSat))
 		i--
@@ -1048,6 +1150,46 @@ func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *PowerDistEventLogBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerDistEventLogBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerDistEventLogBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistCache != nil {
+		{
+			size, err := m.DistCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BtcHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFinality(v)
 	base := offset
@@ -1140,6 +1282,13 @@ func (m *BTCDelDistInfo) Size() (n int) {
 	if m.TotalSat != 0 {
 		n += 1 + sovFinality(uint64(m.TotalSat))
 	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.IsSelfDel {
+		n += 2
+	}
 	return n
 }
 
@@ -1241,6 +1390,22 @@ func (m *FinalityProviderSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *PowerDistEventLogBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovFinality(uint64(m.BtcHeight))
+	}
+	if m.DistCache != nil {
+		l = m.DistCache.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
 func sovFinality(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1784,6 +1949,58 @@ func (m *BTCDelDistInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSelfDel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSelfDel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -2502,6 +2719,111 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PowerDistEventLogBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerDistEventLogBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerDistEventLogBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistCache == nil {
+				m.DistCache = &VotingPowerDistCache{}
+			}
+			if err := m.DistCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFinality(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
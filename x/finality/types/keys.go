@@ -49,4 +49,6 @@ var (
 	FinalityProviderMissedBlockBitmapKeyPrefix = collections.NewPrefix(9) // key prefix for missed block bitmap
 	VotingPowerKey                             = []byte{0x10}             // key prefix for the voting power
 	VotingPowerDistCacheKey                    = []byte{0x11}             // key prefix for voting power distribution cache
+	PowerDistEventLogKey                       = []byte{0x12}             // key prefix for the log of consumed power distribution update events
+	PowerDistEventLogBaseKey                   = []byte{0x13}             // key for the voting power distribution the event log is replayed from
 )
@@ -27,6 +27,12 @@ const (
 	// MetricsKeyJailedFinalityProviderCounter is the number of finality providers
 	// that are being labeled as jailed
 	MetricsKeyJailedFinalityProviderCounter = "jailed_finality_provider_counter"
+
+	/* Metrics for monitoring the power distribution update event log */
+
+	// MetricsKeyPowerDistEventLogSize is the key of the gauge recording the
+	// number of logged power distribution update events
+	MetricsKeyPowerDistEventLogSize = "power_dist_event_log_size"
 )
 
 // RecordLastHeight records the last height. It is triggered upon `IndexBlock`
@@ -75,3 +81,15 @@ func DecrementJailedFinalityProviderCounter() {
 		labels,
 	)
 }
+
+// RecordPowerDistEventLogSize records the number of logged power distribution
+// update events. It is triggered upon `PrunePowerDistEventLog`
+func RecordPowerDistEventLogSize(size int) {
+	keys := []string{MetricsKeyPowerDistEventLogSize}
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, ModuleName)}
+	telemetry.SetGaugeWithLabels(
+		keys,
+		float32(size),
+		labels,
+	)
+}
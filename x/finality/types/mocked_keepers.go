@@ -10,7 +10,9 @@ import (
 
 	types "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	types0 "github.com/babylonlabs-io/babylon/x/epoching/types"
-	types1 "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/babylonlabs-io/babylon/x/incentive/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -49,6 +51,18 @@ func (mr *MockBTCStakingKeeperMockRecorder) ClearPowerDistUpdateEvents(ctx, btcH
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPowerDistUpdateEvents", reflect.TypeOf((*MockBTCStakingKeeper)(nil).ClearPowerDistUpdateEvents), ctx, btcHeight)
 }
 
+// EmitJSONEvent mocks base method.
+func (m *MockBTCStakingKeeper) EmitJSONEvent(ctx context.Context, ev proto.Message) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EmitJSONEvent", ctx, ev)
+}
+
+// EmitJSONEvent indicates an expected call of EmitJSONEvent.
+func (mr *MockBTCStakingKeeperMockRecorder) EmitJSONEvent(ctx, ev interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmitJSONEvent", reflect.TypeOf((*MockBTCStakingKeeper)(nil).EmitJSONEvent), ctx, ev)
+}
+
 // GetAllPowerDistUpdateEvents mocks base method.
 func (m *MockBTCStakingKeeper) GetAllPowerDistUpdateEvents(ctx context.Context, lastBTCTipHeight, btcTipHeight uint32) []*types.EventPowerDistUpdate {
 	m.ctrl.T.Helper()
@@ -149,6 +163,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) HasFinalityProvider(ctx, fpBTCPK int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasFinalityProvider), ctx, fpBTCPK)
 }
 
+// IterateActiveBTCDelegations mocks base method.
+func (m *MockBTCStakingKeeper) IterateActiveBTCDelegations(ctx context.Context, btcHeight uint32, handler func(*types.BTCDelegation) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateActiveBTCDelegations", ctx, btcHeight, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateActiveBTCDelegations indicates an expected call of IterateActiveBTCDelegations.
+func (mr *MockBTCStakingKeeperMockRecorder) IterateActiveBTCDelegations(ctx, btcHeight, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateActiveBTCDelegations", reflect.TypeOf((*MockBTCStakingKeeper)(nil).IterateActiveBTCDelegations), ctx, btcHeight, handler)
+}
+
 // JailFinalityProvider mocks base method.
 func (m *MockBTCStakingKeeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
 	m.ctrl.T.Helper()
@@ -163,18 +191,32 @@ func (mr *MockBTCStakingKeeperMockRecorder) JailFinalityProvider(ctx, fpBTCPK in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).JailFinalityProvider), ctx, fpBTCPK)
 }
 
+// MarkBTCDelegationExpired mocks base method.
+func (m *MockBTCStakingKeeper) MarkBTCDelegationExpired(ctx context.Context, stakingTxHashStr string, btcHeight uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBTCDelegationExpired", ctx, stakingTxHashStr, btcHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkBTCDelegationExpired indicates an expected call of MarkBTCDelegationExpired.
+func (mr *MockBTCStakingKeeperMockRecorder) MarkBTCDelegationExpired(ctx, stakingTxHashStr, btcHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBTCDelegationExpired", reflect.TypeOf((*MockBTCStakingKeeper)(nil).MarkBTCDelegationExpired), ctx, stakingTxHashStr, btcHeight)
+}
+
 // SlashFinalityProvider mocks base method.
-func (m *MockBTCStakingKeeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+func (m *MockBTCStakingKeeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte, reason types.SlashingReason) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SlashFinalityProvider", ctx, fpBTCPK)
+	ret := m.ctrl.Call(m, "SlashFinalityProvider", ctx, fpBTCPK, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SlashFinalityProvider indicates an expected call of SlashFinalityProvider.
-func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK, reason)
 }
 
 // UnjailFinalityProvider mocks base method.
@@ -265,8 +307,22 @@ func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetBTCStakingGauge mocks base method.
+func (m *MockIncentiveKeeper) GetBTCStakingGauge(ctx context.Context, height uint64) *types1.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBTCStakingGauge", ctx, height)
+	ret0, _ := ret[0].(*types1.Gauge)
+	return ret0
+}
+
+// GetBTCStakingGauge indicates an expected call of GetBTCStakingGauge.
+func (mr *MockIncentiveKeeperMockRecorder) GetBTCStakingGauge(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBTCStakingGauge", reflect.TypeOf((*MockIncentiveKeeper)(nil).GetBTCStakingGauge), ctx, height)
+}
+
 // IndexRefundableMsg mocks base method.
-func (m *MockIncentiveKeeper) IndexRefundableMsg(ctx context.Context, msg types1.Msg) {
+func (m *MockIncentiveKeeper) IndexRefundableMsg(ctx context.Context, msg types2.Msg) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IndexRefundableMsg", ctx, msg)
 }
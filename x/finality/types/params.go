@@ -23,6 +23,9 @@ const (
 	// be 17280 + 220 = 17500.
 	// For now it is set to 1 to avoid breaking dependencies.
 	DefaultFinalityActivationHeight = 1
+	// DefaultPowerDistEventRetentionBlocks retains the consumed power
+	// distribution update events for about 4 weeks of BTC blocks
+	DefaultPowerDistEventRetentionBlocks = uint32(4032)
 )
 
 var (
@@ -34,13 +37,14 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		MaxActiveFinalityProviders: DefaultMaxActiveFinalityProviders,
-		FinalitySigTimeout:         DefaultFinalitySigTimeout,
-		SignedBlocksWindow:         DefaultSignedBlocksWindow,
-		MinSignedPerWindow:         DefaultMinSignedPerWindow,
-		MinPubRand:                 DefaultMinPubRand,
-		JailDuration:               DefaultJailDuration,
-		FinalityActivationHeight:   DefaultFinalityActivationHeight,
+		MaxActiveFinalityProviders:    DefaultMaxActiveFinalityProviders,
+		FinalitySigTimeout:            DefaultFinalitySigTimeout,
+		SignedBlocksWindow:            DefaultSignedBlocksWindow,
+		MinSignedPerWindow:            DefaultMinSignedPerWindow,
+		MinPubRand:                    DefaultMinPubRand,
+		JailDuration:                  DefaultJailDuration,
+		FinalityActivationHeight:      DefaultFinalityActivationHeight,
+		PowerDistEventRetentionBlocks: DefaultPowerDistEventRetentionBlocks,
 	}
 }
 
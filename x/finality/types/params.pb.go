@@ -51,6 +51,11 @@ type Params struct {
 	// start to accept finality voting and the minimum allowed value for the public randomness
 	// commit start height.
 	FinalityActivationHeight uint64 `protobuf:"varint,7,opt,name=finality_activation_height,json=finalityActivationHeight,proto3" json:"finality_activation_height,omitempty"`
+	// power_dist_event_retention_blocks is the number of BTC blocks for which the
+	// consumed power distribution update events are retained, such that the
+	// voting power distribution at any BTC height within this window behind the
+	// BTC tip can be reconstructed. 0 means the events are never pruned
+	PowerDistEventRetentionBlocks uint32 `protobuf:"varint,8,opt,name=power_dist_event_retention_blocks,json=powerDistEventRetentionBlocks,proto3" json:"power_dist_event_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,6 +132,13 @@ func (m *Params) GetFinalityActivationHeight() uint64 {
 	return 0
 }
 
+func (m *Params) GetPowerDistEventRetentionBlocks() uint32 {
+	if m != nil {
+		return m.PowerDistEventRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -134,38 +146,40 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x31, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0x7f, 0xfb, 0x2f, 0x52, 0x68, 0x07, 0xc2, 0x21, 0xe5, 0x8a, 0x48, 0x23, 0xa6,
-	0x0a, 0xe9, 0x12, 0xee, 0x90, 0x18, 0x10, 0x4b, 0xab, 0x0a, 0x31, 0x1c, 0x52, 0xd5, 0x43, 0x42,
-	0x62, 0xb1, 0x9c, 0xc4, 0xe7, 0xbe, 0x5c, 0x6c, 0x57, 0xb1, 0xd3, 0x6b, 0xbf, 0x05, 0xe3, 0x8d,
-	0x8c, 0x8c, 0x0c, 0x7c, 0x88, 0xdb, 0x38, 0x31, 0x21, 0x86, 0x03, 0xb5, 0x03, 0x5f, 0x03, 0xc5,
-	0x8e, 0xcb, 0x12, 0xe5, 0xf5, 0xf3, 0x7b, 0xfd, 0xbc, 0x79, 0xf2, 0x7a, 0x51, 0x4a, 0xd2, 0x4d,
-	0x21, 0x45, 0x72, 0x0e, 0x82, 0x14, 0xa0, 0x37, 0xc9, 0xea, 0x38, 0x59, 0x92, 0x92, 0x70, 0x15,
-	0x2f, 0x4b, 0xa9, 0xa5, 0x7f, 0xbf, 0x21, 0x62, 0x47, 0xc4, 0xab, 0xe3, 0xc1, 0x01, 0x93, 0x4c,
-	0x1a, 0x3d, 0xa9, 0xdf, 0x2c, 0x3a, 0xb8, 0x47, 0x38, 0x08, 0x99, 0x98, 0x67, 0x73, 0x74, 0x98,
-	0x49, 0xc5, 0xa5, 0xc2, 0x96, 0xb5, 0x45, 0x23, 0x85, 0x4c, 0x4a, 0x56, 0xd0, 0xc4, 0x54, 0x69,
-	0x75, 0x9e, 0xe4, 0x55, 0x49, 0x34, 0x48, 0x61, 0xf5, 0xc7, 0xdf, 0xda, 0x5e, 0x77, 0x66, 0x26,
-	0xf1, 0xc7, 0xde, 0x23, 0x4e, 0xd6, 0x98, 0x64, 0x1a, 0x56, 0x14, 0xbb, 0x41, 0xea, 0x4b, 0x57,
-	0x90, 0xd3, 0x52, 0x05, 0x28, 0x42, 0xa3, 0xfe, 0x7c, 0xc0, 0xc9, 0x7a, 0x6c, 0x98, 0x57, 0x0d,
-	0x32, 0x73, 0x84, 0xff, 0xd4, 0x3b, 0x50, 0xc0, 0x04, 0xcd, 0x71, 0x5a, 0xc8, 0xec, 0x42, 0xe1,
-	0x4b, 0x10, 0xb9, 0xbc, 0x0c, 0xfe, 0x8b, 0xd0, 0xa8, 0x3d, 0xf7, 0xad, 0x36, 0x31, 0xd2, 0x3b,
-	0xa3, 0xd4, 0x1d, 0x7b, 0x27, 0x05, 0x0c, 0x6b, 0xe0, 0x54, 0x56, 0x3a, 0x68, 0xdb, 0x0e, 0xa7,
-	0x9d, 0x01, 0x7b, 0x6b, 0x15, 0x1f, 0xbc, 0x07, 0x1c, 0x04, 0x6e, 0x7c, 0x96, 0xb4, 0x74, 0x26,
-	0x9d, 0x08, 0x8d, 0x7a, 0x93, 0xe7, 0xd7, 0xb7, 0xc3, 0xd6, 0xcf, 0xdb, 0xe1, 0x43, 0x1b, 0x83,
-	0xca, 0x2f, 0x62, 0x90, 0x09, 0x27, 0x7a, 0x11, 0x9f, 0x52, 0x46, 0xb2, 0xcd, 0x94, 0x66, 0xdf,
-	0xbf, 0x1e, 0x79, 0x4d, 0x4a, 0x53, 0x9a, 0x7d, 0xfe, 0xf3, 0xe5, 0x09, 0x9a, 0xfb, 0x1c, 0xc4,
-	0x99, 0xb9, 0x73, 0x46, 0xcb, 0x66, 0xb8, 0xc8, 0xeb, 0xd5, 0x56, 0xcb, 0x2a, 0xc5, 0x25, 0x11,
-	0x79, 0xf0, 0x7f, 0x84, 0x46, 0x9d, 0xb9, 0xc7, 0x41, 0xcc, 0xaa, 0x74, 0x4e, 0x44, 0xee, 0xbf,
-	0xf1, 0xfa, 0x1f, 0x08, 0x14, 0xd8, 0xa5, 0x1a, 0x74, 0x23, 0x34, 0xba, 0x7b, 0x72, 0x18, 0xdb,
-	0xd8, 0x63, 0x17, 0x7b, 0x3c, 0x6d, 0x80, 0x49, 0xbf, 0x9e, 0xef, 0xea, 0xd7, 0x10, 0x59, 0xdb,
-	0x5e, 0xdd, 0xee, 0x44, 0xff, 0xa5, 0x37, 0xd8, 0xa7, 0x61, 0xfe, 0x83, 0x39, 0xc6, 0x0b, 0x0a,
-	0x6c, 0xa1, 0x83, 0x3b, 0xc6, 0x3e, 0x70, 0xc4, 0x78, 0x0f, 0xbc, 0x36, 0xfa, 0x8b, 0xce, 0xd5,
-	0xa7, 0x61, 0x6b, 0x72, 0x7a, 0xbd, 0x0d, 0xd1, 0xcd, 0x36, 0x44, 0xbf, 0xb7, 0x21, 0xfa, 0xb8,
-	0x0b, 0x5b, 0x37, 0xbb, 0xb0, 0xf5, 0x63, 0x17, 0xb6, 0xde, 0x9f, 0x30, 0xd0, 0x8b, 0x2a, 0x8d,
-	0x33, 0xc9, 0x93, 0x66, 0xdf, 0x0a, 0x92, 0xaa, 0x23, 0x90, 0xae, 0x4c, 0xd6, 0xff, 0x56, 0x54,
-	0x6f, 0x96, 0x54, 0xa5, 0x5d, 0xf3, 0x05, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x27,
-	0x77, 0xaf, 0xc3, 0x02, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0x2f, 0xf4, 0x28, 0xc8, 0xb4, 0x03, 0xa1, 0x48, 0xe9, 0xa1, 0xe6, 0x02, 0xd3, 0x09,
+	0xa9, 0x09, 0x2d, 0x12, 0x03, 0x62, 0xb9, 0xd3, 0x81, 0x3a, 0x14, 0xe9, 0x94, 0x22, 0x21, 0xb1,
+	0x58, 0x4e, 0xe2, 0xe6, 0x5e, 0x1a, 0xdb, 0x51, 0xec, 0xdc, 0x9f, 0x6f, 0xc1, 0x58, 0x36, 0x46,
+	0x46, 0x06, 0x3e, 0x44, 0xc7, 0x8a, 0x09, 0x31, 0x14, 0x74, 0x37, 0xf0, 0x35, 0x50, 0xec, 0xf8,
+	0x58, 0xa2, 0xd8, 0xcf, 0xef, 0x7d, 0x9f, 0x57, 0xcf, 0x6b, 0x14, 0x24, 0x24, 0x59, 0x16, 0x82,
+	0x47, 0xe7, 0xc0, 0x49, 0x01, 0x6a, 0x19, 0xcd, 0x8e, 0xa2, 0x92, 0x54, 0x84, 0xc9, 0xb0, 0xac,
+	0x84, 0x12, 0xee, 0x83, 0x96, 0x08, 0x2d, 0x11, 0xce, 0x8e, 0x7a, 0x7b, 0xb9, 0xc8, 0x85, 0xd6,
+	0xa3, 0xe6, 0xcf, 0xa0, 0xbd, 0xfb, 0x84, 0x01, 0x17, 0x91, 0xfe, 0xb6, 0x57, 0xfb, 0xa9, 0x90,
+	0x4c, 0x48, 0x6c, 0x58, 0x73, 0x68, 0x25, 0x3f, 0x17, 0x22, 0x2f, 0x68, 0xa4, 0x4f, 0x49, 0x7d,
+	0x1e, 0x65, 0x75, 0x45, 0x14, 0x08, 0x6e, 0xf4, 0x27, 0x9f, 0xbb, 0x68, 0x7b, 0xa2, 0x27, 0x71,
+	0x87, 0xe8, 0x80, 0x91, 0x05, 0x26, 0xa9, 0x82, 0x19, 0xc5, 0x76, 0x90, 0xa6, 0xe9, 0x0c, 0x32,
+	0x5a, 0x49, 0xcf, 0x09, 0x9c, 0xc1, 0x6e, 0xdc, 0x63, 0x64, 0x31, 0xd4, 0xcc, 0x9b, 0x16, 0x99,
+	0x58, 0xc2, 0x7d, 0x86, 0xf6, 0x24, 0xe4, 0x9c, 0x66, 0x38, 0x29, 0x44, 0x7a, 0x21, 0xf1, 0x1c,
+	0x78, 0x26, 0xe6, 0xde, 0xad, 0xc0, 0x19, 0x6c, 0xc5, 0xae, 0xd1, 0x46, 0x5a, 0x7a, 0xaf, 0x95,
+	0xa6, 0x62, 0xe3, 0x24, 0x21, 0xc7, 0x0a, 0x18, 0x15, 0xb5, 0xf2, 0xb6, 0x4c, 0x85, 0xd5, 0xce,
+	0x20, 0x7f, 0x67, 0x14, 0x17, 0xd0, 0x43, 0x06, 0x1c, 0xb7, 0x3e, 0x25, 0xad, 0xac, 0x49, 0x37,
+	0x70, 0x06, 0x3b, 0xa3, 0x17, 0x57, 0x37, 0xfd, 0xce, 0xaf, 0x9b, 0xfe, 0x23, 0x13, 0x83, 0xcc,
+	0x2e, 0x42, 0x10, 0x11, 0x23, 0x6a, 0x1a, 0x9e, 0xd2, 0x9c, 0xa4, 0xcb, 0x31, 0x4d, 0x7f, 0x7c,
+	0x3f, 0x44, 0x6d, 0x4a, 0x63, 0x9a, 0x7e, 0xfd, 0xfb, 0xed, 0xa9, 0x13, 0xbb, 0x0c, 0xf8, 0x99,
+	0xee, 0x39, 0xa1, 0x55, 0x3b, 0x5c, 0x80, 0x76, 0x1a, 0xab, 0xb2, 0x4e, 0x70, 0x45, 0x78, 0xe6,
+	0xdd, 0x0e, 0x9c, 0x41, 0x37, 0x46, 0x0c, 0xf8, 0xa4, 0x4e, 0x62, 0xc2, 0x33, 0xf7, 0x2d, 0xda,
+	0xfd, 0x48, 0xa0, 0xc0, 0x36, 0x55, 0x6f, 0x3b, 0x70, 0x06, 0xf7, 0x8e, 0xf7, 0x43, 0x13, 0x7b,
+	0x68, 0x63, 0x0f, 0xc7, 0x2d, 0x30, 0xda, 0x6d, 0xe6, 0xbb, 0xfc, 0xdd, 0x77, 0x8c, 0xed, 0x4e,
+	0x53, 0x6e, 0x45, 0xf7, 0x15, 0xea, 0x6d, 0xd2, 0xd0, 0x7b, 0xd0, 0xd7, 0x78, 0x4a, 0x21, 0x9f,
+	0x2a, 0xef, 0x8e, 0xb6, 0xf7, 0x2c, 0x31, 0xdc, 0x00, 0x27, 0x5a, 0x77, 0x4f, 0xd0, 0xe3, 0x52,
+	0xcc, 0x69, 0x85, 0x33, 0x90, 0x0a, 0xd3, 0x19, 0xe5, 0x0a, 0x57, 0x54, 0x51, 0xae, 0x9b, 0x98,
+	0x8d, 0x78, 0x77, 0xf5, 0x12, 0x0f, 0x34, 0x38, 0x06, 0xa9, 0x5e, 0x37, 0x58, 0x6c, 0x29, 0xb3,
+	0x9b, 0x97, 0xdd, 0xcb, 0x2f, 0xfd, 0xce, 0xe8, 0xf4, 0x6a, 0xe5, 0x3b, 0xd7, 0x2b, 0xdf, 0xf9,
+	0xb3, 0xf2, 0x9d, 0x4f, 0x6b, 0xbf, 0x73, 0xbd, 0xf6, 0x3b, 0x3f, 0xd7, 0x7e, 0xe7, 0xc3, 0x71,
+	0x0e, 0x6a, 0x5a, 0x27, 0x61, 0x2a, 0x58, 0xd4, 0xbe, 0xdc, 0x82, 0x24, 0xf2, 0x10, 0x84, 0x3d,
+	0x46, 0x8b, 0xff, 0x8f, 0x5d, 0x2d, 0x4b, 0x2a, 0x93, 0x6d, 0x9d, 0xc5, 0xf3, 0x7f, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x57, 0x81, 0x5d, 0x1a, 0x0d, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -188,6 +202,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PowerDistEventRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PowerDistEventRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.FinalityActivationHeight != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.FinalityActivationHeight))
 		i--
@@ -270,6 +289,9 @@ func (m *Params) Size() (n int) {
 	if m.FinalityActivationHeight != 0 {
 		n += 1 + sovParams(uint64(m.FinalityActivationHeight))
 	}
+	if m.PowerDistEventRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PowerDistEventRetentionBlocks))
+	}
 	return n
 }
 
@@ -469,6 +491,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDistEventRetentionBlocks", wireType)
+			}
+			m.PowerDistEventRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerDistEventRetentionBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -160,6 +160,32 @@ func (dc *VotingPowerDistCache) GetFinalityProviderPortion(v *FinalityProviderDi
 	return sdkmath.LegacyNewDec(int64(v.TotalBondedSat)).QuoTruncate(sdkmath.LegacyNewDec(int64(dc.TotalBondedSat)))
 }
 
+// GetRewardPortion returns the portion of the BTC staking rewards that the given
+// address receives under this voting power distribution, assuming all active
+// finality providers vote. It includes both the commission of the finality
+// providers and the rewards of the BTC delegations going to the address
+func (dc *VotingPowerDistCache) GetRewardPortion(addr sdk.AccAddress) sdkmath.LegacyDec {
+	portion := sdkmath.LegacyZeroDec()
+	if dc.TotalBondedSat == 0 {
+		return portion
+	}
+
+	for _, fp := range dc.FinalityProviders[:dc.NumActiveFps] {
+		fpPortion := dc.GetFinalityProviderPortion(fp)
+		if fp.GetAddress().Equals(addr) {
+			portion = portion.Add(fpPortion.Mul(*fp.Commission))
+		}
+		btcDelsPortion := fpPortion.Mul(sdkmath.LegacyOneDec().Sub(*fp.Commission))
+		for _, btcDel := range fp.BtcDels {
+			if btcDel.GetAddress().Equals(addr) {
+				portion = portion.Add(btcDelsPortion.Mul(fp.GetBTCDelPortion(btcDel)))
+			}
+		}
+	}
+
+	return portion
+}
+
 func NewFinalityProviderDistInfo(fp *bstypes.FinalityProvider) *FinalityProviderDistInfo {
 	return &FinalityProviderDistInfo{
 		BtcPk:          fp.BtcPk,
@@ -180,6 +206,10 @@ func (v *FinalityProviderDistInfo) AddBTCDel(btcDel *bstypes.BTCDelegation) {
 		StakerAddr:    btcDel.StakerAddr,
 		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
 		TotalSat:      btcDel.TotalSat,
+		RewardAddress: btcDel.GetRewardRecipient(),
+		// parse the staker address once upon building the cache, so that
+		// the self-delegations do not need to be identified per block
+		IsSelfDel: sdk.MustAccAddressFromBech32(btcDel.StakerAddr).Equals(v.GetAddress()),
 	}
 	v.BtcDels = append(v.BtcDels, btcDelDistInfo)
 	v.TotalBondedSat += btcDelDistInfo.TotalSat
@@ -196,7 +226,12 @@ func (v *FinalityProviderDistInfo) GetBTCDelPortion(d *BTCDelDistInfo) sdkmath.L
 	return sdkmath.LegacyNewDec(int64(d.TotalSat)).QuoTruncate(sdkmath.LegacyNewDec(int64(v.TotalBondedSat)))
 }
 
+// GetAddress returns the address to receive rewards from the BTC delegation,
+// which is the staker address if no reward address is set
 func (d *BTCDelDistInfo) GetAddress() sdk.AccAddress {
+	if len(d.RewardAddress) > 0 {
+		return sdk.MustAccAddressFromBech32(d.RewardAddress)
+	}
 	return sdk.MustAccAddressFromBech32(d.StakerAddr)
 }
 
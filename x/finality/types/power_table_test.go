@@ -9,6 +9,7 @@ import (
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	bbn "github.com/babylonlabs-io/babylon/types"
+	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	types "github.com/babylonlabs-io/babylon/x/finality/types"
 )
 
@@ -191,6 +192,40 @@ func TestVotingPowerDistCache(t *testing.T) {
 	}
 }
 
+func TestAddBTCDelSelfDelegation(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	fpDistInfo := types.NewFinalityProviderDistInfo(fp)
+
+	stakingTx, err := bbn.SerializeBTCTx(datagen.GenRandomTx(r))
+	require.NoError(t, err)
+	otherStakerAddr := datagen.GenRandomAccount().Address
+
+	// a BTC delegation staked by the finality provider is a self-delegation,
+	// even if its rewards are routed to another address
+	fpDistInfo.AddBTCDel(&bstypes.BTCDelegation{
+		StakerAddr:    fp.Addr,
+		StakingTx:     stakingTx,
+		TotalSat:      1000,
+		RewardAddress: otherStakerAddr,
+	})
+	// a BTC delegation staked by another staker is not a self-delegation,
+	// even if its rewards are routed to the finality provider
+	fpDistInfo.AddBTCDel(&bstypes.BTCDelegation{
+		StakerAddr:    otherStakerAddr,
+		StakingTx:     stakingTx,
+		TotalSat:      2000,
+		RewardAddress: fp.Addr,
+	})
+
+	require.Len(t, fpDistInfo.BtcDels, 2)
+	require.True(t, fpDistInfo.BtcDels[0].IsSelfDel)
+	require.False(t, fpDistInfo.BtcDels[1].IsSelfDel)
+	require.Equal(t, uint64(3000), fpDistInfo.TotalBondedSat)
+}
+
 func TestSortFinalityProvidersWithZeroedVotingPower(t *testing.T) {
 	tests := []struct {
 		name     string
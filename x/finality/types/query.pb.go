@@ -5,8 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1806,6 +1810,414 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryProjectedRewardsRequest is the request type for the
+// Query/ProjectedRewards RPC method
+type QueryProjectedRewardsRequest struct {
+	// address is the bech32 address receiving the rewards, i.e., the reward
+	// address of BTC delegations or the address of a finality provider
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// num_epochs is the number of upcoming epochs to project the rewards over
+	NumEpochs uint64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+}
+
+func (m *QueryProjectedRewardsRequest) Reset()         { *m = QueryProjectedRewardsRequest{} }
+func (m *QueryProjectedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardsRequest) ProtoMessage()    {}
+func (*QueryProjectedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{32}
+}
+func (m *QueryProjectedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardsRequest.Merge(m, src)
+}
+func (m *QueryProjectedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryProjectedRewardsRequest) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+// QueryProjectedRewardsResponse is the response type for the
+// Query/ProjectedRewards RPC method. All values are estimates and do not
+// guarantee any future rewards
+type QueryProjectedRewardsResponse struct {
+	// accrual_per_epoch is the total BTC staking rewards accrued in the gauges
+	// over the most recent epoch, used as the expected accrual per epoch
+	AccrualPerEpoch github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=accrual_per_epoch,json=accrualPerEpoch,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrual_per_epoch"`
+	// reward_share is the share of the BTC staking rewards the address receives
+	// under the current voting power distribution
+	RewardShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=reward_share,json=rewardShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_share"`
+	// projected_rewards is the estimated rewards of the address over the next
+	// num_epochs epochs
+	ProjectedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=projected_rewards,json=projectedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"projected_rewards"`
+}
+
+func (m *QueryProjectedRewardsResponse) Reset()         { *m = QueryProjectedRewardsResponse{} }
+func (m *QueryProjectedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardsResponse) ProtoMessage()    {}
+func (*QueryProjectedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{33}
+}
+func (m *QueryProjectedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardsResponse.Merge(m, src)
+}
+func (m *QueryProjectedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedRewardsResponse) GetAccrualPerEpoch() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AccrualPerEpoch
+	}
+	return nil
+}
+
+func (m *QueryProjectedRewardsResponse) GetProjectedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProjectedRewards
+	}
+	return nil
+}
+
+// QueryVotingPowerDistributionAtRequest is the request type for the
+// Query/VotingPowerDistributionAt RPC method
+type QueryVotingPowerDistributionAtRequest struct {
+	// btc_height is the BTC tip height at which the voting power distribution
+	// is reconstructed
+	BtcHeight uint32 `protobuf:"varint,1,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+}
+
+func (m *QueryVotingPowerDistributionAtRequest) Reset()         { *m = QueryVotingPowerDistributionAtRequest{} }
+func (m *QueryVotingPowerDistributionAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerDistributionAtRequest) ProtoMessage()    {}
+func (*QueryVotingPowerDistributionAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{34}
+}
+func (m *QueryVotingPowerDistributionAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerDistributionAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerDistributionAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerDistributionAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerDistributionAtRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerDistributionAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerDistributionAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerDistributionAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerDistributionAtRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowerDistributionAtRequest) GetBtcHeight() uint32 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+// FinalityProviderActiveSats is the active sats of a finality provider in a
+// voting power distribution
+type FinalityProviderActiveSats struct {
+	// fp_btc_pk_hex is the hex string of the BTC PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// active_sats is the total amount of active BTC stake (in Satoshi)
+	// delegated to the finality provider
+	ActiveSats uint64 `protobuf:"varint,2,opt,name=active_sats,json=activeSats,proto3" json:"active_sats,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (m *FinalityProviderActiveSats) Reset()         { *m = FinalityProviderActiveSats{} }
+func (m *FinalityProviderActiveSats) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderActiveSats) ProtoMessage()    {}
+func (*FinalityProviderActiveSats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{35}
+}
+func (m *FinalityProviderActiveSats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderActiveSats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderActiveSats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderActiveSats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderActiveSats.Merge(m, src)
+}
+func (m *FinalityProviderActiveSats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderActiveSats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderActiveSats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderActiveSats proto.InternalMessageInfo
+
+func (m *FinalityProviderActiveSats) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *FinalityProviderActiveSats) GetActiveSats() uint64 {
+	if m != nil {
+		return m.ActiveSats
+	}
+	return 0
+}
+
+func (m *FinalityProviderActiveSats) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+// QueryVotingPowerDistributionAtResponse is the response type for the
+// Query/VotingPowerDistributionAt RPC method
+type QueryVotingPowerDistributionAtResponse struct {
+	// finality_providers is the active sats of each finality provider with
+	// active BTC stake, sorted by the BTC PK hex
+	FinalityProviders []*FinalityProviderActiveSats `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+	// total_active_sats is the total amount of active BTC stake (in Satoshi)
+	// of all the finality providers
+	TotalActiveSats uint64 `protobuf:"varint,2,opt,name=total_active_sats,json=totalActiveSats,proto3" json:"total_active_sats,omitempty"`
+}
+
+func (m *QueryVotingPowerDistributionAtResponse) Reset() {
+	*m = QueryVotingPowerDistributionAtResponse{}
+}
+func (m *QueryVotingPowerDistributionAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerDistributionAtResponse) ProtoMessage()    {}
+func (*QueryVotingPowerDistributionAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{36}
+}
+func (m *QueryVotingPowerDistributionAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerDistributionAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerDistributionAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerDistributionAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerDistributionAtResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerDistributionAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerDistributionAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerDistributionAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerDistributionAtResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerDistributionAtResponse) GetFinalityProviders() []*FinalityProviderActiveSats {
+	if m != nil {
+		return m.FinalityProviders
+	}
+	return nil
+}
+
+func (m *QueryVotingPowerDistributionAtResponse) GetTotalActiveSats() uint64 {
+	if m != nil {
+		return m.TotalActiveSats
+	}
+	return 0
+}
+
+// QueryFinalityProviderSlashableAmountRequest is the request type for the
+// Query/FinalityProviderSlashableAmount RPC method
+type QueryFinalityProviderSlashableAmountRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality
+	// provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryFinalityProviderSlashableAmountRequest) Reset() {
+	*m = QueryFinalityProviderSlashableAmountRequest{}
+}
+func (m *QueryFinalityProviderSlashableAmountRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderSlashableAmountRequest) ProtoMessage() {}
+func (*QueryFinalityProviderSlashableAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{37}
+}
+func (m *QueryFinalityProviderSlashableAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderSlashableAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderSlashableAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderSlashableAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderSlashableAmountRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderSlashableAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderSlashableAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderSlashableAmountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderSlashableAmountRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderSlashableAmountRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+// QueryFinalityProviderSlashableAmountResponse is the response type for the
+// Query/FinalityProviderSlashableAmount RPC method
+type QueryFinalityProviderSlashableAmountResponse struct {
+	// height is the Babylon height of the voting power distribution the
+	// amount is taken from
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// slashable_sats is the total amount of active BTC stake (in Satoshi)
+	// delegated to the finality provider, all of which is at risk of being
+	// slashed if the finality provider misbehaves
+	SlashableSats uint64 `protobuf:"varint,2,opt,name=slashable_sats,json=slashableSats,proto3" json:"slashable_sats,omitempty"`
+}
+
+func (m *QueryFinalityProviderSlashableAmountResponse) Reset() {
+	*m = QueryFinalityProviderSlashableAmountResponse{}
+}
+func (m *QueryFinalityProviderSlashableAmountResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderSlashableAmountResponse) ProtoMessage() {}
+func (*QueryFinalityProviderSlashableAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{38}
+}
+func (m *QueryFinalityProviderSlashableAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderSlashableAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderSlashableAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderSlashableAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderSlashableAmountResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderSlashableAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderSlashableAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderSlashableAmountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderSlashableAmountResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderSlashableAmountResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderSlashableAmountResponse) GetSlashableSats() uint64 {
+	if m != nil {
+		return m.SlashableSats
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
@@ -1842,134 +2254,170 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "babylon.finality.v1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "babylon.finality.v1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "babylon.finality.v1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryProjectedRewardsRequest)(nil), "babylon.finality.v1.QueryProjectedRewardsRequest")
+	proto.RegisterType((*QueryProjectedRewardsResponse)(nil), "babylon.finality.v1.QueryProjectedRewardsResponse")
+	proto.RegisterType((*QueryVotingPowerDistributionAtRequest)(nil), "babylon.finality.v1.QueryVotingPowerDistributionAtRequest")
+	proto.RegisterType((*FinalityProviderActiveSats)(nil), "babylon.finality.v1.FinalityProviderActiveSats")
+	proto.RegisterType((*QueryVotingPowerDistributionAtResponse)(nil), "babylon.finality.v1.QueryVotingPowerDistributionAtResponse")
+	proto.RegisterType((*QueryFinalityProviderSlashableAmountRequest)(nil), "babylon.finality.v1.QueryFinalityProviderSlashableAmountRequest")
+	proto.RegisterType((*QueryFinalityProviderSlashableAmountResponse)(nil), "babylon.finality.v1.QueryFinalityProviderSlashableAmountResponse")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
 
 var fileDescriptor_32bddab77af6fdae = []byte{
-	// 1950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdb, 0xd8,
-	0x11, 0xf7, 0xb3, 0x63, 0xc7, 0x19, 0x49, 0x8d, 0xfd, 0xec, 0xb8, 0xae, 0x92, 0xc8, 0x32, 0x77,
-	0x13, 0x7b, 0x9d, 0x84, 0x8c, 0x95, 0x34, 0xcd, 0x06, 0x9b, 0x4d, 0xac, 0xd4, 0xae, 0x8d, 0x3a,
-	0x8e, 0x96, 0xce, 0x06, 0x68, 0x2e, 0x04, 0x25, 0x53, 0x12, 0x6b, 0xf1, 0x63, 0x45, 0x52, 0xb5,
-	0x51, 0x2c, 0x50, 0xf4, 0xb0, 0x87, 0xa2, 0x05, 0x16, 0xe8, 0xa5, 0x3d, 0xec, 0xa1, 0x40, 0x5b,
-	0x14, 0xed, 0xa5, 0xc7, 0xf6, 0x3f, 0xd8, 0xe3, 0x62, 0xdb, 0x43, 0xb1, 0xc5, 0xa6, 0x41, 0x12,
-	0xa0, 0x40, 0x4f, 0xfd, 0x13, 0x0a, 0xbe, 0x37, 0x14, 0x45, 0x89, 0x92, 0xe8, 0x0f, 0xf4, 0x62,
-	0x58, 0xef, 0xcd, 0xc7, 0x6f, 0xe6, 0xcd, 0x0c, 0x67, 0x06, 0x16, 0xca, 0x6a, 0xf9, 0xb0, 0x61,
-	0x99, 0x52, 0x55, 0x37, 0xd5, 0x86, 0xee, 0x1e, 0x4a, 0xad, 0x55, 0xe9, 0x23, 0x4f, 0x6b, 0x1e,
-	0x8a, 0x76, 0xd3, 0x72, 0x2d, 0x3a, 0x83, 0x04, 0x62, 0x40, 0x20, 0xb6, 0x56, 0xb3, 0xb3, 0x35,
-	0xab, 0x66, 0xb1, 0x7b, 0xc9, 0xff, 0x8f, 0x93, 0x66, 0x2f, 0xd5, 0x2c, 0xab, 0xd6, 0xd0, 0x24,
-	0xd5, 0xd6, 0x25, 0xd5, 0x34, 0x2d, 0x57, 0x75, 0x75, 0xcb, 0x74, 0xf0, 0x76, 0xa5, 0x62, 0x39,
-	0x86, 0xe5, 0x48, 0x65, 0xd5, 0xd1, 0xb8, 0x06, 0xa9, 0xb5, 0x5a, 0xd6, 0x5c, 0x75, 0x55, 0xb2,
-	0xd5, 0x9a, 0x6e, 0x32, 0x62, 0xa4, 0xcd, 0xc7, 0xa1, 0xb2, 0xd5, 0xa6, 0x6a, 0x04, 0xd2, 0x84,
-	0x38, 0x8a, 0x36, 0x44, 0x4e, 0xb3, 0x80, 0x78, 0xd8, 0xaf, 0xb2, 0x57, 0x95, 0x5c, 0xdd, 0xd0,
-	0x1c, 0x57, 0x35, 0x6c, 0x24, 0x98, 0x56, 0x0d, 0xdd, 0xb4, 0x24, 0xf6, 0x97, 0x1f, 0x09, 0xb3,
-	0x40, 0x3f, 0xf0, 0xb1, 0x95, 0x98, 0x32, 0x59, 0xfb, 0xc8, 0xd3, 0x1c, 0x57, 0x28, 0xc1, 0x4c,
-	0xe4, 0xd4, 0xb1, 0x2d, 0xd3, 0xd1, 0xe8, 0xbb, 0x30, 0xc1, 0x41, 0xcd, 0x93, 0x3c, 0x59, 0x4e,
-	0x15, 0x2e, 0x8a, 0x31, 0xce, 0x12, 0x39, 0x53, 0xf1, 0xcc, 0xe7, 0x2f, 0x16, 0x46, 0x64, 0x64,
-	0x10, 0xaa, 0xf0, 0x0e, 0x93, 0xb8, 0x81, 0x84, 0xa5, 0xa6, 0xd5, 0xd2, 0xf7, 0xb4, 0x66, 0xc9,
-	0xfa, 0x91, 0xd6, 0x5c, 0x73, 0x37, 0x35, 0xbd, 0x56, 0x77, 0x51, 0x3d, 0x5d, 0x84, 0x4c, 0xd5,
-	0x56, 0xca, 0x6e, 0x45, 0xb1, 0xf7, 0x95, 0xba, 0x76, 0xc0, 0xd4, 0x9d, 0x93, 0xa1, 0x6a, 0x17,
-	0xdd, 0x4a, 0x69, 0x7f, 0x53, 0x3b, 0xa0, 0x73, 0x30, 0x51, 0x67, 0x3c, 0xf3, 0xa3, 0x79, 0xb2,
-	0x7c, 0x46, 0xc6, 0x5f, 0xc2, 0x13, 0x58, 0x49, 0xa2, 0x07, 0x0d, 0x5a, 0x84, 0x74, 0xcb, 0x72,
-	0x75, 0xb3, 0xa6, 0xd8, 0xfe, 0x3d, 0xd3, 0x73, 0x46, 0x4e, 0xf1, 0x33, 0xc6, 0x22, 0x3c, 0x86,
-	0xe5, 0x58, 0x81, 0x8f, 0xbc, 0x66, 0x53, 0x33, 0x5d, 0x46, 0x94, 0x1c, 0x77, 0x5f, 0x3f, 0x44,
-	0xc5, 0x21, 0xbc, 0xd0, 0x48, 0xd2, 0x69, 0x64, 0x0f, 0xec, 0xd1, 0x5e, 0xd8, 0xbf, 0x20, 0x70,
-	0x8d, 0x29, 0x5a, 0xab, 0xb8, 0x7a, 0x4b, 0xeb, 0x56, 0xe7, 0x74, 0xbb, 0xbc, 0x9f, 0xaa, 0x0d,
-	0x80, 0x30, 0x5a, 0x99, 0xa2, 0x54, 0xe1, 0xaa, 0xc8, 0x43, 0x5b, 0xf4, 0x43, 0x5b, 0xe4, 0xc9,
-	0x83, 0xa1, 0x2d, 0x96, 0xd4, 0x9a, 0x86, 0x32, 0xe5, 0x0e, 0x4e, 0xe1, 0x2f, 0xa3, 0xb0, 0x34,
-	0x14, 0x0a, 0x9a, 0xfd, 0x0c, 0xa0, 0xdb, 0x87, 0xc5, 0xbb, 0x5f, 0xbd, 0x58, 0xb8, 0x5d, 0xd3,
-	0xdd, 0xba, 0x57, 0x16, 0x2b, 0x96, 0x21, 0x61, 0xe0, 0x35, 0xd4, 0xb2, 0x73, 0x43, 0xb7, 0x82,
-	0x9f, 0x92, 0x7b, 0x68, 0x6b, 0x8e, 0x58, 0xdc, 0x2a, 0xdd, 0xba, 0x7d, 0xb3, 0xe4, 0x95, 0xbf,
-	0xaf, 0x1d, 0xca, 0x93, 0xe5, 0x21, 0x31, 0xd3, 0xe3, 0xce, 0xb1, 0x1e, 0x77, 0xd2, 0xdb, 0x30,
-	0xe7, 0x34, 0x54, 0xa7, 0xae, 0xed, 0x29, 0xa8, 0x4a, 0x41, 0x51, 0x67, 0x18, 0xf1, 0x2c, 0xde,
-	0x16, 0xf9, 0x25, 0x37, 0x88, 0x5e, 0x07, 0xda, 0xe6, 0x72, 0x2b, 0x01, 0xc7, 0x78, 0x9e, 0x2c,
-	0x67, 0xe4, 0xa9, 0x80, 0xc3, 0xad, 0x20, 0xf5, 0x1c, 0x4c, 0xfc, 0x50, 0xd5, 0x1b, 0xda, 0xde,
-	0xfc, 0x44, 0x9e, 0x2c, 0x4f, 0xca, 0xf8, 0x4b, 0x78, 0x43, 0xe0, 0x7a, 0xb2, 0xa7, 0x44, 0xff,
-	0xed, 0x03, 0x0d, 0xf2, 0x51, 0xb1, 0x03, 0xaa, 0x79, 0x92, 0x1f, 0x5b, 0x4e, 0x15, 0xde, 0x8b,
-	0x4d, 0xd9, 0x84, 0x92, 0xe5, 0xe9, 0x6a, 0x37, 0x09, 0xfd, 0x5e, 0x4c, 0x80, 0x2c, 0x0d, 0x0d,
-	0x10, 0x94, 0xd7, 0x19, 0x21, 0x97, 0xe1, 0x62, 0x68, 0xa5, 0xea, 0x6a, 0x7b, 0x91, 0x00, 0x15,
-	0xee, 0xc0, 0xa5, 0xf8, 0xeb, 0xc1, 0xb9, 0xe2, 0x27, 0x42, 0x9e, 0x31, 0x6e, 0xeb, 0x8e, 0x5b,
-	0xf2, 0xca, 0x0d, 0xbd, 0x22, 0xab, 0xe6, 0x9e, 0x65, 0x98, 0x9a, 0xe3, 0x1c, 0xa1, 0xe0, 0x9c,
-	0x56, 0x22, 0x7c, 0x39, 0x0a, 0x8b, 0x03, 0xf0, 0xa0, 0x35, 0xbf, 0x25, 0x90, 0xb6, 0xbd, 0xb2,
-	0xd2, 0x54, 0xcd, 0x3d, 0xc5, 0x50, 0x6d, 0x7c, 0xbd, 0x8d, 0xd8, 0xd7, 0x1b, 0x2a, 0x4e, 0x2c,
-	0x79, 0x65, 0xff, 0xf4, 0xb1, 0x6a, 0xaf, 0x9b, 0x6e, 0xf3, 0xb0, 0x78, 0xef, 0xab, 0x17, 0x0b,
-	0x77, 0x92, 0x66, 0xd3, 0x6e, 0xa5, 0x6e, 0x5a, 0xcd, 0x26, 0xca, 0x90, 0xc1, 0x6e, 0x0b, 0x3b,
-	0xb5, 0xc7, 0xcf, 0xde, 0x87, 0xf3, 0x5d, 0x18, 0xe9, 0x14, 0x8c, 0xed, 0x6b, 0x87, 0xf8, 0x9a,
-	0xfe, 0xbf, 0x74, 0x16, 0xc6, 0x5b, 0x6a, 0xc3, 0xd3, 0x98, 0xa2, 0xb4, 0xcc, 0x7f, 0xdc, 0x1b,
-	0xbd, 0x4b, 0x84, 0x16, 0x5c, 0x40, 0xf6, 0x47, 0x96, 0x61, 0xe8, 0x61, 0x54, 0xe4, 0x21, 0x6d,
-	0x7a, 0x86, 0x12, 0xb8, 0x12, 0xa5, 0x81, 0xe9, 0x19, 0x48, 0x4f, 0x73, 0x00, 0x15, 0xc6, 0x63,
-	0x68, 0xa6, 0x8b, 0x92, 0x3b, 0x4e, 0xe8, 0x45, 0x38, 0xa7, 0xd9, 0x56, 0xa5, 0xae, 0x98, 0x9e,
-	0x81, 0x95, 0x61, 0x92, 0x1d, 0xec, 0x78, 0x86, 0xf0, 0x33, 0x02, 0x97, 0x3b, 0xbd, 0xdf, 0x89,
-	0xe0, 0xff, 0x1e, 0x59, 0x7f, 0x1f, 0x85, 0x5c, 0x3f, 0x30, 0xe8, 0x8e, 0x03, 0x98, 0x69, 0x47,
-	0x15, 0xb7, 0xb1, 0x23, 0xb8, 0xb6, 0x86, 0x06, 0x57, 0xaf, 0x44, 0x31, 0x72, 0x1a, 0xbc, 0x9d,
-	0x3c, 0x65, 0x77, 0x1d, 0x9f, 0x5e, 0xa4, 0x58, 0x5d, 0x4f, 0x3d, 0x20, 0x5e, 0x1e, 0x76, 0xc6,
-	0x4b, 0xaa, 0xb0, 0x12, 0xdf, 0xad, 0xc4, 0x99, 0xd5, 0x19, 0x5b, 0xd7, 0x60, 0x9a, 0xf9, 0xa0,
-	0xd8, 0xb0, 0x2a, 0xfb, 0x43, 0x3e, 0x97, 0xc2, 0x63, 0x6c, 0xa7, 0x90, 0x18, 0xdd, 0xfe, 0x1d,
-	0x18, 0x2f, 0xfb, 0x07, 0xd8, 0x36, 0x2d, 0xc6, 0x02, 0xd9, 0x32, 0xf7, 0xb4, 0x03, 0x6d, 0x8f,
-	0x73, 0x72, 0x7a, 0xe1, 0x37, 0x04, 0xe6, 0xda, 0x0f, 0xc0, 0x6e, 0xda, 0x25, 0xeb, 0x01, 0x4c,
-	0x38, 0xae, 0xea, 0x7a, 0xbc, 0x17, 0xfb, 0x46, 0x61, 0xa9, 0xef, 0xeb, 0xe9, 0x28, 0x74, 0x97,
-	0x91, 0xcb, 0xc8, 0x76, 0x6a, 0x61, 0xf7, 0x19, 0x81, 0x6f, 0xf6, 0x60, 0x0c, 0x1b, 0x46, 0x66,
-	0x48, 0xf0, 0xf5, 0x49, 0x60, 0x39, 0x32, 0x9c, 0xde, 0x77, 0xe5, 0x16, 0x7c, 0x8b, 0xc1, 0x7b,
-	0x66, 0xb9, 0x5a, 0xd2, 0xb6, 0x47, 0xb0, 0x20, 0x1b, 0xc7, 0x84, 0x66, 0x7d, 0x00, 0x67, 0x79,
-	0x46, 0x73, 0xbb, 0xd2, 0x27, 0xe8, 0x4e, 0x26, 0x58, 0x77, 0xe2, 0x08, 0xef, 0xc2, 0x2c, 0x53,
-	0xb8, 0xee, 0x7f, 0x56, 0xcd, 0x8a, 0x76, 0x84, 0x96, 0xf2, 0x9f, 0x63, 0x30, 0x15, 0xb2, 0xb5,
-	0x3b, 0xdb, 0xa1, 0x75, 0x67, 0x11, 0xd2, 0xcc, 0xd7, 0x4a, 0xa4, 0x29, 0x4a, 0xb1, 0x33, 0x6c,
-	0x49, 0x3e, 0x84, 0xc9, 0x76, 0xe9, 0xf4, 0x6b, 0x5f, 0xfa, 0x44, 0x5f, 0x8e, 0xb3, 0x58, 0x15,
-	0xfc, 0xbe, 0xa8, 0xa2, 0x9a, 0x96, 0xa9, 0x57, 0xd4, 0x86, 0xa2, 0xda, 0xb6, 0x52, 0x57, 0x9d,
-	0x3a, 0xeb, 0xa4, 0xd2, 0xf2, 0x54, 0xfb, 0x66, 0xcd, 0xb6, 0x37, 0x55, 0xa7, 0x4e, 0x05, 0xc8,
-	0x54, 0xad, 0xe6, 0x7e, 0x48, 0x38, 0xce, 0x08, 0x53, 0xfe, 0x61, 0x40, 0x63, 0xc3, 0x5c, 0x28,
-	0xb1, 0xdd, 0xfc, 0x38, 0x7a, 0x8d, 0xf5, 0x52, 0xc7, 0x83, 0xbd, 0xfe, 0xe4, 0xe9, 0xee, 0xae,
-	0x5e, 0x93, 0x67, 0xdb, 0x92, 0x83, 0x06, 0x69, 0x57, 0xaf, 0xd1, 0x2a, 0x4c, 0x33, 0x54, 0x11,
-	0x65, 0x67, 0x4f, 0xac, 0xec, 0xbc, 0x2f, 0xb4, 0x43, 0x8f, 0xf0, 0x1c, 0x2e, 0x74, 0x05, 0x06,
-	0xbe, 0xf0, 0x1a, 0x4c, 0x6a, 0x78, 0x86, 0x75, 0xe5, 0x4a, 0x6c, 0x76, 0x75, 0x33, 0xca, 0x6d,
-	0x36, 0xe1, 0x13, 0x82, 0xb9, 0xe1, 0xa7, 0x6e, 0x40, 0xd7, 0xd1, 0x14, 0xa5, 0x1d, 0x57, 0x6d,
-	0xba, 0x4a, 0x24, 0x43, 0x52, 0xec, 0x6c, 0xf3, 0x74, 0xa7, 0x83, 0x3f, 0x12, 0xcc, 0xb7, 0x2e,
-	0x20, 0x68, 0xea, 0x23, 0x38, 0x17, 0x60, 0x0e, 0x2a, 0x49, 0x42, 0x5b, 0x43, 0xbe, 0xd3, 0x2b,
-	0x28, 0xef, 0x61, 0xbd, 0xdb, 0xd5, 0x6b, 0xa6, 0x6e, 0xd6, 0xb6, 0xcc, 0xaa, 0x75, 0x84, 0x6c,
-	0xfd, 0x9a, 0xc0, 0x4c, 0x84, 0xf3, 0x48, 0x09, 0x1b, 0x79, 0x10, 0xdf, 0x86, 0xb1, 0xe8, 0x83,
-	0x14, 0xe0, 0x82, 0xa1, 0x3b, 0x8e, 0x3f, 0x70, 0xb0, 0x32, 0xaa, 0x54, 0x2c, 0xcf, 0x74, 0x71,
-	0xa6, 0x19, 0x93, 0x67, 0xf8, 0x25, 0xaf, 0xd2, 0x8f, 0xf8, 0x15, 0xdd, 0x86, 0x34, 0x9f, 0x34,
-	0x14, 0xcf, 0x74, 0xf5, 0x06, 0xcb, 0xc3, 0x54, 0x21, 0x2b, 0xf2, 0x6d, 0x82, 0x18, 0x6c, 0x13,
-	0xc4, 0xa7, 0xc1, 0x36, 0xa1, 0x98, 0xf1, 0x47, 0xfb, 0x4f, 0xff, 0xb5, 0x40, 0xfe, 0xf0, 0xef,
-	0x3f, 0xaf, 0x10, 0x39, 0xc5, 0xd9, 0x3f, 0xf4, 0xb9, 0x05, 0x03, 0xe6, 0x7b, 0xbd, 0xd3, 0xae,
-	0x9b, 0x69, 0x87, 0x1f, 0x2b, 0xba, 0x59, 0xb5, 0x30, 0x6c, 0x97, 0x63, 0x9f, 0x32, 0x86, 0x1f,
-	0x57, 0x0a, 0x29, 0x27, 0xbc, 0x12, 0xca, 0xbd, 0xea, 0xda, 0x01, 0x1c, 0x8d, 0x4e, 0x72, 0xec,
-	0xe8, 0xfc, 0x6b, 0x90, 0x26, 0x51, 0x25, 0x68, 0xd4, 0x2e, 0x64, 0x3a, 0x8d, 0x0a, 0x02, 0xf4,
-	0xa8, 0x56, 0xa5, 0x3b, 0xac, 0x3a, 0xbd, 0x60, 0x5d, 0x79, 0xc0, 0x1b, 0x92, 0x68, 0x0f, 0x40,
-	0xa7, 0x21, 0xb3, 0xf3, 0x64, 0x47, 0xd9, 0xd8, 0xda, 0x59, 0xdb, 0xde, 0x7a, 0xbe, 0xfe, 0xdd,
-	0xa9, 0x11, 0x9a, 0x81, 0x73, 0xe1, 0x4f, 0x42, 0xcf, 0xc2, 0xd8, 0xda, 0xce, 0x0f, 0xa6, 0x46,
-	0x0b, 0xff, 0x99, 0x81, 0x71, 0x66, 0x3c, 0xfd, 0x09, 0x81, 0x09, 0xbe, 0xdb, 0xa1, 0xfd, 0x9b,
-	0x8d, 0xe8, 0x22, 0x29, 0xbb, 0x3c, 0x9c, 0x90, 0x83, 0x16, 0xde, 0xfa, 0xe9, 0xdf, 0xde, 0xfc,
-	0x72, 0xf4, 0x32, 0xbd, 0x28, 0xf5, 0xdf, 0x85, 0xd1, 0x97, 0x04, 0x16, 0x86, 0xcc, 0xaa, 0xf4,
-	0x61, 0x7f, 0x95, 0xc9, 0x76, 0x21, 0xd9, 0xb5, 0x13, 0x48, 0x40, 0x6b, 0xee, 0x32, 0x6b, 0x0a,
-	0xf4, 0xa6, 0x34, 0x68, 0x6f, 0x17, 0x4e, 0xe7, 0xd2, 0x8f, 0x79, 0x4e, 0x7f, 0x4c, 0xff, 0x4b,
-	0xe0, 0xf2, 0xc0, 0xe5, 0x15, 0x7d, 0xbf, 0x3f, 0xbc, 0x24, 0xdb, 0xb5, 0xec, 0x83, 0x63, 0xf3,
-	0xa3, 0x71, 0x3b, 0xcc, 0xb8, 0x4d, 0xba, 0x91, 0xd8, 0xb8, 0x48, 0x65, 0xfb, 0x58, 0x62, 0x6b,
-	0x96, 0xd0, 0xe4, 0x37, 0x04, 0x2e, 0x0d, 0xda, 0x87, 0xd1, 0xfb, 0xc9, 0x11, 0xc7, 0xac, 0xe5,
-	0xb2, 0xef, 0x1f, 0x97, 0x1d, 0xed, 0x5d, 0x67, 0xf6, 0x3e, 0xa0, 0xf7, 0x4f, 0x64, 0x2f, 0xfd,
-	0x1d, 0x81, 0xf3, 0x5d, 0xdb, 0x0b, 0x7a, 0x73, 0x48, 0xa8, 0xf5, 0xec, 0x41, 0xb2, 0xab, 0x47,
-	0xe0, 0x40, 0xfc, 0x37, 0x18, 0xfe, 0x25, 0x7a, 0x25, 0x16, 0xbf, 0x1a, 0x70, 0xe1, 0x67, 0x85,
-	0x7e, 0x4d, 0x60, 0x36, 0x6e, 0x9b, 0x40, 0xbf, 0x7d, 0xd4, 0xed, 0x03, 0x47, 0x7c, 0xe7, 0x78,
-	0x4b, 0x0b, 0xe1, 0x19, 0x83, 0x5d, 0xa2, 0x3b, 0xc7, 0x76, 0x3b, 0x93, 0xcc, 0xba, 0x57, 0x2e,
-	0x5a, 0x69, 0xe8, 0x8e, 0x4b, 0xbf, 0x24, 0x30, 0xdd, 0x33, 0xd0, 0xd2, 0xc2, 0x91, 0xa6, 0x5f,
-	0x6e, 0xd9, 0xad, 0x63, 0x4c, 0xcc, 0xc2, 0x53, 0x66, 0xd6, 0x0e, 0xdd, 0x3e, 0x81, 0x59, 0x91,
-	0x09, 0x9e, 0x19, 0xf5, 0x09, 0x81, 0x71, 0x56, 0xe1, 0xe9, 0xd5, 0xfe, 0xa0, 0x3a, 0x47, 0xd8,
-	0xec, 0xd2, 0x50, 0x3a, 0x04, 0x7c, 0x9d, 0x01, 0xbe, 0x4a, 0xdf, 0x8e, 0x05, 0xcc, 0xfb, 0x8c,
-	0x30, 0x99, 0x7f, 0x4e, 0x00, 0xc2, 0x49, 0x90, 0x5e, 0x1b, 0xec, 0xa2, 0xc8, 0x4c, 0x9b, 0xbd,
-	0x9e, 0x8c, 0x38, 0xd1, 0x17, 0x03, 0xc7, 0xc8, 0xcf, 0x08, 0x64, 0x22, 0x43, 0x1c, 0x15, 0xfb,
-	0x2b, 0x89, 0x1b, 0x11, 0xb3, 0x52, 0x62, 0x7a, 0xc4, 0x75, 0x8d, 0xe1, 0xba, 0x42, 0xdf, 0x8a,
-	0xc5, 0xd5, 0xf2, 0x79, 0x42, 0x77, 0xfd, 0x89, 0xc0, 0x64, 0xd0, 0xb5, 0xd2, 0x77, 0xfa, 0xab,
-	0xea, 0x9a, 0x0b, 0xb3, 0x2b, 0x49, 0x48, 0x11, 0xd0, 0x26, 0x03, 0x54, 0xa4, 0x0f, 0x8f, 0x1b,
-	0x71, 0x41, 0x13, 0x4d, 0x7f, 0x45, 0x20, 0x13, 0x69, 0xd1, 0x07, 0x79, 0x33, 0x6e, 0xa8, 0x18,
-	0xe4, 0xcd, 0xd8, 0xde, 0x5f, 0xb8, 0xca, 0xc0, 0xe7, 0x69, 0x2e, 0x16, 0x7c, 0xd8, 0xde, 0xff,
-	0x9e, 0x40, 0xaa, 0xa3, 0xbb, 0xa2, 0x03, 0x62, 0xa9, 0xb7, 0x71, 0xcf, 0xde, 0x48, 0x48, 0x8d,
-	0xa0, 0xee, 0x31, 0x50, 0xb7, 0x69, 0x21, 0x16, 0x54, 0xa4, 0x1d, 0xec, 0x76, 0x26, 0xfd, 0x35,
-	0x81, 0x74, 0x67, 0x23, 0x49, 0x93, 0xe9, 0x6e, 0x7b, 0x50, 0x4c, 0x4a, 0x8e, 0x58, 0x57, 0x18,
-	0xd6, 0xb7, 0xa9, 0x30, 0x1c, 0x6b, 0x71, 0xfb, 0xf3, 0x57, 0x39, 0xf2, 0xc5, 0xab, 0x1c, 0x79,
-	0xf9, 0x2a, 0x47, 0x3e, 0x7d, 0x9d, 0x1b, 0xf9, 0xe2, 0x75, 0x6e, 0xe4, 0x1f, 0xaf, 0x73, 0x23,
-	0xcf, 0x0b, 0xc3, 0xe7, 0xd9, 0x83, 0x50, 0x30, 0x1b, 0x6d, 0xcb, 0x13, 0x6c, 0x74, 0xb8, 0xf5,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x4d, 0x5d, 0x1d, 0x74, 0x1d, 0x00, 0x00,
+	// 2409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x48, 0x96, 0x2c, 0x3d, 0x92, 0xb1, 0x34, 0x96, 0x5d, 0x99, 0x8e, 0x44, 0x79, 0x13,
+	0xdb, 0x8a, 0x2c, 0x71, 0x2d, 0xda, 0x75, 0x1d, 0x27, 0x8e, 0x4d, 0xca, 0x52, 0xa5, 0x56, 0x96,
+	0x99, 0xa5, 0x63, 0xa0, 0x3e, 0x74, 0x31, 0x5c, 0xae, 0xc8, 0x8d, 0xb8, 0x1f, 0xde, 0x5d, 0xca,
+	0x12, 0x0c, 0x03, 0x45, 0x0f, 0x39, 0x14, 0x2d, 0x10, 0xa0, 0x97, 0xf6, 0x90, 0x43, 0x81, 0xb6,
+	0x28, 0xd2, 0x4b, 0x81, 0xfa, 0xd0, 0xfe, 0x07, 0x39, 0x06, 0x6e, 0x0f, 0xad, 0x8b, 0x38, 0x81,
+	0x6d, 0xa0, 0x3d, 0xf6, 0xd6, 0x1e, 0x8b, 0x9d, 0x9d, 0xfd, 0x22, 0x97, 0xe4, 0xea, 0x03, 0xbd,
+	0x24, 0xe6, 0xcc, 0xfb, 0xf8, 0xfd, 0xde, 0xbc, 0x99, 0x7d, 0xef, 0x09, 0x72, 0x55, 0x52, 0xdd,
+	0x6d, 0xea, 0x1a, 0xbf, 0xa9, 0x68, 0xa4, 0xa9, 0xd8, 0xbb, 0xfc, 0xf6, 0x22, 0xff, 0xb0, 0x25,
+	0x9b, 0xbb, 0x79, 0xc3, 0xd4, 0x6d, 0x1d, 0x9f, 0x60, 0x02, 0x79, 0x4f, 0x20, 0xbf, 0xbd, 0x98,
+	0x9d, 0xa8, 0xeb, 0x75, 0x9d, 0xee, 0xf3, 0xce, 0xbf, 0x5c, 0xd1, 0xec, 0x9b, 0x75, 0x5d, 0xaf,
+	0x37, 0x65, 0x9e, 0x18, 0x0a, 0x4f, 0x34, 0x4d, 0xb7, 0x89, 0xad, 0xe8, 0x9a, 0xc5, 0x76, 0xe7,
+	0x24, 0xdd, 0x52, 0x75, 0x8b, 0xaf, 0x12, 0x4b, 0x76, 0x3d, 0xf0, 0xdb, 0x8b, 0x55, 0xd9, 0x26,
+	0x8b, 0xbc, 0x41, 0xea, 0x8a, 0x46, 0x85, 0x99, 0xec, 0x4c, 0x1c, 0x2a, 0x83, 0x98, 0x44, 0xf5,
+	0xac, 0x71, 0x71, 0x12, 0x3e, 0x44, 0x57, 0x26, 0xc7, 0xf0, 0xd0, 0x5f, 0xd5, 0xd6, 0x26, 0x6f,
+	0x2b, 0xaa, 0x6c, 0xd9, 0x44, 0x35, 0x98, 0xc0, 0x38, 0x51, 0x15, 0x4d, 0xe7, 0xe9, 0x7f, 0xd9,
+	0xd2, 0x69, 0x17, 0xa5, 0xe8, 0x92, 0x73, 0x7f, 0xb0, 0xad, 0xe9, 0x30, 0x01, 0x0f, 0xba, 0xa4,
+	0x2b, 0x0c, 0x34, 0x37, 0x01, 0xf8, 0x43, 0x87, 0x56, 0x99, 0xe2, 0x14, 0xe4, 0x87, 0x2d, 0xd9,
+	0xb2, 0xb9, 0x32, 0x9c, 0x88, 0xac, 0x5a, 0x86, 0xae, 0x59, 0x32, 0x7e, 0x17, 0x86, 0x5d, 0x3e,
+	0x93, 0x68, 0x06, 0xcd, 0xa6, 0x0a, 0x67, 0xf2, 0x31, 0x71, 0xce, 0xbb, 0x4a, 0xa5, 0xa3, 0x5f,
+	0xbc, 0xc8, 0x1d, 0x11, 0x98, 0x02, 0xb7, 0x09, 0xef, 0x50, 0x8b, 0x2b, 0x4c, 0xb0, 0x6c, 0xea,
+	0xdb, 0x4a, 0x4d, 0x36, 0xcb, 0xfa, 0x23, 0xd9, 0x2c, 0xda, 0xab, 0xb2, 0x52, 0x6f, 0xd8, 0xcc,
+	0x3d, 0x3e, 0x0b, 0x99, 0x4d, 0x43, 0xac, 0xda, 0x92, 0x68, 0x6c, 0x89, 0x0d, 0x79, 0x87, 0xba,
+	0x1b, 0x15, 0x60, 0xd3, 0x28, 0xd9, 0x52, 0x79, 0x6b, 0x55, 0xde, 0xc1, 0xa7, 0x60, 0xb8, 0x41,
+	0x75, 0x26, 0x07, 0x66, 0xd0, 0xec, 0x51, 0x81, 0xfd, 0xe2, 0xee, 0xc2, 0x5c, 0x12, 0x3f, 0x8c,
+	0xd0, 0x59, 0x48, 0x6f, 0xeb, 0xb6, 0xa2, 0xd5, 0x45, 0xc3, 0xd9, 0xa7, 0x7e, 0x8e, 0x0a, 0x29,
+	0x77, 0x8d, 0xaa, 0x70, 0x77, 0x60, 0x36, 0xd6, 0xe0, 0x52, 0xcb, 0x34, 0x65, 0xcd, 0xa6, 0x42,
+	0xc9, 0x71, 0x77, 0x8d, 0x43, 0xd4, 0x1c, 0x83, 0x17, 0x90, 0x44, 0x61, 0x92, 0x1d, 0xb0, 0x07,
+	0x3a, 0x61, 0xff, 0x0c, 0xc1, 0x45, 0xea, 0xa8, 0x28, 0xd9, 0xca, 0xb6, 0xdc, 0xee, 0xce, 0x6a,
+	0x0f, 0x79, 0x37, 0x57, 0x2b, 0x00, 0x41, 0xa2, 0x53, 0x47, 0xa9, 0xc2, 0xf9, 0x3c, 0x4b, 0x31,
+	0x27, 0xa9, 0xf2, 0xee, 0xbd, 0x63, 0xa9, 0x95, 0x2f, 0x93, 0xba, 0xcc, 0x6c, 0x0a, 0x21, 0x4d,
+	0xee, 0x4f, 0x03, 0x70, 0xa1, 0x2f, 0x14, 0x46, 0xfb, 0x3e, 0x40, 0x7b, 0x0c, 0x4b, 0xd7, 0x9e,
+	0xbf, 0xc8, 0x5d, 0xa9, 0x2b, 0x76, 0xa3, 0x55, 0xcd, 0x4b, 0xba, 0xca, 0xb3, 0xc4, 0x6b, 0x92,
+	0xaa, 0xb5, 0xa0, 0xe8, 0xde, 0x4f, 0xde, 0xde, 0x35, 0x64, 0x2b, 0x5f, 0x5a, 0x2b, 0x5f, 0xbe,
+	0x72, 0xa9, 0xdc, 0xaa, 0x7e, 0x5f, 0xde, 0x15, 0x46, 0xaa, 0x7d, 0x72, 0xa6, 0x23, 0x9c, 0x83,
+	0x1d, 0xe1, 0xc4, 0x57, 0xe0, 0x94, 0xd5, 0x24, 0x56, 0x43, 0xae, 0x89, 0xcc, 0x95, 0xc8, 0x4c,
+	0x1d, 0xa5, 0xc2, 0x13, 0x6c, 0xb7, 0xe4, 0x6e, 0xba, 0x84, 0xf0, 0x3c, 0x60, 0x5f, 0xcb, 0x96,
+	0x3c, 0x8d, 0xa1, 0x19, 0x34, 0x9b, 0x11, 0xc6, 0x3c, 0x0d, 0x5b, 0x62, 0xd2, 0xa7, 0x60, 0xf8,
+	0x63, 0xa2, 0x34, 0xe5, 0xda, 0xe4, 0xf0, 0x0c, 0x9a, 0x1d, 0x11, 0xd8, 0x2f, 0xee, 0x35, 0x82,
+	0xf9, 0x64, 0x47, 0xc9, 0xe2, 0xb7, 0x05, 0xd8, 0xbb, 0x8f, 0xa2, 0xe1, 0x49, 0x4d, 0xa2, 0x99,
+	0xc1, 0xd9, 0x54, 0xe1, 0xfd, 0xd8, 0x2b, 0x9b, 0xd0, 0xb2, 0x30, 0xbe, 0xd9, 0x2e, 0x82, 0xbf,
+	0x1b, 0x93, 0x20, 0x17, 0xfa, 0x26, 0x08, 0xb3, 0x17, 0xce, 0x90, 0x29, 0x38, 0x13, 0xb0, 0x24,
+	0xb6, 0x5c, 0x8b, 0x24, 0x28, 0x77, 0x15, 0xde, 0x8c, 0xdf, 0xee, 0x7d, 0x57, 0x9c, 0x8b, 0x30,
+	0x43, 0x15, 0xd7, 0x15, 0xcb, 0x2e, 0xb7, 0xaa, 0x4d, 0x45, 0x12, 0x88, 0x56, 0xd3, 0x55, 0x4d,
+	0xb6, 0xac, 0x3d, 0x3c, 0x38, 0x87, 0x75, 0x11, 0x9e, 0x0d, 0xc0, 0xd9, 0x1e, 0x78, 0x18, 0x9b,
+	0x5f, 0x23, 0x48, 0x1b, 0xad, 0xaa, 0x68, 0x12, 0xad, 0x26, 0xaa, 0xc4, 0x60, 0xa7, 0xb7, 0x12,
+	0x7b, 0x7a, 0x7d, 0xcd, 0xe5, 0xcb, 0xad, 0xaa, 0xb3, 0x7a, 0x87, 0x18, 0xcb, 0x9a, 0x6d, 0xee,
+	0x96, 0xae, 0x3f, 0x7f, 0x91, 0xbb, 0x9a, 0xf4, 0x36, 0x55, 0xa4, 0x86, 0xa6, 0x9b, 0x26, 0xb3,
+	0x21, 0x80, 0xe1, 0x1b, 0x3b, 0xb4, 0xc3, 0xcf, 0xde, 0x80, 0xe3, 0x6d, 0x18, 0xf1, 0x18, 0x0c,
+	0x6e, 0xc9, 0xbb, 0xec, 0x34, 0x9d, 0x7f, 0xe2, 0x09, 0x18, 0xda, 0x26, 0xcd, 0x96, 0x4c, 0x1d,
+	0xa5, 0x05, 0xf7, 0xc7, 0xf5, 0x81, 0x6b, 0x88, 0xdb, 0x86, 0x93, 0x4c, 0x7d, 0x49, 0x57, 0x55,
+	0x25, 0xc8, 0x8a, 0x19, 0x48, 0x6b, 0x2d, 0x55, 0xf4, 0x42, 0xc9, 0xac, 0x81, 0xd6, 0x52, 0x99,
+	0x3c, 0x9e, 0x06, 0x90, 0xa8, 0x8e, 0x2a, 0x6b, 0x36, 0xb3, 0x1c, 0x5a, 0xc1, 0x67, 0x60, 0x54,
+	0x36, 0x74, 0xa9, 0x21, 0x6a, 0x2d, 0x95, 0xbd, 0x0c, 0x23, 0x74, 0x61, 0xa3, 0xa5, 0x72, 0x3f,
+	0x41, 0x30, 0x15, 0x8e, 0x7e, 0x18, 0xc1, 0xff, 0x3d, 0xb3, 0xfe, 0x3a, 0x00, 0xd3, 0xdd, 0xc0,
+	0xb0, 0x70, 0xec, 0xc0, 0x09, 0x3f, 0xab, 0x5c, 0x8e, 0xa1, 0xe4, 0x5a, 0xeb, 0x9b, 0x5c, 0x9d,
+	0x16, 0xf3, 0x91, 0x55, 0xef, 0xec, 0x84, 0x31, 0xa3, 0x6d, 0xf9, 0xf0, 0x32, 0x45, 0x87, 0x93,
+	0xb1, 0x3e, 0x63, 0xf2, 0xe5, 0x56, 0x38, 0x5f, 0x52, 0x85, 0xb9, 0xf8, 0x6a, 0x25, 0x8e, 0x56,
+	0x38, 0xb7, 0x2e, 0xc2, 0x38, 0x8d, 0x41, 0xa9, 0xa9, 0x4b, 0x5b, 0x7d, 0x3e, 0x97, 0xdc, 0x1d,
+	0xc0, 0x61, 0x61, 0x16, 0xf6, 0xef, 0xc0, 0x50, 0xd5, 0x59, 0x60, 0x65, 0xd3, 0xd9, 0x58, 0x20,
+	0x6b, 0x5a, 0x4d, 0xde, 0x91, 0x6b, 0xae, 0xa6, 0x2b, 0xcf, 0xfd, 0x0a, 0xc1, 0x29, 0xff, 0x00,
+	0xe8, 0x8e, 0xff, 0x64, 0xdd, 0x84, 0x61, 0xcb, 0x26, 0x76, 0xcb, 0xad, 0xc5, 0xde, 0x28, 0x5c,
+	0xe8, 0x7a, 0x7a, 0x0a, 0x33, 0x5a, 0xa1, 0xe2, 0x02, 0x53, 0x3b, 0xb4, 0xb4, 0xfb, 0x0c, 0xc1,
+	0xb7, 0x3a, 0x30, 0x06, 0x05, 0x23, 0x25, 0xe2, 0x7d, 0x7d, 0x12, 0x30, 0x67, 0x0a, 0x87, 0xf7,
+	0x5d, 0xb9, 0x0c, 0xa7, 0x29, 0xbc, 0xfb, 0xba, 0x2d, 0x27, 0x2d, 0x7b, 0x38, 0x1d, 0xb2, 0x71,
+	0x4a, 0x8c, 0xd6, 0x87, 0x70, 0xcc, 0xbd, 0xd1, 0x2e, 0xaf, 0xf4, 0x01, 0xaa, 0x93, 0x61, 0x5a,
+	0x9d, 0x58, 0xdc, 0xbb, 0x30, 0x41, 0x1d, 0x2e, 0x3b, 0x9f, 0x55, 0x4d, 0x92, 0xf7, 0x50, 0x52,
+	0xfe, 0x63, 0x10, 0xc6, 0x02, 0x35, 0xbf, 0xb2, 0xed, 0xfb, 0xee, 0x9c, 0x85, 0x34, 0x8d, 0xb5,
+	0x18, 0x29, 0x8a, 0x52, 0x74, 0x8d, 0x95, 0x24, 0x1f, 0xc1, 0x88, 0xff, 0x74, 0x3a, 0x6f, 0x5f,
+	0xfa, 0x40, 0x5f, 0x8e, 0x63, 0xec, 0x55, 0x70, 0xea, 0x22, 0x89, 0x68, 0xba, 0xa6, 0x48, 0xa4,
+	0x29, 0x12, 0xc3, 0x10, 0x1b, 0xc4, 0x6a, 0xd0, 0x4a, 0x2a, 0x2d, 0x8c, 0xf9, 0x3b, 0x45, 0xc3,
+	0x58, 0x25, 0x56, 0x03, 0x73, 0x90, 0xd9, 0xd4, 0xcd, 0xad, 0x40, 0x70, 0x88, 0x0a, 0xa6, 0x9c,
+	0x45, 0x4f, 0xc6, 0x80, 0x53, 0x81, 0x45, 0xbf, 0xf8, 0xb1, 0x94, 0xfa, 0xe4, 0xf0, 0xbe, 0x61,
+	0x2f, 0xdf, 0xbd, 0x57, 0xa9, 0x28, 0x75, 0x61, 0xc2, 0xb7, 0xec, 0x15, 0x48, 0x15, 0xa5, 0x8e,
+	0x37, 0x61, 0x9c, 0xa2, 0x8a, 0x38, 0x3b, 0x76, 0x60, 0x67, 0xc7, 0x1d, 0xa3, 0x21, 0x3f, 0xdc,
+	0x03, 0x38, 0xd9, 0x96, 0x18, 0xec, 0x84, 0x8b, 0x30, 0x22, 0xb3, 0x35, 0xf6, 0xae, 0x9c, 0x8b,
+	0xbd, 0x5d, 0xed, 0x8a, 0x82, 0xaf, 0xc6, 0x7d, 0x82, 0xe0, 0xb4, 0x7f, 0x75, 0x3d, 0xb9, 0x50,
+	0x51, 0x94, 0xb6, 0x6c, 0x62, 0xda, 0x62, 0xe4, 0x86, 0xa4, 0xe8, 0xda, 0xea, 0xe1, 0x76, 0x07,
+	0x9f, 0x23, 0xc8, 0xc6, 0x01, 0x61, 0x54, 0x97, 0x60, 0xd4, 0xc3, 0xec, 0xbd, 0x24, 0x09, 0xb9,
+	0x06, 0x7a, 0x87, 0xf7, 0xa0, 0xbc, 0xcf, 0xde, 0xbb, 0x8a, 0x52, 0xd7, 0x14, 0xad, 0xbe, 0xa6,
+	0x6d, 0xea, 0x7b, 0xb8, 0xad, 0x5f, 0x21, 0x38, 0x11, 0xd1, 0xdc, 0xd3, 0x85, 0x8d, 0x1c, 0x88,
+	0xc3, 0x61, 0x30, 0x7a, 0x20, 0x05, 0x38, 0xa9, 0x2a, 0x96, 0xe5, 0x34, 0x1c, 0xf4, 0x19, 0x15,
+	0x25, 0xbd, 0xa5, 0xd9, 0xac, 0xa7, 0x19, 0x14, 0x4e, 0xb8, 0x9b, 0xee, 0x2b, 0xbd, 0xe4, 0x6e,
+	0xe1, 0x75, 0x48, 0xbb, 0x9d, 0x86, 0xd8, 0xd2, 0x6c, 0xa5, 0x49, 0xef, 0x61, 0xaa, 0x90, 0xcd,
+	0xbb, 0x83, 0x88, 0xbc, 0x37, 0x88, 0xc8, 0xdf, 0xf3, 0x06, 0x11, 0xa5, 0x8c, 0xd3, 0xda, 0x7f,
+	0xfa, 0x75, 0x0e, 0xfd, 0xee, 0x9f, 0x7f, 0x98, 0x43, 0x42, 0xca, 0x55, 0xff, 0xc8, 0xd1, 0xe6,
+	0x54, 0x98, 0xec, 0x8c, 0x8e, 0xff, 0x6e, 0xa6, 0x2d, 0x77, 0x59, 0x54, 0xb4, 0x4d, 0x9d, 0xa5,
+	0xed, 0x6c, 0xec, 0x51, 0xc6, 0xe8, 0xb3, 0x91, 0x42, 0xca, 0x0a, 0xb6, 0xb8, 0x6a, 0xa7, 0x3b,
+	0x3f, 0x81, 0xa3, 0xd9, 0x89, 0xf6, 0x9d, 0x9d, 0x7f, 0xf6, 0xae, 0x49, 0xd4, 0x09, 0x23, 0x55,
+	0x81, 0x4c, 0x98, 0x94, 0x97, 0xa0, 0x7b, 0x65, 0x95, 0x0e, 0xb1, 0x3a, 0xc4, 0x64, 0x7d, 0xc8,
+	0xda, 0xa6, 0xb2, 0xa9, 0x7f, 0x2c, 0x4b, 0xb6, 0x5c, 0x13, 0xe4, 0x47, 0xc4, 0xac, 0xf9, 0x31,
+	0x2a, 0xc0, 0x31, 0x52, 0xab, 0x99, 0xb2, 0x65, 0xb1, 0x46, 0x7b, 0xf2, 0xd9, 0xd3, 0x85, 0x09,
+	0xe6, 0xa8, 0xe8, 0xee, 0x54, 0x6c, 0x53, 0xd1, 0xea, 0x82, 0x27, 0x88, 0xa7, 0xc0, 0x29, 0xa0,
+	0x45, 0x5a, 0x05, 0x5b, 0xec, 0xb3, 0x31, 0xaa, 0xb5, 0xd4, 0x65, 0xba, 0xc0, 0xfd, 0x6b, 0x00,
+	0xa6, 0xba, 0xf8, 0x64, 0x21, 0x7b, 0x04, 0xe3, 0x44, 0x92, 0xcc, 0x16, 0x69, 0x8a, 0x86, 0x6c,
+	0xba, 0x86, 0x58, 0xd8, 0x4e, 0x47, 0x48, 0x7a, 0xf4, 0x96, 0x74, 0x45, 0x2b, 0x5d, 0x72, 0xe2,
+	0xf4, 0xf9, 0xd7, 0xb9, 0xd9, 0xd0, 0xd3, 0xea, 0x0a, 0xb3, 0xff, 0x2d, 0x58, 0xb5, 0x2d, 0xf6,
+	0xaa, 0x3a, 0x0a, 0x96, 0x70, 0x9c, 0x79, 0x29, 0xcb, 0x26, 0xc5, 0x86, 0xef, 0x41, 0xda, 0xa4,
+	0x58, 0x44, 0xab, 0x41, 0x4c, 0xb7, 0x30, 0x1c, 0x2d, 0x2d, 0x3a, 0x86, 0x9f, 0xbf, 0xc8, 0x9d,
+	0x71, 0xcd, 0x58, 0xb5, 0xad, 0xbc, 0xa2, 0xf3, 0x2a, 0xb1, 0x1b, 0xf9, 0x75, 0xb9, 0x4e, 0xa4,
+	0xdd, 0xdb, 0xb2, 0xf4, 0xec, 0xe9, 0x02, 0x30, 0x64, 0xb7, 0x65, 0x49, 0x48, 0xb9, 0x66, 0x2a,
+	0x8e, 0x15, 0xbc, 0x03, 0xe3, 0x86, 0x47, 0x55, 0x74, 0x37, 0xac, 0xc9, 0xc1, 0xc3, 0xa7, 0x33,
+	0x66, 0xb4, 0x05, 0x94, 0x5b, 0x81, 0x73, 0x5e, 0x99, 0xe2, 0x8d, 0x2a, 0x6e, 0x2b, 0x96, 0x6d,
+	0x2a, 0xd5, 0x96, 0x73, 0xfa, 0x45, 0xbf, 0xce, 0x99, 0x72, 0x47, 0x2a, 0xa1, 0x97, 0x3c, 0x23,
+	0x8c, 0x56, 0xbd, 0xd1, 0x03, 0xb7, 0x03, 0xd9, 0xf6, 0xe6, 0xdf, 0x1d, 0x09, 0x54, 0x88, 0x6d,
+	0x25, 0x79, 0x9a, 0x72, 0x90, 0x22, 0x54, 0x41, 0xb4, 0x88, 0xed, 0xe5, 0x04, 0x90, 0xc0, 0x46,
+	0x30, 0xdc, 0x18, 0x8c, 0x0c, 0x37, 0x9e, 0x22, 0x38, 0xdf, 0x8f, 0x02, 0xcb, 0x9a, 0x1f, 0xf6,
+	0x18, 0x6b, 0xf0, 0xb1, 0xb7, 0xad, 0x3b, 0xa7, 0xb8, 0x49, 0xc6, 0x1c, 0x8c, 0xdb, 0xba, 0xed,
+	0x54, 0x24, 0x1d, 0x4c, 0x8e, 0xd3, 0x8d, 0x40, 0x9d, 0x2b, 0xb3, 0xe9, 0x5a, 0xbb, 0x87, 0x8a,
+	0x33, 0xd4, 0x21, 0xd5, 0xa6, 0x5c, 0x54, 0x9d, 0xd7, 0x75, 0x0f, 0xdf, 0x05, 0x15, 0xe6, 0x93,
+	0x59, 0xec, 0x33, 0x1b, 0x3c, 0x07, 0x6f, 0x58, 0x9e, 0x4a, 0x98, 0x42, 0xc6, 0x5f, 0x75, 0x08,
+	0xcc, 0xdd, 0x04, 0xdc, 0xd9, 0x1b, 0xe0, 0x71, 0xc8, 0x6c, 0xdc, 0xdd, 0x10, 0x57, 0xd6, 0x36,
+	0x8a, 0xeb, 0x6b, 0x0f, 0x96, 0x6f, 0x8f, 0x1d, 0xc1, 0x19, 0x18, 0x0d, 0x7e, 0x22, 0x7c, 0x0c,
+	0x06, 0x8b, 0x1b, 0x3f, 0x18, 0x1b, 0x28, 0xfc, 0x67, 0x12, 0x86, 0x28, 0x60, 0xfc, 0x23, 0x04,
+	0xc3, 0xee, 0xcc, 0x17, 0x77, 0x6f, 0x42, 0xa2, 0x03, 0xe6, 0xec, 0x6c, 0x7f, 0x41, 0x97, 0x27,
+	0xf7, 0xd6, 0x8f, 0xff, 0xf2, 0xfa, 0xe7, 0x03, 0x53, 0xf8, 0x0c, 0xdf, 0x7d, 0xbc, 0x8e, 0xbf,
+	0x41, 0x90, 0xeb, 0x33, 0xc3, 0xc2, 0xb7, 0xba, 0xbb, 0x4c, 0x36, 0x23, 0xcd, 0x16, 0x0f, 0x60,
+	0x81, 0xb1, 0xb9, 0x46, 0xd9, 0x14, 0xf0, 0x25, 0xbe, 0xd7, 0x9f, 0x02, 0x82, 0xf4, 0xe6, 0x1f,
+	0xbb, 0xc7, 0xfa, 0x04, 0xff, 0x1b, 0xc1, 0x54, 0xcf, 0xa1, 0x36, 0xfe, 0xa0, 0x3b, 0xbc, 0x24,
+	0x53, 0xf7, 0xec, 0xcd, 0x7d, 0xeb, 0x33, 0x72, 0x1b, 0x94, 0xdc, 0x2a, 0x5e, 0x49, 0x4c, 0x2e,
+	0x72, 0x29, 0x9e, 0xf0, 0x74, 0xfc, 0x1a, 0x50, 0x7e, 0x8d, 0xe0, 0xcd, 0x5e, 0x73, 0x72, 0x7c,
+	0x23, 0x39, 0xe2, 0x98, 0x71, 0x7d, 0xf6, 0x83, 0xfd, 0xaa, 0x33, 0xbe, 0xcb, 0x94, 0xef, 0x4d,
+	0x7c, 0xe3, 0x40, 0x7c, 0xf1, 0x6f, 0x10, 0x1c, 0x6f, 0x9b, 0x6a, 0xe2, 0x4b, 0x7d, 0x52, 0xad,
+	0x63, 0x3e, 0x9a, 0x5d, 0xdc, 0x83, 0x06, 0xc3, 0xbf, 0x40, 0xf1, 0x5f, 0xc0, 0xe7, 0x62, 0xf1,
+	0x13, 0x4f, 0x8b, 0x7d, 0x35, 0xf0, 0x57, 0x08, 0x26, 0xe2, 0xa6, 0x8c, 0xf8, 0xdb, 0x7b, 0x9d,
+	0x4a, 0xba, 0x88, 0xaf, 0xee, 0x6f, 0x98, 0xc9, 0xdd, 0xa7, 0xb0, 0xcb, 0x78, 0x63, 0xdf, 0x61,
+	0xa7, 0x96, 0x45, 0xd3, 0x37, 0x2d, 0x36, 0x15, 0xcb, 0xc6, 0xcf, 0x10, 0x8c, 0x77, 0x0c, 0xba,
+	0x70, 0x61, 0x4f, 0x53, 0x31, 0x97, 0xd9, 0xe5, 0x7d, 0x4c, 0xd2, 0xb8, 0x7b, 0x94, 0xd6, 0x06,
+	0x5e, 0x3f, 0x00, 0xad, 0xc8, 0x64, 0x8f, 0x92, 0xfa, 0x04, 0xc1, 0x10, 0x7d, 0xe1, 0xf1, 0xf9,
+	0xee, 0xa0, 0xc2, 0xa3, 0xad, 0xec, 0x85, 0xbe, 0x72, 0x0c, 0xf0, 0x3c, 0x05, 0x7c, 0x1e, 0xbf,
+	0x1d, 0x0b, 0xd8, 0xed, 0x3f, 0x82, 0xcb, 0xfc, 0x53, 0x04, 0x10, 0x4c, 0x88, 0xf0, 0xc5, 0xde,
+	0x21, 0x8a, 0xcc, 0xba, 0xb2, 0xf3, 0xc9, 0x84, 0x13, 0x7d, 0x31, 0xd8, 0x78, 0xe9, 0x33, 0x04,
+	0x99, 0xc8, 0x70, 0x07, 0xe7, 0xbb, 0x3b, 0x89, 0x1b, 0x1d, 0x65, 0xf9, 0xc4, 0xf2, 0x0c, 0xd7,
+	0x45, 0x8a, 0xeb, 0x1c, 0x7e, 0x2b, 0x16, 0xd7, 0xb6, 0xa3, 0x13, 0x84, 0xeb, 0xf7, 0x08, 0x46,
+	0xbc, 0x6e, 0x16, 0xbf, 0xd3, 0xdd, 0x55, 0xdb, 0xbc, 0x28, 0x3b, 0x97, 0x44, 0x94, 0x01, 0x5a,
+	0xa5, 0x80, 0x4a, 0xf8, 0xd6, 0x7e, 0x33, 0xce, 0x6b, 0xae, 0xf1, 0x2f, 0x10, 0x64, 0x22, 0xad,
+	0x7b, 0xaf, 0x68, 0xc6, 0x0d, 0x1b, 0xb2, 0x7c, 0x62, 0x79, 0x06, 0xfe, 0x3c, 0x05, 0x3f, 0x83,
+	0xa7, 0x63, 0xc1, 0x07, 0x6d, 0xff, 0x6f, 0x11, 0xa4, 0x42, 0x5d, 0x17, 0xee, 0x91, 0x4b, 0x9d,
+	0x0d, 0x7d, 0x76, 0x21, 0xa1, 0x34, 0x03, 0x75, 0x9d, 0x82, 0xba, 0x82, 0x0b, 0xb1, 0xa0, 0x22,
+	0x6d, 0x62, 0x7b, 0x30, 0xf1, 0x2f, 0x11, 0xa4, 0x2b, 0xe1, 0x1e, 0x30, 0x99, 0x6f, 0x3f, 0x82,
+	0xf9, 0xa4, 0xe2, 0x0c, 0xeb, 0x1c, 0xc5, 0xfa, 0x36, 0xe6, 0xfa, 0x63, 0xc5, 0x7f, 0x44, 0x30,
+	0xd6, 0xde, 0xcd, 0xe1, 0x1e, 0x5f, 0x9c, 0x2e, 0xdd, 0x66, 0xb6, 0xb0, 0x17, 0x95, 0x44, 0x25,
+	0x53, 0x47, 0xe3, 0xc5, 0x3f, 0x66, 0x6d, 0xea, 0x13, 0xfc, 0x77, 0x04, 0xa7, 0xbb, 0xb6, 0x15,
+	0xf8, 0x7a, 0xcf, 0xfb, 0xdb, 0xb3, 0x9d, 0xca, 0xbe, 0xb7, 0x2f, 0x5d, 0x46, 0x68, 0x89, 0x12,
+	0xba, 0x81, 0xdf, 0xeb, 0xf6, 0x0e, 0xf8, 0x7f, 0x89, 0x16, 0x6b, 0x21, 0x0b, 0xfc, 0xe3, 0xa0,
+	0x83, 0x7b, 0x82, 0xff, 0x8b, 0x20, 0xd7, 0xa7, 0x55, 0xe8, 0x55, 0xf1, 0x26, 0xeb, 0x5b, 0xb2,
+	0xc5, 0x03, 0x58, 0x60, 0x6c, 0xcb, 0x94, 0xed, 0xf7, 0xf0, 0xea, 0x7e, 0x1f, 0x99, 0xa0, 0x9b,
+	0x21, 0xd4, 0x72, 0x69, 0xfd, 0x8b, 0x97, 0xd3, 0xe8, 0xcb, 0x97, 0xd3, 0xe8, 0x9b, 0x97, 0xd3,
+	0xe8, 0xd3, 0x57, 0xd3, 0x47, 0xbe, 0x7c, 0x35, 0x7d, 0xe4, 0x6f, 0xaf, 0xa6, 0x8f, 0x3c, 0x28,
+	0xf4, 0x1f, 0xba, 0xee, 0x04, 0xee, 0x69, 0x6b, 0x5d, 0x1d, 0xa6, 0xf3, 0xad, 0xcb, 0xff, 0x1b,
+	0x00, 0x5b, 0x90, 0x58, 0xe9, 0x54, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2016,6 +2464,20 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries the signing info of all the active finality providers
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// ProjectedRewards estimates the BTC staking rewards of a given address over
+	// the next given number of epochs. The projection is best-effort and
+	// non-binding: it assumes the rewards keep accruing at the rate of the most
+	// recent epoch and the voting power distribution stays as it is now
+	ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error)
+	// VotingPowerDistributionAt queries the voting power distribution, i.e., the
+	// active sats of each finality provider, at a given BTC height. The
+	// distribution is reconstructed by replaying the logged power distribution
+	// update events up to the BTC height
+	VotingPowerDistributionAt(ctx context.Context, in *QueryVotingPowerDistributionAtRequest, opts ...grpc.CallOption) (*QueryVotingPowerDistributionAtResponse, error)
+	// FinalityProviderSlashableAmount queries the amount of BTC stake that
+	// would be slashed if a given finality provider misbehaves, i.e., its
+	// active sats under the current voting power distribution
+	FinalityProviderSlashableAmount(ctx context.Context, in *QueryFinalityProviderSlashableAmountRequest, opts ...grpc.CallOption) (*QueryFinalityProviderSlashableAmountResponse, error)
 }
 
 type queryClient struct {
@@ -2152,6 +2614,33 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error) {
+	out := new(QueryProjectedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ProjectedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPowerDistributionAt(ctx context.Context, in *QueryVotingPowerDistributionAtRequest, opts ...grpc.CallOption) (*QueryVotingPowerDistributionAtResponse, error) {
+	out := new(QueryVotingPowerDistributionAtResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/VotingPowerDistributionAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderSlashableAmount(ctx context.Context, in *QueryFinalityProviderSlashableAmountRequest, opts ...grpc.CallOption) (*QueryFinalityProviderSlashableAmountResponse, error) {
+	out := new(QueryFinalityProviderSlashableAmountResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalityProviderSlashableAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -2186,6 +2675,20 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries the signing info of all the active finality providers
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// ProjectedRewards estimates the BTC staking rewards of a given address over
+	// the next given number of epochs. The projection is best-effort and
+	// non-binding: it assumes the rewards keep accruing at the rate of the most
+	// recent epoch and the voting power distribution stays as it is now
+	ProjectedRewards(context.Context, *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error)
+	// VotingPowerDistributionAt queries the voting power distribution, i.e., the
+	// active sats of each finality provider, at a given BTC height. The
+	// distribution is reconstructed by replaying the logged power distribution
+	// update events up to the BTC height
+	VotingPowerDistributionAt(context.Context, *QueryVotingPowerDistributionAtRequest) (*QueryVotingPowerDistributionAtResponse, error)
+	// FinalityProviderSlashableAmount queries the amount of BTC stake that
+	// would be slashed if a given finality provider misbehaves, i.e., its
+	// active sats under the current voting power distribution
+	FinalityProviderSlashableAmount(context.Context, *QueryFinalityProviderSlashableAmountRequest) (*QueryFinalityProviderSlashableAmountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2234,6 +2737,15 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedRewards(ctx context.Context, req *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRewards not implemented")
+}
+func (*UnimplementedQueryServer) VotingPowerDistributionAt(ctx context.Context, req *QueryVotingPowerDistributionAtRequest) (*QueryVotingPowerDistributionAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerDistributionAt not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderSlashableAmount(ctx context.Context, req *QueryFinalityProviderSlashableAmountRequest) (*QueryFinalityProviderSlashableAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderSlashableAmount not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2491,17 +3003,71 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.finality.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ActiveFinalityProvidersAtHeight",
-			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
+func _Query_ProjectedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ProjectedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRewards(ctx, req.(*QueryProjectedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPowerDistributionAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerDistributionAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerDistributionAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/VotingPowerDistributionAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerDistributionAt(ctx, req.(*QueryVotingPowerDistributionAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderSlashableAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderSlashableAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderSlashableAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalityProviderSlashableAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderSlashableAmount(ctx, req.(*QueryFinalityProviderSlashableAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.finality.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ActiveFinalityProvidersAtHeight",
+			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
 		},
 		{
 			MethodName: "FinalityProviderPowerAtHeight",
@@ -2551,6 +3117,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "ProjectedRewards",
+			Handler:    _Query_ProjectedRewards_Handler,
+		},
+		{
+			MethodName: "VotingPowerDistributionAt",
+			Handler:    _Query_VotingPowerDistributionAt_Handler,
+		},
+		{
+			MethodName: "FinalityProviderSlashableAmount",
+			Handler:    _Query_FinalityProviderSlashableAmount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/finality/v1/query.proto",
@@ -3836,141 +4414,415 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProjectedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+func (m *QueryProjectedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryProjectedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.ProjectedRewards) > 0 {
+		for iNdEx := len(m.ProjectedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	{
+		size := m.RewardShare.Size()
+		i -= size
+		if _, err := m.RewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.AccrualPerEpoch) > 0 {
+		for iNdEx := len(m.AccrualPerEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccrualPerEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVotingPowerDistributionAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerDistributionAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerDistributionAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveFinalityProvidersAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalityProviderActiveSats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderActiveSats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderActiveSats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcPkHex != nil {
-		l = m.BtcPkHex.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	if m.ActiveSats != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveSats))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Jailed {
-		n += 2
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVotingPowerDistributionAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerDistributionAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerDistributionAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalActiveSats != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalActiveSats))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderSlashableAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderSlashableAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderSlashableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderSlashableAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderSlashableAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderSlashableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashableSats != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashableSats))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPkHex != nil {
+		l = m.BtcPkHex.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.FinalityProviders) > 0 {
@@ -4373,26 +5225,142 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryProjectedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *QueryProjectedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccrualPerEpoch) > 0 {
+		for _, e := range m.AccrualPerEpoch {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.RewardShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProjectedRewards) > 0 {
+		for _, e := range m.ProjectedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVotingPowerDistributionAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *FinalityProviderActiveSats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveSats != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveSats))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVotingPowerDistributionAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalActiveSats != 0 {
+		n += 1 + sovQuery(uint64(m.TotalActiveSats))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderSlashableAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderSlashableAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.SlashableSats != 0 {
+		n += 1 + sovQuery(uint64(m.SlashableSats))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -7060,7 +8028,756 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &EvidenceResponse{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, &EvidenceResponse{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, SigningInfoResponse{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7087,10 +8804,10 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &EvidenceResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7115,7 +8832,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7138,17 +8855,17 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7158,16 +8875,29 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
 			}
-			var msglen int
+			m.NumEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7177,28 +8907,11 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NumEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7220,7 +8933,7 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7243,15 +8956,15 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrualPerEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7278,14 +8991,48 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidences = append(m.Evidences, &EvidenceResponse{})
-			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccrualPerEpoch = append(m.AccrualPerEpoch, types.Coin{})
+			if err := m.AccrualPerEpoch[len(m.AccrualPerEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7312,10 +9059,8 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProjectedRewards = append(m.ProjectedRewards, types.Coin{})
+			if err := m.ProjectedRewards[len(m.ProjectedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7340,7 +9085,7 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingPowerDistributionAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7363,17 +9108,17 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingPowerDistributionAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingPowerDistributionAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
 			}
-			var stringLen uint64
+			m.BtcHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7383,24 +9128,11 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BtcHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7422,7 +9154,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderActiveSats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7445,10 +9177,10 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderActiveSats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderActiveSats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7485,9 +9217,9 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSats", wireType)
 			}
-			m.StartHeight = 0
+			m.ActiveSats = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7497,35 +9229,16 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.ActiveSats |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
-			}
-			m.MissedBlocksCounter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7535,25 +9248,12 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7575,7 +9275,7 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingPowerDistributionAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7598,15 +9298,15 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingPowerDistributionAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingPowerDistributionAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7633,10 +9333,30 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderActiveSats{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveSats", wireType)
+			}
+			m.TotalActiveSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7658,7 +9378,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderSlashableAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7681,17 +9401,17 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderSlashableAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderSlashableAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7701,27 +9421,23 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7744,7 +9460,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderSlashableAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7767,17 +9483,17 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderSlashableAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderSlashableAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7787,31 +9503,16 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SigningInfos = append(m.SigningInfos, SigningInfoResponse{})
-			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashableSats", wireType)
 			}
-			var msglen int
+			m.SlashableSats = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7821,28 +9522,11 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SlashableSats |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10,6 +10,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagDenom = "denom"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group incentive queries under a subcommand
@@ -26,6 +30,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryRewardGauges(),
 		CmdQueryBTCStakingGauge(),
 		CmdQueryBTCTimestampingGauge(),
+		CmdQueryIncentiveModuleAccounting(),
+		CmdQueryAllRewardGauges(),
+		CmdQueryRewardsDistributed(),
+		CmdQueryRewardDenoms(),
+		CmdQueryGaugesByType(),
 	)
 
 	return cmd
@@ -77,10 +86,16 @@ func CmdQueryBTCStakingGauge() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryBTCStakingGaugeRequest{
 				Height: height,
+				Denom:  denom,
 			}
 			res, err := queryClient.BTCStakingGauge(cmd.Context(), req)
 			if err != nil {
@@ -91,6 +106,7 @@ func CmdQueryBTCStakingGauge() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagDenom, "", "The (optional) denom of the queried coin in the gauge")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -112,10 +128,16 @@ func CmdQueryBTCTimestampingGauge() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryBTCTimestampingGaugeRequest{
 				EpochNum: epoch,
+				Denom:    denom,
 			}
 			res, err := queryClient.BTCTimestampingGauge(cmd.Context(), req)
 			if err != nil {
@@ -126,7 +148,172 @@ func CmdQueryBTCTimestampingGauge() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagDenom, "", "The (optional) denom of the queried coin in the gauge")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIncentiveModuleAccounting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounting",
+		Short: "shows the balance of the incentive module account against the withdrawable rewards of all reward gauges",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentiveModuleAccounting(cmd.Context(), &types.QueryIncentiveModuleAccountingRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllRewardGauges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-reward-gauges [address]",
+		Short: "shows reward gauges with withdrawable coins of a given address under all stakeholder types",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllRewardGaugesRequest{
+				Address: args[0],
+			}
+			res, err := queryClient.AllRewardGauges(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRewardsDistributed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-distributed [from_epoch] [to_epoch]",
+		Short: "shows the total rewards distributed to all reward gauges in the epochs within the given range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromEpoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRewardsDistributedRequest{
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			}
+			res, err := queryClient.RewardsDistributed(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRewardDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-denoms [address]",
+		Short: "shows the denoms present across the reward gauges of a given address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardDenomsRequest{
+				Address: args[0],
+			}
+			res, err := queryClient.RewardDenoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGaugesByType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauges-by-type [stakeholder_type]",
+		Short: "shows the reward gauges of all stakeholders of a given type (submitter, reporter, finality_provider, or btc_delegation)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryGaugesByTypeRequest{
+				StakeholderType: args[0],
+				Pagination:      pageReq,
+			}
+			res, err := queryClient.GaugesByType(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gauges-by-type")
 
 	return cmd
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"github.com/spf13/cobra"
+	"strings"
 
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,6 +23,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewWithdrawRewardCmd(),
+		NewWithdrawAllRewardsCmd(),
 	)
 
 	return cmd
@@ -51,3 +53,34 @@ func NewWithdrawRewardCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewWithdrawAllRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-all-rewards",
+		Short: "withdraw rewards of the stakeholder behind the transaction submitter in all types with withdrawable rewards",
+		Long: strings.TrimSpace(
+			`Withdraw rewards of the stakeholder behind the transaction submitter in all
+stakeholder types (submitter, reporter, finality_provider, btc_delegation) with
+withdrawable rewards, in a single transaction. The withdrawn rewards are
+itemized per stakeholder type in the message response of the transaction, which
+can be inspected via "query tx" once the transaction is included.`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgWithdrawAllReward{
+				Address: clientCtx.FromAddress.String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,24 +19,59 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		// failing to get a reward gauge at previous height is a programming error
 		panic("failed to get a reward gauge at previous height")
 	}
+	// rewards accumulated in reward gauges, recorded as distributed at once
+	distributed := sdk.NewCoins()
 	// reward each of the finality provider and its BTC delegations in proportion
 	for _, fp := range filteredDc.FinalityProviders {
 		// get coins that will be allocated to the finality provider and its BTC delegations
 		fpPortion := filteredDc.GetFinalityProviderPortion(fp)
 		coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
-		// reward the finality provider with commission
-		coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
-		k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)
-		// reward the rest of coins to each BTC delegation proportional to its voting power portion
-		coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
-		for _, btcDel := range fp.BtcDels {
-			btcDelPortion := fp.GetBTCDelPortion(btcDel)
-			coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
-			k.accumulateRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress(), coinsForDel)
+		// reward the finality provider with commission, and the rest of coins
+		// to each BTC delegation proportional to its voting power portion
+		coinsForCommission, coinsForBTCDels := splitFinalityProviderRewards(fp, coinsForFpsAndDels)
+		distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)...)
+		selfDelRewards := sdk.NewCoins()
+		for i, btcDel := range fp.BtcDels {
+			distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress(), coinsForBTCDels[i])...)
+			// track the rewards of the finality provider's self-delegations, as
+			// they are mixed with the rewards of the staker's other BTC
+			// delegations in the reward gauge
+			if btcDel.IsSelfDel {
+				selfDelRewards = selfDelRewards.Add(coinsForBTCDels[i]...)
+			}
 		}
+		if !selfDelRewards.IsZero() {
+			k.recordFpSelfDelRewards(ctx, fp.BtcPk.MustMarshal(), selfDelRewards)
+		}
+	}
+	// the change due to truncating the finality providers' portions of the
+	// gauge is not accumulated into any reward gauge, and thus remains in the
+	// incentive module account. It is bounded by one unit of each denom per
+	// finality provider. The change due to truncating the BTC delegations'
+	// rewards is credited to the finality provider, see
+	// splitFinalityProviderRewards
+	k.recordRewardsDistributed(ctx, distributed)
+}
+
+// splitFinalityProviderRewards splits the given rewards of a finality provider
+// into its commission and the rewards of each of its BTC delegations, in the
+// same order as the BTC delegations. The change due to the truncating
+// operations upon the BTC delegations' rewards is credited to the finality
+// provider, such that the split sums up to the given rewards exactly
+func splitFinalityProviderRewards(fp *ftypes.FinalityProviderDistInfo, coins sdk.Coins) (sdk.Coins, []sdk.Coins) {
+	coinsForCommission := types.GetCoinsPortion(coins, *fp.Commission)
+	coinsForBTCDels := coins.Sub(coinsForCommission...)
+
+	coinsForEachBTCDel := make([]sdk.Coins, 0, len(fp.BtcDels))
+	change := coinsForBTCDels
+	for _, btcDel := range fp.BtcDels {
+		btcDelPortion := fp.GetBTCDelPortion(btcDel)
+		coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
+		coinsForEachBTCDel = append(coinsForEachBTCDel, coinsForDel)
+		change = change.Sub(coinsForDel...)
 	}
 
-	// TODO: handle the change in the gauge due to the truncating operations
+	return coinsForCommission.Add(change...), coinsForEachBTCDel
 }
 
 func (k Keeper) accumulateBTCStakingReward(ctx context.Context, btcStakingReward sdk.Coins) {
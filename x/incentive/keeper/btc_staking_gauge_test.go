@@ -6,6 +6,7 @@ import (
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	epochingtypes "github.com/babylonlabs-io/babylon/x/epoching/types"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
@@ -23,8 +24,15 @@ func FuzzRewardBTCStaking(f *testing.F) {
 		// mock bank keeper
 		bankKeeper := types.NewMockBankKeeper(ctrl)
 
+		// mock epoching keeper
+		epochNum := datagen.RandomInt(r, 1000) + 1
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		// the distributed rewards are recorded at once, rather than once per
+		// reward gauge
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).MaxTimes(1)
+
 		// create incentive keeper
-		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, epochingKeeper)
 		height := datagen.RandomInt(r, 1000)
 		ctx = datagen.WithCtxHeight(ctx, height)
 
@@ -40,24 +48,37 @@ func FuzzRewardBTCStaking(f *testing.F) {
 		distributedCoins := sdk.NewCoins()
 		fpRewardMap := map[string]sdk.Coins{}     // key: address, value: reward
 		btcDelRewardMap := map[string]sdk.Coins{} // key: address, value: reward
+		// staker addresses of BTC delegations whose rewards are routed elsewhere
+		routedStakerAddrs := []string{}
 
 		for _, fp := range dc.FinalityProviders {
+			distributedBefore := distributedCoins
 			fpPortion := dc.GetFinalityProviderPortion(fp)
 			coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
 			coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
-			if coinsForCommission.IsAllPositive() {
-				fpRewardMap[fp.GetAddress().String()] = coinsForCommission
-				distributedCoins.Add(coinsForCommission...)
-			}
 			coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
+			// the change due to truncation goes to the finality provider
+			change := coinsForBTCDels
 			for _, btcDel := range fp.BtcDels {
 				btcDelPortion := fp.GetBTCDelPortion(btcDel)
 				coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
+				change = change.Sub(coinsForDel...)
 				if coinsForDel.IsAllPositive() {
 					btcDelRewardMap[btcDel.GetAddress().String()] = coinsForDel
-					distributedCoins.Add(coinsForDel...)
+					distributedCoins = distributedCoins.Add(coinsForDel...)
 				}
+				if len(btcDel.RewardAddress) > 0 {
+					routedStakerAddrs = append(routedStakerAddrs, btcDel.StakerAddr)
+				}
+			}
+			coinsForFp := coinsForCommission.Add(change...)
+			if coinsForFp.IsAllPositive() {
+				fpRewardMap[fp.GetAddress().String()] = coinsForFp
+				distributedCoins = distributedCoins.Add(coinsForFp...)
 			}
+			// the rewards of the finality provider and its BTC delegations sum
+			// up to its portion exactly
+			require.Equal(t, coinsForFpsAndDels, distributedCoins.Sub(distributedBefore...))
 		}
 
 		// distribute rewards in the gauge to finality providers/delegations
@@ -78,8 +99,93 @@ func FuzzRewardBTCStaking(f *testing.F) {
 			require.NotNil(t, rg)
 			require.Equal(t, reward, rg.Coins)
 		}
+		// assert stakers with a distinct reward address do not receive rewards
+		for _, addrStr := range routedStakerAddrs {
+			addr, err := sdk.AccAddressFromBech32(addrStr)
+			require.NoError(t, err)
+			require.Nil(t, keeper.GetRewardGauge(ctx, types.BTCDelegationType, addr))
+		}
 
 		// assert distributedCoins is a subset of coins in gauge
 		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
+
+		// assert the rewards distributed in the epoch are the sum of the
+		// rewards accumulated in all reward gauges
+		accumulated := sdk.NewCoins()
+		for _, reward := range fpRewardMap {
+			accumulated = accumulated.Add(reward...)
+		}
+		for _, reward := range btcDelRewardMap {
+			accumulated = accumulated.Add(reward...)
+		}
+		recorded, err := keeper.GetRewardsDistributed(ctx, epochNum, epochNum)
+		require.NoError(t, err)
+		require.Equal(t, accumulated, recorded)
+	})
+}
+
+func FuzzRewardBTCStakingSelfDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock epoching keeper
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
+
+		// create incentive keeper
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+		height := datagen.RandomInt(r, 1000)
+		ctx = datagen.WithCtxHeight(ctx, height)
+
+		// set a random gauge
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCStakingGauge(ctx, height, gauge)
+
+		// generate a random voting power distribution cache, where some BTC
+		// delegations are staked by the finality provider they restake to
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 100)
+		require.NoError(t, err)
+		expectedSelfDelRewards := map[string]sdk.Coins{} // key: finality provider BTC PK
+		for _, fp := range dc.FinalityProviders {
+			fpPortion := dc.GetFinalityProviderPortion(fp)
+			coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
+			coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
+			coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
+
+			selfDelRewards := sdk.NewCoins()
+			for _, btcDel := range fp.BtcDels {
+				if r.Intn(2) == 0 {
+					continue
+				}
+				btcDel.StakerAddr = fp.GetAddress().String()
+				btcDel.IsSelfDel = true
+				btcDelPortion := fp.GetBTCDelPortion(btcDel)
+				selfDelRewards = selfDelRewards.Add(types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)...)
+			}
+			expectedSelfDelRewards[fp.BtcPk.MarshalHex()] = selfDelRewards
+		}
+
+		// distribute rewards in the gauge to finality providers/delegations
+		keeper.RewardBTCStaking(ctx, height, dc)
+
+		// assert the rewards of the self-delegations of each finality
+		// provider are tracked
+		for _, fp := range dc.FinalityProviders {
+			selfDelRewards, err := keeper.GetFpSelfDelRewards(ctx, fp.BtcPk.MustMarshal())
+			require.NoError(t, err)
+			require.Equal(t, expectedSelfDelRewards[fp.BtcPk.MarshalHex()], selfDelRewards)
+		}
+
+		// a finality provider without any rewards has no self-delegation
+		// rewards
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		selfDelRewards, err := keeper.GetFpSelfDelRewards(ctx, fpBTCPK.MustMarshal())
+		require.NoError(t, err)
+		require.True(t, selfDelRewards.IsZero())
 	})
 }
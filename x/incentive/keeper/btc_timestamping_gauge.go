@@ -24,26 +24,30 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 	// TODO: parameterise bestPortion
 	bestPortion := math.LegacyNewDecWithPrec(80, 2) // 80 * 10^{-2} = 0.8
 
+	// rewards accumulated in reward gauges, recorded as distributed at once
+	distributed := sdk.NewCoins()
+
 	// distribute coins to best submitter
 	submitterPortion := params.SubmitterPortion.QuoTruncate(btcTimestampingPortion)
 	coinsToSubmitters := gauge.GetCoinsPortion(submitterPortion)
 	coinsToBestSubmitter := types.GetCoinsPortion(coinsToSubmitters, bestPortion)
-	k.accumulateRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter, coinsToBestSubmitter)
+	distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter, coinsToBestSubmitter)...)
 	restCoinsToSubmitters := coinsToSubmitters.Sub(coinsToBestSubmitter...)
 
 	// distribute coins to best reporter
 	reporterPortion := params.ReporterPortion.QuoTruncate(btcTimestampingPortion)
 	coinsToReporters := gauge.GetCoinsPortion(reporterPortion)
 	coinsToBestReporter := types.GetCoinsPortion(coinsToReporters, bestPortion)
-	k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, coinsToBestReporter)
+	distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, coinsToBestReporter)...)
 	restCoinsToReporters := coinsToReporters.Sub(coinsToBestReporter...)
 
 	// if there is only 1 submission, distribute the rest to submitter and reporter, then skip the rest logic
 	if len(rdi.Others) == 0 {
 		// give rest coins to the best submitter
-		k.accumulateRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter, restCoinsToSubmitters)
+		distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter, restCoinsToSubmitters)...)
 		// give rest coins to the best reporter
-		k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, restCoinsToReporters)
+		distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, restCoinsToReporters)...)
+		k.recordRewardsDistributed(ctx, distributed)
 		// skip the rest logic
 		return
 	}
@@ -54,7 +58,7 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 	coinsToEachOtherSubmitter := types.GetCoinsPortion(restCoinsToSubmitters, eachOtherSubmitterPortion)
 	if coinsToEachOtherSubmitter.IsAllPositive() {
 		for _, submission := range rdi.Others {
-			k.accumulateRewardGauge(ctx, types.SubmitterType, submission.Submitter, coinsToEachOtherSubmitter)
+			distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.SubmitterType, submission.Submitter, coinsToEachOtherSubmitter)...)
 		}
 	}
 
@@ -64,9 +68,11 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 	coinsToEachOtherReporter := types.GetCoinsPortion(restCoinsToReporters, eachOtherReporterPortion)
 	if coinsToEachOtherReporter.IsAllPositive() {
 		for _, submission := range rdi.Others {
-			k.accumulateRewardGauge(ctx, types.ReporterType, submission.Reporter, coinsToEachOtherReporter)
+			distributed = distributed.Add(k.accumulateRewardGauge(ctx, types.ReporterType, submission.Reporter, coinsToEachOtherReporter)...)
 		}
 	}
+
+	k.recordRewardsDistributed(ctx, distributed)
 }
 
 func (k Keeper) accumulateBTCTimestampingReward(ctx context.Context, btcTimestampingReward sdk.Coins) {
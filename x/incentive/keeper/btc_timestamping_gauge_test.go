@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	epochingtypes "github.com/babylonlabs-io/babylon/x/epoching/types"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
@@ -24,8 +25,15 @@ func FuzzRewardBTCTimestamping(f *testing.F) {
 		// mock bank keeper
 		bankKeeper := types.NewMockBankKeeper(ctrl)
 
+		// mock epoching keeper
+		epochNum := datagen.RandomInt(r, 1000) + 1
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		// the distributed rewards are recorded at once, rather than once per
+		// reward gauge
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).MaxTimes(1)
+
 		// create incentive keeper
-		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, epochingKeeper)
 		epoch := datagen.RandomInt(r, 1000) + 1
 
 		// set a random gauge
@@ -119,5 +127,12 @@ func FuzzRewardBTCTimestamping(f *testing.F) {
 
 		// assert distributedCoins is a subset of coins in gauge
 		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
+
+		// assert the rewards distributed in the epoch are a subset of coins
+		// in gauge as well
+		recorded, err := keeper.GetRewardsDistributed(ctx, epochNum, epochNum)
+		require.NoError(t, err)
+		require.True(t, recorded.IsAllPositive())
+		require.True(t, gauge.Coins.IsAllGTE(recorded))
 	})
 }
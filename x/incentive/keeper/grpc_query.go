@@ -5,6 +5,7 @@ import (
 
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -46,6 +47,9 @@ func (k Keeper) BTCStakingGauge(goCtx context.Context, req *types.QueryBTCStakin
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if err := validateDenomFilter(req.Denom); err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// find gauge
@@ -53,6 +57,7 @@ func (k Keeper) BTCStakingGauge(goCtx context.Context, req *types.QueryBTCStakin
 	if gauge == nil {
 		return nil, types.ErrBTCStakingGaugeNotFound
 	}
+	gauge.Coins = filterCoinsByDenom(gauge.Coins, req.Denom)
 
 	return &types.QueryBTCStakingGaugeResponse{Gauge: convertGaugeToBTCStakingResponse(*gauge)}, nil
 }
@@ -61,6 +66,9 @@ func (k Keeper) BTCTimestampingGauge(goCtx context.Context, req *types.QueryBTCT
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if err := validateDenomFilter(req.Denom); err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// find gauge
@@ -68,10 +76,175 @@ func (k Keeper) BTCTimestampingGauge(goCtx context.Context, req *types.QueryBTCT
 	if gauge == nil {
 		return nil, types.ErrBTCTimestampingGaugeNotFound
 	}
+	gauge.Coins = filterCoinsByDenom(gauge.Coins, req.Denom)
 
 	return &types.QueryBTCTimestampingGaugeResponse{Gauge: convertGaugeToBTCTimestampingResponse(*gauge)}, nil
 }
 
+func (k Keeper) IncentiveModuleAccounting(goCtx context.Context, req *types.QueryIncentiveModuleAccountingRequest) (*types.QueryIncentiveModuleAccountingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	moduleBalance := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
+	gaugeTotal := k.GetWithdrawableRewardsTotal(ctx)
+
+	// compare the two totals denom by denom
+	surplus, deficit := sdk.NewCoins(), sdk.NewCoins()
+	for _, denom := range moduleBalance.Add(gaugeTotal...).Denoms() {
+		diff := moduleBalance.AmountOf(denom).Sub(gaugeTotal.AmountOf(denom))
+		if diff.IsPositive() {
+			surplus = surplus.Add(sdk.NewCoin(denom, diff))
+		} else if diff.IsNegative() {
+			deficit = deficit.Add(sdk.NewCoin(denom, diff.Neg()))
+		}
+	}
+
+	return &types.QueryIncentiveModuleAccountingResponse{
+		ModuleBalance: moduleBalance,
+		GaugeTotal:    gaugeTotal,
+		Surplus:       surplus,
+		Deficit:       deficit,
+	}, nil
+}
+
+// AllRewardGauges returns the reward gauges of the given address under all
+// stakeholder types, with their withdrawable coins precomputed. Unlike
+// RewardGauges, reward gauges without withdrawable coins are omitted and no
+// error is returned if there is no reward gauge at all
+func (k Keeper) AllRewardGauges(goCtx context.Context, req *types.QueryAllRewardGaugesRequest) (*types.QueryAllRewardGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// try to cast address
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rgMap := map[string]*types.RewardGaugeWithWithdrawableResponse{}
+	for _, sType := range types.GetAllStakeholderTypes() {
+		rg := k.GetRewardGauge(ctx, sType, address)
+		if rg == nil {
+			continue
+		}
+		withdrawableCoins := rg.GetWithdrawableCoins()
+		if withdrawableCoins.IsZero() {
+			continue
+		}
+		rgMap[sType.String()] = &types.RewardGaugeWithWithdrawableResponse{
+			Coins:             rg.Coins,
+			WithdrawnCoins:    rg.WithdrawnCoins,
+			WithdrawableCoins: withdrawableCoins,
+		}
+	}
+
+	return &types.QueryAllRewardGaugesResponse{RewardGauges: rgMap}, nil
+}
+
+// RewardsDistributed returns the total rewards distributed to all reward
+// gauges in the epochs within [from_epoch, to_epoch]
+func (k Keeper) RewardsDistributed(goCtx context.Context, req *types.QueryRewardsDistributedRequest) (*types.QueryRewardsDistributedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "from_epoch %d is larger than to_epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	total, err := k.GetRewardsDistributed(ctx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardsDistributedResponse{Total: total}, nil
+}
+
+// RewardDenoms returns the sorted distinct denoms present across the reward
+// gauges of the given address under all stakeholder types. An empty list is
+// returned if the address has no reward gauge
+func (k Keeper) RewardDenoms(goCtx context.Context, req *types.QueryRewardDenomsRequest) (*types.QueryRewardDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// try to cast address
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allCoins := sdk.NewCoins()
+	for _, sType := range types.GetAllStakeholderTypes() {
+		rg := k.GetRewardGauge(ctx, sType, address)
+		if rg == nil {
+			continue
+		}
+		allCoins = allCoins.Add(rg.Coins...)
+	}
+
+	return &types.QueryRewardDenomsResponse{Denoms: allCoins.Denoms()}, nil
+}
+
+// GaugesByType returns the reward gauges of all stakeholders of the given
+// stakeholder type, in ascending order of stakeholder address bytes
+func (k Keeper) GaugesByType(goCtx context.Context, req *types.QueryGaugesByTypeRequest) (*types.QueryGaugesByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sType, err := types.NewStakeHolderTypeFromString(req.StakeholderType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid stakeholder type %q: %v", req.StakeholderType, err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var gauges []*types.StakeholderRewardGaugeResponse
+	pageRes, err := query.Paginate(k.rewardGaugeStore(ctx, sType), req.Pagination, func(key, value []byte) error {
+		var rg types.RewardGauge
+		if err := k.cdc.Unmarshal(value, &rg); err != nil {
+			return err
+		}
+		gauges = append(gauges, &types.StakeholderRewardGaugeResponse{
+			Address:        sdk.AccAddress(key).String(),
+			Coins:          rg.Coins,
+			WithdrawnCoins: rg.WithdrawnCoins,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGaugesByTypeResponse{Gauges: gauges, Pagination: pageRes}, nil
+}
+
+// validateDenomFilter validates the optional denom filter of a gauge query
+func validateDenomFilter(denom string) error {
+	if len(denom) == 0 {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// filterCoinsByDenom returns the coin of the given denom in the given coins,
+// which has zero amount if the coins do not contain the denom. If the denom
+// is empty, it returns the given coins as is
+func filterCoinsByDenom(coins sdk.Coins, denom string) sdk.Coins {
+	if len(denom) == 0 {
+		return coins
+	}
+	return sdk.Coins{sdk.NewCoin(denom, coins.AmountOf(denom))}
+}
+
 func convertGaugeToBTCStakingResponse(gauge types.Gauge) *types.BTCStakingGaugeResponse {
 	return &types.BTCStakingGaugeResponse{
 		Coins: gauge.Coins,
@@ -1,13 +1,20 @@
 package keeper_test
 
 import (
+	"bytes"
+	"math"
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -83,7 +90,26 @@ func FuzzBTCStakingGaugeQuery(f *testing.F) {
 			resp, err := keeper.BTCStakingGauge(ctx, req)
 			require.NoError(t, err)
 			require.True(t, resp.Gauge.Coins.Equal(gaugeList[i].Coins))
+
+			// query with a denom held by the gauge
+			coin := gaugeList[i].Coins[r.Intn(len(gaugeList[i].Coins))]
+			req.Denom = coin.Denom
+			resp, err = keeper.BTCStakingGauge(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, sdk.Coins{coin}, resp.Gauge.Coins)
+
+			// query with a denom not held by the gauge
+			req.Denom = "unknowndenom"
+			resp, err = keeper.BTCStakingGauge(ctx, req)
+			require.NoError(t, err)
+			require.Len(t, resp.Gauge.Coins, 1)
+			require.Equal(t, req.Denom, resp.Gauge.Coins[0].Denom)
+			require.True(t, resp.Gauge.Coins[0].Amount.IsZero())
 		}
+
+		// query with an invalid denom
+		_, err := keeper.BTCStakingGauge(ctx, &types.QueryBTCStakingGaugeRequest{Height: heightList[0], Denom: "!"})
+		require.Error(t, err)
 	})
 }
 
@@ -126,3 +152,194 @@ func FuzzBTCTimestampingGaugeQuery(f *testing.F) {
 		}
 	})
 }
+
+func TestIncentiveModuleAccountingQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coin := func(denom string, amount int64) sdk.Coin {
+		return sdk.NewCoin(denom, sdkmath.NewInt(amount))
+	}
+
+	// the module account holds more ubbn and less uother than owed, and
+	// some uextra that is not owed at all
+	incentiveAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	moduleBalance := sdk.NewCoins(coin("ubbn", 150), coin("uother", 10), coin("uextra", 5))
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), incentiveAcc.GetAddress()).Return(moduleBalance).Times(1)
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(incentiveAcc).Times(1)
+
+	keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, nil)
+
+	// a partially withdrawn reward gauge and a fully withdrawable one
+	rg1 := types.NewRewardGauge(coin("ubbn", 100))
+	rg1.WithdrawnCoins = sdk.NewCoins(coin("ubbn", 40))
+	keeper.SetRewardGauge(ctx, types.SubmitterType, datagen.GenRandomAccount().GetAddress(), rg1)
+	rg2 := types.NewRewardGauge(sdk.NewCoins(coin("ubbn", 50), coin("uother", 30))...)
+	keeper.SetRewardGauge(ctx, types.FinalityProviderType, datagen.GenRandomAccount().GetAddress(), rg2)
+
+	resp, err := keeper.IncentiveModuleAccounting(ctx, &types.QueryIncentiveModuleAccountingRequest{})
+	require.NoError(t, err)
+	require.True(t, moduleBalance.Equal(resp.ModuleBalance))
+	require.True(t, sdk.NewCoins(coin("ubbn", 110), coin("uother", 30)).Equal(resp.GaugeTotal))
+	require.True(t, sdk.NewCoins(coin("ubbn", 40), coin("uextra", 5)).Equal(resp.Surplus))
+	require.True(t, sdk.NewCoins(coin("uother", 20)).Equal(resp.Deficit))
+}
+
+func TestAllRewardGaugesQuery(t *testing.T) {
+	keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+	sAddr := datagen.GenRandomAccount().GetAddress()
+
+	// no reward gauge at all
+	resp, err := keeper.AllRewardGauges(ctx, &types.QueryAllRewardGaugesRequest{Address: sAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, resp.RewardGauges)
+
+	// a partially withdrawn reward gauge as a finality provider
+	fpGauge := types.NewRewardGauge(sdk.NewInt64Coin("ubbn", 100))
+	fpGauge.WithdrawnCoins = sdk.NewCoins(sdk.NewInt64Coin("ubbn", 30))
+	keeper.SetRewardGauge(ctx, types.FinalityProviderType, sAddr, fpGauge)
+	// a fully withdrawn reward gauge as a BTC delegation
+	delGauge := types.NewRewardGauge(sdk.NewInt64Coin("ubbn", 50))
+	delGauge.SetFullyWithdrawn()
+	keeper.SetRewardGauge(ctx, types.BTCDelegationType, sAddr, delGauge)
+
+	// only the reward gauge with withdrawable coins is returned
+	resp, err = keeper.AllRewardGauges(ctx, &types.QueryAllRewardGaugesRequest{Address: sAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.RewardGauges, 1)
+	rg := resp.RewardGauges[types.FinalityProviderType.String()]
+	require.NotNil(t, rg)
+	require.Equal(t, fpGauge.Coins, rg.Coins)
+	require.Equal(t, fpGauge.WithdrawnCoins, rg.WithdrawnCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubbn", 70)), rg.WithdrawableCoins)
+
+	// invalid address
+	_, err = keeper.AllRewardGauges(ctx, &types.QueryAllRewardGaugesRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestRewardsDistributedQuery(t *testing.T) {
+	keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+
+	// no reward is distributed yet
+	resp, err := keeper.RewardsDistributed(ctx, &types.QueryRewardsDistributedRequest{FromEpoch: 0, ToEpoch: math.MaxUint64})
+	require.NoError(t, err)
+	require.True(t, resp.Total.IsZero())
+
+	// rewards distributed in epochs 1 to 5, with an extra denom in epoch 3
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		err := keeper.EpochRewardsDistributed.Set(ctx, collections.Join(epoch, "ubbn"), sdkmath.NewIntFromUint64(10*epoch))
+		require.NoError(t, err)
+	}
+	err = keeper.EpochRewardsDistributed.Set(ctx, collections.Join(uint64(3), "uother"), sdkmath.NewInt(7))
+	require.NoError(t, err)
+
+	// both ends of the range are inclusive
+	resp, err = keeper.RewardsDistributed(ctx, &types.QueryRewardsDistributedRequest{FromEpoch: 2, ToEpoch: 4})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubbn", 90), sdk.NewInt64Coin("uother", 7)), resp.Total)
+
+	resp, err = keeper.RewardsDistributed(ctx, &types.QueryRewardsDistributedRequest{FromEpoch: 5, ToEpoch: 5})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubbn", 50)), resp.Total)
+
+	resp, err = keeper.RewardsDistributed(ctx, &types.QueryRewardsDistributedRequest{FromEpoch: 0, ToEpoch: math.MaxUint64})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubbn", 150), sdk.NewInt64Coin("uother", 7)), resp.Total)
+
+	// no reward is distributed after epoch 5
+	resp, err = keeper.RewardsDistributed(ctx, &types.QueryRewardsDistributedRequest{FromEpoch: 6, ToEpoch: 10})
+	require.NoError(t, err)
+	require.True(t, resp.Total.IsZero())
+
+	// invalid range
+	_, err = keeper.RewardsDistributed(ctx, &types.QueryRewardsDistributedRequest{FromEpoch: 4, ToEpoch: 2})
+	require.Error(t, err)
+}
+
+func TestRewardDenomsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+	sAddr := datagen.GenRandomAccount().GetAddress()
+
+	// no reward gauge at all
+	resp, err := keeper.RewardDenoms(ctx, &types.QueryRewardDenomsRequest{Address: sAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, resp.Denoms)
+
+	// reward gauges with overlapping denoms, including a fully withdrawn one
+	fpGauge := types.NewRewardGauge(sdk.NewInt64Coin("ubbn", 100), sdk.NewInt64Coin("uother", 5))
+	keeper.SetRewardGauge(ctx, types.FinalityProviderType, sAddr, fpGauge)
+	delGauge := types.NewRewardGauge(sdk.NewInt64Coin("ubbn", 50), sdk.NewInt64Coin("uextra", 3))
+	delGauge.SetFullyWithdrawn()
+	keeper.SetRewardGauge(ctx, types.BTCDelegationType, sAddr, delGauge)
+
+	// the distinct denoms are returned in sorted order
+	resp, err = keeper.RewardDenoms(ctx, &types.QueryRewardDenomsRequest{Address: sAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ubbn", "uextra", "uother"}, resp.Denoms)
+
+	// invalid address
+	_, err = keeper.RewardDenoms(ctx, &types.QueryRewardDenomsRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func FuzzGaugesByTypeQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+
+		// insert random reward gauges under the queried stakeholder type, and
+		// one under another type that is not returned
+		sType := datagen.GenRandomStakeholderType(r)
+		otherType := types.StakeholderType((byte(sType) + 1) % byte(len(types.GetAllStakeholderTypes())))
+		keeper.SetRewardGauge(ctx, otherType, datagen.GenRandomAccount().GetAddress(), datagen.GenRandomRewardGauge(r))
+
+		numGauges := int(datagen.RandomInt(r, 20)) + 1
+		expected := map[string]*types.RewardGauge{}
+		for i := 0; i < numGauges; i++ {
+			sAddr := datagen.GenRandomAccount().GetAddress()
+			rg := datagen.GenRandomRewardGauge(r)
+			keeper.SetRewardGauge(ctx, sType, sAddr, rg)
+			expected[sAddr.String()] = rg
+		}
+
+		// page through all reward gauges of the stakeholder type
+		limit := datagen.RandomInt(r, numGauges) + 1
+		pagination := &query.PageRequest{Limit: limit}
+		var gauges []*types.StakeholderRewardGaugeResponse
+		for {
+			resp, err := keeper.GaugesByType(ctx, &types.QueryGaugesByTypeRequest{
+				StakeholderType: sType.String(),
+				Pagination:      pagination,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.Gauges)), limit)
+			gauges = append(gauges, resp.Gauges...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			pagination.Key = resp.Pagination.NextKey
+		}
+		require.Len(t, gauges, numGauges)
+
+		// gauges are ordered by stakeholder address and match the stored ones
+		for i, gauge := range gauges {
+			if i > 0 {
+				prevAddr := sdk.MustAccAddressFromBech32(gauges[i-1].Address)
+				require.Negative(t, bytes.Compare(prevAddr, sdk.MustAccAddressFromBech32(gauge.Address)))
+			}
+			rg, ok := expected[gauge.Address]
+			require.True(t, ok)
+			require.True(t, rg.Coins.Equal(gauge.Coins))
+			require.True(t, rg.WithdrawnCoins.Equal(gauge.WithdrawnCoins))
+		}
+
+		// invalid stakeholder type
+		_, err := keeper.GaugesByType(ctx, &types.QueryGaugesByTypeRequest{StakeholderType: "invalid"})
+		require.Error(t, err)
+	})
+}
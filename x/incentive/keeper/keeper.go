@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +24,17 @@ type (
 		// RefundableMsgKeySet is the set of hashes of messages that can be refunded
 		// Each key is a hash of the message bytes
 		RefundableMsgKeySet collections.KeySet[[]byte]
+		// EpochRewardsDistributed is the total amount of rewards distributed to
+		// reward gauges in each epoch
+		// key: (epoch number, denom)
+		// value: amount distributed in the epoch
+		EpochRewardsDistributed collections.Map[collections.Pair[uint64, string], math.Int]
+		// FpSelfDelRewards is the total amount of rewards credited to the BTC
+		// delegations that each finality provider makes to itself, i.e., whose
+		// staker address is the finality provider's address
+		// key: (finality provider BTC PK, denom)
+		// value: amount credited to the self-delegations
+		FpSelfDelRewards collections.Map[collections.Pair[[]byte, string], math.Int]
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -55,6 +67,20 @@ func NewKeeper(
 			"refundable_msg_key_set",
 			collections.BytesKey,
 		),
+		EpochRewardsDistributed: collections.NewMap(
+			sb,
+			types.RewardsDistributedPrefix,
+			"epoch_rewards_distributed",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			sdk.IntValue,
+		),
+		FpSelfDelRewards: collections.NewMap(
+			sb,
+			types.FpSelfDelRewardsPrefix,
+			"fp_self_del_rewards",
+			collections.PairKeyCodec(collections.BytesKey, collections.StringKey),
+			sdk.IntValue,
+		),
 		authority:        authority,
 		feeCollectorName: feeCollectorName,
 	}
@@ -65,3 +65,39 @@ func (ms msgServer) WithdrawReward(goCtx context.Context, req *types.MsgWithdraw
 		Coins: withdrawnCoins,
 	}, nil
 }
+
+// WithdrawAllReward withdraws the rewards of a given stakeholder in all
+// stakeholder types with withdrawable coins
+func (ms msgServer) WithdrawAllReward(goCtx context.Context, req *types.MsgWithdrawAllReward) (*types.MsgWithdrawAllRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// withdraw reward in each stakeholder type that has withdrawable coins
+	var rewards []*types.WithdrawnReward
+	for _, sType := range types.GetAllStakeholderTypes() {
+		rg := ms.GetRewardGauge(ctx, sType, addr)
+		if rg == nil || !rg.GetWithdrawableCoins().IsAllPositive() {
+			continue
+		}
+		withdrawnCoins, err := ms.withdrawReward(ctx, sType, addr)
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, &types.WithdrawnReward{
+			Type:  sType.String(),
+			Coins: withdrawnCoins,
+		})
+	}
+	if len(rewards) == 0 {
+		return nil, types.ErrNoWithdrawableCoins
+	}
+
+	// all good
+	return &types.MsgWithdrawAllRewardResponse{
+		Rewards: rewards,
+	}, nil
+}
@@ -9,6 +9,9 @@ import (
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
 	"github.com/babylonlabs-io/babylon/x/incentive/keeper"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -57,9 +60,92 @@ func FuzzWithdrawReward(f *testing.F) {
 		require.NoError(t, err)
 		require.Equal(t, withdrawableCoins, resp.Coins)
 
+		// ensure the reward withdrawn event is emitted
+		var withdrawnEvent *types.EventRewardWithdrawn
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&types.EventRewardWithdrawn{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			withdrawnEvent = msg.(*types.EventRewardWithdrawn)
+		}
+		require.NotNil(t, withdrawnEvent)
+		require.Equal(t, sAddr.String(), withdrawnEvent.Address)
+		require.Equal(t, sType.String(), withdrawnEvent.Type)
+		require.Equal(t, withdrawableCoins, withdrawnEvent.Amount)
+
 		// ensure reward gauge is now empty
 		newRg := ik.GetRewardGauge(ctx, sType, sAddr)
 		require.NotNil(t, newRg)
 		require.True(t, newRg.IsFullyWithdrawn())
 	})
 }
+
+func FuzzWithdrawAllReward(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bk := types.NewMockBankKeeper(ctrl)
+
+		ik, ctx := testkeeper.IncentiveKeeper(t, bk, nil, nil)
+		ms := keeper.NewMsgServerImpl(*ik)
+
+		sAddr := datagen.GenRandomAccount().GetAddress()
+
+		// no reward to withdraw
+		_, err := ms.WithdrawAllReward(ctx, &types.MsgWithdrawAllReward{
+			Address: sAddr.String(),
+		})
+		require.ErrorIs(t, err, types.ErrNoWithdrawableCoins)
+
+		// generate and set a random reward gauge with a random set of
+		// withdrawable coins for some stakeholder types, and a fully withdrawn
+		// reward gauge for the others
+		expectedRewards := []*types.WithdrawnReward{}
+		for _, sType := range types.GetAllStakeholderTypes() {
+			rg := datagen.GenRandomRewardGauge(r)
+			if r.Intn(2) == 0 {
+				rg.SetFullyWithdrawn()
+				ik.SetRewardGauge(ctx, sType, sAddr, rg)
+				continue
+			}
+			rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+			ik.SetRewardGauge(ctx, sType, sAddr, rg)
+
+			// mock transfer of withdrawable coins
+			withdrawableCoins := rg.GetWithdrawableCoins()
+			if !withdrawableCoins.IsAllPositive() {
+				continue
+			}
+			bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(sAddr), gomock.Eq(withdrawableCoins)).Times(1)
+			expectedRewards = append(expectedRewards, &types.WithdrawnReward{
+				Type:  sType.String(),
+				Coins: withdrawableCoins,
+			})
+		}
+
+		// invoke withdraw and assert consistency
+		resp, err := ms.WithdrawAllReward(ctx, &types.MsgWithdrawAllReward{
+			Address: sAddr.String(),
+		})
+		if len(expectedRewards) == 0 {
+			require.ErrorIs(t, err, types.ErrNoWithdrawableCoins)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, expectedRewards, resp.Rewards)
+
+		// ensure all reward gauges are now empty
+		for _, sType := range types.GetAllStakeholderTypes() {
+			rg := ik.GetRewardGauge(ctx, sType, sAddr)
+			require.NotNil(t, rg)
+			require.True(t, rg.IsFullyWithdrawn())
+		}
+	})
+}
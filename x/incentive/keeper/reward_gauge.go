@@ -2,6 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	stdmath "math"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -26,15 +31,23 @@ func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType,
 	// empty reward gauge
 	rg.SetFullyWithdrawn()
 	k.SetRewardGauge(ctx, sType, addr, rg)
+	// emit event with the exact coins transferred to the stakeholder
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(
+		types.NewEventRewardWithdrawn(sType, addr, withdrawableCoins),
+	); err != nil {
+		return nil, err
+	}
 	// all good, return
 	return withdrawableCoins, nil
 }
 
-// accumulateRewardGauge accumulates the given reward of of a given stakeholder in a given type
-func (k Keeper) accumulateRewardGauge(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, reward sdk.Coins) {
+// accumulateRewardGauge accumulates the given reward of of a given stakeholder in a given type,
+// and returns the reward that is accumulated. The caller is responsible for
+// recording the accumulated rewards as distributed via recordRewardsDistributed
+func (k Keeper) accumulateRewardGauge(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, reward sdk.Coins) sdk.Coins {
 	// if reward contains nothing, do nothing
 	if !reward.IsAllPositive() {
-		return
+		return nil
 	}
 	// get reward gauge, or create a new one if it does not exist
 	rg := k.GetRewardGauge(ctx, sType, addr)
@@ -45,6 +58,97 @@ func (k Keeper) accumulateRewardGauge(ctx context.Context, sType types.Stakehold
 	rg.Add(reward)
 	// set back
 	k.SetRewardGauge(ctx, sType, addr, rg)
+	return reward
+}
+
+// recordRewardsDistributed adds the given reward to the total rewards
+// distributed to reward gauges in the current epoch. It is meant to be invoked
+// once with the aggregated rewards of a distribution, rather than once per
+// reward gauge
+func (k Keeper) recordRewardsDistributed(ctx context.Context, reward sdk.Coins) {
+	if reward.IsZero() {
+		return
+	}
+	epochNum := k.epochingKeeper.GetEpoch(ctx).EpochNumber
+	for _, coin := range reward {
+		key := collections.Join(epochNum, coin.Denom)
+		amount, err := k.EpochRewardsDistributed.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			amount = math.ZeroInt()
+		} else if err != nil {
+			panic(err) // only possible upon a corrupted store
+		}
+		if err := k.EpochRewardsDistributed.Set(ctx, key, amount.Add(coin.Amount)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetRewardsDistributed returns the total rewards distributed to reward gauges
+// in the epochs within [fromEpoch, toEpoch]
+func (k Keeper) GetRewardsDistributed(ctx context.Context, fromEpoch, toEpoch uint64) (sdk.Coins, error) {
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		StartInclusive(collections.PairPrefix[uint64, string](fromEpoch))
+	if toEpoch < stdmath.MaxUint64 {
+		rng = rng.EndExclusive(collections.PairPrefix[uint64, string](toEpoch + 1))
+	}
+
+	iter, err := k.EpochRewardsDistributed.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	total := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(sdk.NewCoin(kv.Key.K2(), kv.Value))
+	}
+	return total, nil
+}
+
+// recordFpSelfDelRewards adds the given reward to the total rewards credited
+// to the self-delegations of the finality provider with the given BTC PK
+func (k Keeper) recordFpSelfDelRewards(ctx context.Context, fpBTCPK []byte, reward sdk.Coins) {
+	for _, coin := range reward {
+		if !coin.IsPositive() {
+			continue
+		}
+		key := collections.Join(fpBTCPK, coin.Denom)
+		amount, err := k.FpSelfDelRewards.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			amount = math.ZeroInt()
+		} else if err != nil {
+			panic(err) // only possible upon a corrupted store
+		}
+		if err := k.FpSelfDelRewards.Set(ctx, key, amount.Add(coin.Amount)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetFpSelfDelRewards returns the total rewards credited to the
+// self-delegations of the finality provider with the given BTC PK
+func (k Keeper) GetFpSelfDelRewards(ctx context.Context, fpBTCPK []byte) (sdk.Coins, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, string](fpBTCPK)
+	iter, err := k.FpSelfDelRewards.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	total := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(sdk.NewCoin(kv.Key.K2(), kv.Value))
+	}
+	return total, nil
 }
 
 func (k Keeper) SetRewardGauge(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, rg *types.RewardGauge) {
@@ -65,6 +169,24 @@ func (k Keeper) GetRewardGauge(ctx context.Context, sType types.StakeholderType,
 	return &rg
 }
 
+// GetWithdrawableRewardsTotal returns the sum of the withdrawable coins of all
+// reward gauges, i.e., the coins that the incentive module account owes to all
+// stakeholders
+func (k Keeper) GetWithdrawableRewardsTotal(ctx context.Context) sdk.Coins {
+	storeAdaptor := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	rgStore := prefix.NewStore(storeAdaptor, types.RewardGaugeKey)
+	iter := rgStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	total := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		var rg types.RewardGauge
+		k.cdc.MustUnmarshal(iter.Value(), &rg)
+		total = total.Add(rg.GetWithdrawableCoins()...)
+	}
+	return total
+}
+
 // rewardGaugeStore returns the KVStore of the reward gauge of a stakeholder
 // of a given type {submitter, reporter, finality provider, BTC delegation}
 // prefix: RewardGaugeKey
@@ -9,6 +9,7 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawReward{}, "incentive/MsgWithdrawReward", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllReward{}, "incentive/MsgWithdrawAllReward", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "incentive/MsgUpdateParams", nil)
 }
 
@@ -17,6 +18,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgWithdrawReward{},
+		&MsgWithdrawAllReward{},
 		&MsgUpdateParams{},
 	)
 
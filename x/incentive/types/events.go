@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEventRewardWithdrawn returns a new EventRewardWithdrawn for the given
+// stakeholder and the coins transferred to it
+func NewEventRewardWithdrawn(sType StakeholderType, addr sdk.AccAddress, amount sdk.Coins) *EventRewardWithdrawn {
+	return &EventRewardWithdrawn{
+		Address: addr.String(),
+		Type:    sType.String(),
+		Amount:  amount,
+	}
+}
@@ -22,4 +22,6 @@ var (
 	BTCTimestampingGaugeKey   = []byte{0x03}             // key prefix for BTC timestamping gauge at each height
 	RewardGaugeKey            = []byte{0x04}             // key prefix for reward gauge for a given stakeholder in a given type
 	RefundableMsgKeySetPrefix = collections.NewPrefix(5) // key prefix for refundable msg key set
+	RewardsDistributedPrefix  = collections.NewPrefix(6) // key prefix for rewards distributed to reward gauges in each epoch
+	FpSelfDelRewardsPrefix    = collections.NewPrefix(7) // key prefix for rewards credited to the self-delegations of each finality provider
 )
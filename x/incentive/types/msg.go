@@ -7,5 +7,6 @@ import (
 // ensure that these message types implement the sdk.Msg interface
 var (
 	_ sdk.Msg = &MsgWithdrawReward{}
+	_ sdk.Msg = &MsgWithdrawAllReward{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
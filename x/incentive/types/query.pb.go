@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -267,6 +268,10 @@ func (m *QueryRewardGaugesResponse) GetRewardGauges() map[string]*RewardGaugesRe
 type QueryBTCStakingGaugeRequest struct {
 	// height is the queried Babylon height
 	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// denom is the optional denom of the queried coin. If set, the gauge in the
+	// response only contains the coin of this denom, which has zero amount if
+	// the gauge does not hold this denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryBTCStakingGaugeRequest) Reset()         { *m = QueryBTCStakingGaugeRequest{} }
@@ -309,6 +314,13 @@ func (m *QueryBTCStakingGaugeRequest) GetHeight() uint64 {
 	return 0
 }
 
+func (m *QueryBTCStakingGaugeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type BTCStakingGaugeResponse struct {
 	// coins that have been in the gauge
 	// can have multiple coin denoms
@@ -451,6 +463,10 @@ func (m *QueryBTCStakingGaugeResponse) GetGauge() *BTCStakingGaugeResponse {
 type QueryBTCTimestampingGaugeRequest struct {
 	// epoch_num is the queried epoch number
 	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// denom is the optional denom of the queried coin. If set, the gauge in the
+	// response only contains the coin of this denom, which has zero amount if
+	// the gauge does not hold this denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryBTCTimestampingGaugeRequest) Reset()         { *m = QueryBTCTimestampingGaugeRequest{} }
@@ -493,6 +509,13 @@ func (m *QueryBTCTimestampingGaugeRequest) GetEpochNum() uint64 {
 	return 0
 }
 
+func (m *QueryBTCTimestampingGaugeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryBTCTimestampingGaugeResponse is response type for the Query/BTCTimestampingGauge RPC method.
 type QueryBTCTimestampingGaugeResponse struct {
 	// gauge is the BTC timestamping gauge at the queried epoch
@@ -539,830 +562,3557 @@ func (m *QueryBTCTimestampingGaugeResponse) GetGauge() *BTCTimestampingGaugeResp
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
-	proto.RegisterType((*QueryRewardGaugesRequest)(nil), "babylon.incentive.QueryRewardGaugesRequest")
-	proto.RegisterType((*RewardGaugesResponse)(nil), "babylon.incentive.RewardGaugesResponse")
-	proto.RegisterType((*QueryRewardGaugesResponse)(nil), "babylon.incentive.QueryRewardGaugesResponse")
-	proto.RegisterMapType((map[string]*RewardGaugesResponse)(nil), "babylon.incentive.QueryRewardGaugesResponse.RewardGaugesEntry")
-	proto.RegisterType((*QueryBTCStakingGaugeRequest)(nil), "babylon.incentive.QueryBTCStakingGaugeRequest")
-	proto.RegisterType((*BTCStakingGaugeResponse)(nil), "babylon.incentive.BTCStakingGaugeResponse")
-	proto.RegisterType((*BTCTimestampingGaugeResponse)(nil), "babylon.incentive.BTCTimestampingGaugeResponse")
-	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
-	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
-	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+// QueryIncentiveModuleAccountingRequest is request type for the Query/IncentiveModuleAccounting RPC method.
+type QueryIncentiveModuleAccountingRequest struct {
 }
 
-func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
-
-var fileDescriptor_e1a59cc0c7c44135 = []byte{
-	// 748 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x41, 0x4f, 0x53, 0x4b,
-	0x14, 0xc7, 0x7b, 0x0b, 0xed, 0x7b, 0x9c, 0xc7, 0x7b, 0x3c, 0xc6, 0x46, 0x4b, 0xc1, 0x02, 0x37,
-	0x51, 0x89, 0xca, 0x1d, 0xa1, 0x10, 0xd4, 0x04, 0x35, 0x25, 0xc4, 0x95, 0x44, 0xaf, 0xac, 0xdc,
-	0xd4, 0xb9, 0xed, 0xe4, 0xf6, 0x4a, 0x7b, 0xa7, 0xdc, 0x99, 0x82, 0x15, 0x59, 0xe8, 0x27, 0x30,
-	0x71, 0xe5, 0xde, 0x8d, 0x7e, 0x0a, 0x97, 0x2c, 0x49, 0xdc, 0xb8, 0x52, 0x03, 0xae, 0xdc, 0xf8,
-	0x15, 0x4c, 0x67, 0xa6, 0xcd, 0xad, 0xbd, 0x17, 0x21, 0x31, 0xba, 0xea, 0xdc, 0x39, 0x73, 0xce,
-	0xff, 0x77, 0x66, 0xce, 0x39, 0x85, 0xb3, 0x0e, 0x71, 0x5a, 0x35, 0xe6, 0x63, 0xcf, 0x2f, 0x53,
-	0x5f, 0x78, 0x5b, 0x14, 0x6f, 0x36, 0x69, 0xd0, 0xb2, 0x1a, 0x01, 0x13, 0x0c, 0x8d, 0x6a, 0xb3,
-	0xd5, 0x35, 0xe7, 0x32, 0x2e, 0x73, 0x99, 0xb4, 0xe2, 0xf6, 0x4a, 0x1d, 0xcc, 0x4d, 0xb8, 0x8c,
-	0xb9, 0x35, 0x8a, 0x49, 0xc3, 0xc3, 0xc4, 0xf7, 0x99, 0x20, 0xc2, 0x63, 0x3e, 0xd7, 0xd6, 0x7c,
-	0xbf, 0x4a, 0x83, 0x04, 0xa4, 0xde, 0xb1, 0x4f, 0xf7, 0xdb, 0xbb, 0xab, 0x4e, 0x88, 0x32, 0xe3,
-	0x75, 0xc6, 0xb1, 0x43, 0x38, 0xc5, 0x5b, 0x73, 0x0e, 0x15, 0x64, 0x0e, 0x97, 0x99, 0xe7, 0x2b,
-	0xbb, 0x99, 0x01, 0x74, 0xaf, 0x0d, 0x7e, 0x57, 0xc6, 0xb5, 0xe9, 0x66, 0x93, 0x72, 0x61, 0xae,
-	0xc1, 0xa9, 0x9e, 0x5d, 0xde, 0x60, 0x3e, 0xa7, 0x68, 0x09, 0xd2, 0x4a, 0x3f, 0x6b, 0x4c, 0x19,
-	0x33, 0xff, 0xcc, 0x8f, 0x59, 0x7d, 0x79, 0x5a, 0xca, 0xa5, 0x38, 0xb8, 0xf7, 0x71, 0x32, 0x61,
-	0xeb, 0xe3, 0xe6, 0x02, 0x64, 0x65, 0x3c, 0x9b, 0x6e, 0x93, 0xa0, 0x72, 0x9b, 0x34, 0x5d, 0xda,
-	0xd1, 0x42, 0x59, 0xf8, 0x8b, 0x54, 0x2a, 0x01, 0xe5, 0x2a, 0xea, 0x90, 0xdd, 0xf9, 0x34, 0xbf,
-	0x19, 0x90, 0xe9, 0xf5, 0xd0, 0x1c, 0x04, 0x52, 0xed, 0x14, 0xda, 0x0e, 0x03, 0x12, 0x43, 0x25,
-	0x69, 0xb5, 0x93, 0xb4, 0x74, 0x92, 0xd6, 0x0a, 0xf3, 0xfc, 0xe2, 0x95, 0x36, 0xc6, 0xdb, 0x4f,
-	0x93, 0x33, 0xae, 0x27, 0xaa, 0x4d, 0xc7, 0x2a, 0xb3, 0x3a, 0xd6, 0x37, 0xa2, 0x7e, 0x66, 0x79,
-	0x65, 0x03, 0x8b, 0x56, 0x83, 0x72, 0xe9, 0xc0, 0x6d, 0x15, 0x19, 0x09, 0x18, 0xd9, 0xf6, 0x44,
-	0xb5, 0x12, 0x90, 0x6d, 0xbf, 0xa4, 0xc4, 0x92, 0xbf, 0x5e, 0xec, 0xbf, 0xae, 0x86, 0xfc, 0x36,
-	0xbf, 0x1a, 0x30, 0x16, 0x71, 0x51, 0x3a, 0xed, 0x32, 0xfc, 0x1b, 0xc8, 0xfd, 0x92, 0x2b, 0x0d,
-	0x3a, 0xfd, 0x1b, 0x11, 0xaf, 0x10, 0x1b, 0xc4, 0x0a, 0x6f, 0xae, 0xfa, 0x22, 0x68, 0xd9, 0xc3,
-	0x41, 0x68, 0x2b, 0x57, 0x85, 0xd1, 0xbe, 0x23, 0xe8, 0x7f, 0x18, 0xd8, 0xa0, 0x2d, 0xfd, 0x3e,
-	0xed, 0x25, 0x5a, 0x86, 0xd4, 0x16, 0xa9, 0x35, 0x69, 0x36, 0x29, 0x2b, 0xe1, 0x42, 0x04, 0x43,
-	0x94, 0xbc, 0xad, 0xbc, 0xae, 0x27, 0xaf, 0x1a, 0xe6, 0x22, 0x8c, 0x4b, 0xcc, 0xe2, 0xfa, 0xca,
-	0x7d, 0x41, 0x36, 0x3c, 0xdf, 0x95, 0x67, 0x3b, 0x75, 0x71, 0x1a, 0xd2, 0x55, 0xea, 0xb9, 0x55,
-	0x21, 0x65, 0x07, 0x6d, 0xfd, 0x65, 0x3e, 0x85, 0x33, 0x7d, 0x1e, 0xbf, 0xad, 0x2e, 0xcc, 0x67,
-	0x06, 0x4c, 0x14, 0xd7, 0x57, 0xd6, 0xbd, 0x3a, 0xe5, 0x82, 0xd4, 0x1b, 0x7f, 0x82, 0xe1, 0x21,
-	0x4c, 0x44, 0x5f, 0x9c, 0x46, 0xb8, 0x05, 0x29, 0x59, 0x20, 0xba, 0x4b, 0x2f, 0x46, 0xbc, 0x4d,
-	0x8c, 0xab, 0xad, 0x1c, 0xcd, 0x9b, 0x30, 0xd5, 0x51, 0x88, 0xc8, 0x54, 0xbd, 0xcf, 0x38, 0x0c,
-	0xd1, 0x06, 0x2b, 0x57, 0x4b, 0x7e, 0xb3, 0xae, 0x9f, 0xe8, 0x6f, 0xb9, 0xb1, 0xd6, 0xac, 0x9b,
-	0x8f, 0x60, 0xfa, 0x88, 0x00, 0x9a, 0x73, 0xb5, 0x97, 0x13, 0x47, 0x73, 0xc6, 0xfa, 0x6b, 0xd8,
-	0xf9, 0x57, 0x29, 0x48, 0x49, 0x31, 0xf4, 0x04, 0xd2, 0x6a, 0xfc, 0xa0, 0x73, 0x71, 0x3d, 0xd1,
-	0x33, 0xe7, 0x72, 0xe7, 0x7f, 0x76, 0x4c, 0x29, 0x99, 0xd3, 0xcf, 0xdf, 0x7f, 0x79, 0x99, 0x1c,
-	0x47, 0x63, 0x38, 0x6e, 0x22, 0xa3, 0xd7, 0x06, 0x0c, 0x87, 0x2b, 0x1e, 0x5d, 0x3a, 0x5e, 0x5b,
-	0x2a, 0x90, 0xcb, 0x27, 0xe9, 0x61, 0xf3, 0x9a, 0xc4, 0x29, 0xa0, 0xb9, 0x08, 0x1c, 0x3d, 0x3c,
-	0xf1, 0x8e, 0x5e, 0xec, 0xe2, 0xf0, 0xcc, 0x40, 0x6f, 0x0c, 0x18, 0xf9, 0xe1, 0xf1, 0x91, 0x15,
-	0x27, 0x1e, 0xdd, 0x99, 0x39, 0x7c, 0xec, 0xf3, 0x9a, 0x77, 0x51, 0xf2, 0x62, 0x34, 0x1b, 0xc1,
-	0xeb, 0x88, 0x72, 0x89, 0x2b, 0x27, 0x85, 0x88, 0x77, 0x54, 0xa3, 0xef, 0xa2, 0x77, 0x06, 0x64,
-	0xa2, 0x0a, 0x00, 0x15, 0x8e, 0x00, 0x88, 0xab, 0xd7, 0xdc, 0xc2, 0xc9, 0x9c, 0x34, 0xfa, 0xb2,
-	0x44, 0x5f, 0x42, 0x8b, 0x31, 0xe8, 0x22, 0xe4, 0xd9, 0xe1, 0xef, 0xb6, 0xc5, 0x6e, 0xf1, 0xce,
-	0xde, 0x41, 0xde, 0xd8, 0x3f, 0xc8, 0x1b, 0x9f, 0x0f, 0xf2, 0xc6, 0x8b, 0xc3, 0x7c, 0x62, 0xff,
-	0x30, 0x9f, 0xf8, 0x70, 0x98, 0x4f, 0x3c, 0x28, 0x84, 0xba, 0x5e, 0x87, 0xae, 0x11, 0x87, 0xcf,
-	0x7a, 0xac, 0xab, 0xf4, 0x38, 0xa4, 0x25, 0xc7, 0x80, 0x93, 0x96, 0x7f, 0xda, 0x85, 0xef, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0x08, 0x27, 0xd2, 0x7f, 0x08, 0x00, 0x00,
+func (m *QueryIncentiveModuleAccountingRequest) Reset()         { *m = QueryIncentiveModuleAccountingRequest{} }
+func (m *QueryIncentiveModuleAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveModuleAccountingRequest) ProtoMessage()    {}
+func (*QueryIncentiveModuleAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{11}
+}
+func (m *QueryIncentiveModuleAccountingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveModuleAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveModuleAccountingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveModuleAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveModuleAccountingRequest.Merge(m, src)
+}
+func (m *QueryIncentiveModuleAccountingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveModuleAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveModuleAccountingRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIncentiveModuleAccountingRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// RewardGauge queries the reward gauge of a given stakeholder address
-	RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error)
-	// BTCStakingGauge queries the BTC staking gauge of a given height
-	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
-	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
-	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+// QueryIncentiveModuleAccountingResponse is response type for the Query/IncentiveModuleAccounting RPC method.
+type QueryIncentiveModuleAccountingResponse struct {
+	// module_balance is the balance of the incentive module account
+	ModuleBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=module_balance,json=moduleBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"module_balance"`
+	// gauge_total is the sum of the withdrawable coins of all reward gauges,
+	// i.e., the coins that the incentive module account owes to stakeholders
+	GaugeTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=gauge_total,json=gaugeTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_total"`
+	// surplus is, for each denom, the amount by which module_balance exceeds
+	// gauge_total. A surplus comes from rewards that are not distributed to
+	// reward gauges yet and from the remainders of truncated reward portions
+	Surplus github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=surplus,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"surplus"`
+	// deficit is, for each denom, the amount by which gauge_total exceeds
+	// module_balance. A nonzero deficit indicates an accounting bug, as the
+	// stakeholders cannot withdraw all of their rewards
+	Deficit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deficit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deficit"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryIncentiveModuleAccountingResponse) Reset() {
+	*m = QueryIncentiveModuleAccountingResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryIncentiveModuleAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveModuleAccountingResponse) ProtoMessage()    {}
+func (*QueryIncentiveModuleAccountingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{12}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIncentiveModuleAccountingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveModuleAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveModuleAccountingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIncentiveModuleAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveModuleAccountingResponse.Merge(m, src)
+}
+func (m *QueryIncentiveModuleAccountingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveModuleAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveModuleAccountingResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error) {
-	out := new(QueryRewardGaugesResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIncentiveModuleAccountingResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveModuleAccountingResponse) GetModuleBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ModuleBalance
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error) {
-	out := new(QueryBTCStakingGaugeResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCStakingGauge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIncentiveModuleAccountingResponse) GetGaugeTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeTotal
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error) {
-	out := new(QueryBTCTimestampingGaugeResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCTimestampingGauge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIncentiveModuleAccountingResponse) GetSurplus() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Surplus
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// RewardGauge queries the reward gauge of a given stakeholder address
-	RewardGauges(context.Context, *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error)
-	// BTCStakingGauge queries the BTC staking gauge of a given height
-	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
-	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
-	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+func (m *QueryIncentiveModuleAccountingResponse) GetDeficit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deficit
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryAllRewardGaugesRequest is request type for the Query/AllRewardGauges RPC method.
+type QueryAllRewardGaugesRequest struct {
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllRewardGaugesRequest) Reset()         { *m = QueryAllRewardGaugesRequest{} }
+func (m *QueryAllRewardGaugesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRewardGaugesRequest) ProtoMessage()    {}
+func (*QueryAllRewardGaugesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{13}
 }
-func (*UnimplementedQueryServer) RewardGauges(ctx context.Context, req *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardGauges not implemented")
+func (m *QueryAllRewardGaugesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCStakingGauge not implemented")
+func (m *QueryAllRewardGaugesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardGaugesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
+func (m *QueryAllRewardGaugesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardGaugesRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllRewardGaugesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardGaugesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardGaugesRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryAllRewardGaugesRequest proto.InternalMessageInfo
+
+func (m *QueryAllRewardGaugesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RewardGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardGaugesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/RewardGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardGauges(ctx, req.(*QueryRewardGaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RewardGaugeWithWithdrawableResponse is a reward gauge of a stakeholder
+// together with its withdrawable coins
+type RewardGaugeWithWithdrawableResponse struct {
+	// coins are coins that have been in the gauge
+	// Can have multiple coin denoms
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// withdrawn_coins are coins that have been withdrawn by the stakeholder already
+	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins"`
+	// withdrawable_coins are coins that can be withdrawn by the stakeholder,
+	// i.e., coins minus withdrawn_coins
+	WithdrawableCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawable_coins,json=withdrawableCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawable_coins"`
 }
 
-func _Query_BTCStakingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCStakingGaugeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCStakingGauge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/BTCStakingGauge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCStakingGauge(ctx, req.(*QueryBTCStakingGaugeRequest))
+func (m *RewardGaugeWithWithdrawableResponse) Reset()         { *m = RewardGaugeWithWithdrawableResponse{} }
+func (m *RewardGaugeWithWithdrawableResponse) String() string { return proto.CompactTextString(m) }
+func (*RewardGaugeWithWithdrawableResponse) ProtoMessage()    {}
+func (*RewardGaugeWithWithdrawableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{14}
+}
+func (m *RewardGaugeWithWithdrawableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardGaugeWithWithdrawableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardGaugeWithWithdrawableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RewardGaugeWithWithdrawableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardGaugeWithWithdrawableResponse.Merge(m, src)
+}
+func (m *RewardGaugeWithWithdrawableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardGaugeWithWithdrawableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardGaugeWithWithdrawableResponse.DiscardUnknown(m)
 }
 
-func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCTimestampingGaugeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCTimestampingGauge(ctx, in)
+var xxx_messageInfo_RewardGaugeWithWithdrawableResponse proto.InternalMessageInfo
+
+func (m *RewardGaugeWithWithdrawableResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/BTCTimestampingGauge",
+	return nil
+}
+
+func (m *RewardGaugeWithWithdrawableResponse) GetWithdrawnCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnCoins
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCTimestampingGauge(ctx, req.(*QueryBTCTimestampingGaugeRequest))
+	return nil
+}
+
+func (m *RewardGaugeWithWithdrawableResponse) GetWithdrawableCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawableCoins
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.incentive.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RewardGauges",
-			Handler:    _Query_RewardGauges_Handler,
-		},
-		{
-			MethodName: "BTCStakingGauge",
-			Handler:    _Query_BTCStakingGauge_Handler,
-		},
-		{
-			MethodName: "BTCTimestampingGauge",
-			Handler:    _Query_BTCTimestampingGauge_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/incentive/query.proto",
+// QueryAllRewardGaugesResponse is response type for the Query/AllRewardGauges RPC method.
+type QueryAllRewardGaugesResponse struct {
+	// reward_gauges is the map of reward gauges, where key is the stakeholder
+	// type and value is the reward gauge of the stakeholder in that type.
+	// Stakeholder types without withdrawable coins are omitted
+	RewardGauges map[string]*RewardGaugeWithWithdrawableResponse `protobuf:"bytes,1,rep,name=reward_gauges,json=rewardGauges,proto3" json:"reward_gauges,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllRewardGaugesResponse) Reset()         { *m = QueryAllRewardGaugesResponse{} }
+func (m *QueryAllRewardGaugesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRewardGaugesResponse) ProtoMessage()    {}
+func (*QueryAllRewardGaugesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{15}
+}
+func (m *QueryAllRewardGaugesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRewardGaugesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardGaugesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllRewardGaugesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardGaugesResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllRewardGaugesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardGaugesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardGaugesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRewardGaugesResponse proto.InternalMessageInfo
+
+func (m *QueryAllRewardGaugesResponse) GetRewardGauges() map[string]*RewardGaugeWithWithdrawableResponse {
+	if m != nil {
+		return m.RewardGauges
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsDistributedRequest is request type for the Query/RewardsDistributed RPC method.
+type QueryRewardsDistributedRequest struct {
+	// from_epoch is the first epoch of the range, inclusive
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// to_epoch is the last epoch of the range, inclusive
+	ToEpoch uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardsDistributedRequest) Reset()         { *m = QueryRewardsDistributedRequest{} }
+func (m *QueryRewardsDistributedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsDistributedRequest) ProtoMessage()    {}
+func (*QueryRewardsDistributedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{16}
+}
+func (m *QueryRewardsDistributedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsDistributedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsDistributedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRewardGaugesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardsDistributedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsDistributedRequest.Merge(m, src)
 }
-
-func (m *QueryRewardGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardsDistributedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsDistributedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsDistributedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRewardsDistributedRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsDistributedRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RewardGaugesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsDistributedRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsDistributedResponse is response type for the Query/RewardsDistributed RPC method.
+type QueryRewardsDistributedResponse struct {
+	// total is the total of coins distributed to all reward gauges in the
+	// epochs within the range, by denom
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (m *RewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawnCoins) > 0 {
-		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRewardsDistributedResponse) Reset()         { *m = QueryRewardsDistributedResponse{} }
+func (m *QueryRewardsDistributedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsDistributedResponse) ProtoMessage()    {}
+func (*QueryRewardsDistributedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{17}
+}
+func (m *QueryRewardsDistributedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsDistributedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsDistributedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsDistributedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsDistributedResponse.Merge(m, src)
+}
+func (m *QueryRewardsDistributedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsDistributedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsDistributedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardGaugesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsDistributedResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsDistributedResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardDenomsRequest is request type for the Query/RewardDenoms RPC method.
+type QueryRewardDenomsRequest struct {
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryRewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardGauges) > 0 {
-		for k := range m.RewardGauges {
-			v := m.RewardGauges[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRewardDenomsRequest) Reset()         { *m = QueryRewardDenomsRequest{} }
+func (m *QueryRewardDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardDenomsRequest) ProtoMessage()    {}
+func (*QueryRewardDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{18}
+}
+func (m *QueryRewardDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardDenomsRequest.Merge(m, src)
+}
+func (m *QueryRewardDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardDenomsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCStakingGaugeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardDenomsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCStakingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardDenomsResponse is response type for the Query/RewardDenoms RPC method.
+type QueryRewardDenomsResponse struct {
+	// denoms is the sorted list of distinct denoms present across the reward
+	// gauges of the address under all stakeholder types
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func (m *QueryBTCStakingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRewardDenomsResponse) Reset()         { *m = QueryRewardDenomsResponse{} }
+func (m *QueryRewardDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardDenomsResponse) ProtoMessage()    {}
+func (*QueryRewardDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{19}
 }
-
-func (m *BTCStakingGaugeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BTCStakingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BTCStakingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRewardDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardDenomsResponse.Merge(m, src)
+}
+func (m *QueryRewardDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardDenomsResponse.DiscardUnknown(m)
 }
 
-func (m *BTCTimestampingGaugeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BTCTimestampingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGaugesByTypeRequest is request type for the Query/GaugesByType RPC method.
+type QueryGaugesByTypeRequest struct {
+	// stakeholder_type is the stakeholder type of the queried reward gauges,
+	// i.e., one of submitter, reporter, finality_provider, and btc_delegation
+	StakeholderType string `protobuf:"bytes,1,opt,name=stakeholder_type,json=stakeholderType,proto3" json:"stakeholder_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGaugesByTypeRequest) Reset()         { *m = QueryGaugesByTypeRequest{} }
+func (m *QueryGaugesByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesByTypeRequest) ProtoMessage()    {}
+func (*QueryGaugesByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{20}
+}
+func (m *QueryGaugesByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGaugesByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesByTypeRequest.Merge(m, src)
+}
+func (m *QueryGaugesByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesByTypeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCStakingGaugeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugesByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryGaugesByTypeRequest) GetStakeholderType() string {
+	if m != nil {
+		return m.StakeholderType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCStakingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGaugesByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBTCStakingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		{
-			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// StakeholderRewardGaugeResponse is the reward gauge of a stakeholder
+type StakeholderRewardGaugeResponse struct {
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// coins are coins that have been in the gauge
+	// Can have multiple coin denoms
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// withdrawn_coins are coins that have been withdrawn by the stakeholder already
+	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins"`
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StakeholderRewardGaugeResponse) Reset()         { *m = StakeholderRewardGaugeResponse{} }
+func (m *StakeholderRewardGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*StakeholderRewardGaugeResponse) ProtoMessage()    {}
+func (*StakeholderRewardGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{21}
+}
+func (m *StakeholderRewardGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeholderRewardGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeholderRewardGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StakeholderRewardGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeholderRewardGaugeResponse.Merge(m, src)
+}
+func (m *StakeholderRewardGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeholderRewardGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeholderRewardGaugeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StakeholderRewardGaugeResponse proto.InternalMessageInfo
+
+func (m *StakeholderRewardGaugeResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+func (m *StakeholderRewardGaugeResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBTCTimestampingGaugeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StakeholderRewardGaugeResponse) GetWithdrawnCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnCoins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCTimestampingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGaugesByTypeResponse is response type for the Query/GaugesByType RPC method.
+type QueryGaugesByTypeResponse struct {
+	// gauges are the reward gauges of the stakeholders of the queried type, in
+	// ascending order of stakeholder address bytes
+	Gauges []*StakeholderRewardGaugeResponse `protobuf:"bytes,1,rep,name=gauges,proto3" json:"gauges,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		{
-			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGaugesByTypeResponse) Reset()         { *m = QueryGaugesByTypeResponse{} }
+func (m *QueryGaugesByTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesByTypeResponse) ProtoMessage()    {}
+func (*QueryGaugesByTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{22}
+}
+func (m *QueryGaugesByTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesByTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesByTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryGaugesByTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesByTypeResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGaugesByTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesByTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesByTypeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGaugesByTypeResponse proto.InternalMessageInfo
+
+func (m *QueryGaugesByTypeResponse) GetGauges() []*StakeholderRewardGaugeResponse {
+	if m != nil {
+		return m.Gauges
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryRewardGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGaugesByTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *RewardGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardGaugesRequest)(nil), "babylon.incentive.QueryRewardGaugesRequest")
+	proto.RegisterType((*RewardGaugesResponse)(nil), "babylon.incentive.RewardGaugesResponse")
+	proto.RegisterType((*QueryRewardGaugesResponse)(nil), "babylon.incentive.QueryRewardGaugesResponse")
+	proto.RegisterMapType((map[string]*RewardGaugesResponse)(nil), "babylon.incentive.QueryRewardGaugesResponse.RewardGaugesEntry")
+	proto.RegisterType((*QueryBTCStakingGaugeRequest)(nil), "babylon.incentive.QueryBTCStakingGaugeRequest")
+	proto.RegisterType((*BTCStakingGaugeResponse)(nil), "babylon.incentive.BTCStakingGaugeResponse")
+	proto.RegisterType((*BTCTimestampingGaugeResponse)(nil), "babylon.incentive.BTCTimestampingGaugeResponse")
+	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
+	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
+	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+	proto.RegisterType((*QueryIncentiveModuleAccountingRequest)(nil), "babylon.incentive.QueryIncentiveModuleAccountingRequest")
+	proto.RegisterType((*QueryIncentiveModuleAccountingResponse)(nil), "babylon.incentive.QueryIncentiveModuleAccountingResponse")
+	proto.RegisterType((*QueryAllRewardGaugesRequest)(nil), "babylon.incentive.QueryAllRewardGaugesRequest")
+	proto.RegisterType((*RewardGaugeWithWithdrawableResponse)(nil), "babylon.incentive.RewardGaugeWithWithdrawableResponse")
+	proto.RegisterType((*QueryAllRewardGaugesResponse)(nil), "babylon.incentive.QueryAllRewardGaugesResponse")
+	proto.RegisterMapType((map[string]*RewardGaugeWithWithdrawableResponse)(nil), "babylon.incentive.QueryAllRewardGaugesResponse.RewardGaugesEntry")
+	proto.RegisterType((*QueryRewardsDistributedRequest)(nil), "babylon.incentive.QueryRewardsDistributedRequest")
+	proto.RegisterType((*QueryRewardsDistributedResponse)(nil), "babylon.incentive.QueryRewardsDistributedResponse")
+	proto.RegisterType((*QueryRewardDenomsRequest)(nil), "babylon.incentive.QueryRewardDenomsRequest")
+	proto.RegisterType((*QueryRewardDenomsResponse)(nil), "babylon.incentive.QueryRewardDenomsResponse")
+	proto.RegisterType((*QueryGaugesByTypeRequest)(nil), "babylon.incentive.QueryGaugesByTypeRequest")
+	proto.RegisterType((*StakeholderRewardGaugeResponse)(nil), "babylon.incentive.StakeholderRewardGaugeResponse")
+	proto.RegisterType((*QueryGaugesByTypeResponse)(nil), "babylon.incentive.QueryGaugesByTypeResponse")
+}
+
+func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
+
+var fileDescriptor_e1a59cc0c7c44135 = []byte{
+	// 1351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x8d, 0xdb, 0xbc, 0xa6, 0x4d, 0x3b, 0x44, 0xc5, 0x76, 0x52, 0xa7, 0x59, 0x68,
+	0x52, 0x4a, 0xe2, 0x25, 0x7f, 0xda, 0xa4, 0x95, 0x1a, 0x35, 0x4e, 0xd3, 0xaa, 0x82, 0x56, 0x65,
+	0x1b, 0x54, 0xa9, 0x17, 0x33, 0xb6, 0x27, 0xf6, 0x92, 0xf5, 0x8e, 0xbb, 0x3b, 0x9b, 0xe0, 0x06,
+	0x1f, 0x40, 0x5c, 0x91, 0x90, 0xfa, 0x01, 0x7a, 0x81, 0x03, 0x5c, 0x90, 0xf8, 0x00, 0x08, 0x89,
+	0x4b, 0x8f, 0x95, 0xb8, 0xf4, 0x04, 0x28, 0xe1, 0xc4, 0x85, 0x3b, 0x17, 0xd0, 0xce, 0xcc, 0xda,
+	0xeb, 0x78, 0x37, 0x76, 0x50, 0x5d, 0x24, 0x4e, 0xde, 0x9d, 0x79, 0x7f, 0x7e, 0x6f, 0xde, 0xdb,
+	0xf7, 0x7b, 0x63, 0x38, 0x9b, 0xc7, 0xf9, 0x9a, 0x49, 0x2d, 0xcd, 0xb0, 0x0a, 0xc4, 0x62, 0xc6,
+	0x16, 0xd1, 0x1e, 0xb9, 0xc4, 0xae, 0x65, 0xaa, 0x36, 0x65, 0x14, 0x9d, 0x96, 0xdb, 0x99, 0xc6,
+	0x76, 0x6a, 0xa4, 0x44, 0x4b, 0x94, 0xef, 0x6a, 0xde, 0x93, 0x10, 0x4c, 0x8d, 0x95, 0x28, 0x2d,
+	0x99, 0x44, 0xc3, 0x55, 0x43, 0xc3, 0x96, 0x45, 0x19, 0x66, 0x06, 0xb5, 0x1c, 0xb9, 0x9b, 0x6e,
+	0xf7, 0x52, 0xc5, 0x36, 0xae, 0xf8, 0xfb, 0x13, 0xed, 0xfb, 0x8d, 0x27, 0xdf, 0x44, 0x81, 0x3a,
+	0x15, 0xea, 0x68, 0x79, 0xec, 0x10, 0x6d, 0x6b, 0x36, 0x4f, 0x18, 0x9e, 0xd5, 0x0a, 0xd4, 0xb0,
+	0xe4, 0xfe, 0xc5, 0xe0, 0x3e, 0x0f, 0xa1, 0x21, 0x55, 0xc5, 0x25, 0xc3, 0xe2, 0x78, 0x84, 0xac,
+	0x3a, 0x02, 0xe8, 0x7d, 0x4f, 0xe2, 0x1e, 0xc7, 0xa0, 0x93, 0x47, 0x2e, 0x71, 0x98, 0x7a, 0x17,
+	0x5e, 0x6b, 0x59, 0x75, 0xaa, 0xd4, 0x72, 0x08, 0x5a, 0x84, 0xb8, 0xc0, 0x9a, 0x50, 0xce, 0x29,
+	0x17, 0x8e, 0xcf, 0x25, 0x33, 0x6d, 0x67, 0x92, 0x11, 0x2a, 0xd9, 0x23, 0xcf, 0x7e, 0x19, 0xef,
+	0xd3, 0xa5, 0xb8, 0xba, 0x00, 0x09, 0x6e, 0x4f, 0x27, 0xdb, 0xd8, 0x2e, 0xde, 0xc2, 0x6e, 0x89,
+	0xf8, 0xbe, 0x50, 0x02, 0x8e, 0xe2, 0x62, 0xd1, 0x26, 0x8e, 0xb0, 0x3a, 0xa8, 0xfb, 0xaf, 0xea,
+	0x9f, 0x0a, 0x8c, 0xb4, 0x6a, 0x48, 0x1c, 0x18, 0x06, 0xbc, 0x70, 0x3d, 0x85, 0x7e, 0x0e, 0x43,
+	0x04, 0x9c, 0xf1, 0x02, 0xce, 0xc8, 0x50, 0x33, 0xab, 0xd4, 0xb0, 0xb2, 0xef, 0x78, 0x30, 0xbe,
+	0xfd, 0x75, 0xfc, 0x42, 0xc9, 0x60, 0x65, 0x37, 0x9f, 0x29, 0xd0, 0x8a, 0x26, 0x4f, 0x47, 0xfc,
+	0xcc, 0x38, 0xc5, 0x4d, 0x8d, 0xd5, 0xaa, 0xc4, 0xe1, 0x0a, 0x8e, 0x2e, 0x2c, 0x23, 0x06, 0xc3,
+	0xdb, 0x06, 0x2b, 0x17, 0x6d, 0xbc, 0x6d, 0xe5, 0x84, 0xb3, 0xd8, 0xcb, 0x77, 0x76, 0xb2, 0xe1,
+	0x83, 0xbf, 0xab, 0x7f, 0x28, 0x90, 0x0c, 0x39, 0x28, 0x19, 0x76, 0x01, 0x4e, 0xd8, 0x7c, 0x3d,
+	0x57, 0xe2, 0x1b, 0x32, 0xfc, 0xe5, 0x90, 0x2c, 0x44, 0x1a, 0xc9, 0x04, 0x17, 0xd7, 0x2c, 0x66,
+	0xd7, 0xf4, 0x21, 0x3b, 0xb0, 0x94, 0x2a, 0xc3, 0xe9, 0x36, 0x11, 0x74, 0x0a, 0xfa, 0x37, 0x49,
+	0x4d, 0xe6, 0xc7, 0x7b, 0x44, 0xd7, 0x60, 0x60, 0x0b, 0x9b, 0x2e, 0x49, 0xc4, 0x78, 0x25, 0x4c,
+	0x85, 0x60, 0x08, 0x73, 0xaf, 0x0b, 0xad, 0xab, 0xb1, 0x25, 0x45, 0x7d, 0x17, 0x46, 0x39, 0xcc,
+	0xec, 0xfa, 0xea, 0x7d, 0x86, 0x37, 0x0d, 0xab, 0xc4, 0x65, 0xfd, 0xba, 0x38, 0x03, 0xf1, 0x32,
+	0x31, 0x4a, 0x65, 0xc6, 0xdd, 0x1e, 0xd1, 0xe5, 0x1b, 0x1a, 0x81, 0x81, 0x22, 0xb1, 0x68, 0x85,
+	0x7b, 0x1e, 0xd4, 0xc5, 0x8b, 0xfa, 0x09, 0xbc, 0xde, 0x66, 0xe7, 0x95, 0x55, 0x8b, 0xfa, 0xa9,
+	0x02, 0x63, 0xd9, 0xf5, 0xd5, 0x75, 0xa3, 0x42, 0x1c, 0x86, 0x2b, 0xd5, 0xff, 0x02, 0xc3, 0x87,
+	0x30, 0x16, 0x7e, 0x9c, 0x12, 0xc2, 0x75, 0x18, 0xe0, 0x65, 0x23, 0xbf, 0xdd, 0x8b, 0x21, 0x19,
+	0x8b, 0x50, 0xd5, 0x85, 0xa2, 0xfa, 0x01, 0x9c, 0xf3, 0x3d, 0x84, 0x44, 0x2a, 0xb2, 0x36, 0x0a,
+	0x83, 0xa4, 0x4a, 0x0b, 0xe5, 0x9c, 0xe5, 0x56, 0x64, 0xe2, 0x8e, 0xf1, 0x85, 0xbb, 0x6e, 0x25,
+	0x22, 0x75, 0x1f, 0xc1, 0xc4, 0x01, 0x66, 0x25, 0xfa, 0xb5, 0x56, 0xf4, 0x5a, 0x38, 0xfa, 0x48,
+	0x7d, 0x3f, 0x84, 0x29, 0x38, 0xcf, 0x7d, 0xdd, 0xf6, 0xb5, 0xee, 0xd0, 0xa2, 0x6b, 0x92, 0x95,
+	0x42, 0x81, 0xba, 0x16, 0x33, 0xac, 0x92, 0xdf, 0x01, 0x5f, 0xf4, 0xc3, 0x64, 0x27, 0x49, 0x09,
+	0xcd, 0x86, 0x93, 0x15, 0xbe, 0x97, 0xcb, 0x63, 0x13, 0x5b, 0x05, 0xd2, 0x8b, 0x24, 0x9f, 0x10,
+	0x2e, 0xb2, 0xc2, 0x03, 0x32, 0xe1, 0x38, 0x0f, 0x28, 0xc7, 0x28, 0xc3, 0x66, 0x2f, 0x5a, 0x13,
+	0x70, 0xfb, 0xeb, 0x9e, 0x79, 0x44, 0xe0, 0xa8, 0xe3, 0xda, 0x55, 0xd3, 0x75, 0x12, 0xfd, 0x2f,
+	0xdf, 0x93, 0x6f, 0xdb, 0x73, 0x53, 0x24, 0x1b, 0x46, 0xc1, 0x60, 0x89, 0x23, 0x3d, 0x70, 0x23,
+	0x6d, 0xab, 0x8b, 0xb2, 0xef, 0xac, 0x98, 0xe6, 0xe1, 0xf8, 0xe8, 0xaf, 0x18, 0xbc, 0x11, 0xd0,
+	0x78, 0x60, 0xb0, 0xf2, 0x03, 0xd9, 0xbf, 0x71, 0xde, 0x24, 0xff, 0x7b, 0x7a, 0x42, 0x8f, 0x01,
+	0x6d, 0x07, 0x02, 0x96, 0x8e, 0x7b, 0x50, 0x12, 0xa7, 0x83, 0x6e, 0x04, 0x35, 0xfe, 0xad, 0xc0,
+	0x58, 0x78, 0xda, 0xe4, 0xa9, 0x6f, 0x84, 0xb3, 0xe3, 0x4a, 0x14, 0x3b, 0x46, 0xd8, 0xe9, 0x48,
+	0x90, 0xdb, 0xdd, 0x11, 0xe4, 0x7b, 0xad, 0x04, 0x79, 0xf9, 0x60, 0x82, 0x8c, 0xaa, 0xa5, 0x20,
+	0x5f, 0x3e, 0x84, 0x74, 0x80, 0xd6, 0x9d, 0x1b, 0x86, 0xc3, 0x6c, 0x23, 0xef, 0x32, 0x52, 0xf4,
+	0x4b, 0xf7, 0x2c, 0xc0, 0x86, 0x4d, 0x2b, 0x39, 0xde, 0x70, 0x65, 0xf7, 0x1d, 0xf4, 0x56, 0xd6,
+	0xbc, 0x05, 0x94, 0x84, 0x63, 0x8c, 0xca, 0xcd, 0x18, 0xdf, 0x3c, 0xca, 0x28, 0xdf, 0x52, 0x3f,
+	0x57, 0x60, 0x3c, 0xd2, 0x78, 0xb3, 0xac, 0x45, 0xb7, 0xe9, 0x45, 0x59, 0x73, 0xcb, 0xfb, 0xe6,
+	0xc4, 0x1b, 0x1e, 0x3d, 0x74, 0xf1, 0x5d, 0xce, 0x43, 0x32, 0x44, 0x4b, 0xa2, 0x3e, 0x03, 0x71,
+	0x4e, 0x33, 0xa2, 0x1e, 0x06, 0x75, 0xf9, 0xa6, 0x7e, 0xa1, 0x48, 0x5f, 0x22, 0x8d, 0xd9, 0xda,
+	0x7a, 0xad, 0xda, 0x60, 0xb1, 0xb7, 0xe0, 0x94, 0xc3, 0xf0, 0x26, 0x29, 0x53, 0xb3, 0x48, 0xec,
+	0x9c, 0x87, 0x54, 0x3a, 0x1d, 0x0e, 0xac, 0x7b, 0x1a, 0xe8, 0x26, 0x40, 0x73, 0xa8, 0x96, 0xc9,
+	0x9e, 0x6c, 0x39, 0x1a, 0x71, 0x89, 0xf0, 0x0f, 0xe8, 0x1e, 0x6e, 0x90, 0xa5, 0x1e, 0xd0, 0x54,
+	0x9f, 0xc4, 0x20, 0x7d, 0xbf, 0x69, 0x3b, 0x50, 0x1b, 0x8d, 0x50, 0x22, 0x4f, 0xa0, 0xd9, 0x71,
+	0x62, 0xaf, 0xb2, 0xe3, 0xf4, 0xf7, 0x7e, 0x20, 0xfe, 0xce, 0x1f, 0x88, 0x5b, 0xb3, 0x24, 0x0f,
+	0xe4, 0x36, 0xc4, 0x5b, 0xbe, 0xf5, 0xd9, 0x90, 0x8f, 0xec, 0xe0, 0x33, 0xd5, 0xa5, 0x01, 0x74,
+	0x2b, 0x24, 0x8d, 0x53, 0x1d, 0xd3, 0x28, 0x8d, 0x04, 0x54, 0xe7, 0x9e, 0x0e, 0xc1, 0x00, 0x47,
+	0x8c, 0x1e, 0x43, 0x5c, 0x5c, 0x86, 0xd0, 0xf9, 0xa8, 0x1e, 0xd4, 0x72, 0xeb, 0x4a, 0x4d, 0x76,
+	0x12, 0x13, 0xee, 0xd4, 0x89, 0xcf, 0x7e, 0xfe, 0xfd, 0x49, 0x6c, 0x14, 0x25, 0xb5, 0xa8, 0xbb,
+	0x24, 0xfa, 0x4a, 0x81, 0xa1, 0x60, 0x97, 0x42, 0x6f, 0x77, 0x77, 0x49, 0x10, 0x40, 0xa6, 0x0f,
+	0x73, 0xa3, 0x50, 0xaf, 0x70, 0x38, 0xf3, 0x68, 0x36, 0x04, 0x8e, 0x2c, 0x50, 0x6d, 0x47, 0x3e,
+	0xd4, 0xb5, 0x60, 0x8f, 0x46, 0xdf, 0x28, 0x30, 0xbc, 0x6f, 0xe8, 0x44, 0x99, 0x28, 0xe7, 0xe1,
+	0xf7, 0x84, 0x94, 0xd6, 0xb5, 0xbc, 0xc4, 0x7b, 0x89, 0xe3, 0xd5, 0xd0, 0x4c, 0x08, 0xde, 0x3c,
+	0x2b, 0xe4, 0x1c, 0xa1, 0x24, 0x20, 0x6a, 0x3b, 0xe2, 0xda, 0x51, 0x47, 0x3f, 0x2a, 0x30, 0x12,
+	0x36, 0x62, 0xa2, 0xf9, 0x03, 0x00, 0x44, 0xcd, 0xc9, 0xa9, 0x85, 0xc3, 0x29, 0x49, 0xe8, 0xd7,
+	0x38, 0xf4, 0x45, 0x74, 0x29, 0x02, 0x3a, 0x0b, 0x68, 0xfa, 0xf8, 0x1b, 0xe3, 0x78, 0x1d, 0xfd,
+	0xa0, 0x40, 0x32, 0x72, 0x9e, 0x45, 0x4b, 0x51, 0x90, 0x3a, 0x0d, 0xcb, 0xa9, 0x2b, 0xff, 0x42,
+	0x53, 0x46, 0x34, 0xcd, 0x23, 0x9a, 0x44, 0x6f, 0x86, 0x44, 0x24, 0xa7, 0x6a, 0xdc, 0x84, 0xf8,
+	0xbd, 0x02, 0xc3, 0xfb, 0x78, 0x3b, 0xba, 0x5e, 0xc2, 0xe7, 0xbb, 0x94, 0xd6, 0xb5, 0xbc, 0x84,
+	0xb8, 0xcc, 0x21, 0x2e, 0xa1, 0xcb, 0x5d, 0xd5, 0x37, 0x36, 0xcd, 0x5c, 0xcb, 0x1c, 0x82, 0x7e,
+	0x52, 0x00, 0xb5, 0xd3, 0x2a, 0x9a, 0x3d, 0xf8, 0x23, 0x0b, 0xe1, 0xf7, 0xd4, 0xdc, 0x61, 0x54,
+	0x24, 0xfa, 0x9b, 0x1c, 0xfd, 0x75, 0xb4, 0x1c, 0x82, 0x5e, 0xe0, 0x74, 0x72, 0xc5, 0xa6, 0x9e,
+	0xb6, 0xd3, 0x9c, 0x20, 0xea, 0xda, 0x8e, 0x3f, 0x2f, 0xd4, 0xd1, 0xd7, 0x8d, 0x8e, 0x22, 0x08,
+	0xb6, 0x53, 0x47, 0x69, 0x21, 0xef, 0xd4, 0x74, 0x77, 0xc2, 0x12, 0xf3, 0x55, 0x8e, 0x79, 0x01,
+	0xcd, 0x1d, 0xa6, 0xa3, 0x08, 0x5e, 0x47, 0x4f, 0x15, 0x18, 0x0a, 0x92, 0x45, 0x34, 0xce, 0x10,
+	0xe2, 0x4f, 0x4d, 0x77, 0x27, 0x2c, 0x71, 0x2e, 0x70, 0x9c, 0x19, 0x34, 0x1d, 0x82, 0x53, 0x24,
+	0x5f, 0xdb, 0xd9, 0x3f, 0x47, 0xd4, 0xb3, 0x77, 0x9e, 0xed, 0xa6, 0x95, 0xe7, 0xbb, 0x69, 0xe5,
+	0xb7, 0xdd, 0xb4, 0xf2, 0xe5, 0x5e, 0xba, 0xef, 0xf9, 0x5e, 0xba, 0xef, 0xc5, 0x5e, 0xba, 0xef,
+	0xe1, 0x7c, 0x80, 0x27, 0xa5, 0x45, 0x13, 0xe7, 0x9d, 0x19, 0x83, 0x36, 0x1c, 0x7c, 0x1c, 0x70,
+	0xe1, 0x99, 0x73, 0xf2, 0x71, 0xfe, 0x47, 0xde, 0xfc, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11,
+	0xbd, 0xcc, 0xfb, 0xbf, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RewardGauge queries the reward gauge of a given stakeholder address
+	RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error)
+	// BTCStakingGauge queries the BTC staking gauge of a given height
+	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
+	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
+	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+	// IncentiveModuleAccounting queries the balance of the incentive module
+	// account against the rewards that are withdrawable from all reward gauges
+	IncentiveModuleAccounting(ctx context.Context, in *QueryIncentiveModuleAccountingRequest, opts ...grpc.CallOption) (*QueryIncentiveModuleAccountingResponse, error)
+	// AllRewardGauges queries the reward gauges of a given address under all
+	// stakeholder types, with the withdrawable coins of each reward gauge
+	AllRewardGauges(ctx context.Context, in *QueryAllRewardGaugesRequest, opts ...grpc.CallOption) (*QueryAllRewardGaugesResponse, error)
+	// RewardsDistributed queries the total rewards distributed to all reward
+	// gauges in the epochs within the given range
+	RewardsDistributed(ctx context.Context, in *QueryRewardsDistributedRequest, opts ...grpc.CallOption) (*QueryRewardsDistributedResponse, error)
+	// RewardDenoms queries the distinct denoms present across the reward
+	// gauges of a given address
+	RewardDenoms(ctx context.Context, in *QueryRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRewardDenomsResponse, error)
+	// GaugesByType queries the reward gauges of all stakeholders of a given
+	// stakeholder type
+	GaugesByType(ctx context.Context, in *QueryGaugesByTypeRequest, opts ...grpc.CallOption) (*QueryGaugesByTypeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.WithdrawnCoins) > 0 {
-		for _, e := range m.WithdrawnCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error) {
+	out := new(QueryRewardGaugesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardGauges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error) {
+	out := new(QueryBTCStakingGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCStakingGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RewardGauges) > 0 {
-		for k, v := range m.RewardGauges {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error) {
+	out := new(QueryBTCTimestampingGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCTimestampingGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCStakingGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) IncentiveModuleAccounting(ctx context.Context, in *QueryIncentiveModuleAccountingRequest, opts ...grpc.CallOption) (*QueryIncentiveModuleAccountingResponse, error) {
+	out := new(QueryIncentiveModuleAccountingResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/IncentiveModuleAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return out, nil
+}
+
+func (c *queryClient) AllRewardGauges(ctx context.Context, in *QueryAllRewardGaugesRequest, opts ...grpc.CallOption) (*QueryAllRewardGaugesResponse, error) {
+	out := new(QueryAllRewardGaugesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/AllRewardGauges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BTCStakingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RewardsDistributed(ctx context.Context, in *QueryRewardsDistributedRequest, opts ...grpc.CallOption) (*QueryRewardsDistributedResponse, error) {
+	out := new(QueryRewardsDistributedResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardsDistributed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) RewardDenoms(ctx context.Context, in *QueryRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRewardDenomsResponse, error) {
+	out := new(QueryRewardDenomsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BTCTimestampingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GaugesByType(ctx context.Context, in *QueryGaugesByTypeRequest, opts ...grpc.CallOption) (*QueryGaugesByTypeResponse, error) {
+	out := new(QueryGaugesByTypeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/GaugesByType", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RewardGauge queries the reward gauge of a given stakeholder address
+	RewardGauges(context.Context, *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error)
+	// BTCStakingGauge queries the BTC staking gauge of a given height
+	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
+	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
+	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+	// IncentiveModuleAccounting queries the balance of the incentive module
+	// account against the rewards that are withdrawable from all reward gauges
+	IncentiveModuleAccounting(context.Context, *QueryIncentiveModuleAccountingRequest) (*QueryIncentiveModuleAccountingResponse, error)
+	// AllRewardGauges queries the reward gauges of a given address under all
+	// stakeholder types, with the withdrawable coins of each reward gauge
+	AllRewardGauges(context.Context, *QueryAllRewardGaugesRequest) (*QueryAllRewardGaugesResponse, error)
+	// RewardsDistributed queries the total rewards distributed to all reward
+	// gauges in the epochs within the given range
+	RewardsDistributed(context.Context, *QueryRewardsDistributedRequest) (*QueryRewardsDistributedResponse, error)
+	// RewardDenoms queries the distinct denoms present across the reward
+	// gauges of a given address
+	RewardDenoms(context.Context, *QueryRewardDenomsRequest) (*QueryRewardDenomsResponse, error)
+	// GaugesByType queries the reward gauges of all stakeholders of a given
+	// stakeholder type
+	GaugesByType(context.Context, *QueryGaugesByTypeRequest) (*QueryGaugesByTypeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RewardGauges(ctx context.Context, req *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardGauges not implemented")
+}
+func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCStakingGauge not implemented")
+}
+func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveModuleAccounting(ctx context.Context, req *QueryIncentiveModuleAccountingRequest) (*QueryIncentiveModuleAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveModuleAccounting not implemented")
+}
+func (*UnimplementedQueryServer) AllRewardGauges(ctx context.Context, req *QueryAllRewardGaugesRequest) (*QueryAllRewardGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRewardGauges not implemented")
+}
+func (*UnimplementedQueryServer) RewardsDistributed(ctx context.Context, req *QueryRewardsDistributedRequest) (*QueryRewardsDistributedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsDistributed not implemented")
+}
+func (*UnimplementedQueryServer) RewardDenoms(ctx context.Context, req *QueryRewardDenomsRequest) (*QueryRewardDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDenoms not implemented")
+}
+func (*UnimplementedQueryServer) GaugesByType(ctx context.Context, req *QueryGaugesByTypeRequest) (*QueryGaugesByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugesByType not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/RewardGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardGauges(ctx, req.(*QueryRewardGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCStakingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCStakingGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCStakingGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCStakingGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCStakingGauge(ctx, req.(*QueryBTCStakingGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCTimestampingGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCTimestampingGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCTimestampingGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCTimestampingGauge(ctx, req.(*QueryBTCTimestampingGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentiveModuleAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveModuleAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveModuleAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/IncentiveModuleAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveModuleAccounting(ctx, req.(*QueryIncentiveModuleAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRewardGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRewardGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRewardGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/AllRewardGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRewardGauges(ctx, req.(*QueryAllRewardGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsDistributed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsDistributedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsDistributed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/RewardsDistributed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsDistributed(ctx, req.(*QueryRewardsDistributedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/RewardDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardDenoms(ctx, req.(*QueryRewardDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GaugesByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugesByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/GaugesByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugesByType(ctx, req.(*QueryGaugesByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.incentive.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RewardGauges",
+			Handler:    _Query_RewardGauges_Handler,
+		},
+		{
+			MethodName: "BTCStakingGauge",
+			Handler:    _Query_BTCStakingGauge_Handler,
+		},
+		{
+			MethodName: "BTCTimestampingGauge",
+			Handler:    _Query_BTCTimestampingGauge_Handler,
+		},
+		{
+			MethodName: "IncentiveModuleAccounting",
+			Handler:    _Query_IncentiveModuleAccounting_Handler,
+		},
+		{
+			MethodName: "AllRewardGauges",
+			Handler:    _Query_AllRewardGauges_Handler,
+		},
+		{
+			MethodName: "RewardsDistributed",
+			Handler:    _Query_RewardsDistributed_Handler,
+		},
+		{
+			MethodName: "RewardDenoms",
+			Handler:    _Query_RewardDenoms_Handler,
+		},
+		{
+			MethodName: "GaugesByType",
+			Handler:    _Query_GaugesByType_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/incentive/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardGauges) > 0 {
+		for k := range m.RewardGauges {
+			v := m.RewardGauges[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCStakingGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCStakingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCStakingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCStakingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCStakingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTimestampingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTimestampingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCStakingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCStakingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCStakingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveModuleAccountingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveModuleAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveModuleAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveModuleAccountingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveModuleAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveModuleAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deficit) > 0 {
+		for iNdEx := len(m.Deficit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deficit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for iNdEx := len(m.Surplus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surplus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GaugeTotal) > 0 {
+		for iNdEx := len(m.GaugeTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModuleBalance) > 0 {
+		for iNdEx := len(m.ModuleBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRewardGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardGaugeWithWithdrawableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardGaugeWithWithdrawableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardGaugeWithWithdrawableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawableCoins) > 0 {
+		for iNdEx := len(m.WithdrawableCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawableCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRewardGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardGauges) > 0 {
+		for k := range m.RewardGauges {
+			v := m.RewardGauges[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsDistributedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsDistributedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsDistributedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsDistributedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsDistributedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsDistributedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugesByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakeholderType) > 0 {
+		i -= len(m.StakeholderType)
+		copy(dAtA[i:], m.StakeholderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakeholderType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeholderRewardGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeholderRewardGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeholderRewardGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugesByTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesByTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesByTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardGauges) > 0 {
+		for k, v := range m.RewardGauges {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCStakingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCStakingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCTimestampingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCStakingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveModuleAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIncentiveModuleAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleBalance) > 0 {
+		for _, e := range m.ModuleBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GaugeTotal) > 0 {
+		for _, e := range m.GaugeTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for _, e := range m.Surplus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Deficit) > 0 {
+		for _, e := range m.Deficit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllRewardGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardGaugeWithWithdrawableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawableCoins) > 0 {
+		for _, e := range m.WithdrawableCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllRewardGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardGauges) > 0 {
+		for k, v := range m.RewardGauges {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsDistributedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryRewardsDistributedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGaugesByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakeholderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakeholderRewardGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGaugesByTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardGauges == nil {
+				m.RewardGauges = make(map[string]*RewardGaugesResponse)
+			}
+			var mapkey string
+			var mapvalue *RewardGaugesResponse
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RewardGaugesResponse{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RewardGauges[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCStakingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCTimestampingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &BTCStakingGaugeResponse{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &BTCTimestampingGaugeResponse{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryBTCStakingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryBTCTimestampingGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
-	}
-	return n
-}
 
-func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveModuleAccountingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,10 +4135,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveModuleAccountingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveModuleAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1412,7 +4162,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveModuleAccountingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1435,15 +4185,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveModuleAccountingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveModuleAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1470,7 +4220,110 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ModuleBalance = append(m.ModuleBalance, types.Coin{})
+			if err := m.ModuleBalance[len(m.ModuleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeTotal = append(m.GaugeTotal, types.Coin{})
+			if err := m.GaugeTotal[len(m.GaugeTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Surplus = append(m.Surplus, types.Coin{})
+			if err := m.Surplus[len(m.Surplus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deficit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deficit = append(m.Deficit, types.Coin{})
+			if err := m.Deficit[len(m.Deficit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1495,7 +4348,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,10 +4371,10 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRewardGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1577,7 +4430,7 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *RewardGaugeWithWithdrawableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1600,10 +4453,10 @@ func (m *RewardGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardGaugeWithWithdrawableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardGaugeWithWithdrawableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1674,6 +4527,40 @@ func (m *RewardGaugesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawableCoins = append(m.WithdrawableCoins, types.Coin{})
+			if err := m.WithdrawableCoins[len(m.WithdrawableCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1695,7 +4582,7 @@ func (m *RewardGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,10 +4605,10 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRewardGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1754,10 +4641,10 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RewardGauges == nil {
-				m.RewardGauges = make(map[string]*RewardGaugesResponse)
+				m.RewardGauges = make(map[string]*RewardGaugeWithWithdrawableResponse)
 			}
 			var mapkey string
-			var mapvalue *RewardGaugesResponse
+			var mapvalue *RewardGaugeWithWithdrawableResponse
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -1831,7 +4718,7 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &RewardGaugesResponse{}
+					mapvalue = &RewardGaugeWithWithdrawableResponse{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -1851,8 +4738,96 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.RewardGauges[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.RewardGauges[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsDistributedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsDistributedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsDistributedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1874,7 +4849,7 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsDistributedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1897,17 +4872,17 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsDistributedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsDistributedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1917,11 +4892,26 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1943,7 +4933,7 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1966,17 +4956,17 @@ func (m *BTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCStakingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1986,25 +4976,23 @@ func (m *BTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2027,7 +5015,7 @@ func (m *BTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,17 +5038,17 @@ func (m *BTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCTimestampingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2070,25 +5058,23 @@ func (m *BTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2111,7 +5097,7 @@ func (m *BTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesByTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2134,15 +5120,47 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesByTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeholderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeholderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2169,10 +5187,10 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &BTCStakingGaugeResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2197,7 +5215,7 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *StakeholderRewardGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,17 +5238,17 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakeholderRewardGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakeholderRewardGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.EpochNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2240,11 +5258,92 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2266,7 +5365,7 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesByTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2289,15 +5388,15 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesByTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesByTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2324,10 +5423,44 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &BTCTimestampingGaugeResponse{}
+			m.Gauges = append(m.Gauges, &StakeholderRewardGaugeResponse{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -134,6 +134,156 @@ func (m *MsgWithdrawRewardResponse) GetCoins() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// MsgWithdrawAllReward defines a message for withdrawing rewards of a
+// stakeholder in all stakeholder types with withdrawable coins.
+type MsgWithdrawAllReward struct {
+	// address is the address of the stakeholder in bech32 string
+	// signer of this msg has to be this address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgWithdrawAllReward) Reset()         { *m = MsgWithdrawAllReward{} }
+func (m *MsgWithdrawAllReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllReward) ProtoMessage()    {}
+func (*MsgWithdrawAllReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{2}
+}
+func (m *MsgWithdrawAllReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllReward.Merge(m, src)
+}
+func (m *MsgWithdrawAllReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllReward proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllReward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// WithdrawnReward is the reward withdrawn by a stakeholder in a given type
+type WithdrawnReward struct {
+	// {submitter, reporter, finality_provider, btc_delegation}
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// coins is the withdrawed coins
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *WithdrawnReward) Reset()         { *m = WithdrawnReward{} }
+func (m *WithdrawnReward) String() string { return proto.CompactTextString(m) }
+func (*WithdrawnReward) ProtoMessage()    {}
+func (*WithdrawnReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{3}
+}
+func (m *WithdrawnReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawnReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawnReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawnReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawnReward.Merge(m, src)
+}
+func (m *WithdrawnReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawnReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawnReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawnReward proto.InternalMessageInfo
+
+func (m *WithdrawnReward) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WithdrawnReward) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// MsgWithdrawAllRewardResponse is the response to the MsgWithdrawAllReward message
+type MsgWithdrawAllRewardResponse struct {
+	// rewards are the rewards withdrawn in each stakeholder type. Stakeholder
+	// types without withdrawable coins are omitted
+	Rewards []*WithdrawnReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (m *MsgWithdrawAllRewardResponse) Reset()         { *m = MsgWithdrawAllRewardResponse{} }
+func (m *MsgWithdrawAllRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllRewardResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{4}
+}
+func (m *MsgWithdrawAllRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllRewardResponse) GetRewards() []*WithdrawnReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // MsgUpdateParams defines a message for updating incentive module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -151,7 +301,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4de6776d39a3a22, []int{2}
+	return fileDescriptor_b4de6776d39a3a22, []int{5}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +352,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4de6776d39a3a22, []int{3}
+	return fileDescriptor_b4de6776d39a3a22, []int{6}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,6 +384,9 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgWithdrawReward)(nil), "babylon.incentive.MsgWithdrawReward")
 	proto.RegisterType((*MsgWithdrawRewardResponse)(nil), "babylon.incentive.MsgWithdrawRewardResponse")
+	proto.RegisterType((*MsgWithdrawAllReward)(nil), "babylon.incentive.MsgWithdrawAllReward")
+	proto.RegisterType((*WithdrawnReward)(nil), "babylon.incentive.WithdrawnReward")
+	proto.RegisterType((*MsgWithdrawAllRewardResponse)(nil), "babylon.incentive.MsgWithdrawAllRewardResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.incentive.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.incentive.MsgUpdateParamsResponse")
 }
@@ -241,37 +394,41 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/tx.proto", fileDescriptor_b4de6776d39a3a22) }
 
 var fileDescriptor_b4de6776d39a3a22 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xd8, 0x1f, 0xd2, 0xb1, 0x54, 0x3a, 0x14, 0xba, 0xd9, 0xc3, 0xb6, 0x2c, 0x1e, 0x42,
-	0x30, 0x3b, 0xa6, 0x05, 0x85, 0xde, 0x8c, 0xe7, 0xa0, 0xac, 0x88, 0xe0, 0x41, 0x99, 0xcd, 0x0e,
-	0x93, 0xc1, 0xee, 0xce, 0xb2, 0x6f, 0x9a, 0x36, 0x17, 0x11, 0xff, 0x02, 0xf1, 0xcf, 0xf0, 0xd4,
-	0x83, 0x7f, 0x44, 0x8f, 0xa5, 0x27, 0x4f, 0x2a, 0xc9, 0xa1, 0xff, 0x86, 0xcc, 0xce, 0x6c, 0x1b,
-	0xbb, 0x05, 0x7b, 0x9a, 0x79, 0xf9, 0xbe, 0xf9, 0xde, 0xf7, 0xbe, 0x97, 0xc5, 0x7e, 0xc2, 0x92,
-	0xe9, 0xa1, 0xca, 0xa9, 0xcc, 0x47, 0x3c, 0xd7, 0x72, 0xc2, 0xa9, 0x3e, 0x89, 0x8a, 0x52, 0x69,
-	0x45, 0x36, 0x1d, 0x16, 0x5d, 0x61, 0xfe, 0x96, 0x50, 0x42, 0x55, 0x28, 0x35, 0x37, 0x4b, 0xf4,
-	0xdb, 0x23, 0x05, 0x99, 0x82, 0x0f, 0x16, 0xb0, 0x85, 0x83, 0xb6, 0x6d, 0x45, 0x33, 0x10, 0x74,
-	0xd2, 0x37, 0x87, 0x03, 0x02, 0x07, 0x24, 0x0c, 0x38, 0x9d, 0xf4, 0x13, 0xae, 0x59, 0x9f, 0x8e,
-	0x94, 0xcc, 0x6b, 0xbc, 0x69, 0xac, 0x60, 0x25, 0xcb, 0x9c, 0x70, 0xf8, 0x12, 0x6f, 0x0e, 0x41,
-	0xbc, 0x95, 0x7a, 0x9c, 0x96, 0xec, 0x38, 0xe6, 0xc7, 0xac, 0x4c, 0x09, 0xc1, 0xcb, 0x7a, 0x5a,
-	0x70, 0x0f, 0xed, 0xa2, 0xce, 0x5a, 0x5c, 0xdd, 0x89, 0x87, 0xef, 0xb3, 0x34, 0x2d, 0x39, 0x80,
-	0x77, 0xaf, 0xfa, 0xb9, 0x2e, 0x0f, 0xd6, 0xbf, 0x5c, 0x9e, 0x76, 0xeb, 0x2a, 0xfc, 0x84, 0xdb,
-	0x0d, 0xc1, 0x98, 0x43, 0xa1, 0x72, 0xe0, 0x84, 0xe1, 0x15, 0xe3, 0x0d, 0x3c, 0xb4, 0xbb, 0xd4,
-	0x79, 0xb0, 0xd7, 0x8e, 0xdc, 0x90, 0xc6, 0x7d, 0xe4, 0xdc, 0x47, 0x2f, 0x94, 0xcc, 0x07, 0x4f,
-	0xce, 0x7e, 0xed, 0xb4, 0xbe, 0xff, 0xde, 0xe9, 0x08, 0xa9, 0xc7, 0x47, 0x49, 0x34, 0x52, 0x99,
-	0x4b, 0xc4, 0x1d, 0x3d, 0x48, 0x3f, 0x52, 0xe3, 0x0c, 0xaa, 0x07, 0x10, 0x5b, 0xe5, 0xf0, 0x1b,
-	0xc2, 0x0f, 0x87, 0x20, 0xde, 0x14, 0x29, 0xd3, 0xfc, 0x55, 0x35, 0x2a, 0x79, 0x8a, 0xd7, 0xd8,
-	0x91, 0x1e, 0xab, 0x52, 0xea, 0xa9, 0x1d, 0x6a, 0xe0, 0x5d, 0xfc, 0xe8, 0x6d, 0xb9, 0xee, 0xcf,
-	0xad, 0xf5, 0xd7, 0xba, 0x94, 0xb9, 0x88, 0xaf, 0xa9, 0xe4, 0x19, 0x5e, 0xb5, 0x61, 0x55, 0x23,
-	0x1b, 0xbf, 0x8d, 0x55, 0x46, 0xb6, 0xc5, 0x60, 0xd9, 0xf8, 0x8d, 0x1d, 0xfd, 0x60, 0xc3, 0x44,
-	0x72, 0x2d, 0x14, 0xb6, 0xf1, 0xf6, 0x0d, 0x4f, 0x75, 0x24, 0x7b, 0x17, 0x08, 0x2f, 0x0d, 0x41,
-	0x90, 0x14, 0x6f, 0xdc, 0xd8, 0xc2, 0xa3, 0x5b, 0xba, 0x35, 0xa2, 0xf5, 0x1f, 0xdf, 0x85, 0x75,
-	0xb5, 0x80, 0xf7, 0x78, 0xfd, 0x9f, 0x64, 0xc2, 0xdb, 0x5f, 0x2f, 0x72, 0xfc, 0xee, 0xff, 0x39,
-	0xb5, 0xbe, 0xbf, 0xf2, 0xf9, 0xf2, 0xb4, 0x8b, 0x06, 0xc3, 0xb3, 0x59, 0x80, 0xce, 0x67, 0x01,
-	0xfa, 0x33, 0x0b, 0xd0, 0xd7, 0x79, 0xd0, 0x3a, 0x9f, 0x07, 0xad, 0x9f, 0xf3, 0xa0, 0xf5, 0x6e,
-	0x7f, 0x61, 0x9f, 0x4e, 0xf6, 0x90, 0x25, 0xd0, 0x93, 0xaa, 0x2e, 0xe9, 0xc9, 0xe2, 0x47, 0x64,
-	0x16, 0x9c, 0xac, 0x56, 0xff, 0xd5, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x43, 0xa9,
-	0xe8, 0x66, 0x03, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xee, 0x74, 0x7f, 0xb1, 0xcf, 0x65, 0x97, 0x86, 0xc2, 0xa6, 0x41, 0xb2, 0x4b, 0x10, 0x2c,
+	0xc5, 0x66, 0xec, 0x2e, 0x28, 0x2c, 0x22, 0x6c, 0x3d, 0x17, 0x25, 0x22, 0x82, 0x88, 0x32, 0x69,
+	0x86, 0x34, 0xd8, 0x64, 0x42, 0x66, 0xb6, 0xbb, 0xbd, 0x88, 0x78, 0xf2, 0x28, 0xfe, 0x19, 0x9e,
+	0xf6, 0xe0, 0x3f, 0xe0, 0x6d, 0x8f, 0x8b, 0x27, 0x4f, 0x2a, 0xed, 0x61, 0xff, 0x0d, 0x49, 0x32,
+	0xc9, 0xc6, 0xa6, 0xd4, 0x5e, 0xf6, 0x94, 0xbc, 0xbc, 0x37, 0xdf, 0xfb, 0xbe, 0x2f, 0x1f, 0x03,
+	0x9a, 0x4d, 0xec, 0xf1, 0x90, 0x05, 0xd8, 0x0b, 0xfa, 0x34, 0x10, 0xde, 0x88, 0x62, 0x71, 0x66,
+	0x86, 0x11, 0x13, 0x4c, 0xa9, 0xc9, 0x9e, 0x99, 0xf7, 0xb4, 0xba, 0xcb, 0x5c, 0x96, 0x74, 0x71,
+	0xfc, 0x96, 0x0e, 0x6a, 0x8d, 0x3e, 0xe3, 0x3e, 0xe3, 0x6f, 0xd3, 0x46, 0x5a, 0xc8, 0xd6, 0x6e,
+	0x5a, 0x61, 0x9f, 0xbb, 0x78, 0xd4, 0x89, 0x1f, 0xb2, 0xa1, 0xcb, 0x86, 0x4d, 0x38, 0xc5, 0xa3,
+	0x8e, 0x4d, 0x05, 0xe9, 0xe0, 0x3e, 0xf3, 0x82, 0xac, 0x5f, 0x26, 0x16, 0x92, 0x88, 0xf8, 0x12,
+	0xd8, 0x78, 0x0a, 0xb5, 0x1e, 0x77, 0x5f, 0x7a, 0x62, 0xe0, 0x44, 0xe4, 0xd4, 0xa2, 0xa7, 0x24,
+	0x72, 0x14, 0x05, 0x56, 0xc5, 0x38, 0xa4, 0x2a, 0xda, 0x47, 0xcd, 0x4d, 0x2b, 0x79, 0x57, 0x54,
+	0xd8, 0x20, 0x8e, 0x13, 0x51, 0xce, 0xd5, 0x6a, 0xf2, 0x39, 0x2b, 0x8f, 0xb6, 0x3e, 0x5e, 0x9d,
+	0xb7, 0xb2, 0xca, 0x78, 0x0f, 0x8d, 0x12, 0xa0, 0x45, 0x79, 0xc8, 0x02, 0x4e, 0x15, 0x02, 0x6b,
+	0x31, 0x37, 0xae, 0xa2, 0xfd, 0x95, 0xe6, 0xad, 0x83, 0x86, 0x29, 0x45, 0xc6, 0xec, 0x4d, 0xc9,
+	0xde, 0x7c, 0xc2, 0xbc, 0xa0, 0x7b, 0xff, 0xe2, 0xd7, 0x5e, 0xe5, 0xeb, 0xef, 0xbd, 0xa6, 0xeb,
+	0x89, 0xc1, 0x89, 0x6d, 0xf6, 0x99, 0x2f, 0x1d, 0x91, 0x8f, 0x36, 0x77, 0xde, 0xe1, 0x98, 0x19,
+	0x4f, 0x0e, 0x70, 0x2b, 0x45, 0x36, 0x1e, 0x43, 0xbd, 0xb0, 0xff, 0x78, 0x38, 0x94, 0x9a, 0x0a,
+	0xfc, 0xd1, 0x22, 0xfe, 0x9f, 0x10, 0xec, 0x64, 0xa7, 0x83, 0x05, 0x7e, 0xe4, 0x52, 0xaa, 0x37,
+	0x26, 0xe5, 0x35, 0xdc, 0x9e, 0x27, 0x25, 0x77, 0xf3, 0x11, 0x6c, 0x44, 0xc9, 0x97, 0xcc, 0x4f,
+	0xc3, 0x2c, 0x45, 0xcd, 0x9c, 0xd1, 0x62, 0x65, 0x47, 0x8c, 0x2f, 0x08, 0x76, 0x7a, 0xdc, 0x7d,
+	0x11, 0x3a, 0x44, 0xd0, 0x67, 0x49, 0x26, 0x94, 0x07, 0xb0, 0x49, 0x4e, 0xc4, 0x80, 0x45, 0x9e,
+	0x18, 0xa7, 0x6a, 0xbb, 0xea, 0x8f, 0x6f, 0xed, 0xba, 0xd4, 0x76, 0x9c, 0x7a, 0xf4, 0x5c, 0x44,
+	0x5e, 0xe0, 0x5a, 0xd7, 0xa3, 0xca, 0x43, 0x58, 0x4f, 0x53, 0x95, 0x64, 0x23, 0x76, 0xa3, 0x4c,
+	0x24, 0x5d, 0xd1, 0x5d, 0x8d, 0xdd, 0xb0, 0xe4, 0xf8, 0xd1, 0x76, 0xec, 0xfd, 0x35, 0x90, 0xd1,
+	0x80, 0xdd, 0x19, 0x4e, 0x99, 0xda, 0x83, 0xef, 0x55, 0x58, 0xe9, 0x71, 0x57, 0x71, 0x60, 0x7b,
+	0x26, 0xae, 0x77, 0xe6, 0x6c, 0x2b, 0x65, 0x50, 0xbb, 0xb7, 0xcc, 0x54, 0xee, 0xad, 0x0f, 0xb5,
+	0x72, 0x86, 0xee, 0x2e, 0x86, 0xc8, 0x07, 0x35, 0xbc, 0xe4, 0x60, 0xbe, 0xee, 0x0d, 0x6c, 0xfd,
+	0xf3, 0x23, 0x8c, 0xf9, 0x00, 0xc5, 0x19, 0xad, 0xf5, 0xff, 0x99, 0x0c, 0x5f, 0x5b, 0xfb, 0x70,
+	0x75, 0xde, 0x42, 0xdd, 0xde, 0xc5, 0x44, 0x47, 0x97, 0x13, 0x1d, 0xfd, 0x99, 0xe8, 0xe8, 0xf3,
+	0x54, 0xaf, 0x5c, 0x4e, 0xf5, 0xca, 0xcf, 0xa9, 0x5e, 0x79, 0x75, 0x58, 0x08, 0xa7, 0x84, 0x1d,
+	0x12, 0x9b, 0xb7, 0x3d, 0x96, 0x95, 0xf8, 0xac, 0x78, 0xb9, 0xc5, 0x69, 0xb5, 0xd7, 0x93, 0x3b,
+	0xe4, 0xf0, 0xef, 0x00, 0x39, 0x9e, 0x20, 0x7c, 0xfe, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +445,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// WithdrawReward defines a method to withdraw rewards of a stakeholder
 	WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error)
+	// WithdrawAllReward defines a method to withdraw rewards of a stakeholder
+	// in all stakeholder types
+	WithdrawAllReward(ctx context.Context, in *MsgWithdrawAllReward, opts ...grpc.CallOption) (*MsgWithdrawAllRewardResponse, error)
 	// UpdateParams updates the incentive module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -309,6 +469,15 @@ func (c *msgClient) WithdrawReward(ctx context.Context, in *MsgWithdrawReward, o
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAllReward(ctx context.Context, in *MsgWithdrawAllReward, opts ...grpc.CallOption) (*MsgWithdrawAllRewardResponse, error) {
+	out := new(MsgWithdrawAllRewardResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Msg/WithdrawAllReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.incentive.Msg/UpdateParams", in, out, opts...)
@@ -322,6 +491,9 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// WithdrawReward defines a method to withdraw rewards of a stakeholder
 	WithdrawReward(context.Context, *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error)
+	// WithdrawAllReward defines a method to withdraw rewards of a stakeholder
+	// in all stakeholder types
+	WithdrawAllReward(context.Context, *MsgWithdrawAllReward) (*MsgWithdrawAllRewardResponse, error)
 	// UpdateParams updates the incentive module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -333,6 +505,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) WithdrawReward(ctx context.Context, req *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawReward not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawAllReward(ctx context.Context, req *MsgWithdrawAllReward) (*MsgWithdrawAllRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllReward not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -359,6 +534,24 @@ func _Msg_WithdrawReward_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAllReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Msg/WithdrawAllReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllReward(ctx, req.(*MsgWithdrawAllReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -385,6 +578,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawReward",
 			Handler:    _Msg_WithdrawReward_Handler,
 		},
+		{
+			MethodName: "WithdrawAllReward",
+			Handler:    _Msg_WithdrawAllReward_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -468,6 +665,117 @@ func (m *MsgWithdrawRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAllReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawnReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawnReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawnReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -574,46 +882,93 @@ func (m *MsgWithdrawRewardResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgWithdrawAllReward) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *WithdrawnReward) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgWithdrawAllRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func (m *MsgWithdrawReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWithdrawReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -802,6 +1157,288 @@ func (m *MsgWithdrawRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawAllReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawnReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawnReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawnReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &WithdrawnReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
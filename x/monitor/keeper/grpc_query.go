@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/store/prefix"
 	"github.com/babylonlabs-io/babylon/x/monitor/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -42,3 +46,191 @@ func (k Keeper) ReportedCheckpointBtcHeight(c context.Context, req *types.QueryR
 
 	return &types.QueryReportedCheckpointBtcHeightResponse{BtcLightClientHeight: btcHeight}, nil
 }
+
+// AllEndedEpochs returns all ended epochs recorded by the module together with
+// the BTC light client height at their end, in ascending order of epoch number.
+// Epoch 0 is not included as its end is not recorded, and its BTC light client
+// height is the base BTC header height
+func (k Keeper) AllEndedEpochs(c context.Context, req *types.QueryAllEndedEpochsRequest) (*types.QueryAllEndedEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.EpochEndLightClientHeightPrefix)
+
+	var endedEpochs []*types.EndedEpoch
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		if len(key) != 8 {
+			panic("invalid data in database")
+		}
+		btcHeight, err := bytesToBtcHeight(value)
+		if err != nil {
+			panic("invalid data in database")
+		}
+
+		endedEpochs = append(endedEpochs, &types.EndedEpoch{
+			EpochNum:             sdk.BigEndianToUint64(key),
+			BtcLightClientHeight: btcHeight,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllEndedEpochsResponse{EndedEpochs: endedEpochs, Pagination: pageRes}, nil
+}
+
+// maxCheckpointReportingLagSeriesLen is the maximum number of epochs that a
+// CheckpointReportingLagSeries query can cover
+const maxCheckpointReportingLagSeriesLen = 1000
+
+// CheckpointReportingLagSeries returns, for each epoch in the given range, the
+// difference between the BTC light client height when the checkpoint of the
+// epoch is reported and the one when the epoch ended. Epochs whose checkpoint
+// is not reported yet are marked as such. All epochs in the range must have
+// ended
+func (k Keeper) CheckpointReportingLagSeries(c context.Context, req *types.QueryCheckpointReportingLagSeriesRequest) (*types.QueryCheckpointReportingLagSeriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "from_epoch %d is larger than to_epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxCheckpointReportingLagSeriesLen {
+		return nil, status.Errorf(codes.InvalidArgument, "the range cannot cover more than %d epochs", maxCheckpointReportingLagSeriesLen)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lags := make([]*types.CheckpointReportingLag, 0, req.ToEpoch-req.FromEpoch+1)
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		lag, err := k.checkpointReportingLag(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		lags = append(lags, lag)
+	}
+
+	return &types.QueryCheckpointReportingLagSeriesResponse{Lags: lags}, nil
+}
+
+// checkpointReportingLag returns the reporting lag of the checkpoint of the
+// given epoch, from the BTC light client heights recorded upon the end of the
+// epoch and upon the report of its checkpoint
+func (k Keeper) checkpointReportingLag(ctx context.Context, epoch uint64) (*types.CheckpointReportingLag, error) {
+	endedHeight, err := k.LightclientHeightAtEpochEnd(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	lag := &types.CheckpointReportingLag{
+		EpochNum:            epoch,
+		EndedEpochBtcHeight: endedHeight,
+	}
+
+	ckpt, err := k.checkpointingKeeper.GetRawCheckpoint(ctx, epoch)
+	if err != nil {
+		// the checkpoint of the epoch is not sealed yet
+		return lag, nil
+	}
+	reportedHeight, err := k.LightclientHeightAtCheckpointReported(ctx, ckpt.Ckpt.HashStr())
+	if err != nil {
+		if errors.Is(err, types.ErrCheckpointNotReported) {
+			return lag, nil
+		}
+		return nil, err
+	}
+
+	lag.Reported = true
+	lag.ReportedBtcHeight = reportedHeight
+	if reportedHeight > endedHeight {
+		lag.Lag = reportedHeight - endedHeight
+	}
+	return lag, nil
+}
+
+// EpochForBtcHeight returns the latest epoch whose BTC light client height at
+// its end is no larger than the given BTC height. The ended epochs are scanned
+// in descending order of epoch number. Epoch 0 is returned if no recorded
+// epoch qualifies but the base BTC header is no higher than the given height
+func (k Keeper) EpochForBtcHeight(c context.Context, req *types.QueryEpochForBtcHeightRequest) (*types.QueryEpochForBtcHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.EpochEndLightClientHeightPrefix)
+
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != 8 {
+			panic("invalid data in database")
+		}
+		btcHeight, err := bytesToBtcHeight(iter.Value())
+		if err != nil {
+			panic("invalid data in database")
+		}
+		if btcHeight <= req.BtcHeight {
+			return &types.QueryEpochForBtcHeightResponse{
+				EpochNum:             sdk.BigEndianToUint64(iter.Key()),
+				BtcLightClientHeight: btcHeight,
+			}, nil
+		}
+	}
+
+	baseHeight := k.btcLightClientKeeper.GetBaseBTCHeader(ctx).Height
+	if baseHeight <= req.BtcHeight {
+		return &types.QueryEpochForBtcHeightResponse{
+			EpochNum:             0,
+			BtcLightClientHeight: baseHeight,
+		}, nil
+	}
+
+	return nil, types.ErrNoEpochEndedByHeight.Wrapf("BTC height %d", req.BtcHeight)
+}
+
+// MonitorSummary returns the number of ended epochs recorded by the module,
+// the number of those whose checkpoint is reported, and the latest of the
+// latter. Each ended epoch is checked via its sealed checkpoint, so the cost
+// of the query grows linearly with the number of ended epochs
+func (k Keeper) MonitorSummary(c context.Context, req *types.QueryMonitorSummaryRequest) (*types.QueryMonitorSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.EpochEndLightClientHeightPrefix)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	resp := &types.QueryMonitorSummaryResponse{}
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != 8 {
+			panic("invalid data in database")
+		}
+		epoch := sdk.BigEndianToUint64(iter.Key())
+		resp.EndedEpochs++
+
+		lag, err := k.checkpointReportingLag(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if lag.Reported {
+			resp.ReportedEpochs++
+			// epochs are iterated in ascending order
+			resp.LatestReportedEpoch = epoch
+		}
+	}
+
+	return resp, nil
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -147,3 +149,246 @@ func FuzzQueryReportedCheckpointBtcHeight(f *testing.F) {
 		require.ErrorIs(t, err, types.ErrCheckpointNotReported)
 	})
 }
+
+func FuzzQueryAllEndedEpochs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, mk)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		// end a random number of epochs, each after extending the BTC light
+		// client with a random number of headers
+		numEpochs := datagen.RandomInt(r, 10) + 1
+		expectedHeights := make([]uint32, 0, numEpochs)
+		for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+			tip := lck.GetTipInfo(ctx)
+			chain := datagen.GenRandomValidChainStartingFrom(
+				r,
+				tip.Header.ToBlockHeader(),
+				nil,
+				uint32(datagen.RandomInt(r, 5)+1),
+			)
+			err := lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(chain))
+			require.NoError(t, err)
+
+			mk.Hooks().AfterEpochEnds(ctx, epoch)
+			expectedHeights = append(expectedHeights, lck.GetTipInfo(ctx).Height)
+		}
+
+		// sweep all ended epochs page by page
+		limit := datagen.RandomInt(r, int(numEpochs)) + 1
+		endedEpochs := []*types.EndedEpoch{}
+		pagination := &query.PageRequest{Limit: limit}
+		for {
+			resp, err := queryClient.AllEndedEpochs(ctx, &types.QueryAllEndedEpochsRequest{Pagination: pagination})
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.EndedEpochs)), limit)
+			endedEpochs = append(endedEpochs, resp.EndedEpochs...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			// the pagination cursor is the big-endian epoch number of the next page
+			require.Equal(t, sdk.Uint64ToBigEndian(uint64(len(endedEpochs))+1), resp.Pagination.NextKey)
+			pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: limit}
+		}
+
+		require.Len(t, endedEpochs, int(numEpochs))
+		for i, endedEpoch := range endedEpochs {
+			require.Equal(t, uint64(i+1), endedEpoch.EpochNum)
+			require.Equal(t, expectedHeights[i], endedEpoch.BtcLightClientHeight)
+		}
+	})
+}
+
+func FuzzQueryCheckpointReportingLagSeries(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+		ck := babylonApp.CheckpointingKeeper
+
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, mk)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		extendBTCChain := func() uint32 {
+			tip := lck.GetTipInfo(ctx)
+			chain := datagen.GenRandomValidChainStartingFrom(
+				r,
+				tip.Header.ToBlockHeader(),
+				nil,
+				uint32(datagen.RandomInt(r, 5)+1),
+			)
+			err := lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(chain))
+			require.NoError(t, err)
+			return lck.GetTipInfo(ctx).Height
+		}
+
+		// end a random number of epochs, and report the checkpoints of some
+		// of them after extending the BTC light client
+		numEpochs := datagen.RandomInt(r, 10) + 1
+		expectedLags := make([]*types.CheckpointReportingLag, 0, numEpochs)
+		for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+			endedHeight := extendBTCChain()
+			mk.Hooks().AfterEpochEnds(ctx, epoch)
+			expectedLag := &types.CheckpointReportingLag{
+				EpochNum:            epoch,
+				EndedEpochBtcHeight: endedHeight,
+			}
+
+			ckpt := datagen.GenRandomRawCheckpoint(r)
+			ckpt.EpochNum = epoch
+			err := ck.AddRawCheckpoint(ctx, ckpttypes.NewCheckpointWithMeta(ckpt, ckpttypes.Sealed))
+			require.NoError(t, err)
+
+			if datagen.OneInN(r, 2) {
+				reportedHeight := extendBTCChain()
+				err := mk.Hooks().AfterRawCheckpointBlsSigVerified(ctx, ckpt)
+				require.NoError(t, err)
+				expectedLag.Reported = true
+				expectedLag.ReportedBtcHeight = reportedHeight
+				expectedLag.Lag = reportedHeight - endedHeight
+			}
+			expectedLags = append(expectedLags, expectedLag)
+		}
+
+		// query the lags of a random range of ended epochs
+		fromEpoch := datagen.RandomInt(r, int(numEpochs)) + 1
+		toEpoch := fromEpoch + datagen.RandomInt(r, int(numEpochs-fromEpoch)+1)
+		resp, err := queryClient.CheckpointReportingLagSeries(ctx, &types.QueryCheckpointReportingLagSeriesRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   toEpoch,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedLags[fromEpoch-1:toEpoch], resp.Lags)
+
+		// the range cannot include epochs that have not ended yet
+		_, err = queryClient.CheckpointReportingLagSeries(ctx, &types.QueryCheckpointReportingLagSeriesRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   numEpochs + 1,
+		})
+		require.ErrorIs(t, err, types.ErrEpochNotEnded)
+
+		// invalid range
+		_, err = queryClient.CheckpointReportingLagSeries(ctx, &types.QueryCheckpointReportingLagSeriesRequest{
+			FromEpoch: toEpoch + 1,
+			ToEpoch:   toEpoch,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzQueryEpochForBtcHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, mk)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		// end a random number of epochs, each after extending the BTC light
+		// client with a random number of headers
+		baseHeight := lck.GetBaseBTCHeader(ctx).Height
+		numEpochs := datagen.RandomInt(r, 10) + 1
+		endedHeights := []uint32{baseHeight}
+		for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+			tip := lck.GetTipInfo(ctx)
+			chain := datagen.GenRandomValidChainStartingFrom(
+				r,
+				tip.Header.ToBlockHeader(),
+				nil,
+				uint32(datagen.RandomInt(r, 5)+1),
+			)
+			err := lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(chain))
+			require.NoError(t, err)
+
+			mk.Hooks().AfterEpochEnds(ctx, epoch)
+			endedHeights = append(endedHeights, lck.GetTipInfo(ctx).Height)
+		}
+
+		// no epoch had ended before the base BTC header
+		if baseHeight > 0 {
+			_, err := queryClient.EpochForBtcHeight(ctx, &types.QueryEpochForBtcHeightRequest{BtcHeight: baseHeight - 1})
+			require.ErrorContains(t, err, types.ErrNoEpochEndedByHeight.Error())
+		}
+
+		// every BTC height maps to the latest epoch that had ended by then
+		tipHeight := endedHeights[len(endedHeights)-1]
+		for btcHeight := baseHeight; btcHeight <= tipHeight+1; btcHeight++ {
+			expectedEpoch := uint64(0)
+			for epoch, endedHeight := range endedHeights {
+				if endedHeight <= btcHeight {
+					expectedEpoch = uint64(epoch)
+				}
+			}
+
+			resp, err := queryClient.EpochForBtcHeight(ctx, &types.QueryEpochForBtcHeightRequest{BtcHeight: btcHeight})
+			require.NoError(t, err)
+			require.Equal(t, expectedEpoch, resp.EpochNum)
+			require.Equal(t, endedHeights[expectedEpoch], resp.BtcLightClientHeight)
+		}
+	})
+}
+
+func FuzzQueryMonitorSummary(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		mk := babylonApp.MonitorKeeper
+		ck := babylonApp.CheckpointingKeeper
+
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, mk)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		// no epoch has ended yet
+		resp, err := queryClient.MonitorSummary(ctx, &types.QueryMonitorSummaryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryMonitorSummaryResponse{}, resp)
+
+		// end a random number of epochs, and report the checkpoints of some
+		// of them
+		numEpochs := datagen.RandomInt(r, 10) + 1
+		expected := &types.QueryMonitorSummaryResponse{EndedEpochs: numEpochs}
+		for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+			mk.Hooks().AfterEpochEnds(ctx, epoch)
+
+			ckpt := datagen.GenRandomRawCheckpoint(r)
+			ckpt.EpochNum = epoch
+			err := ck.AddRawCheckpoint(ctx, ckpttypes.NewCheckpointWithMeta(ckpt, ckpttypes.Sealed))
+			require.NoError(t, err)
+
+			if datagen.OneInN(r, 2) {
+				err := mk.Hooks().AfterRawCheckpointBlsSigVerified(ctx, ckpt)
+				require.NoError(t, err)
+				expected.ReportedEpochs++
+				expected.LatestReportedEpoch = epoch
+			}
+		}
+
+		resp, err = queryClient.MonitorSummary(ctx, &types.QueryMonitorSummaryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expected, resp)
+	})
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/app"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+func FuzzAfterEpochEndsHook(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		ek := babylonApp.EpochingKeeper
+		mk := babylonApp.MonitorKeeper
+
+		epochNum := datagen.RandomInt(r, 10) + 1
+
+		// the epoch has not ended yet
+		_, err := mk.LightclientHeightAtEpochEnd(ctx, epochNum)
+		require.ErrorIs(t, err, types.ErrEpochNotEnded)
+
+		root := lck.GetBaseBTCHeader(ctx)
+		chain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			root.Header.ToBlockHeader(),
+			nil,
+			uint32(datagen.RandomInt(r, 10))+1,
+		)
+		headerBytes := datagen.HeaderToHeaderBytes(chain)
+		err = lck.InsertHeadersWithHookAndEvents(ctx, headerBytes)
+		require.NoError(t, err)
+
+		// the epoching module fires the hook registered by the monitor module
+		ek.AfterEpochEnds(ctx, epochNum)
+
+		btcHeight, err := mk.LightclientHeightAtEpochEnd(ctx, epochNum)
+		require.NoError(t, err)
+		require.Equal(t, lck.GetTipInfo(ctx).Height, btcHeight)
+	})
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+// RegisterInvariants registers the monitor module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reported-checkpoint-btc-height", ReportedCheckpointBtcHeightInvariant(k))
+}
+
+// ReportedCheckpointBtcHeightInvariant checks that, for every ended epoch whose
+// checkpoint is reported, the BTC light client height at which the checkpoint
+// is reported is no lower than the BTC light client height at the epoch end
+func ReportedCheckpointBtcHeightInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+		store := prefix.NewStore(storeAdapter, types.EpochEndLightClientHeightPrefix)
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			epoch := sdk.BigEndianToUint64(iter.Key())
+			endedHeight, err := bytesToBtcHeight(iter.Value())
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tepoch %d has an invalid ended BTC height: %v\n", epoch, err)
+				continue
+			}
+
+			// the checkpoint of the epoch is not sealed yet
+			ckpt, err := k.checkpointingKeeper.GetRawCheckpoint(ctx, epoch)
+			if err != nil {
+				continue
+			}
+
+			reportedHeight, err := k.LightclientHeightAtCheckpointReported(ctx, ckpt.Ckpt.HashStr())
+			if err != nil {
+				// the checkpoint of the epoch is not reported yet
+				continue
+			}
+
+			if reportedHeight < endedHeight {
+				count++
+				msg += fmt.Sprintf("\tepoch %d has its checkpoint reported at BTC height %d, lower than the ended BTC height %d\n",
+					epoch, reportedHeight, endedHeight)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "reported-checkpoint-btc-height",
+			fmt.Sprintf("amount of epochs with checkpoint reported before the epoch end found %d\n%s", count, msg),
+		), broken
+	}
+}
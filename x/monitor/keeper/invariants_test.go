@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/app"
+	"github.com/babylonlabs-io/babylon/btctxformatter"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/babylonlabs-io/babylon/testutil/mocks"
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+	types2 "github.com/babylonlabs-io/babylon/x/epoching/types"
+	"github.com/babylonlabs-io/babylon/x/monitor/keeper"
+)
+
+func FuzzReportedCheckpointBtcHeightInvariant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+		ck := babylonApp.CheckpointingKeeper
+		mockEk := mocks.NewMockEpochingKeeper(ctl)
+		ck.SetEpochingKeeper(mockEk)
+		invariant := keeper.ReportedCheckpointBtcHeightInvariant(mk)
+
+		insertHeaders := func() {
+			chain := datagen.GenRandomValidChainStartingFrom(
+				r,
+				lck.GetTipInfo(ctx).Header.ToBlockHeader(),
+				nil,
+				uint32(datagen.RandomInt(r, 5)+1),
+			)
+			err := lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(chain))
+			require.NoError(t, err)
+		}
+
+		// generate a checkpoint and end its epoch
+		valBlsSet, privKeys := datagen.GenerateValidatorSetWithBLSPrivKeys(int(datagen.RandomIntOtherThan(r, 0, 10)))
+		valSet := make([]types2.Validator, len(valBlsSet.ValSet))
+		for i, val := range valBlsSet.ValSet {
+			valSet[i] = types2.Validator{
+				Addr:  []byte(val.ValidatorAddress),
+				Power: int64(val.VotingPower),
+			}
+			err := ck.CreateRegistration(ctx, val.BlsPubKey, []byte(val.ValidatorAddress))
+			require.NoError(t, err)
+		}
+		mockCkptWithMeta := &ckpttypes.RawCheckpointWithMeta{Ckpt: datagen.GenerateLegitimateRawCheckpoint(r, privKeys)}
+		epoch := mockCkptWithMeta.Ckpt.EpochNum
+		mockEk.EXPECT().GetValidatorSet(gomock.Any(), gomock.Eq(epoch)).Return(valSet).AnyTimes()
+		// make sure voting power is always sufficient
+		mockEk.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Eq(epoch)).Return(int64(0)).AnyTimes()
+		mk.Hooks().AfterEpochEnds(ctx, epoch)
+
+		// the invariant holds if the checkpoint is not sealed yet
+		_, broken := invariant(ctx)
+		require.False(t, broken)
+
+		err := ck.AddRawCheckpoint(ctx, mockCkptWithMeta)
+		require.NoError(t, err)
+
+		// the invariant holds if the checkpoint is not reported yet
+		_, broken = invariant(ctx)
+		require.False(t, broken)
+
+		// report the checkpoint after BTC light client has progressed
+		insertHeaders()
+		btcCkpt := btctxformatter.RawBtcCheckpoint{
+			Epoch:            epoch,
+			BlockHash:        *mockCkptWithMeta.Ckpt.BlockHash,
+			BitMap:           mockCkptWithMeta.Ckpt.Bitmap,
+			SubmitterAddress: datagen.GenRandomByteArray(r, btctxformatter.AddressLength),
+			BlsSig:           *mockCkptWithMeta.Ckpt.BlsMultiSig,
+		}
+		err = ck.VerifyCheckpoint(ctx, btcCkpt)
+		require.NoError(t, err)
+
+		// the invariant holds as the checkpoint is reported after the epoch end
+		_, broken = invariant(ctx)
+		require.False(t, broken)
+
+		// corrupt the ended BTC height of the epoch such that it is higher
+		// than the reported BTC height of its checkpoint
+		insertHeaders()
+		mk.Hooks().AfterEpochEnds(ctx, epoch)
+
+		msg, broken := invariant(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, fmt.Sprintf("epoch %d ", epoch))
+	})
+}
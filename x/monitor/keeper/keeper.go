@@ -19,6 +19,7 @@ type (
 		cdc                  codec.BinaryCodec
 		storeService         corestoretypes.KVStoreService
 		btcLightClientKeeper types.BTCLightClientKeeper
+		checkpointingKeeper  types.CheckpointingKeeper
 	}
 )
 
@@ -26,11 +27,13 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
 	bk types.BTCLightClientKeeper,
+	ck types.CheckpointingKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:                  cdc,
 		storeService:         storeService,
 		btcLightClientKeeper: bk,
+		checkpointingKeeper:  ck,
 	}
 }
 
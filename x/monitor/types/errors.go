@@ -8,4 +8,5 @@ import (
 var (
 	ErrEpochNotEnded         = errorsmod.Register(ModuleName, 1100, "Epoch not ended yet")
 	ErrCheckpointNotReported = errorsmod.Register(ModuleName, 1101, "Checkpoint not reported yet")
+	ErrNoEpochEndedByHeight  = errorsmod.Register(ModuleName, 1102, "No epoch ended by the given BTC height")
 )
@@ -2,10 +2,16 @@ package types
 
 import (
 	"context"
+
 	lc "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
 )
 
 type BTCLightClientKeeper interface {
 	GetTipInfo(ctx context.Context) *lc.BTCHeaderInfo
 	GetBaseBTCHeader(ctx context.Context) *lc.BTCHeaderInfo
 }
+
+type CheckpointingKeeper interface {
+	GetRawCheckpoint(ctx context.Context, epochNum uint64) (*ckpttypes.RawCheckpointWithMeta, error)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -219,350 +220,2392 @@ func (m *QueryReportedCheckpointBtcHeightResponse) GetBtcLightClientHeight() uin
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryEndedEpochBtcHeightRequest)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightRequest")
-	proto.RegisterType((*QueryEndedEpochBtcHeightResponse)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightResponse")
-	proto.RegisterType((*QueryReportedCheckpointBtcHeightRequest)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightRequest")
-	proto.RegisterType((*QueryReportedCheckpointBtcHeightResponse)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightResponse")
+// QueryAllEndedEpochsRequest defines a query type for AllEndedEpochs RPC
+// method
+type QueryAllEndedEpochsRequest struct {
+	// pagination defines an optional pagination for the request. The key of
+	// the pagination is the big-endian encoding of the epoch number
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/monitor/v1/query.proto", fileDescriptor_a8aafb034c55a8f2) }
-
-var fileDescriptor_a8aafb034c55a8f2 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0x13, 0x31,
-	0x18, 0xed, 0xf8, 0x8b, 0x36, 0xe0, 0x25, 0x0a, 0x4a, 0x2b, 0x63, 0x99, 0x83, 0x16, 0xa4, 0x13,
-	0x6a, 0xf5, 0xa4, 0x78, 0x68, 0xa9, 0x14, 0x14, 0xc1, 0xb9, 0xe9, 0x65, 0xc8, 0xa4, 0x61, 0x12,
-	0x3a, 0x93, 0xa4, 0x93, 0x4c, 0xb1, 0x94, 0x5e, 0x3c, 0x7a, 0x12, 0xfc, 0x47, 0xfc, 0x33, 0xbc,
-	0x08, 0x05, 0x2f, 0x1e, 0x97, 0x76, 0xff, 0x90, 0x65, 0xd2, 0xee, 0x5c, 0x76, 0xba, 0x65, 0x77,
-	0x8f, 0xf9, 0x5e, 0xde, 0xfb, 0xbe, 0xf7, 0xbd, 0x04, 0xb8, 0x11, 0x8e, 0x16, 0x89, 0x14, 0x28,
-	0x95, 0x82, 0x1b, 0x99, 0xa1, 0x79, 0x0f, 0xcd, 0x72, 0x9a, 0x2d, 0x7c, 0x95, 0x49, 0x23, 0x21,
-	0xdc, 0xe3, 0xfe, 0x1e, 0xf7, 0xe7, 0xbd, 0xe6, 0x93, 0x58, 0xca, 0x38, 0xa1, 0x08, 0x2b, 0x8e,
-	0xb0, 0x10, 0xd2, 0x60, 0xc3, 0xa5, 0xd0, 0x3b, 0x86, 0xf7, 0x0e, 0x3c, 0xfd, 0x5c, 0x08, 0x8c,
-	0xc4, 0x84, 0x4e, 0x46, 0x4a, 0x12, 0x36, 0x30, 0x64, 0x4c, 0x79, 0xcc, 0x4c, 0x40, 0x67, 0x39,
-	0xd5, 0x06, 0xb6, 0x40, 0x83, 0x16, 0x40, 0x28, 0xf2, 0xf4, 0xb1, 0xd3, 0x76, 0x3a, 0x77, 0x82,
-	0xba, 0x2d, 0x7c, 0xca, 0x53, 0xef, 0x0b, 0x68, 0x1f, 0xe6, 0x6b, 0x25, 0x85, 0xa6, 0xf0, 0x35,
-	0x78, 0x14, 0x19, 0x12, 0x26, 0x45, 0x31, 0x24, 0x09, 0xa7, 0xc2, 0x84, 0xcc, 0x5e, 0xb1, 0x72,
-	0xf7, 0x83, 0x87, 0x91, 0x21, 0x1f, 0x8b, 0xf3, 0xd0, 0x82, 0x3b, 0xba, 0xf7, 0x1e, 0x3c, 0xb7,
-	0xd2, 0x01, 0x55, 0x32, 0x33, 0x74, 0x32, 0x64, 0x94, 0x4c, 0x95, 0xe4, 0xc2, 0x54, 0x8d, 0x48,
-	0xa6, 0xca, 0x84, 0x0c, 0x6b, 0x66, 0x35, 0x1b, 0x41, 0xbd, 0x28, 0x8c, 0xb1, 0x66, 0x1e, 0x06,
-	0x9d, 0xe3, 0x3a, 0x37, 0x1a, 0xf5, 0xe5, 0x8f, 0xdb, 0xe0, 0xae, 0xed, 0x01, 0x7f, 0x3b, 0xe0,
-	0x41, 0xc5, 0x2e, 0x60, 0xdf, 0xbf, 0x18, 0x8d, 0x7f, 0x64, 0xf3, 0xcd, 0x57, 0x57, 0x23, 0xed,
-	0x3c, 0x78, 0xfe, 0xf7, 0x7f, 0xa7, 0xbf, 0x6e, 0x75, 0xe0, 0x33, 0x54, 0xf1, 0x5a, 0x6c, 0x70,
-	0x1a, 0x2d, 0xcb, 0x44, 0x57, 0xf0, 0xaf, 0x03, 0x5a, 0x97, 0xec, 0x06, 0xbe, 0x39, 0x38, 0xc5,
-	0xf1, 0x64, 0x9a, 0x6f, 0xaf, 0x47, 0xde, 0x5b, 0xe9, 0x5b, 0x2b, 0x5d, 0xf8, 0xa2, 0xca, 0x0a,
-	0x29, 0x89, 0x1a, 0x2d, 0xcb, 0xf8, 0x57, 0x83, 0x0f, 0x7f, 0x36, 0xae, 0xb3, 0xde, 0xb8, 0xce,
-	0xc9, 0xc6, 0x75, 0x7e, 0x6e, 0xdd, 0xda, 0x7a, 0xeb, 0xd6, 0xfe, 0x6f, 0xdd, 0xda, 0xd7, 0x5e,
-	0xcc, 0x0d, 0xcb, 0x23, 0x9f, 0xc8, 0xf4, 0x5c, 0x30, 0xc1, 0x91, 0xee, 0x72, 0x59, 0xea, 0x7f,
-	0x2b, 0x3b, 0x98, 0x85, 0xa2, 0x3a, 0xba, 0x67, 0xbf, 0x49, 0xff, 0x2c, 0x00, 0x00, 0xff, 0xff,
-	0x85, 0xb8, 0x2a, 0x58, 0x7a, 0x03, 0x00, 0x00,
+func (m *QueryAllEndedEpochsRequest) Reset()         { *m = QueryAllEndedEpochsRequest{} }
+func (m *QueryAllEndedEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllEndedEpochsRequest) ProtoMessage()    {}
+func (*QueryAllEndedEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{4}
+}
+func (m *QueryAllEndedEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllEndedEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllEndedEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllEndedEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllEndedEpochsRequest.Merge(m, src)
+}
+func (m *QueryAllEndedEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllEndedEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllEndedEpochsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAllEndedEpochsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
-	// finish
-	EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error)
-	// ReportedCheckpointBtcHeight returns the BTC light client height at which
-	// the checkpoint with the given hash is reported back to Babylon
-	ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error)
+func (m *QueryAllEndedEpochsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAllEndedEpochsResponse defines a response type for AllEndedEpochs RPC
+// method
+type QueryAllEndedEpochsResponse struct {
+	// ended_epochs is the list of ended epochs in ascending order of epoch
+	// number
+	EndedEpochs []*EndedEpoch `protobuf:"bytes,1,rep,name=ended_epochs,json=endedEpochs,proto3" json:"ended_epochs,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllEndedEpochsResponse) Reset()         { *m = QueryAllEndedEpochsResponse{} }
+func (m *QueryAllEndedEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllEndedEpochsResponse) ProtoMessage()    {}
+func (*QueryAllEndedEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{5}
 }
-
-func (c *queryClient) EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error) {
-	out := new(QueryEndedEpochBtcHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/EndedEpochBtcHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllEndedEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllEndedEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllEndedEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllEndedEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllEndedEpochsResponse.Merge(m, src)
+}
+func (m *QueryAllEndedEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllEndedEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllEndedEpochsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error) {
-	out := new(QueryReportedCheckpointBtcHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/ReportedCheckpointBtcHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllEndedEpochsResponse proto.InternalMessageInfo
+
+func (m *QueryAllEndedEpochsResponse) GetEndedEpochs() []*EndedEpoch {
+	if m != nil {
+		return m.EndedEpochs
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
-	// finish
-	EndedEpochBtcHeight(context.Context, *QueryEndedEpochBtcHeightRequest) (*QueryEndedEpochBtcHeightResponse, error)
-	// ReportedCheckpointBtcHeight returns the BTC light client height at which
-	// the checkpoint with the given hash is reported back to Babylon
-	ReportedCheckpointBtcHeight(context.Context, *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error)
+func (m *QueryAllEndedEpochsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// EndedEpoch is an ended epoch together with the BTC light client height at
+// its end
+type EndedEpoch struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// height of btc light client when epoch ended
+	BtcLightClientHeight uint32 `protobuf:"varint,2,opt,name=btc_light_client_height,json=btcLightClientHeight,proto3" json:"btc_light_client_height,omitempty"`
 }
 
-func (*UnimplementedQueryServer) EndedEpochBtcHeight(ctx context.Context, req *QueryEndedEpochBtcHeightRequest) (*QueryEndedEpochBtcHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EndedEpochBtcHeight not implemented")
+func (m *EndedEpoch) Reset()         { *m = EndedEpoch{} }
+func (m *EndedEpoch) String() string { return proto.CompactTextString(m) }
+func (*EndedEpoch) ProtoMessage()    {}
+func (*EndedEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{6}
 }
-func (*UnimplementedQueryServer) ReportedCheckpointBtcHeight(ctx context.Context, req *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportedCheckpointBtcHeight not implemented")
+func (m *EndedEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *EndedEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndedEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndedEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndedEpoch.Merge(m, src)
+}
+func (m *EndedEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndedEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndedEpoch.DiscardUnknown(m)
 }
 
-func _Query_EndedEpochBtcHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEndedEpochBtcHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EndedEpochBtcHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.monitor.v1.Query/EndedEpochBtcHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EndedEpochBtcHeight(ctx, req.(*QueryEndedEpochBtcHeightRequest))
+var xxx_messageInfo_EndedEpoch proto.InternalMessageInfo
+
+func (m *EndedEpoch) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ReportedCheckpointBtcHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReportedCheckpointBtcHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReportedCheckpointBtcHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.monitor.v1.Query/ReportedCheckpointBtcHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReportedCheckpointBtcHeight(ctx, req.(*QueryReportedCheckpointBtcHeightRequest))
+func (m *EndedEpoch) GetBtcLightClientHeight() uint32 {
+	if m != nil {
+		return m.BtcLightClientHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.monitor.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EndedEpochBtcHeight",
-			Handler:    _Query_EndedEpochBtcHeight_Handler,
-		},
-		{
-			MethodName: "ReportedCheckpointBtcHeight",
-			Handler:    _Query_ReportedCheckpointBtcHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/monitor/v1/query.proto",
+// QueryCheckpointReportingLagSeriesRequest defines a query type for
+// CheckpointReportingLagSeries RPC method
+type QueryCheckpointReportingLagSeriesRequest struct {
+	// from_epoch is the first epoch of the range, inclusive
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// to_epoch is the last epoch of the range, inclusive
+	ToEpoch uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
 }
 
-func (m *QueryEndedEpochBtcHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCheckpointReportingLagSeriesRequest) Reset() {
+	*m = QueryCheckpointReportingLagSeriesRequest{}
+}
+func (m *QueryCheckpointReportingLagSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointReportingLagSeriesRequest) ProtoMessage()    {}
+func (*QueryCheckpointReportingLagSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{7}
+}
+func (m *QueryCheckpointReportingLagSeriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointReportingLagSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointReportingLagSeriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEndedEpochBtcHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCheckpointReportingLagSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointReportingLagSeriesRequest.Merge(m, src)
+}
+func (m *QueryCheckpointReportingLagSeriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointReportingLagSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointReportingLagSeriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEndedEpochBtcHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryCheckpointReportingLagSeriesRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointReportingLagSeriesRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryEndedEpochBtcHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCheckpointReportingLagSeriesRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEndedEpochBtcHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointReportingLagSeriesResponse defines a response type for
+// CheckpointReportingLagSeries RPC method
+type QueryCheckpointReportingLagSeriesResponse struct {
+	// lags is the list of checkpoint reporting lags in ascending order of
+	// epoch number
+	Lags []*CheckpointReportingLag `protobuf:"bytes,1,rep,name=lags,proto3" json:"lags,omitempty"`
 }
 
-func (m *QueryEndedEpochBtcHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcLightClientHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BtcLightClientHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCheckpointReportingLagSeriesResponse) Reset() {
+	*m = QueryCheckpointReportingLagSeriesResponse{}
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCheckpointReportingLagSeriesResponse) ProtoMessage() {}
+func (*QueryCheckpointReportingLagSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{8}
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointReportingLagSeriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointReportingLagSeriesResponse.Merge(m, src)
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointReportingLagSeriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReportedCheckpointBtcHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointReportingLagSeriesResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointReportingLagSeriesResponse) GetLags() []*CheckpointReportingLag {
+	if m != nil {
+		return m.Lags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryReportedCheckpointBtcHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckpointReportingLag is the reporting lag of the checkpoint of an ended
+// epoch
+type CheckpointReportingLag struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// height of btc light client when epoch ended
+	EndedEpochBtcHeight uint32 `protobuf:"varint,2,opt,name=ended_epoch_btc_height,json=endedEpochBtcHeight,proto3" json:"ended_epoch_btc_height,omitempty"`
+	// reported is whether the checkpoint of the epoch is reported back to
+	// Babylon yet
+	Reported bool `protobuf:"varint,3,opt,name=reported,proto3" json:"reported,omitempty"`
+	// height of btc light client when the checkpoint is reported. It is 0 if
+	// the checkpoint is not reported yet
+	ReportedBtcHeight uint32 `protobuf:"varint,4,opt,name=reported_btc_height,json=reportedBtcHeight,proto3" json:"reported_btc_height,omitempty"`
+	// lag is the number of BTC blocks between the end of the epoch and the
+	// report of its checkpoint. It is 0 if the checkpoint is not reported yet
+	Lag uint32 `protobuf:"varint,5,opt,name=lag,proto3" json:"lag,omitempty"`
 }
 
-func (m *QueryReportedCheckpointBtcHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CkptHash) > 0 {
-		i -= len(m.CkptHash)
-		copy(dAtA[i:], m.CkptHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CkptHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *CheckpointReportingLag) Reset()         { *m = CheckpointReportingLag{} }
+func (m *CheckpointReportingLag) String() string { return proto.CompactTextString(m) }
+func (*CheckpointReportingLag) ProtoMessage()    {}
+func (*CheckpointReportingLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{9}
+}
+func (m *CheckpointReportingLag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointReportingLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointReportingLag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CheckpointReportingLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointReportingLag.Merge(m, src)
+}
+func (m *CheckpointReportingLag) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointReportingLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointReportingLag.DiscardUnknown(m)
 }
 
-func (m *QueryReportedCheckpointBtcHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointReportingLag proto.InternalMessageInfo
+
+func (m *CheckpointReportingLag) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryReportedCheckpointBtcHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointReportingLag) GetEndedEpochBtcHeight() uint32 {
+	if m != nil {
+		return m.EndedEpochBtcHeight
+	}
+	return 0
 }
 
-func (m *QueryReportedCheckpointBtcHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcLightClientHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BtcLightClientHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *CheckpointReportingLag) GetReported() bool {
+	if m != nil {
+		return m.Reported
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CheckpointReportingLag) GetReportedBtcHeight() uint32 {
+	if m != nil {
+		return m.ReportedBtcHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryEndedEpochBtcHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CheckpointReportingLag) GetLag() uint32 {
+	if m != nil {
+		return m.Lag
 	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+	return 0
+}
+
+// QueryEpochForBtcHeightRequest defines a query type for EpochForBtcHeight
+// RPC method
+type QueryEpochForBtcHeightRequest struct {
+	BtcHeight uint32 `protobuf:"varint,1,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+}
+
+func (m *QueryEpochForBtcHeightRequest) Reset()         { *m = QueryEpochForBtcHeightRequest{} }
+func (m *QueryEpochForBtcHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochForBtcHeightRequest) ProtoMessage()    {}
+func (*QueryEpochForBtcHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{10}
+}
+func (m *QueryEpochForBtcHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochForBtcHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochForBtcHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryEpochForBtcHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochForBtcHeightRequest.Merge(m, src)
+}
+func (m *QueryEpochForBtcHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochForBtcHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochForBtcHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEndedEpochBtcHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEpochForBtcHeightRequest proto.InternalMessageInfo
+
+func (m *QueryEpochForBtcHeightRequest) GetBtcHeight() uint32 {
+	if m != nil {
+		return m.BtcHeight
 	}
-	var l int
-	_ = l
-	if m.BtcLightClientHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	return 0
+}
+
+// QueryEpochForBtcHeightResponse defines a response type for
+// EpochForBtcHeight RPC method
+type QueryEpochForBtcHeightResponse struct {
+	// epoch_num is the latest epoch that had ended by the given BTC light
+	// client height
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// height of btc light client when the epoch ended
+	BtcLightClientHeight uint32 `protobuf:"varint,2,opt,name=btc_light_client_height,json=btcLightClientHeight,proto3" json:"btc_light_client_height,omitempty"`
+}
+
+func (m *QueryEpochForBtcHeightResponse) Reset()         { *m = QueryEpochForBtcHeightResponse{} }
+func (m *QueryEpochForBtcHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochForBtcHeightResponse) ProtoMessage()    {}
+func (*QueryEpochForBtcHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{11}
+}
+func (m *QueryEpochForBtcHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochForBtcHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochForBtcHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryEpochForBtcHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochForBtcHeightResponse.Merge(m, src)
+}
+func (m *QueryEpochForBtcHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochForBtcHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochForBtcHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReportedCheckpointBtcHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEpochForBtcHeightResponse proto.InternalMessageInfo
+
+func (m *QueryEpochForBtcHeightResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	var l int
-	_ = l
-	l = len(m.CkptHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryEpochForBtcHeightResponse) GetBtcLightClientHeight() uint32 {
+	if m != nil {
+		return m.BtcLightClientHeight
+	}
+	return 0
+}
+
+// QueryMonitorSummaryRequest defines a query type for MonitorSummary RPC
+// method
+type QueryMonitorSummaryRequest struct {
+}
+
+func (m *QueryMonitorSummaryRequest) Reset()         { *m = QueryMonitorSummaryRequest{} }
+func (m *QueryMonitorSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMonitorSummaryRequest) ProtoMessage()    {}
+func (*QueryMonitorSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{12}
+}
+func (m *QueryMonitorSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMonitorSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMonitorSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMonitorSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMonitorSummaryRequest.Merge(m, src)
+}
+func (m *QueryMonitorSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMonitorSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMonitorSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMonitorSummaryRequest proto.InternalMessageInfo
+
+// QueryMonitorSummaryResponse defines a response type for MonitorSummary RPC
+// method
+type QueryMonitorSummaryResponse struct {
+	// ended_epochs is the number of ended epochs recorded by the module
+	EndedEpochs uint64 `protobuf:"varint,1,opt,name=ended_epochs,json=endedEpochs,proto3" json:"ended_epochs,omitempty"`
+	// reported_epochs is the number of ended epochs whose checkpoint is
+	// reported back to Babylon
+	ReportedEpochs uint64 `protobuf:"varint,2,opt,name=reported_epochs,json=reportedEpochs,proto3" json:"reported_epochs,omitempty"`
+	// latest_reported_epoch is the latest ended epoch whose checkpoint is
+	// reported back to Babylon. It is 0 if no checkpoint is reported yet
+	LatestReportedEpoch uint64 `protobuf:"varint,3,opt,name=latest_reported_epoch,json=latestReportedEpoch,proto3" json:"latest_reported_epoch,omitempty"`
+}
+
+func (m *QueryMonitorSummaryResponse) Reset()         { *m = QueryMonitorSummaryResponse{} }
+func (m *QueryMonitorSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMonitorSummaryResponse) ProtoMessage()    {}
+func (*QueryMonitorSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{13}
+}
+func (m *QueryMonitorSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMonitorSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMonitorSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMonitorSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMonitorSummaryResponse.Merge(m, src)
+}
+func (m *QueryMonitorSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMonitorSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMonitorSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMonitorSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryMonitorSummaryResponse) GetEndedEpochs() uint64 {
+	if m != nil {
+		return m.EndedEpochs
+	}
+	return 0
+}
+
+func (m *QueryMonitorSummaryResponse) GetReportedEpochs() uint64 {
+	if m != nil {
+		return m.ReportedEpochs
+	}
+	return 0
+}
+
+func (m *QueryMonitorSummaryResponse) GetLatestReportedEpoch() uint64 {
+	if m != nil {
+		return m.LatestReportedEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryEndedEpochBtcHeightRequest)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightRequest")
+	proto.RegisterType((*QueryEndedEpochBtcHeightResponse)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightResponse")
+	proto.RegisterType((*QueryReportedCheckpointBtcHeightRequest)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightRequest")
+	proto.RegisterType((*QueryReportedCheckpointBtcHeightResponse)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightResponse")
+	proto.RegisterType((*QueryAllEndedEpochsRequest)(nil), "babylon.monitor.v1.QueryAllEndedEpochsRequest")
+	proto.RegisterType((*QueryAllEndedEpochsResponse)(nil), "babylon.monitor.v1.QueryAllEndedEpochsResponse")
+	proto.RegisterType((*EndedEpoch)(nil), "babylon.monitor.v1.EndedEpoch")
+	proto.RegisterType((*QueryCheckpointReportingLagSeriesRequest)(nil), "babylon.monitor.v1.QueryCheckpointReportingLagSeriesRequest")
+	proto.RegisterType((*QueryCheckpointReportingLagSeriesResponse)(nil), "babylon.monitor.v1.QueryCheckpointReportingLagSeriesResponse")
+	proto.RegisterType((*CheckpointReportingLag)(nil), "babylon.monitor.v1.CheckpointReportingLag")
+	proto.RegisterType((*QueryEpochForBtcHeightRequest)(nil), "babylon.monitor.v1.QueryEpochForBtcHeightRequest")
+	proto.RegisterType((*QueryEpochForBtcHeightResponse)(nil), "babylon.monitor.v1.QueryEpochForBtcHeightResponse")
+	proto.RegisterType((*QueryMonitorSummaryRequest)(nil), "babylon.monitor.v1.QueryMonitorSummaryRequest")
+	proto.RegisterType((*QueryMonitorSummaryResponse)(nil), "babylon.monitor.v1.QueryMonitorSummaryResponse")
+}
+
+func init() { proto.RegisterFile("babylon/monitor/v1/query.proto", fileDescriptor_a8aafb034c55a8f2) }
+
+var fileDescriptor_a8aafb034c55a8f2 = []byte{
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x93, 0x14, 0x36, 0x2f, 0x10, 0xe8, 0x04, 0x4a, 0x70, 0x12, 0x13, 0x8c, 0xd4, 0x2c,
+	0x45, 0xf5, 0x68, 0x37, 0xe5, 0xc2, 0x9f, 0x48, 0x6d, 0x69, 0xa8, 0x44, 0x40, 0xe0, 0x9e, 0xe0,
+	0x62, 0xc6, 0xce, 0xe0, 0xb5, 0xe2, 0xf5, 0xb8, 0x9e, 0xd9, 0x88, 0x68, 0xb5, 0x17, 0x3e, 0x01,
+	0x12, 0x82, 0x1b, 0x1f, 0x80, 0x1b, 0x27, 0x3e, 0x43, 0x2f, 0x48, 0x95, 0xb8, 0x70, 0xac, 0x12,
+	0x2e, 0x7c, 0x0b, 0xe4, 0x99, 0xb1, 0xbd, 0xcb, 0xda, 0x4e, 0x5a, 0xc4, 0x6d, 0x3d, 0xef, 0xcf,
+	0xfc, 0xde, 0xef, 0xf7, 0xde, 0x9b, 0x05, 0xcb, 0x27, 0xfe, 0x69, 0xcc, 0x12, 0x3c, 0x64, 0x49,
+	0x24, 0x58, 0x86, 0x4f, 0x7a, 0xf8, 0xe1, 0x88, 0x66, 0xa7, 0x4e, 0x9a, 0x31, 0xc1, 0x10, 0xd2,
+	0x76, 0x47, 0xdb, 0x9d, 0x93, 0x9e, 0xb9, 0x15, 0x32, 0x16, 0xc6, 0x14, 0x93, 0x34, 0xc2, 0x24,
+	0x49, 0x98, 0x20, 0x22, 0x62, 0x09, 0x57, 0x11, 0xe6, 0x8d, 0x80, 0xf1, 0x21, 0xe3, 0xd8, 0x27,
+	0x9c, 0xaa, 0x54, 0xf8, 0xa4, 0xe7, 0x53, 0x41, 0x7a, 0x38, 0x25, 0x61, 0x94, 0x48, 0x67, 0xe5,
+	0x6b, 0xef, 0xc3, 0x1b, 0x5f, 0xe4, 0x1e, 0xf7, 0x92, 0x23, 0x7a, 0x74, 0x2f, 0x65, 0xc1, 0xe0,
+	0x8e, 0x08, 0xee, 0xd3, 0x28, 0x1c, 0x08, 0x97, 0x3e, 0x1c, 0x51, 0x2e, 0xd0, 0x26, 0xac, 0xd0,
+	0xdc, 0xe0, 0x25, 0xa3, 0xe1, 0x86, 0xb1, 0x63, 0x74, 0x97, 0xdd, 0x8e, 0x3c, 0xf8, 0x6c, 0x34,
+	0xb4, 0xbf, 0x84, 0x9d, 0xe6, 0x78, 0x9e, 0xb2, 0x84, 0x53, 0xf4, 0x2e, 0xbc, 0xe6, 0x8b, 0xc0,
+	0x8b, 0xf3, 0x43, 0x2f, 0x88, 0x23, 0x9a, 0x08, 0x6f, 0x20, 0x5d, 0x64, 0xba, 0x17, 0xdd, 0x57,
+	0x7c, 0x11, 0x1c, 0xe6, 0xdf, 0x77, 0xa5, 0x51, 0x85, 0xdb, 0x07, 0xb0, 0x2b, 0x53, 0xbb, 0x34,
+	0x65, 0x99, 0xa0, 0x47, 0x77, 0x07, 0x34, 0x38, 0x4e, 0x59, 0x94, 0x88, 0x3a, 0x88, 0xc1, 0x71,
+	0x2a, 0xbc, 0x01, 0xe1, 0x03, 0x99, 0x73, 0xc5, 0xed, 0xe4, 0x07, 0xf7, 0x09, 0x1f, 0xd8, 0x04,
+	0xba, 0x17, 0xe7, 0xf9, 0x6f, 0x50, 0x8f, 0xc0, 0x94, 0x57, 0xdc, 0x8e, 0xe3, 0x8a, 0x08, 0x5e,
+	0xa0, 0x3b, 0x00, 0xa8, 0x78, 0x97, 0x79, 0x56, 0xfb, 0xd7, 0x1d, 0x25, 0x92, 0x93, 0x8b, 0xe4,
+	0x28, 0xbd, 0xb5, 0x48, 0xce, 0xe7, 0x24, 0xa4, 0x3a, 0xd6, 0x9d, 0x8a, 0xb4, 0x7f, 0x31, 0x60,
+	0xb3, 0xf6, 0x1a, 0x0d, 0xfe, 0x36, 0xbc, 0x40, 0xf3, 0x63, 0x4f, 0xaa, 0xc3, 0x37, 0x8c, 0x9d,
+	0xa5, 0xee, 0x6a, 0xdf, 0x72, 0xe6, 0x1b, 0xc8, 0xa9, 0xc2, 0xdd, 0x55, 0x5a, 0xa5, 0x42, 0x1f,
+	0xcf, 0x40, 0x5d, 0x94, 0x50, 0x77, 0x2f, 0x84, 0xaa, 0xee, 0x9f, 0xc1, 0xfa, 0x35, 0x40, 0x75,
+	0x47, 0x6b, 0x0b, 0xb5, 0x71, 0xbe, 0xd8, 0xca, 0xb9, 0x92, 0xb5, 0x92, 0x53, 0x09, 0x1c, 0x25,
+	0xe1, 0x21, 0x09, 0x1f, 0xd0, 0x2c, 0xa2, 0xa5, 0x02, 0xdb, 0x00, 0xdf, 0x64, 0x6c, 0xa8, 0x88,
+	0xd1, 0x00, 0x56, 0xf2, 0x13, 0x05, 0xef, 0x75, 0xe8, 0x08, 0xa6, 0x8d, 0x8b, 0xd2, 0xf8, 0xbc,
+	0x60, 0xd2, 0x64, 0x1f, 0xc3, 0xdb, 0x97, 0xb8, 0x45, 0x0b, 0xb0, 0x0f, 0xcb, 0x31, 0x09, 0x0b,
+	0xe2, 0x6f, 0xd4, 0x11, 0x5f, 0x9f, 0xc7, 0x95, 0x71, 0xf6, 0x23, 0x03, 0xae, 0xd5, 0x3b, 0xb4,
+	0x33, 0xb8, 0x07, 0xd7, 0xa6, 0x84, 0xf7, 0x72, 0x36, 0x67, 0x08, 0x5c, 0xa7, 0xf3, 0xd3, 0x89,
+	0x4c, 0xe8, 0x64, 0x7a, 0x22, 0x36, 0x96, 0x76, 0x8c, 0x6e, 0xc7, 0x2d, 0xbf, 0x91, 0x03, 0xeb,
+	0xc5, 0xef, 0xe9, 0x6c, 0xcb, 0x32, 0xdb, 0xd5, 0xc2, 0x54, 0xe5, 0x7a, 0x19, 0x96, 0x62, 0x12,
+	0x6e, 0x5c, 0x91, 0xf6, 0xfc, 0xa7, 0xbd, 0x0f, 0xdb, 0x6a, 0x2f, 0xe4, 0x97, 0x1e, 0xb0, 0x6c,
+	0x6e, 0x64, 0xb7, 0x01, 0xa6, 0x32, 0xab, 0xe1, 0x5a, 0xf1, 0x0b, 0x2f, 0x5b, 0x80, 0xd5, 0x14,
+	0xaf, 0xc9, 0xfe, 0x3f, 0x7a, 0x6a, 0x4b, 0xcf, 0xf1, 0xa7, 0x4a, 0xb0, 0x07, 0xa3, 0xe1, 0x90,
+	0x64, 0xa7, 0x1a, 0xb2, 0xfd, 0x73, 0x31, 0x7f, 0xff, 0x36, 0x6b, 0x44, 0x6f, 0xce, 0xcd, 0x5f,
+	0x0e, 0x6a, 0x66, 0xbe, 0x76, 0xe1, 0xa5, 0x92, 0x58, 0xed, 0xa5, 0x1a, 0x6e, 0xad, 0x38, 0xd6,
+	0x8e, 0x7d, 0x78, 0x35, 0x26, 0x82, 0x72, 0xe1, 0xcd, 0xfa, 0x4b, 0xa9, 0x96, 0xdd, 0x75, 0x65,
+	0x74, 0xa7, 0x83, 0xfa, 0x4f, 0x3a, 0x70, 0x45, 0xe2, 0x43, 0xbf, 0x1a, 0xb0, 0x5e, 0xb3, 0x91,
+	0xd1, 0x5e, 0x5d, 0x4b, 0x5e, 0xb0, 0xff, 0xcd, 0x5b, 0x4f, 0x17, 0xa4, 0xc8, 0xb0, 0x9d, 0xef,
+	0xfe, 0xf8, 0xeb, 0x87, 0xc5, 0x2e, 0xba, 0x8e, 0x6b, 0xde, 0x37, 0x55, 0x3a, 0x1e, 0x97, 0x02,
+	0x4e, 0xd0, 0xef, 0x06, 0x6c, 0xb6, 0x6c, 0x68, 0xf4, 0x7e, 0x23, 0x8a, 0x8b, 0xdf, 0x07, 0xf3,
+	0x83, 0x67, 0x0b, 0xd6, 0xa5, 0xec, 0xc9, 0x52, 0x6e, 0xa2, 0x77, 0xea, 0x4a, 0x09, 0xca, 0x40,
+	0x8e, 0xc7, 0xe5, 0x23, 0x34, 0x41, 0x3f, 0x1a, 0xb0, 0x36, 0xbb, 0xa7, 0x91, 0xd3, 0x88, 0xa2,
+	0xf6, 0xdd, 0x30, 0xf1, 0xa5, 0xfd, 0x35, 0x50, 0x5b, 0x02, 0xdd, 0x42, 0x66, 0x33, 0xe7, 0xe8,
+	0x6f, 0x03, 0xb6, 0xda, 0x96, 0x19, 0x6a, 0xe6, 0xea, 0x12, 0x9b, 0xd6, 0xfc, 0xf0, 0x19, 0xa3,
+	0x75, 0x05, 0x87, 0xb2, 0x82, 0x03, 0xf4, 0x51, 0x3b, 0xd5, 0x7a, 0x28, 0xa2, 0x24, 0xf4, 0x62,
+	0x12, 0xe2, 0x71, 0xb5, 0xdc, 0x27, 0x78, 0x5c, 0xac, 0xf2, 0x09, 0xfa, 0xcd, 0x80, 0xab, 0x73,
+	0x0b, 0x04, 0xf5, 0x9a, 0xfb, 0xb9, 0x61, 0x59, 0x99, 0xfd, 0xa7, 0x09, 0xd1, 0xa5, 0xbc, 0x27,
+	0x4b, 0xb9, 0x85, 0xfa, 0x75, 0xa5, 0x54, 0xab, 0x8f, 0xe3, 0x71, 0xf5, 0x31, 0x51, 0x2a, 0xa1,
+	0x9f, 0x0c, 0x58, 0x9b, 0x5d, 0x32, 0x2d, 0xcd, 0x53, 0xbb, 0xac, 0x4c, 0x7c, 0x69, 0x7f, 0x8d,
+	0xf7, 0x2d, 0x89, 0x77, 0x1b, 0x6d, 0xd6, 0xe1, 0xe5, 0xca, 0xf9, 0xce, 0x27, 0x8f, 0xce, 0x2c,
+	0xe3, 0xf1, 0x99, 0x65, 0x3c, 0x39, 0xb3, 0x8c, 0xef, 0xcf, 0xad, 0x85, 0xc7, 0xe7, 0xd6, 0xc2,
+	0x9f, 0xe7, 0xd6, 0xc2, 0x57, 0xbd, 0x30, 0x12, 0x83, 0x91, 0xef, 0x04, 0x6c, 0x58, 0x24, 0x88,
+	0x89, 0xcf, 0x6f, 0x46, 0xac, 0xcc, 0xf7, 0x6d, 0x99, 0x51, 0x9c, 0xa6, 0x94, 0xfb, 0xcf, 0xc9,
+	0xbf, 0xa0, 0x7b, 0xff, 0x0c, 0x00, 0x16, 0x09, 0xf3, 0xaf, 0x02, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
+	// finish
+	EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error)
+	// ReportedCheckpointBtcHeight returns the BTC light client height at which
+	// the checkpoint with the given hash is reported back to Babylon
+	ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error)
+	// AllEndedEpochs returns all ended epochs together with the BTC light client
+	// height at their end, in ascending order of epoch number
+	AllEndedEpochs(ctx context.Context, in *QueryAllEndedEpochsRequest, opts ...grpc.CallOption) (*QueryAllEndedEpochsResponse, error)
+	// CheckpointReportingLagSeries returns, for each epoch in the given range,
+	// the number of BTC blocks between the end of the epoch and the report of
+	// its checkpoint back to Babylon
+	CheckpointReportingLagSeries(ctx context.Context, in *QueryCheckpointReportingLagSeriesRequest, opts ...grpc.CallOption) (*QueryCheckpointReportingLagSeriesResponse, error)
+	// EpochForBtcHeight returns the latest epoch that had ended by the given
+	// BTC light client height, i.e., the latest epoch whose BTC light client
+	// height at its end is no larger than the given height
+	EpochForBtcHeight(ctx context.Context, in *QueryEpochForBtcHeightRequest, opts ...grpc.CallOption) (*QueryEpochForBtcHeightResponse, error)
+	// MonitorSummary returns the number of ended epochs, the number of those
+	// whose checkpoint is reported, and the latest epoch whose checkpoint is
+	// reported
+	MonitorSummary(ctx context.Context, in *QueryMonitorSummaryRequest, opts ...grpc.CallOption) (*QueryMonitorSummaryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error) {
+	out := new(QueryEndedEpochBtcHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/EndedEpochBtcHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error) {
+	out := new(QueryReportedCheckpointBtcHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/ReportedCheckpointBtcHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllEndedEpochs(ctx context.Context, in *QueryAllEndedEpochsRequest, opts ...grpc.CallOption) (*QueryAllEndedEpochsResponse, error) {
+	out := new(QueryAllEndedEpochsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/AllEndedEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointReportingLagSeries(ctx context.Context, in *QueryCheckpointReportingLagSeriesRequest, opts ...grpc.CallOption) (*QueryCheckpointReportingLagSeriesResponse, error) {
+	out := new(QueryCheckpointReportingLagSeriesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/CheckpointReportingLagSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochForBtcHeight(ctx context.Context, in *QueryEpochForBtcHeightRequest, opts ...grpc.CallOption) (*QueryEpochForBtcHeightResponse, error) {
+	out := new(QueryEpochForBtcHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/EpochForBtcHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MonitorSummary(ctx context.Context, in *QueryMonitorSummaryRequest, opts ...grpc.CallOption) (*QueryMonitorSummaryResponse, error) {
+	out := new(QueryMonitorSummaryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/MonitorSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
+	// finish
+	EndedEpochBtcHeight(context.Context, *QueryEndedEpochBtcHeightRequest) (*QueryEndedEpochBtcHeightResponse, error)
+	// ReportedCheckpointBtcHeight returns the BTC light client height at which
+	// the checkpoint with the given hash is reported back to Babylon
+	ReportedCheckpointBtcHeight(context.Context, *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error)
+	// AllEndedEpochs returns all ended epochs together with the BTC light client
+	// height at their end, in ascending order of epoch number
+	AllEndedEpochs(context.Context, *QueryAllEndedEpochsRequest) (*QueryAllEndedEpochsResponse, error)
+	// CheckpointReportingLagSeries returns, for each epoch in the given range,
+	// the number of BTC blocks between the end of the epoch and the report of
+	// its checkpoint back to Babylon
+	CheckpointReportingLagSeries(context.Context, *QueryCheckpointReportingLagSeriesRequest) (*QueryCheckpointReportingLagSeriesResponse, error)
+	// EpochForBtcHeight returns the latest epoch that had ended by the given
+	// BTC light client height, i.e., the latest epoch whose BTC light client
+	// height at its end is no larger than the given height
+	EpochForBtcHeight(context.Context, *QueryEpochForBtcHeightRequest) (*QueryEpochForBtcHeightResponse, error)
+	// MonitorSummary returns the number of ended epochs, the number of those
+	// whose checkpoint is reported, and the latest epoch whose checkpoint is
+	// reported
+	MonitorSummary(context.Context, *QueryMonitorSummaryRequest) (*QueryMonitorSummaryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) EndedEpochBtcHeight(ctx context.Context, req *QueryEndedEpochBtcHeightRequest) (*QueryEndedEpochBtcHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndedEpochBtcHeight not implemented")
+}
+func (*UnimplementedQueryServer) ReportedCheckpointBtcHeight(ctx context.Context, req *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportedCheckpointBtcHeight not implemented")
+}
+func (*UnimplementedQueryServer) AllEndedEpochs(ctx context.Context, req *QueryAllEndedEpochsRequest) (*QueryAllEndedEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllEndedEpochs not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointReportingLagSeries(ctx context.Context, req *QueryCheckpointReportingLagSeriesRequest) (*QueryCheckpointReportingLagSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointReportingLagSeries not implemented")
+}
+func (*UnimplementedQueryServer) EpochForBtcHeight(ctx context.Context, req *QueryEpochForBtcHeightRequest) (*QueryEpochForBtcHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochForBtcHeight not implemented")
+}
+func (*UnimplementedQueryServer) MonitorSummary(ctx context.Context, req *QueryMonitorSummaryRequest) (*QueryMonitorSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorSummary not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_EndedEpochBtcHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEndedEpochBtcHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EndedEpochBtcHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/EndedEpochBtcHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EndedEpochBtcHeight(ctx, req.(*QueryEndedEpochBtcHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReportedCheckpointBtcHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportedCheckpointBtcHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReportedCheckpointBtcHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/ReportedCheckpointBtcHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReportedCheckpointBtcHeight(ctx, req.(*QueryReportedCheckpointBtcHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllEndedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEndedEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllEndedEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/AllEndedEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllEndedEpochs(ctx, req.(*QueryAllEndedEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointReportingLagSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointReportingLagSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointReportingLagSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/CheckpointReportingLagSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointReportingLagSeries(ctx, req.(*QueryCheckpointReportingLagSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochForBtcHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochForBtcHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochForBtcHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/EpochForBtcHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochForBtcHeight(ctx, req.(*QueryEpochForBtcHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MonitorSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMonitorSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MonitorSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/MonitorSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MonitorSummary(ctx, req.(*QueryMonitorSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.monitor.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EndedEpochBtcHeight",
+			Handler:    _Query_EndedEpochBtcHeight_Handler,
+		},
+		{
+			MethodName: "ReportedCheckpointBtcHeight",
+			Handler:    _Query_ReportedCheckpointBtcHeight_Handler,
+		},
+		{
+			MethodName: "AllEndedEpochs",
+			Handler:    _Query_AllEndedEpochs_Handler,
+		},
+		{
+			MethodName: "CheckpointReportingLagSeries",
+			Handler:    _Query_CheckpointReportingLagSeries_Handler,
+		},
+		{
+			MethodName: "EpochForBtcHeight",
+			Handler:    _Query_EpochForBtcHeight_Handler,
+		},
+		{
+			MethodName: "MonitorSummary",
+			Handler:    _Query_MonitorSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/monitor/v1/query.proto",
+}
+
+func (m *QueryEndedEpochBtcHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEndedEpochBtcHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEndedEpochBtcHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEndedEpochBtcHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEndedEpochBtcHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEndedEpochBtcHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportedCheckpointBtcHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportedCheckpointBtcHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportedCheckpointBtcHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CkptHash) > 0 {
+		i -= len(m.CkptHash)
+		copy(dAtA[i:], m.CkptHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CkptHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportedCheckpointBtcHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportedCheckpointBtcHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportedCheckpointBtcHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEndedEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEndedEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEndedEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEndedEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEndedEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEndedEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EndedEpochs) > 0 {
+		for iNdEx := len(m.EndedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointReportingLagSeriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointReportingLagSeriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointReportingLagSeriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointReportingLagSeriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointReportingLagSeriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointReportingLagSeriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lags) > 0 {
+		for iNdEx := len(m.Lags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointReportingLag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointReportingLag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointReportingLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lag != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReportedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReportedBtcHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reported {
+		i--
+		if m.Reported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndedEpochBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndedEpochBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochForBtcHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochForBtcHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochForBtcHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochForBtcHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochForBtcHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochForBtcHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonitorSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonitorSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonitorSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonitorSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonitorSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonitorSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestReportedEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestReportedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReportedEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReportedEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EndedEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndedEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEndedEpochBtcHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEndedEpochBtcHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *QueryReportedCheckpointBtcHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CkptHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportedCheckpointBtcHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *QueryAllEndedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEndedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EndedEpochs) > 0 {
+		for _, e := range m.EndedEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EndedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *QueryCheckpointReportingLagSeriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryCheckpointReportingLagSeriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lags) > 0 {
+		for _, e := range m.Lags {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointReportingLag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.EndedEpochBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndedEpochBtcHeight))
+	}
+	if m.Reported {
+		n += 2
+	}
+	if m.ReportedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ReportedBtcHeight))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovQuery(uint64(m.Lag))
+	}
+	return n
+}
+
+func (m *QueryEpochForBtcHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *QueryEpochForBtcHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *QueryMonitorSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMonitorSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.EndedEpochs))
+	}
+	if m.ReportedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.ReportedEpochs))
+	}
+	if m.LatestReportedEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.LatestReportedEpoch))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CkptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEndedEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEndedEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEndedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEndedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEndedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEndedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndedEpochs = append(m.EndedEpochs, &EndedEpoch{})
+			if err := m.EndedEpochs[len(m.EndedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointReportingLagSeriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointReportingLagSeriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointReportingLagSeriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointReportingLagSeriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointReportingLagSeriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointReportingLagSeriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lags = append(m.Lags, &CheckpointReportingLag{})
+			if err := m.Lags[len(m.Lags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryReportedCheckpointBtcHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcLightClientHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointReportingLag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -585,10 +2628,10 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointReportingLag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointReportingLag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -610,6 +2653,83 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedEpochBtcHeight", wireType)
+			}
+			m.EndedEpochBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndedEpochBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reported = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedBtcHeight", wireType)
+			}
+			m.ReportedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -631,7 +2751,7 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochForBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -654,17 +2774,17 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochForBtcHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochForBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
 			}
-			m.BtcLightClientHeight = 0
+			m.BtcHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -674,7 +2794,7 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				m.BtcHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -700,7 +2820,7 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochForBtcHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,17 +2843,17 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochForBtcHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochForBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var stringLen uint64
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -743,24 +2863,80 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CkptHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMonitorSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMonitorSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMonitorSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -782,7 +2958,7 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMonitorSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,17 +2981,17 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMonitorSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMonitorSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedEpochs", wireType)
 			}
-			m.BtcLightClientHeight = 0
+			m.EndedEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -825,7 +3001,45 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				m.EndedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedEpochs", wireType)
+			}
+			m.ReportedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestReportedEpoch", wireType)
+			}
+			m.LatestReportedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestReportedEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
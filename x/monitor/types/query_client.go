@@ -0,0 +1,109 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryOptions configures how RetryingQueryClient retries queries that fail
+// with a transient gRPC error
+type RetryOptions struct {
+	// Attempts is the maximum number of attempts of a query, including the
+	// first one
+	Attempts uint
+	// Delay is the delay before the first retry. The delay doubles upon each
+	// subsequent retry
+	Delay time.Duration
+	// MaxDelay caps the delay between two attempts. Zero means no cap
+	MaxDelay time.Duration
+}
+
+// DefaultRetryOptions returns the default RetryOptions
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		Attempts: 5,
+		Delay:    400 * time.Millisecond,
+		MaxDelay: 5 * time.Second,
+	}
+}
+
+// Validate validates the retry options
+func (o RetryOptions) Validate() error {
+	// NOTE: retry-go treats zero attempts as retrying forever
+	if o.Attempts == 0 {
+		return fmt.Errorf("the number of attempts must be positive")
+	}
+	if o.Delay < 0 {
+		return fmt.Errorf("the delay cannot be negative, got %v", o.Delay)
+	}
+	if o.MaxDelay < 0 {
+		return fmt.Errorf("the max delay cannot be negative, got %v", o.MaxDelay)
+	}
+	return nil
+}
+
+// RetryingQueryClient is a QueryClient that retries EndedEpochBtcHeight and
+// ReportedCheckpointBtcHeight upon transient gRPC errors, with exponential
+// backoff between attempts. Retries stop as soon as the context of the query
+// is done. Other queries are passed to the underlying QueryClient as is.
+type RetryingQueryClient struct {
+	QueryClient
+	opts RetryOptions
+}
+
+var _ QueryClient = (*RetryingQueryClient)(nil)
+
+// NewRetryingQueryClient returns a RetryingQueryClient that wraps the
+// generated QueryClient over the given client connection
+func NewRetryingQueryClient(cc grpc1.ClientConn, opts RetryOptions) (*RetryingQueryClient, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retry options: %w", err)
+	}
+	return &RetryingQueryClient{
+		QueryClient: NewQueryClient(cc),
+		opts:        opts,
+	}, nil
+}
+
+func (c *RetryingQueryClient) EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error) {
+	return retry.DoWithData(func() (*QueryEndedEpochBtcHeightResponse, error) {
+		return c.QueryClient.EndedEpochBtcHeight(ctx, in, opts...)
+	}, c.retryOptions(ctx)...)
+}
+
+func (c *RetryingQueryClient) ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error) {
+	return retry.DoWithData(func() (*QueryReportedCheckpointBtcHeightResponse, error) {
+		return c.QueryClient.ReportedCheckpointBtcHeight(ctx, in, opts...)
+	}, c.retryOptions(ctx)...)
+}
+
+func (c *RetryingQueryClient) retryOptions(ctx context.Context) []retry.Option {
+	return []retry.Option{
+		retry.Context(ctx),
+		retry.Attempts(c.opts.Attempts),
+		retry.Delay(c.opts.Delay),
+		retry.MaxDelay(c.opts.MaxDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.RetryIf(isTransientQueryErr),
+		retry.LastErrorOnly(true),
+	}
+}
+
+// isTransientQueryErr returns whether the given error returned by a query is
+// transient, i.e., retrying the same query may succeed. Errors returned by the
+// query handler itself, such as NotFound, are not transient.
+func isTransientQueryErr(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
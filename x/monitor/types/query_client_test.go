@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+// flakyClientConn is a client connection that fails the first numFailures
+// invocations with the given error and succeeds afterwards
+type flakyClientConn struct {
+	numFailures int
+	err         error
+	numInvokes  int
+}
+
+func (c *flakyClientConn) Invoke(_ context.Context, _ string, _, reply interface{}, _ ...grpc.CallOption) error {
+	c.numInvokes++
+	if c.numInvokes <= c.numFailures {
+		return c.err
+	}
+	if resp, ok := reply.(*types.QueryEndedEpochBtcHeightResponse); ok {
+		resp.BtcLightClientHeight = 10
+	}
+	return nil
+}
+
+func (c *flakyClientConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streaming is not supported")
+}
+
+func TestRetryingQueryClient(t *testing.T) {
+	opts := types.RetryOptions{
+		Attempts: 3,
+		Delay:    time.Millisecond,
+		MaxDelay: 2 * time.Millisecond,
+	}
+	req := &types.QueryEndedEpochBtcHeightRequest{EpochNum: 1}
+
+	t.Run("transient errors are retried", func(t *testing.T) {
+		cc := &flakyClientConn{numFailures: 2, err: status.Error(codes.Unavailable, "unavailable")}
+		client, err := types.NewRetryingQueryClient(cc, opts)
+		require.NoError(t, err)
+
+		resp, err := client.EndedEpochBtcHeight(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, uint32(10), resp.BtcLightClientHeight)
+		require.Equal(t, 3, cc.numInvokes)
+	})
+
+	t.Run("retries stop after the given attempts", func(t *testing.T) {
+		cc := &flakyClientConn{numFailures: 3, err: status.Error(codes.Unavailable, "unavailable")}
+		client, err := types.NewRetryingQueryClient(cc, opts)
+		require.NoError(t, err)
+
+		_, err = client.ReportedCheckpointBtcHeight(context.Background(), &types.QueryReportedCheckpointBtcHeightRequest{})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, 3, cc.numInvokes)
+	})
+
+	t.Run("non-transient errors are not retried", func(t *testing.T) {
+		cc := &flakyClientConn{numFailures: 1, err: status.Error(codes.NotFound, "not found")}
+		client, err := types.NewRetryingQueryClient(cc, opts)
+		require.NoError(t, err)
+
+		_, err = client.EndedEpochBtcHeight(context.Background(), req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Equal(t, 1, cc.numInvokes)
+	})
+
+	t.Run("retries stop upon context cancellation", func(t *testing.T) {
+		cc := &flakyClientConn{numFailures: 3, err: status.Error(codes.Unavailable, "unavailable")}
+		client, err := types.NewRetryingQueryClient(cc, types.RetryOptions{Attempts: 3, Delay: time.Hour})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = client.EndedEpochBtcHeight(ctx, req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, 1, cc.numInvokes)
+	})
+
+	t.Run("invalid options are rejected", func(t *testing.T) {
+		_, err := types.NewRetryingQueryClient(&flakyClientConn{}, types.RetryOptions{})
+		require.Error(t, err)
+	})
+}